@@ -1,21 +1,29 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
+	"net/http"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
 	"rival/config"
+	"rival/internal/auth/util"
 	"rival/internal/common/middleware"
+	"rival/pkg/mail"
+	"rival/pkg/notify"
 
 	adminhandler "rival/internal/admin/handler"
 	authhandler "rival/internal/auth/handler"
 	merchantshandler "rival/internal/merchants/handler"
+	offershandler "rival/internal/offers/handler"
 	ordershandler "rival/internal/orders/handler"
 	paymentshandler "rival/internal/payments/handler"
+	reviewshandler "rival/internal/reviews/handler"
 	usershandler "rival/internal/users/handler"
 
 	authpb "rival/gen/proto/proto/api"
@@ -23,6 +31,9 @@ import (
 
 func main() {
 	config := config.GetConfig()
+	if err := util.SetTrustedProxies(config.Security.TrustedProxies); err != nil {
+		log.Fatalf("Invalid security.trusted_proxies: %v", err)
+	}
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", config.Server.Port))
 	if err != nil {
 		log.Fatalf("Failed to listen: %v", err)
@@ -33,6 +44,9 @@ func main() {
 			middleware.LoggingInterceptor,
 			middleware.AuthInterceptor,
 		),
+		grpc.ChainStreamInterceptor(
+			middleware.StreamAuthInterceptor,
+		),
 	)
 
 	// Register auth service
@@ -77,7 +91,61 @@ func main() {
 	}
 	authpb.RegisterOrderServiceServer(s, ordersHandler)
 
-	// TODO: Add offers service when handler is implemented
+	// Register offers service (nearby search only so far)
+	offersHandler, err := offershandler.NewOfferHandler()
+	if err != nil {
+		log.Fatalf("Failed to create offers handler: %v", err)
+	}
+	authpb.RegisterOfferServiceServer(s, offersHandler)
+
+	// Register reviews service
+	reviewsHandler, err := reviewshandler.NewReviewHandler()
+	if err != nil {
+		log.Fatalf("Failed to create reviews handler: %v", err)
+	}
+	authpb.RegisterReviewServiceServer(s, reviewsHandler)
+
+	// Remind merchants before their KYC licences expire
+	go merchantsHandler.StartDocumentExpiryReminders(context.Background())
+
+	// Auto-reject orders nobody accepted and escalate late ones
+	go ordersHandler.StartOrderTimers(context.Background())
+
+	// Send queued push notifications
+	pushWorker, err := notify.NewPushWorkerFromConfig()
+	if err != nil {
+		log.Fatalf("Failed to create push worker: %v", err)
+	}
+	if pushWorker != nil {
+		go pushWorker.Start(context.Background())
+	}
+
+	// Send queued emails
+	mailWorker, err := mail.NewWorkerFromConfig()
+	if err != nil {
+		log.Fatalf("Failed to create mail worker: %v", err)
+	}
+	go mailWorker.Start(context.Background())
+
+	// Rotate JWT signing keys and publish them as JWKS
+	keyRing, err := util.GetKeyRing()
+	if err != nil {
+		log.Fatalf("Failed to load JWT signing keys: %v", err)
+	}
+	go keyRing.StartRotation(context.Background(), time.Duration(config.JWT.RotationHour)*time.Hour)
+
+	jwksHandler, err := authhandler.NewJWKSHandler()
+	if err != nil {
+		log.Fatalf("Failed to create JWKS handler: %v", err)
+	}
+	mux := http.NewServeMux()
+	mux.Handle(authhandler.JWKSPath, jwksHandler)
+	go func() {
+		log.Println("JWKS endpoint listening on :", config.JWT.JWKSPort)
+		if err := http.ListenAndServe(fmt.Sprintf(":%d", config.JWT.JWKSPort), mux); err != nil {
+			log.Fatalf("Failed to serve JWKS: %v", err)
+		}
+	}()
 
 	// Enable reflection for grpcurl/grpc clients
 	reflection.Register(s)
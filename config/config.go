@@ -19,6 +19,112 @@ type Config struct {
 	MailHog        MailHogConfig        `yaml:"mail"`
 	Firebase       FirebaseConfig       `yaml:"firebase"`
 	PaymentGateway PaymentGatewayConfig `yaml:"payment_gateway"`
+	Security       SecurityConfig       `yaml:"security"`
+	Identity       IdentityConfig       `yaml:"identity"`
+	KYC            KYCConfig            `yaml:"kyc"`
+	Geocoder       GeocoderConfig       `yaml:"geocoder"`
+	Orders         OrdersConfig         `yaml:"orders"`
+	Receipts       ReceiptsConfig       `yaml:"receipts"`
+	Push           PushConfig           `yaml:"push"`
+	SMS            SMSConfig            `yaml:"sms"`
+}
+
+// OrdersConfig sets the order SLA timers and numbering.
+type OrdersConfig struct {
+	AcceptTimeoutMinutes    int    `yaml:"accept_timeout_minutes"`    // pending orders are auto-rejected after this
+	PreparingTimeoutMinutes int    `yaml:"preparing_timeout_minutes"` // orders preparing longer than this are escalated
+	TimerIntervalSeconds    int    `yaml:"timer_interval_seconds"`    // how often each instance polls for due timers
+	NumberReset             string `yaml:"number_reset"`              // daily or never, when merchants' order numbers start over
+}
+
+// ReceiptsConfig sets how receipts and tax invoices are issued.
+type ReceiptsConfig struct {
+	GSTRatePercent float64 `yaml:"gst_rate_percent"` // GST included in order prices
+	ViewURLMinutes int     `yaml:"view_url_minutes"` // lifetime of the presigned download links
+}
+
+// PushConfig picks the push provider and paces the delivery worker.
+type PushConfig struct {
+	Provider            string  `yaml:"provider"` // fcm, recording or empty to disable
+	MaxAttempts         int     `yaml:"max_attempts"`
+	IntervalSeconds     int     `yaml:"interval_seconds"`       // how often each instance polls for queued pushes
+	NearbyOfferRadiusKm float64 `yaml:"nearby_offer_radius_km"` // users with a device this close hear about new offers
+}
+
+// SMSConfig picks the SMS provider for phone OTPs.
+type SMSConfig struct {
+	Provider      string       `yaml:"provider"`       // msg91, twilio, fake or empty to disable phone login
+	ResendSeconds int          `yaml:"resend_seconds"` // minimum gap between codes to one number
+	MSG91         MSG91Config  `yaml:"msg91"`
+	Twilio        TwilioConfig `yaml:"twilio"`
+}
+
+type MSG91Config struct {
+	AuthKey    string `yaml:"auth_key"`
+	TemplateID string `yaml:"template_id"` // DLT approved flow template with an ##otp## variable
+	URL        string `yaml:"url"`         // defaults to https://control.msg91.com
+}
+
+type TwilioConfig struct {
+	AccountSID string `yaml:"account_sid"`
+	AuthToken  string `yaml:"auth_token"`
+	From       string `yaml:"from"` // sending number or messaging service SID
+	URL        string `yaml:"url"`  // defaults to https://api.twilio.com
+}
+
+// GeocoderConfig picks how merchant addresses sent without coordinates are located.
+type GeocoderConfig struct {
+	Provider    string `yaml:"provider"` // nominatim, fixture or empty to disable
+	URL         string `yaml:"url"`
+	UserAgent   string `yaml:"user_agent"`
+	FixturePath string `yaml:"fixture_path"` // JSON object of address -> {latitude, longitude}
+}
+
+// KYCConfig controls merchant document uploads and licence expiry reminders.
+type KYCConfig struct {
+	MaxUploadMB       int   `yaml:"max_upload_mb"`
+	UploadURLMinutes  int   `yaml:"upload_url_minutes"`
+	ViewURLMinutes    int   `yaml:"view_url_minutes"`
+	ReminderDays      []int `yaml:"reminder_days"` // days before expiry to remind the merchant
+	CheckIntervalHour int   `yaml:"check_interval_hour"`
+}
+
+// IdentityConfig lists the social login providers besides Firebase.
+type IdentityConfig struct {
+	OIDC      []OIDCProviderConfig `yaml:"oidc"`
+	LocalStub bool                 `yaml:"local_stub"` // in-process OIDC issuer for tests, never enable in production
+}
+
+type OIDCProviderConfig struct {
+	Name      string   `yaml:"name"` // provider name clients send, e.g. google or apple
+	Issuer    string   `yaml:"issuer"`
+	ClientIDs []string `yaml:"client_ids"` // accepted audiences
+	JWKSURL   string   `yaml:"jwks_url"`   // discovered from the issuer when empty
+}
+
+type SecurityConfig struct {
+	Login          LoginThrottleConfig  `yaml:"login"`
+	Password       PasswordPolicyConfig `yaml:"password"`
+	TrustedProxies []string             `yaml:"trusted_proxies"` // load balancers whose x-forwarded-for is believed
+}
+
+type LoginThrottleConfig struct {
+	WindowMinutes    int `yaml:"window_minutes"` // sliding window for failure counters
+	MaxEmailFailures int `yaml:"max_email_failures"`
+	MaxIPFailures    int `yaml:"max_ip_failures"`
+	MaxPairFailures  int `yaml:"max_pair_failures"` // same email from the same IP
+	DelayAfter       int `yaml:"delay_after"`       // failures before progressive delays start
+	MaxDelaySeconds  int `yaml:"max_delay_seconds"`
+	LockoutMinutes   int `yaml:"lockout_minutes"`
+}
+
+type PasswordPolicyConfig struct {
+	MinLength      int  `yaml:"min_length"`
+	RequireUpper   bool `yaml:"require_upper"`
+	RequireLower   bool `yaml:"require_lower"`
+	RequireDigit   bool `yaml:"require_digit"`
+	RequireSymbol  bool `yaml:"require_symbol"`
+	DisallowCommon bool `yaml:"disallow_common"`
 }
 
 type PaymentGatewayConfig struct {
@@ -45,6 +151,12 @@ type MailHogConfig struct {
 	SMTPServer string `yaml:"smtp_server"`
 	SMTPPort   int    `yaml:"smtp_port"`
 	WebUIPort  int    `yaml:"web_ui_port"`
+	// Transport is smtp (default) or file, which writes .eml files to SinkDir
+	Transport       string `yaml:"transport"`
+	SinkDir         string `yaml:"sink_dir"`
+	From            string `yaml:"from"`
+	MaxAttempts     int    `yaml:"max_attempts"`
+	IntervalSeconds int    `yaml:"interval_seconds"`
 }
 
 type DatabaseConfig struct {
@@ -63,8 +175,15 @@ type RedisConfig struct {
 }
 
 type JWTConfig struct {
-	Secret     string `yaml:"secret"`
-	ExpiryHour int    `yaml:"expiry_hour"`
+	ExpiryHour        int    `yaml:"expiry_hour"`
+	RefreshExpiryHour int    `yaml:"refresh_expiry_hour"`
+	Algorithm         string `yaml:"algorithm"` // RS256 or EdDSA
+	Issuer            string `yaml:"issuer"`
+	KeyStore          string `yaml:"key_store"` // redis (default, shared by instances) or file
+	KeysDir           string `yaml:"keys_dir"`  // used by the file key store
+	RotationHour      int    `yaml:"rotation_hour"`
+	GraceHour         int    `yaml:"grace_hour"` // how long retired keys still verify
+	JWKSPort          int    `yaml:"jwks_port"`
 }
 
 type ServerConfig struct {
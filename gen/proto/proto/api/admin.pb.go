@@ -138,7 +138,7 @@ type GetAllMerchantsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
 	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // all, active, pending, suspended
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // all, active, pending, suspended, or any merchant status
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -249,25 +249,697 @@ func (x *GetAllMerchantsResponse) GetTotalCount() int32 {
 type ApproveMerchantRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveMerchantRequest) Reset() {
+	*x = ApproveMerchantRequest{}
+	mi := &file_proto_api_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveMerchantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveMerchantRequest) ProtoMessage() {}
+
+func (x *ApproveMerchantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveMerchantRequest.ProtoReflect.Descriptor instead.
+func (*ApproveMerchantRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ApproveMerchantRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *ApproveMerchantRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ApproveMerchantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Merchant      *schema.Merchant       `protobuf:"bytes,2,opt,name=merchant,proto3" json:"merchant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveMerchantResponse) Reset() {
+	*x = ApproveMerchantResponse{}
+	mi := &file_proto_api_admin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveMerchantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveMerchantResponse) ProtoMessage() {}
+
+func (x *ApproveMerchantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_admin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveMerchantResponse.ProtoReflect.Descriptor instead.
+func (*ApproveMerchantResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ApproveMerchantResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ApproveMerchantResponse) GetMerchant() *schema.Merchant {
+	if x != nil {
+		return x.Merchant
+	}
+	return nil
+}
+
+type SuspendMerchantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendMerchantRequest) Reset() {
+	*x = SuspendMerchantRequest{}
+	mi := &file_proto_api_admin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendMerchantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendMerchantRequest) ProtoMessage() {}
+
+func (x *SuspendMerchantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_admin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendMerchantRequest.ProtoReflect.Descriptor instead.
+func (*SuspendMerchantRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SuspendMerchantRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *SuspendMerchantRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SuspendMerchantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Merchant      *schema.Merchant       `protobuf:"bytes,2,opt,name=merchant,proto3" json:"merchant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendMerchantResponse) Reset() {
+	*x = SuspendMerchantResponse{}
+	mi := &file_proto_api_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendMerchantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendMerchantResponse) ProtoMessage() {}
+
+func (x *SuspendMerchantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendMerchantResponse.ProtoReflect.Descriptor instead.
+func (*SuspendMerchantResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_admin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SuspendMerchantResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SuspendMerchantResponse) GetMerchant() *schema.Merchant {
+	if x != nil {
+		return x.Merchant
+	}
+	return nil
+}
+
+type StartMerchantReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartMerchantReviewRequest) Reset() {
+	*x = StartMerchantReviewRequest{}
+	mi := &file_proto_api_admin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartMerchantReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartMerchantReviewRequest) ProtoMessage() {}
+
+func (x *StartMerchantReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_admin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartMerchantReviewRequest.ProtoReflect.Descriptor instead.
+func (*StartMerchantReviewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_admin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *StartMerchantReviewRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+type StartMerchantReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Merchant      *schema.Merchant       `protobuf:"bytes,1,opt,name=merchant,proto3" json:"merchant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartMerchantReviewResponse) Reset() {
+	*x = StartMerchantReviewResponse{}
+	mi := &file_proto_api_admin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartMerchantReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartMerchantReviewResponse) ProtoMessage() {}
+
+func (x *StartMerchantReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_admin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartMerchantReviewResponse.ProtoReflect.Descriptor instead.
+func (*StartMerchantReviewResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_admin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *StartMerchantReviewResponse) GetMerchant() *schema.Merchant {
+	if x != nil {
+		return x.Merchant
+	}
+	return nil
+}
+
+type RejectMerchantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectMerchantRequest) Reset() {
+	*x = RejectMerchantRequest{}
+	mi := &file_proto_api_admin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectMerchantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectMerchantRequest) ProtoMessage() {}
+
+func (x *RejectMerchantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_admin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectMerchantRequest.ProtoReflect.Descriptor instead.
+func (*RejectMerchantRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_admin_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RejectMerchantRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *RejectMerchantRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RejectMerchantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Merchant      *schema.Merchant       `protobuf:"bytes,1,opt,name=merchant,proto3" json:"merchant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectMerchantResponse) Reset() {
+	*x = RejectMerchantResponse{}
+	mi := &file_proto_api_admin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectMerchantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectMerchantResponse) ProtoMessage() {}
+
+func (x *RejectMerchantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_admin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectMerchantResponse.ProtoReflect.Descriptor instead.
+func (*RejectMerchantResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_admin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RejectMerchantResponse) GetMerchant() *schema.Merchant {
+	if x != nil {
+		return x.Merchant
+	}
+	return nil
+}
+
+type ReinstateMerchantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReinstateMerchantRequest) Reset() {
+	*x = ReinstateMerchantRequest{}
+	mi := &file_proto_api_admin_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReinstateMerchantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReinstateMerchantRequest) ProtoMessage() {}
+
+func (x *ReinstateMerchantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_admin_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReinstateMerchantRequest.ProtoReflect.Descriptor instead.
+func (*ReinstateMerchantRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_admin_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ReinstateMerchantRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *ReinstateMerchantRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReinstateMerchantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Merchant      *schema.Merchant       `protobuf:"bytes,1,opt,name=merchant,proto3" json:"merchant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReinstateMerchantResponse) Reset() {
+	*x = ReinstateMerchantResponse{}
+	mi := &file_proto_api_admin_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReinstateMerchantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReinstateMerchantResponse) ProtoMessage() {}
+
+func (x *ReinstateMerchantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_admin_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReinstateMerchantResponse.ProtoReflect.Descriptor instead.
+func (*ReinstateMerchantResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_admin_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ReinstateMerchantResponse) GetMerchant() *schema.Merchant {
+	if x != nil {
+		return x.Merchant
+	}
+	return nil
+}
+
+type GetMerchantStatusHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMerchantStatusHistoryRequest) Reset() {
+	*x = GetMerchantStatusHistoryRequest{}
+	mi := &file_proto_api_admin_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMerchantStatusHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMerchantStatusHistoryRequest) ProtoMessage() {}
+
+func (x *GetMerchantStatusHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_admin_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMerchantStatusHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetMerchantStatusHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_admin_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetMerchantStatusHistoryRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+type GetMerchantStatusHistoryResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	History       []*schema.MerchantStatusChange `protobuf:"bytes,1,rep,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMerchantStatusHistoryResponse) Reset() {
+	*x = GetMerchantStatusHistoryResponse{}
+	mi := &file_proto_api_admin_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMerchantStatusHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMerchantStatusHistoryResponse) ProtoMessage() {}
+
+func (x *GetMerchantStatusHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_admin_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMerchantStatusHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetMerchantStatusHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_admin_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetMerchantStatusHistoryResponse) GetHistory() []*schema.MerchantStatusChange {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type ListMerchantDocumentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMerchantDocumentsRequest) Reset() {
+	*x = ListMerchantDocumentsRequest{}
+	mi := &file_proto_api_admin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMerchantDocumentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMerchantDocumentsRequest) ProtoMessage() {}
+
+func (x *ListMerchantDocumentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_admin_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMerchantDocumentsRequest.ProtoReflect.Descriptor instead.
+func (*ListMerchantDocumentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_admin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListMerchantDocumentsRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+type ListMerchantDocumentsResponse struct {
+	state            protoimpl.MessageState     `protogen:"open.v1"`
+	Documents        []*schema.MerchantDocument `protobuf:"bytes,1,rep,name=documents,proto3" json:"documents,omitempty"`
+	MissingDocuments []string                   `protobuf:"bytes,2,rep,name=missing_documents,json=missingDocuments,proto3" json:"missing_documents,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListMerchantDocumentsResponse) Reset() {
+	*x = ListMerchantDocumentsResponse{}
+	mi := &file_proto_api_admin_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMerchantDocumentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMerchantDocumentsResponse) ProtoMessage() {}
+
+func (x *ListMerchantDocumentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_admin_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMerchantDocumentsResponse.ProtoReflect.Descriptor instead.
+func (*ListMerchantDocumentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_admin_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListMerchantDocumentsResponse) GetDocuments() []*schema.MerchantDocument {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+func (x *ListMerchantDocumentsResponse) GetMissingDocuments() []string {
+	if x != nil {
+		return x.MissingDocuments
+	}
+	return nil
+}
+
+type VerifyMerchantDocumentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DocumentId    int64                  `protobuf:"varint,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApproveMerchantRequest) Reset() {
-	*x = ApproveMerchantRequest{}
-	mi := &file_proto_api_admin_proto_msgTypes[4]
+func (x *VerifyMerchantDocumentRequest) Reset() {
+	*x = VerifyMerchantDocumentRequest{}
+	mi := &file_proto_api_admin_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApproveMerchantRequest) String() string {
+func (x *VerifyMerchantDocumentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApproveMerchantRequest) ProtoMessage() {}
+func (*VerifyMerchantDocumentRequest) ProtoMessage() {}
 
-func (x *ApproveMerchantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_admin_proto_msgTypes[4]
+func (x *VerifyMerchantDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_admin_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -278,40 +950,40 @@ func (x *ApproveMerchantRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApproveMerchantRequest.ProtoReflect.Descriptor instead.
-func (*ApproveMerchantRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_admin_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use VerifyMerchantDocumentRequest.ProtoReflect.Descriptor instead.
+func (*VerifyMerchantDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_admin_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ApproveMerchantRequest) GetMerchantId() int64 {
+func (x *VerifyMerchantDocumentRequest) GetDocumentId() int64 {
 	if x != nil {
-		return x.MerchantId
+		return x.DocumentId
 	}
 	return 0
 }
 
-type ApproveMerchantResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+type VerifyMerchantDocumentResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Document      *schema.MerchantDocument `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApproveMerchantResponse) Reset() {
-	*x = ApproveMerchantResponse{}
-	mi := &file_proto_api_admin_proto_msgTypes[5]
+func (x *VerifyMerchantDocumentResponse) Reset() {
+	*x = VerifyMerchantDocumentResponse{}
+	mi := &file_proto_api_admin_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApproveMerchantResponse) String() string {
+func (x *VerifyMerchantDocumentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApproveMerchantResponse) ProtoMessage() {}
+func (*VerifyMerchantDocumentResponse) ProtoMessage() {}
 
-func (x *ApproveMerchantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_admin_proto_msgTypes[5]
+func (x *VerifyMerchantDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_admin_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -322,41 +994,41 @@ func (x *ApproveMerchantResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApproveMerchantResponse.ProtoReflect.Descriptor instead.
-func (*ApproveMerchantResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_admin_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use VerifyMerchantDocumentResponse.ProtoReflect.Descriptor instead.
+func (*VerifyMerchantDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_admin_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ApproveMerchantResponse) GetSuccess() bool {
+func (x *VerifyMerchantDocumentResponse) GetDocument() *schema.MerchantDocument {
 	if x != nil {
-		return x.Success
+		return x.Document
 	}
-	return false
+	return nil
 }
 
-type SuspendMerchantRequest struct {
+type RejectMerchantDocumentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	DocumentId    int64                  `protobuf:"varint,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
 	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SuspendMerchantRequest) Reset() {
-	*x = SuspendMerchantRequest{}
-	mi := &file_proto_api_admin_proto_msgTypes[6]
+func (x *RejectMerchantDocumentRequest) Reset() {
+	*x = RejectMerchantDocumentRequest{}
+	mi := &file_proto_api_admin_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuspendMerchantRequest) String() string {
+func (x *RejectMerchantDocumentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuspendMerchantRequest) ProtoMessage() {}
+func (*RejectMerchantDocumentRequest) ProtoMessage() {}
 
-func (x *SuspendMerchantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_admin_proto_msgTypes[6]
+func (x *RejectMerchantDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_admin_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -367,47 +1039,47 @@ func (x *SuspendMerchantRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuspendMerchantRequest.ProtoReflect.Descriptor instead.
-func (*SuspendMerchantRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_admin_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use RejectMerchantDocumentRequest.ProtoReflect.Descriptor instead.
+func (*RejectMerchantDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_admin_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *SuspendMerchantRequest) GetMerchantId() int64 {
+func (x *RejectMerchantDocumentRequest) GetDocumentId() int64 {
 	if x != nil {
-		return x.MerchantId
+		return x.DocumentId
 	}
 	return 0
 }
 
-func (x *SuspendMerchantRequest) GetReason() string {
+func (x *RejectMerchantDocumentRequest) GetReason() string {
 	if x != nil {
 		return x.Reason
 	}
 	return ""
 }
 
-type SuspendMerchantResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+type RejectMerchantDocumentResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Document      *schema.MerchantDocument `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SuspendMerchantResponse) Reset() {
-	*x = SuspendMerchantResponse{}
-	mi := &file_proto_api_admin_proto_msgTypes[7]
+func (x *RejectMerchantDocumentResponse) Reset() {
+	*x = RejectMerchantDocumentResponse{}
+	mi := &file_proto_api_admin_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SuspendMerchantResponse) String() string {
+func (x *RejectMerchantDocumentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuspendMerchantResponse) ProtoMessage() {}
+func (*RejectMerchantDocumentResponse) ProtoMessage() {}
 
-func (x *SuspendMerchantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_admin_proto_msgTypes[7]
+func (x *RejectMerchantDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_admin_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -418,16 +1090,16 @@ func (x *SuspendMerchantResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuspendMerchantResponse.ProtoReflect.Descriptor instead.
-func (*SuspendMerchantResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_admin_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use RejectMerchantDocumentResponse.ProtoReflect.Descriptor instead.
+func (*RejectMerchantDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_admin_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *SuspendMerchantResponse) GetSuccess() bool {
+func (x *RejectMerchantDocumentResponse) GetDocument() *schema.MerchantDocument {
 	if x != nil {
-		return x.Success
+		return x.Document
 	}
-	return false
+	return nil
 }
 
 type GetAllUsersRequest struct {
@@ -441,7 +1113,7 @@ type GetAllUsersRequest struct {
 
 func (x *GetAllUsersRequest) Reset() {
 	*x = GetAllUsersRequest{}
-	mi := &file_proto_api_admin_proto_msgTypes[8]
+	mi := &file_proto_api_admin_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -453,7 +1125,7 @@ func (x *GetAllUsersRequest) String() string {
 func (*GetAllUsersRequest) ProtoMessage() {}
 
 func (x *GetAllUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_admin_proto_msgTypes[8]
+	mi := &file_proto_api_admin_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -466,7 +1138,7 @@ func (x *GetAllUsersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllUsersRequest.ProtoReflect.Descriptor instead.
 func (*GetAllUsersRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_admin_proto_rawDescGZIP(), []int{8}
+	return file_proto_api_admin_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *GetAllUsersRequest) GetPage() int32 {
@@ -500,7 +1172,7 @@ type GetAllUsersResponse struct {
 
 func (x *GetAllUsersResponse) Reset() {
 	*x = GetAllUsersResponse{}
-	mi := &file_proto_api_admin_proto_msgTypes[9]
+	mi := &file_proto_api_admin_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -512,7 +1184,7 @@ func (x *GetAllUsersResponse) String() string {
 func (*GetAllUsersResponse) ProtoMessage() {}
 
 func (x *GetAllUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_admin_proto_msgTypes[9]
+	mi := &file_proto_api_admin_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -525,7 +1197,7 @@ func (x *GetAllUsersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllUsersResponse.ProtoReflect.Descriptor instead.
 func (*GetAllUsersResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_admin_proto_rawDescGZIP(), []int{9}
+	return file_proto_api_admin_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GetAllUsersResponse) GetUsers() []*schema.User {
@@ -552,7 +1224,7 @@ type SuspendUserRequest struct {
 
 func (x *SuspendUserRequest) Reset() {
 	*x = SuspendUserRequest{}
-	mi := &file_proto_api_admin_proto_msgTypes[10]
+	mi := &file_proto_api_admin_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -564,7 +1236,7 @@ func (x *SuspendUserRequest) String() string {
 func (*SuspendUserRequest) ProtoMessage() {}
 
 func (x *SuspendUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_admin_proto_msgTypes[10]
+	mi := &file_proto_api_admin_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -577,7 +1249,7 @@ func (x *SuspendUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SuspendUserRequest.ProtoReflect.Descriptor instead.
 func (*SuspendUserRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_admin_proto_rawDescGZIP(), []int{10}
+	return file_proto_api_admin_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *SuspendUserRequest) GetUserId() int64 {
@@ -603,7 +1275,7 @@ type SuspendUserResponse struct {
 
 func (x *SuspendUserResponse) Reset() {
 	*x = SuspendUserResponse{}
-	mi := &file_proto_api_admin_proto_msgTypes[11]
+	mi := &file_proto_api_admin_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -615,7 +1287,7 @@ func (x *SuspendUserResponse) String() string {
 func (*SuspendUserResponse) ProtoMessage() {}
 
 func (x *SuspendUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_admin_proto_msgTypes[11]
+	mi := &file_proto_api_admin_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -628,7 +1300,7 @@ func (x *SuspendUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SuspendUserResponse.ProtoReflect.Descriptor instead.
 func (*SuspendUserResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_admin_proto_rawDescGZIP(), []int{11}
+	return file_proto_api_admin_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *SuspendUserResponse) GetSuccess() bool {
@@ -652,7 +1324,7 @@ type GetAllTransactionsRequest struct {
 
 func (x *GetAllTransactionsRequest) Reset() {
 	*x = GetAllTransactionsRequest{}
-	mi := &file_proto_api_admin_proto_msgTypes[12]
+	mi := &file_proto_api_admin_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -664,7 +1336,7 @@ func (x *GetAllTransactionsRequest) String() string {
 func (*GetAllTransactionsRequest) ProtoMessage() {}
 
 func (x *GetAllTransactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_admin_proto_msgTypes[12]
+	mi := &file_proto_api_admin_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -677,7 +1349,7 @@ func (x *GetAllTransactionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllTransactionsRequest.ProtoReflect.Descriptor instead.
 func (*GetAllTransactionsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_admin_proto_rawDescGZIP(), []int{12}
+	return file_proto_api_admin_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GetAllTransactionsRequest) GetPage() int32 {
@@ -732,7 +1404,7 @@ type GetAllTransactionsResponse struct {
 
 func (x *GetAllTransactionsResponse) Reset() {
 	*x = GetAllTransactionsResponse{}
-	mi := &file_proto_api_admin_proto_msgTypes[13]
+	mi := &file_proto_api_admin_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -744,7 +1416,7 @@ func (x *GetAllTransactionsResponse) String() string {
 func (*GetAllTransactionsResponse) ProtoMessage() {}
 
 func (x *GetAllTransactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_admin_proto_msgTypes[13]
+	mi := &file_proto_api_admin_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -757,7 +1429,7 @@ func (x *GetAllTransactionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllTransactionsResponse.ProtoReflect.Descriptor instead.
 func (*GetAllTransactionsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_admin_proto_rawDescGZIP(), []int{13}
+	return file_proto_api_admin_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetAllTransactionsResponse) GetTransactions() []*schema.Transaction {
@@ -786,7 +1458,7 @@ type GetAuditLogsRequest struct {
 
 func (x *GetAuditLogsRequest) Reset() {
 	*x = GetAuditLogsRequest{}
-	mi := &file_proto_api_admin_proto_msgTypes[14]
+	mi := &file_proto_api_admin_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -798,7 +1470,7 @@ func (x *GetAuditLogsRequest) String() string {
 func (*GetAuditLogsRequest) ProtoMessage() {}
 
 func (x *GetAuditLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_admin_proto_msgTypes[14]
+	mi := &file_proto_api_admin_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -811,7 +1483,7 @@ func (x *GetAuditLogsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAuditLogsRequest.ProtoReflect.Descriptor instead.
 func (*GetAuditLogsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_admin_proto_rawDescGZIP(), []int{14}
+	return file_proto_api_admin_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *GetAuditLogsRequest) GetPage() int32 {
@@ -852,7 +1524,7 @@ type GetAuditLogsResponse struct {
 
 func (x *GetAuditLogsResponse) Reset() {
 	*x = GetAuditLogsResponse{}
-	mi := &file_proto_api_admin_proto_msgTypes[15]
+	mi := &file_proto_api_admin_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -864,7 +1536,7 @@ func (x *GetAuditLogsResponse) String() string {
 func (*GetAuditLogsResponse) ProtoMessage() {}
 
 func (x *GetAuditLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_admin_proto_msgTypes[15]
+	mi := &file_proto_api_admin_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -877,7 +1549,7 @@ func (x *GetAuditLogsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAuditLogsResponse.ProtoReflect.Descriptor instead.
 func (*GetAuditLogsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_admin_proto_rawDescGZIP(), []int{15}
+	return file_proto_api_admin_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *GetAuditLogsResponse) GetLogs() []*schema.AuditLog {
@@ -902,7 +1574,7 @@ type StreamSystemAlertsRequest struct {
 
 func (x *StreamSystemAlertsRequest) Reset() {
 	*x = StreamSystemAlertsRequest{}
-	mi := &file_proto_api_admin_proto_msgTypes[16]
+	mi := &file_proto_api_admin_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -914,7 +1586,7 @@ func (x *StreamSystemAlertsRequest) String() string {
 func (*StreamSystemAlertsRequest) ProtoMessage() {}
 
 func (x *StreamSystemAlertsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_admin_proto_msgTypes[16]
+	mi := &file_proto_api_admin_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -927,7 +1599,7 @@ func (x *StreamSystemAlertsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamSystemAlertsRequest.ProtoReflect.Descriptor instead.
 func (*StreamSystemAlertsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_admin_proto_rawDescGZIP(), []int{16}
+	return file_proto_api_admin_proto_rawDescGZIP(), []int{30}
 }
 
 type StreamSystemAlertsResponse struct {
@@ -944,7 +1616,7 @@ type StreamSystemAlertsResponse struct {
 
 func (x *StreamSystemAlertsResponse) Reset() {
 	*x = StreamSystemAlertsResponse{}
-	mi := &file_proto_api_admin_proto_msgTypes[17]
+	mi := &file_proto_api_admin_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -956,7 +1628,7 @@ func (x *StreamSystemAlertsResponse) String() string {
 func (*StreamSystemAlertsResponse) ProtoMessage() {}
 
 func (x *StreamSystemAlertsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_admin_proto_msgTypes[17]
+	mi := &file_proto_api_admin_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -969,7 +1641,7 @@ func (x *StreamSystemAlertsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamSystemAlertsResponse.ProtoReflect.Descriptor instead.
 func (*StreamSystemAlertsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_admin_proto_rawDescGZIP(), []int{17}
+	return file_proto_api_admin_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *StreamSystemAlertsResponse) GetId() string {
@@ -1014,6 +1686,214 @@ func (x *StreamSystemAlertsResponse) GetTimestamp() int64 {
 	return 0
 }
 
+type ListFlaggedReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFlaggedReviewsRequest) Reset() {
+	*x = ListFlaggedReviewsRequest{}
+	mi := &file_proto_api_admin_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFlaggedReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFlaggedReviewsRequest) ProtoMessage() {}
+
+func (x *ListFlaggedReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_admin_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFlaggedReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListFlaggedReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_admin_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListFlaggedReviewsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListFlaggedReviewsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListFlaggedReviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviews       []*schema.Review       `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"` // most flagged first
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFlaggedReviewsResponse) Reset() {
+	*x = ListFlaggedReviewsResponse{}
+	mi := &file_proto_api_admin_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFlaggedReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFlaggedReviewsResponse) ProtoMessage() {}
+
+func (x *ListFlaggedReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_admin_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFlaggedReviewsResponse.ProtoReflect.Descriptor instead.
+func (*ListFlaggedReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_admin_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListFlaggedReviewsResponse) GetReviews() []*schema.Review {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+func (x *ListFlaggedReviewsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type ModerateReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      int64                  `protobuf:"varint,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"` // hide, restore or dismiss (keep it published and clear the flags)
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateReviewRequest) Reset() {
+	*x = ModerateReviewRequest{}
+	mi := &file_proto_api_admin_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateReviewRequest) ProtoMessage() {}
+
+func (x *ModerateReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_admin_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateReviewRequest.ProtoReflect.Descriptor instead.
+func (*ModerateReviewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_admin_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ModerateReviewRequest) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *ModerateReviewRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ModerateReviewRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ModerateReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Review        *schema.Review         `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateReviewResponse) Reset() {
+	*x = ModerateReviewResponse{}
+	mi := &file_proto_api_admin_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateReviewResponse) ProtoMessage() {}
+
+func (x *ModerateReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_admin_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateReviewResponse.ProtoReflect.Descriptor instead.
+func (*ModerateReviewResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_admin_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ModerateReviewResponse) GetReview() *schema.Review {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
 var File_proto_api_admin_proto protoreflect.FileDescriptor
 
 const file_proto_api_admin_proto_rawDesc = "" +
@@ -1034,18 +1914,60 @@ const file_proto_api_admin_proto_rawDesc = "" +
 	"\x17GetAllMerchantsResponse\x127\n" +
 	"\tmerchants\x18\x01 \x03(\v2\x19.rival.schema.v1.MerchantR\tmerchants\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"9\n" +
+	"totalCount\"Q\n" +
 	"\x16ApproveMerchantRequest\x12\x1f\n" +
 	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
-	"merchantId\"3\n" +
+	"merchantId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"j\n" +
 	"\x17ApproveMerchantResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"Q\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x125\n" +
+	"\bmerchant\x18\x02 \x01(\v2\x19.rival.schema.v1.MerchantR\bmerchant\"Q\n" +
 	"\x16SuspendMerchantRequest\x12\x1f\n" +
 	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
 	"merchantId\x12\x16\n" +
-	"\x06reason\x18\x02 \x01(\tR\x06reason\"3\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"j\n" +
 	"\x17SuspendMerchantResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"R\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x125\n" +
+	"\bmerchant\x18\x02 \x01(\v2\x19.rival.schema.v1.MerchantR\bmerchant\"=\n" +
+	"\x1aStartMerchantReviewRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\"T\n" +
+	"\x1bStartMerchantReviewResponse\x125\n" +
+	"\bmerchant\x18\x01 \x01(\v2\x19.rival.schema.v1.MerchantR\bmerchant\"P\n" +
+	"\x15RejectMerchantRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"O\n" +
+	"\x16RejectMerchantResponse\x125\n" +
+	"\bmerchant\x18\x01 \x01(\v2\x19.rival.schema.v1.MerchantR\bmerchant\"S\n" +
+	"\x18ReinstateMerchantRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"R\n" +
+	"\x19ReinstateMerchantResponse\x125\n" +
+	"\bmerchant\x18\x01 \x01(\v2\x19.rival.schema.v1.MerchantR\bmerchant\"B\n" +
+	"\x1fGetMerchantStatusHistoryRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\"c\n" +
+	" GetMerchantStatusHistoryResponse\x12?\n" +
+	"\ahistory\x18\x01 \x03(\v2%.rival.schema.v1.MerchantStatusChangeR\ahistory\"?\n" +
+	"\x1cListMerchantDocumentsRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\"\x8d\x01\n" +
+	"\x1dListMerchantDocumentsResponse\x12?\n" +
+	"\tdocuments\x18\x01 \x03(\v2!.rival.schema.v1.MerchantDocumentR\tdocuments\x12+\n" +
+	"\x11missing_documents\x18\x02 \x03(\tR\x10missingDocuments\"@\n" +
+	"\x1dVerifyMerchantDocumentRequest\x12\x1f\n" +
+	"\vdocument_id\x18\x01 \x01(\x03R\n" +
+	"documentId\"_\n" +
+	"\x1eVerifyMerchantDocumentResponse\x12=\n" +
+	"\bdocument\x18\x01 \x01(\v2!.rival.schema.v1.MerchantDocumentR\bdocument\"X\n" +
+	"\x1dRejectMerchantDocumentRequest\x12\x1f\n" +
+	"\vdocument_id\x18\x01 \x01(\x03R\n" +
+	"documentId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"_\n" +
+	"\x1eRejectMerchantDocumentResponse\x12=\n" +
+	"\bdocument\x18\x01 \x01(\v2!.rival.schema.v1.MerchantDocumentR\bdocument\"R\n" +
 	"\x12GetAllUsersRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x12\n" +
@@ -1089,12 +2011,34 @@ const file_proto_api_admin_proto_rawDesc = "" +
 	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1a\n" +
 	"\bseverity\x18\x04 \x01(\tR\bseverity\x12\x12\n" +
 	"\x04type\x18\x05 \x01(\tR\x04type\x12\x1c\n" +
-	"\ttimestamp\x18\x06 \x01(\x03R\ttimestamp2\xf1\x06\n" +
+	"\ttimestamp\x18\x06 \x01(\x03R\ttimestamp\"E\n" +
+	"\x19ListFlaggedReviewsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"p\n" +
+	"\x1aListFlaggedReviewsResponse\x121\n" +
+	"\areviews\x18\x01 \x03(\v2\x17.rival.schema.v1.ReviewR\areviews\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\"d\n" +
+	"\x15ModerateReviewRequest\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\x03R\breviewId\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"I\n" +
+	"\x16ModerateReviewResponse\x12/\n" +
+	"\x06review\x18\x01 \x01(\v2\x17.rival.schema.v1.ReviewR\x06review2\xbd\x0e\n" +
 	"\fAdminService\x12n\n" +
 	"\x11GetDashboardStats\x12+.rival.api.v1.GetAdminDashboardStatsRequest\x1a,.rival.api.v1.GetAdminDashboardStatsResponse\x12^\n" +
 	"\x0fGetAllMerchants\x12$.rival.api.v1.GetAllMerchantsRequest\x1a%.rival.api.v1.GetAllMerchantsResponse\x12^\n" +
 	"\x0fApproveMerchant\x12$.rival.api.v1.ApproveMerchantRequest\x1a%.rival.api.v1.ApproveMerchantResponse\x12^\n" +
-	"\x0fSuspendMerchant\x12$.rival.api.v1.SuspendMerchantRequest\x1a%.rival.api.v1.SuspendMerchantResponse\x12R\n" +
+	"\x0fSuspendMerchant\x12$.rival.api.v1.SuspendMerchantRequest\x1a%.rival.api.v1.SuspendMerchantResponse\x12j\n" +
+	"\x13StartMerchantReview\x12(.rival.api.v1.StartMerchantReviewRequest\x1a).rival.api.v1.StartMerchantReviewResponse\x12[\n" +
+	"\x0eRejectMerchant\x12#.rival.api.v1.RejectMerchantRequest\x1a$.rival.api.v1.RejectMerchantResponse\x12d\n" +
+	"\x11ReinstateMerchant\x12&.rival.api.v1.ReinstateMerchantRequest\x1a'.rival.api.v1.ReinstateMerchantResponse\x12y\n" +
+	"\x18GetMerchantStatusHistory\x12-.rival.api.v1.GetMerchantStatusHistoryRequest\x1a..rival.api.v1.GetMerchantStatusHistoryResponse\x12p\n" +
+	"\x15ListMerchantDocuments\x12*.rival.api.v1.ListMerchantDocumentsRequest\x1a+.rival.api.v1.ListMerchantDocumentsResponse\x12s\n" +
+	"\x16VerifyMerchantDocument\x12+.rival.api.v1.VerifyMerchantDocumentRequest\x1a,.rival.api.v1.VerifyMerchantDocumentResponse\x12s\n" +
+	"\x16RejectMerchantDocument\x12+.rival.api.v1.RejectMerchantDocumentRequest\x1a,.rival.api.v1.RejectMerchantDocumentResponse\x12g\n" +
+	"\x12ListFlaggedReviews\x12'.rival.api.v1.ListFlaggedReviewsRequest\x1a(.rival.api.v1.ListFlaggedReviewsResponse\x12[\n" +
+	"\x0eModerateReview\x12#.rival.api.v1.ModerateReviewRequest\x1a$.rival.api.v1.ModerateReviewResponse\x12R\n" +
 	"\vGetAllUsers\x12 .rival.api.v1.GetAllUsersRequest\x1a!.rival.api.v1.GetAllUsersResponse\x12R\n" +
 	"\vSuspendUser\x12 .rival.api.v1.SuspendUserRequest\x1a!.rival.api.v1.SuspendUserResponse\x12g\n" +
 	"\x12GetAllTransactions\x12'.rival.api.v1.GetAllTransactionsRequest\x1a(.rival.api.v1.GetAllTransactionsResponse\x12U\n" +
@@ -1113,59 +2057,109 @@ func file_proto_api_admin_proto_rawDescGZIP() []byte {
 	return file_proto_api_admin_proto_rawDescData
 }
 
-var file_proto_api_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_proto_api_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
 var file_proto_api_admin_proto_goTypes = []any{
-	(*GetAdminDashboardStatsRequest)(nil),  // 0: rival.api.v1.GetAdminDashboardStatsRequest
-	(*GetAdminDashboardStatsResponse)(nil), // 1: rival.api.v1.GetAdminDashboardStatsResponse
-	(*GetAllMerchantsRequest)(nil),         // 2: rival.api.v1.GetAllMerchantsRequest
-	(*GetAllMerchantsResponse)(nil),        // 3: rival.api.v1.GetAllMerchantsResponse
-	(*ApproveMerchantRequest)(nil),         // 4: rival.api.v1.ApproveMerchantRequest
-	(*ApproveMerchantResponse)(nil),        // 5: rival.api.v1.ApproveMerchantResponse
-	(*SuspendMerchantRequest)(nil),         // 6: rival.api.v1.SuspendMerchantRequest
-	(*SuspendMerchantResponse)(nil),        // 7: rival.api.v1.SuspendMerchantResponse
-	(*GetAllUsersRequest)(nil),             // 8: rival.api.v1.GetAllUsersRequest
-	(*GetAllUsersResponse)(nil),            // 9: rival.api.v1.GetAllUsersResponse
-	(*SuspendUserRequest)(nil),             // 10: rival.api.v1.SuspendUserRequest
-	(*SuspendUserResponse)(nil),            // 11: rival.api.v1.SuspendUserResponse
-	(*GetAllTransactionsRequest)(nil),      // 12: rival.api.v1.GetAllTransactionsRequest
-	(*GetAllTransactionsResponse)(nil),     // 13: rival.api.v1.GetAllTransactionsResponse
-	(*GetAuditLogsRequest)(nil),            // 14: rival.api.v1.GetAuditLogsRequest
-	(*GetAuditLogsResponse)(nil),           // 15: rival.api.v1.GetAuditLogsResponse
-	(*StreamSystemAlertsRequest)(nil),      // 16: rival.api.v1.StreamSystemAlertsRequest
-	(*StreamSystemAlertsResponse)(nil),     // 17: rival.api.v1.StreamSystemAlertsResponse
-	(*schema.Merchant)(nil),                // 18: rival.schema.v1.Merchant
-	(*schema.User)(nil),                    // 19: rival.schema.v1.User
-	(*schema.Transaction)(nil),             // 20: rival.schema.v1.Transaction
-	(*schema.AuditLog)(nil),                // 21: rival.schema.v1.AuditLog
+	(*GetAdminDashboardStatsRequest)(nil),    // 0: rival.api.v1.GetAdminDashboardStatsRequest
+	(*GetAdminDashboardStatsResponse)(nil),   // 1: rival.api.v1.GetAdminDashboardStatsResponse
+	(*GetAllMerchantsRequest)(nil),           // 2: rival.api.v1.GetAllMerchantsRequest
+	(*GetAllMerchantsResponse)(nil),          // 3: rival.api.v1.GetAllMerchantsResponse
+	(*ApproveMerchantRequest)(nil),           // 4: rival.api.v1.ApproveMerchantRequest
+	(*ApproveMerchantResponse)(nil),          // 5: rival.api.v1.ApproveMerchantResponse
+	(*SuspendMerchantRequest)(nil),           // 6: rival.api.v1.SuspendMerchantRequest
+	(*SuspendMerchantResponse)(nil),          // 7: rival.api.v1.SuspendMerchantResponse
+	(*StartMerchantReviewRequest)(nil),       // 8: rival.api.v1.StartMerchantReviewRequest
+	(*StartMerchantReviewResponse)(nil),      // 9: rival.api.v1.StartMerchantReviewResponse
+	(*RejectMerchantRequest)(nil),            // 10: rival.api.v1.RejectMerchantRequest
+	(*RejectMerchantResponse)(nil),           // 11: rival.api.v1.RejectMerchantResponse
+	(*ReinstateMerchantRequest)(nil),         // 12: rival.api.v1.ReinstateMerchantRequest
+	(*ReinstateMerchantResponse)(nil),        // 13: rival.api.v1.ReinstateMerchantResponse
+	(*GetMerchantStatusHistoryRequest)(nil),  // 14: rival.api.v1.GetMerchantStatusHistoryRequest
+	(*GetMerchantStatusHistoryResponse)(nil), // 15: rival.api.v1.GetMerchantStatusHistoryResponse
+	(*ListMerchantDocumentsRequest)(nil),     // 16: rival.api.v1.ListMerchantDocumentsRequest
+	(*ListMerchantDocumentsResponse)(nil),    // 17: rival.api.v1.ListMerchantDocumentsResponse
+	(*VerifyMerchantDocumentRequest)(nil),    // 18: rival.api.v1.VerifyMerchantDocumentRequest
+	(*VerifyMerchantDocumentResponse)(nil),   // 19: rival.api.v1.VerifyMerchantDocumentResponse
+	(*RejectMerchantDocumentRequest)(nil),    // 20: rival.api.v1.RejectMerchantDocumentRequest
+	(*RejectMerchantDocumentResponse)(nil),   // 21: rival.api.v1.RejectMerchantDocumentResponse
+	(*GetAllUsersRequest)(nil),               // 22: rival.api.v1.GetAllUsersRequest
+	(*GetAllUsersResponse)(nil),              // 23: rival.api.v1.GetAllUsersResponse
+	(*SuspendUserRequest)(nil),               // 24: rival.api.v1.SuspendUserRequest
+	(*SuspendUserResponse)(nil),              // 25: rival.api.v1.SuspendUserResponse
+	(*GetAllTransactionsRequest)(nil),        // 26: rival.api.v1.GetAllTransactionsRequest
+	(*GetAllTransactionsResponse)(nil),       // 27: rival.api.v1.GetAllTransactionsResponse
+	(*GetAuditLogsRequest)(nil),              // 28: rival.api.v1.GetAuditLogsRequest
+	(*GetAuditLogsResponse)(nil),             // 29: rival.api.v1.GetAuditLogsResponse
+	(*StreamSystemAlertsRequest)(nil),        // 30: rival.api.v1.StreamSystemAlertsRequest
+	(*StreamSystemAlertsResponse)(nil),       // 31: rival.api.v1.StreamSystemAlertsResponse
+	(*ListFlaggedReviewsRequest)(nil),        // 32: rival.api.v1.ListFlaggedReviewsRequest
+	(*ListFlaggedReviewsResponse)(nil),       // 33: rival.api.v1.ListFlaggedReviewsResponse
+	(*ModerateReviewRequest)(nil),            // 34: rival.api.v1.ModerateReviewRequest
+	(*ModerateReviewResponse)(nil),           // 35: rival.api.v1.ModerateReviewResponse
+	(*schema.Merchant)(nil),                  // 36: rival.schema.v1.Merchant
+	(*schema.MerchantStatusChange)(nil),      // 37: rival.schema.v1.MerchantStatusChange
+	(*schema.MerchantDocument)(nil),          // 38: rival.schema.v1.MerchantDocument
+	(*schema.User)(nil),                      // 39: rival.schema.v1.User
+	(*schema.Transaction)(nil),               // 40: rival.schema.v1.Transaction
+	(*schema.AuditLog)(nil),                  // 41: rival.schema.v1.AuditLog
+	(*schema.Review)(nil),                    // 42: rival.schema.v1.Review
 }
 var file_proto_api_admin_proto_depIdxs = []int32{
-	18, // 0: rival.api.v1.GetAllMerchantsResponse.merchants:type_name -> rival.schema.v1.Merchant
-	19, // 1: rival.api.v1.GetAllUsersResponse.users:type_name -> rival.schema.v1.User
-	20, // 2: rival.api.v1.GetAllTransactionsResponse.transactions:type_name -> rival.schema.v1.Transaction
-	21, // 3: rival.api.v1.GetAuditLogsResponse.logs:type_name -> rival.schema.v1.AuditLog
-	0,  // 4: rival.api.v1.AdminService.GetDashboardStats:input_type -> rival.api.v1.GetAdminDashboardStatsRequest
-	2,  // 5: rival.api.v1.AdminService.GetAllMerchants:input_type -> rival.api.v1.GetAllMerchantsRequest
-	4,  // 6: rival.api.v1.AdminService.ApproveMerchant:input_type -> rival.api.v1.ApproveMerchantRequest
-	6,  // 7: rival.api.v1.AdminService.SuspendMerchant:input_type -> rival.api.v1.SuspendMerchantRequest
-	8,  // 8: rival.api.v1.AdminService.GetAllUsers:input_type -> rival.api.v1.GetAllUsersRequest
-	10, // 9: rival.api.v1.AdminService.SuspendUser:input_type -> rival.api.v1.SuspendUserRequest
-	12, // 10: rival.api.v1.AdminService.GetAllTransactions:input_type -> rival.api.v1.GetAllTransactionsRequest
-	14, // 11: rival.api.v1.AdminService.GetAuditLogs:input_type -> rival.api.v1.GetAuditLogsRequest
-	16, // 12: rival.api.v1.AdminService.StreamSystemAlerts:input_type -> rival.api.v1.StreamSystemAlertsRequest
-	1,  // 13: rival.api.v1.AdminService.GetDashboardStats:output_type -> rival.api.v1.GetAdminDashboardStatsResponse
-	3,  // 14: rival.api.v1.AdminService.GetAllMerchants:output_type -> rival.api.v1.GetAllMerchantsResponse
-	5,  // 15: rival.api.v1.AdminService.ApproveMerchant:output_type -> rival.api.v1.ApproveMerchantResponse
-	7,  // 16: rival.api.v1.AdminService.SuspendMerchant:output_type -> rival.api.v1.SuspendMerchantResponse
-	9,  // 17: rival.api.v1.AdminService.GetAllUsers:output_type -> rival.api.v1.GetAllUsersResponse
-	11, // 18: rival.api.v1.AdminService.SuspendUser:output_type -> rival.api.v1.SuspendUserResponse
-	13, // 19: rival.api.v1.AdminService.GetAllTransactions:output_type -> rival.api.v1.GetAllTransactionsResponse
-	15, // 20: rival.api.v1.AdminService.GetAuditLogs:output_type -> rival.api.v1.GetAuditLogsResponse
-	17, // 21: rival.api.v1.AdminService.StreamSystemAlerts:output_type -> rival.api.v1.StreamSystemAlertsResponse
-	13, // [13:22] is the sub-list for method output_type
-	4,  // [4:13] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	36, // 0: rival.api.v1.GetAllMerchantsResponse.merchants:type_name -> rival.schema.v1.Merchant
+	36, // 1: rival.api.v1.ApproveMerchantResponse.merchant:type_name -> rival.schema.v1.Merchant
+	36, // 2: rival.api.v1.SuspendMerchantResponse.merchant:type_name -> rival.schema.v1.Merchant
+	36, // 3: rival.api.v1.StartMerchantReviewResponse.merchant:type_name -> rival.schema.v1.Merchant
+	36, // 4: rival.api.v1.RejectMerchantResponse.merchant:type_name -> rival.schema.v1.Merchant
+	36, // 5: rival.api.v1.ReinstateMerchantResponse.merchant:type_name -> rival.schema.v1.Merchant
+	37, // 6: rival.api.v1.GetMerchantStatusHistoryResponse.history:type_name -> rival.schema.v1.MerchantStatusChange
+	38, // 7: rival.api.v1.ListMerchantDocumentsResponse.documents:type_name -> rival.schema.v1.MerchantDocument
+	38, // 8: rival.api.v1.VerifyMerchantDocumentResponse.document:type_name -> rival.schema.v1.MerchantDocument
+	38, // 9: rival.api.v1.RejectMerchantDocumentResponse.document:type_name -> rival.schema.v1.MerchantDocument
+	39, // 10: rival.api.v1.GetAllUsersResponse.users:type_name -> rival.schema.v1.User
+	40, // 11: rival.api.v1.GetAllTransactionsResponse.transactions:type_name -> rival.schema.v1.Transaction
+	41, // 12: rival.api.v1.GetAuditLogsResponse.logs:type_name -> rival.schema.v1.AuditLog
+	42, // 13: rival.api.v1.ListFlaggedReviewsResponse.reviews:type_name -> rival.schema.v1.Review
+	42, // 14: rival.api.v1.ModerateReviewResponse.review:type_name -> rival.schema.v1.Review
+	0,  // 15: rival.api.v1.AdminService.GetDashboardStats:input_type -> rival.api.v1.GetAdminDashboardStatsRequest
+	2,  // 16: rival.api.v1.AdminService.GetAllMerchants:input_type -> rival.api.v1.GetAllMerchantsRequest
+	4,  // 17: rival.api.v1.AdminService.ApproveMerchant:input_type -> rival.api.v1.ApproveMerchantRequest
+	6,  // 18: rival.api.v1.AdminService.SuspendMerchant:input_type -> rival.api.v1.SuspendMerchantRequest
+	8,  // 19: rival.api.v1.AdminService.StartMerchantReview:input_type -> rival.api.v1.StartMerchantReviewRequest
+	10, // 20: rival.api.v1.AdminService.RejectMerchant:input_type -> rival.api.v1.RejectMerchantRequest
+	12, // 21: rival.api.v1.AdminService.ReinstateMerchant:input_type -> rival.api.v1.ReinstateMerchantRequest
+	14, // 22: rival.api.v1.AdminService.GetMerchantStatusHistory:input_type -> rival.api.v1.GetMerchantStatusHistoryRequest
+	16, // 23: rival.api.v1.AdminService.ListMerchantDocuments:input_type -> rival.api.v1.ListMerchantDocumentsRequest
+	18, // 24: rival.api.v1.AdminService.VerifyMerchantDocument:input_type -> rival.api.v1.VerifyMerchantDocumentRequest
+	20, // 25: rival.api.v1.AdminService.RejectMerchantDocument:input_type -> rival.api.v1.RejectMerchantDocumentRequest
+	32, // 26: rival.api.v1.AdminService.ListFlaggedReviews:input_type -> rival.api.v1.ListFlaggedReviewsRequest
+	34, // 27: rival.api.v1.AdminService.ModerateReview:input_type -> rival.api.v1.ModerateReviewRequest
+	22, // 28: rival.api.v1.AdminService.GetAllUsers:input_type -> rival.api.v1.GetAllUsersRequest
+	24, // 29: rival.api.v1.AdminService.SuspendUser:input_type -> rival.api.v1.SuspendUserRequest
+	26, // 30: rival.api.v1.AdminService.GetAllTransactions:input_type -> rival.api.v1.GetAllTransactionsRequest
+	28, // 31: rival.api.v1.AdminService.GetAuditLogs:input_type -> rival.api.v1.GetAuditLogsRequest
+	30, // 32: rival.api.v1.AdminService.StreamSystemAlerts:input_type -> rival.api.v1.StreamSystemAlertsRequest
+	1,  // 33: rival.api.v1.AdminService.GetDashboardStats:output_type -> rival.api.v1.GetAdminDashboardStatsResponse
+	3,  // 34: rival.api.v1.AdminService.GetAllMerchants:output_type -> rival.api.v1.GetAllMerchantsResponse
+	5,  // 35: rival.api.v1.AdminService.ApproveMerchant:output_type -> rival.api.v1.ApproveMerchantResponse
+	7,  // 36: rival.api.v1.AdminService.SuspendMerchant:output_type -> rival.api.v1.SuspendMerchantResponse
+	9,  // 37: rival.api.v1.AdminService.StartMerchantReview:output_type -> rival.api.v1.StartMerchantReviewResponse
+	11, // 38: rival.api.v1.AdminService.RejectMerchant:output_type -> rival.api.v1.RejectMerchantResponse
+	13, // 39: rival.api.v1.AdminService.ReinstateMerchant:output_type -> rival.api.v1.ReinstateMerchantResponse
+	15, // 40: rival.api.v1.AdminService.GetMerchantStatusHistory:output_type -> rival.api.v1.GetMerchantStatusHistoryResponse
+	17, // 41: rival.api.v1.AdminService.ListMerchantDocuments:output_type -> rival.api.v1.ListMerchantDocumentsResponse
+	19, // 42: rival.api.v1.AdminService.VerifyMerchantDocument:output_type -> rival.api.v1.VerifyMerchantDocumentResponse
+	21, // 43: rival.api.v1.AdminService.RejectMerchantDocument:output_type -> rival.api.v1.RejectMerchantDocumentResponse
+	33, // 44: rival.api.v1.AdminService.ListFlaggedReviews:output_type -> rival.api.v1.ListFlaggedReviewsResponse
+	35, // 45: rival.api.v1.AdminService.ModerateReview:output_type -> rival.api.v1.ModerateReviewResponse
+	23, // 46: rival.api.v1.AdminService.GetAllUsers:output_type -> rival.api.v1.GetAllUsersResponse
+	25, // 47: rival.api.v1.AdminService.SuspendUser:output_type -> rival.api.v1.SuspendUserResponse
+	27, // 48: rival.api.v1.AdminService.GetAllTransactions:output_type -> rival.api.v1.GetAllTransactionsResponse
+	29, // 49: rival.api.v1.AdminService.GetAuditLogs:output_type -> rival.api.v1.GetAuditLogsResponse
+	31, // 50: rival.api.v1.AdminService.StreamSystemAlerts:output_type -> rival.api.v1.StreamSystemAlertsResponse
+	33, // [33:51] is the sub-list for method output_type
+	15, // [15:33] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_proto_api_admin_proto_init() }
@@ -1179,7 +2173,7 @@ func file_proto_api_admin_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_api_admin_proto_rawDesc), len(file_proto_api_admin_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   18,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
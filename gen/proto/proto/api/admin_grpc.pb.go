@@ -19,15 +19,24 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AdminService_GetDashboardStats_FullMethodName  = "/rival.api.v1.AdminService/GetDashboardStats"
-	AdminService_GetAllMerchants_FullMethodName    = "/rival.api.v1.AdminService/GetAllMerchants"
-	AdminService_ApproveMerchant_FullMethodName    = "/rival.api.v1.AdminService/ApproveMerchant"
-	AdminService_SuspendMerchant_FullMethodName    = "/rival.api.v1.AdminService/SuspendMerchant"
-	AdminService_GetAllUsers_FullMethodName        = "/rival.api.v1.AdminService/GetAllUsers"
-	AdminService_SuspendUser_FullMethodName        = "/rival.api.v1.AdminService/SuspendUser"
-	AdminService_GetAllTransactions_FullMethodName = "/rival.api.v1.AdminService/GetAllTransactions"
-	AdminService_GetAuditLogs_FullMethodName       = "/rival.api.v1.AdminService/GetAuditLogs"
-	AdminService_StreamSystemAlerts_FullMethodName = "/rival.api.v1.AdminService/StreamSystemAlerts"
+	AdminService_GetDashboardStats_FullMethodName        = "/rival.api.v1.AdminService/GetDashboardStats"
+	AdminService_GetAllMerchants_FullMethodName          = "/rival.api.v1.AdminService/GetAllMerchants"
+	AdminService_ApproveMerchant_FullMethodName          = "/rival.api.v1.AdminService/ApproveMerchant"
+	AdminService_SuspendMerchant_FullMethodName          = "/rival.api.v1.AdminService/SuspendMerchant"
+	AdminService_StartMerchantReview_FullMethodName      = "/rival.api.v1.AdminService/StartMerchantReview"
+	AdminService_RejectMerchant_FullMethodName           = "/rival.api.v1.AdminService/RejectMerchant"
+	AdminService_ReinstateMerchant_FullMethodName        = "/rival.api.v1.AdminService/ReinstateMerchant"
+	AdminService_GetMerchantStatusHistory_FullMethodName = "/rival.api.v1.AdminService/GetMerchantStatusHistory"
+	AdminService_ListMerchantDocuments_FullMethodName    = "/rival.api.v1.AdminService/ListMerchantDocuments"
+	AdminService_VerifyMerchantDocument_FullMethodName   = "/rival.api.v1.AdminService/VerifyMerchantDocument"
+	AdminService_RejectMerchantDocument_FullMethodName   = "/rival.api.v1.AdminService/RejectMerchantDocument"
+	AdminService_ListFlaggedReviews_FullMethodName       = "/rival.api.v1.AdminService/ListFlaggedReviews"
+	AdminService_ModerateReview_FullMethodName           = "/rival.api.v1.AdminService/ModerateReview"
+	AdminService_GetAllUsers_FullMethodName              = "/rival.api.v1.AdminService/GetAllUsers"
+	AdminService_SuspendUser_FullMethodName              = "/rival.api.v1.AdminService/SuspendUser"
+	AdminService_GetAllTransactions_FullMethodName       = "/rival.api.v1.AdminService/GetAllTransactions"
+	AdminService_GetAuditLogs_FullMethodName             = "/rival.api.v1.AdminService/GetAuditLogs"
+	AdminService_StreamSystemAlerts_FullMethodName       = "/rival.api.v1.AdminService/StreamSystemAlerts"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -38,6 +47,15 @@ type AdminServiceClient interface {
 	GetAllMerchants(ctx context.Context, in *GetAllMerchantsRequest, opts ...grpc.CallOption) (*GetAllMerchantsResponse, error)
 	ApproveMerchant(ctx context.Context, in *ApproveMerchantRequest, opts ...grpc.CallOption) (*ApproveMerchantResponse, error)
 	SuspendMerchant(ctx context.Context, in *SuspendMerchantRequest, opts ...grpc.CallOption) (*SuspendMerchantResponse, error)
+	StartMerchantReview(ctx context.Context, in *StartMerchantReviewRequest, opts ...grpc.CallOption) (*StartMerchantReviewResponse, error)
+	RejectMerchant(ctx context.Context, in *RejectMerchantRequest, opts ...grpc.CallOption) (*RejectMerchantResponse, error)
+	ReinstateMerchant(ctx context.Context, in *ReinstateMerchantRequest, opts ...grpc.CallOption) (*ReinstateMerchantResponse, error)
+	GetMerchantStatusHistory(ctx context.Context, in *GetMerchantStatusHistoryRequest, opts ...grpc.CallOption) (*GetMerchantStatusHistoryResponse, error)
+	ListMerchantDocuments(ctx context.Context, in *ListMerchantDocumentsRequest, opts ...grpc.CallOption) (*ListMerchantDocumentsResponse, error)
+	VerifyMerchantDocument(ctx context.Context, in *VerifyMerchantDocumentRequest, opts ...grpc.CallOption) (*VerifyMerchantDocumentResponse, error)
+	RejectMerchantDocument(ctx context.Context, in *RejectMerchantDocumentRequest, opts ...grpc.CallOption) (*RejectMerchantDocumentResponse, error)
+	ListFlaggedReviews(ctx context.Context, in *ListFlaggedReviewsRequest, opts ...grpc.CallOption) (*ListFlaggedReviewsResponse, error)
+	ModerateReview(ctx context.Context, in *ModerateReviewRequest, opts ...grpc.CallOption) (*ModerateReviewResponse, error)
 	GetAllUsers(ctx context.Context, in *GetAllUsersRequest, opts ...grpc.CallOption) (*GetAllUsersResponse, error)
 	SuspendUser(ctx context.Context, in *SuspendUserRequest, opts ...grpc.CallOption) (*SuspendUserResponse, error)
 	GetAllTransactions(ctx context.Context, in *GetAllTransactionsRequest, opts ...grpc.CallOption) (*GetAllTransactionsResponse, error)
@@ -93,6 +111,96 @@ func (c *adminServiceClient) SuspendMerchant(ctx context.Context, in *SuspendMer
 	return out, nil
 }
 
+func (c *adminServiceClient) StartMerchantReview(ctx context.Context, in *StartMerchantReviewRequest, opts ...grpc.CallOption) (*StartMerchantReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartMerchantReviewResponse)
+	err := c.cc.Invoke(ctx, AdminService_StartMerchantReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RejectMerchant(ctx context.Context, in *RejectMerchantRequest, opts ...grpc.CallOption) (*RejectMerchantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectMerchantResponse)
+	err := c.cc.Invoke(ctx, AdminService_RejectMerchant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ReinstateMerchant(ctx context.Context, in *ReinstateMerchantRequest, opts ...grpc.CallOption) (*ReinstateMerchantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReinstateMerchantResponse)
+	err := c.cc.Invoke(ctx, AdminService_ReinstateMerchant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetMerchantStatusHistory(ctx context.Context, in *GetMerchantStatusHistoryRequest, opts ...grpc.CallOption) (*GetMerchantStatusHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMerchantStatusHistoryResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetMerchantStatusHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListMerchantDocuments(ctx context.Context, in *ListMerchantDocumentsRequest, opts ...grpc.CallOption) (*ListMerchantDocumentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMerchantDocumentsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListMerchantDocuments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) VerifyMerchantDocument(ctx context.Context, in *VerifyMerchantDocumentRequest, opts ...grpc.CallOption) (*VerifyMerchantDocumentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyMerchantDocumentResponse)
+	err := c.cc.Invoke(ctx, AdminService_VerifyMerchantDocument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RejectMerchantDocument(ctx context.Context, in *RejectMerchantDocumentRequest, opts ...grpc.CallOption) (*RejectMerchantDocumentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectMerchantDocumentResponse)
+	err := c.cc.Invoke(ctx, AdminService_RejectMerchantDocument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListFlaggedReviews(ctx context.Context, in *ListFlaggedReviewsRequest, opts ...grpc.CallOption) (*ListFlaggedReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFlaggedReviewsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListFlaggedReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ModerateReview(ctx context.Context, in *ModerateReviewRequest, opts ...grpc.CallOption) (*ModerateReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModerateReviewResponse)
+	err := c.cc.Invoke(ctx, AdminService_ModerateReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) GetAllUsers(ctx context.Context, in *GetAllUsersRequest, opts ...grpc.CallOption) (*GetAllUsersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetAllUsersResponse)
@@ -160,6 +268,15 @@ type AdminServiceServer interface {
 	GetAllMerchants(context.Context, *GetAllMerchantsRequest) (*GetAllMerchantsResponse, error)
 	ApproveMerchant(context.Context, *ApproveMerchantRequest) (*ApproveMerchantResponse, error)
 	SuspendMerchant(context.Context, *SuspendMerchantRequest) (*SuspendMerchantResponse, error)
+	StartMerchantReview(context.Context, *StartMerchantReviewRequest) (*StartMerchantReviewResponse, error)
+	RejectMerchant(context.Context, *RejectMerchantRequest) (*RejectMerchantResponse, error)
+	ReinstateMerchant(context.Context, *ReinstateMerchantRequest) (*ReinstateMerchantResponse, error)
+	GetMerchantStatusHistory(context.Context, *GetMerchantStatusHistoryRequest) (*GetMerchantStatusHistoryResponse, error)
+	ListMerchantDocuments(context.Context, *ListMerchantDocumentsRequest) (*ListMerchantDocumentsResponse, error)
+	VerifyMerchantDocument(context.Context, *VerifyMerchantDocumentRequest) (*VerifyMerchantDocumentResponse, error)
+	RejectMerchantDocument(context.Context, *RejectMerchantDocumentRequest) (*RejectMerchantDocumentResponse, error)
+	ListFlaggedReviews(context.Context, *ListFlaggedReviewsRequest) (*ListFlaggedReviewsResponse, error)
+	ModerateReview(context.Context, *ModerateReviewRequest) (*ModerateReviewResponse, error)
 	GetAllUsers(context.Context, *GetAllUsersRequest) (*GetAllUsersResponse, error)
 	SuspendUser(context.Context, *SuspendUserRequest) (*SuspendUserResponse, error)
 	GetAllTransactions(context.Context, *GetAllTransactionsRequest) (*GetAllTransactionsResponse, error)
@@ -187,6 +304,33 @@ func (UnimplementedAdminServiceServer) ApproveMerchant(context.Context, *Approve
 func (UnimplementedAdminServiceServer) SuspendMerchant(context.Context, *SuspendMerchantRequest) (*SuspendMerchantResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SuspendMerchant not implemented")
 }
+func (UnimplementedAdminServiceServer) StartMerchantReview(context.Context, *StartMerchantReviewRequest) (*StartMerchantReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartMerchantReview not implemented")
+}
+func (UnimplementedAdminServiceServer) RejectMerchant(context.Context, *RejectMerchantRequest) (*RejectMerchantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectMerchant not implemented")
+}
+func (UnimplementedAdminServiceServer) ReinstateMerchant(context.Context, *ReinstateMerchantRequest) (*ReinstateMerchantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReinstateMerchant not implemented")
+}
+func (UnimplementedAdminServiceServer) GetMerchantStatusHistory(context.Context, *GetMerchantStatusHistoryRequest) (*GetMerchantStatusHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMerchantStatusHistory not implemented")
+}
+func (UnimplementedAdminServiceServer) ListMerchantDocuments(context.Context, *ListMerchantDocumentsRequest) (*ListMerchantDocumentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMerchantDocuments not implemented")
+}
+func (UnimplementedAdminServiceServer) VerifyMerchantDocument(context.Context, *VerifyMerchantDocumentRequest) (*VerifyMerchantDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyMerchantDocument not implemented")
+}
+func (UnimplementedAdminServiceServer) RejectMerchantDocument(context.Context, *RejectMerchantDocumentRequest) (*RejectMerchantDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectMerchantDocument not implemented")
+}
+func (UnimplementedAdminServiceServer) ListFlaggedReviews(context.Context, *ListFlaggedReviewsRequest) (*ListFlaggedReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFlaggedReviews not implemented")
+}
+func (UnimplementedAdminServiceServer) ModerateReview(context.Context, *ModerateReviewRequest) (*ModerateReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerateReview not implemented")
+}
 func (UnimplementedAdminServiceServer) GetAllUsers(context.Context, *GetAllUsersRequest) (*GetAllUsersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllUsers not implemented")
 }
@@ -295,6 +439,168 @@ func _AdminService_SuspendMerchant_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_StartMerchantReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartMerchantReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).StartMerchantReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_StartMerchantReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).StartMerchantReview(ctx, req.(*StartMerchantReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RejectMerchant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectMerchantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RejectMerchant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RejectMerchant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RejectMerchant(ctx, req.(*RejectMerchantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ReinstateMerchant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReinstateMerchantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReinstateMerchant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ReinstateMerchant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReinstateMerchant(ctx, req.(*ReinstateMerchantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetMerchantStatusHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMerchantStatusHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetMerchantStatusHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetMerchantStatusHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetMerchantStatusHistory(ctx, req.(*GetMerchantStatusHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListMerchantDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMerchantDocumentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListMerchantDocuments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListMerchantDocuments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListMerchantDocuments(ctx, req.(*ListMerchantDocumentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_VerifyMerchantDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyMerchantDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).VerifyMerchantDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_VerifyMerchantDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).VerifyMerchantDocument(ctx, req.(*VerifyMerchantDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RejectMerchantDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectMerchantDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RejectMerchantDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RejectMerchantDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RejectMerchantDocument(ctx, req.(*RejectMerchantDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListFlaggedReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFlaggedReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListFlaggedReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListFlaggedReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListFlaggedReviews(ctx, req.(*ListFlaggedReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ModerateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ModerateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ModerateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ModerateReview(ctx, req.(*ModerateReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_GetAllUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetAllUsersRequest)
 	if err := dec(in); err != nil {
@@ -401,6 +707,42 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SuspendMerchant",
 			Handler:    _AdminService_SuspendMerchant_Handler,
 		},
+		{
+			MethodName: "StartMerchantReview",
+			Handler:    _AdminService_StartMerchantReview_Handler,
+		},
+		{
+			MethodName: "RejectMerchant",
+			Handler:    _AdminService_RejectMerchant_Handler,
+		},
+		{
+			MethodName: "ReinstateMerchant",
+			Handler:    _AdminService_ReinstateMerchant_Handler,
+		},
+		{
+			MethodName: "GetMerchantStatusHistory",
+			Handler:    _AdminService_GetMerchantStatusHistory_Handler,
+		},
+		{
+			MethodName: "ListMerchantDocuments",
+			Handler:    _AdminService_ListMerchantDocuments_Handler,
+		},
+		{
+			MethodName: "VerifyMerchantDocument",
+			Handler:    _AdminService_VerifyMerchantDocument_Handler,
+		},
+		{
+			MethodName: "RejectMerchantDocument",
+			Handler:    _AdminService_RejectMerchantDocument_Handler,
+		},
+		{
+			MethodName: "ListFlaggedReviews",
+			Handler:    _AdminService_ListFlaggedReviews_Handler,
+		},
+		{
+			MethodName: "ModerateReview",
+			Handler:    _AdminService_ModerateReview_Handler,
+		},
 		{
 			MethodName: "GetAllUsers",
 			Handler:    _AdminService_GetAllUsers_Handler,
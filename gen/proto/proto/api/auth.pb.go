@@ -29,6 +29,7 @@ type SignupRequest struct {
 	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	Phone         string                 `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
 	Role          schema.UserRole        `protobuf:"varint,5,opt,name=role,proto3,enum=rival.schema.v1.UserRole" json:"role,omitempty"`
+	Locale        string                 `protobuf:"bytes,6,opt,name=locale,proto3" json:"locale,omitempty"` // language of emails: en (default) or hi
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -98,6 +99,13 @@ func (x *SignupRequest) GetRole() schema.UserRole {
 	return schema.UserRole(0)
 }
 
+func (x *SignupRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
 type SignupResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
@@ -697,6 +705,7 @@ func (x *ResetPasswordResponse) GetSuccess() bool {
 type FirebaseLoginRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FirebaseToken string                 `protobuf:"bytes,1,opt,name=firebase_token,json=firebaseToken,proto3" json:"firebase_token,omitempty"`
+	Provider      string                 `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"` // defaults to firebase
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -738,6 +747,13 @@ func (x *FirebaseLoginRequest) GetFirebaseToken() string {
 	return ""
 }
 
+func (x *FirebaseLoginRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
 type FirebaseLoginResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
@@ -806,6 +822,126 @@ func (x *FirebaseLoginResponse) GetExpiresIn() int64 {
 	return 0
 }
 
+type SocialLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"` // firebase, google, apple
+	IdToken       string                 `protobuf:"bytes,2,opt,name=id_token,json=idToken,proto3" json:"id_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SocialLoginRequest) Reset() {
+	*x = SocialLoginRequest{}
+	mi := &file_proto_api_auth_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SocialLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SocialLoginRequest) ProtoMessage() {}
+
+func (x *SocialLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_auth_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SocialLoginRequest.ProtoReflect.Descriptor instead.
+func (*SocialLoginRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_auth_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SocialLoginRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *SocialLoginRequest) GetIdToken() string {
+	if x != nil {
+		return x.IdToken
+	}
+	return ""
+}
+
+type SocialLoginResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	User          *schema.User           `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	ExpiresIn     int64                  `protobuf:"varint,4,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SocialLoginResponse) Reset() {
+	*x = SocialLoginResponse{}
+	mi := &file_proto_api_auth_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SocialLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SocialLoginResponse) ProtoMessage() {}
+
+func (x *SocialLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_auth_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SocialLoginResponse.ProtoReflect.Descriptor instead.
+func (*SocialLoginResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_auth_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SocialLoginResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *SocialLoginResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *SocialLoginResponse) GetUser() *schema.User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *SocialLoginResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
 type RefreshTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
@@ -815,7 +951,7 @@ type RefreshTokenRequest struct {
 
 func (x *RefreshTokenRequest) Reset() {
 	*x = RefreshTokenRequest{}
-	mi := &file_proto_api_auth_proto_msgTypes[14]
+	mi := &file_proto_api_auth_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -827,7 +963,7 @@ func (x *RefreshTokenRequest) String() string {
 func (*RefreshTokenRequest) ProtoMessage() {}
 
 func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_auth_proto_msgTypes[14]
+	mi := &file_proto_api_auth_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -840,7 +976,7 @@ func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
 func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_auth_proto_rawDescGZIP(), []int{14}
+	return file_proto_api_auth_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *RefreshTokenRequest) GetRefreshToken() string {
@@ -861,7 +997,7 @@ type RefreshTokenResponse struct {
 
 func (x *RefreshTokenResponse) Reset() {
 	*x = RefreshTokenResponse{}
-	mi := &file_proto_api_auth_proto_msgTypes[15]
+	mi := &file_proto_api_auth_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -873,7 +1009,7 @@ func (x *RefreshTokenResponse) String() string {
 func (*RefreshTokenResponse) ProtoMessage() {}
 
 func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_auth_proto_msgTypes[15]
+	mi := &file_proto_api_auth_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -886,7 +1022,7 @@ func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshTokenResponse.ProtoReflect.Descriptor instead.
 func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_auth_proto_rawDescGZIP(), []int{15}
+	return file_proto_api_auth_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *RefreshTokenResponse) GetAccessToken() string {
@@ -919,7 +1055,7 @@ type LogoutRequest struct {
 
 func (x *LogoutRequest) Reset() {
 	*x = LogoutRequest{}
-	mi := &file_proto_api_auth_proto_msgTypes[16]
+	mi := &file_proto_api_auth_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -931,7 +1067,7 @@ func (x *LogoutRequest) String() string {
 func (*LogoutRequest) ProtoMessage() {}
 
 func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_auth_proto_msgTypes[16]
+	mi := &file_proto_api_auth_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -944,7 +1080,7 @@ func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
 func (*LogoutRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_auth_proto_rawDescGZIP(), []int{16}
+	return file_proto_api_auth_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *LogoutRequest) GetToken() string {
@@ -963,7 +1099,7 @@ type LogoutResponse struct {
 
 func (x *LogoutResponse) Reset() {
 	*x = LogoutResponse{}
-	mi := &file_proto_api_auth_proto_msgTypes[17]
+	mi := &file_proto_api_auth_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -975,7 +1111,7 @@ func (x *LogoutResponse) String() string {
 func (*LogoutResponse) ProtoMessage() {}
 
 func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_auth_proto_msgTypes[17]
+	mi := &file_proto_api_auth_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -988,7 +1124,7 @@ func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
 func (*LogoutResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_auth_proto_rawDescGZIP(), []int{17}
+	return file_proto_api_auth_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *LogoutResponse) GetSuccess() bool {
@@ -1006,7 +1142,7 @@ type WhoAmIRequest struct {
 
 func (x *WhoAmIRequest) Reset() {
 	*x = WhoAmIRequest{}
-	mi := &file_proto_api_auth_proto_msgTypes[18]
+	mi := &file_proto_api_auth_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1018,7 +1154,7 @@ func (x *WhoAmIRequest) String() string {
 func (*WhoAmIRequest) ProtoMessage() {}
 
 func (x *WhoAmIRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_auth_proto_msgTypes[18]
+	mi := &file_proto_api_auth_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1031,7 +1167,7 @@ func (x *WhoAmIRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WhoAmIRequest.ProtoReflect.Descriptor instead.
 func (*WhoAmIRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_auth_proto_rawDescGZIP(), []int{18}
+	return file_proto_api_auth_proto_rawDescGZIP(), []int{20}
 }
 
 type WhoAmIResponse struct {
@@ -1043,7 +1179,7 @@ type WhoAmIResponse struct {
 
 func (x *WhoAmIResponse) Reset() {
 	*x = WhoAmIResponse{}
-	mi := &file_proto_api_auth_proto_msgTypes[19]
+	mi := &file_proto_api_auth_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1055,7 +1191,7 @@ func (x *WhoAmIResponse) String() string {
 func (*WhoAmIResponse) ProtoMessage() {}
 
 func (x *WhoAmIResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_auth_proto_msgTypes[19]
+	mi := &file_proto_api_auth_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1068,7 +1204,7 @@ func (x *WhoAmIResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WhoAmIResponse.ProtoReflect.Descriptor instead.
 func (*WhoAmIResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_auth_proto_rawDescGZIP(), []int{19}
+	return file_proto_api_auth_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *WhoAmIResponse) GetUser() *schema.User {
@@ -1078,88 +1214,1147 @@ func (x *WhoAmIResponse) GetUser() *schema.User {
 	return nil
 }
 
-var File_proto_api_auth_proto protoreflect.FileDescriptor
+type ListSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_proto_api_auth_proto_rawDesc = "" +
-	"\n" +
-	"\x14proto/api/auth.proto\x12\frival.api.v1\x1a\x19proto/schema/schema.proto\"\x9a\x01\n" +
-	"\rSignupRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
-	"\x05phone\x18\x04 \x01(\tR\x05phone\x12-\n" +
-	"\x04role\x18\x05 \x01(\x0e2\x19.rival.schema.v1.UserRoleR\x04role\"E\n" +
-	"\x0eSignupResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\x12\x19\n" +
-	"\botp_sent\x18\x02 \x01(\bR\aotpSent\":\n" +
-	"\x10VerifyOTPRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\x12\x10\n" +
-	"\x03otp\x18\x02 \x01(\tR\x03otp\"\xa5\x01\n" +
-	"\x11VerifyOTPResponse\x12!\n" +
-	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
-	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12)\n" +
-	"\x04user\x18\x03 \x01(\v2\x15.rival.schema.v1.UserR\x04user\x12\x1d\n" +
-	"\n" +
-	"expires_in\x18\x04 \x01(\x03R\texpiresIn\"(\n" +
-	"\x10ResendOTPRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\"H\n" +
-	"\x11ResendOTPResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\x12\x19\n" +
-	"\botp_sent\x18\x02 \x01(\bR\aotpSent\"@\n" +
-	"\fLoginRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"\xa1\x01\n" +
-	"\rLoginResponse\x12!\n" +
-	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
-	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12)\n" +
-	"\x04user\x18\x03 \x01(\v2\x15.rival.schema.v1.UserR\x04user\x12\x1d\n" +
-	"\n" +
-	"expires_in\x18\x04 \x01(\x03R\texpiresIn\"-\n" +
-	"\x15ForgotPasswordRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\"M\n" +
-	"\x16ForgotPasswordResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\x12\x19\n" +
-	"\botp_sent\x18\x02 \x01(\bR\aotpSent\"a\n" +
-	"\x14ResetPasswordRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\x12\x10\n" +
-	"\x03otp\x18\x02 \x01(\tR\x03otp\x12!\n" +
-	"\fnew_password\x18\x03 \x01(\tR\vnewPassword\"K\n" +
-	"\x15ResetPasswordResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
-	"\asuccess\x18\x02 \x01(\bR\asuccess\"=\n" +
-	"\x14FirebaseLoginRequest\x12%\n" +
-	"\x0efirebase_token\x18\x01 \x01(\tR\rfirebaseToken\"\xa9\x01\n" +
-	"\x15FirebaseLoginResponse\x12!\n" +
-	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
-	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12)\n" +
-	"\x04user\x18\x03 \x01(\v2\x15.rival.schema.v1.UserR\x04user\x12\x1d\n" +
-	"\n" +
-	"expires_in\x18\x04 \x01(\x03R\texpiresIn\":\n" +
-	"\x13RefreshTokenRequest\x12#\n" +
-	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"}\n" +
-	"\x14RefreshTokenResponse\x12!\n" +
-	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
-	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12\x1d\n" +
-	"\n" +
-	"expires_in\x18\x03 \x01(\x03R\texpiresIn\"%\n" +
-	"\rLogoutRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\"*\n" +
-	"\x0eLogoutResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x0f\n" +
-	"\rWhoAmIRequest\";\n" +
-	"\x0eWhoAmIResponse\x12)\n" +
-	"\x04user\x18\x01 \x01(\v2\x15.rival.schema.v1.UserR\x04user2\xa2\x06\n" +
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	mi := &file_proto_api_auth_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsRequest) ProtoMessage() {}
+
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_auth_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_auth_proto_rawDescGZIP(), []int{22}
+}
+
+type ListSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*schema.UserSession  `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	mi := &file_proto_api_auth_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_auth_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_auth_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListSessionsResponse) GetSessions() []*schema.UserSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type RevokeSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     int64                  `protobuf:"varint,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionRequest) Reset() {
+	*x = RevokeSessionRequest{}
+	mi := &file_proto_api_auth_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionRequest) ProtoMessage() {}
+
+func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_auth_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_auth_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RevokeSessionRequest) GetSessionId() int64 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+type RevokeSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionResponse) Reset() {
+	*x = RevokeSessionResponse{}
+	mi := &file_proto_api_auth_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionResponse) ProtoMessage() {}
+
+func (x *RevokeSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_auth_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionResponse.ProtoReflect.Descriptor instead.
+func (*RevokeSessionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_auth_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RevokeSessionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type UnlockAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` // from the account locked email
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockAccountRequest) Reset() {
+	*x = UnlockAccountRequest{}
+	mi := &file_proto_api_auth_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockAccountRequest) ProtoMessage() {}
+
+func (x *UnlockAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_auth_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockAccountRequest.ProtoReflect.Descriptor instead.
+func (*UnlockAccountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_auth_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UnlockAccountRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type UnlockAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockAccountResponse) Reset() {
+	*x = UnlockAccountResponse{}
+	mi := &file_proto_api_auth_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockAccountResponse) ProtoMessage() {}
+
+func (x *UnlockAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_auth_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockAccountResponse.ProtoReflect.Descriptor instead.
+func (*UnlockAccountResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_auth_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UnlockAccountResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UnlockAccountResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListIdentitiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIdentitiesRequest) Reset() {
+	*x = ListIdentitiesRequest{}
+	mi := &file_proto_api_auth_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIdentitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIdentitiesRequest) ProtoMessage() {}
+
+func (x *ListIdentitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_auth_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIdentitiesRequest.ProtoReflect.Descriptor instead.
+func (*ListIdentitiesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_auth_proto_rawDescGZIP(), []int{28}
+}
+
+type ListIdentitiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identities    []*schema.UserIdentity `protobuf:"bytes,1,rep,name=identities,proto3" json:"identities,omitempty"`
+	HasPassword   bool                   `protobuf:"varint,2,opt,name=has_password,json=hasPassword,proto3" json:"has_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIdentitiesResponse) Reset() {
+	*x = ListIdentitiesResponse{}
+	mi := &file_proto_api_auth_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIdentitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIdentitiesResponse) ProtoMessage() {}
+
+func (x *ListIdentitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_auth_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIdentitiesResponse.ProtoReflect.Descriptor instead.
+func (*ListIdentitiesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_auth_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListIdentitiesResponse) GetIdentities() []*schema.UserIdentity {
+	if x != nil {
+		return x.Identities
+	}
+	return nil
+}
+
+func (x *ListIdentitiesResponse) GetHasPassword() bool {
+	if x != nil {
+		return x.HasPassword
+	}
+	return false
+}
+
+type LinkIdentityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"` // firebase, google, apple
+	IdToken       string                 `protobuf:"bytes,2,opt,name=id_token,json=idToken,proto3" json:"id_token,omitempty"`
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"` // current password, required to re-authenticate
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkIdentityRequest) Reset() {
+	*x = LinkIdentityRequest{}
+	mi := &file_proto_api_auth_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkIdentityRequest) ProtoMessage() {}
+
+func (x *LinkIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_auth_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkIdentityRequest.ProtoReflect.Descriptor instead.
+func (*LinkIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_auth_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *LinkIdentityRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *LinkIdentityRequest) GetIdToken() string {
+	if x != nil {
+		return x.IdToken
+	}
+	return ""
+}
+
+func (x *LinkIdentityRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type LinkIdentityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identity      *schema.UserIdentity   `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkIdentityResponse) Reset() {
+	*x = LinkIdentityResponse{}
+	mi := &file_proto_api_auth_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkIdentityResponse) ProtoMessage() {}
+
+func (x *LinkIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_auth_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkIdentityResponse.ProtoReflect.Descriptor instead.
+func (*LinkIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_auth_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *LinkIdentityResponse) GetIdentity() *schema.UserIdentity {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
+type UnlinkIdentityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"` // current password, required to re-authenticate
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkIdentityRequest) Reset() {
+	*x = UnlinkIdentityRequest{}
+	mi := &file_proto_api_auth_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkIdentityRequest) ProtoMessage() {}
+
+func (x *UnlinkIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_auth_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkIdentityRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_auth_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UnlinkIdentityRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *UnlinkIdentityRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type UnlinkIdentityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkIdentityResponse) Reset() {
+	*x = UnlinkIdentityResponse{}
+	mi := &file_proto_api_auth_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkIdentityResponse) ProtoMessage() {}
+
+func (x *UnlinkIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_auth_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkIdentityResponse.ProtoReflect.Descriptor instead.
+func (*UnlinkIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_auth_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UnlinkIdentityResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Texts a code to the number to prove the signed in user owns it
+type SendPhoneVerificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Phone         string                 `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"` // as typed, numbers without a country code are taken as Indian
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendPhoneVerificationRequest) Reset() {
+	*x = SendPhoneVerificationRequest{}
+	mi := &file_proto_api_auth_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendPhoneVerificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendPhoneVerificationRequest) ProtoMessage() {}
+
+func (x *SendPhoneVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_auth_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendPhoneVerificationRequest.ProtoReflect.Descriptor instead.
+func (*SendPhoneVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_auth_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SendPhoneVerificationRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type SendPhoneVerificationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Phone         string                 `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"` // normalized to E.164
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ExpiresIn     int64                  `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"` // seconds the code is valid
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendPhoneVerificationResponse) Reset() {
+	*x = SendPhoneVerificationResponse{}
+	mi := &file_proto_api_auth_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendPhoneVerificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendPhoneVerificationResponse) ProtoMessage() {}
+
+func (x *SendPhoneVerificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_auth_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendPhoneVerificationResponse.ProtoReflect.Descriptor instead.
+func (*SendPhoneVerificationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_auth_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SendPhoneVerificationResponse) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *SendPhoneVerificationResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SendPhoneVerificationResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+type ConfirmPhoneVerificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Phone         string                 `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
+	Otp           string                 `protobuf:"bytes,2,opt,name=otp,proto3" json:"otp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmPhoneVerificationRequest) Reset() {
+	*x = ConfirmPhoneVerificationRequest{}
+	mi := &file_proto_api_auth_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmPhoneVerificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmPhoneVerificationRequest) ProtoMessage() {}
+
+func (x *ConfirmPhoneVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_auth_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmPhoneVerificationRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmPhoneVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_auth_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ConfirmPhoneVerificationRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *ConfirmPhoneVerificationRequest) GetOtp() string {
+	if x != nil {
+		return x.Otp
+	}
+	return ""
+}
+
+type ConfirmPhoneVerificationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *schema.User           `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmPhoneVerificationResponse) Reset() {
+	*x = ConfirmPhoneVerificationResponse{}
+	mi := &file_proto_api_auth_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmPhoneVerificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmPhoneVerificationResponse) ProtoMessage() {}
+
+func (x *ConfirmPhoneVerificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_auth_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmPhoneVerificationResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmPhoneVerificationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_auth_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ConfirmPhoneVerificationResponse) GetUser() *schema.User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// Texts a sign in code to a verified number. The response is the same
+// whether or not the number belongs to an account.
+type LoginWithPhoneRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Phone         string                 `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginWithPhoneRequest) Reset() {
+	*x = LoginWithPhoneRequest{}
+	mi := &file_proto_api_auth_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginWithPhoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginWithPhoneRequest) ProtoMessage() {}
+
+func (x *LoginWithPhoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_auth_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginWithPhoneRequest.ProtoReflect.Descriptor instead.
+func (*LoginWithPhoneRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_auth_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *LoginWithPhoneRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type LoginWithPhoneResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Phone         string                 `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ExpiresIn     int64                  `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginWithPhoneResponse) Reset() {
+	*x = LoginWithPhoneResponse{}
+	mi := &file_proto_api_auth_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginWithPhoneResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginWithPhoneResponse) ProtoMessage() {}
+
+func (x *LoginWithPhoneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_auth_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginWithPhoneResponse.ProtoReflect.Descriptor instead.
+func (*LoginWithPhoneResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_auth_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *LoginWithPhoneResponse) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *LoginWithPhoneResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *LoginWithPhoneResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+type VerifyPhoneOTPRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Phone         string                 `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
+	Otp           string                 `protobuf:"bytes,2,opt,name=otp,proto3" json:"otp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyPhoneOTPRequest) Reset() {
+	*x = VerifyPhoneOTPRequest{}
+	mi := &file_proto_api_auth_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyPhoneOTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyPhoneOTPRequest) ProtoMessage() {}
+
+func (x *VerifyPhoneOTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_auth_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyPhoneOTPRequest.ProtoReflect.Descriptor instead.
+func (*VerifyPhoneOTPRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_auth_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *VerifyPhoneOTPRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *VerifyPhoneOTPRequest) GetOtp() string {
+	if x != nil {
+		return x.Otp
+	}
+	return ""
+}
+
+type VerifyPhoneOTPResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	User          *schema.User           `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	ExpiresIn     int64                  `protobuf:"varint,4,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyPhoneOTPResponse) Reset() {
+	*x = VerifyPhoneOTPResponse{}
+	mi := &file_proto_api_auth_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyPhoneOTPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyPhoneOTPResponse) ProtoMessage() {}
+
+func (x *VerifyPhoneOTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_auth_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyPhoneOTPResponse.ProtoReflect.Descriptor instead.
+func (*VerifyPhoneOTPResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_auth_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *VerifyPhoneOTPResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *VerifyPhoneOTPResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *VerifyPhoneOTPResponse) GetUser() *schema.User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *VerifyPhoneOTPResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+var File_proto_api_auth_proto protoreflect.FileDescriptor
+
+const file_proto_api_auth_proto_rawDesc = "" +
+	"\n" +
+	"\x14proto/api/auth.proto\x12\frival.api.v1\x1a\x19proto/schema/schema.proto\"\xb2\x01\n" +
+	"\rSignupRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05phone\x18\x04 \x01(\tR\x05phone\x12-\n" +
+	"\x04role\x18\x05 \x01(\x0e2\x19.rival.schema.v1.UserRoleR\x04role\x12\x16\n" +
+	"\x06locale\x18\x06 \x01(\tR\x06locale\"E\n" +
+	"\x0eSignupResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x19\n" +
+	"\botp_sent\x18\x02 \x01(\bR\aotpSent\":\n" +
+	"\x10VerifyOTPRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x10\n" +
+	"\x03otp\x18\x02 \x01(\tR\x03otp\"\xa5\x01\n" +
+	"\x11VerifyOTPResponse\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12)\n" +
+	"\x04user\x18\x03 \x01(\v2\x15.rival.schema.v1.UserR\x04user\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x04 \x01(\x03R\texpiresIn\"(\n" +
+	"\x10ResendOTPRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"H\n" +
+	"\x11ResendOTPResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x19\n" +
+	"\botp_sent\x18\x02 \x01(\bR\aotpSent\"@\n" +
+	"\fLoginRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\xa1\x01\n" +
+	"\rLoginResponse\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12)\n" +
+	"\x04user\x18\x03 \x01(\v2\x15.rival.schema.v1.UserR\x04user\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x04 \x01(\x03R\texpiresIn\"-\n" +
+	"\x15ForgotPasswordRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"M\n" +
+	"\x16ForgotPasswordResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x19\n" +
+	"\botp_sent\x18\x02 \x01(\bR\aotpSent\"a\n" +
+	"\x14ResetPasswordRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x10\n" +
+	"\x03otp\x18\x02 \x01(\tR\x03otp\x12!\n" +
+	"\fnew_password\x18\x03 \x01(\tR\vnewPassword\"K\n" +
+	"\x15ResetPasswordResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"Y\n" +
+	"\x14FirebaseLoginRequest\x12%\n" +
+	"\x0efirebase_token\x18\x01 \x01(\tR\rfirebaseToken\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\"\xa9\x01\n" +
+	"\x15FirebaseLoginResponse\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12)\n" +
+	"\x04user\x18\x03 \x01(\v2\x15.rival.schema.v1.UserR\x04user\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x04 \x01(\x03R\texpiresIn\"K\n" +
+	"\x12SocialLoginRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x19\n" +
+	"\bid_token\x18\x02 \x01(\tR\aidToken\"\xa7\x01\n" +
+	"\x13SocialLoginResponse\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12)\n" +
+	"\x04user\x18\x03 \x01(\v2\x15.rival.schema.v1.UserR\x04user\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x04 \x01(\x03R\texpiresIn\":\n" +
+	"\x13RefreshTokenRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"}\n" +
+	"\x14RefreshTokenResponse\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x03 \x01(\x03R\texpiresIn\"%\n" +
+	"\rLogoutRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"*\n" +
+	"\x0eLogoutResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x0f\n" +
+	"\rWhoAmIRequest\";\n" +
+	"\x0eWhoAmIResponse\x12)\n" +
+	"\x04user\x18\x01 \x01(\v2\x15.rival.schema.v1.UserR\x04user\"\x15\n" +
+	"\x13ListSessionsRequest\"P\n" +
+	"\x14ListSessionsResponse\x128\n" +
+	"\bsessions\x18\x01 \x03(\v2\x1c.rival.schema.v1.UserSessionR\bsessions\"5\n" +
+	"\x14RevokeSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\x03R\tsessionId\"1\n" +
+	"\x15RevokeSessionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\",\n" +
+	"\x14UnlockAccountRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"K\n" +
+	"\x15UnlockAccountResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x17\n" +
+	"\x15ListIdentitiesRequest\"z\n" +
+	"\x16ListIdentitiesResponse\x12=\n" +
+	"\n" +
+	"identities\x18\x01 \x03(\v2\x1d.rival.schema.v1.UserIdentityR\n" +
+	"identities\x12!\n" +
+	"\fhas_password\x18\x02 \x01(\bR\vhasPassword\"h\n" +
+	"\x13LinkIdentityRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x19\n" +
+	"\bid_token\x18\x02 \x01(\tR\aidToken\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\"Q\n" +
+	"\x14LinkIdentityResponse\x129\n" +
+	"\bidentity\x18\x01 \x01(\v2\x1d.rival.schema.v1.UserIdentityR\bidentity\"O\n" +
+	"\x15UnlinkIdentityRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"2\n" +
+	"\x16UnlinkIdentityResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"4\n" +
+	"\x1cSendPhoneVerificationRequest\x12\x14\n" +
+	"\x05phone\x18\x01 \x01(\tR\x05phone\"n\n" +
+	"\x1dSendPhoneVerificationResponse\x12\x14\n" +
+	"\x05phone\x18\x01 \x01(\tR\x05phone\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x03 \x01(\x03R\texpiresIn\"I\n" +
+	"\x1fConfirmPhoneVerificationRequest\x12\x14\n" +
+	"\x05phone\x18\x01 \x01(\tR\x05phone\x12\x10\n" +
+	"\x03otp\x18\x02 \x01(\tR\x03otp\"M\n" +
+	" ConfirmPhoneVerificationResponse\x12)\n" +
+	"\x04user\x18\x01 \x01(\v2\x15.rival.schema.v1.UserR\x04user\"-\n" +
+	"\x15LoginWithPhoneRequest\x12\x14\n" +
+	"\x05phone\x18\x01 \x01(\tR\x05phone\"g\n" +
+	"\x16LoginWithPhoneResponse\x12\x14\n" +
+	"\x05phone\x18\x01 \x01(\tR\x05phone\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x03 \x01(\x03R\texpiresIn\"?\n" +
+	"\x15VerifyPhoneOTPRequest\x12\x14\n" +
+	"\x05phone\x18\x01 \x01(\tR\x05phone\x12\x10\n" +
+	"\x03otp\x18\x02 \x01(\tR\x03otp\"\xaa\x01\n" +
+	"\x16VerifyPhoneOTPResponse\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12)\n" +
+	"\x04user\x18\x03 \x01(\v2\x15.rival.schema.v1.UserR\x04user\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x04 \x01(\x03R\texpiresIn2\xb9\x0e\n" +
 	"\vAuthService\x12C\n" +
 	"\x06Signup\x12\x1b.rival.api.v1.SignupRequest\x1a\x1c.rival.api.v1.SignupResponse\x12L\n" +
 	"\tVerifyOTP\x12\x1e.rival.api.v1.VerifyOTPRequest\x1a\x1f.rival.api.v1.VerifyOTPResponse\x12L\n" +
 	"\tResendOTP\x12\x1e.rival.api.v1.ResendOTPRequest\x1a\x1f.rival.api.v1.ResendOTPResponse\x12@\n" +
 	"\x05Login\x12\x1a.rival.api.v1.LoginRequest\x1a\x1b.rival.api.v1.LoginResponse\x12X\n" +
-	"\rFirebaseLogin\x12\".rival.api.v1.FirebaseLoginRequest\x1a#.rival.api.v1.FirebaseLoginResponse\x12[\n" +
+	"\rFirebaseLogin\x12\".rival.api.v1.FirebaseLoginRequest\x1a#.rival.api.v1.FirebaseLoginResponse\x12R\n" +
+	"\vSocialLogin\x12 .rival.api.v1.SocialLoginRequest\x1a!.rival.api.v1.SocialLoginResponse\x12[\n" +
 	"\x0eForgotPassword\x12#.rival.api.v1.ForgotPasswordRequest\x1a$.rival.api.v1.ForgotPasswordResponse\x12X\n" +
 	"\rResetPassword\x12\".rival.api.v1.ResetPasswordRequest\x1a#.rival.api.v1.ResetPasswordResponse\x12U\n" +
 	"\fRefreshToken\x12!.rival.api.v1.RefreshTokenRequest\x1a\".rival.api.v1.RefreshTokenResponse\x12C\n" +
 	"\x06Logout\x12\x1b.rival.api.v1.LogoutRequest\x1a\x1c.rival.api.v1.LogoutResponse\x12C\n" +
-	"\x06WhoAmI\x12\x1b.rival.api.v1.WhoAmIRequest\x1a\x1c.rival.api.v1.WhoAmIResponseB\x1bZ\x19rival/gen/proto/proto/apib\x06proto3"
+	"\x06WhoAmI\x12\x1b.rival.api.v1.WhoAmIRequest\x1a\x1c.rival.api.v1.WhoAmIResponse\x12U\n" +
+	"\fListSessions\x12!.rival.api.v1.ListSessionsRequest\x1a\".rival.api.v1.ListSessionsResponse\x12X\n" +
+	"\rRevokeSession\x12\".rival.api.v1.RevokeSessionRequest\x1a#.rival.api.v1.RevokeSessionResponse\x12X\n" +
+	"\rUnlockAccount\x12\".rival.api.v1.UnlockAccountRequest\x1a#.rival.api.v1.UnlockAccountResponse\x12[\n" +
+	"\x0eListIdentities\x12#.rival.api.v1.ListIdentitiesRequest\x1a$.rival.api.v1.ListIdentitiesResponse\x12U\n" +
+	"\fLinkIdentity\x12!.rival.api.v1.LinkIdentityRequest\x1a\".rival.api.v1.LinkIdentityResponse\x12[\n" +
+	"\x0eUnlinkIdentity\x12#.rival.api.v1.UnlinkIdentityRequest\x1a$.rival.api.v1.UnlinkIdentityResponse\x12p\n" +
+	"\x15SendPhoneVerification\x12*.rival.api.v1.SendPhoneVerificationRequest\x1a+.rival.api.v1.SendPhoneVerificationResponse\x12y\n" +
+	"\x18ConfirmPhoneVerification\x12-.rival.api.v1.ConfirmPhoneVerificationRequest\x1a..rival.api.v1.ConfirmPhoneVerificationResponse\x12[\n" +
+	"\x0eLoginWithPhone\x12#.rival.api.v1.LoginWithPhoneRequest\x1a$.rival.api.v1.LoginWithPhoneResponse\x12[\n" +
+	"\x0eVerifyPhoneOTP\x12#.rival.api.v1.VerifyPhoneOTPRequest\x1a$.rival.api.v1.VerifyPhoneOTPResponseB\x1bZ\x19rival/gen/proto/proto/apib\x06proto3"
 
 var (
 	file_proto_api_auth_proto_rawDescOnce sync.Once
@@ -1173,62 +2368,114 @@ func file_proto_api_auth_proto_rawDescGZIP() []byte {
 	return file_proto_api_auth_proto_rawDescData
 }
 
-var file_proto_api_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_proto_api_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
 var file_proto_api_auth_proto_goTypes = []any{
-	(*SignupRequest)(nil),          // 0: rival.api.v1.SignupRequest
-	(*SignupResponse)(nil),         // 1: rival.api.v1.SignupResponse
-	(*VerifyOTPRequest)(nil),       // 2: rival.api.v1.VerifyOTPRequest
-	(*VerifyOTPResponse)(nil),      // 3: rival.api.v1.VerifyOTPResponse
-	(*ResendOTPRequest)(nil),       // 4: rival.api.v1.ResendOTPRequest
-	(*ResendOTPResponse)(nil),      // 5: rival.api.v1.ResendOTPResponse
-	(*LoginRequest)(nil),           // 6: rival.api.v1.LoginRequest
-	(*LoginResponse)(nil),          // 7: rival.api.v1.LoginResponse
-	(*ForgotPasswordRequest)(nil),  // 8: rival.api.v1.ForgotPasswordRequest
-	(*ForgotPasswordResponse)(nil), // 9: rival.api.v1.ForgotPasswordResponse
-	(*ResetPasswordRequest)(nil),   // 10: rival.api.v1.ResetPasswordRequest
-	(*ResetPasswordResponse)(nil),  // 11: rival.api.v1.ResetPasswordResponse
-	(*FirebaseLoginRequest)(nil),   // 12: rival.api.v1.FirebaseLoginRequest
-	(*FirebaseLoginResponse)(nil),  // 13: rival.api.v1.FirebaseLoginResponse
-	(*RefreshTokenRequest)(nil),    // 14: rival.api.v1.RefreshTokenRequest
-	(*RefreshTokenResponse)(nil),   // 15: rival.api.v1.RefreshTokenResponse
-	(*LogoutRequest)(nil),          // 16: rival.api.v1.LogoutRequest
-	(*LogoutResponse)(nil),         // 17: rival.api.v1.LogoutResponse
-	(*WhoAmIRequest)(nil),          // 18: rival.api.v1.WhoAmIRequest
-	(*WhoAmIResponse)(nil),         // 19: rival.api.v1.WhoAmIResponse
-	(schema.UserRole)(0),           // 20: rival.schema.v1.UserRole
-	(*schema.User)(nil),            // 21: rival.schema.v1.User
+	(*SignupRequest)(nil),                    // 0: rival.api.v1.SignupRequest
+	(*SignupResponse)(nil),                   // 1: rival.api.v1.SignupResponse
+	(*VerifyOTPRequest)(nil),                 // 2: rival.api.v1.VerifyOTPRequest
+	(*VerifyOTPResponse)(nil),                // 3: rival.api.v1.VerifyOTPResponse
+	(*ResendOTPRequest)(nil),                 // 4: rival.api.v1.ResendOTPRequest
+	(*ResendOTPResponse)(nil),                // 5: rival.api.v1.ResendOTPResponse
+	(*LoginRequest)(nil),                     // 6: rival.api.v1.LoginRequest
+	(*LoginResponse)(nil),                    // 7: rival.api.v1.LoginResponse
+	(*ForgotPasswordRequest)(nil),            // 8: rival.api.v1.ForgotPasswordRequest
+	(*ForgotPasswordResponse)(nil),           // 9: rival.api.v1.ForgotPasswordResponse
+	(*ResetPasswordRequest)(nil),             // 10: rival.api.v1.ResetPasswordRequest
+	(*ResetPasswordResponse)(nil),            // 11: rival.api.v1.ResetPasswordResponse
+	(*FirebaseLoginRequest)(nil),             // 12: rival.api.v1.FirebaseLoginRequest
+	(*FirebaseLoginResponse)(nil),            // 13: rival.api.v1.FirebaseLoginResponse
+	(*SocialLoginRequest)(nil),               // 14: rival.api.v1.SocialLoginRequest
+	(*SocialLoginResponse)(nil),              // 15: rival.api.v1.SocialLoginResponse
+	(*RefreshTokenRequest)(nil),              // 16: rival.api.v1.RefreshTokenRequest
+	(*RefreshTokenResponse)(nil),             // 17: rival.api.v1.RefreshTokenResponse
+	(*LogoutRequest)(nil),                    // 18: rival.api.v1.LogoutRequest
+	(*LogoutResponse)(nil),                   // 19: rival.api.v1.LogoutResponse
+	(*WhoAmIRequest)(nil),                    // 20: rival.api.v1.WhoAmIRequest
+	(*WhoAmIResponse)(nil),                   // 21: rival.api.v1.WhoAmIResponse
+	(*ListSessionsRequest)(nil),              // 22: rival.api.v1.ListSessionsRequest
+	(*ListSessionsResponse)(nil),             // 23: rival.api.v1.ListSessionsResponse
+	(*RevokeSessionRequest)(nil),             // 24: rival.api.v1.RevokeSessionRequest
+	(*RevokeSessionResponse)(nil),            // 25: rival.api.v1.RevokeSessionResponse
+	(*UnlockAccountRequest)(nil),             // 26: rival.api.v1.UnlockAccountRequest
+	(*UnlockAccountResponse)(nil),            // 27: rival.api.v1.UnlockAccountResponse
+	(*ListIdentitiesRequest)(nil),            // 28: rival.api.v1.ListIdentitiesRequest
+	(*ListIdentitiesResponse)(nil),           // 29: rival.api.v1.ListIdentitiesResponse
+	(*LinkIdentityRequest)(nil),              // 30: rival.api.v1.LinkIdentityRequest
+	(*LinkIdentityResponse)(nil),             // 31: rival.api.v1.LinkIdentityResponse
+	(*UnlinkIdentityRequest)(nil),            // 32: rival.api.v1.UnlinkIdentityRequest
+	(*UnlinkIdentityResponse)(nil),           // 33: rival.api.v1.UnlinkIdentityResponse
+	(*SendPhoneVerificationRequest)(nil),     // 34: rival.api.v1.SendPhoneVerificationRequest
+	(*SendPhoneVerificationResponse)(nil),    // 35: rival.api.v1.SendPhoneVerificationResponse
+	(*ConfirmPhoneVerificationRequest)(nil),  // 36: rival.api.v1.ConfirmPhoneVerificationRequest
+	(*ConfirmPhoneVerificationResponse)(nil), // 37: rival.api.v1.ConfirmPhoneVerificationResponse
+	(*LoginWithPhoneRequest)(nil),            // 38: rival.api.v1.LoginWithPhoneRequest
+	(*LoginWithPhoneResponse)(nil),           // 39: rival.api.v1.LoginWithPhoneResponse
+	(*VerifyPhoneOTPRequest)(nil),            // 40: rival.api.v1.VerifyPhoneOTPRequest
+	(*VerifyPhoneOTPResponse)(nil),           // 41: rival.api.v1.VerifyPhoneOTPResponse
+	(schema.UserRole)(0),                     // 42: rival.schema.v1.UserRole
+	(*schema.User)(nil),                      // 43: rival.schema.v1.User
+	(*schema.UserSession)(nil),               // 44: rival.schema.v1.UserSession
+	(*schema.UserIdentity)(nil),              // 45: rival.schema.v1.UserIdentity
 }
 var file_proto_api_auth_proto_depIdxs = []int32{
-	20, // 0: rival.api.v1.SignupRequest.role:type_name -> rival.schema.v1.UserRole
-	21, // 1: rival.api.v1.VerifyOTPResponse.user:type_name -> rival.schema.v1.User
-	21, // 2: rival.api.v1.LoginResponse.user:type_name -> rival.schema.v1.User
-	21, // 3: rival.api.v1.FirebaseLoginResponse.user:type_name -> rival.schema.v1.User
-	21, // 4: rival.api.v1.WhoAmIResponse.user:type_name -> rival.schema.v1.User
-	0,  // 5: rival.api.v1.AuthService.Signup:input_type -> rival.api.v1.SignupRequest
-	2,  // 6: rival.api.v1.AuthService.VerifyOTP:input_type -> rival.api.v1.VerifyOTPRequest
-	4,  // 7: rival.api.v1.AuthService.ResendOTP:input_type -> rival.api.v1.ResendOTPRequest
-	6,  // 8: rival.api.v1.AuthService.Login:input_type -> rival.api.v1.LoginRequest
-	12, // 9: rival.api.v1.AuthService.FirebaseLogin:input_type -> rival.api.v1.FirebaseLoginRequest
-	8,  // 10: rival.api.v1.AuthService.ForgotPassword:input_type -> rival.api.v1.ForgotPasswordRequest
-	10, // 11: rival.api.v1.AuthService.ResetPassword:input_type -> rival.api.v1.ResetPasswordRequest
-	14, // 12: rival.api.v1.AuthService.RefreshToken:input_type -> rival.api.v1.RefreshTokenRequest
-	16, // 13: rival.api.v1.AuthService.Logout:input_type -> rival.api.v1.LogoutRequest
-	18, // 14: rival.api.v1.AuthService.WhoAmI:input_type -> rival.api.v1.WhoAmIRequest
-	1,  // 15: rival.api.v1.AuthService.Signup:output_type -> rival.api.v1.SignupResponse
-	3,  // 16: rival.api.v1.AuthService.VerifyOTP:output_type -> rival.api.v1.VerifyOTPResponse
-	5,  // 17: rival.api.v1.AuthService.ResendOTP:output_type -> rival.api.v1.ResendOTPResponse
-	7,  // 18: rival.api.v1.AuthService.Login:output_type -> rival.api.v1.LoginResponse
-	13, // 19: rival.api.v1.AuthService.FirebaseLogin:output_type -> rival.api.v1.FirebaseLoginResponse
-	9,  // 20: rival.api.v1.AuthService.ForgotPassword:output_type -> rival.api.v1.ForgotPasswordResponse
-	11, // 21: rival.api.v1.AuthService.ResetPassword:output_type -> rival.api.v1.ResetPasswordResponse
-	15, // 22: rival.api.v1.AuthService.RefreshToken:output_type -> rival.api.v1.RefreshTokenResponse
-	17, // 23: rival.api.v1.AuthService.Logout:output_type -> rival.api.v1.LogoutResponse
-	19, // 24: rival.api.v1.AuthService.WhoAmI:output_type -> rival.api.v1.WhoAmIResponse
-	15, // [15:25] is the sub-list for method output_type
-	5,  // [5:15] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	42, // 0: rival.api.v1.SignupRequest.role:type_name -> rival.schema.v1.UserRole
+	43, // 1: rival.api.v1.VerifyOTPResponse.user:type_name -> rival.schema.v1.User
+	43, // 2: rival.api.v1.LoginResponse.user:type_name -> rival.schema.v1.User
+	43, // 3: rival.api.v1.FirebaseLoginResponse.user:type_name -> rival.schema.v1.User
+	43, // 4: rival.api.v1.SocialLoginResponse.user:type_name -> rival.schema.v1.User
+	43, // 5: rival.api.v1.WhoAmIResponse.user:type_name -> rival.schema.v1.User
+	44, // 6: rival.api.v1.ListSessionsResponse.sessions:type_name -> rival.schema.v1.UserSession
+	45, // 7: rival.api.v1.ListIdentitiesResponse.identities:type_name -> rival.schema.v1.UserIdentity
+	45, // 8: rival.api.v1.LinkIdentityResponse.identity:type_name -> rival.schema.v1.UserIdentity
+	43, // 9: rival.api.v1.ConfirmPhoneVerificationResponse.user:type_name -> rival.schema.v1.User
+	43, // 10: rival.api.v1.VerifyPhoneOTPResponse.user:type_name -> rival.schema.v1.User
+	0,  // 11: rival.api.v1.AuthService.Signup:input_type -> rival.api.v1.SignupRequest
+	2,  // 12: rival.api.v1.AuthService.VerifyOTP:input_type -> rival.api.v1.VerifyOTPRequest
+	4,  // 13: rival.api.v1.AuthService.ResendOTP:input_type -> rival.api.v1.ResendOTPRequest
+	6,  // 14: rival.api.v1.AuthService.Login:input_type -> rival.api.v1.LoginRequest
+	12, // 15: rival.api.v1.AuthService.FirebaseLogin:input_type -> rival.api.v1.FirebaseLoginRequest
+	14, // 16: rival.api.v1.AuthService.SocialLogin:input_type -> rival.api.v1.SocialLoginRequest
+	8,  // 17: rival.api.v1.AuthService.ForgotPassword:input_type -> rival.api.v1.ForgotPasswordRequest
+	10, // 18: rival.api.v1.AuthService.ResetPassword:input_type -> rival.api.v1.ResetPasswordRequest
+	16, // 19: rival.api.v1.AuthService.RefreshToken:input_type -> rival.api.v1.RefreshTokenRequest
+	18, // 20: rival.api.v1.AuthService.Logout:input_type -> rival.api.v1.LogoutRequest
+	20, // 21: rival.api.v1.AuthService.WhoAmI:input_type -> rival.api.v1.WhoAmIRequest
+	22, // 22: rival.api.v1.AuthService.ListSessions:input_type -> rival.api.v1.ListSessionsRequest
+	24, // 23: rival.api.v1.AuthService.RevokeSession:input_type -> rival.api.v1.RevokeSessionRequest
+	26, // 24: rival.api.v1.AuthService.UnlockAccount:input_type -> rival.api.v1.UnlockAccountRequest
+	28, // 25: rival.api.v1.AuthService.ListIdentities:input_type -> rival.api.v1.ListIdentitiesRequest
+	30, // 26: rival.api.v1.AuthService.LinkIdentity:input_type -> rival.api.v1.LinkIdentityRequest
+	32, // 27: rival.api.v1.AuthService.UnlinkIdentity:input_type -> rival.api.v1.UnlinkIdentityRequest
+	34, // 28: rival.api.v1.AuthService.SendPhoneVerification:input_type -> rival.api.v1.SendPhoneVerificationRequest
+	36, // 29: rival.api.v1.AuthService.ConfirmPhoneVerification:input_type -> rival.api.v1.ConfirmPhoneVerificationRequest
+	38, // 30: rival.api.v1.AuthService.LoginWithPhone:input_type -> rival.api.v1.LoginWithPhoneRequest
+	40, // 31: rival.api.v1.AuthService.VerifyPhoneOTP:input_type -> rival.api.v1.VerifyPhoneOTPRequest
+	1,  // 32: rival.api.v1.AuthService.Signup:output_type -> rival.api.v1.SignupResponse
+	3,  // 33: rival.api.v1.AuthService.VerifyOTP:output_type -> rival.api.v1.VerifyOTPResponse
+	5,  // 34: rival.api.v1.AuthService.ResendOTP:output_type -> rival.api.v1.ResendOTPResponse
+	7,  // 35: rival.api.v1.AuthService.Login:output_type -> rival.api.v1.LoginResponse
+	13, // 36: rival.api.v1.AuthService.FirebaseLogin:output_type -> rival.api.v1.FirebaseLoginResponse
+	15, // 37: rival.api.v1.AuthService.SocialLogin:output_type -> rival.api.v1.SocialLoginResponse
+	9,  // 38: rival.api.v1.AuthService.ForgotPassword:output_type -> rival.api.v1.ForgotPasswordResponse
+	11, // 39: rival.api.v1.AuthService.ResetPassword:output_type -> rival.api.v1.ResetPasswordResponse
+	17, // 40: rival.api.v1.AuthService.RefreshToken:output_type -> rival.api.v1.RefreshTokenResponse
+	19, // 41: rival.api.v1.AuthService.Logout:output_type -> rival.api.v1.LogoutResponse
+	21, // 42: rival.api.v1.AuthService.WhoAmI:output_type -> rival.api.v1.WhoAmIResponse
+	23, // 43: rival.api.v1.AuthService.ListSessions:output_type -> rival.api.v1.ListSessionsResponse
+	25, // 44: rival.api.v1.AuthService.RevokeSession:output_type -> rival.api.v1.RevokeSessionResponse
+	27, // 45: rival.api.v1.AuthService.UnlockAccount:output_type -> rival.api.v1.UnlockAccountResponse
+	29, // 46: rival.api.v1.AuthService.ListIdentities:output_type -> rival.api.v1.ListIdentitiesResponse
+	31, // 47: rival.api.v1.AuthService.LinkIdentity:output_type -> rival.api.v1.LinkIdentityResponse
+	33, // 48: rival.api.v1.AuthService.UnlinkIdentity:output_type -> rival.api.v1.UnlinkIdentityResponse
+	35, // 49: rival.api.v1.AuthService.SendPhoneVerification:output_type -> rival.api.v1.SendPhoneVerificationResponse
+	37, // 50: rival.api.v1.AuthService.ConfirmPhoneVerification:output_type -> rival.api.v1.ConfirmPhoneVerificationResponse
+	39, // 51: rival.api.v1.AuthService.LoginWithPhone:output_type -> rival.api.v1.LoginWithPhoneResponse
+	41, // 52: rival.api.v1.AuthService.VerifyPhoneOTP:output_type -> rival.api.v1.VerifyPhoneOTPResponse
+	32, // [32:53] is the sub-list for method output_type
+	11, // [11:32] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_proto_api_auth_proto_init() }
@@ -1242,7 +2489,7 @@ func file_proto_api_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_api_auth_proto_rawDesc), len(file_proto_api_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   42,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -328,6 +328,8 @@ type UpdateMerchantAddressRequest struct {
 	Country       string                 `protobuf:"bytes,6,opt,name=country,proto3" json:"country,omitempty"`
 	Latitude      float64                `protobuf:"fixed64,7,opt,name=latitude,proto3" json:"latitude,omitempty"`
 	Longitude     float64                `protobuf:"fixed64,8,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	AddressId     int64                  `protobuf:"varint,9,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"` // 0 updates the primary address, creating it if missing
+	Label         string                 `protobuf:"bytes,10,opt,name=label,proto3" json:"label,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -418,6 +420,20 @@ func (x *UpdateMerchantAddressRequest) GetLongitude() float64 {
 	return 0
 }
 
+func (x *UpdateMerchantAddressRequest) GetAddressId() int64 {
+	if x != nil {
+		return x.AddressId
+	}
+	return 0
+}
+
+func (x *UpdateMerchantAddressRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
 type UpdateMerchantAddressResponse struct {
 	state         protoimpl.MessageState  `protogen:"open.v1"`
 	Address       *schema.MerchantAddress `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
@@ -462,30 +478,36 @@ func (x *UpdateMerchantAddressResponse) GetAddress() *schema.MerchantAddress {
 	return nil
 }
 
-type GetOrdersRequest struct {
+type AddMerchantAddressRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Street        string                 `protobuf:"bytes,2,opt,name=street,proto3" json:"street,omitempty"`
+	City          string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	State         string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	PostalCode    string                 `protobuf:"bytes,5,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	Country       string                 `protobuf:"bytes,6,opt,name=country,proto3" json:"country,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,7,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,8,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Label         string                 `protobuf:"bytes,9,opt,name=label,proto3" json:"label,omitempty"`
+	IsPrimary     bool                   `protobuf:"varint,10,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrdersRequest) Reset() {
-	*x = GetOrdersRequest{}
+func (x *AddMerchantAddressRequest) Reset() {
+	*x = AddMerchantAddressRequest{}
 	mi := &file_proto_api_merchants_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrdersRequest) String() string {
+func (x *AddMerchantAddressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrdersRequest) ProtoMessage() {}
+func (*AddMerchantAddressRequest) ProtoMessage() {}
 
-func (x *GetOrdersRequest) ProtoReflect() protoreflect.Message {
+func (x *AddMerchantAddressRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_api_merchants_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -497,61 +519,102 @@ func (x *GetOrdersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrdersRequest.ProtoReflect.Descriptor instead.
-func (*GetOrdersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddMerchantAddressRequest.ProtoReflect.Descriptor instead.
+func (*AddMerchantAddressRequest) Descriptor() ([]byte, []int) {
 	return file_proto_api_merchants_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetOrdersRequest) GetMerchantId() int64 {
+func (x *AddMerchantAddressRequest) GetMerchantId() int64 {
 	if x != nil {
 		return x.MerchantId
 	}
 	return 0
 }
 
-func (x *GetOrdersRequest) GetPage() int32 {
+func (x *AddMerchantAddressRequest) GetStreet() string {
 	if x != nil {
-		return x.Page
+		return x.Street
+	}
+	return ""
+}
+
+func (x *AddMerchantAddressRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *AddMerchantAddressRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *AddMerchantAddressRequest) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *AddMerchantAddressRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *AddMerchantAddressRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
 	}
 	return 0
 }
 
-func (x *GetOrdersRequest) GetLimit() int32 {
+func (x *AddMerchantAddressRequest) GetLongitude() float64 {
 	if x != nil {
-		return x.Limit
+		return x.Longitude
 	}
 	return 0
 }
 
-func (x *GetOrdersRequest) GetStatus() string {
+func (x *AddMerchantAddressRequest) GetLabel() string {
 	if x != nil {
-		return x.Status
+		return x.Label
 	}
 	return ""
 }
 
-type GetOrdersResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Orders        []*schema.Order        `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+func (x *AddMerchantAddressRequest) GetIsPrimary() bool {
+	if x != nil {
+		return x.IsPrimary
+	}
+	return false
+}
+
+type AddMerchantAddressResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Address       *schema.MerchantAddress `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrdersResponse) Reset() {
-	*x = GetOrdersResponse{}
+func (x *AddMerchantAddressResponse) Reset() {
+	*x = AddMerchantAddressResponse{}
 	mi := &file_proto_api_merchants_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrdersResponse) String() string {
+func (x *AddMerchantAddressResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrdersResponse) ProtoMessage() {}
+func (*AddMerchantAddressResponse) ProtoMessage() {}
 
-func (x *GetOrdersResponse) ProtoReflect() protoreflect.Message {
+func (x *AddMerchantAddressResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_api_merchants_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -563,48 +626,40 @@ func (x *GetOrdersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrdersResponse.ProtoReflect.Descriptor instead.
-func (*GetOrdersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddMerchantAddressResponse.ProtoReflect.Descriptor instead.
+func (*AddMerchantAddressResponse) Descriptor() ([]byte, []int) {
 	return file_proto_api_merchants_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetOrdersResponse) GetOrders() []*schema.Order {
+func (x *AddMerchantAddressResponse) GetAddress() *schema.MerchantAddress {
 	if x != nil {
-		return x.Orders
+		return x.Address
 	}
 	return nil
 }
 
-func (x *GetOrdersResponse) GetTotalCount() int32 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
-}
-
-type UpdateOrderStatusRequest struct {
+type DeleteMerchantAddressRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	Notes         string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	AddressId     int64                  `protobuf:"varint,2,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateOrderStatusRequest) Reset() {
-	*x = UpdateOrderStatusRequest{}
+func (x *DeleteMerchantAddressRequest) Reset() {
+	*x = DeleteMerchantAddressRequest{}
 	mi := &file_proto_api_merchants_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateOrderStatusRequest) String() string {
+func (x *DeleteMerchantAddressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOrderStatusRequest) ProtoMessage() {}
+func (*DeleteMerchantAddressRequest) ProtoMessage() {}
 
-func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteMerchantAddressRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_api_merchants_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -616,53 +671,46 @@ func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOrderStatusRequest.ProtoReflect.Descriptor instead.
-func (*UpdateOrderStatusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteMerchantAddressRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMerchantAddressRequest) Descriptor() ([]byte, []int) {
 	return file_proto_api_merchants_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *UpdateOrderStatusRequest) GetOrderId() int64 {
+func (x *DeleteMerchantAddressRequest) GetMerchantId() int64 {
 	if x != nil {
-		return x.OrderId
+		return x.MerchantId
 	}
 	return 0
 }
 
-func (x *UpdateOrderStatusRequest) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
-}
-
-func (x *UpdateOrderStatusRequest) GetNotes() string {
+func (x *DeleteMerchantAddressRequest) GetAddressId() int64 {
 	if x != nil {
-		return x.Notes
+		return x.AddressId
 	}
-	return ""
+	return 0
 }
 
-type UpdateOrderStatusResponse struct {
+type DeleteMerchantAddressResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Order         *schema.Order          `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateOrderStatusResponse) Reset() {
-	*x = UpdateOrderStatusResponse{}
+func (x *DeleteMerchantAddressResponse) Reset() {
+	*x = DeleteMerchantAddressResponse{}
 	mi := &file_proto_api_merchants_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateOrderStatusResponse) String() string {
+func (x *DeleteMerchantAddressResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOrderStatusResponse) ProtoMessage() {}
+func (*DeleteMerchantAddressResponse) ProtoMessage() {}
 
-func (x *UpdateOrderStatusResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteMerchantAddressResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_api_merchants_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -674,41 +722,40 @@ func (x *UpdateOrderStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOrderStatusResponse.ProtoReflect.Descriptor instead.
-func (*UpdateOrderStatusResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteMerchantAddressResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMerchantAddressResponse) Descriptor() ([]byte, []int) {
 	return file_proto_api_merchants_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *UpdateOrderStatusResponse) GetOrder() *schema.Order {
+func (x *DeleteMerchantAddressResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Order
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-type GetCustomersRequest struct {
+type SetPrimaryMerchantAddressRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	AddressId     int64                  `protobuf:"varint,2,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCustomersRequest) Reset() {
-	*x = GetCustomersRequest{}
+func (x *SetPrimaryMerchantAddressRequest) Reset() {
+	*x = SetPrimaryMerchantAddressRequest{}
 	mi := &file_proto_api_merchants_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCustomersRequest) String() string {
+func (x *SetPrimaryMerchantAddressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCustomersRequest) ProtoMessage() {}
+func (*SetPrimaryMerchantAddressRequest) ProtoMessage() {}
 
-func (x *GetCustomersRequest) ProtoReflect() protoreflect.Message {
+func (x *SetPrimaryMerchantAddressRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_api_merchants_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -720,54 +767,46 @@ func (x *GetCustomersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCustomersRequest.ProtoReflect.Descriptor instead.
-func (*GetCustomersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetPrimaryMerchantAddressRequest.ProtoReflect.Descriptor instead.
+func (*SetPrimaryMerchantAddressRequest) Descriptor() ([]byte, []int) {
 	return file_proto_api_merchants_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetCustomersRequest) GetMerchantId() int64 {
+func (x *SetPrimaryMerchantAddressRequest) GetMerchantId() int64 {
 	if x != nil {
 		return x.MerchantId
 	}
 	return 0
 }
 
-func (x *GetCustomersRequest) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *GetCustomersRequest) GetLimit() int32 {
+func (x *SetPrimaryMerchantAddressRequest) GetAddressId() int64 {
 	if x != nil {
-		return x.Limit
+		return x.AddressId
 	}
 	return 0
 }
 
-type GetCustomersResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Customers     []*schema.User         `protobuf:"bytes,1,rep,name=customers,proto3" json:"customers,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+type SetPrimaryMerchantAddressResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Address       *schema.MerchantAddress `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCustomersResponse) Reset() {
-	*x = GetCustomersResponse{}
+func (x *SetPrimaryMerchantAddressResponse) Reset() {
+	*x = SetPrimaryMerchantAddressResponse{}
 	mi := &file_proto_api_merchants_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCustomersResponse) String() string {
+func (x *SetPrimaryMerchantAddressResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCustomersResponse) ProtoMessage() {}
+func (*SetPrimaryMerchantAddressResponse) ProtoMessage() {}
 
-func (x *GetCustomersResponse) ProtoReflect() protoreflect.Message {
+func (x *SetPrimaryMerchantAddressResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_api_merchants_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -779,48 +818,48 @@ func (x *GetCustomersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCustomersResponse.ProtoReflect.Descriptor instead.
-func (*GetCustomersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetPrimaryMerchantAddressResponse.ProtoReflect.Descriptor instead.
+func (*SetPrimaryMerchantAddressResponse) Descriptor() ([]byte, []int) {
 	return file_proto_api_merchants_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetCustomersResponse) GetCustomers() []*schema.User {
+func (x *SetPrimaryMerchantAddressResponse) GetAddress() *schema.MerchantAddress {
 	if x != nil {
-		return x.Customers
+		return x.Address
 	}
 	return nil
 }
 
-func (x *GetCustomersResponse) GetTotalCount() int32 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
-}
-
-type GetPayoutsRequest struct {
+type GetOrdersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
 	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
 	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	FromDate      int64                  `protobuf:"varint,5,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`     // unix seconds, inclusive
+	ToDate        int64                  `protobuf:"varint,6,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`           // unix seconds, exclusive
+	OutletId      int64                  `protobuf:"varint,7,opt,name=outlet_id,json=outletId,proto3" json:"outlet_id,omitempty"`     // defaults to every outlet the caller can see
+	MinAmount     float64                `protobuf:"fixed64,8,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"` // on total_amount
+	Sort          string                 `protobuf:"bytes,9,opt,name=sort,proto3" json:"sort,omitempty"`                              // newest (default), oldest, amount_desc, amount_asc
+	Search        string                 `protobuf:"bytes,10,opt,name=search,proto3" json:"search,omitempty"`                         // order number, customer name or phone
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPayoutsRequest) Reset() {
-	*x = GetPayoutsRequest{}
+func (x *GetOrdersRequest) Reset() {
+	*x = GetOrdersRequest{}
 	mi := &file_proto_api_merchants_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPayoutsRequest) String() string {
+func (x *GetOrdersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPayoutsRequest) ProtoMessage() {}
+func (*GetOrdersRequest) ProtoMessage() {}
 
-func (x *GetPayoutsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetOrdersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_api_merchants_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -832,54 +871,103 @@ func (x *GetPayoutsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPayoutsRequest.ProtoReflect.Descriptor instead.
-func (*GetPayoutsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetOrdersRequest.ProtoReflect.Descriptor instead.
+func (*GetOrdersRequest) Descriptor() ([]byte, []int) {
 	return file_proto_api_merchants_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetPayoutsRequest) GetMerchantId() int64 {
+func (x *GetOrdersRequest) GetMerchantId() int64 {
 	if x != nil {
 		return x.MerchantId
 	}
 	return 0
 }
 
-func (x *GetPayoutsRequest) GetPage() int32 {
+func (x *GetOrdersRequest) GetPage() int32 {
 	if x != nil {
 		return x.Page
 	}
 	return 0
 }
 
-func (x *GetPayoutsRequest) GetLimit() int32 {
+func (x *GetOrdersRequest) GetLimit() int32 {
 	if x != nil {
 		return x.Limit
 	}
 	return 0
 }
 
-type GetPayoutsResponse struct {
+func (x *GetOrdersRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetOrdersRequest) GetFromDate() int64 {
+	if x != nil {
+		return x.FromDate
+	}
+	return 0
+}
+
+func (x *GetOrdersRequest) GetToDate() int64 {
+	if x != nil {
+		return x.ToDate
+	}
+	return 0
+}
+
+func (x *GetOrdersRequest) GetOutletId() int64 {
+	if x != nil {
+		return x.OutletId
+	}
+	return 0
+}
+
+func (x *GetOrdersRequest) GetMinAmount() float64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *GetOrdersRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *GetOrdersRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+type GetOrdersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Payouts       []*schema.Settlement   `protobuf:"bytes,1,rep,name=payouts,proto3" json:"payouts,omitempty"`
+	Orders        []*schema.Order        `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
 	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPayoutsResponse) Reset() {
-	*x = GetPayoutsResponse{}
+func (x *GetOrdersResponse) Reset() {
+	*x = GetOrdersResponse{}
 	mi := &file_proto_api_merchants_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPayoutsResponse) String() string {
+func (x *GetOrdersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPayoutsResponse) ProtoMessage() {}
+func (*GetOrdersResponse) ProtoMessage() {}
 
-func (x *GetPayoutsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetOrdersResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_api_merchants_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -891,53 +979,3872 @@ func (x *GetPayoutsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPayoutsResponse.ProtoReflect.Descriptor instead.
-func (*GetPayoutsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetOrdersResponse.ProtoReflect.Descriptor instead.
+func (*GetOrdersResponse) Descriptor() ([]byte, []int) {
 	return file_proto_api_merchants_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetPayoutsResponse) GetPayouts() []*schema.Settlement {
+func (x *GetOrdersResponse) GetOrders() []*schema.Order {
 	if x != nil {
-		return x.Payouts
+		return x.Orders
 	}
 	return nil
 }
 
-func (x *GetPayoutsResponse) GetTotalCount() int32 {
+func (x *GetOrdersResponse) GetTotalCount() int32 {
 	if x != nil {
 		return x.TotalCount
 	}
 	return 0
 }
 
-type CreateOfferRequest struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	MerchantId         int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
-	Title              string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description        string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	DiscountPercentage float64                `protobuf:"fixed64,4,opt,name=discount_percentage,json=discountPercentage,proto3" json:"discount_percentage,omitempty"`
-	MinAmount          float64                `protobuf:"fixed64,5,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
-	MaxDiscount        float64                `protobuf:"fixed64,6,opt,name=max_discount,json=maxDiscount,proto3" json:"max_discount,omitempty"`
-	ValidUntil         int64                  `protobuf:"varint,7,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+type UpdateOrderStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // accepted, preparing, ready, completed, cancelled, rejected
+	Notes         string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`   // reason kept with the status change
+	MerchantId    int64                  `protobuf:"varint,4,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrderStatusRequest) Reset() {
+	*x = UpdateOrderStatusRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrderStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrderStatusRequest) ProtoMessage() {}
+
+func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrderStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOrderStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateOrderStatusRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *UpdateOrderStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpdateOrderStatusRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *UpdateOrderStatusRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+type UpdateOrderStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *schema.Order          `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrderStatusResponse) Reset() {
+	*x = UpdateOrderStatusResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrderStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrderStatusResponse) ProtoMessage() {}
+
+func (x *UpdateOrderStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrderStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateOrderStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateOrderStatusResponse) GetOrder() *schema.Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type GetCustomersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCustomersRequest) Reset() {
+	*x = GetCustomersRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCustomersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomersRequest) ProtoMessage() {}
+
+func (x *GetCustomersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomersRequest.ProtoReflect.Descriptor instead.
+func (*GetCustomersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetCustomersRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *GetCustomersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetCustomersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetCustomersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Customers     []*schema.User         `protobuf:"bytes,1,rep,name=customers,proto3" json:"customers,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCustomersResponse) Reset() {
+	*x = GetCustomersResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCustomersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomersResponse) ProtoMessage() {}
+
+func (x *GetCustomersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomersResponse.ProtoReflect.Descriptor instead.
+func (*GetCustomersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetCustomersResponse) GetCustomers() []*schema.User {
+	if x != nil {
+		return x.Customers
+	}
+	return nil
+}
+
+func (x *GetCustomersResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type GetPayoutsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPayoutsRequest) Reset() {
+	*x = GetPayoutsRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPayoutsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayoutsRequest) ProtoMessage() {}
+
+func (x *GetPayoutsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayoutsRequest.ProtoReflect.Descriptor instead.
+func (*GetPayoutsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetPayoutsRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *GetPayoutsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetPayoutsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetPayoutsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payouts       []*schema.Settlement   `protobuf:"bytes,1,rep,name=payouts,proto3" json:"payouts,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPayoutsResponse) Reset() {
+	*x = GetPayoutsResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPayoutsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayoutsResponse) ProtoMessage() {}
+
+func (x *GetPayoutsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayoutsResponse.ProtoReflect.Descriptor instead.
+func (*GetPayoutsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetPayoutsResponse) GetPayouts() []*schema.Settlement {
+	if x != nil {
+		return x.Payouts
+	}
+	return nil
+}
+
+func (x *GetPayoutsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type CreateOfferRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId         int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Title              string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description        string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	DiscountPercentage float64                `protobuf:"fixed64,4,opt,name=discount_percentage,json=discountPercentage,proto3" json:"discount_percentage,omitempty"`
+	MinAmount          float64                `protobuf:"fixed64,5,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	MaxDiscount        float64                `protobuf:"fixed64,6,opt,name=max_discount,json=maxDiscount,proto3" json:"max_discount,omitempty"`
+	ValidUntil         int64                  `protobuf:"varint,7,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateOfferRequest) Reset() {
+	*x = CreateOfferRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOfferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOfferRequest) ProtoMessage() {}
+
+func (x *CreateOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOfferRequest.ProtoReflect.Descriptor instead.
+func (*CreateOfferRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateOfferRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *CreateOfferRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateOfferRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateOfferRequest) GetDiscountPercentage() float64 {
+	if x != nil {
+		return x.DiscountPercentage
+	}
+	return 0
+}
+
+func (x *CreateOfferRequest) GetMinAmount() float64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *CreateOfferRequest) GetMaxDiscount() float64 {
+	if x != nil {
+		return x.MaxDiscount
+	}
+	return 0
+}
+
+func (x *CreateOfferRequest) GetValidUntil() int64 {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return 0
+}
+
+type CreateOfferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Offer         *schema.Offer          `protobuf:"bytes,1,opt,name=offer,proto3" json:"offer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOfferResponse) Reset() {
+	*x = CreateOfferResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOfferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOfferResponse) ProtoMessage() {}
+
+func (x *CreateOfferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOfferResponse.ProtoReflect.Descriptor instead.
+func (*CreateOfferResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateOfferResponse) GetOffer() *schema.Offer {
+	if x != nil {
+		return x.Offer
+	}
+	return nil
+}
+
+type GetOffersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	ActiveOnly    bool                   `protobuf:"varint,2,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOffersRequest) Reset() {
+	*x = GetOffersRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOffersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOffersRequest) ProtoMessage() {}
+
+func (x *GetOffersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOffersRequest.ProtoReflect.Descriptor instead.
+func (*GetOffersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetOffersRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *GetOffersRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+type GetOffersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Offers        []*schema.Offer        `protobuf:"bytes,1,rep,name=offers,proto3" json:"offers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOffersResponse) Reset() {
+	*x = GetOffersResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOffersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOffersResponse) ProtoMessage() {}
+
+func (x *GetOffersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOffersResponse.ProtoReflect.Descriptor instead.
+func (*GetOffersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetOffersResponse) GetOffers() []*schema.Offer {
+	if x != nil {
+		return x.Offers
+	}
+	return nil
+}
+
+type UpdateOfferRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	OfferId            int64                  `protobuf:"varint,1,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+	Title              string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description        string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	DiscountPercentage float64                `protobuf:"fixed64,4,opt,name=discount_percentage,json=discountPercentage,proto3" json:"discount_percentage,omitempty"`
+	MinAmount          float64                `protobuf:"fixed64,5,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	MaxDiscount        float64                `protobuf:"fixed64,6,opt,name=max_discount,json=maxDiscount,proto3" json:"max_discount,omitempty"`
+	IsActive           bool                   `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	ValidUntil         int64                  `protobuf:"varint,8,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UpdateOfferRequest) Reset() {
+	*x = UpdateOfferRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOfferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOfferRequest) ProtoMessage() {}
+
+func (x *UpdateOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOfferRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOfferRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UpdateOfferRequest) GetOfferId() int64 {
+	if x != nil {
+		return x.OfferId
+	}
+	return 0
+}
+
+func (x *UpdateOfferRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateOfferRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateOfferRequest) GetDiscountPercentage() float64 {
+	if x != nil {
+		return x.DiscountPercentage
+	}
+	return 0
+}
+
+func (x *UpdateOfferRequest) GetMinAmount() float64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *UpdateOfferRequest) GetMaxDiscount() float64 {
+	if x != nil {
+		return x.MaxDiscount
+	}
+	return 0
+}
+
+func (x *UpdateOfferRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *UpdateOfferRequest) GetValidUntil() int64 {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return 0
+}
+
+type UpdateOfferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Offer         *schema.Offer          `protobuf:"bytes,1,opt,name=offer,proto3" json:"offer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOfferResponse) Reset() {
+	*x = UpdateOfferResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOfferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOfferResponse) ProtoMessage() {}
+
+func (x *UpdateOfferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOfferResponse.ProtoReflect.Descriptor instead.
+func (*UpdateOfferResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UpdateOfferResponse) GetOffer() *schema.Offer {
+	if x != nil {
+		return x.Offer
+	}
+	return nil
+}
+
+type GetDashboardStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDashboardStatsRequest) Reset() {
+	*x = GetDashboardStatsRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDashboardStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDashboardStatsRequest) ProtoMessage() {}
+
+func (x *GetDashboardStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDashboardStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetDashboardStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetDashboardStatsRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+type GetDashboardStatsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TodayRevenue   float64                `protobuf:"fixed64,1,opt,name=today_revenue,json=todayRevenue,proto3" json:"today_revenue,omitempty"`
+	TodayOrders    int32                  `protobuf:"varint,2,opt,name=today_orders,json=todayOrders,proto3" json:"today_orders,omitempty"`
+	NewCustomers   int32                  `protobuf:"varint,3,opt,name=new_customers,json=newCustomers,proto3" json:"new_customers,omitempty"`
+	TotalCustomers int32                  `protobuf:"varint,4,opt,name=total_customers,json=totalCustomers,proto3" json:"total_customers,omitempty"`
+	PendingPayout  float64                `protobuf:"fixed64,5,opt,name=pending_payout,json=pendingPayout,proto3" json:"pending_payout,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetDashboardStatsResponse) Reset() {
+	*x = GetDashboardStatsResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDashboardStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDashboardStatsResponse) ProtoMessage() {}
+
+func (x *GetDashboardStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDashboardStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetDashboardStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetDashboardStatsResponse) GetTodayRevenue() float64 {
+	if x != nil {
+		return x.TodayRevenue
+	}
+	return 0
+}
+
+func (x *GetDashboardStatsResponse) GetTodayOrders() int32 {
+	if x != nil {
+		return x.TodayOrders
+	}
+	return 0
+}
+
+func (x *GetDashboardStatsResponse) GetNewCustomers() int32 {
+	if x != nil {
+		return x.NewCustomers
+	}
+	return 0
+}
+
+func (x *GetDashboardStatsResponse) GetTotalCustomers() int32 {
+	if x != nil {
+		return x.TotalCustomers
+	}
+	return 0
+}
+
+func (x *GetDashboardStatsResponse) GetPendingPayout() float64 {
+	if x != nil {
+		return x.PendingPayout
+	}
+	return 0
+}
+
+type StreamOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamOrdersRequest) Reset() {
+	*x = StreamOrdersRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamOrdersRequest) ProtoMessage() {}
+
+func (x *StreamOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamOrdersRequest.ProtoReflect.Descriptor instead.
+func (*StreamOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *StreamOrdersRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+type StreamOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *schema.Order          `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	EventType     string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"` // created, or the status the order moved to
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamOrdersResponse) Reset() {
+	*x = StreamOrdersResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamOrdersResponse) ProtoMessage() {}
+
+func (x *StreamOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamOrdersResponse.ProtoReflect.Descriptor instead.
+func (*StreamOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *StreamOrdersResponse) GetOrder() *schema.Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *StreamOrdersResponse) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+type StreamNotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamNotificationsRequest) Reset() {
+	*x = StreamNotificationsRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamNotificationsRequest) ProtoMessage() {}
+
+func (x *StreamNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*StreamNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *StreamNotificationsRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+type StreamNotificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"` // order, payment, onboarding, kyc, order_sla, system
+	Timestamp     int64                  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	DeepLink      string                 `protobuf:"bytes,6,opt,name=deep_link,json=deepLink,proto3" json:"deep_link,omitempty"`
+	Replayed      bool                   `protobuf:"varint,7,opt,name=replayed,proto3" json:"replayed,omitempty"` // sent while the stream was closed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamNotificationsResponse) Reset() {
+	*x = StreamNotificationsResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamNotificationsResponse) ProtoMessage() {}
+
+func (x *StreamNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*StreamNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *StreamNotificationsResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StreamNotificationsResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *StreamNotificationsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *StreamNotificationsResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *StreamNotificationsResponse) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *StreamNotificationsResponse) GetDeepLink() string {
+	if x != nil {
+		return x.DeepLink
+	}
+	return ""
+}
+
+func (x *StreamNotificationsResponse) GetReplayed() bool {
+	if x != nil {
+		return x.Replayed
+	}
+	return false
+}
+
+type CreateAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`                           // orders:read, orders:write, payments:read, ...
+	AllowedIps    []string               `protobuf:"bytes,4,rep,name=allowed_ips,json=allowedIps,proto3" json:"allowed_ips,omitempty"` // IPs or CIDRs, empty allows any
+	ExpiresAt     int64                  `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`   // 0 never expires
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPIKeyRequest) Reset() {
+	*x = CreateAPIKeyRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyRequest) ProtoMessage() {}
+
+func (x *CreateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CreateAPIKeyRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *CreateAPIKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyRequest) GetAllowedIps() []string {
+	if x != nil {
+		return x.AllowedIps
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyRequest) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type CreateAPIKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        *schema.MerchantApiKey `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"` // only returned once
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPIKeyResponse) Reset() {
+	*x = CreateAPIKeyResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyResponse) ProtoMessage() {}
+
+func (x *CreateAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CreateAPIKeyResponse) GetApiKey() *schema.MerchantApiKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type ListAPIKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAPIKeysRequest) Reset() {
+	*x = ListAPIKeysRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAPIKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPIKeysRequest) ProtoMessage() {}
+
+func (x *ListAPIKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPIKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListAPIKeysRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+type ListAPIKeysResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	ApiKeys       []*schema.MerchantApiKey `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAPIKeysResponse) Reset() {
+	*x = ListAPIKeysResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAPIKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPIKeysResponse) ProtoMessage() {}
+
+func (x *ListAPIKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPIKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListAPIKeysResponse) GetApiKeys() []*schema.MerchantApiKey {
+	if x != nil {
+		return x.ApiKeys
+	}
+	return nil
+}
+
+type RevokeAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	ApiKeyId      int64                  `protobuf:"varint,2,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPIKeyRequest) Reset() {
+	*x = RevokeAPIKeyRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyRequest) ProtoMessage() {}
+
+func (x *RevokeAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RevokeAPIKeyRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *RevokeAPIKeyRequest) GetApiKeyId() int64 {
+	if x != nil {
+		return x.ApiKeyId
+	}
+	return 0
+}
+
+type RevokeAPIKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPIKeyResponse) Reset() {
+	*x = RevokeAPIKeyResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyResponse) ProtoMessage() {}
+
+func (x *RevokeAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RevokeAPIKeyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SubmitForReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitForReviewRequest) Reset() {
+	*x = SubmitForReviewRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitForReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitForReviewRequest) ProtoMessage() {}
+
+func (x *SubmitForReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitForReviewRequest.ProtoReflect.Descriptor instead.
+func (*SubmitForReviewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SubmitForReviewRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+type SubmitForReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Merchant      *schema.Merchant       `protobuf:"bytes,1,opt,name=merchant,proto3" json:"merchant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitForReviewResponse) Reset() {
+	*x = SubmitForReviewResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitForReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitForReviewResponse) ProtoMessage() {}
+
+func (x *SubmitForReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitForReviewResponse.ProtoReflect.Descriptor instead.
+func (*SubmitForReviewResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SubmitForReviewResponse) GetMerchant() *schema.Merchant {
+	if x != nil {
+		return x.Merchant
+	}
+	return nil
+}
+
+type GetOnboardingStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOnboardingStatusRequest) Reset() {
+	*x = GetOnboardingStatusRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOnboardingStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOnboardingStatusRequest) ProtoMessage() {}
+
+func (x *GetOnboardingStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOnboardingStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetOnboardingStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetOnboardingStatusRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+type GetOnboardingStatusResponse struct {
+	state            protoimpl.MessageState         `protogen:"open.v1"`
+	Status           string                         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	History          []*schema.MerchantStatusChange `protobuf:"bytes,2,rep,name=history,proto3" json:"history,omitempty"`
+	MissingDocuments []string                       `protobuf:"bytes,3,rep,name=missing_documents,json=missingDocuments,proto3" json:"missing_documents,omitempty"` // required documents not yet verified
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetOnboardingStatusResponse) Reset() {
+	*x = GetOnboardingStatusResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOnboardingStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOnboardingStatusResponse) ProtoMessage() {}
+
+func (x *GetOnboardingStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOnboardingStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetOnboardingStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetOnboardingStatusResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetOnboardingStatusResponse) GetHistory() []*schema.MerchantStatusChange {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *GetOnboardingStatusResponse) GetMissingDocuments() []string {
+	if x != nil {
+		return x.MissingDocuments
+	}
+	return nil
+}
+
+type RequestDocumentUploadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	DocType       string                 `protobuf:"bytes,2,opt,name=doc_type,json=docType,proto3" json:"doc_type,omitempty"`             // pan, gst, fssai
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // application/pdf, image/jpeg, image/png
+	SizeBytes     int64                  `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestDocumentUploadRequest) Reset() {
+	*x = RequestDocumentUploadRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestDocumentUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestDocumentUploadRequest) ProtoMessage() {}
+
+func (x *RequestDocumentUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestDocumentUploadRequest.ProtoReflect.Descriptor instead.
+func (*RequestDocumentUploadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RequestDocumentUploadRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *RequestDocumentUploadRequest) GetDocType() string {
+	if x != nil {
+		return x.DocType
+	}
+	return ""
+}
+
+func (x *RequestDocumentUploadRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *RequestDocumentUploadRequest) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+type RequestDocumentUploadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadUrl     string                 `protobuf:"bytes,1,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"`                                                                        // POST the file here as multipart form data
+	FormData      map[string]string      `protobuf:"bytes,2,rep,name=form_data,json=formData,proto3" json:"form_data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // fields to send before the file
+	ObjectKey     string                 `protobuf:"bytes,3,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	ExpiresIn     int64                  `protobuf:"varint,4,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestDocumentUploadResponse) Reset() {
+	*x = RequestDocumentUploadResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestDocumentUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestDocumentUploadResponse) ProtoMessage() {}
+
+func (x *RequestDocumentUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestDocumentUploadResponse.ProtoReflect.Descriptor instead.
+func (*RequestDocumentUploadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RequestDocumentUploadResponse) GetUploadUrl() string {
+	if x != nil {
+		return x.UploadUrl
+	}
+	return ""
+}
+
+func (x *RequestDocumentUploadResponse) GetFormData() map[string]string {
+	if x != nil {
+		return x.FormData
+	}
+	return nil
+}
+
+func (x *RequestDocumentUploadResponse) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+func (x *RequestDocumentUploadResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+type ConfirmDocumentUploadRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId     int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	DocType        string                 `protobuf:"bytes,2,opt,name=doc_type,json=docType,proto3" json:"doc_type,omitempty"`
+	ObjectKey      string                 `protobuf:"bytes,3,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	DocumentNumber string                 `protobuf:"bytes,4,opt,name=document_number,json=documentNumber,proto3" json:"document_number,omitempty"`
+	ExpiresAt      int64                  `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // licence expiry, 0 if the document doesn't expire
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ConfirmDocumentUploadRequest) Reset() {
+	*x = ConfirmDocumentUploadRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmDocumentUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmDocumentUploadRequest) ProtoMessage() {}
+
+func (x *ConfirmDocumentUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmDocumentUploadRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmDocumentUploadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ConfirmDocumentUploadRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *ConfirmDocumentUploadRequest) GetDocType() string {
+	if x != nil {
+		return x.DocType
+	}
+	return ""
+}
+
+func (x *ConfirmDocumentUploadRequest) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+func (x *ConfirmDocumentUploadRequest) GetDocumentNumber() string {
+	if x != nil {
+		return x.DocumentNumber
+	}
+	return ""
+}
+
+func (x *ConfirmDocumentUploadRequest) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type ConfirmDocumentUploadResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Document      *schema.MerchantDocument `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmDocumentUploadResponse) Reset() {
+	*x = ConfirmDocumentUploadResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmDocumentUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmDocumentUploadResponse) ProtoMessage() {}
+
+func (x *ConfirmDocumentUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmDocumentUploadResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmDocumentUploadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ConfirmDocumentUploadResponse) GetDocument() *schema.MerchantDocument {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+type ListDocumentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDocumentsRequest) Reset() {
+	*x = ListDocumentsRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDocumentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDocumentsRequest) ProtoMessage() {}
+
+func (x *ListDocumentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDocumentsRequest.ProtoReflect.Descriptor instead.
+func (*ListDocumentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListDocumentsRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+type ListDocumentsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Documents     []*schema.MerchantDocument `protobuf:"bytes,1,rep,name=documents,proto3" json:"documents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDocumentsResponse) Reset() {
+	*x = ListDocumentsResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDocumentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDocumentsResponse) ProtoMessage() {}
+
+func (x *ListDocumentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDocumentsResponse.ProtoReflect.Descriptor instead.
+func (*ListDocumentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListDocumentsResponse) GetDocuments() []*schema.MerchantDocument {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+type GetBusinessHoursRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBusinessHoursRequest) Reset() {
+	*x = GetBusinessHoursRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBusinessHoursRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBusinessHoursRequest) ProtoMessage() {}
+
+func (x *GetBusinessHoursRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBusinessHoursRequest.ProtoReflect.Descriptor instead.
+func (*GetBusinessHoursRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetBusinessHoursRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+type GetBusinessHoursResponse struct {
+	state             protoimpl.MessageState          `protogen:"open.v1"`
+	Timezone          string                          `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Intervals         []*schema.BusinessHoursInterval `protobuf:"bytes,2,rep,name=intervals,proto3" json:"intervals,omitempty"`
+	Closures          []*schema.MerchantClosure       `protobuf:"bytes,3,rep,name=closures,proto3" json:"closures,omitempty"` // upcoming and current
+	OrdersPausedUntil int64                           `protobuf:"varint,4,opt,name=orders_paused_until,json=ordersPausedUntil,proto3" json:"orders_paused_until,omitempty"`
+	IsOpenNow         bool                            `protobuf:"varint,5,opt,name=is_open_now,json=isOpenNow,proto3" json:"is_open_now,omitempty"`
+	ClosedReason      string                          `protobuf:"bytes,6,opt,name=closed_reason,json=closedReason,proto3" json:"closed_reason,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetBusinessHoursResponse) Reset() {
+	*x = GetBusinessHoursResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBusinessHoursResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBusinessHoursResponse) ProtoMessage() {}
+
+func (x *GetBusinessHoursResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBusinessHoursResponse.ProtoReflect.Descriptor instead.
+func (*GetBusinessHoursResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetBusinessHoursResponse) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetBusinessHoursResponse) GetIntervals() []*schema.BusinessHoursInterval {
+	if x != nil {
+		return x.Intervals
+	}
+	return nil
+}
+
+func (x *GetBusinessHoursResponse) GetClosures() []*schema.MerchantClosure {
+	if x != nil {
+		return x.Closures
+	}
+	return nil
+}
+
+func (x *GetBusinessHoursResponse) GetOrdersPausedUntil() int64 {
+	if x != nil {
+		return x.OrdersPausedUntil
+	}
+	return 0
+}
+
+func (x *GetBusinessHoursResponse) GetIsOpenNow() bool {
+	if x != nil {
+		return x.IsOpenNow
+	}
+	return false
+}
+
+func (x *GetBusinessHoursResponse) GetClosedReason() string {
+	if x != nil {
+		return x.ClosedReason
+	}
+	return ""
+}
+
+type SetBusinessHoursRequest struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	MerchantId    int64                           `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Timezone      string                          `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`   // IANA name, e.g. Asia/Kolkata; unchanged when empty
+	Intervals     []*schema.BusinessHoursInterval `protobuf:"bytes,3,rep,name=intervals,proto3" json:"intervals,omitempty"` // replaces the weekly schedule
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBusinessHoursRequest) Reset() {
+	*x = SetBusinessHoursRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBusinessHoursRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBusinessHoursRequest) ProtoMessage() {}
+
+func (x *SetBusinessHoursRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBusinessHoursRequest.ProtoReflect.Descriptor instead.
+func (*SetBusinessHoursRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SetBusinessHoursRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *SetBusinessHoursRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *SetBusinessHoursRequest) GetIntervals() []*schema.BusinessHoursInterval {
+	if x != nil {
+		return x.Intervals
+	}
+	return nil
+}
+
+type AddClosureRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	StartsAt      int64                  `protobuf:"varint,2,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        int64                  `protobuf:"varint,3,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	Date          string                 `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD holiday in the merchant's timezone, used when starts_at is 0
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddClosureRequest) Reset() {
+	*x = AddClosureRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddClosureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddClosureRequest) ProtoMessage() {}
+
+func (x *AddClosureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddClosureRequest.ProtoReflect.Descriptor instead.
+func (*AddClosureRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *AddClosureRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *AddClosureRequest) GetStartsAt() int64 {
+	if x != nil {
+		return x.StartsAt
+	}
+	return 0
+}
+
+func (x *AddClosureRequest) GetEndsAt() int64 {
+	if x != nil {
+		return x.EndsAt
+	}
+	return 0
+}
+
+func (x *AddClosureRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *AddClosureRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AddClosureResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Closure       *schema.MerchantClosure `protobuf:"bytes,1,opt,name=closure,proto3" json:"closure,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddClosureResponse) Reset() {
+	*x = AddClosureResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddClosureResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddClosureResponse) ProtoMessage() {}
+
+func (x *AddClosureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddClosureResponse.ProtoReflect.Descriptor instead.
+func (*AddClosureResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *AddClosureResponse) GetClosure() *schema.MerchantClosure {
+	if x != nil {
+		return x.Closure
+	}
+	return nil
+}
+
+type DeleteClosureRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	ClosureId     int64                  `protobuf:"varint,2,opt,name=closure_id,json=closureId,proto3" json:"closure_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteClosureRequest) Reset() {
+	*x = DeleteClosureRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteClosureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteClosureRequest) ProtoMessage() {}
+
+func (x *DeleteClosureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteClosureRequest.ProtoReflect.Descriptor instead.
+func (*DeleteClosureRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DeleteClosureRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *DeleteClosureRequest) GetClosureId() int64 {
+	if x != nil {
+		return x.ClosureId
+	}
+	return 0
+}
+
+type DeleteClosureResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteClosureResponse) Reset() {
+	*x = DeleteClosureResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteClosureResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteClosureResponse) ProtoMessage() {}
+
+func (x *DeleteClosureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteClosureResponse.ProtoReflect.Descriptor instead.
+func (*DeleteClosureResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DeleteClosureResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type PauseOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Minutes       int32                  `protobuf:"varint,2,opt,name=minutes,proto3" json:"minutes,omitempty"` // 0 resumes orders
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseOrdersRequest) Reset() {
+	*x = PauseOrdersRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseOrdersRequest) ProtoMessage() {}
+
+func (x *PauseOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseOrdersRequest.ProtoReflect.Descriptor instead.
+func (*PauseOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *PauseOrdersRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *PauseOrdersRequest) GetMinutes() int32 {
+	if x != nil {
+		return x.Minutes
+	}
+	return 0
+}
+
+type PauseOrdersResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	OrdersPausedUntil int64                  `protobuf:"varint,1,opt,name=orders_paused_until,json=ordersPausedUntil,proto3" json:"orders_paused_until,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PauseOrdersResponse) Reset() {
+	*x = PauseOrdersResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseOrdersResponse) ProtoMessage() {}
+
+func (x *PauseOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseOrdersResponse.ProtoReflect.Descriptor instead.
+func (*PauseOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *PauseOrdersResponse) GetOrdersPausedUntil() int64 {
+	if x != nil {
+		return x.OrdersPausedUntil
+	}
+	return 0
+}
+
+type GetCatalogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCatalogRequest) Reset() {
+	*x = GetCatalogRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCatalogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCatalogRequest) ProtoMessage() {}
+
+func (x *GetCatalogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCatalogRequest.ProtoReflect.Descriptor instead.
+func (*GetCatalogRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetCatalogRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+type GetCatalogResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Categories    []*schema.CatalogCategory `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	Items         []*schema.CatalogItem     `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCatalogResponse) Reset() {
+	*x = GetCatalogResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCatalogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCatalogResponse) ProtoMessage() {}
+
+func (x *GetCatalogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCatalogResponse.ProtoReflect.Descriptor instead.
+func (*GetCatalogResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetCatalogResponse) GetCategories() []*schema.CatalogCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *GetCatalogResponse) GetItems() []*schema.CatalogItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CreateCatalogCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SortOrder     int32                  `protobuf:"varint,3,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCatalogCategoryRequest) Reset() {
+	*x = CreateCatalogCategoryRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCatalogCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCatalogCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCatalogCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCatalogCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCatalogCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CreateCatalogCategoryRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *CreateCatalogCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCatalogCategoryRequest) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+type UpdateCatalogCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	CategoryId    int64                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	SortOrder     int32                  `protobuf:"varint,4,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCatalogCategoryRequest) Reset() {
+	*x = UpdateCatalogCategoryRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCatalogCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCatalogCategoryRequest) ProtoMessage() {}
+
+func (x *UpdateCatalogCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCatalogCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCatalogCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *UpdateCatalogCategoryRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *UpdateCatalogCategoryRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *UpdateCatalogCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCatalogCategoryRequest) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+type CatalogCategoryResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Category      *schema.CatalogCategory `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CatalogCategoryResponse) Reset() {
+	*x = CatalogCategoryResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogCategoryResponse) ProtoMessage() {}
+
+func (x *CatalogCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogCategoryResponse.ProtoReflect.Descriptor instead.
+func (*CatalogCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CatalogCategoryResponse) GetCategory() *schema.CatalogCategory {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type DeleteCatalogCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	CategoryId    int64                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // items in the category become uncategorised
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCatalogCategoryRequest) Reset() {
+	*x = DeleteCatalogCategoryRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCatalogCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCatalogCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteCatalogCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCatalogCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCatalogCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *DeleteCatalogCategoryRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *DeleteCatalogCategoryRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+type DeleteCatalogCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCatalogCategoryResponse) Reset() {
+	*x = DeleteCatalogCategoryResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCatalogCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCatalogCategoryResponse) ProtoMessage() {}
+
+func (x *DeleteCatalogCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCatalogCategoryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCatalogCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *DeleteCatalogCategoryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type CatalogOptionInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` // variant or addon
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PriceDelta    float64                `protobuf:"fixed64,3,opt,name=price_delta,json=priceDelta,proto3" json:"price_delta,omitempty"`
+	IsAvailable   bool                   `protobuf:"varint,4,opt,name=is_available,json=isAvailable,proto3" json:"is_available,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CatalogOptionInput) Reset() {
+	*x = CatalogOptionInput{}
+	mi := &file_proto_api_merchants_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogOptionInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogOptionInput) ProtoMessage() {}
+
+func (x *CatalogOptionInput) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogOptionInput.ProtoReflect.Descriptor instead.
+func (*CatalogOptionInput) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CatalogOptionInput) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CatalogOptionInput) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CatalogOptionInput) GetPriceDelta() float64 {
+	if x != nil {
+		return x.PriceDelta
+	}
+	return 0
+}
+
+func (x *CatalogOptionInput) GetIsAvailable() bool {
+	if x != nil {
+		return x.IsAvailable
+	}
+	return false
+}
+
+type CreateCatalogItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	CategoryId    int64                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price         float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+	SortOrder     int32                  `protobuf:"varint,6,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	Options       []*CatalogOptionInput  `protobuf:"bytes,7,rep,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCatalogItemRequest) Reset() {
+	*x = CreateCatalogItemRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCatalogItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCatalogItemRequest) ProtoMessage() {}
+
+func (x *CreateCatalogItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCatalogItemRequest.ProtoReflect.Descriptor instead.
+func (*CreateCatalogItemRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CreateCatalogItemRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *CreateCatalogItemRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CreateCatalogItemRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCatalogItemRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateCatalogItemRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CreateCatalogItemRequest) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+func (x *CreateCatalogItemRequest) GetOptions() []*CatalogOptionInput {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type UpdateCatalogItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	ItemId        int64                  `protobuf:"varint,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	CategoryId    int64                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Price         float64                `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`
+	SortOrder     int32                  `protobuf:"varint,7,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	Options       []*CatalogOptionInput  `protobuf:"bytes,8,rep,name=options,proto3" json:"options,omitempty"` // replaces the item's options
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCatalogItemRequest) Reset() {
+	*x = UpdateCatalogItemRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCatalogItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCatalogItemRequest) ProtoMessage() {}
+
+func (x *UpdateCatalogItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCatalogItemRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCatalogItemRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *UpdateCatalogItemRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *UpdateCatalogItemRequest) GetItemId() int64 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *UpdateCatalogItemRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *UpdateCatalogItemRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCatalogItemRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateCatalogItemRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *UpdateCatalogItemRequest) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+func (x *UpdateCatalogItemRequest) GetOptions() []*CatalogOptionInput {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type CatalogItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *schema.CatalogItem    `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CatalogItemResponse) Reset() {
+	*x = CatalogItemResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogItemResponse) ProtoMessage() {}
+
+func (x *CatalogItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogItemResponse.ProtoReflect.Descriptor instead.
+func (*CatalogItemResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *CatalogItemResponse) GetItem() *schema.CatalogItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type DeleteCatalogItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	ItemId        int64                  `protobuf:"varint,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCatalogItemRequest) Reset() {
+	*x = DeleteCatalogItemRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCatalogItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCatalogItemRequest) ProtoMessage() {}
+
+func (x *DeleteCatalogItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCatalogItemRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCatalogItemRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *DeleteCatalogItemRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *DeleteCatalogItemRequest) GetItemId() int64 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+type DeleteCatalogItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCatalogItemResponse) Reset() {
+	*x = DeleteCatalogItemResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCatalogItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCatalogItemResponse) ProtoMessage() {}
+
+func (x *DeleteCatalogItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCatalogItemResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCatalogItemResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *DeleteCatalogItemResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SetCatalogAvailabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	ItemId        int64                  `protobuf:"varint,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"` // set one of item_id or option_id
+	OptionId      int64                  `protobuf:"varint,3,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	IsAvailable   bool                   `protobuf:"varint,4,opt,name=is_available,json=isAvailable,proto3" json:"is_available,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCatalogAvailabilityRequest) Reset() {
+	*x = SetCatalogAvailabilityRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCatalogAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCatalogAvailabilityRequest) ProtoMessage() {}
+
+func (x *SetCatalogAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCatalogAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*SetCatalogAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *SetCatalogAvailabilityRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *SetCatalogAvailabilityRequest) GetItemId() int64 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *SetCatalogAvailabilityRequest) GetOptionId() int64 {
+	if x != nil {
+		return x.OptionId
+	}
+	return 0
+}
+
+func (x *SetCatalogAvailabilityRequest) GetIsAvailable() bool {
+	if x != nil {
+		return x.IsAvailable
+	}
+	return false
+}
+
+type SetCatalogAvailabilityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCatalogAvailabilityResponse) Reset() {
+	*x = SetCatalogAvailabilityResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCatalogAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCatalogAvailabilityResponse) ProtoMessage() {}
+
+func (x *SetCatalogAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCatalogAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*SetCatalogAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *SetCatalogAvailabilityResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RequestCatalogImageUploadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	ItemId        int64                  `protobuf:"varint,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // image/jpeg, image/png, image/webp
+	SizeBytes     int64                  `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestCatalogImageUploadRequest) Reset() {
+	*x = RequestCatalogImageUploadRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestCatalogImageUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestCatalogImageUploadRequest) ProtoMessage() {}
+
+func (x *RequestCatalogImageUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestCatalogImageUploadRequest.ProtoReflect.Descriptor instead.
+func (*RequestCatalogImageUploadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *RequestCatalogImageUploadRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *RequestCatalogImageUploadRequest) GetItemId() int64 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *RequestCatalogImageUploadRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *RequestCatalogImageUploadRequest) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+type RequestCatalogImageUploadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadUrl     string                 `protobuf:"bytes,1,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"` // POST the file here as multipart form data
+	FormData      map[string]string      `protobuf:"bytes,2,rep,name=form_data,json=formData,proto3" json:"form_data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ObjectKey     string                 `protobuf:"bytes,3,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	ExpiresIn     int64                  `protobuf:"varint,4,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestCatalogImageUploadResponse) Reset() {
+	*x = RequestCatalogImageUploadResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestCatalogImageUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestCatalogImageUploadResponse) ProtoMessage() {}
+
+func (x *RequestCatalogImageUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestCatalogImageUploadResponse.ProtoReflect.Descriptor instead.
+func (*RequestCatalogImageUploadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *RequestCatalogImageUploadResponse) GetUploadUrl() string {
+	if x != nil {
+		return x.UploadUrl
+	}
+	return ""
+}
+
+func (x *RequestCatalogImageUploadResponse) GetFormData() map[string]string {
+	if x != nil {
+		return x.FormData
+	}
+	return nil
+}
+
+func (x *RequestCatalogImageUploadResponse) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+func (x *RequestCatalogImageUploadResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+type ConfirmCatalogImageUploadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	ItemId        int64                  `protobuf:"varint,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	ObjectKey     string                 `protobuf:"bytes,3,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmCatalogImageUploadRequest) Reset() {
+	*x = ConfirmCatalogImageUploadRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmCatalogImageUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmCatalogImageUploadRequest) ProtoMessage() {}
+
+func (x *ConfirmCatalogImageUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmCatalogImageUploadRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmCatalogImageUploadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ConfirmCatalogImageUploadRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *ConfirmCatalogImageUploadRequest) GetItemId() int64 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *ConfirmCatalogImageUploadRequest) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+type InviteStaffRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`                                    // owner, manager, cashier
+	OutletIds     []int64                `protobuf:"varint,4,rep,packed,name=outlet_ids,json=outletIds,proto3" json:"outlet_ids,omitempty"` // empty for every outlet
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteStaffRequest) Reset() {
+	*x = InviteStaffRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteStaffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteStaffRequest) ProtoMessage() {}
+
+func (x *InviteStaffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteStaffRequest.ProtoReflect.Descriptor instead.
+func (*InviteStaffRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *InviteStaffRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *InviteStaffRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *InviteStaffRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *InviteStaffRequest) GetOutletIds() []int64 {
+	if x != nil {
+		return x.OutletIds
+	}
+	return nil
+}
+
+type InviteStaffResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Invitation    *schema.StaffInvitation `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteStaffResponse) Reset() {
+	*x = InviteStaffResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteStaffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteStaffResponse) ProtoMessage() {}
+
+func (x *InviteStaffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteStaffResponse.ProtoReflect.Descriptor instead.
+func (*InviteStaffResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *InviteStaffResponse) GetInvitation() *schema.StaffInvitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+type AcceptStaffInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` // from the invitation email, must be accepted by the invited address
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptStaffInvitationRequest) Reset() {
+	*x = AcceptStaffInvitationRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptStaffInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptStaffInvitationRequest) ProtoMessage() {}
+
+func (x *AcceptStaffInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptStaffInvitationRequest.ProtoReflect.Descriptor instead.
+func (*AcceptStaffInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *AcceptStaffInvitationRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type AcceptStaffInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Membership    *schema.MerchantStaff  `protobuf:"bytes,1,opt,name=membership,proto3" json:"membership,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptStaffInvitationResponse) Reset() {
+	*x = AcceptStaffInvitationResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptStaffInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptStaffInvitationResponse) ProtoMessage() {}
+
+func (x *AcceptStaffInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptStaffInvitationResponse.ProtoReflect.Descriptor instead.
+func (*AcceptStaffInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *AcceptStaffInvitationResponse) GetMembership() *schema.MerchantStaff {
+	if x != nil {
+		return x.Membership
+	}
+	return nil
+}
+
+type RevokeStaffInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	InvitationId  int64                  `protobuf:"varint,2,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeStaffInvitationRequest) Reset() {
+	*x = RevokeStaffInvitationRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeStaffInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeStaffInvitationRequest) ProtoMessage() {}
+
+func (x *RevokeStaffInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeStaffInvitationRequest.ProtoReflect.Descriptor instead.
+func (*RevokeStaffInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *RevokeStaffInvitationRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *RevokeStaffInvitationRequest) GetInvitationId() int64 {
+	if x != nil {
+		return x.InvitationId
+	}
+	return 0
+}
+
+type RevokeStaffInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeStaffInvitationResponse) Reset() {
+	*x = RevokeStaffInvitationResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeStaffInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeStaffInvitationResponse) ProtoMessage() {}
+
+func (x *RevokeStaffInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeStaffInvitationResponse.ProtoReflect.Descriptor instead.
+func (*RevokeStaffInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *RevokeStaffInvitationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListStaffRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStaffRequest) Reset() {
+	*x = ListStaffRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStaffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStaffRequest) ProtoMessage() {}
+
+func (x *ListStaffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStaffRequest.ProtoReflect.Descriptor instead.
+func (*ListStaffRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListStaffRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+type ListStaffResponse struct {
+	state              protoimpl.MessageState    `protogen:"open.v1"`
+	Staff              []*schema.MerchantStaff   `protobuf:"bytes,1,rep,name=staff,proto3" json:"staff,omitempty"`
+	PendingInvitations []*schema.StaffInvitation `protobuf:"bytes,2,rep,name=pending_invitations,json=pendingInvitations,proto3" json:"pending_invitations,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
 
-func (x *CreateOfferRequest) Reset() {
-	*x = CreateOfferRequest{}
-	mi := &file_proto_api_merchants_proto_msgTypes[16]
+func (x *ListStaffResponse) Reset() {
+	*x = ListStaffResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateOfferRequest) String() string {
+func (x *ListStaffResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOfferRequest) ProtoMessage() {}
+func (*ListStaffResponse) ProtoMessage() {}
 
-func (x *CreateOfferRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_merchants_proto_msgTypes[16]
+func (x *ListStaffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -948,82 +4855,115 @@ func (x *CreateOfferRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOfferRequest.ProtoReflect.Descriptor instead.
-func (*CreateOfferRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_merchants_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ListStaffResponse.ProtoReflect.Descriptor instead.
+func (*ListStaffResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *CreateOfferRequest) GetMerchantId() int64 {
+func (x *ListStaffResponse) GetStaff() []*schema.MerchantStaff {
 	if x != nil {
-		return x.MerchantId
+		return x.Staff
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateOfferRequest) GetTitle() string {
+func (x *ListStaffResponse) GetPendingInvitations() []*schema.StaffInvitation {
 	if x != nil {
-		return x.Title
+		return x.PendingInvitations
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateOfferRequest) GetDescription() string {
+type UpdateStaffRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	StaffId       int64                  `protobuf:"varint,2,opt,name=staff_id,json=staffId,proto3" json:"staff_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	OutletIds     []int64                `protobuf:"varint,4,rep,packed,name=outlet_ids,json=outletIds,proto3" json:"outlet_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateStaffRequest) Reset() {
+	*x = UpdateStaffRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateStaffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateStaffRequest) ProtoMessage() {}
+
+func (x *UpdateStaffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[85]
 	if x != nil {
-		return x.Description
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateOfferRequest) GetDiscountPercentage() float64 {
+// Deprecated: Use UpdateStaffRequest.ProtoReflect.Descriptor instead.
+func (*UpdateStaffRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *UpdateStaffRequest) GetMerchantId() int64 {
 	if x != nil {
-		return x.DiscountPercentage
+		return x.MerchantId
 	}
 	return 0
 }
 
-func (x *CreateOfferRequest) GetMinAmount() float64 {
+func (x *UpdateStaffRequest) GetStaffId() int64 {
 	if x != nil {
-		return x.MinAmount
+		return x.StaffId
 	}
 	return 0
 }
 
-func (x *CreateOfferRequest) GetMaxDiscount() float64 {
+func (x *UpdateStaffRequest) GetRole() string {
 	if x != nil {
-		return x.MaxDiscount
+		return x.Role
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateOfferRequest) GetValidUntil() int64 {
+func (x *UpdateStaffRequest) GetOutletIds() []int64 {
 	if x != nil {
-		return x.ValidUntil
+		return x.OutletIds
 	}
-	return 0
+	return nil
 }
 
-type CreateOfferResponse struct {
+type UpdateStaffResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Offer         *schema.Offer          `protobuf:"bytes,1,opt,name=offer,proto3" json:"offer,omitempty"`
+	Staff         *schema.MerchantStaff  `protobuf:"bytes,1,opt,name=staff,proto3" json:"staff,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateOfferResponse) Reset() {
-	*x = CreateOfferResponse{}
-	mi := &file_proto_api_merchants_proto_msgTypes[17]
+func (x *UpdateStaffResponse) Reset() {
+	*x = UpdateStaffResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateOfferResponse) String() string {
+func (x *UpdateStaffResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOfferResponse) ProtoMessage() {}
+func (*UpdateStaffResponse) ProtoMessage() {}
 
-func (x *CreateOfferResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_merchants_proto_msgTypes[17]
+func (x *UpdateStaffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1034,41 +4974,41 @@ func (x *CreateOfferResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOfferResponse.ProtoReflect.Descriptor instead.
-func (*CreateOfferResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_merchants_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use UpdateStaffResponse.ProtoReflect.Descriptor instead.
+func (*UpdateStaffResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *CreateOfferResponse) GetOffer() *schema.Offer {
+func (x *UpdateStaffResponse) GetStaff() *schema.MerchantStaff {
 	if x != nil {
-		return x.Offer
+		return x.Staff
 	}
 	return nil
 }
 
-type GetOffersRequest struct {
+type RemoveStaffRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
-	ActiveOnly    bool                   `protobuf:"varint,2,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	StaffId       int64                  `protobuf:"varint,2,opt,name=staff_id,json=staffId,proto3" json:"staff_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOffersRequest) Reset() {
-	*x = GetOffersRequest{}
-	mi := &file_proto_api_merchants_proto_msgTypes[18]
+func (x *RemoveStaffRequest) Reset() {
+	*x = RemoveStaffRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOffersRequest) String() string {
+func (x *RemoveStaffRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOffersRequest) ProtoMessage() {}
+func (*RemoveStaffRequest) ProtoMessage() {}
 
-func (x *GetOffersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_merchants_proto_msgTypes[18]
+func (x *RemoveStaffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1079,47 +5019,47 @@ func (x *GetOffersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOffersRequest.ProtoReflect.Descriptor instead.
-func (*GetOffersRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_merchants_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use RemoveStaffRequest.ProtoReflect.Descriptor instead.
+func (*RemoveStaffRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *GetOffersRequest) GetMerchantId() int64 {
+func (x *RemoveStaffRequest) GetMerchantId() int64 {
 	if x != nil {
 		return x.MerchantId
 	}
 	return 0
 }
 
-func (x *GetOffersRequest) GetActiveOnly() bool {
+func (x *RemoveStaffRequest) GetStaffId() int64 {
 	if x != nil {
-		return x.ActiveOnly
+		return x.StaffId
 	}
-	return false
+	return 0
 }
 
-type GetOffersResponse struct {
+type RemoveStaffResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Offers        []*schema.Offer        `protobuf:"bytes,1,rep,name=offers,proto3" json:"offers,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOffersResponse) Reset() {
-	*x = GetOffersResponse{}
-	mi := &file_proto_api_merchants_proto_msgTypes[19]
+func (x *RemoveStaffResponse) Reset() {
+	*x = RemoveStaffResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOffersResponse) String() string {
+func (x *RemoveStaffResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOffersResponse) ProtoMessage() {}
+func (*RemoveStaffResponse) ProtoMessage() {}
 
-func (x *GetOffersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_merchants_proto_msgTypes[19]
+func (x *RemoveStaffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1130,47 +5070,39 @@ func (x *GetOffersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOffersResponse.ProtoReflect.Descriptor instead.
-func (*GetOffersResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_merchants_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use RemoveStaffResponse.ProtoReflect.Descriptor instead.
+func (*RemoveStaffResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *GetOffersResponse) GetOffers() []*schema.Offer {
+func (x *RemoveStaffResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Offers
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-type UpdateOfferRequest struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	OfferId            int64                  `protobuf:"varint,1,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
-	Title              string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description        string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	DiscountPercentage float64                `protobuf:"fixed64,4,opt,name=discount_percentage,json=discountPercentage,proto3" json:"discount_percentage,omitempty"`
-	MinAmount          float64                `protobuf:"fixed64,5,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
-	MaxDiscount        float64                `protobuf:"fixed64,6,opt,name=max_discount,json=maxDiscount,proto3" json:"max_discount,omitempty"`
-	IsActive           bool                   `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	ValidUntil         int64                  `protobuf:"varint,8,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type ListMyMembershipsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateOfferRequest) Reset() {
-	*x = UpdateOfferRequest{}
-	mi := &file_proto_api_merchants_proto_msgTypes[20]
+func (x *ListMyMembershipsRequest) Reset() {
+	*x = ListMyMembershipsRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateOfferRequest) String() string {
+func (x *ListMyMembershipsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOfferRequest) ProtoMessage() {}
+func (*ListMyMembershipsRequest) ProtoMessage() {}
 
-func (x *UpdateOfferRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_merchants_proto_msgTypes[20]
+func (x *ListMyMembershipsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1181,89 +5113,175 @@ func (x *UpdateOfferRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOfferRequest.ProtoReflect.Descriptor instead.
-func (*UpdateOfferRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_merchants_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ListMyMembershipsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyMembershipsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *UpdateOfferRequest) GetOfferId() int64 {
-	if x != nil {
-		return x.OfferId
-	}
-	return 0
+type ListMyMembershipsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Memberships   []*schema.MerchantStaff `protobuf:"bytes,1,rep,name=memberships,proto3" json:"memberships,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateOfferRequest) GetTitle() string {
+func (x *ListMyMembershipsResponse) Reset() {
+	*x = ListMyMembershipsResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyMembershipsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyMembershipsResponse) ProtoMessage() {}
+
+func (x *ListMyMembershipsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[90]
 	if x != nil {
-		return x.Title
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateOfferRequest) GetDescription() string {
+// Deprecated: Use ListMyMembershipsResponse.ProtoReflect.Descriptor instead.
+func (*ListMyMembershipsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ListMyMembershipsResponse) GetMemberships() []*schema.MerchantStaff {
 	if x != nil {
-		return x.Description
+		return x.Memberships
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateOfferRequest) GetDiscountPercentage() float64 {
+type GetOrderReceiptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	OrderId       int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderReceiptRequest) Reset() {
+	*x = GetOrderReceiptRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderReceiptRequest) ProtoMessage() {}
+
+func (x *GetOrderReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[91]
 	if x != nil {
-		return x.DiscountPercentage
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateOfferRequest) GetMinAmount() float64 {
+// Deprecated: Use GetOrderReceiptRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *GetOrderReceiptRequest) GetMerchantId() int64 {
 	if x != nil {
-		return x.MinAmount
+		return x.MerchantId
 	}
 	return 0
 }
 
-func (x *UpdateOfferRequest) GetMaxDiscount() float64 {
+func (x *GetOrderReceiptRequest) GetOrderId() int64 {
 	if x != nil {
-		return x.MaxDiscount
+		return x.OrderId
 	}
 	return 0
 }
 
-func (x *UpdateOfferRequest) GetIsActive() bool {
+type GetOrderReceiptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Receipt       *schema.Receipt        `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderReceiptResponse) Reset() {
+	*x = GetOrderReceiptResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderReceiptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderReceiptResponse) ProtoMessage() {}
+
+func (x *GetOrderReceiptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[92]
 	if x != nil {
-		return x.IsActive
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateOfferRequest) GetValidUntil() int64 {
+// Deprecated: Use GetOrderReceiptResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderReceiptResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *GetOrderReceiptResponse) GetReceipt() *schema.Receipt {
 	if x != nil {
-		return x.ValidUntil
+		return x.Receipt
 	}
-	return 0
+	return nil
 }
 
-type UpdateOfferResponse struct {
+type ReplyToReviewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Offer         *schema.Offer          `protobuf:"bytes,1,opt,name=offer,proto3" json:"offer,omitempty"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	ReviewId      int64                  `protobuf:"varint,2,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	Reply         string                 `protobuf:"bytes,3,opt,name=reply,proto3" json:"reply,omitempty"` // replaces an earlier reply
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateOfferResponse) Reset() {
-	*x = UpdateOfferResponse{}
-	mi := &file_proto_api_merchants_proto_msgTypes[21]
+func (x *ReplyToReviewRequest) Reset() {
+	*x = ReplyToReviewRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateOfferResponse) String() string {
+func (x *ReplyToReviewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOfferResponse) ProtoMessage() {}
+func (*ReplyToReviewRequest) ProtoMessage() {}
 
-func (x *UpdateOfferResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_merchants_proto_msgTypes[21]
+func (x *ReplyToReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1274,40 +5292,54 @@ func (x *UpdateOfferResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOfferResponse.ProtoReflect.Descriptor instead.
-func (*UpdateOfferResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_merchants_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ReplyToReviewRequest.ProtoReflect.Descriptor instead.
+func (*ReplyToReviewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *UpdateOfferResponse) GetOffer() *schema.Offer {
+func (x *ReplyToReviewRequest) GetMerchantId() int64 {
 	if x != nil {
-		return x.Offer
+		return x.MerchantId
 	}
-	return nil
+	return 0
 }
 
-type GetDashboardStatsRequest struct {
+func (x *ReplyToReviewRequest) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *ReplyToReviewRequest) GetReply() string {
+	if x != nil {
+		return x.Reply
+	}
+	return ""
+}
+
+type ReplyToReviewResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Review        *schema.Review         `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDashboardStatsRequest) Reset() {
-	*x = GetDashboardStatsRequest{}
-	mi := &file_proto_api_merchants_proto_msgTypes[22]
+func (x *ReplyToReviewResponse) Reset() {
+	*x = ReplyToReviewResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDashboardStatsRequest) String() string {
+func (x *ReplyToReviewResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDashboardStatsRequest) ProtoMessage() {}
+func (*ReplyToReviewResponse) ProtoMessage() {}
 
-func (x *GetDashboardStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_merchants_proto_msgTypes[22]
+func (x *ReplyToReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1318,44 +5350,43 @@ func (x *GetDashboardStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDashboardStatsRequest.ProtoReflect.Descriptor instead.
-func (*GetDashboardStatsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_merchants_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use ReplyToReviewResponse.ProtoReflect.Descriptor instead.
+func (*ReplyToReviewResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *GetDashboardStatsRequest) GetMerchantId() int64 {
+func (x *ReplyToReviewResponse) GetReview() *schema.Review {
 	if x != nil {
-		return x.MerchantId
+		return x.Review
 	}
-	return 0
+	return nil
 }
 
-type GetDashboardStatsResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	TodayRevenue   float64                `protobuf:"fixed64,1,opt,name=today_revenue,json=todayRevenue,proto3" json:"today_revenue,omitempty"`
-	TodayOrders    int32                  `protobuf:"varint,2,opt,name=today_orders,json=todayOrders,proto3" json:"today_orders,omitempty"`
-	NewCustomers   int32                  `protobuf:"varint,3,opt,name=new_customers,json=newCustomers,proto3" json:"new_customers,omitempty"`
-	TotalCustomers int32                  `protobuf:"varint,4,opt,name=total_customers,json=totalCustomers,proto3" json:"total_customers,omitempty"`
-	PendingPayout  float64                `protobuf:"fixed64,5,opt,name=pending_payout,json=pendingPayout,proto3" json:"pending_payout,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type ListMerchantNotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	UnreadOnly    bool                   `protobuf:"varint,4,opt,name=unread_only,json=unreadOnly,proto3" json:"unread_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDashboardStatsResponse) Reset() {
-	*x = GetDashboardStatsResponse{}
-	mi := &file_proto_api_merchants_proto_msgTypes[23]
+func (x *ListMerchantNotificationsRequest) Reset() {
+	*x = ListMerchantNotificationsRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDashboardStatsResponse) String() string {
+func (x *ListMerchantNotificationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDashboardStatsResponse) ProtoMessage() {}
+func (*ListMerchantNotificationsRequest) ProtoMessage() {}
 
-func (x *GetDashboardStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_merchants_proto_msgTypes[23]
+func (x *ListMerchantNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1366,68 +5397,63 @@ func (x *GetDashboardStatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDashboardStatsResponse.ProtoReflect.Descriptor instead.
-func (*GetDashboardStatsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_merchants_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *GetDashboardStatsResponse) GetTodayRevenue() float64 {
-	if x != nil {
-		return x.TodayRevenue
-	}
-	return 0
+// Deprecated: Use ListMerchantNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListMerchantNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *GetDashboardStatsResponse) GetTodayOrders() int32 {
+func (x *ListMerchantNotificationsRequest) GetMerchantId() int64 {
 	if x != nil {
-		return x.TodayOrders
+		return x.MerchantId
 	}
 	return 0
 }
 
-func (x *GetDashboardStatsResponse) GetNewCustomers() int32 {
+func (x *ListMerchantNotificationsRequest) GetPage() int32 {
 	if x != nil {
-		return x.NewCustomers
+		return x.Page
 	}
 	return 0
 }
 
-func (x *GetDashboardStatsResponse) GetTotalCustomers() int32 {
+func (x *ListMerchantNotificationsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.TotalCustomers
+		return x.Limit
 	}
 	return 0
 }
 
-func (x *GetDashboardStatsResponse) GetPendingPayout() float64 {
+func (x *ListMerchantNotificationsRequest) GetUnreadOnly() bool {
 	if x != nil {
-		return x.PendingPayout
+		return x.UnreadOnly
 	}
-	return 0
+	return false
 }
 
-type StreamOrdersRequest struct {
+type ListMerchantNotificationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Notifications []*schema.Notification `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	UnreadCount   int32                  `protobuf:"varint,3,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StreamOrdersRequest) Reset() {
-	*x = StreamOrdersRequest{}
-	mi := &file_proto_api_merchants_proto_msgTypes[24]
+func (x *ListMerchantNotificationsResponse) Reset() {
+	*x = ListMerchantNotificationsResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamOrdersRequest) String() string {
+func (x *ListMerchantNotificationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamOrdersRequest) ProtoMessage() {}
+func (*ListMerchantNotificationsResponse) ProtoMessage() {}
 
-func (x *StreamOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_merchants_proto_msgTypes[24]
+func (x *ListMerchantNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1438,41 +5464,56 @@ func (x *StreamOrdersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamOrdersRequest.ProtoReflect.Descriptor instead.
-func (*StreamOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_merchants_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use ListMerchantNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListMerchantNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *StreamOrdersRequest) GetMerchantId() int64 {
+func (x *ListMerchantNotificationsResponse) GetNotifications() []*schema.Notification {
 	if x != nil {
-		return x.MerchantId
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *ListMerchantNotificationsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
 	}
 	return 0
 }
 
-type StreamOrdersResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Order         *schema.Order          `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
-	EventType     string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"` // new, updated, cancelled
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ListMerchantNotificationsResponse) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
 }
 
-func (x *StreamOrdersResponse) Reset() {
-	*x = StreamOrdersResponse{}
-	mi := &file_proto_api_merchants_proto_msgTypes[25]
+type MarkMerchantNotificationsReadRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId      int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	NotificationIds []int64                `protobuf:"varint,2,rep,packed,name=notification_ids,json=notificationIds,proto3" json:"notification_ids,omitempty"`
+	All             bool                   `protobuf:"varint,3,opt,name=all,proto3" json:"all,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MarkMerchantNotificationsReadRequest) Reset() {
+	*x = MarkMerchantNotificationsReadRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamOrdersResponse) String() string {
+func (x *MarkMerchantNotificationsReadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamOrdersResponse) ProtoMessage() {}
+func (*MarkMerchantNotificationsReadRequest) ProtoMessage() {}
 
-func (x *StreamOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_merchants_proto_msgTypes[25]
+func (x *MarkMerchantNotificationsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1483,47 +5524,54 @@ func (x *StreamOrdersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamOrdersResponse.ProtoReflect.Descriptor instead.
-func (*StreamOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_merchants_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use MarkMerchantNotificationsReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkMerchantNotificationsReadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *StreamOrdersResponse) GetOrder() *schema.Order {
+func (x *MarkMerchantNotificationsReadRequest) GetMerchantId() int64 {
 	if x != nil {
-		return x.Order
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *MarkMerchantNotificationsReadRequest) GetNotificationIds() []int64 {
+	if x != nil {
+		return x.NotificationIds
 	}
 	return nil
 }
 
-func (x *StreamOrdersResponse) GetEventType() string {
+func (x *MarkMerchantNotificationsReadRequest) GetAll() bool {
 	if x != nil {
-		return x.EventType
+		return x.All
 	}
-	return ""
+	return false
 }
 
-type StreamNotificationsRequest struct {
+type MarkMerchantNotificationsReadResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Updated       int32                  `protobuf:"varint,1,opt,name=updated,proto3" json:"updated,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StreamNotificationsRequest) Reset() {
-	*x = StreamNotificationsRequest{}
-	mi := &file_proto_api_merchants_proto_msgTypes[26]
+func (x *MarkMerchantNotificationsReadResponse) Reset() {
+	*x = MarkMerchantNotificationsReadResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamNotificationsRequest) String() string {
+func (x *MarkMerchantNotificationsReadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamNotificationsRequest) ProtoMessage() {}
+func (*MarkMerchantNotificationsReadResponse) ProtoMessage() {}
 
-func (x *StreamNotificationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_merchants_proto_msgTypes[26]
+func (x *MarkMerchantNotificationsReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1534,44 +5582,40 @@ func (x *StreamNotificationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamNotificationsRequest.ProtoReflect.Descriptor instead.
-func (*StreamNotificationsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_merchants_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use MarkMerchantNotificationsReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkMerchantNotificationsReadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *StreamNotificationsRequest) GetMerchantId() int64 {
+func (x *MarkMerchantNotificationsReadResponse) GetUpdated() int32 {
 	if x != nil {
-		return x.MerchantId
+		return x.Updated
 	}
 	return 0
 }
 
-type StreamNotificationsResponse struct {
+type GetMerchantUnreadNotificationCountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"` // order, payout, system
-	Timestamp     int64                  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StreamNotificationsResponse) Reset() {
-	*x = StreamNotificationsResponse{}
-	mi := &file_proto_api_merchants_proto_msgTypes[27]
+func (x *GetMerchantUnreadNotificationCountRequest) Reset() {
+	*x = GetMerchantUnreadNotificationCountRequest{}
+	mi := &file_proto_api_merchants_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamNotificationsResponse) String() string {
+func (x *GetMerchantUnreadNotificationCountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamNotificationsResponse) ProtoMessage() {}
+func (*GetMerchantUnreadNotificationCountRequest) ProtoMessage() {}
 
-func (x *StreamNotificationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_merchants_proto_msgTypes[27]
+func (x *GetMerchantUnreadNotificationCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1582,42 +5626,58 @@ func (x *StreamNotificationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamNotificationsResponse.ProtoReflect.Descriptor instead.
-func (*StreamNotificationsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_merchants_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use GetMerchantUnreadNotificationCountRequest.ProtoReflect.Descriptor instead.
+func (*GetMerchantUnreadNotificationCountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *StreamNotificationsResponse) GetId() string {
+func (x *GetMerchantUnreadNotificationCountRequest) GetMerchantId() int64 {
 	if x != nil {
-		return x.Id
+		return x.MerchantId
 	}
-	return ""
+	return 0
 }
 
-func (x *StreamNotificationsResponse) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+type GetMerchantUnreadNotificationCountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StreamNotificationsResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
+func (x *GetMerchantUnreadNotificationCountResponse) Reset() {
+	*x = GetMerchantUnreadNotificationCountResponse{}
+	mi := &file_proto_api_merchants_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamNotificationsResponse) GetType() string {
+func (x *GetMerchantUnreadNotificationCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMerchantUnreadNotificationCountResponse) ProtoMessage() {}
+
+func (x *GetMerchantUnreadNotificationCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_merchants_proto_msgTypes[100]
 	if x != nil {
-		return x.Type
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *StreamNotificationsResponse) GetTimestamp() int64 {
+// Deprecated: Use GetMerchantUnreadNotificationCountResponse.ProtoReflect.Descriptor instead.
+func (*GetMerchantUnreadNotificationCountResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_merchants_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GetMerchantUnreadNotificationCountResponse) GetCount() int32 {
 	if x != nil {
-		return x.Timestamp
+		return x.Count
 	}
 	return 0
 }
@@ -1645,7 +5705,7 @@ const file_proto_api_merchants_proto_rawDesc = "" +
 	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
 	"merchantId\"\\\n" +
 	"\x1aGetMerchantAddressResponse\x12>\n" +
-	"\taddresses\x18\x01 \x03(\v2 .rival.schema.v1.MerchantAddressR\taddresses\"\xf6\x01\n" +
+	"\taddresses\x18\x01 \x03(\v2 .rival.schema.v1.MerchantAddressR\taddresses\"\xab\x02\n" +
 	"\x1cUpdateMerchantAddressRequest\x12\x1f\n" +
 	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
 	"merchantId\x12\x16\n" +
@@ -1656,23 +5716,68 @@ const file_proto_api_merchants_proto_rawDesc = "" +
 	"postalCode\x12\x18\n" +
 	"\acountry\x18\x06 \x01(\tR\acountry\x12\x1a\n" +
 	"\blatitude\x18\a \x01(\x01R\blatitude\x12\x1c\n" +
-	"\tlongitude\x18\b \x01(\x01R\tlongitude\"[\n" +
+	"\tlongitude\x18\b \x01(\x01R\tlongitude\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\t \x01(\x03R\taddressId\x12\x14\n" +
+	"\x05label\x18\n" +
+	" \x01(\tR\x05label\"[\n" +
 	"\x1dUpdateMerchantAddressResponse\x12:\n" +
-	"\aaddress\x18\x01 \x01(\v2 .rival.schema.v1.MerchantAddressR\aaddress\"u\n" +
+	"\aaddress\x18\x01 \x01(\v2 .rival.schema.v1.MerchantAddressR\aaddress\"\xa8\x02\n" +
+	"\x19AddMerchantAddressRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x16\n" +
+	"\x06street\x18\x02 \x01(\tR\x06street\x12\x12\n" +
+	"\x04city\x18\x03 \x01(\tR\x04city\x12\x14\n" +
+	"\x05state\x18\x04 \x01(\tR\x05state\x12\x1f\n" +
+	"\vpostal_code\x18\x05 \x01(\tR\n" +
+	"postalCode\x12\x18\n" +
+	"\acountry\x18\x06 \x01(\tR\acountry\x12\x1a\n" +
+	"\blatitude\x18\a \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\b \x01(\x01R\tlongitude\x12\x14\n" +
+	"\x05label\x18\t \x01(\tR\x05label\x12\x1d\n" +
+	"\n" +
+	"is_primary\x18\n" +
+	" \x01(\bR\tisPrimary\"X\n" +
+	"\x1aAddMerchantAddressResponse\x12:\n" +
+	"\aaddress\x18\x01 \x01(\v2 .rival.schema.v1.MerchantAddressR\aaddress\"^\n" +
+	"\x1cDeleteMerchantAddressRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\x02 \x01(\x03R\taddressId\"9\n" +
+	"\x1dDeleteMerchantAddressResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"b\n" +
+	" SetPrimaryMerchantAddressRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\x02 \x01(\x03R\taddressId\"_\n" +
+	"!SetPrimaryMerchantAddressResponse\x12:\n" +
+	"\aaddress\x18\x01 \x01(\v2 .rival.schema.v1.MerchantAddressR\aaddress\"\x93\x02\n" +
 	"\x10GetOrdersRequest\x12\x1f\n" +
 	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
 	"merchantId\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
 	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06status\x18\x04 \x01(\tR\x06status\"d\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1b\n" +
+	"\tfrom_date\x18\x05 \x01(\x03R\bfromDate\x12\x17\n" +
+	"\ato_date\x18\x06 \x01(\x03R\x06toDate\x12\x1b\n" +
+	"\toutlet_id\x18\a \x01(\x03R\boutletId\x12\x1d\n" +
+	"\n" +
+	"min_amount\x18\b \x01(\x01R\tminAmount\x12\x12\n" +
+	"\x04sort\x18\t \x01(\tR\x04sort\x12\x16\n" +
+	"\x06search\x18\n" +
+	" \x01(\tR\x06search\"d\n" +
 	"\x11GetOrdersResponse\x12.\n" +
 	"\x06orders\x18\x01 \x03(\v2\x16.rival.schema.v1.OrderR\x06orders\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"c\n" +
+	"totalCount\"\x84\x01\n" +
 	"\x18UpdateOrderStatusRequest\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
-	"\x05notes\x18\x03 \x01(\tR\x05notes\"I\n" +
+	"\x05notes\x18\x03 \x01(\tR\x05notes\x12\x1f\n" +
+	"\vmerchant_id\x18\x04 \x01(\x03R\n" +
+	"merchantId\"I\n" +
 	"\x19UpdateOrderStatusResponse\x12,\n" +
 	"\x05order\x18\x01 \x01(\v2\x16.rival.schema.v1.OrderR\x05order\"`\n" +
 	"\x13GetCustomersRequest\x12\x1f\n" +
@@ -1744,21 +5849,318 @@ const file_proto_api_merchants_proto_rawDesc = "" +
 	"event_type\x18\x02 \x01(\tR\teventType\"=\n" +
 	"\x1aStreamNotificationsRequest\x12\x1f\n" +
 	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
-	"merchantId\"\x8f\x01\n" +
+	"merchantId\"\xc8\x01\n" +
 	"\x1bStreamNotificationsResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
 	"\amessage\x18\x03 \x01(\tR\amessage\x12\x12\n" +
 	"\x04type\x18\x04 \x01(\tR\x04type\x12\x1c\n" +
-	"\ttimestamp\x18\x05 \x01(\x03R\ttimestamp2\x9c\n" +
+	"\ttimestamp\x18\x05 \x01(\x03R\ttimestamp\x12\x1b\n" +
+	"\tdeep_link\x18\x06 \x01(\tR\bdeepLink\x12\x1a\n" +
+	"\breplayed\x18\a \x01(\bR\breplayed\"\xa2\x01\n" +
+	"\x13CreateAPIKeyRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\x12\x1f\n" +
+	"\vallowed_ips\x18\x04 \x03(\tR\n" +
+	"allowedIps\x12\x1d\n" +
 	"\n" +
+	"expires_at\x18\x05 \x01(\x03R\texpiresAt\"b\n" +
+	"\x14CreateAPIKeyResponse\x128\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x1f.rival.schema.v1.MerchantApiKeyR\x06apiKey\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\"5\n" +
+	"\x12ListAPIKeysRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\"Q\n" +
+	"\x13ListAPIKeysResponse\x12:\n" +
+	"\bapi_keys\x18\x01 \x03(\v2\x1f.rival.schema.v1.MerchantApiKeyR\aapiKeys\"T\n" +
+	"\x13RevokeAPIKeyRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x1c\n" +
+	"\n" +
+	"api_key_id\x18\x02 \x01(\x03R\bapiKeyId\"0\n" +
+	"\x14RevokeAPIKeyResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"9\n" +
+	"\x16SubmitForReviewRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\"P\n" +
+	"\x17SubmitForReviewResponse\x125\n" +
+	"\bmerchant\x18\x01 \x01(\v2\x19.rival.schema.v1.MerchantR\bmerchant\"=\n" +
+	"\x1aGetOnboardingStatusRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\"\xa3\x01\n" +
+	"\x1bGetOnboardingStatusResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12?\n" +
+	"\ahistory\x18\x02 \x03(\v2%.rival.schema.v1.MerchantStatusChangeR\ahistory\x12+\n" +
+	"\x11missing_documents\x18\x03 \x03(\tR\x10missingDocuments\"\x9c\x01\n" +
+	"\x1cRequestDocumentUploadRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x19\n" +
+	"\bdoc_type\x18\x02 \x01(\tR\adocType\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x04 \x01(\x03R\tsizeBytes\"\x91\x02\n" +
+	"\x1dRequestDocumentUploadResponse\x12\x1d\n" +
+	"\n" +
+	"upload_url\x18\x01 \x01(\tR\tuploadUrl\x12V\n" +
+	"\tform_data\x18\x02 \x03(\v29.rival.api.v1.RequestDocumentUploadResponse.FormDataEntryR\bformData\x12\x1d\n" +
+	"\n" +
+	"object_key\x18\x03 \x01(\tR\tobjectKey\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x04 \x01(\x03R\texpiresIn\x1a;\n" +
+	"\rFormDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc1\x01\n" +
+	"\x1cConfirmDocumentUploadRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x19\n" +
+	"\bdoc_type\x18\x02 \x01(\tR\adocType\x12\x1d\n" +
+	"\n" +
+	"object_key\x18\x03 \x01(\tR\tobjectKey\x12'\n" +
+	"\x0fdocument_number\x18\x04 \x01(\tR\x0edocumentNumber\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\x03R\texpiresAt\"^\n" +
+	"\x1dConfirmDocumentUploadResponse\x12=\n" +
+	"\bdocument\x18\x01 \x01(\v2!.rival.schema.v1.MerchantDocumentR\bdocument\"7\n" +
+	"\x14ListDocumentsRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\"X\n" +
+	"\x15ListDocumentsResponse\x12?\n" +
+	"\tdocuments\x18\x01 \x03(\v2!.rival.schema.v1.MerchantDocumentR\tdocuments\":\n" +
+	"\x17GetBusinessHoursRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\"\xaf\x02\n" +
+	"\x18GetBusinessHoursResponse\x12\x1a\n" +
+	"\btimezone\x18\x01 \x01(\tR\btimezone\x12D\n" +
+	"\tintervals\x18\x02 \x03(\v2&.rival.schema.v1.BusinessHoursIntervalR\tintervals\x12<\n" +
+	"\bclosures\x18\x03 \x03(\v2 .rival.schema.v1.MerchantClosureR\bclosures\x12.\n" +
+	"\x13orders_paused_until\x18\x04 \x01(\x03R\x11ordersPausedUntil\x12\x1e\n" +
+	"\vis_open_now\x18\x05 \x01(\bR\tisOpenNow\x12#\n" +
+	"\rclosed_reason\x18\x06 \x01(\tR\fclosedReason\"\x9c\x01\n" +
+	"\x17SetBusinessHoursRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\x12D\n" +
+	"\tintervals\x18\x03 \x03(\v2&.rival.schema.v1.BusinessHoursIntervalR\tintervals\"\x96\x01\n" +
+	"\x11AddClosureRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x1b\n" +
+	"\tstarts_at\x18\x02 \x01(\x03R\bstartsAt\x12\x17\n" +
+	"\aends_at\x18\x03 \x01(\x03R\x06endsAt\x12\x12\n" +
+	"\x04date\x18\x04 \x01(\tR\x04date\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"P\n" +
+	"\x12AddClosureResponse\x12:\n" +
+	"\aclosure\x18\x01 \x01(\v2 .rival.schema.v1.MerchantClosureR\aclosure\"V\n" +
+	"\x14DeleteClosureRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x1d\n" +
+	"\n" +
+	"closure_id\x18\x02 \x01(\x03R\tclosureId\"1\n" +
+	"\x15DeleteClosureResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"O\n" +
+	"\x12PauseOrdersRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x18\n" +
+	"\aminutes\x18\x02 \x01(\x05R\aminutes\"E\n" +
+	"\x13PauseOrdersResponse\x12.\n" +
+	"\x13orders_paused_until\x18\x01 \x01(\x03R\x11ordersPausedUntil\"4\n" +
+	"\x11GetCatalogRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\"\x8a\x01\n" +
+	"\x12GetCatalogResponse\x12@\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2 .rival.schema.v1.CatalogCategoryR\n" +
+	"categories\x122\n" +
+	"\x05items\x18\x02 \x03(\v2\x1c.rival.schema.v1.CatalogItemR\x05items\"r\n" +
+	"\x1cCreateCatalogCategoryRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\x03 \x01(\x05R\tsortOrder\"\x93\x01\n" +
+	"\x1cUpdateCatalogCategoryRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\x03R\n" +
+	"categoryId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\x04 \x01(\x05R\tsortOrder\"W\n" +
+	"\x17CatalogCategoryResponse\x12<\n" +
+	"\bcategory\x18\x01 \x01(\v2 .rival.schema.v1.CatalogCategoryR\bcategory\"`\n" +
+	"\x1cDeleteCatalogCategoryRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\x03R\n" +
+	"categoryId\"9\n" +
+	"\x1dDeleteCatalogCategoryResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x80\x01\n" +
+	"\x12CatalogOptionInput\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
+	"\vprice_delta\x18\x03 \x01(\x01R\n" +
+	"priceDelta\x12!\n" +
+	"\fis_available\x18\x04 \x01(\bR\visAvailable\"\x83\x02\n" +
+	"\x18CreateCatalogItemRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\x03R\n" +
+	"categoryId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x01R\x05price\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\x06 \x01(\x05R\tsortOrder\x12:\n" +
+	"\aoptions\x18\a \x03(\v2 .rival.api.v1.CatalogOptionInputR\aoptions\"\x9c\x02\n" +
+	"\x18UpdateCatalogItemRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\x03R\x06itemId\x12\x1f\n" +
+	"\vcategory_id\x18\x03 \x01(\x03R\n" +
+	"categoryId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05price\x18\x06 \x01(\x01R\x05price\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\a \x01(\x05R\tsortOrder\x12:\n" +
+	"\aoptions\x18\b \x03(\v2 .rival.api.v1.CatalogOptionInputR\aoptions\"G\n" +
+	"\x13CatalogItemResponse\x120\n" +
+	"\x04item\x18\x01 \x01(\v2\x1c.rival.schema.v1.CatalogItemR\x04item\"T\n" +
+	"\x18DeleteCatalogItemRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\x03R\x06itemId\"5\n" +
+	"\x19DeleteCatalogItemResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x99\x01\n" +
+	"\x1dSetCatalogAvailabilityRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\x03R\x06itemId\x12\x1b\n" +
+	"\toption_id\x18\x03 \x01(\x03R\boptionId\x12!\n" +
+	"\fis_available\x18\x04 \x01(\bR\visAvailable\":\n" +
+	"\x1eSetCatalogAvailabilityResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x9e\x01\n" +
+	" RequestCatalogImageUploadRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\x03R\x06itemId\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x04 \x01(\x03R\tsizeBytes\"\x99\x02\n" +
+	"!RequestCatalogImageUploadResponse\x12\x1d\n" +
+	"\n" +
+	"upload_url\x18\x01 \x01(\tR\tuploadUrl\x12Z\n" +
+	"\tform_data\x18\x02 \x03(\v2=.rival.api.v1.RequestCatalogImageUploadResponse.FormDataEntryR\bformData\x12\x1d\n" +
+	"\n" +
+	"object_key\x18\x03 \x01(\tR\tobjectKey\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x04 \x01(\x03R\texpiresIn\x1a;\n" +
+	"\rFormDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"{\n" +
+	" ConfirmCatalogImageUploadRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\x03R\x06itemId\x12\x1d\n" +
+	"\n" +
+	"object_key\x18\x03 \x01(\tR\tobjectKey\"~\n" +
+	"\x12InviteStaffRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x1d\n" +
+	"\n" +
+	"outlet_ids\x18\x04 \x03(\x03R\toutletIds\"W\n" +
+	"\x13InviteStaffResponse\x12@\n" +
+	"\n" +
+	"invitation\x18\x01 \x01(\v2 .rival.schema.v1.StaffInvitationR\n" +
+	"invitation\"4\n" +
+	"\x1cAcceptStaffInvitationRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"_\n" +
+	"\x1dAcceptStaffInvitationResponse\x12>\n" +
+	"\n" +
+	"membership\x18\x01 \x01(\v2\x1e.rival.schema.v1.MerchantStaffR\n" +
+	"membership\"d\n" +
+	"\x1cRevokeStaffInvitationRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12#\n" +
+	"\rinvitation_id\x18\x02 \x01(\x03R\finvitationId\"9\n" +
+	"\x1dRevokeStaffInvitationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"3\n" +
+	"\x10ListStaffRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\"\x9c\x01\n" +
+	"\x11ListStaffResponse\x124\n" +
+	"\x05staff\x18\x01 \x03(\v2\x1e.rival.schema.v1.MerchantStaffR\x05staff\x12Q\n" +
+	"\x13pending_invitations\x18\x02 \x03(\v2 .rival.schema.v1.StaffInvitationR\x12pendingInvitations\"\x83\x01\n" +
+	"\x12UpdateStaffRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x19\n" +
+	"\bstaff_id\x18\x02 \x01(\x03R\astaffId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x1d\n" +
+	"\n" +
+	"outlet_ids\x18\x04 \x03(\x03R\toutletIds\"K\n" +
+	"\x13UpdateStaffResponse\x124\n" +
+	"\x05staff\x18\x01 \x01(\v2\x1e.rival.schema.v1.MerchantStaffR\x05staff\"P\n" +
+	"\x12RemoveStaffRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x19\n" +
+	"\bstaff_id\x18\x02 \x01(\x03R\astaffId\"/\n" +
+	"\x13RemoveStaffResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x1a\n" +
+	"\x18ListMyMembershipsRequest\"]\n" +
+	"\x19ListMyMembershipsResponse\x12@\n" +
+	"\vmemberships\x18\x01 \x03(\v2\x1e.rival.schema.v1.MerchantStaffR\vmemberships\"T\n" +
+	"\x16GetOrderReceiptRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x03R\aorderId\"M\n" +
+	"\x17GetOrderReceiptResponse\x122\n" +
+	"\areceipt\x18\x01 \x01(\v2\x18.rival.schema.v1.ReceiptR\areceipt\"j\n" +
+	"\x14ReplyToReviewRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x1b\n" +
+	"\treview_id\x18\x02 \x01(\x03R\breviewId\x12\x14\n" +
+	"\x05reply\x18\x03 \x01(\tR\x05reply\"H\n" +
+	"\x15ReplyToReviewResponse\x12/\n" +
+	"\x06review\x18\x01 \x01(\v2\x17.rival.schema.v1.ReviewR\x06review\"\x8e\x01\n" +
+	" ListMerchantNotificationsRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x1f\n" +
+	"\vunread_only\x18\x04 \x01(\bR\n" +
+	"unreadOnly\"\xac\x01\n" +
+	"!ListMerchantNotificationsResponse\x12C\n" +
+	"\rnotifications\x18\x01 \x03(\v2\x1d.rival.schema.v1.NotificationR\rnotifications\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12!\n" +
+	"\funread_count\x18\x03 \x01(\x05R\vunreadCount\"\x84\x01\n" +
+	"$MarkMerchantNotificationsReadRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12)\n" +
+	"\x10notification_ids\x18\x02 \x03(\x03R\x0fnotificationIds\x12\x10\n" +
+	"\x03all\x18\x03 \x01(\bR\x03all\"A\n" +
+	"%MarkMerchantNotificationsReadResponse\x12\x18\n" +
+	"\aupdated\x18\x01 \x01(\x05R\aupdated\"L\n" +
+	")GetMerchantUnreadNotificationCountRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\"B\n" +
+	"*GetMerchantUnreadNotificationCountResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count2\xc3(\n" +
 	"\x0fMerchantService\x12R\n" +
 	"\vGetMerchant\x12 .rival.api.v1.GetMerchantRequest\x1a!.rival.api.v1.GetMerchantResponse\x12[\n" +
 	"\x0eUpdateMerchant\x12#.rival.api.v1.UpdateMerchantRequest\x1a$.rival.api.v1.UpdateMerchantResponse\x12g\n" +
 	"\x12GetMerchantAddress\x12'.rival.api.v1.GetMerchantAddressRequest\x1a(.rival.api.v1.GetMerchantAddressResponse\x12p\n" +
-	"\x15UpdateMerchantAddress\x12*.rival.api.v1.UpdateMerchantAddressRequest\x1a+.rival.api.v1.UpdateMerchantAddressResponse\x12L\n" +
+	"\x15UpdateMerchantAddress\x12*.rival.api.v1.UpdateMerchantAddressRequest\x1a+.rival.api.v1.UpdateMerchantAddressResponse\x12g\n" +
+	"\x12AddMerchantAddress\x12'.rival.api.v1.AddMerchantAddressRequest\x1a(.rival.api.v1.AddMerchantAddressResponse\x12p\n" +
+	"\x15DeleteMerchantAddress\x12*.rival.api.v1.DeleteMerchantAddressRequest\x1a+.rival.api.v1.DeleteMerchantAddressResponse\x12|\n" +
+	"\x19SetPrimaryMerchantAddress\x12..rival.api.v1.SetPrimaryMerchantAddressRequest\x1a/.rival.api.v1.SetPrimaryMerchantAddressResponse\x12L\n" +
 	"\tGetOrders\x12\x1e.rival.api.v1.GetOrdersRequest\x1a\x1f.rival.api.v1.GetOrdersResponse\x12d\n" +
-	"\x11UpdateOrderStatus\x12&.rival.api.v1.UpdateOrderStatusRequest\x1a'.rival.api.v1.UpdateOrderStatusResponse\x12U\n" +
+	"\x11UpdateOrderStatus\x12&.rival.api.v1.UpdateOrderStatusRequest\x1a'.rival.api.v1.UpdateOrderStatusResponse\x12^\n" +
+	"\x0fGetOrderReceipt\x12$.rival.api.v1.GetOrderReceiptRequest\x1a%.rival.api.v1.GetOrderReceiptResponse\x12X\n" +
+	"\rReplyToReview\x12\".rival.api.v1.ReplyToReviewRequest\x1a#.rival.api.v1.ReplyToReviewResponse\x12U\n" +
 	"\fGetCustomers\x12!.rival.api.v1.GetCustomersRequest\x1a\".rival.api.v1.GetCustomersResponse\x12O\n" +
 	"\n" +
 	"GetPayouts\x12\x1f.rival.api.v1.GetPayoutsRequest\x1a .rival.api.v1.GetPayoutsResponse\x12R\n" +
@@ -1767,7 +6169,42 @@ const file_proto_api_merchants_proto_rawDesc = "" +
 	"\vUpdateOffer\x12 .rival.api.v1.UpdateOfferRequest\x1a!.rival.api.v1.UpdateOfferResponse\x12d\n" +
 	"\x11GetDashboardStats\x12&.rival.api.v1.GetDashboardStatsRequest\x1a'.rival.api.v1.GetDashboardStatsResponse\x12W\n" +
 	"\fStreamOrders\x12!.rival.api.v1.StreamOrdersRequest\x1a\".rival.api.v1.StreamOrdersResponse0\x01\x12l\n" +
-	"\x13StreamNotifications\x12(.rival.api.v1.StreamNotificationsRequest\x1a).rival.api.v1.StreamNotificationsResponse0\x01B\x1bZ\x19rival/gen/proto/proto/apib\x06proto3"
+	"\x13StreamNotifications\x12(.rival.api.v1.StreamNotificationsRequest\x1a).rival.api.v1.StreamNotificationsResponse0\x01\x12t\n" +
+	"\x11ListNotifications\x12..rival.api.v1.ListMerchantNotificationsRequest\x1a/.rival.api.v1.ListMerchantNotificationsResponse\x12\x80\x01\n" +
+	"\x15MarkNotificationsRead\x122.rival.api.v1.MarkMerchantNotificationsReadRequest\x1a3.rival.api.v1.MarkMerchantNotificationsReadResponse\x12\x8f\x01\n" +
+	"\x1aGetUnreadNotificationCount\x127.rival.api.v1.GetMerchantUnreadNotificationCountRequest\x1a8.rival.api.v1.GetMerchantUnreadNotificationCountResponse\x12U\n" +
+	"\fCreateAPIKey\x12!.rival.api.v1.CreateAPIKeyRequest\x1a\".rival.api.v1.CreateAPIKeyResponse\x12R\n" +
+	"\vListAPIKeys\x12 .rival.api.v1.ListAPIKeysRequest\x1a!.rival.api.v1.ListAPIKeysResponse\x12U\n" +
+	"\fRevokeAPIKey\x12!.rival.api.v1.RevokeAPIKeyRequest\x1a\".rival.api.v1.RevokeAPIKeyResponse\x12^\n" +
+	"\x0fSubmitForReview\x12$.rival.api.v1.SubmitForReviewRequest\x1a%.rival.api.v1.SubmitForReviewResponse\x12j\n" +
+	"\x13GetOnboardingStatus\x12(.rival.api.v1.GetOnboardingStatusRequest\x1a).rival.api.v1.GetOnboardingStatusResponse\x12p\n" +
+	"\x15RequestDocumentUpload\x12*.rival.api.v1.RequestDocumentUploadRequest\x1a+.rival.api.v1.RequestDocumentUploadResponse\x12p\n" +
+	"\x15ConfirmDocumentUpload\x12*.rival.api.v1.ConfirmDocumentUploadRequest\x1a+.rival.api.v1.ConfirmDocumentUploadResponse\x12X\n" +
+	"\rListDocuments\x12\".rival.api.v1.ListDocumentsRequest\x1a#.rival.api.v1.ListDocumentsResponse\x12a\n" +
+	"\x10GetBusinessHours\x12%.rival.api.v1.GetBusinessHoursRequest\x1a&.rival.api.v1.GetBusinessHoursResponse\x12a\n" +
+	"\x10SetBusinessHours\x12%.rival.api.v1.SetBusinessHoursRequest\x1a&.rival.api.v1.GetBusinessHoursResponse\x12O\n" +
+	"\n" +
+	"AddClosure\x12\x1f.rival.api.v1.AddClosureRequest\x1a .rival.api.v1.AddClosureResponse\x12X\n" +
+	"\rDeleteClosure\x12\".rival.api.v1.DeleteClosureRequest\x1a#.rival.api.v1.DeleteClosureResponse\x12R\n" +
+	"\vPauseOrders\x12 .rival.api.v1.PauseOrdersRequest\x1a!.rival.api.v1.PauseOrdersResponse\x12O\n" +
+	"\n" +
+	"GetCatalog\x12\x1f.rival.api.v1.GetCatalogRequest\x1a .rival.api.v1.GetCatalogResponse\x12j\n" +
+	"\x15CreateCatalogCategory\x12*.rival.api.v1.CreateCatalogCategoryRequest\x1a%.rival.api.v1.CatalogCategoryResponse\x12j\n" +
+	"\x15UpdateCatalogCategory\x12*.rival.api.v1.UpdateCatalogCategoryRequest\x1a%.rival.api.v1.CatalogCategoryResponse\x12p\n" +
+	"\x15DeleteCatalogCategory\x12*.rival.api.v1.DeleteCatalogCategoryRequest\x1a+.rival.api.v1.DeleteCatalogCategoryResponse\x12^\n" +
+	"\x11CreateCatalogItem\x12&.rival.api.v1.CreateCatalogItemRequest\x1a!.rival.api.v1.CatalogItemResponse\x12^\n" +
+	"\x11UpdateCatalogItem\x12&.rival.api.v1.UpdateCatalogItemRequest\x1a!.rival.api.v1.CatalogItemResponse\x12d\n" +
+	"\x11DeleteCatalogItem\x12&.rival.api.v1.DeleteCatalogItemRequest\x1a'.rival.api.v1.DeleteCatalogItemResponse\x12s\n" +
+	"\x16SetCatalogAvailability\x12+.rival.api.v1.SetCatalogAvailabilityRequest\x1a,.rival.api.v1.SetCatalogAvailabilityResponse\x12|\n" +
+	"\x19RequestCatalogImageUpload\x12..rival.api.v1.RequestCatalogImageUploadRequest\x1a/.rival.api.v1.RequestCatalogImageUploadResponse\x12n\n" +
+	"\x19ConfirmCatalogImageUpload\x12..rival.api.v1.ConfirmCatalogImageUploadRequest\x1a!.rival.api.v1.CatalogItemResponse\x12R\n" +
+	"\vInviteStaff\x12 .rival.api.v1.InviteStaffRequest\x1a!.rival.api.v1.InviteStaffResponse\x12p\n" +
+	"\x15AcceptStaffInvitation\x12*.rival.api.v1.AcceptStaffInvitationRequest\x1a+.rival.api.v1.AcceptStaffInvitationResponse\x12p\n" +
+	"\x15RevokeStaffInvitation\x12*.rival.api.v1.RevokeStaffInvitationRequest\x1a+.rival.api.v1.RevokeStaffInvitationResponse\x12L\n" +
+	"\tListStaff\x12\x1e.rival.api.v1.ListStaffRequest\x1a\x1f.rival.api.v1.ListStaffResponse\x12R\n" +
+	"\vUpdateStaff\x12 .rival.api.v1.UpdateStaffRequest\x1a!.rival.api.v1.UpdateStaffResponse\x12R\n" +
+	"\vRemoveStaff\x12 .rival.api.v1.RemoveStaffRequest\x1a!.rival.api.v1.RemoveStaffResponse\x12d\n" +
+	"\x11ListMyMemberships\x12&.rival.api.v1.ListMyMembershipsRequest\x1a'.rival.api.v1.ListMyMembershipsResponseB\x1bZ\x19rival/gen/proto/proto/apib\x06proto3"
 
 var (
 	file_proto_api_merchants_proto_rawDescOnce sync.Once
@@ -1781,89 +6218,281 @@ func file_proto_api_merchants_proto_rawDescGZIP() []byte {
 	return file_proto_api_merchants_proto_rawDescData
 }
 
-var file_proto_api_merchants_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_proto_api_merchants_proto_msgTypes = make([]protoimpl.MessageInfo, 103)
 var file_proto_api_merchants_proto_goTypes = []any{
-	(*GetMerchantRequest)(nil),            // 0: rival.api.v1.GetMerchantRequest
-	(*GetMerchantResponse)(nil),           // 1: rival.api.v1.GetMerchantResponse
-	(*UpdateMerchantRequest)(nil),         // 2: rival.api.v1.UpdateMerchantRequest
-	(*UpdateMerchantResponse)(nil),        // 3: rival.api.v1.UpdateMerchantResponse
-	(*GetMerchantAddressRequest)(nil),     // 4: rival.api.v1.GetMerchantAddressRequest
-	(*GetMerchantAddressResponse)(nil),    // 5: rival.api.v1.GetMerchantAddressResponse
-	(*UpdateMerchantAddressRequest)(nil),  // 6: rival.api.v1.UpdateMerchantAddressRequest
-	(*UpdateMerchantAddressResponse)(nil), // 7: rival.api.v1.UpdateMerchantAddressResponse
-	(*GetOrdersRequest)(nil),              // 8: rival.api.v1.GetOrdersRequest
-	(*GetOrdersResponse)(nil),             // 9: rival.api.v1.GetOrdersResponse
-	(*UpdateOrderStatusRequest)(nil),      // 10: rival.api.v1.UpdateOrderStatusRequest
-	(*UpdateOrderStatusResponse)(nil),     // 11: rival.api.v1.UpdateOrderStatusResponse
-	(*GetCustomersRequest)(nil),           // 12: rival.api.v1.GetCustomersRequest
-	(*GetCustomersResponse)(nil),          // 13: rival.api.v1.GetCustomersResponse
-	(*GetPayoutsRequest)(nil),             // 14: rival.api.v1.GetPayoutsRequest
-	(*GetPayoutsResponse)(nil),            // 15: rival.api.v1.GetPayoutsResponse
-	(*CreateOfferRequest)(nil),            // 16: rival.api.v1.CreateOfferRequest
-	(*CreateOfferResponse)(nil),           // 17: rival.api.v1.CreateOfferResponse
-	(*GetOffersRequest)(nil),              // 18: rival.api.v1.GetOffersRequest
-	(*GetOffersResponse)(nil),             // 19: rival.api.v1.GetOffersResponse
-	(*UpdateOfferRequest)(nil),            // 20: rival.api.v1.UpdateOfferRequest
-	(*UpdateOfferResponse)(nil),           // 21: rival.api.v1.UpdateOfferResponse
-	(*GetDashboardStatsRequest)(nil),      // 22: rival.api.v1.GetDashboardStatsRequest
-	(*GetDashboardStatsResponse)(nil),     // 23: rival.api.v1.GetDashboardStatsResponse
-	(*StreamOrdersRequest)(nil),           // 24: rival.api.v1.StreamOrdersRequest
-	(*StreamOrdersResponse)(nil),          // 25: rival.api.v1.StreamOrdersResponse
-	(*StreamNotificationsRequest)(nil),    // 26: rival.api.v1.StreamNotificationsRequest
-	(*StreamNotificationsResponse)(nil),   // 27: rival.api.v1.StreamNotificationsResponse
-	(*schema.Merchant)(nil),               // 28: rival.schema.v1.Merchant
-	(*schema.MerchantAddress)(nil),        // 29: rival.schema.v1.MerchantAddress
-	(*schema.Order)(nil),                  // 30: rival.schema.v1.Order
-	(*schema.User)(nil),                   // 31: rival.schema.v1.User
-	(*schema.Settlement)(nil),             // 32: rival.schema.v1.Settlement
-	(*schema.Offer)(nil),                  // 33: rival.schema.v1.Offer
+	(*GetMerchantRequest)(nil),                         // 0: rival.api.v1.GetMerchantRequest
+	(*GetMerchantResponse)(nil),                        // 1: rival.api.v1.GetMerchantResponse
+	(*UpdateMerchantRequest)(nil),                      // 2: rival.api.v1.UpdateMerchantRequest
+	(*UpdateMerchantResponse)(nil),                     // 3: rival.api.v1.UpdateMerchantResponse
+	(*GetMerchantAddressRequest)(nil),                  // 4: rival.api.v1.GetMerchantAddressRequest
+	(*GetMerchantAddressResponse)(nil),                 // 5: rival.api.v1.GetMerchantAddressResponse
+	(*UpdateMerchantAddressRequest)(nil),               // 6: rival.api.v1.UpdateMerchantAddressRequest
+	(*UpdateMerchantAddressResponse)(nil),              // 7: rival.api.v1.UpdateMerchantAddressResponse
+	(*AddMerchantAddressRequest)(nil),                  // 8: rival.api.v1.AddMerchantAddressRequest
+	(*AddMerchantAddressResponse)(nil),                 // 9: rival.api.v1.AddMerchantAddressResponse
+	(*DeleteMerchantAddressRequest)(nil),               // 10: rival.api.v1.DeleteMerchantAddressRequest
+	(*DeleteMerchantAddressResponse)(nil),              // 11: rival.api.v1.DeleteMerchantAddressResponse
+	(*SetPrimaryMerchantAddressRequest)(nil),           // 12: rival.api.v1.SetPrimaryMerchantAddressRequest
+	(*SetPrimaryMerchantAddressResponse)(nil),          // 13: rival.api.v1.SetPrimaryMerchantAddressResponse
+	(*GetOrdersRequest)(nil),                           // 14: rival.api.v1.GetOrdersRequest
+	(*GetOrdersResponse)(nil),                          // 15: rival.api.v1.GetOrdersResponse
+	(*UpdateOrderStatusRequest)(nil),                   // 16: rival.api.v1.UpdateOrderStatusRequest
+	(*UpdateOrderStatusResponse)(nil),                  // 17: rival.api.v1.UpdateOrderStatusResponse
+	(*GetCustomersRequest)(nil),                        // 18: rival.api.v1.GetCustomersRequest
+	(*GetCustomersResponse)(nil),                       // 19: rival.api.v1.GetCustomersResponse
+	(*GetPayoutsRequest)(nil),                          // 20: rival.api.v1.GetPayoutsRequest
+	(*GetPayoutsResponse)(nil),                         // 21: rival.api.v1.GetPayoutsResponse
+	(*CreateOfferRequest)(nil),                         // 22: rival.api.v1.CreateOfferRequest
+	(*CreateOfferResponse)(nil),                        // 23: rival.api.v1.CreateOfferResponse
+	(*GetOffersRequest)(nil),                           // 24: rival.api.v1.GetOffersRequest
+	(*GetOffersResponse)(nil),                          // 25: rival.api.v1.GetOffersResponse
+	(*UpdateOfferRequest)(nil),                         // 26: rival.api.v1.UpdateOfferRequest
+	(*UpdateOfferResponse)(nil),                        // 27: rival.api.v1.UpdateOfferResponse
+	(*GetDashboardStatsRequest)(nil),                   // 28: rival.api.v1.GetDashboardStatsRequest
+	(*GetDashboardStatsResponse)(nil),                  // 29: rival.api.v1.GetDashboardStatsResponse
+	(*StreamOrdersRequest)(nil),                        // 30: rival.api.v1.StreamOrdersRequest
+	(*StreamOrdersResponse)(nil),                       // 31: rival.api.v1.StreamOrdersResponse
+	(*StreamNotificationsRequest)(nil),                 // 32: rival.api.v1.StreamNotificationsRequest
+	(*StreamNotificationsResponse)(nil),                // 33: rival.api.v1.StreamNotificationsResponse
+	(*CreateAPIKeyRequest)(nil),                        // 34: rival.api.v1.CreateAPIKeyRequest
+	(*CreateAPIKeyResponse)(nil),                       // 35: rival.api.v1.CreateAPIKeyResponse
+	(*ListAPIKeysRequest)(nil),                         // 36: rival.api.v1.ListAPIKeysRequest
+	(*ListAPIKeysResponse)(nil),                        // 37: rival.api.v1.ListAPIKeysResponse
+	(*RevokeAPIKeyRequest)(nil),                        // 38: rival.api.v1.RevokeAPIKeyRequest
+	(*RevokeAPIKeyResponse)(nil),                       // 39: rival.api.v1.RevokeAPIKeyResponse
+	(*SubmitForReviewRequest)(nil),                     // 40: rival.api.v1.SubmitForReviewRequest
+	(*SubmitForReviewResponse)(nil),                    // 41: rival.api.v1.SubmitForReviewResponse
+	(*GetOnboardingStatusRequest)(nil),                 // 42: rival.api.v1.GetOnboardingStatusRequest
+	(*GetOnboardingStatusResponse)(nil),                // 43: rival.api.v1.GetOnboardingStatusResponse
+	(*RequestDocumentUploadRequest)(nil),               // 44: rival.api.v1.RequestDocumentUploadRequest
+	(*RequestDocumentUploadResponse)(nil),              // 45: rival.api.v1.RequestDocumentUploadResponse
+	(*ConfirmDocumentUploadRequest)(nil),               // 46: rival.api.v1.ConfirmDocumentUploadRequest
+	(*ConfirmDocumentUploadResponse)(nil),              // 47: rival.api.v1.ConfirmDocumentUploadResponse
+	(*ListDocumentsRequest)(nil),                       // 48: rival.api.v1.ListDocumentsRequest
+	(*ListDocumentsResponse)(nil),                      // 49: rival.api.v1.ListDocumentsResponse
+	(*GetBusinessHoursRequest)(nil),                    // 50: rival.api.v1.GetBusinessHoursRequest
+	(*GetBusinessHoursResponse)(nil),                   // 51: rival.api.v1.GetBusinessHoursResponse
+	(*SetBusinessHoursRequest)(nil),                    // 52: rival.api.v1.SetBusinessHoursRequest
+	(*AddClosureRequest)(nil),                          // 53: rival.api.v1.AddClosureRequest
+	(*AddClosureResponse)(nil),                         // 54: rival.api.v1.AddClosureResponse
+	(*DeleteClosureRequest)(nil),                       // 55: rival.api.v1.DeleteClosureRequest
+	(*DeleteClosureResponse)(nil),                      // 56: rival.api.v1.DeleteClosureResponse
+	(*PauseOrdersRequest)(nil),                         // 57: rival.api.v1.PauseOrdersRequest
+	(*PauseOrdersResponse)(nil),                        // 58: rival.api.v1.PauseOrdersResponse
+	(*GetCatalogRequest)(nil),                          // 59: rival.api.v1.GetCatalogRequest
+	(*GetCatalogResponse)(nil),                         // 60: rival.api.v1.GetCatalogResponse
+	(*CreateCatalogCategoryRequest)(nil),               // 61: rival.api.v1.CreateCatalogCategoryRequest
+	(*UpdateCatalogCategoryRequest)(nil),               // 62: rival.api.v1.UpdateCatalogCategoryRequest
+	(*CatalogCategoryResponse)(nil),                    // 63: rival.api.v1.CatalogCategoryResponse
+	(*DeleteCatalogCategoryRequest)(nil),               // 64: rival.api.v1.DeleteCatalogCategoryRequest
+	(*DeleteCatalogCategoryResponse)(nil),              // 65: rival.api.v1.DeleteCatalogCategoryResponse
+	(*CatalogOptionInput)(nil),                         // 66: rival.api.v1.CatalogOptionInput
+	(*CreateCatalogItemRequest)(nil),                   // 67: rival.api.v1.CreateCatalogItemRequest
+	(*UpdateCatalogItemRequest)(nil),                   // 68: rival.api.v1.UpdateCatalogItemRequest
+	(*CatalogItemResponse)(nil),                        // 69: rival.api.v1.CatalogItemResponse
+	(*DeleteCatalogItemRequest)(nil),                   // 70: rival.api.v1.DeleteCatalogItemRequest
+	(*DeleteCatalogItemResponse)(nil),                  // 71: rival.api.v1.DeleteCatalogItemResponse
+	(*SetCatalogAvailabilityRequest)(nil),              // 72: rival.api.v1.SetCatalogAvailabilityRequest
+	(*SetCatalogAvailabilityResponse)(nil),             // 73: rival.api.v1.SetCatalogAvailabilityResponse
+	(*RequestCatalogImageUploadRequest)(nil),           // 74: rival.api.v1.RequestCatalogImageUploadRequest
+	(*RequestCatalogImageUploadResponse)(nil),          // 75: rival.api.v1.RequestCatalogImageUploadResponse
+	(*ConfirmCatalogImageUploadRequest)(nil),           // 76: rival.api.v1.ConfirmCatalogImageUploadRequest
+	(*InviteStaffRequest)(nil),                         // 77: rival.api.v1.InviteStaffRequest
+	(*InviteStaffResponse)(nil),                        // 78: rival.api.v1.InviteStaffResponse
+	(*AcceptStaffInvitationRequest)(nil),               // 79: rival.api.v1.AcceptStaffInvitationRequest
+	(*AcceptStaffInvitationResponse)(nil),              // 80: rival.api.v1.AcceptStaffInvitationResponse
+	(*RevokeStaffInvitationRequest)(nil),               // 81: rival.api.v1.RevokeStaffInvitationRequest
+	(*RevokeStaffInvitationResponse)(nil),              // 82: rival.api.v1.RevokeStaffInvitationResponse
+	(*ListStaffRequest)(nil),                           // 83: rival.api.v1.ListStaffRequest
+	(*ListStaffResponse)(nil),                          // 84: rival.api.v1.ListStaffResponse
+	(*UpdateStaffRequest)(nil),                         // 85: rival.api.v1.UpdateStaffRequest
+	(*UpdateStaffResponse)(nil),                        // 86: rival.api.v1.UpdateStaffResponse
+	(*RemoveStaffRequest)(nil),                         // 87: rival.api.v1.RemoveStaffRequest
+	(*RemoveStaffResponse)(nil),                        // 88: rival.api.v1.RemoveStaffResponse
+	(*ListMyMembershipsRequest)(nil),                   // 89: rival.api.v1.ListMyMembershipsRequest
+	(*ListMyMembershipsResponse)(nil),                  // 90: rival.api.v1.ListMyMembershipsResponse
+	(*GetOrderReceiptRequest)(nil),                     // 91: rival.api.v1.GetOrderReceiptRequest
+	(*GetOrderReceiptResponse)(nil),                    // 92: rival.api.v1.GetOrderReceiptResponse
+	(*ReplyToReviewRequest)(nil),                       // 93: rival.api.v1.ReplyToReviewRequest
+	(*ReplyToReviewResponse)(nil),                      // 94: rival.api.v1.ReplyToReviewResponse
+	(*ListMerchantNotificationsRequest)(nil),           // 95: rival.api.v1.ListMerchantNotificationsRequest
+	(*ListMerchantNotificationsResponse)(nil),          // 96: rival.api.v1.ListMerchantNotificationsResponse
+	(*MarkMerchantNotificationsReadRequest)(nil),       // 97: rival.api.v1.MarkMerchantNotificationsReadRequest
+	(*MarkMerchantNotificationsReadResponse)(nil),      // 98: rival.api.v1.MarkMerchantNotificationsReadResponse
+	(*GetMerchantUnreadNotificationCountRequest)(nil),  // 99: rival.api.v1.GetMerchantUnreadNotificationCountRequest
+	(*GetMerchantUnreadNotificationCountResponse)(nil), // 100: rival.api.v1.GetMerchantUnreadNotificationCountResponse
+	nil,                                  // 101: rival.api.v1.RequestDocumentUploadResponse.FormDataEntry
+	nil,                                  // 102: rival.api.v1.RequestCatalogImageUploadResponse.FormDataEntry
+	(*schema.Merchant)(nil),              // 103: rival.schema.v1.Merchant
+	(*schema.MerchantAddress)(nil),       // 104: rival.schema.v1.MerchantAddress
+	(*schema.Order)(nil),                 // 105: rival.schema.v1.Order
+	(*schema.User)(nil),                  // 106: rival.schema.v1.User
+	(*schema.Settlement)(nil),            // 107: rival.schema.v1.Settlement
+	(*schema.Offer)(nil),                 // 108: rival.schema.v1.Offer
+	(*schema.MerchantApiKey)(nil),        // 109: rival.schema.v1.MerchantApiKey
+	(*schema.MerchantStatusChange)(nil),  // 110: rival.schema.v1.MerchantStatusChange
+	(*schema.MerchantDocument)(nil),      // 111: rival.schema.v1.MerchantDocument
+	(*schema.BusinessHoursInterval)(nil), // 112: rival.schema.v1.BusinessHoursInterval
+	(*schema.MerchantClosure)(nil),       // 113: rival.schema.v1.MerchantClosure
+	(*schema.CatalogCategory)(nil),       // 114: rival.schema.v1.CatalogCategory
+	(*schema.CatalogItem)(nil),           // 115: rival.schema.v1.CatalogItem
+	(*schema.StaffInvitation)(nil),       // 116: rival.schema.v1.StaffInvitation
+	(*schema.MerchantStaff)(nil),         // 117: rival.schema.v1.MerchantStaff
+	(*schema.Receipt)(nil),               // 118: rival.schema.v1.Receipt
+	(*schema.Review)(nil),                // 119: rival.schema.v1.Review
+	(*schema.Notification)(nil),          // 120: rival.schema.v1.Notification
 }
 var file_proto_api_merchants_proto_depIdxs = []int32{
-	28, // 0: rival.api.v1.GetMerchantResponse.merchant:type_name -> rival.schema.v1.Merchant
-	28, // 1: rival.api.v1.UpdateMerchantResponse.merchant:type_name -> rival.schema.v1.Merchant
-	29, // 2: rival.api.v1.GetMerchantAddressResponse.addresses:type_name -> rival.schema.v1.MerchantAddress
-	29, // 3: rival.api.v1.UpdateMerchantAddressResponse.address:type_name -> rival.schema.v1.MerchantAddress
-	30, // 4: rival.api.v1.GetOrdersResponse.orders:type_name -> rival.schema.v1.Order
-	30, // 5: rival.api.v1.UpdateOrderStatusResponse.order:type_name -> rival.schema.v1.Order
-	31, // 6: rival.api.v1.GetCustomersResponse.customers:type_name -> rival.schema.v1.User
-	32, // 7: rival.api.v1.GetPayoutsResponse.payouts:type_name -> rival.schema.v1.Settlement
-	33, // 8: rival.api.v1.CreateOfferResponse.offer:type_name -> rival.schema.v1.Offer
-	33, // 9: rival.api.v1.GetOffersResponse.offers:type_name -> rival.schema.v1.Offer
-	33, // 10: rival.api.v1.UpdateOfferResponse.offer:type_name -> rival.schema.v1.Offer
-	30, // 11: rival.api.v1.StreamOrdersResponse.order:type_name -> rival.schema.v1.Order
-	0,  // 12: rival.api.v1.MerchantService.GetMerchant:input_type -> rival.api.v1.GetMerchantRequest
-	2,  // 13: rival.api.v1.MerchantService.UpdateMerchant:input_type -> rival.api.v1.UpdateMerchantRequest
-	4,  // 14: rival.api.v1.MerchantService.GetMerchantAddress:input_type -> rival.api.v1.GetMerchantAddressRequest
-	6,  // 15: rival.api.v1.MerchantService.UpdateMerchantAddress:input_type -> rival.api.v1.UpdateMerchantAddressRequest
-	8,  // 16: rival.api.v1.MerchantService.GetOrders:input_type -> rival.api.v1.GetOrdersRequest
-	10, // 17: rival.api.v1.MerchantService.UpdateOrderStatus:input_type -> rival.api.v1.UpdateOrderStatusRequest
-	12, // 18: rival.api.v1.MerchantService.GetCustomers:input_type -> rival.api.v1.GetCustomersRequest
-	14, // 19: rival.api.v1.MerchantService.GetPayouts:input_type -> rival.api.v1.GetPayoutsRequest
-	16, // 20: rival.api.v1.MerchantService.CreateOffer:input_type -> rival.api.v1.CreateOfferRequest
-	18, // 21: rival.api.v1.MerchantService.GetOffers:input_type -> rival.api.v1.GetOffersRequest
-	20, // 22: rival.api.v1.MerchantService.UpdateOffer:input_type -> rival.api.v1.UpdateOfferRequest
-	22, // 23: rival.api.v1.MerchantService.GetDashboardStats:input_type -> rival.api.v1.GetDashboardStatsRequest
-	24, // 24: rival.api.v1.MerchantService.StreamOrders:input_type -> rival.api.v1.StreamOrdersRequest
-	26, // 25: rival.api.v1.MerchantService.StreamNotifications:input_type -> rival.api.v1.StreamNotificationsRequest
-	1,  // 26: rival.api.v1.MerchantService.GetMerchant:output_type -> rival.api.v1.GetMerchantResponse
-	3,  // 27: rival.api.v1.MerchantService.UpdateMerchant:output_type -> rival.api.v1.UpdateMerchantResponse
-	5,  // 28: rival.api.v1.MerchantService.GetMerchantAddress:output_type -> rival.api.v1.GetMerchantAddressResponse
-	7,  // 29: rival.api.v1.MerchantService.UpdateMerchantAddress:output_type -> rival.api.v1.UpdateMerchantAddressResponse
-	9,  // 30: rival.api.v1.MerchantService.GetOrders:output_type -> rival.api.v1.GetOrdersResponse
-	11, // 31: rival.api.v1.MerchantService.UpdateOrderStatus:output_type -> rival.api.v1.UpdateOrderStatusResponse
-	13, // 32: rival.api.v1.MerchantService.GetCustomers:output_type -> rival.api.v1.GetCustomersResponse
-	15, // 33: rival.api.v1.MerchantService.GetPayouts:output_type -> rival.api.v1.GetPayoutsResponse
-	17, // 34: rival.api.v1.MerchantService.CreateOffer:output_type -> rival.api.v1.CreateOfferResponse
-	19, // 35: rival.api.v1.MerchantService.GetOffers:output_type -> rival.api.v1.GetOffersResponse
-	21, // 36: rival.api.v1.MerchantService.UpdateOffer:output_type -> rival.api.v1.UpdateOfferResponse
-	23, // 37: rival.api.v1.MerchantService.GetDashboardStats:output_type -> rival.api.v1.GetDashboardStatsResponse
-	25, // 38: rival.api.v1.MerchantService.StreamOrders:output_type -> rival.api.v1.StreamOrdersResponse
-	27, // 39: rival.api.v1.MerchantService.StreamNotifications:output_type -> rival.api.v1.StreamNotificationsResponse
-	26, // [26:40] is the sub-list for method output_type
-	12, // [12:26] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	103, // 0: rival.api.v1.GetMerchantResponse.merchant:type_name -> rival.schema.v1.Merchant
+	103, // 1: rival.api.v1.UpdateMerchantResponse.merchant:type_name -> rival.schema.v1.Merchant
+	104, // 2: rival.api.v1.GetMerchantAddressResponse.addresses:type_name -> rival.schema.v1.MerchantAddress
+	104, // 3: rival.api.v1.UpdateMerchantAddressResponse.address:type_name -> rival.schema.v1.MerchantAddress
+	104, // 4: rival.api.v1.AddMerchantAddressResponse.address:type_name -> rival.schema.v1.MerchantAddress
+	104, // 5: rival.api.v1.SetPrimaryMerchantAddressResponse.address:type_name -> rival.schema.v1.MerchantAddress
+	105, // 6: rival.api.v1.GetOrdersResponse.orders:type_name -> rival.schema.v1.Order
+	105, // 7: rival.api.v1.UpdateOrderStatusResponse.order:type_name -> rival.schema.v1.Order
+	106, // 8: rival.api.v1.GetCustomersResponse.customers:type_name -> rival.schema.v1.User
+	107, // 9: rival.api.v1.GetPayoutsResponse.payouts:type_name -> rival.schema.v1.Settlement
+	108, // 10: rival.api.v1.CreateOfferResponse.offer:type_name -> rival.schema.v1.Offer
+	108, // 11: rival.api.v1.GetOffersResponse.offers:type_name -> rival.schema.v1.Offer
+	108, // 12: rival.api.v1.UpdateOfferResponse.offer:type_name -> rival.schema.v1.Offer
+	105, // 13: rival.api.v1.StreamOrdersResponse.order:type_name -> rival.schema.v1.Order
+	109, // 14: rival.api.v1.CreateAPIKeyResponse.api_key:type_name -> rival.schema.v1.MerchantApiKey
+	109, // 15: rival.api.v1.ListAPIKeysResponse.api_keys:type_name -> rival.schema.v1.MerchantApiKey
+	103, // 16: rival.api.v1.SubmitForReviewResponse.merchant:type_name -> rival.schema.v1.Merchant
+	110, // 17: rival.api.v1.GetOnboardingStatusResponse.history:type_name -> rival.schema.v1.MerchantStatusChange
+	101, // 18: rival.api.v1.RequestDocumentUploadResponse.form_data:type_name -> rival.api.v1.RequestDocumentUploadResponse.FormDataEntry
+	111, // 19: rival.api.v1.ConfirmDocumentUploadResponse.document:type_name -> rival.schema.v1.MerchantDocument
+	111, // 20: rival.api.v1.ListDocumentsResponse.documents:type_name -> rival.schema.v1.MerchantDocument
+	112, // 21: rival.api.v1.GetBusinessHoursResponse.intervals:type_name -> rival.schema.v1.BusinessHoursInterval
+	113, // 22: rival.api.v1.GetBusinessHoursResponse.closures:type_name -> rival.schema.v1.MerchantClosure
+	112, // 23: rival.api.v1.SetBusinessHoursRequest.intervals:type_name -> rival.schema.v1.BusinessHoursInterval
+	113, // 24: rival.api.v1.AddClosureResponse.closure:type_name -> rival.schema.v1.MerchantClosure
+	114, // 25: rival.api.v1.GetCatalogResponse.categories:type_name -> rival.schema.v1.CatalogCategory
+	115, // 26: rival.api.v1.GetCatalogResponse.items:type_name -> rival.schema.v1.CatalogItem
+	114, // 27: rival.api.v1.CatalogCategoryResponse.category:type_name -> rival.schema.v1.CatalogCategory
+	66,  // 28: rival.api.v1.CreateCatalogItemRequest.options:type_name -> rival.api.v1.CatalogOptionInput
+	66,  // 29: rival.api.v1.UpdateCatalogItemRequest.options:type_name -> rival.api.v1.CatalogOptionInput
+	115, // 30: rival.api.v1.CatalogItemResponse.item:type_name -> rival.schema.v1.CatalogItem
+	102, // 31: rival.api.v1.RequestCatalogImageUploadResponse.form_data:type_name -> rival.api.v1.RequestCatalogImageUploadResponse.FormDataEntry
+	116, // 32: rival.api.v1.InviteStaffResponse.invitation:type_name -> rival.schema.v1.StaffInvitation
+	117, // 33: rival.api.v1.AcceptStaffInvitationResponse.membership:type_name -> rival.schema.v1.MerchantStaff
+	117, // 34: rival.api.v1.ListStaffResponse.staff:type_name -> rival.schema.v1.MerchantStaff
+	116, // 35: rival.api.v1.ListStaffResponse.pending_invitations:type_name -> rival.schema.v1.StaffInvitation
+	117, // 36: rival.api.v1.UpdateStaffResponse.staff:type_name -> rival.schema.v1.MerchantStaff
+	117, // 37: rival.api.v1.ListMyMembershipsResponse.memberships:type_name -> rival.schema.v1.MerchantStaff
+	118, // 38: rival.api.v1.GetOrderReceiptResponse.receipt:type_name -> rival.schema.v1.Receipt
+	119, // 39: rival.api.v1.ReplyToReviewResponse.review:type_name -> rival.schema.v1.Review
+	120, // 40: rival.api.v1.ListMerchantNotificationsResponse.notifications:type_name -> rival.schema.v1.Notification
+	0,   // 41: rival.api.v1.MerchantService.GetMerchant:input_type -> rival.api.v1.GetMerchantRequest
+	2,   // 42: rival.api.v1.MerchantService.UpdateMerchant:input_type -> rival.api.v1.UpdateMerchantRequest
+	4,   // 43: rival.api.v1.MerchantService.GetMerchantAddress:input_type -> rival.api.v1.GetMerchantAddressRequest
+	6,   // 44: rival.api.v1.MerchantService.UpdateMerchantAddress:input_type -> rival.api.v1.UpdateMerchantAddressRequest
+	8,   // 45: rival.api.v1.MerchantService.AddMerchantAddress:input_type -> rival.api.v1.AddMerchantAddressRequest
+	10,  // 46: rival.api.v1.MerchantService.DeleteMerchantAddress:input_type -> rival.api.v1.DeleteMerchantAddressRequest
+	12,  // 47: rival.api.v1.MerchantService.SetPrimaryMerchantAddress:input_type -> rival.api.v1.SetPrimaryMerchantAddressRequest
+	14,  // 48: rival.api.v1.MerchantService.GetOrders:input_type -> rival.api.v1.GetOrdersRequest
+	16,  // 49: rival.api.v1.MerchantService.UpdateOrderStatus:input_type -> rival.api.v1.UpdateOrderStatusRequest
+	91,  // 50: rival.api.v1.MerchantService.GetOrderReceipt:input_type -> rival.api.v1.GetOrderReceiptRequest
+	93,  // 51: rival.api.v1.MerchantService.ReplyToReview:input_type -> rival.api.v1.ReplyToReviewRequest
+	18,  // 52: rival.api.v1.MerchantService.GetCustomers:input_type -> rival.api.v1.GetCustomersRequest
+	20,  // 53: rival.api.v1.MerchantService.GetPayouts:input_type -> rival.api.v1.GetPayoutsRequest
+	22,  // 54: rival.api.v1.MerchantService.CreateOffer:input_type -> rival.api.v1.CreateOfferRequest
+	24,  // 55: rival.api.v1.MerchantService.GetOffers:input_type -> rival.api.v1.GetOffersRequest
+	26,  // 56: rival.api.v1.MerchantService.UpdateOffer:input_type -> rival.api.v1.UpdateOfferRequest
+	28,  // 57: rival.api.v1.MerchantService.GetDashboardStats:input_type -> rival.api.v1.GetDashboardStatsRequest
+	30,  // 58: rival.api.v1.MerchantService.StreamOrders:input_type -> rival.api.v1.StreamOrdersRequest
+	32,  // 59: rival.api.v1.MerchantService.StreamNotifications:input_type -> rival.api.v1.StreamNotificationsRequest
+	95,  // 60: rival.api.v1.MerchantService.ListNotifications:input_type -> rival.api.v1.ListMerchantNotificationsRequest
+	97,  // 61: rival.api.v1.MerchantService.MarkNotificationsRead:input_type -> rival.api.v1.MarkMerchantNotificationsReadRequest
+	99,  // 62: rival.api.v1.MerchantService.GetUnreadNotificationCount:input_type -> rival.api.v1.GetMerchantUnreadNotificationCountRequest
+	34,  // 63: rival.api.v1.MerchantService.CreateAPIKey:input_type -> rival.api.v1.CreateAPIKeyRequest
+	36,  // 64: rival.api.v1.MerchantService.ListAPIKeys:input_type -> rival.api.v1.ListAPIKeysRequest
+	38,  // 65: rival.api.v1.MerchantService.RevokeAPIKey:input_type -> rival.api.v1.RevokeAPIKeyRequest
+	40,  // 66: rival.api.v1.MerchantService.SubmitForReview:input_type -> rival.api.v1.SubmitForReviewRequest
+	42,  // 67: rival.api.v1.MerchantService.GetOnboardingStatus:input_type -> rival.api.v1.GetOnboardingStatusRequest
+	44,  // 68: rival.api.v1.MerchantService.RequestDocumentUpload:input_type -> rival.api.v1.RequestDocumentUploadRequest
+	46,  // 69: rival.api.v1.MerchantService.ConfirmDocumentUpload:input_type -> rival.api.v1.ConfirmDocumentUploadRequest
+	48,  // 70: rival.api.v1.MerchantService.ListDocuments:input_type -> rival.api.v1.ListDocumentsRequest
+	50,  // 71: rival.api.v1.MerchantService.GetBusinessHours:input_type -> rival.api.v1.GetBusinessHoursRequest
+	52,  // 72: rival.api.v1.MerchantService.SetBusinessHours:input_type -> rival.api.v1.SetBusinessHoursRequest
+	53,  // 73: rival.api.v1.MerchantService.AddClosure:input_type -> rival.api.v1.AddClosureRequest
+	55,  // 74: rival.api.v1.MerchantService.DeleteClosure:input_type -> rival.api.v1.DeleteClosureRequest
+	57,  // 75: rival.api.v1.MerchantService.PauseOrders:input_type -> rival.api.v1.PauseOrdersRequest
+	59,  // 76: rival.api.v1.MerchantService.GetCatalog:input_type -> rival.api.v1.GetCatalogRequest
+	61,  // 77: rival.api.v1.MerchantService.CreateCatalogCategory:input_type -> rival.api.v1.CreateCatalogCategoryRequest
+	62,  // 78: rival.api.v1.MerchantService.UpdateCatalogCategory:input_type -> rival.api.v1.UpdateCatalogCategoryRequest
+	64,  // 79: rival.api.v1.MerchantService.DeleteCatalogCategory:input_type -> rival.api.v1.DeleteCatalogCategoryRequest
+	67,  // 80: rival.api.v1.MerchantService.CreateCatalogItem:input_type -> rival.api.v1.CreateCatalogItemRequest
+	68,  // 81: rival.api.v1.MerchantService.UpdateCatalogItem:input_type -> rival.api.v1.UpdateCatalogItemRequest
+	70,  // 82: rival.api.v1.MerchantService.DeleteCatalogItem:input_type -> rival.api.v1.DeleteCatalogItemRequest
+	72,  // 83: rival.api.v1.MerchantService.SetCatalogAvailability:input_type -> rival.api.v1.SetCatalogAvailabilityRequest
+	74,  // 84: rival.api.v1.MerchantService.RequestCatalogImageUpload:input_type -> rival.api.v1.RequestCatalogImageUploadRequest
+	76,  // 85: rival.api.v1.MerchantService.ConfirmCatalogImageUpload:input_type -> rival.api.v1.ConfirmCatalogImageUploadRequest
+	77,  // 86: rival.api.v1.MerchantService.InviteStaff:input_type -> rival.api.v1.InviteStaffRequest
+	79,  // 87: rival.api.v1.MerchantService.AcceptStaffInvitation:input_type -> rival.api.v1.AcceptStaffInvitationRequest
+	81,  // 88: rival.api.v1.MerchantService.RevokeStaffInvitation:input_type -> rival.api.v1.RevokeStaffInvitationRequest
+	83,  // 89: rival.api.v1.MerchantService.ListStaff:input_type -> rival.api.v1.ListStaffRequest
+	85,  // 90: rival.api.v1.MerchantService.UpdateStaff:input_type -> rival.api.v1.UpdateStaffRequest
+	87,  // 91: rival.api.v1.MerchantService.RemoveStaff:input_type -> rival.api.v1.RemoveStaffRequest
+	89,  // 92: rival.api.v1.MerchantService.ListMyMemberships:input_type -> rival.api.v1.ListMyMembershipsRequest
+	1,   // 93: rival.api.v1.MerchantService.GetMerchant:output_type -> rival.api.v1.GetMerchantResponse
+	3,   // 94: rival.api.v1.MerchantService.UpdateMerchant:output_type -> rival.api.v1.UpdateMerchantResponse
+	5,   // 95: rival.api.v1.MerchantService.GetMerchantAddress:output_type -> rival.api.v1.GetMerchantAddressResponse
+	7,   // 96: rival.api.v1.MerchantService.UpdateMerchantAddress:output_type -> rival.api.v1.UpdateMerchantAddressResponse
+	9,   // 97: rival.api.v1.MerchantService.AddMerchantAddress:output_type -> rival.api.v1.AddMerchantAddressResponse
+	11,  // 98: rival.api.v1.MerchantService.DeleteMerchantAddress:output_type -> rival.api.v1.DeleteMerchantAddressResponse
+	13,  // 99: rival.api.v1.MerchantService.SetPrimaryMerchantAddress:output_type -> rival.api.v1.SetPrimaryMerchantAddressResponse
+	15,  // 100: rival.api.v1.MerchantService.GetOrders:output_type -> rival.api.v1.GetOrdersResponse
+	17,  // 101: rival.api.v1.MerchantService.UpdateOrderStatus:output_type -> rival.api.v1.UpdateOrderStatusResponse
+	92,  // 102: rival.api.v1.MerchantService.GetOrderReceipt:output_type -> rival.api.v1.GetOrderReceiptResponse
+	94,  // 103: rival.api.v1.MerchantService.ReplyToReview:output_type -> rival.api.v1.ReplyToReviewResponse
+	19,  // 104: rival.api.v1.MerchantService.GetCustomers:output_type -> rival.api.v1.GetCustomersResponse
+	21,  // 105: rival.api.v1.MerchantService.GetPayouts:output_type -> rival.api.v1.GetPayoutsResponse
+	23,  // 106: rival.api.v1.MerchantService.CreateOffer:output_type -> rival.api.v1.CreateOfferResponse
+	25,  // 107: rival.api.v1.MerchantService.GetOffers:output_type -> rival.api.v1.GetOffersResponse
+	27,  // 108: rival.api.v1.MerchantService.UpdateOffer:output_type -> rival.api.v1.UpdateOfferResponse
+	29,  // 109: rival.api.v1.MerchantService.GetDashboardStats:output_type -> rival.api.v1.GetDashboardStatsResponse
+	31,  // 110: rival.api.v1.MerchantService.StreamOrders:output_type -> rival.api.v1.StreamOrdersResponse
+	33,  // 111: rival.api.v1.MerchantService.StreamNotifications:output_type -> rival.api.v1.StreamNotificationsResponse
+	96,  // 112: rival.api.v1.MerchantService.ListNotifications:output_type -> rival.api.v1.ListMerchantNotificationsResponse
+	98,  // 113: rival.api.v1.MerchantService.MarkNotificationsRead:output_type -> rival.api.v1.MarkMerchantNotificationsReadResponse
+	100, // 114: rival.api.v1.MerchantService.GetUnreadNotificationCount:output_type -> rival.api.v1.GetMerchantUnreadNotificationCountResponse
+	35,  // 115: rival.api.v1.MerchantService.CreateAPIKey:output_type -> rival.api.v1.CreateAPIKeyResponse
+	37,  // 116: rival.api.v1.MerchantService.ListAPIKeys:output_type -> rival.api.v1.ListAPIKeysResponse
+	39,  // 117: rival.api.v1.MerchantService.RevokeAPIKey:output_type -> rival.api.v1.RevokeAPIKeyResponse
+	41,  // 118: rival.api.v1.MerchantService.SubmitForReview:output_type -> rival.api.v1.SubmitForReviewResponse
+	43,  // 119: rival.api.v1.MerchantService.GetOnboardingStatus:output_type -> rival.api.v1.GetOnboardingStatusResponse
+	45,  // 120: rival.api.v1.MerchantService.RequestDocumentUpload:output_type -> rival.api.v1.RequestDocumentUploadResponse
+	47,  // 121: rival.api.v1.MerchantService.ConfirmDocumentUpload:output_type -> rival.api.v1.ConfirmDocumentUploadResponse
+	49,  // 122: rival.api.v1.MerchantService.ListDocuments:output_type -> rival.api.v1.ListDocumentsResponse
+	51,  // 123: rival.api.v1.MerchantService.GetBusinessHours:output_type -> rival.api.v1.GetBusinessHoursResponse
+	51,  // 124: rival.api.v1.MerchantService.SetBusinessHours:output_type -> rival.api.v1.GetBusinessHoursResponse
+	54,  // 125: rival.api.v1.MerchantService.AddClosure:output_type -> rival.api.v1.AddClosureResponse
+	56,  // 126: rival.api.v1.MerchantService.DeleteClosure:output_type -> rival.api.v1.DeleteClosureResponse
+	58,  // 127: rival.api.v1.MerchantService.PauseOrders:output_type -> rival.api.v1.PauseOrdersResponse
+	60,  // 128: rival.api.v1.MerchantService.GetCatalog:output_type -> rival.api.v1.GetCatalogResponse
+	63,  // 129: rival.api.v1.MerchantService.CreateCatalogCategory:output_type -> rival.api.v1.CatalogCategoryResponse
+	63,  // 130: rival.api.v1.MerchantService.UpdateCatalogCategory:output_type -> rival.api.v1.CatalogCategoryResponse
+	65,  // 131: rival.api.v1.MerchantService.DeleteCatalogCategory:output_type -> rival.api.v1.DeleteCatalogCategoryResponse
+	69,  // 132: rival.api.v1.MerchantService.CreateCatalogItem:output_type -> rival.api.v1.CatalogItemResponse
+	69,  // 133: rival.api.v1.MerchantService.UpdateCatalogItem:output_type -> rival.api.v1.CatalogItemResponse
+	71,  // 134: rival.api.v1.MerchantService.DeleteCatalogItem:output_type -> rival.api.v1.DeleteCatalogItemResponse
+	73,  // 135: rival.api.v1.MerchantService.SetCatalogAvailability:output_type -> rival.api.v1.SetCatalogAvailabilityResponse
+	75,  // 136: rival.api.v1.MerchantService.RequestCatalogImageUpload:output_type -> rival.api.v1.RequestCatalogImageUploadResponse
+	69,  // 137: rival.api.v1.MerchantService.ConfirmCatalogImageUpload:output_type -> rival.api.v1.CatalogItemResponse
+	78,  // 138: rival.api.v1.MerchantService.InviteStaff:output_type -> rival.api.v1.InviteStaffResponse
+	80,  // 139: rival.api.v1.MerchantService.AcceptStaffInvitation:output_type -> rival.api.v1.AcceptStaffInvitationResponse
+	82,  // 140: rival.api.v1.MerchantService.RevokeStaffInvitation:output_type -> rival.api.v1.RevokeStaffInvitationResponse
+	84,  // 141: rival.api.v1.MerchantService.ListStaff:output_type -> rival.api.v1.ListStaffResponse
+	86,  // 142: rival.api.v1.MerchantService.UpdateStaff:output_type -> rival.api.v1.UpdateStaffResponse
+	88,  // 143: rival.api.v1.MerchantService.RemoveStaff:output_type -> rival.api.v1.RemoveStaffResponse
+	90,  // 144: rival.api.v1.MerchantService.ListMyMemberships:output_type -> rival.api.v1.ListMyMembershipsResponse
+	93,  // [93:145] is the sub-list for method output_type
+	41,  // [41:93] is the sub-list for method input_type
+	41,  // [41:41] is the sub-list for extension type_name
+	41,  // [41:41] is the sub-list for extension extendee
+	0,   // [0:41] is the sub-list for field type_name
 }
 
 func init() { file_proto_api_merchants_proto_init() }
@@ -1877,7 +6506,7 @@ func file_proto_api_merchants_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_api_merchants_proto_rawDesc), len(file_proto_api_merchants_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   28,
+			NumMessages:   103,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,20 +19,58 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MerchantService_GetMerchant_FullMethodName           = "/rival.api.v1.MerchantService/GetMerchant"
-	MerchantService_UpdateMerchant_FullMethodName        = "/rival.api.v1.MerchantService/UpdateMerchant"
-	MerchantService_GetMerchantAddress_FullMethodName    = "/rival.api.v1.MerchantService/GetMerchantAddress"
-	MerchantService_UpdateMerchantAddress_FullMethodName = "/rival.api.v1.MerchantService/UpdateMerchantAddress"
-	MerchantService_GetOrders_FullMethodName             = "/rival.api.v1.MerchantService/GetOrders"
-	MerchantService_UpdateOrderStatus_FullMethodName     = "/rival.api.v1.MerchantService/UpdateOrderStatus"
-	MerchantService_GetCustomers_FullMethodName          = "/rival.api.v1.MerchantService/GetCustomers"
-	MerchantService_GetPayouts_FullMethodName            = "/rival.api.v1.MerchantService/GetPayouts"
-	MerchantService_CreateOffer_FullMethodName           = "/rival.api.v1.MerchantService/CreateOffer"
-	MerchantService_GetOffers_FullMethodName             = "/rival.api.v1.MerchantService/GetOffers"
-	MerchantService_UpdateOffer_FullMethodName           = "/rival.api.v1.MerchantService/UpdateOffer"
-	MerchantService_GetDashboardStats_FullMethodName     = "/rival.api.v1.MerchantService/GetDashboardStats"
-	MerchantService_StreamOrders_FullMethodName          = "/rival.api.v1.MerchantService/StreamOrders"
-	MerchantService_StreamNotifications_FullMethodName   = "/rival.api.v1.MerchantService/StreamNotifications"
+	MerchantService_GetMerchant_FullMethodName                = "/rival.api.v1.MerchantService/GetMerchant"
+	MerchantService_UpdateMerchant_FullMethodName             = "/rival.api.v1.MerchantService/UpdateMerchant"
+	MerchantService_GetMerchantAddress_FullMethodName         = "/rival.api.v1.MerchantService/GetMerchantAddress"
+	MerchantService_UpdateMerchantAddress_FullMethodName      = "/rival.api.v1.MerchantService/UpdateMerchantAddress"
+	MerchantService_AddMerchantAddress_FullMethodName         = "/rival.api.v1.MerchantService/AddMerchantAddress"
+	MerchantService_DeleteMerchantAddress_FullMethodName      = "/rival.api.v1.MerchantService/DeleteMerchantAddress"
+	MerchantService_SetPrimaryMerchantAddress_FullMethodName  = "/rival.api.v1.MerchantService/SetPrimaryMerchantAddress"
+	MerchantService_GetOrders_FullMethodName                  = "/rival.api.v1.MerchantService/GetOrders"
+	MerchantService_UpdateOrderStatus_FullMethodName          = "/rival.api.v1.MerchantService/UpdateOrderStatus"
+	MerchantService_GetOrderReceipt_FullMethodName            = "/rival.api.v1.MerchantService/GetOrderReceipt"
+	MerchantService_ReplyToReview_FullMethodName              = "/rival.api.v1.MerchantService/ReplyToReview"
+	MerchantService_GetCustomers_FullMethodName               = "/rival.api.v1.MerchantService/GetCustomers"
+	MerchantService_GetPayouts_FullMethodName                 = "/rival.api.v1.MerchantService/GetPayouts"
+	MerchantService_CreateOffer_FullMethodName                = "/rival.api.v1.MerchantService/CreateOffer"
+	MerchantService_GetOffers_FullMethodName                  = "/rival.api.v1.MerchantService/GetOffers"
+	MerchantService_UpdateOffer_FullMethodName                = "/rival.api.v1.MerchantService/UpdateOffer"
+	MerchantService_GetDashboardStats_FullMethodName          = "/rival.api.v1.MerchantService/GetDashboardStats"
+	MerchantService_StreamOrders_FullMethodName               = "/rival.api.v1.MerchantService/StreamOrders"
+	MerchantService_StreamNotifications_FullMethodName        = "/rival.api.v1.MerchantService/StreamNotifications"
+	MerchantService_ListNotifications_FullMethodName          = "/rival.api.v1.MerchantService/ListNotifications"
+	MerchantService_MarkNotificationsRead_FullMethodName      = "/rival.api.v1.MerchantService/MarkNotificationsRead"
+	MerchantService_GetUnreadNotificationCount_FullMethodName = "/rival.api.v1.MerchantService/GetUnreadNotificationCount"
+	MerchantService_CreateAPIKey_FullMethodName               = "/rival.api.v1.MerchantService/CreateAPIKey"
+	MerchantService_ListAPIKeys_FullMethodName                = "/rival.api.v1.MerchantService/ListAPIKeys"
+	MerchantService_RevokeAPIKey_FullMethodName               = "/rival.api.v1.MerchantService/RevokeAPIKey"
+	MerchantService_SubmitForReview_FullMethodName            = "/rival.api.v1.MerchantService/SubmitForReview"
+	MerchantService_GetOnboardingStatus_FullMethodName        = "/rival.api.v1.MerchantService/GetOnboardingStatus"
+	MerchantService_RequestDocumentUpload_FullMethodName      = "/rival.api.v1.MerchantService/RequestDocumentUpload"
+	MerchantService_ConfirmDocumentUpload_FullMethodName      = "/rival.api.v1.MerchantService/ConfirmDocumentUpload"
+	MerchantService_ListDocuments_FullMethodName              = "/rival.api.v1.MerchantService/ListDocuments"
+	MerchantService_GetBusinessHours_FullMethodName           = "/rival.api.v1.MerchantService/GetBusinessHours"
+	MerchantService_SetBusinessHours_FullMethodName           = "/rival.api.v1.MerchantService/SetBusinessHours"
+	MerchantService_AddClosure_FullMethodName                 = "/rival.api.v1.MerchantService/AddClosure"
+	MerchantService_DeleteClosure_FullMethodName              = "/rival.api.v1.MerchantService/DeleteClosure"
+	MerchantService_PauseOrders_FullMethodName                = "/rival.api.v1.MerchantService/PauseOrders"
+	MerchantService_GetCatalog_FullMethodName                 = "/rival.api.v1.MerchantService/GetCatalog"
+	MerchantService_CreateCatalogCategory_FullMethodName      = "/rival.api.v1.MerchantService/CreateCatalogCategory"
+	MerchantService_UpdateCatalogCategory_FullMethodName      = "/rival.api.v1.MerchantService/UpdateCatalogCategory"
+	MerchantService_DeleteCatalogCategory_FullMethodName      = "/rival.api.v1.MerchantService/DeleteCatalogCategory"
+	MerchantService_CreateCatalogItem_FullMethodName          = "/rival.api.v1.MerchantService/CreateCatalogItem"
+	MerchantService_UpdateCatalogItem_FullMethodName          = "/rival.api.v1.MerchantService/UpdateCatalogItem"
+	MerchantService_DeleteCatalogItem_FullMethodName          = "/rival.api.v1.MerchantService/DeleteCatalogItem"
+	MerchantService_SetCatalogAvailability_FullMethodName     = "/rival.api.v1.MerchantService/SetCatalogAvailability"
+	MerchantService_RequestCatalogImageUpload_FullMethodName  = "/rival.api.v1.MerchantService/RequestCatalogImageUpload"
+	MerchantService_ConfirmCatalogImageUpload_FullMethodName  = "/rival.api.v1.MerchantService/ConfirmCatalogImageUpload"
+	MerchantService_InviteStaff_FullMethodName                = "/rival.api.v1.MerchantService/InviteStaff"
+	MerchantService_AcceptStaffInvitation_FullMethodName      = "/rival.api.v1.MerchantService/AcceptStaffInvitation"
+	MerchantService_RevokeStaffInvitation_FullMethodName      = "/rival.api.v1.MerchantService/RevokeStaffInvitation"
+	MerchantService_ListStaff_FullMethodName                  = "/rival.api.v1.MerchantService/ListStaff"
+	MerchantService_UpdateStaff_FullMethodName                = "/rival.api.v1.MerchantService/UpdateStaff"
+	MerchantService_RemoveStaff_FullMethodName                = "/rival.api.v1.MerchantService/RemoveStaff"
+	MerchantService_ListMyMemberships_FullMethodName          = "/rival.api.v1.MerchantService/ListMyMemberships"
 )
 
 // MerchantServiceClient is the client API for MerchantService service.
@@ -43,8 +81,13 @@ type MerchantServiceClient interface {
 	UpdateMerchant(ctx context.Context, in *UpdateMerchantRequest, opts ...grpc.CallOption) (*UpdateMerchantResponse, error)
 	GetMerchantAddress(ctx context.Context, in *GetMerchantAddressRequest, opts ...grpc.CallOption) (*GetMerchantAddressResponse, error)
 	UpdateMerchantAddress(ctx context.Context, in *UpdateMerchantAddressRequest, opts ...grpc.CallOption) (*UpdateMerchantAddressResponse, error)
+	AddMerchantAddress(ctx context.Context, in *AddMerchantAddressRequest, opts ...grpc.CallOption) (*AddMerchantAddressResponse, error)
+	DeleteMerchantAddress(ctx context.Context, in *DeleteMerchantAddressRequest, opts ...grpc.CallOption) (*DeleteMerchantAddressResponse, error)
+	SetPrimaryMerchantAddress(ctx context.Context, in *SetPrimaryMerchantAddressRequest, opts ...grpc.CallOption) (*SetPrimaryMerchantAddressResponse, error)
 	GetOrders(ctx context.Context, in *GetOrdersRequest, opts ...grpc.CallOption) (*GetOrdersResponse, error)
 	UpdateOrderStatus(ctx context.Context, in *UpdateOrderStatusRequest, opts ...grpc.CallOption) (*UpdateOrderStatusResponse, error)
+	GetOrderReceipt(ctx context.Context, in *GetOrderReceiptRequest, opts ...grpc.CallOption) (*GetOrderReceiptResponse, error)
+	ReplyToReview(ctx context.Context, in *ReplyToReviewRequest, opts ...grpc.CallOption) (*ReplyToReviewResponse, error)
 	GetCustomers(ctx context.Context, in *GetCustomersRequest, opts ...grpc.CallOption) (*GetCustomersResponse, error)
 	GetPayouts(ctx context.Context, in *GetPayoutsRequest, opts ...grpc.CallOption) (*GetPayoutsResponse, error)
 	CreateOffer(ctx context.Context, in *CreateOfferRequest, opts ...grpc.CallOption) (*CreateOfferResponse, error)
@@ -53,6 +96,45 @@ type MerchantServiceClient interface {
 	GetDashboardStats(ctx context.Context, in *GetDashboardStatsRequest, opts ...grpc.CallOption) (*GetDashboardStatsResponse, error)
 	StreamOrders(ctx context.Context, in *StreamOrdersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamOrdersResponse], error)
 	StreamNotifications(ctx context.Context, in *StreamNotificationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamNotificationsResponse], error)
+	ListNotifications(ctx context.Context, in *ListMerchantNotificationsRequest, opts ...grpc.CallOption) (*ListMerchantNotificationsResponse, error)
+	MarkNotificationsRead(ctx context.Context, in *MarkMerchantNotificationsReadRequest, opts ...grpc.CallOption) (*MarkMerchantNotificationsReadResponse, error)
+	GetUnreadNotificationCount(ctx context.Context, in *GetMerchantUnreadNotificationCountRequest, opts ...grpc.CallOption) (*GetMerchantUnreadNotificationCountResponse, error)
+	// API keys for POS / server-to-server integrations
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error)
+	ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error)
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error)
+	// Onboarding
+	SubmitForReview(ctx context.Context, in *SubmitForReviewRequest, opts ...grpc.CallOption) (*SubmitForReviewResponse, error)
+	GetOnboardingStatus(ctx context.Context, in *GetOnboardingStatusRequest, opts ...grpc.CallOption) (*GetOnboardingStatusResponse, error)
+	// KYC documents
+	RequestDocumentUpload(ctx context.Context, in *RequestDocumentUploadRequest, opts ...grpc.CallOption) (*RequestDocumentUploadResponse, error)
+	ConfirmDocumentUpload(ctx context.Context, in *ConfirmDocumentUploadRequest, opts ...grpc.CallOption) (*ConfirmDocumentUploadResponse, error)
+	ListDocuments(ctx context.Context, in *ListDocumentsRequest, opts ...grpc.CallOption) (*ListDocumentsResponse, error)
+	// Business hours
+	GetBusinessHours(ctx context.Context, in *GetBusinessHoursRequest, opts ...grpc.CallOption) (*GetBusinessHoursResponse, error)
+	SetBusinessHours(ctx context.Context, in *SetBusinessHoursRequest, opts ...grpc.CallOption) (*GetBusinessHoursResponse, error)
+	AddClosure(ctx context.Context, in *AddClosureRequest, opts ...grpc.CallOption) (*AddClosureResponse, error)
+	DeleteClosure(ctx context.Context, in *DeleteClosureRequest, opts ...grpc.CallOption) (*DeleteClosureResponse, error)
+	PauseOrders(ctx context.Context, in *PauseOrdersRequest, opts ...grpc.CallOption) (*PauseOrdersResponse, error)
+	// Catalog
+	GetCatalog(ctx context.Context, in *GetCatalogRequest, opts ...grpc.CallOption) (*GetCatalogResponse, error)
+	CreateCatalogCategory(ctx context.Context, in *CreateCatalogCategoryRequest, opts ...grpc.CallOption) (*CatalogCategoryResponse, error)
+	UpdateCatalogCategory(ctx context.Context, in *UpdateCatalogCategoryRequest, opts ...grpc.CallOption) (*CatalogCategoryResponse, error)
+	DeleteCatalogCategory(ctx context.Context, in *DeleteCatalogCategoryRequest, opts ...grpc.CallOption) (*DeleteCatalogCategoryResponse, error)
+	CreateCatalogItem(ctx context.Context, in *CreateCatalogItemRequest, opts ...grpc.CallOption) (*CatalogItemResponse, error)
+	UpdateCatalogItem(ctx context.Context, in *UpdateCatalogItemRequest, opts ...grpc.CallOption) (*CatalogItemResponse, error)
+	DeleteCatalogItem(ctx context.Context, in *DeleteCatalogItemRequest, opts ...grpc.CallOption) (*DeleteCatalogItemResponse, error)
+	SetCatalogAvailability(ctx context.Context, in *SetCatalogAvailabilityRequest, opts ...grpc.CallOption) (*SetCatalogAvailabilityResponse, error)
+	RequestCatalogImageUpload(ctx context.Context, in *RequestCatalogImageUploadRequest, opts ...grpc.CallOption) (*RequestCatalogImageUploadResponse, error)
+	ConfirmCatalogImageUpload(ctx context.Context, in *ConfirmCatalogImageUploadRequest, opts ...grpc.CallOption) (*CatalogItemResponse, error)
+	// Staff
+	InviteStaff(ctx context.Context, in *InviteStaffRequest, opts ...grpc.CallOption) (*InviteStaffResponse, error)
+	AcceptStaffInvitation(ctx context.Context, in *AcceptStaffInvitationRequest, opts ...grpc.CallOption) (*AcceptStaffInvitationResponse, error)
+	RevokeStaffInvitation(ctx context.Context, in *RevokeStaffInvitationRequest, opts ...grpc.CallOption) (*RevokeStaffInvitationResponse, error)
+	ListStaff(ctx context.Context, in *ListStaffRequest, opts ...grpc.CallOption) (*ListStaffResponse, error)
+	UpdateStaff(ctx context.Context, in *UpdateStaffRequest, opts ...grpc.CallOption) (*UpdateStaffResponse, error)
+	RemoveStaff(ctx context.Context, in *RemoveStaffRequest, opts ...grpc.CallOption) (*RemoveStaffResponse, error)
+	ListMyMemberships(ctx context.Context, in *ListMyMembershipsRequest, opts ...grpc.CallOption) (*ListMyMembershipsResponse, error)
 }
 
 type merchantServiceClient struct {
@@ -103,6 +185,36 @@ func (c *merchantServiceClient) UpdateMerchantAddress(ctx context.Context, in *U
 	return out, nil
 }
 
+func (c *merchantServiceClient) AddMerchantAddress(ctx context.Context, in *AddMerchantAddressRequest, opts ...grpc.CallOption) (*AddMerchantAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddMerchantAddressResponse)
+	err := c.cc.Invoke(ctx, MerchantService_AddMerchantAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantServiceClient) DeleteMerchantAddress(ctx context.Context, in *DeleteMerchantAddressRequest, opts ...grpc.CallOption) (*DeleteMerchantAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMerchantAddressResponse)
+	err := c.cc.Invoke(ctx, MerchantService_DeleteMerchantAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantServiceClient) SetPrimaryMerchantAddress(ctx context.Context, in *SetPrimaryMerchantAddressRequest, opts ...grpc.CallOption) (*SetPrimaryMerchantAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPrimaryMerchantAddressResponse)
+	err := c.cc.Invoke(ctx, MerchantService_SetPrimaryMerchantAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *merchantServiceClient) GetOrders(ctx context.Context, in *GetOrdersRequest, opts ...grpc.CallOption) (*GetOrdersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetOrdersResponse)
@@ -123,6 +235,26 @@ func (c *merchantServiceClient) UpdateOrderStatus(ctx context.Context, in *Updat
 	return out, nil
 }
 
+func (c *merchantServiceClient) GetOrderReceipt(ctx context.Context, in *GetOrderReceiptRequest, opts ...grpc.CallOption) (*GetOrderReceiptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrderReceiptResponse)
+	err := c.cc.Invoke(ctx, MerchantService_GetOrderReceipt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantServiceClient) ReplyToReview(ctx context.Context, in *ReplyToReviewRequest, opts ...grpc.CallOption) (*ReplyToReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplyToReviewResponse)
+	err := c.cc.Invoke(ctx, MerchantService_ReplyToReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *merchantServiceClient) GetCustomers(ctx context.Context, in *GetCustomersRequest, opts ...grpc.CallOption) (*GetCustomersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetCustomersResponse)
@@ -221,334 +353,1506 @@ func (c *merchantServiceClient) StreamNotifications(ctx context.Context, in *Str
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type MerchantService_StreamNotificationsClient = grpc.ServerStreamingClient[StreamNotificationsResponse]
 
-// MerchantServiceServer is the server API for MerchantService service.
-// All implementations must embed UnimplementedMerchantServiceServer
-// for forward compatibility.
-type MerchantServiceServer interface {
-	GetMerchant(context.Context, *GetMerchantRequest) (*GetMerchantResponse, error)
-	UpdateMerchant(context.Context, *UpdateMerchantRequest) (*UpdateMerchantResponse, error)
-	GetMerchantAddress(context.Context, *GetMerchantAddressRequest) (*GetMerchantAddressResponse, error)
-	UpdateMerchantAddress(context.Context, *UpdateMerchantAddressRequest) (*UpdateMerchantAddressResponse, error)
-	GetOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error)
-	UpdateOrderStatus(context.Context, *UpdateOrderStatusRequest) (*UpdateOrderStatusResponse, error)
-	GetCustomers(context.Context, *GetCustomersRequest) (*GetCustomersResponse, error)
-	GetPayouts(context.Context, *GetPayoutsRequest) (*GetPayoutsResponse, error)
-	CreateOffer(context.Context, *CreateOfferRequest) (*CreateOfferResponse, error)
-	GetOffers(context.Context, *GetOffersRequest) (*GetOffersResponse, error)
-	UpdateOffer(context.Context, *UpdateOfferRequest) (*UpdateOfferResponse, error)
-	GetDashboardStats(context.Context, *GetDashboardStatsRequest) (*GetDashboardStatsResponse, error)
-	StreamOrders(*StreamOrdersRequest, grpc.ServerStreamingServer[StreamOrdersResponse]) error
-	StreamNotifications(*StreamNotificationsRequest, grpc.ServerStreamingServer[StreamNotificationsResponse]) error
-	mustEmbedUnimplementedMerchantServiceServer()
+func (c *merchantServiceClient) ListNotifications(ctx context.Context, in *ListMerchantNotificationsRequest, opts ...grpc.CallOption) (*ListMerchantNotificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMerchantNotificationsResponse)
+	err := c.cc.Invoke(ctx, MerchantService_ListNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedMerchantServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedMerchantServiceServer struct{}
+func (c *merchantServiceClient) MarkNotificationsRead(ctx context.Context, in *MarkMerchantNotificationsReadRequest, opts ...grpc.CallOption) (*MarkMerchantNotificationsReadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkMerchantNotificationsReadResponse)
+	err := c.cc.Invoke(ctx, MerchantService_MarkNotificationsRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (UnimplementedMerchantServiceServer) GetMerchant(context.Context, *GetMerchantRequest) (*GetMerchantResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMerchant not implemented")
+func (c *merchantServiceClient) GetUnreadNotificationCount(ctx context.Context, in *GetMerchantUnreadNotificationCountRequest, opts ...grpc.CallOption) (*GetMerchantUnreadNotificationCountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMerchantUnreadNotificationCountResponse)
+	err := c.cc.Invoke(ctx, MerchantService_GetUnreadNotificationCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMerchantServiceServer) UpdateMerchant(context.Context, *UpdateMerchantRequest) (*UpdateMerchantResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateMerchant not implemented")
+
+func (c *merchantServiceClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, MerchantService_CreateAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMerchantServiceServer) GetMerchantAddress(context.Context, *GetMerchantAddressRequest) (*GetMerchantAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMerchantAddress not implemented")
+
+func (c *merchantServiceClient) ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAPIKeysResponse)
+	err := c.cc.Invoke(ctx, MerchantService_ListAPIKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMerchantServiceServer) UpdateMerchantAddress(context.Context, *UpdateMerchantAddressRequest) (*UpdateMerchantAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateMerchantAddress not implemented")
+
+func (c *merchantServiceClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAPIKeyResponse)
+	err := c.cc.Invoke(ctx, MerchantService_RevokeAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMerchantServiceServer) GetOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrders not implemented")
+
+func (c *merchantServiceClient) SubmitForReview(ctx context.Context, in *SubmitForReviewRequest, opts ...grpc.CallOption) (*SubmitForReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitForReviewResponse)
+	err := c.cc.Invoke(ctx, MerchantService_SubmitForReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMerchantServiceServer) UpdateOrderStatus(context.Context, *UpdateOrderStatusRequest) (*UpdateOrderStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrderStatus not implemented")
+
+func (c *merchantServiceClient) GetOnboardingStatus(ctx context.Context, in *GetOnboardingStatusRequest, opts ...grpc.CallOption) (*GetOnboardingStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOnboardingStatusResponse)
+	err := c.cc.Invoke(ctx, MerchantService_GetOnboardingStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMerchantServiceServer) GetCustomers(context.Context, *GetCustomersRequest) (*GetCustomersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCustomers not implemented")
+
+func (c *merchantServiceClient) RequestDocumentUpload(ctx context.Context, in *RequestDocumentUploadRequest, opts ...grpc.CallOption) (*RequestDocumentUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestDocumentUploadResponse)
+	err := c.cc.Invoke(ctx, MerchantService_RequestDocumentUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMerchantServiceServer) GetPayouts(context.Context, *GetPayoutsRequest) (*GetPayoutsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPayouts not implemented")
+
+func (c *merchantServiceClient) ConfirmDocumentUpload(ctx context.Context, in *ConfirmDocumentUploadRequest, opts ...grpc.CallOption) (*ConfirmDocumentUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmDocumentUploadResponse)
+	err := c.cc.Invoke(ctx, MerchantService_ConfirmDocumentUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMerchantServiceServer) CreateOffer(context.Context, *CreateOfferRequest) (*CreateOfferResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateOffer not implemented")
+
+func (c *merchantServiceClient) ListDocuments(ctx context.Context, in *ListDocumentsRequest, opts ...grpc.CallOption) (*ListDocumentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDocumentsResponse)
+	err := c.cc.Invoke(ctx, MerchantService_ListDocuments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMerchantServiceServer) GetOffers(context.Context, *GetOffersRequest) (*GetOffersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOffers not implemented")
+
+func (c *merchantServiceClient) GetBusinessHours(ctx context.Context, in *GetBusinessHoursRequest, opts ...grpc.CallOption) (*GetBusinessHoursResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBusinessHoursResponse)
+	err := c.cc.Invoke(ctx, MerchantService_GetBusinessHours_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMerchantServiceServer) UpdateOffer(context.Context, *UpdateOfferRequest) (*UpdateOfferResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateOffer not implemented")
+
+func (c *merchantServiceClient) SetBusinessHours(ctx context.Context, in *SetBusinessHoursRequest, opts ...grpc.CallOption) (*GetBusinessHoursResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBusinessHoursResponse)
+	err := c.cc.Invoke(ctx, MerchantService_SetBusinessHours_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMerchantServiceServer) GetDashboardStats(context.Context, *GetDashboardStatsRequest) (*GetDashboardStatsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDashboardStats not implemented")
+
+func (c *merchantServiceClient) AddClosure(ctx context.Context, in *AddClosureRequest, opts ...grpc.CallOption) (*AddClosureResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddClosureResponse)
+	err := c.cc.Invoke(ctx, MerchantService_AddClosure_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMerchantServiceServer) StreamOrders(*StreamOrdersRequest, grpc.ServerStreamingServer[StreamOrdersResponse]) error {
-	return status.Errorf(codes.Unimplemented, "method StreamOrders not implemented")
+
+func (c *merchantServiceClient) DeleteClosure(ctx context.Context, in *DeleteClosureRequest, opts ...grpc.CallOption) (*DeleteClosureResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteClosureResponse)
+	err := c.cc.Invoke(ctx, MerchantService_DeleteClosure_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantServiceClient) PauseOrders(ctx context.Context, in *PauseOrdersRequest, opts ...grpc.CallOption) (*PauseOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PauseOrdersResponse)
+	err := c.cc.Invoke(ctx, MerchantService_PauseOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantServiceClient) GetCatalog(ctx context.Context, in *GetCatalogRequest, opts ...grpc.CallOption) (*GetCatalogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCatalogResponse)
+	err := c.cc.Invoke(ctx, MerchantService_GetCatalog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantServiceClient) CreateCatalogCategory(ctx context.Context, in *CreateCatalogCategoryRequest, opts ...grpc.CallOption) (*CatalogCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CatalogCategoryResponse)
+	err := c.cc.Invoke(ctx, MerchantService_CreateCatalogCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantServiceClient) UpdateCatalogCategory(ctx context.Context, in *UpdateCatalogCategoryRequest, opts ...grpc.CallOption) (*CatalogCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CatalogCategoryResponse)
+	err := c.cc.Invoke(ctx, MerchantService_UpdateCatalogCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantServiceClient) DeleteCatalogCategory(ctx context.Context, in *DeleteCatalogCategoryRequest, opts ...grpc.CallOption) (*DeleteCatalogCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCatalogCategoryResponse)
+	err := c.cc.Invoke(ctx, MerchantService_DeleteCatalogCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantServiceClient) CreateCatalogItem(ctx context.Context, in *CreateCatalogItemRequest, opts ...grpc.CallOption) (*CatalogItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CatalogItemResponse)
+	err := c.cc.Invoke(ctx, MerchantService_CreateCatalogItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantServiceClient) UpdateCatalogItem(ctx context.Context, in *UpdateCatalogItemRequest, opts ...grpc.CallOption) (*CatalogItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CatalogItemResponse)
+	err := c.cc.Invoke(ctx, MerchantService_UpdateCatalogItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantServiceClient) DeleteCatalogItem(ctx context.Context, in *DeleteCatalogItemRequest, opts ...grpc.CallOption) (*DeleteCatalogItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCatalogItemResponse)
+	err := c.cc.Invoke(ctx, MerchantService_DeleteCatalogItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantServiceClient) SetCatalogAvailability(ctx context.Context, in *SetCatalogAvailabilityRequest, opts ...grpc.CallOption) (*SetCatalogAvailabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCatalogAvailabilityResponse)
+	err := c.cc.Invoke(ctx, MerchantService_SetCatalogAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantServiceClient) RequestCatalogImageUpload(ctx context.Context, in *RequestCatalogImageUploadRequest, opts ...grpc.CallOption) (*RequestCatalogImageUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestCatalogImageUploadResponse)
+	err := c.cc.Invoke(ctx, MerchantService_RequestCatalogImageUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantServiceClient) ConfirmCatalogImageUpload(ctx context.Context, in *ConfirmCatalogImageUploadRequest, opts ...grpc.CallOption) (*CatalogItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CatalogItemResponse)
+	err := c.cc.Invoke(ctx, MerchantService_ConfirmCatalogImageUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantServiceClient) InviteStaff(ctx context.Context, in *InviteStaffRequest, opts ...grpc.CallOption) (*InviteStaffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InviteStaffResponse)
+	err := c.cc.Invoke(ctx, MerchantService_InviteStaff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantServiceClient) AcceptStaffInvitation(ctx context.Context, in *AcceptStaffInvitationRequest, opts ...grpc.CallOption) (*AcceptStaffInvitationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptStaffInvitationResponse)
+	err := c.cc.Invoke(ctx, MerchantService_AcceptStaffInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantServiceClient) RevokeStaffInvitation(ctx context.Context, in *RevokeStaffInvitationRequest, opts ...grpc.CallOption) (*RevokeStaffInvitationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeStaffInvitationResponse)
+	err := c.cc.Invoke(ctx, MerchantService_RevokeStaffInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantServiceClient) ListStaff(ctx context.Context, in *ListStaffRequest, opts ...grpc.CallOption) (*ListStaffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStaffResponse)
+	err := c.cc.Invoke(ctx, MerchantService_ListStaff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantServiceClient) UpdateStaff(ctx context.Context, in *UpdateStaffRequest, opts ...grpc.CallOption) (*UpdateStaffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateStaffResponse)
+	err := c.cc.Invoke(ctx, MerchantService_UpdateStaff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantServiceClient) RemoveStaff(ctx context.Context, in *RemoveStaffRequest, opts ...grpc.CallOption) (*RemoveStaffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveStaffResponse)
+	err := c.cc.Invoke(ctx, MerchantService_RemoveStaff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantServiceClient) ListMyMemberships(ctx context.Context, in *ListMyMembershipsRequest, opts ...grpc.CallOption) (*ListMyMembershipsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMyMembershipsResponse)
+	err := c.cc.Invoke(ctx, MerchantService_ListMyMemberships_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MerchantServiceServer is the server API for MerchantService service.
+// All implementations must embed UnimplementedMerchantServiceServer
+// for forward compatibility.
+type MerchantServiceServer interface {
+	GetMerchant(context.Context, *GetMerchantRequest) (*GetMerchantResponse, error)
+	UpdateMerchant(context.Context, *UpdateMerchantRequest) (*UpdateMerchantResponse, error)
+	GetMerchantAddress(context.Context, *GetMerchantAddressRequest) (*GetMerchantAddressResponse, error)
+	UpdateMerchantAddress(context.Context, *UpdateMerchantAddressRequest) (*UpdateMerchantAddressResponse, error)
+	AddMerchantAddress(context.Context, *AddMerchantAddressRequest) (*AddMerchantAddressResponse, error)
+	DeleteMerchantAddress(context.Context, *DeleteMerchantAddressRequest) (*DeleteMerchantAddressResponse, error)
+	SetPrimaryMerchantAddress(context.Context, *SetPrimaryMerchantAddressRequest) (*SetPrimaryMerchantAddressResponse, error)
+	GetOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error)
+	UpdateOrderStatus(context.Context, *UpdateOrderStatusRequest) (*UpdateOrderStatusResponse, error)
+	GetOrderReceipt(context.Context, *GetOrderReceiptRequest) (*GetOrderReceiptResponse, error)
+	ReplyToReview(context.Context, *ReplyToReviewRequest) (*ReplyToReviewResponse, error)
+	GetCustomers(context.Context, *GetCustomersRequest) (*GetCustomersResponse, error)
+	GetPayouts(context.Context, *GetPayoutsRequest) (*GetPayoutsResponse, error)
+	CreateOffer(context.Context, *CreateOfferRequest) (*CreateOfferResponse, error)
+	GetOffers(context.Context, *GetOffersRequest) (*GetOffersResponse, error)
+	UpdateOffer(context.Context, *UpdateOfferRequest) (*UpdateOfferResponse, error)
+	GetDashboardStats(context.Context, *GetDashboardStatsRequest) (*GetDashboardStatsResponse, error)
+	StreamOrders(*StreamOrdersRequest, grpc.ServerStreamingServer[StreamOrdersResponse]) error
+	StreamNotifications(*StreamNotificationsRequest, grpc.ServerStreamingServer[StreamNotificationsResponse]) error
+	ListNotifications(context.Context, *ListMerchantNotificationsRequest) (*ListMerchantNotificationsResponse, error)
+	MarkNotificationsRead(context.Context, *MarkMerchantNotificationsReadRequest) (*MarkMerchantNotificationsReadResponse, error)
+	GetUnreadNotificationCount(context.Context, *GetMerchantUnreadNotificationCountRequest) (*GetMerchantUnreadNotificationCountResponse, error)
+	// API keys for POS / server-to-server integrations
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+	ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error)
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error)
+	// Onboarding
+	SubmitForReview(context.Context, *SubmitForReviewRequest) (*SubmitForReviewResponse, error)
+	GetOnboardingStatus(context.Context, *GetOnboardingStatusRequest) (*GetOnboardingStatusResponse, error)
+	// KYC documents
+	RequestDocumentUpload(context.Context, *RequestDocumentUploadRequest) (*RequestDocumentUploadResponse, error)
+	ConfirmDocumentUpload(context.Context, *ConfirmDocumentUploadRequest) (*ConfirmDocumentUploadResponse, error)
+	ListDocuments(context.Context, *ListDocumentsRequest) (*ListDocumentsResponse, error)
+	// Business hours
+	GetBusinessHours(context.Context, *GetBusinessHoursRequest) (*GetBusinessHoursResponse, error)
+	SetBusinessHours(context.Context, *SetBusinessHoursRequest) (*GetBusinessHoursResponse, error)
+	AddClosure(context.Context, *AddClosureRequest) (*AddClosureResponse, error)
+	DeleteClosure(context.Context, *DeleteClosureRequest) (*DeleteClosureResponse, error)
+	PauseOrders(context.Context, *PauseOrdersRequest) (*PauseOrdersResponse, error)
+	// Catalog
+	GetCatalog(context.Context, *GetCatalogRequest) (*GetCatalogResponse, error)
+	CreateCatalogCategory(context.Context, *CreateCatalogCategoryRequest) (*CatalogCategoryResponse, error)
+	UpdateCatalogCategory(context.Context, *UpdateCatalogCategoryRequest) (*CatalogCategoryResponse, error)
+	DeleteCatalogCategory(context.Context, *DeleteCatalogCategoryRequest) (*DeleteCatalogCategoryResponse, error)
+	CreateCatalogItem(context.Context, *CreateCatalogItemRequest) (*CatalogItemResponse, error)
+	UpdateCatalogItem(context.Context, *UpdateCatalogItemRequest) (*CatalogItemResponse, error)
+	DeleteCatalogItem(context.Context, *DeleteCatalogItemRequest) (*DeleteCatalogItemResponse, error)
+	SetCatalogAvailability(context.Context, *SetCatalogAvailabilityRequest) (*SetCatalogAvailabilityResponse, error)
+	RequestCatalogImageUpload(context.Context, *RequestCatalogImageUploadRequest) (*RequestCatalogImageUploadResponse, error)
+	ConfirmCatalogImageUpload(context.Context, *ConfirmCatalogImageUploadRequest) (*CatalogItemResponse, error)
+	// Staff
+	InviteStaff(context.Context, *InviteStaffRequest) (*InviteStaffResponse, error)
+	AcceptStaffInvitation(context.Context, *AcceptStaffInvitationRequest) (*AcceptStaffInvitationResponse, error)
+	RevokeStaffInvitation(context.Context, *RevokeStaffInvitationRequest) (*RevokeStaffInvitationResponse, error)
+	ListStaff(context.Context, *ListStaffRequest) (*ListStaffResponse, error)
+	UpdateStaff(context.Context, *UpdateStaffRequest) (*UpdateStaffResponse, error)
+	RemoveStaff(context.Context, *RemoveStaffRequest) (*RemoveStaffResponse, error)
+	ListMyMemberships(context.Context, *ListMyMembershipsRequest) (*ListMyMembershipsResponse, error)
+	mustEmbedUnimplementedMerchantServiceServer()
+}
+
+// UnimplementedMerchantServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMerchantServiceServer struct{}
+
+func (UnimplementedMerchantServiceServer) GetMerchant(context.Context, *GetMerchantRequest) (*GetMerchantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMerchant not implemented")
+}
+func (UnimplementedMerchantServiceServer) UpdateMerchant(context.Context, *UpdateMerchantRequest) (*UpdateMerchantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMerchant not implemented")
+}
+func (UnimplementedMerchantServiceServer) GetMerchantAddress(context.Context, *GetMerchantAddressRequest) (*GetMerchantAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMerchantAddress not implemented")
+}
+func (UnimplementedMerchantServiceServer) UpdateMerchantAddress(context.Context, *UpdateMerchantAddressRequest) (*UpdateMerchantAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMerchantAddress not implemented")
+}
+func (UnimplementedMerchantServiceServer) AddMerchantAddress(context.Context, *AddMerchantAddressRequest) (*AddMerchantAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMerchantAddress not implemented")
+}
+func (UnimplementedMerchantServiceServer) DeleteMerchantAddress(context.Context, *DeleteMerchantAddressRequest) (*DeleteMerchantAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMerchantAddress not implemented")
+}
+func (UnimplementedMerchantServiceServer) SetPrimaryMerchantAddress(context.Context, *SetPrimaryMerchantAddressRequest) (*SetPrimaryMerchantAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPrimaryMerchantAddress not implemented")
+}
+func (UnimplementedMerchantServiceServer) GetOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrders not implemented")
+}
+func (UnimplementedMerchantServiceServer) UpdateOrderStatus(context.Context, *UpdateOrderStatusRequest) (*UpdateOrderStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrderStatus not implemented")
+}
+func (UnimplementedMerchantServiceServer) GetOrderReceipt(context.Context, *GetOrderReceiptRequest) (*GetOrderReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderReceipt not implemented")
+}
+func (UnimplementedMerchantServiceServer) ReplyToReview(context.Context, *ReplyToReviewRequest) (*ReplyToReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplyToReview not implemented")
+}
+func (UnimplementedMerchantServiceServer) GetCustomers(context.Context, *GetCustomersRequest) (*GetCustomersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCustomers not implemented")
+}
+func (UnimplementedMerchantServiceServer) GetPayouts(context.Context, *GetPayoutsRequest) (*GetPayoutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayouts not implemented")
+}
+func (UnimplementedMerchantServiceServer) CreateOffer(context.Context, *CreateOfferRequest) (*CreateOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOffer not implemented")
+}
+func (UnimplementedMerchantServiceServer) GetOffers(context.Context, *GetOffersRequest) (*GetOffersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOffers not implemented")
+}
+func (UnimplementedMerchantServiceServer) UpdateOffer(context.Context, *UpdateOfferRequest) (*UpdateOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOffer not implemented")
+}
+func (UnimplementedMerchantServiceServer) GetDashboardStats(context.Context, *GetDashboardStatsRequest) (*GetDashboardStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDashboardStats not implemented")
+}
+func (UnimplementedMerchantServiceServer) StreamOrders(*StreamOrdersRequest, grpc.ServerStreamingServer[StreamOrdersResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamOrders not implemented")
+}
+func (UnimplementedMerchantServiceServer) StreamNotifications(*StreamNotificationsRequest, grpc.ServerStreamingServer[StreamNotificationsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamNotifications not implemented")
+}
+func (UnimplementedMerchantServiceServer) ListNotifications(context.Context, *ListMerchantNotificationsRequest) (*ListMerchantNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotifications not implemented")
+}
+func (UnimplementedMerchantServiceServer) MarkNotificationsRead(context.Context, *MarkMerchantNotificationsReadRequest) (*MarkMerchantNotificationsReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkNotificationsRead not implemented")
+}
+func (UnimplementedMerchantServiceServer) GetUnreadNotificationCount(context.Context, *GetMerchantUnreadNotificationCountRequest) (*GetMerchantUnreadNotificationCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnreadNotificationCount not implemented")
+}
+func (UnimplementedMerchantServiceServer) CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (UnimplementedMerchantServiceServer) ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPIKeys not implemented")
+}
+func (UnimplementedMerchantServiceServer) RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
+func (UnimplementedMerchantServiceServer) SubmitForReview(context.Context, *SubmitForReviewRequest) (*SubmitForReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitForReview not implemented")
+}
+func (UnimplementedMerchantServiceServer) GetOnboardingStatus(context.Context, *GetOnboardingStatusRequest) (*GetOnboardingStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOnboardingStatus not implemented")
+}
+func (UnimplementedMerchantServiceServer) RequestDocumentUpload(context.Context, *RequestDocumentUploadRequest) (*RequestDocumentUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestDocumentUpload not implemented")
+}
+func (UnimplementedMerchantServiceServer) ConfirmDocumentUpload(context.Context, *ConfirmDocumentUploadRequest) (*ConfirmDocumentUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmDocumentUpload not implemented")
+}
+func (UnimplementedMerchantServiceServer) ListDocuments(context.Context, *ListDocumentsRequest) (*ListDocumentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDocuments not implemented")
+}
+func (UnimplementedMerchantServiceServer) GetBusinessHours(context.Context, *GetBusinessHoursRequest) (*GetBusinessHoursResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBusinessHours not implemented")
+}
+func (UnimplementedMerchantServiceServer) SetBusinessHours(context.Context, *SetBusinessHoursRequest) (*GetBusinessHoursResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBusinessHours not implemented")
+}
+func (UnimplementedMerchantServiceServer) AddClosure(context.Context, *AddClosureRequest) (*AddClosureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddClosure not implemented")
+}
+func (UnimplementedMerchantServiceServer) DeleteClosure(context.Context, *DeleteClosureRequest) (*DeleteClosureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteClosure not implemented")
+}
+func (UnimplementedMerchantServiceServer) PauseOrders(context.Context, *PauseOrdersRequest) (*PauseOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseOrders not implemented")
+}
+func (UnimplementedMerchantServiceServer) GetCatalog(context.Context, *GetCatalogRequest) (*GetCatalogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCatalog not implemented")
+}
+func (UnimplementedMerchantServiceServer) CreateCatalogCategory(context.Context, *CreateCatalogCategoryRequest) (*CatalogCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCatalogCategory not implemented")
+}
+func (UnimplementedMerchantServiceServer) UpdateCatalogCategory(context.Context, *UpdateCatalogCategoryRequest) (*CatalogCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCatalogCategory not implemented")
+}
+func (UnimplementedMerchantServiceServer) DeleteCatalogCategory(context.Context, *DeleteCatalogCategoryRequest) (*DeleteCatalogCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCatalogCategory not implemented")
+}
+func (UnimplementedMerchantServiceServer) CreateCatalogItem(context.Context, *CreateCatalogItemRequest) (*CatalogItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCatalogItem not implemented")
+}
+func (UnimplementedMerchantServiceServer) UpdateCatalogItem(context.Context, *UpdateCatalogItemRequest) (*CatalogItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCatalogItem not implemented")
+}
+func (UnimplementedMerchantServiceServer) DeleteCatalogItem(context.Context, *DeleteCatalogItemRequest) (*DeleteCatalogItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCatalogItem not implemented")
+}
+func (UnimplementedMerchantServiceServer) SetCatalogAvailability(context.Context, *SetCatalogAvailabilityRequest) (*SetCatalogAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCatalogAvailability not implemented")
+}
+func (UnimplementedMerchantServiceServer) RequestCatalogImageUpload(context.Context, *RequestCatalogImageUploadRequest) (*RequestCatalogImageUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestCatalogImageUpload not implemented")
+}
+func (UnimplementedMerchantServiceServer) ConfirmCatalogImageUpload(context.Context, *ConfirmCatalogImageUploadRequest) (*CatalogItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmCatalogImageUpload not implemented")
+}
+func (UnimplementedMerchantServiceServer) InviteStaff(context.Context, *InviteStaffRequest) (*InviteStaffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteStaff not implemented")
+}
+func (UnimplementedMerchantServiceServer) AcceptStaffInvitation(context.Context, *AcceptStaffInvitationRequest) (*AcceptStaffInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptStaffInvitation not implemented")
+}
+func (UnimplementedMerchantServiceServer) RevokeStaffInvitation(context.Context, *RevokeStaffInvitationRequest) (*RevokeStaffInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeStaffInvitation not implemented")
+}
+func (UnimplementedMerchantServiceServer) ListStaff(context.Context, *ListStaffRequest) (*ListStaffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStaff not implemented")
+}
+func (UnimplementedMerchantServiceServer) UpdateStaff(context.Context, *UpdateStaffRequest) (*UpdateStaffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStaff not implemented")
+}
+func (UnimplementedMerchantServiceServer) RemoveStaff(context.Context, *RemoveStaffRequest) (*RemoveStaffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveStaff not implemented")
+}
+func (UnimplementedMerchantServiceServer) ListMyMemberships(context.Context, *ListMyMembershipsRequest) (*ListMyMembershipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyMemberships not implemented")
+}
+func (UnimplementedMerchantServiceServer) mustEmbedUnimplementedMerchantServiceServer() {}
+func (UnimplementedMerchantServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeMerchantServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MerchantServiceServer will
+// result in compilation errors.
+type UnsafeMerchantServiceServer interface {
+	mustEmbedUnimplementedMerchantServiceServer()
+}
+
+func RegisterMerchantServiceServer(s grpc.ServiceRegistrar, srv MerchantServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMerchantServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MerchantService_ServiceDesc, srv)
+}
+
+func _MerchantService_GetMerchant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMerchantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).GetMerchant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_GetMerchant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).GetMerchant(ctx, req.(*GetMerchantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantService_UpdateMerchant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMerchantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).UpdateMerchant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_UpdateMerchant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).UpdateMerchant(ctx, req.(*UpdateMerchantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantService_GetMerchantAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMerchantAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).GetMerchantAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_GetMerchantAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).GetMerchantAddress(ctx, req.(*GetMerchantAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantService_UpdateMerchantAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMerchantAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).UpdateMerchantAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_UpdateMerchantAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).UpdateMerchantAddress(ctx, req.(*UpdateMerchantAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantService_AddMerchantAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMerchantAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).AddMerchantAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_AddMerchantAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).AddMerchantAddress(ctx, req.(*AddMerchantAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantService_DeleteMerchantAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMerchantAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).DeleteMerchantAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_DeleteMerchantAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).DeleteMerchantAddress(ctx, req.(*DeleteMerchantAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantService_SetPrimaryMerchantAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPrimaryMerchantAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).SetPrimaryMerchantAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_SetPrimaryMerchantAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).SetPrimaryMerchantAddress(ctx, req.(*SetPrimaryMerchantAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantService_GetOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).GetOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_GetOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).GetOrders(ctx, req.(*GetOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantService_UpdateOrderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrderStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).UpdateOrderStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_UpdateOrderStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).UpdateOrderStatus(ctx, req.(*UpdateOrderStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantService_GetOrderReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).GetOrderReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_GetOrderReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).GetOrderReceipt(ctx, req.(*GetOrderReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantService_ReplyToReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplyToReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).ReplyToReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_ReplyToReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).ReplyToReview(ctx, req.(*ReplyToReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantService_GetCustomers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCustomersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).GetCustomers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_GetCustomers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).GetCustomers(ctx, req.(*GetCustomersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantService_GetPayouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPayoutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).GetPayouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_GetPayouts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).GetPayouts(ctx, req.(*GetPayoutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantService_CreateOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).CreateOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_CreateOffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).CreateOffer(ctx, req.(*CreateOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantService_GetOffers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOffersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).GetOffers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_GetOffers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).GetOffers(ctx, req.(*GetOffersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantService_UpdateOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).UpdateOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_UpdateOffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).UpdateOffer(ctx, req.(*UpdateOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantService_GetDashboardStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDashboardStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).GetDashboardStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_GetDashboardStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).GetDashboardStats(ctx, req.(*GetDashboardStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantService_StreamOrders_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamOrdersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MerchantServiceServer).StreamOrders(m, &grpc.GenericServerStream[StreamOrdersRequest, StreamOrdersResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MerchantService_StreamOrdersServer = grpc.ServerStreamingServer[StreamOrdersResponse]
+
+func _MerchantService_StreamNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamNotificationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MerchantServiceServer).StreamNotifications(m, &grpc.GenericServerStream[StreamNotificationsRequest, StreamNotificationsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MerchantService_StreamNotificationsServer = grpc.ServerStreamingServer[StreamNotificationsResponse]
+
+func _MerchantService_ListNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMerchantNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).ListNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_ListNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).ListNotifications(ctx, req.(*ListMerchantNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantService_MarkNotificationsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkMerchantNotificationsReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).MarkNotificationsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_MarkNotificationsRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).MarkNotificationsRead(ctx, req.(*MarkMerchantNotificationsReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantService_GetUnreadNotificationCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMerchantUnreadNotificationCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).GetUnreadNotificationCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_GetUnreadNotificationCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).GetUnreadNotificationCount(ctx, req.(*GetMerchantUnreadNotificationCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantService_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_CreateAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantService_ListAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAPIKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).ListAPIKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_ListAPIKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).ListAPIKeys(ctx, req.(*ListAPIKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantService_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_RevokeAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantService_SubmitForReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitForReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).SubmitForReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_SubmitForReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).SubmitForReview(ctx, req.(*SubmitForReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantService_GetOnboardingStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOnboardingStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).GetOnboardingStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_GetOnboardingStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).GetOnboardingStatus(ctx, req.(*GetOnboardingStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantService_RequestDocumentUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestDocumentUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).RequestDocumentUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_RequestDocumentUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).RequestDocumentUpload(ctx, req.(*RequestDocumentUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantService_ConfirmDocumentUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmDocumentUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).ConfirmDocumentUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_ConfirmDocumentUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).ConfirmDocumentUpload(ctx, req.(*ConfirmDocumentUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantService_ListDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDocumentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).ListDocuments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_ListDocuments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).ListDocuments(ctx, req.(*ListDocumentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantService_GetBusinessHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBusinessHoursRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).GetBusinessHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_GetBusinessHours_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).GetBusinessHours(ctx, req.(*GetBusinessHoursRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantService_SetBusinessHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBusinessHoursRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).SetBusinessHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_SetBusinessHours_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).SetBusinessHours(ctx, req.(*SetBusinessHoursRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantService_AddClosure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddClosureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).AddClosure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_AddClosure_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).AddClosure(ctx, req.(*AddClosureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMerchantServiceServer) StreamNotifications(*StreamNotificationsRequest, grpc.ServerStreamingServer[StreamNotificationsResponse]) error {
-	return status.Errorf(codes.Unimplemented, "method StreamNotifications not implemented")
+
+func _MerchantService_DeleteClosure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteClosureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).DeleteClosure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_DeleteClosure_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).DeleteClosure(ctx, req.(*DeleteClosureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMerchantServiceServer) mustEmbedUnimplementedMerchantServiceServer() {}
-func (UnimplementedMerchantServiceServer) testEmbeddedByValue()                         {}
 
-// UnsafeMerchantServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to MerchantServiceServer will
-// result in compilation errors.
-type UnsafeMerchantServiceServer interface {
-	mustEmbedUnimplementedMerchantServiceServer()
+func _MerchantService_PauseOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).PauseOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_PauseOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).PauseOrders(ctx, req.(*PauseOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterMerchantServiceServer(s grpc.ServiceRegistrar, srv MerchantServiceServer) {
-	// If the following call pancis, it indicates UnimplementedMerchantServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _MerchantService_GetCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCatalogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&MerchantService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).GetCatalog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_GetCatalog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).GetCatalog(ctx, req.(*GetCatalogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _MerchantService_GetMerchant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMerchantRequest)
+func _MerchantService_CreateCatalogCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCatalogCategoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MerchantServiceServer).GetMerchant(ctx, in)
+		return srv.(MerchantServiceServer).CreateCatalogCategory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MerchantService_GetMerchant_FullMethodName,
+		FullMethod: MerchantService_CreateCatalogCategory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MerchantServiceServer).GetMerchant(ctx, req.(*GetMerchantRequest))
+		return srv.(MerchantServiceServer).CreateCatalogCategory(ctx, req.(*CreateCatalogCategoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MerchantService_UpdateMerchant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateMerchantRequest)
+func _MerchantService_UpdateCatalogCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCatalogCategoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MerchantServiceServer).UpdateMerchant(ctx, in)
+		return srv.(MerchantServiceServer).UpdateCatalogCategory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MerchantService_UpdateMerchant_FullMethodName,
+		FullMethod: MerchantService_UpdateCatalogCategory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MerchantServiceServer).UpdateMerchant(ctx, req.(*UpdateMerchantRequest))
+		return srv.(MerchantServiceServer).UpdateCatalogCategory(ctx, req.(*UpdateCatalogCategoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MerchantService_GetMerchantAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMerchantAddressRequest)
+func _MerchantService_DeleteCatalogCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCatalogCategoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MerchantServiceServer).GetMerchantAddress(ctx, in)
+		return srv.(MerchantServiceServer).DeleteCatalogCategory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MerchantService_GetMerchantAddress_FullMethodName,
+		FullMethod: MerchantService_DeleteCatalogCategory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MerchantServiceServer).GetMerchantAddress(ctx, req.(*GetMerchantAddressRequest))
+		return srv.(MerchantServiceServer).DeleteCatalogCategory(ctx, req.(*DeleteCatalogCategoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MerchantService_UpdateMerchantAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateMerchantAddressRequest)
+func _MerchantService_CreateCatalogItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCatalogItemRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MerchantServiceServer).UpdateMerchantAddress(ctx, in)
+		return srv.(MerchantServiceServer).CreateCatalogItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MerchantService_UpdateMerchantAddress_FullMethodName,
+		FullMethod: MerchantService_CreateCatalogItem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MerchantServiceServer).UpdateMerchantAddress(ctx, req.(*UpdateMerchantAddressRequest))
+		return srv.(MerchantServiceServer).CreateCatalogItem(ctx, req.(*CreateCatalogItemRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MerchantService_GetOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrdersRequest)
+func _MerchantService_UpdateCatalogItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCatalogItemRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MerchantServiceServer).GetOrders(ctx, in)
+		return srv.(MerchantServiceServer).UpdateCatalogItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MerchantService_GetOrders_FullMethodName,
+		FullMethod: MerchantService_UpdateCatalogItem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MerchantServiceServer).GetOrders(ctx, req.(*GetOrdersRequest))
+		return srv.(MerchantServiceServer).UpdateCatalogItem(ctx, req.(*UpdateCatalogItemRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MerchantService_UpdateOrderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateOrderStatusRequest)
+func _MerchantService_DeleteCatalogItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCatalogItemRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MerchantServiceServer).UpdateOrderStatus(ctx, in)
+		return srv.(MerchantServiceServer).DeleteCatalogItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MerchantService_UpdateOrderStatus_FullMethodName,
+		FullMethod: MerchantService_DeleteCatalogItem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MerchantServiceServer).UpdateOrderStatus(ctx, req.(*UpdateOrderStatusRequest))
+		return srv.(MerchantServiceServer).DeleteCatalogItem(ctx, req.(*DeleteCatalogItemRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MerchantService_GetCustomers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCustomersRequest)
+func _MerchantService_SetCatalogAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCatalogAvailabilityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MerchantServiceServer).GetCustomers(ctx, in)
+		return srv.(MerchantServiceServer).SetCatalogAvailability(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MerchantService_GetCustomers_FullMethodName,
+		FullMethod: MerchantService_SetCatalogAvailability_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MerchantServiceServer).GetCustomers(ctx, req.(*GetCustomersRequest))
+		return srv.(MerchantServiceServer).SetCatalogAvailability(ctx, req.(*SetCatalogAvailabilityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MerchantService_GetPayouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPayoutsRequest)
+func _MerchantService_RequestCatalogImageUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestCatalogImageUploadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MerchantServiceServer).GetPayouts(ctx, in)
+		return srv.(MerchantServiceServer).RequestCatalogImageUpload(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MerchantService_GetPayouts_FullMethodName,
+		FullMethod: MerchantService_RequestCatalogImageUpload_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MerchantServiceServer).GetPayouts(ctx, req.(*GetPayoutsRequest))
+		return srv.(MerchantServiceServer).RequestCatalogImageUpload(ctx, req.(*RequestCatalogImageUploadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MerchantService_CreateOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateOfferRequest)
+func _MerchantService_ConfirmCatalogImageUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmCatalogImageUploadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MerchantServiceServer).CreateOffer(ctx, in)
+		return srv.(MerchantServiceServer).ConfirmCatalogImageUpload(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MerchantService_CreateOffer_FullMethodName,
+		FullMethod: MerchantService_ConfirmCatalogImageUpload_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MerchantServiceServer).CreateOffer(ctx, req.(*CreateOfferRequest))
+		return srv.(MerchantServiceServer).ConfirmCatalogImageUpload(ctx, req.(*ConfirmCatalogImageUploadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MerchantService_GetOffers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOffersRequest)
+func _MerchantService_InviteStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteStaffRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MerchantServiceServer).GetOffers(ctx, in)
+		return srv.(MerchantServiceServer).InviteStaff(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MerchantService_GetOffers_FullMethodName,
+		FullMethod: MerchantService_InviteStaff_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MerchantServiceServer).GetOffers(ctx, req.(*GetOffersRequest))
+		return srv.(MerchantServiceServer).InviteStaff(ctx, req.(*InviteStaffRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MerchantService_UpdateOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateOfferRequest)
+func _MerchantService_AcceptStaffInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptStaffInvitationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MerchantServiceServer).UpdateOffer(ctx, in)
+		return srv.(MerchantServiceServer).AcceptStaffInvitation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MerchantService_UpdateOffer_FullMethodName,
+		FullMethod: MerchantService_AcceptStaffInvitation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MerchantServiceServer).UpdateOffer(ctx, req.(*UpdateOfferRequest))
+		return srv.(MerchantServiceServer).AcceptStaffInvitation(ctx, req.(*AcceptStaffInvitationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MerchantService_GetDashboardStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDashboardStatsRequest)
+func _MerchantService_RevokeStaffInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeStaffInvitationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MerchantServiceServer).GetDashboardStats(ctx, in)
+		return srv.(MerchantServiceServer).RevokeStaffInvitation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MerchantService_GetDashboardStats_FullMethodName,
+		FullMethod: MerchantService_RevokeStaffInvitation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MerchantServiceServer).GetDashboardStats(ctx, req.(*GetDashboardStatsRequest))
+		return srv.(MerchantServiceServer).RevokeStaffInvitation(ctx, req.(*RevokeStaffInvitationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MerchantService_StreamOrders_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(StreamOrdersRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _MerchantService_ListStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStaffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(MerchantServiceServer).StreamOrders(m, &grpc.GenericServerStream[StreamOrdersRequest, StreamOrdersResponse]{ServerStream: stream})
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).ListStaff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_ListStaff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).ListStaff(ctx, req.(*ListStaffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type MerchantService_StreamOrdersServer = grpc.ServerStreamingServer[StreamOrdersResponse]
+func _MerchantService_UpdateStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStaffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).UpdateStaff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_UpdateStaff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).UpdateStaff(ctx, req.(*UpdateStaffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func _MerchantService_StreamNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(StreamNotificationsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _MerchantService_RemoveStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveStaffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(MerchantServiceServer).StreamNotifications(m, &grpc.GenericServerStream[StreamNotificationsRequest, StreamNotificationsResponse]{ServerStream: stream})
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).RemoveStaff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_RemoveStaff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).RemoveStaff(ctx, req.(*RemoveStaffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type MerchantService_StreamNotificationsServer = grpc.ServerStreamingServer[StreamNotificationsResponse]
+func _MerchantService_ListMyMemberships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyMembershipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).ListMyMemberships(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_ListMyMemberships_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).ListMyMemberships(ctx, req.(*ListMyMembershipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
 // MerchantService_ServiceDesc is the grpc.ServiceDesc for MerchantService service.
 // It's only intended for direct use with grpc.RegisterService,
@@ -573,6 +1877,18 @@ var MerchantService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateMerchantAddress",
 			Handler:    _MerchantService_UpdateMerchantAddress_Handler,
 		},
+		{
+			MethodName: "AddMerchantAddress",
+			Handler:    _MerchantService_AddMerchantAddress_Handler,
+		},
+		{
+			MethodName: "DeleteMerchantAddress",
+			Handler:    _MerchantService_DeleteMerchantAddress_Handler,
+		},
+		{
+			MethodName: "SetPrimaryMerchantAddress",
+			Handler:    _MerchantService_SetPrimaryMerchantAddress_Handler,
+		},
 		{
 			MethodName: "GetOrders",
 			Handler:    _MerchantService_GetOrders_Handler,
@@ -581,6 +1897,14 @@ var MerchantService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateOrderStatus",
 			Handler:    _MerchantService_UpdateOrderStatus_Handler,
 		},
+		{
+			MethodName: "GetOrderReceipt",
+			Handler:    _MerchantService_GetOrderReceipt_Handler,
+		},
+		{
+			MethodName: "ReplyToReview",
+			Handler:    _MerchantService_ReplyToReview_Handler,
+		},
 		{
 			MethodName: "GetCustomers",
 			Handler:    _MerchantService_GetCustomers_Handler,
@@ -605,6 +1929,138 @@ var MerchantService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDashboardStats",
 			Handler:    _MerchantService_GetDashboardStats_Handler,
 		},
+		{
+			MethodName: "ListNotifications",
+			Handler:    _MerchantService_ListNotifications_Handler,
+		},
+		{
+			MethodName: "MarkNotificationsRead",
+			Handler:    _MerchantService_MarkNotificationsRead_Handler,
+		},
+		{
+			MethodName: "GetUnreadNotificationCount",
+			Handler:    _MerchantService_GetUnreadNotificationCount_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _MerchantService_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "ListAPIKeys",
+			Handler:    _MerchantService_ListAPIKeys_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _MerchantService_RevokeAPIKey_Handler,
+		},
+		{
+			MethodName: "SubmitForReview",
+			Handler:    _MerchantService_SubmitForReview_Handler,
+		},
+		{
+			MethodName: "GetOnboardingStatus",
+			Handler:    _MerchantService_GetOnboardingStatus_Handler,
+		},
+		{
+			MethodName: "RequestDocumentUpload",
+			Handler:    _MerchantService_RequestDocumentUpload_Handler,
+		},
+		{
+			MethodName: "ConfirmDocumentUpload",
+			Handler:    _MerchantService_ConfirmDocumentUpload_Handler,
+		},
+		{
+			MethodName: "ListDocuments",
+			Handler:    _MerchantService_ListDocuments_Handler,
+		},
+		{
+			MethodName: "GetBusinessHours",
+			Handler:    _MerchantService_GetBusinessHours_Handler,
+		},
+		{
+			MethodName: "SetBusinessHours",
+			Handler:    _MerchantService_SetBusinessHours_Handler,
+		},
+		{
+			MethodName: "AddClosure",
+			Handler:    _MerchantService_AddClosure_Handler,
+		},
+		{
+			MethodName: "DeleteClosure",
+			Handler:    _MerchantService_DeleteClosure_Handler,
+		},
+		{
+			MethodName: "PauseOrders",
+			Handler:    _MerchantService_PauseOrders_Handler,
+		},
+		{
+			MethodName: "GetCatalog",
+			Handler:    _MerchantService_GetCatalog_Handler,
+		},
+		{
+			MethodName: "CreateCatalogCategory",
+			Handler:    _MerchantService_CreateCatalogCategory_Handler,
+		},
+		{
+			MethodName: "UpdateCatalogCategory",
+			Handler:    _MerchantService_UpdateCatalogCategory_Handler,
+		},
+		{
+			MethodName: "DeleteCatalogCategory",
+			Handler:    _MerchantService_DeleteCatalogCategory_Handler,
+		},
+		{
+			MethodName: "CreateCatalogItem",
+			Handler:    _MerchantService_CreateCatalogItem_Handler,
+		},
+		{
+			MethodName: "UpdateCatalogItem",
+			Handler:    _MerchantService_UpdateCatalogItem_Handler,
+		},
+		{
+			MethodName: "DeleteCatalogItem",
+			Handler:    _MerchantService_DeleteCatalogItem_Handler,
+		},
+		{
+			MethodName: "SetCatalogAvailability",
+			Handler:    _MerchantService_SetCatalogAvailability_Handler,
+		},
+		{
+			MethodName: "RequestCatalogImageUpload",
+			Handler:    _MerchantService_RequestCatalogImageUpload_Handler,
+		},
+		{
+			MethodName: "ConfirmCatalogImageUpload",
+			Handler:    _MerchantService_ConfirmCatalogImageUpload_Handler,
+		},
+		{
+			MethodName: "InviteStaff",
+			Handler:    _MerchantService_InviteStaff_Handler,
+		},
+		{
+			MethodName: "AcceptStaffInvitation",
+			Handler:    _MerchantService_AcceptStaffInvitation_Handler,
+		},
+		{
+			MethodName: "RevokeStaffInvitation",
+			Handler:    _MerchantService_RevokeStaffInvitation_Handler,
+		},
+		{
+			MethodName: "ListStaff",
+			Handler:    _MerchantService_ListStaff_Handler,
+		},
+		{
+			MethodName: "UpdateStaff",
+			Handler:    _MerchantService_UpdateStaff_Handler,
+		},
+		{
+			MethodName: "RemoveStaff",
+			Handler:    _MerchantService_RemoveStaff_Handler,
+		},
+		{
+			MethodName: "ListMyMemberships",
+			Handler:    _MerchantService_ListMyMemberships_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -28,6 +28,7 @@ type GetNearbyOffersRequest struct {
 	Latitude      float64                `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
 	Longitude     float64                `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
 	RadiusKm      float64                `protobuf:"fixed64,4,opt,name=radius_km,json=radiusKm,proto3" json:"radius_km,omitempty"`
+	Sort          string                 `protobuf:"bytes,5,opt,name=sort,proto3" json:"sort,omitempty"` // distance (default) or rating
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -90,6 +91,13 @@ func (x *GetNearbyOffersRequest) GetRadiusKm() float64 {
 	return 0
 }
 
+func (x *GetNearbyOffersRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
 type GetNearbyOffersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Offers        []*schema.Offer        `protobuf:"bytes,1,rep,name=offers,proto3" json:"offers,omitempty"`
@@ -594,12 +602,13 @@ var File_proto_api_offers_proto protoreflect.FileDescriptor
 
 const file_proto_api_offers_proto_rawDesc = "" +
 	"\n" +
-	"\x16proto/api/offers.proto\x12\frival.api.v1\x1a\x19proto/schema/schema.proto\"\x88\x01\n" +
+	"\x16proto/api/offers.proto\x12\frival.api.v1\x1a\x19proto/schema/schema.proto\"\x9c\x01\n" +
 	"\x16GetNearbyOffersRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
 	"\blatitude\x18\x02 \x01(\x01R\blatitude\x12\x1c\n" +
 	"\tlongitude\x18\x03 \x01(\x01R\tlongitude\x12\x1b\n" +
-	"\tradius_km\x18\x04 \x01(\x01R\bradiusKm\"I\n" +
+	"\tradius_km\x18\x04 \x01(\x01R\bradiusKm\x12\x12\n" +
+	"\x04sort\x18\x05 \x01(\tR\x04sort\"I\n" +
 	"\x17GetNearbyOffersResponse\x12.\n" +
 	"\x06offers\x18\x01 \x03(\v2\x16.rival.schema.v1.OfferR\x06offers\"3\n" +
 	"\x16GetOfferDetailsRequest\x12\x19\n" +
@@ -23,14 +23,19 @@ const (
 )
 
 type CreateOrderRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	MerchantId    int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
-	OfferId       int64                  `protobuf:"varint,3,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
-	Items         string                 `protobuf:"bytes,4,opt,name=items,proto3" json:"items,omitempty"` // JSON string
-	Subtotal      float64                `protobuf:"fixed64,5,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
-	CoinsUsed     float64                `protobuf:"fixed64,6,opt,name=coins_used,json=coinsUsed,proto3" json:"coins_used,omitempty"`
-	Notes         string                 `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Customer the order is for. Customers order for themselves and may leave it
+	// unset; merchant API keys must set it and the customer confirms the order.
+	UserId     int64   `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MerchantId int64   `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	OfferId    int64   `protobuf:"varint,3,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+	Items      string  `protobuf:"bytes,4,opt,name=items,proto3" json:"items,omitempty"` // JSON string
+	Subtotal   float64 `protobuf:"fixed64,5,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	CoinsUsed  float64 `protobuf:"fixed64,6,opt,name=coins_used,json=coinsUsed,proto3" json:"coins_used,omitempty"`
+	Notes      string  `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
+	// Catalog line items. The server prices them and subtotal, when set, must match.
+	LineItems     []*schema.OrderLineItem `protobuf:"bytes,8,rep,name=line_items,json=lineItems,proto3" json:"line_items,omitempty"`
+	OutletId      int64                   `protobuf:"varint,9,opt,name=outlet_id,json=outletId,proto3" json:"outlet_id,omitempty"` // merchant address the order is placed at, optional
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -114,6 +119,20 @@ func (x *CreateOrderRequest) GetNotes() string {
 	return ""
 }
 
+func (x *CreateOrderRequest) GetLineItems() []*schema.OrderLineItem {
+	if x != nil {
+		return x.LineItems
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetOutletId() int64 {
+	if x != nil {
+		return x.OutletId
+	}
+	return 0
+}
+
 type CreateOrderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Order         *schema.Order          `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
@@ -248,7 +267,7 @@ func (x *GetOrderResponse) GetOrder() *schema.Order {
 
 type GetUserOrdersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // optional, must be the signed in customer
 	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
 	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
@@ -462,6 +481,95 @@ func (x *CancelOrderResponse) GetSuccess() bool {
 	return false
 }
 
+// The customer accepts an order a merchant created for them, paying the coins
+type ConfirmOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmOrderRequest) Reset() {
+	*x = ConfirmOrderRequest{}
+	mi := &file_proto_api_orders_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmOrderRequest) ProtoMessage() {}
+
+func (x *ConfirmOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_orders_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmOrderRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmOrderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_orders_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ConfirmOrderRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+type ConfirmOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *schema.Order          `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmOrderResponse) Reset() {
+	*x = ConfirmOrderResponse{}
+	mi := &file_proto_api_orders_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmOrderResponse) ProtoMessage() {}
+
+func (x *ConfirmOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_orders_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmOrderResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmOrderResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_orders_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ConfirmOrderResponse) GetOrder() *schema.Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
 type StreamOrderUpdatesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -471,7 +579,7 @@ type StreamOrderUpdatesRequest struct {
 
 func (x *StreamOrderUpdatesRequest) Reset() {
 	*x = StreamOrderUpdatesRequest{}
-	mi := &file_proto_api_orders_proto_msgTypes[8]
+	mi := &file_proto_api_orders_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -483,7 +591,7 @@ func (x *StreamOrderUpdatesRequest) String() string {
 func (*StreamOrderUpdatesRequest) ProtoMessage() {}
 
 func (x *StreamOrderUpdatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_orders_proto_msgTypes[8]
+	mi := &file_proto_api_orders_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -496,7 +604,7 @@ func (x *StreamOrderUpdatesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamOrderUpdatesRequest.ProtoReflect.Descriptor instead.
 func (*StreamOrderUpdatesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_orders_proto_rawDescGZIP(), []int{8}
+	return file_proto_api_orders_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *StreamOrderUpdatesRequest) GetUserId() int64 {
@@ -509,14 +617,14 @@ func (x *StreamOrderUpdatesRequest) GetUserId() int64 {
 type StreamOrderUpdatesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Order         *schema.Order          `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
-	EventType     string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"` // created, confirmed, completed, cancelled
+	EventType     string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"` // created, or the status the order moved to
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *StreamOrderUpdatesResponse) Reset() {
 	*x = StreamOrderUpdatesResponse{}
-	mi := &file_proto_api_orders_proto_msgTypes[9]
+	mi := &file_proto_api_orders_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -528,7 +636,7 @@ func (x *StreamOrderUpdatesResponse) String() string {
 func (*StreamOrderUpdatesResponse) ProtoMessage() {}
 
 func (x *StreamOrderUpdatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_orders_proto_msgTypes[9]
+	mi := &file_proto_api_orders_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -541,7 +649,7 @@ func (x *StreamOrderUpdatesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamOrderUpdatesResponse.ProtoReflect.Descriptor instead.
 func (*StreamOrderUpdatesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_orders_proto_rawDescGZIP(), []int{9}
+	return file_proto_api_orders_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *StreamOrderUpdatesResponse) GetOrder() *schema.Order {
@@ -558,11 +666,100 @@ func (x *StreamOrderUpdatesResponse) GetEventType() string {
 	return ""
 }
 
+// The receipt is issued the first time a completed order asks for it
+type GetReceiptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReceiptRequest) Reset() {
+	*x = GetReceiptRequest{}
+	mi := &file_proto_api_orders_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceiptRequest) ProtoMessage() {}
+
+func (x *GetReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_orders_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceiptRequest.ProtoReflect.Descriptor instead.
+func (*GetReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_orders_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetReceiptRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+type GetReceiptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Receipt       *schema.Receipt        `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReceiptResponse) Reset() {
+	*x = GetReceiptResponse{}
+	mi := &file_proto_api_orders_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReceiptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceiptResponse) ProtoMessage() {}
+
+func (x *GetReceiptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_orders_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceiptResponse.ProtoReflect.Descriptor instead.
+func (*GetReceiptResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_orders_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetReceiptResponse) GetReceipt() *schema.Receipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
 var File_proto_api_orders_proto protoreflect.FileDescriptor
 
 const file_proto_api_orders_proto_rawDesc = "" +
 	"\n" +
-	"\x16proto/api/orders.proto\x12\frival.api.v1\x1a\x19proto/schema/schema.proto\"\xd0\x01\n" +
+	"\x16proto/api/orders.proto\x12\frival.api.v1\x1a\x19proto/schema/schema.proto\"\xac\x02\n" +
 	"\x12CreateOrderRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
 	"\vmerchant_id\x18\x02 \x01(\x03R\n" +
@@ -572,7 +769,10 @@ const file_proto_api_orders_proto_rawDesc = "" +
 	"\bsubtotal\x18\x05 \x01(\x01R\bsubtotal\x12\x1d\n" +
 	"\n" +
 	"coins_used\x18\x06 \x01(\x01R\tcoinsUsed\x12\x14\n" +
-	"\x05notes\x18\a \x01(\tR\x05notes\"C\n" +
+	"\x05notes\x18\a \x01(\tR\x05notes\x12=\n" +
+	"\n" +
+	"line_items\x18\b \x03(\v2\x1e.rival.schema.v1.OrderLineItemR\tlineItems\x12\x1b\n" +
+	"\toutlet_id\x18\t \x01(\x03R\boutletId\"C\n" +
 	"\x13CreateOrderResponse\x12,\n" +
 	"\x05order\x18\x01 \x01(\v2\x16.rival.schema.v1.OrderR\x05order\",\n" +
 	"\x0fGetOrderRequest\x12\x19\n" +
@@ -592,19 +792,30 @@ const file_proto_api_orders_proto_rawDesc = "" +
 	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x16\n" +
 	"\x06reason\x18\x02 \x01(\tR\x06reason\"/\n" +
 	"\x13CancelOrderResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"4\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"0\n" +
+	"\x13ConfirmOrderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\"D\n" +
+	"\x14ConfirmOrderResponse\x12,\n" +
+	"\x05order\x18\x01 \x01(\v2\x16.rival.schema.v1.OrderR\x05order\"4\n" +
 	"\x19StreamOrderUpdatesRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\"i\n" +
 	"\x1aStreamOrderUpdatesResponse\x12,\n" +
 	"\x05order\x18\x01 \x01(\v2\x16.rival.schema.v1.OrderR\x05order\x12\x1d\n" +
 	"\n" +
-	"event_type\x18\x02 \x01(\tR\teventType2\xc6\x03\n" +
+	"event_type\x18\x02 \x01(\tR\teventType\".\n" +
+	"\x11GetReceiptRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\"H\n" +
+	"\x12GetReceiptResponse\x122\n" +
+	"\areceipt\x18\x01 \x01(\v2\x18.rival.schema.v1.ReceiptR\areceipt2\xee\x04\n" +
 	"\fOrderService\x12R\n" +
 	"\vCreateOrder\x12 .rival.api.v1.CreateOrderRequest\x1a!.rival.api.v1.CreateOrderResponse\x12I\n" +
 	"\bGetOrder\x12\x1d.rival.api.v1.GetOrderRequest\x1a\x1e.rival.api.v1.GetOrderResponse\x12X\n" +
 	"\rGetUserOrders\x12\".rival.api.v1.GetUserOrdersRequest\x1a#.rival.api.v1.GetUserOrdersResponse\x12R\n" +
-	"\vCancelOrder\x12 .rival.api.v1.CancelOrderRequest\x1a!.rival.api.v1.CancelOrderResponse\x12i\n" +
-	"\x12StreamOrderUpdates\x12'.rival.api.v1.StreamOrderUpdatesRequest\x1a(.rival.api.v1.StreamOrderUpdatesResponse0\x01B\x1bZ\x19rival/gen/proto/proto/apib\x06proto3"
+	"\vCancelOrder\x12 .rival.api.v1.CancelOrderRequest\x1a!.rival.api.v1.CancelOrderResponse\x12U\n" +
+	"\fConfirmOrder\x12!.rival.api.v1.ConfirmOrderRequest\x1a\".rival.api.v1.ConfirmOrderResponse\x12i\n" +
+	"\x12StreamOrderUpdates\x12'.rival.api.v1.StreamOrderUpdatesRequest\x1a(.rival.api.v1.StreamOrderUpdatesResponse0\x01\x12O\n" +
+	"\n" +
+	"GetReceipt\x12\x1f.rival.api.v1.GetReceiptRequest\x1a .rival.api.v1.GetReceiptResponseB\x1bZ\x19rival/gen/proto/proto/apib\x06proto3"
 
 var (
 	file_proto_api_orders_proto_rawDescOnce sync.Once
@@ -618,7 +829,7 @@ func file_proto_api_orders_proto_rawDescGZIP() []byte {
 	return file_proto_api_orders_proto_rawDescData
 }
 
-var file_proto_api_orders_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_proto_api_orders_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_proto_api_orders_proto_goTypes = []any{
 	(*CreateOrderRequest)(nil),         // 0: rival.api.v1.CreateOrderRequest
 	(*CreateOrderResponse)(nil),        // 1: rival.api.v1.CreateOrderResponse
@@ -628,30 +839,43 @@ var file_proto_api_orders_proto_goTypes = []any{
 	(*GetUserOrdersResponse)(nil),      // 5: rival.api.v1.GetUserOrdersResponse
 	(*CancelOrderRequest)(nil),         // 6: rival.api.v1.CancelOrderRequest
 	(*CancelOrderResponse)(nil),        // 7: rival.api.v1.CancelOrderResponse
-	(*StreamOrderUpdatesRequest)(nil),  // 8: rival.api.v1.StreamOrderUpdatesRequest
-	(*StreamOrderUpdatesResponse)(nil), // 9: rival.api.v1.StreamOrderUpdatesResponse
-	(*schema.Order)(nil),               // 10: rival.schema.v1.Order
+	(*ConfirmOrderRequest)(nil),        // 8: rival.api.v1.ConfirmOrderRequest
+	(*ConfirmOrderResponse)(nil),       // 9: rival.api.v1.ConfirmOrderResponse
+	(*StreamOrderUpdatesRequest)(nil),  // 10: rival.api.v1.StreamOrderUpdatesRequest
+	(*StreamOrderUpdatesResponse)(nil), // 11: rival.api.v1.StreamOrderUpdatesResponse
+	(*GetReceiptRequest)(nil),          // 12: rival.api.v1.GetReceiptRequest
+	(*GetReceiptResponse)(nil),         // 13: rival.api.v1.GetReceiptResponse
+	(*schema.OrderLineItem)(nil),       // 14: rival.schema.v1.OrderLineItem
+	(*schema.Order)(nil),               // 15: rival.schema.v1.Order
+	(*schema.Receipt)(nil),             // 16: rival.schema.v1.Receipt
 }
 var file_proto_api_orders_proto_depIdxs = []int32{
-	10, // 0: rival.api.v1.CreateOrderResponse.order:type_name -> rival.schema.v1.Order
-	10, // 1: rival.api.v1.GetOrderResponse.order:type_name -> rival.schema.v1.Order
-	10, // 2: rival.api.v1.GetUserOrdersResponse.orders:type_name -> rival.schema.v1.Order
-	10, // 3: rival.api.v1.StreamOrderUpdatesResponse.order:type_name -> rival.schema.v1.Order
-	0,  // 4: rival.api.v1.OrderService.CreateOrder:input_type -> rival.api.v1.CreateOrderRequest
-	2,  // 5: rival.api.v1.OrderService.GetOrder:input_type -> rival.api.v1.GetOrderRequest
-	4,  // 6: rival.api.v1.OrderService.GetUserOrders:input_type -> rival.api.v1.GetUserOrdersRequest
-	6,  // 7: rival.api.v1.OrderService.CancelOrder:input_type -> rival.api.v1.CancelOrderRequest
-	8,  // 8: rival.api.v1.OrderService.StreamOrderUpdates:input_type -> rival.api.v1.StreamOrderUpdatesRequest
-	1,  // 9: rival.api.v1.OrderService.CreateOrder:output_type -> rival.api.v1.CreateOrderResponse
-	3,  // 10: rival.api.v1.OrderService.GetOrder:output_type -> rival.api.v1.GetOrderResponse
-	5,  // 11: rival.api.v1.OrderService.GetUserOrders:output_type -> rival.api.v1.GetUserOrdersResponse
-	7,  // 12: rival.api.v1.OrderService.CancelOrder:output_type -> rival.api.v1.CancelOrderResponse
-	9,  // 13: rival.api.v1.OrderService.StreamOrderUpdates:output_type -> rival.api.v1.StreamOrderUpdatesResponse
-	9,  // [9:14] is the sub-list for method output_type
-	4,  // [4:9] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	14, // 0: rival.api.v1.CreateOrderRequest.line_items:type_name -> rival.schema.v1.OrderLineItem
+	15, // 1: rival.api.v1.CreateOrderResponse.order:type_name -> rival.schema.v1.Order
+	15, // 2: rival.api.v1.GetOrderResponse.order:type_name -> rival.schema.v1.Order
+	15, // 3: rival.api.v1.GetUserOrdersResponse.orders:type_name -> rival.schema.v1.Order
+	15, // 4: rival.api.v1.ConfirmOrderResponse.order:type_name -> rival.schema.v1.Order
+	15, // 5: rival.api.v1.StreamOrderUpdatesResponse.order:type_name -> rival.schema.v1.Order
+	16, // 6: rival.api.v1.GetReceiptResponse.receipt:type_name -> rival.schema.v1.Receipt
+	0,  // 7: rival.api.v1.OrderService.CreateOrder:input_type -> rival.api.v1.CreateOrderRequest
+	2,  // 8: rival.api.v1.OrderService.GetOrder:input_type -> rival.api.v1.GetOrderRequest
+	4,  // 9: rival.api.v1.OrderService.GetUserOrders:input_type -> rival.api.v1.GetUserOrdersRequest
+	6,  // 10: rival.api.v1.OrderService.CancelOrder:input_type -> rival.api.v1.CancelOrderRequest
+	8,  // 11: rival.api.v1.OrderService.ConfirmOrder:input_type -> rival.api.v1.ConfirmOrderRequest
+	10, // 12: rival.api.v1.OrderService.StreamOrderUpdates:input_type -> rival.api.v1.StreamOrderUpdatesRequest
+	12, // 13: rival.api.v1.OrderService.GetReceipt:input_type -> rival.api.v1.GetReceiptRequest
+	1,  // 14: rival.api.v1.OrderService.CreateOrder:output_type -> rival.api.v1.CreateOrderResponse
+	3,  // 15: rival.api.v1.OrderService.GetOrder:output_type -> rival.api.v1.GetOrderResponse
+	5,  // 16: rival.api.v1.OrderService.GetUserOrders:output_type -> rival.api.v1.GetUserOrdersResponse
+	7,  // 17: rival.api.v1.OrderService.CancelOrder:output_type -> rival.api.v1.CancelOrderResponse
+	9,  // 18: rival.api.v1.OrderService.ConfirmOrder:output_type -> rival.api.v1.ConfirmOrderResponse
+	11, // 19: rival.api.v1.OrderService.StreamOrderUpdates:output_type -> rival.api.v1.StreamOrderUpdatesResponse
+	13, // 20: rival.api.v1.OrderService.GetReceipt:output_type -> rival.api.v1.GetReceiptResponse
+	14, // [14:21] is the sub-list for method output_type
+	7,  // [7:14] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_proto_api_orders_proto_init() }
@@ -665,7 +889,7 @@ func file_proto_api_orders_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_api_orders_proto_rawDesc), len(file_proto_api_orders_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
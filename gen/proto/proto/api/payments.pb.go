@@ -1911,6 +1911,95 @@ func (x *GetFinancialHistoryResponse) GetCurrentBalance() float64 {
 	return 0
 }
 
+// Receipts are issued for completed payments to merchants
+type GetTransactionReceiptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId int64                  `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionReceiptRequest) Reset() {
+	*x = GetTransactionReceiptRequest{}
+	mi := &file_proto_api_payments_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionReceiptRequest) ProtoMessage() {}
+
+func (x *GetTransactionReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_payments_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionReceiptRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_payments_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetTransactionReceiptRequest) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+type GetTransactionReceiptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Receipt       *schema.Receipt        `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionReceiptResponse) Reset() {
+	*x = GetTransactionReceiptResponse{}
+	mi := &file_proto_api_payments_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionReceiptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionReceiptResponse) ProtoMessage() {}
+
+func (x *GetTransactionReceiptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_payments_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionReceiptResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionReceiptResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_payments_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetTransactionReceiptResponse) GetReceipt() *schema.Receipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
 var File_proto_api_payments_proto protoreflect.FileDescriptor
 
 const file_proto_api_payments_proto_rawDesc = "" +
@@ -2074,7 +2163,11 @@ const file_proto_api_payments_proto_rawDesc = "" +
 	"\x05items\x18\x01 \x03(\v2\".rival.api.v1.FinancialHistoryItemR\x05items\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
 	"totalCount\x12'\n" +
-	"\x0fcurrent_balance\x18\x03 \x01(\x01R\x0ecurrentBalance2\x8d\v\n" +
+	"\x0fcurrent_balance\x18\x03 \x01(\x01R\x0ecurrentBalance\"E\n" +
+	"\x1cGetTransactionReceiptRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\x03R\rtransactionId\"S\n" +
+	"\x1dGetTransactionReceiptResponse\x122\n" +
+	"\areceipt\x18\x01 \x01(\v2\x18.rival.schema.v1.ReceiptR\areceipt2\xff\v\n" +
 	"\x0ePaymentService\x12m\n" +
 	"\x14InitiateCoinPurchase\x12).rival.api.v1.InitiateCoinPurchaseRequest\x1a*.rival.api.v1.InitiateCoinPurchaseResponse\x12X\n" +
 	"\rVerifyPayment\x12\".rival.api.v1.VerifyPaymentRequest\x1a#.rival.api.v1.VerifyPaymentResponse\x12d\n" +
@@ -2086,7 +2179,8 @@ const file_proto_api_payments_proto_rawDesc = "" +
 	"GetBalance\x12\x1f.rival.api.v1.GetBalanceRequest\x1a .rival.api.v1.GetBalanceResponse\x12p\n" +
 	"\x15GetTransactionHistory\x12*.rival.api.v1.GetTransactionHistoryRequest\x1a+.rival.api.v1.GetTransactionHistoryResponse\x12X\n" +
 	"\rProcessRefund\x12\".rival.api.v1.ProcessRefundRequest\x1a#.rival.api.v1.ProcessRefundResponse\x12j\n" +
-	"\x13GetFinancialHistory\x12(.rival.api.v1.GetFinancialHistoryRequest\x1a).rival.api.v1.GetFinancialHistoryResponse\x12g\n" +
+	"\x13GetFinancialHistory\x12(.rival.api.v1.GetFinancialHistoryRequest\x1a).rival.api.v1.GetFinancialHistoryResponse\x12p\n" +
+	"\x15GetTransactionReceipt\x12*.rival.api.v1.GetTransactionReceiptRequest\x1a+.rival.api.v1.GetTransactionReceiptResponse\x12g\n" +
 	"\x12InitiateSettlement\x12'.rival.api.v1.InitiateSettlementRequest\x1a(.rival.api.v1.InitiateSettlementResponse\x12[\n" +
 	"\x0eGetSettlements\x12#.rival.api.v1.GetSettlementsRequest\x1a$.rival.api.v1.GetSettlementsResponse\x12o\n" +
 	"\x14StreamPaymentUpdates\x12).rival.api.v1.StreamPaymentUpdatesRequest\x1a*.rival.api.v1.StreamPaymentUpdatesResponse0\x01\x12{\n" +
@@ -2104,7 +2198,7 @@ func file_proto_api_payments_proto_rawDescGZIP() []byte {
 	return file_proto_api_payments_proto_rawDescData
 }
 
-var file_proto_api_payments_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
+var file_proto_api_payments_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
 var file_proto_api_payments_proto_goTypes = []any{
 	(*InitiateCoinPurchaseRequest)(nil),      // 0: rival.api.v1.InitiateCoinPurchaseRequest
 	(*InitiateCoinPurchaseResponse)(nil),     // 1: rival.api.v1.InitiateCoinPurchaseResponse
@@ -2135,55 +2229,61 @@ var file_proto_api_payments_proto_goTypes = []any{
 	(*GetFinancialHistoryRequest)(nil),       // 26: rival.api.v1.GetFinancialHistoryRequest
 	(*FinancialHistoryItem)(nil),             // 27: rival.api.v1.FinancialHistoryItem
 	(*GetFinancialHistoryResponse)(nil),      // 28: rival.api.v1.GetFinancialHistoryResponse
-	(*schema.CoinPurchase)(nil),              // 29: rival.schema.v1.CoinPurchase
-	(*schema.Transaction)(nil),               // 30: rival.schema.v1.Transaction
-	(*schema.Settlement)(nil),                // 31: rival.schema.v1.Settlement
+	(*GetTransactionReceiptRequest)(nil),     // 29: rival.api.v1.GetTransactionReceiptRequest
+	(*GetTransactionReceiptResponse)(nil),    // 30: rival.api.v1.GetTransactionReceiptResponse
+	(*schema.CoinPurchase)(nil),              // 31: rival.schema.v1.CoinPurchase
+	(*schema.Transaction)(nil),               // 32: rival.schema.v1.Transaction
+	(*schema.Settlement)(nil),                // 33: rival.schema.v1.Settlement
+	(*schema.Receipt)(nil),                   // 34: rival.schema.v1.Receipt
 }
 var file_proto_api_payments_proto_depIdxs = []int32{
-	29, // 0: rival.api.v1.VerifyPaymentResponse.purchase:type_name -> rival.schema.v1.CoinPurchase
-	29, // 1: rival.api.v1.GetPaymentHistoryResponse.purchases:type_name -> rival.schema.v1.CoinPurchase
-	30, // 2: rival.api.v1.PayToMerchantResponse.transaction:type_name -> rival.schema.v1.Transaction
-	30, // 3: rival.api.v1.TransferToUserResponse.transaction:type_name -> rival.schema.v1.Transaction
-	30, // 4: rival.api.v1.GetTransactionHistoryResponse.transactions:type_name -> rival.schema.v1.Transaction
-	30, // 5: rival.api.v1.ProcessRefundResponse.refund_transaction:type_name -> rival.schema.v1.Transaction
-	31, // 6: rival.api.v1.InitiateSettlementResponse.settlement:type_name -> rival.schema.v1.Settlement
-	31, // 7: rival.api.v1.GetSettlementsResponse.settlements:type_name -> rival.schema.v1.Settlement
-	29, // 8: rival.api.v1.StreamPaymentUpdatesResponse.purchase:type_name -> rival.schema.v1.CoinPurchase
-	30, // 9: rival.api.v1.StreamTransactionUpdatesResponse.transaction:type_name -> rival.schema.v1.Transaction
+	31, // 0: rival.api.v1.VerifyPaymentResponse.purchase:type_name -> rival.schema.v1.CoinPurchase
+	31, // 1: rival.api.v1.GetPaymentHistoryResponse.purchases:type_name -> rival.schema.v1.CoinPurchase
+	32, // 2: rival.api.v1.PayToMerchantResponse.transaction:type_name -> rival.schema.v1.Transaction
+	32, // 3: rival.api.v1.TransferToUserResponse.transaction:type_name -> rival.schema.v1.Transaction
+	32, // 4: rival.api.v1.GetTransactionHistoryResponse.transactions:type_name -> rival.schema.v1.Transaction
+	32, // 5: rival.api.v1.ProcessRefundResponse.refund_transaction:type_name -> rival.schema.v1.Transaction
+	33, // 6: rival.api.v1.InitiateSettlementResponse.settlement:type_name -> rival.schema.v1.Settlement
+	33, // 7: rival.api.v1.GetSettlementsResponse.settlements:type_name -> rival.schema.v1.Settlement
+	31, // 8: rival.api.v1.StreamPaymentUpdatesResponse.purchase:type_name -> rival.schema.v1.CoinPurchase
+	32, // 9: rival.api.v1.StreamTransactionUpdatesResponse.transaction:type_name -> rival.schema.v1.Transaction
 	27, // 10: rival.api.v1.GetFinancialHistoryResponse.items:type_name -> rival.api.v1.FinancialHistoryItem
-	0,  // 11: rival.api.v1.PaymentService.InitiateCoinPurchase:input_type -> rival.api.v1.InitiateCoinPurchaseRequest
-	2,  // 12: rival.api.v1.PaymentService.VerifyPayment:input_type -> rival.api.v1.VerifyPaymentRequest
-	4,  // 13: rival.api.v1.PaymentService.GetPaymentHistory:input_type -> rival.api.v1.GetPaymentHistoryRequest
-	6,  // 14: rival.api.v1.PaymentService.RefundPayment:input_type -> rival.api.v1.RefundPaymentRequest
-	8,  // 15: rival.api.v1.PaymentService.PayToMerchant:input_type -> rival.api.v1.PayToMerchantRequest
-	10, // 16: rival.api.v1.PaymentService.TransferToUser:input_type -> rival.api.v1.TransferToUserRequest
-	12, // 17: rival.api.v1.PaymentService.GetBalance:input_type -> rival.api.v1.GetBalanceRequest
-	14, // 18: rival.api.v1.PaymentService.GetTransactionHistory:input_type -> rival.api.v1.GetTransactionHistoryRequest
-	16, // 19: rival.api.v1.PaymentService.ProcessRefund:input_type -> rival.api.v1.ProcessRefundRequest
-	26, // 20: rival.api.v1.PaymentService.GetFinancialHistory:input_type -> rival.api.v1.GetFinancialHistoryRequest
-	18, // 21: rival.api.v1.PaymentService.InitiateSettlement:input_type -> rival.api.v1.InitiateSettlementRequest
-	20, // 22: rival.api.v1.PaymentService.GetSettlements:input_type -> rival.api.v1.GetSettlementsRequest
-	22, // 23: rival.api.v1.PaymentService.StreamPaymentUpdates:input_type -> rival.api.v1.StreamPaymentUpdatesRequest
-	24, // 24: rival.api.v1.PaymentService.StreamTransactionUpdates:input_type -> rival.api.v1.StreamTransactionUpdatesRequest
-	1,  // 25: rival.api.v1.PaymentService.InitiateCoinPurchase:output_type -> rival.api.v1.InitiateCoinPurchaseResponse
-	3,  // 26: rival.api.v1.PaymentService.VerifyPayment:output_type -> rival.api.v1.VerifyPaymentResponse
-	5,  // 27: rival.api.v1.PaymentService.GetPaymentHistory:output_type -> rival.api.v1.GetPaymentHistoryResponse
-	7,  // 28: rival.api.v1.PaymentService.RefundPayment:output_type -> rival.api.v1.RefundPaymentResponse
-	9,  // 29: rival.api.v1.PaymentService.PayToMerchant:output_type -> rival.api.v1.PayToMerchantResponse
-	11, // 30: rival.api.v1.PaymentService.TransferToUser:output_type -> rival.api.v1.TransferToUserResponse
-	13, // 31: rival.api.v1.PaymentService.GetBalance:output_type -> rival.api.v1.GetBalanceResponse
-	15, // 32: rival.api.v1.PaymentService.GetTransactionHistory:output_type -> rival.api.v1.GetTransactionHistoryResponse
-	17, // 33: rival.api.v1.PaymentService.ProcessRefund:output_type -> rival.api.v1.ProcessRefundResponse
-	28, // 34: rival.api.v1.PaymentService.GetFinancialHistory:output_type -> rival.api.v1.GetFinancialHistoryResponse
-	19, // 35: rival.api.v1.PaymentService.InitiateSettlement:output_type -> rival.api.v1.InitiateSettlementResponse
-	21, // 36: rival.api.v1.PaymentService.GetSettlements:output_type -> rival.api.v1.GetSettlementsResponse
-	23, // 37: rival.api.v1.PaymentService.StreamPaymentUpdates:output_type -> rival.api.v1.StreamPaymentUpdatesResponse
-	25, // 38: rival.api.v1.PaymentService.StreamTransactionUpdates:output_type -> rival.api.v1.StreamTransactionUpdatesResponse
-	25, // [25:39] is the sub-list for method output_type
-	11, // [11:25] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	34, // 11: rival.api.v1.GetTransactionReceiptResponse.receipt:type_name -> rival.schema.v1.Receipt
+	0,  // 12: rival.api.v1.PaymentService.InitiateCoinPurchase:input_type -> rival.api.v1.InitiateCoinPurchaseRequest
+	2,  // 13: rival.api.v1.PaymentService.VerifyPayment:input_type -> rival.api.v1.VerifyPaymentRequest
+	4,  // 14: rival.api.v1.PaymentService.GetPaymentHistory:input_type -> rival.api.v1.GetPaymentHistoryRequest
+	6,  // 15: rival.api.v1.PaymentService.RefundPayment:input_type -> rival.api.v1.RefundPaymentRequest
+	8,  // 16: rival.api.v1.PaymentService.PayToMerchant:input_type -> rival.api.v1.PayToMerchantRequest
+	10, // 17: rival.api.v1.PaymentService.TransferToUser:input_type -> rival.api.v1.TransferToUserRequest
+	12, // 18: rival.api.v1.PaymentService.GetBalance:input_type -> rival.api.v1.GetBalanceRequest
+	14, // 19: rival.api.v1.PaymentService.GetTransactionHistory:input_type -> rival.api.v1.GetTransactionHistoryRequest
+	16, // 20: rival.api.v1.PaymentService.ProcessRefund:input_type -> rival.api.v1.ProcessRefundRequest
+	26, // 21: rival.api.v1.PaymentService.GetFinancialHistory:input_type -> rival.api.v1.GetFinancialHistoryRequest
+	29, // 22: rival.api.v1.PaymentService.GetTransactionReceipt:input_type -> rival.api.v1.GetTransactionReceiptRequest
+	18, // 23: rival.api.v1.PaymentService.InitiateSettlement:input_type -> rival.api.v1.InitiateSettlementRequest
+	20, // 24: rival.api.v1.PaymentService.GetSettlements:input_type -> rival.api.v1.GetSettlementsRequest
+	22, // 25: rival.api.v1.PaymentService.StreamPaymentUpdates:input_type -> rival.api.v1.StreamPaymentUpdatesRequest
+	24, // 26: rival.api.v1.PaymentService.StreamTransactionUpdates:input_type -> rival.api.v1.StreamTransactionUpdatesRequest
+	1,  // 27: rival.api.v1.PaymentService.InitiateCoinPurchase:output_type -> rival.api.v1.InitiateCoinPurchaseResponse
+	3,  // 28: rival.api.v1.PaymentService.VerifyPayment:output_type -> rival.api.v1.VerifyPaymentResponse
+	5,  // 29: rival.api.v1.PaymentService.GetPaymentHistory:output_type -> rival.api.v1.GetPaymentHistoryResponse
+	7,  // 30: rival.api.v1.PaymentService.RefundPayment:output_type -> rival.api.v1.RefundPaymentResponse
+	9,  // 31: rival.api.v1.PaymentService.PayToMerchant:output_type -> rival.api.v1.PayToMerchantResponse
+	11, // 32: rival.api.v1.PaymentService.TransferToUser:output_type -> rival.api.v1.TransferToUserResponse
+	13, // 33: rival.api.v1.PaymentService.GetBalance:output_type -> rival.api.v1.GetBalanceResponse
+	15, // 34: rival.api.v1.PaymentService.GetTransactionHistory:output_type -> rival.api.v1.GetTransactionHistoryResponse
+	17, // 35: rival.api.v1.PaymentService.ProcessRefund:output_type -> rival.api.v1.ProcessRefundResponse
+	28, // 36: rival.api.v1.PaymentService.GetFinancialHistory:output_type -> rival.api.v1.GetFinancialHistoryResponse
+	30, // 37: rival.api.v1.PaymentService.GetTransactionReceipt:output_type -> rival.api.v1.GetTransactionReceiptResponse
+	19, // 38: rival.api.v1.PaymentService.InitiateSettlement:output_type -> rival.api.v1.InitiateSettlementResponse
+	21, // 39: rival.api.v1.PaymentService.GetSettlements:output_type -> rival.api.v1.GetSettlementsResponse
+	23, // 40: rival.api.v1.PaymentService.StreamPaymentUpdates:output_type -> rival.api.v1.StreamPaymentUpdatesResponse
+	25, // 41: rival.api.v1.PaymentService.StreamTransactionUpdates:output_type -> rival.api.v1.StreamTransactionUpdatesResponse
+	27, // [27:42] is the sub-list for method output_type
+	12, // [12:27] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_proto_api_payments_proto_init() }
@@ -2197,7 +2297,7 @@ func file_proto_api_payments_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_api_payments_proto_rawDesc), len(file_proto_api_payments_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   29,
+			NumMessages:   31,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -29,6 +29,7 @@ const (
 	PaymentService_GetTransactionHistory_FullMethodName    = "/rival.api.v1.PaymentService/GetTransactionHistory"
 	PaymentService_ProcessRefund_FullMethodName            = "/rival.api.v1.PaymentService/ProcessRefund"
 	PaymentService_GetFinancialHistory_FullMethodName      = "/rival.api.v1.PaymentService/GetFinancialHistory"
+	PaymentService_GetTransactionReceipt_FullMethodName    = "/rival.api.v1.PaymentService/GetTransactionReceipt"
 	PaymentService_InitiateSettlement_FullMethodName       = "/rival.api.v1.PaymentService/InitiateSettlement"
 	PaymentService_GetSettlements_FullMethodName           = "/rival.api.v1.PaymentService/GetSettlements"
 	PaymentService_StreamPaymentUpdates_FullMethodName     = "/rival.api.v1.PaymentService/StreamPaymentUpdates"
@@ -51,6 +52,7 @@ type PaymentServiceClient interface {
 	GetTransactionHistory(ctx context.Context, in *GetTransactionHistoryRequest, opts ...grpc.CallOption) (*GetTransactionHistoryResponse, error)
 	ProcessRefund(ctx context.Context, in *ProcessRefundRequest, opts ...grpc.CallOption) (*ProcessRefundResponse, error)
 	GetFinancialHistory(ctx context.Context, in *GetFinancialHistoryRequest, opts ...grpc.CallOption) (*GetFinancialHistoryResponse, error)
+	GetTransactionReceipt(ctx context.Context, in *GetTransactionReceiptRequest, opts ...grpc.CallOption) (*GetTransactionReceiptResponse, error)
 	// Merchant Settlements
 	InitiateSettlement(ctx context.Context, in *InitiateSettlementRequest, opts ...grpc.CallOption) (*InitiateSettlementResponse, error)
 	GetSettlements(ctx context.Context, in *GetSettlementsRequest, opts ...grpc.CallOption) (*GetSettlementsResponse, error)
@@ -167,6 +169,16 @@ func (c *paymentServiceClient) GetFinancialHistory(ctx context.Context, in *GetF
 	return out, nil
 }
 
+func (c *paymentServiceClient) GetTransactionReceipt(ctx context.Context, in *GetTransactionReceiptRequest, opts ...grpc.CallOption) (*GetTransactionReceiptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTransactionReceiptResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GetTransactionReceipt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *paymentServiceClient) InitiateSettlement(ctx context.Context, in *InitiateSettlementRequest, opts ...grpc.CallOption) (*InitiateSettlementResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(InitiateSettlementResponse)
@@ -241,6 +253,7 @@ type PaymentServiceServer interface {
 	GetTransactionHistory(context.Context, *GetTransactionHistoryRequest) (*GetTransactionHistoryResponse, error)
 	ProcessRefund(context.Context, *ProcessRefundRequest) (*ProcessRefundResponse, error)
 	GetFinancialHistory(context.Context, *GetFinancialHistoryRequest) (*GetFinancialHistoryResponse, error)
+	GetTransactionReceipt(context.Context, *GetTransactionReceiptRequest) (*GetTransactionReceiptResponse, error)
 	// Merchant Settlements
 	InitiateSettlement(context.Context, *InitiateSettlementRequest) (*InitiateSettlementResponse, error)
 	GetSettlements(context.Context, *GetSettlementsRequest) (*GetSettlementsResponse, error)
@@ -287,6 +300,9 @@ func (UnimplementedPaymentServiceServer) ProcessRefund(context.Context, *Process
 func (UnimplementedPaymentServiceServer) GetFinancialHistory(context.Context, *GetFinancialHistoryRequest) (*GetFinancialHistoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFinancialHistory not implemented")
 }
+func (UnimplementedPaymentServiceServer) GetTransactionReceipt(context.Context, *GetTransactionReceiptRequest) (*GetTransactionReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionReceipt not implemented")
+}
 func (UnimplementedPaymentServiceServer) InitiateSettlement(context.Context, *InitiateSettlementRequest) (*InitiateSettlementResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InitiateSettlement not implemented")
 }
@@ -500,6 +516,24 @@ func _PaymentService_GetFinancialHistory_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentService_GetTransactionReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetTransactionReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetTransactionReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetTransactionReceipt(ctx, req.(*GetTransactionReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PaymentService_InitiateSettlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InitiateSettlementRequest)
 	if err := dec(in); err != nil {
@@ -605,6 +639,10 @@ var PaymentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFinancialHistory",
 			Handler:    _PaymentService_GetFinancialHistory_Handler,
 		},
+		{
+			MethodName: "GetTransactionReceipt",
+			Handler:    _PaymentService_GetTransactionReceipt_Handler,
+		},
 		{
 			MethodName: "InitiateSettlement",
 			Handler:    _PaymentService_InitiateSettlement_Handler,
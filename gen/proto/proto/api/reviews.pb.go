@@ -0,0 +1,608 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.21.12
+// source: proto/api/reviews.proto
+
+package api
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	schema "rival/gen/proto/proto/schema"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Only the customer of a completed order can review it, once
+type CreateReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Rating        int32                  `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"` // 1 to 5 stars
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	PhotoKeys     []string               `protobuf:"bytes,4,rep,name=photo_keys,json=photoKeys,proto3" json:"photo_keys,omitempty"` // object keys from RequestReviewPhotoUpload
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReviewRequest) Reset() {
+	*x = CreateReviewRequest{}
+	mi := &file_proto_api_reviews_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReviewRequest) ProtoMessage() {}
+
+func (x *CreateReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_reviews_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReviewRequest.ProtoReflect.Descriptor instead.
+func (*CreateReviewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_reviews_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateReviewRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *CreateReviewRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *CreateReviewRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *CreateReviewRequest) GetPhotoKeys() []string {
+	if x != nil {
+		return x.PhotoKeys
+	}
+	return nil
+}
+
+type CreateReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Review        *schema.Review         `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReviewResponse) Reset() {
+	*x = CreateReviewResponse{}
+	mi := &file_proto_api_reviews_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReviewResponse) ProtoMessage() {}
+
+func (x *CreateReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_reviews_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReviewResponse.ProtoReflect.Descriptor instead.
+func (*CreateReviewResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_reviews_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateReviewResponse) GetReview() *schema.Review {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+type RequestReviewPhotoUploadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // image/jpeg, image/png, image/webp
+	SizeBytes     int64                  `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestReviewPhotoUploadRequest) Reset() {
+	*x = RequestReviewPhotoUploadRequest{}
+	mi := &file_proto_api_reviews_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestReviewPhotoUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestReviewPhotoUploadRequest) ProtoMessage() {}
+
+func (x *RequestReviewPhotoUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_reviews_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestReviewPhotoUploadRequest.ProtoReflect.Descriptor instead.
+func (*RequestReviewPhotoUploadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_reviews_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RequestReviewPhotoUploadRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *RequestReviewPhotoUploadRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *RequestReviewPhotoUploadRequest) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+type RequestReviewPhotoUploadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadUrl     string                 `protobuf:"bytes,1,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"` // POST the file here as multipart form data
+	FormData      map[string]string      `protobuf:"bytes,2,rep,name=form_data,json=formData,proto3" json:"form_data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ObjectKey     string                 `protobuf:"bytes,3,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	ExpiresIn     int64                  `protobuf:"varint,4,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestReviewPhotoUploadResponse) Reset() {
+	*x = RequestReviewPhotoUploadResponse{}
+	mi := &file_proto_api_reviews_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestReviewPhotoUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestReviewPhotoUploadResponse) ProtoMessage() {}
+
+func (x *RequestReviewPhotoUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_reviews_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestReviewPhotoUploadResponse.ProtoReflect.Descriptor instead.
+func (*RequestReviewPhotoUploadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_reviews_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RequestReviewPhotoUploadResponse) GetUploadUrl() string {
+	if x != nil {
+		return x.UploadUrl
+	}
+	return ""
+}
+
+func (x *RequestReviewPhotoUploadResponse) GetFormData() map[string]string {
+	if x != nil {
+		return x.FormData
+	}
+	return nil
+}
+
+func (x *RequestReviewPhotoUploadResponse) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+func (x *RequestReviewPhotoUploadResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+type ListMerchantReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMerchantReviewsRequest) Reset() {
+	*x = ListMerchantReviewsRequest{}
+	mi := &file_proto_api_reviews_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMerchantReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMerchantReviewsRequest) ProtoMessage() {}
+
+func (x *ListMerchantReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_reviews_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMerchantReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListMerchantReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_reviews_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListMerchantReviewsRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *ListMerchantReviewsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListMerchantReviewsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListMerchantReviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviews       []*schema.Review       `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	RatingAverage float64                `protobuf:"fixed64,3,opt,name=rating_average,json=ratingAverage,proto3" json:"rating_average,omitempty"`
+	RatingCount   int32                  `protobuf:"varint,4,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMerchantReviewsResponse) Reset() {
+	*x = ListMerchantReviewsResponse{}
+	mi := &file_proto_api_reviews_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMerchantReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMerchantReviewsResponse) ProtoMessage() {}
+
+func (x *ListMerchantReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_reviews_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMerchantReviewsResponse.ProtoReflect.Descriptor instead.
+func (*ListMerchantReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_reviews_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListMerchantReviewsResponse) GetReviews() []*schema.Review {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+func (x *ListMerchantReviewsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListMerchantReviewsResponse) GetRatingAverage() float64 {
+	if x != nil {
+		return x.RatingAverage
+	}
+	return 0
+}
+
+func (x *ListMerchantReviewsResponse) GetRatingCount() int32 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
+type FlagReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      int64                  `protobuf:"varint,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlagReviewRequest) Reset() {
+	*x = FlagReviewRequest{}
+	mi := &file_proto_api_reviews_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlagReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlagReviewRequest) ProtoMessage() {}
+
+func (x *FlagReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_reviews_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlagReviewRequest.ProtoReflect.Descriptor instead.
+func (*FlagReviewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_reviews_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FlagReviewRequest) GetReviewId() int64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *FlagReviewRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type FlagReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlagReviewResponse) Reset() {
+	*x = FlagReviewResponse{}
+	mi := &file_proto_api_reviews_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlagReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlagReviewResponse) ProtoMessage() {}
+
+func (x *FlagReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_reviews_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlagReviewResponse.ProtoReflect.Descriptor instead.
+func (*FlagReviewResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_reviews_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *FlagReviewResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+var File_proto_api_reviews_proto protoreflect.FileDescriptor
+
+const file_proto_api_reviews_proto_rawDesc = "" +
+	"\n" +
+	"\x17proto/api/reviews.proto\x12\frival.api.v1\x1a\x19proto/schema/schema.proto\"{\n" +
+	"\x13CreateReviewRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x16\n" +
+	"\x06rating\x18\x02 \x01(\x05R\x06rating\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x12\x1d\n" +
+	"\n" +
+	"photo_keys\x18\x04 \x03(\tR\tphotoKeys\"G\n" +
+	"\x14CreateReviewResponse\x12/\n" +
+	"\x06review\x18\x01 \x01(\v2\x17.rival.schema.v1.ReviewR\x06review\"~\n" +
+	"\x1fRequestReviewPhotoUploadRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x03 \x01(\x03R\tsizeBytes\"\x97\x02\n" +
+	" RequestReviewPhotoUploadResponse\x12\x1d\n" +
+	"\n" +
+	"upload_url\x18\x01 \x01(\tR\tuploadUrl\x12Y\n" +
+	"\tform_data\x18\x02 \x03(\v2<.rival.api.v1.RequestReviewPhotoUploadResponse.FormDataEntryR\bformData\x12\x1d\n" +
+	"\n" +
+	"object_key\x18\x03 \x01(\tR\tobjectKey\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x04 \x01(\x03R\texpiresIn\x1a;\n" +
+	"\rFormDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"g\n" +
+	"\x1aListMerchantReviewsRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\xbb\x01\n" +
+	"\x1bListMerchantReviewsResponse\x121\n" +
+	"\areviews\x18\x01 \x03(\v2\x17.rival.schema.v1.ReviewR\areviews\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12%\n" +
+	"\x0erating_average\x18\x03 \x01(\x01R\rratingAverage\x12!\n" +
+	"\frating_count\x18\x04 \x01(\x05R\vratingCount\"H\n" +
+	"\x11FlagReviewRequest\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\x03R\breviewId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\".\n" +
+	"\x12FlagReviewResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess2\x9e\x03\n" +
+	"\rReviewService\x12U\n" +
+	"\fCreateReview\x12!.rival.api.v1.CreateReviewRequest\x1a\".rival.api.v1.CreateReviewResponse\x12y\n" +
+	"\x18RequestReviewPhotoUpload\x12-.rival.api.v1.RequestReviewPhotoUploadRequest\x1a..rival.api.v1.RequestReviewPhotoUploadResponse\x12j\n" +
+	"\x13ListMerchantReviews\x12(.rival.api.v1.ListMerchantReviewsRequest\x1a).rival.api.v1.ListMerchantReviewsResponse\x12O\n" +
+	"\n" +
+	"FlagReview\x12\x1f.rival.api.v1.FlagReviewRequest\x1a .rival.api.v1.FlagReviewResponseB\x1bZ\x19rival/gen/proto/proto/apib\x06proto3"
+
+var (
+	file_proto_api_reviews_proto_rawDescOnce sync.Once
+	file_proto_api_reviews_proto_rawDescData []byte
+)
+
+func file_proto_api_reviews_proto_rawDescGZIP() []byte {
+	file_proto_api_reviews_proto_rawDescOnce.Do(func() {
+		file_proto_api_reviews_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_api_reviews_proto_rawDesc), len(file_proto_api_reviews_proto_rawDesc)))
+	})
+	return file_proto_api_reviews_proto_rawDescData
+}
+
+var file_proto_api_reviews_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_proto_api_reviews_proto_goTypes = []any{
+	(*CreateReviewRequest)(nil),              // 0: rival.api.v1.CreateReviewRequest
+	(*CreateReviewResponse)(nil),             // 1: rival.api.v1.CreateReviewResponse
+	(*RequestReviewPhotoUploadRequest)(nil),  // 2: rival.api.v1.RequestReviewPhotoUploadRequest
+	(*RequestReviewPhotoUploadResponse)(nil), // 3: rival.api.v1.RequestReviewPhotoUploadResponse
+	(*ListMerchantReviewsRequest)(nil),       // 4: rival.api.v1.ListMerchantReviewsRequest
+	(*ListMerchantReviewsResponse)(nil),      // 5: rival.api.v1.ListMerchantReviewsResponse
+	(*FlagReviewRequest)(nil),                // 6: rival.api.v1.FlagReviewRequest
+	(*FlagReviewResponse)(nil),               // 7: rival.api.v1.FlagReviewResponse
+	nil,                                      // 8: rival.api.v1.RequestReviewPhotoUploadResponse.FormDataEntry
+	(*schema.Review)(nil),                    // 9: rival.schema.v1.Review
+}
+var file_proto_api_reviews_proto_depIdxs = []int32{
+	9, // 0: rival.api.v1.CreateReviewResponse.review:type_name -> rival.schema.v1.Review
+	8, // 1: rival.api.v1.RequestReviewPhotoUploadResponse.form_data:type_name -> rival.api.v1.RequestReviewPhotoUploadResponse.FormDataEntry
+	9, // 2: rival.api.v1.ListMerchantReviewsResponse.reviews:type_name -> rival.schema.v1.Review
+	0, // 3: rival.api.v1.ReviewService.CreateReview:input_type -> rival.api.v1.CreateReviewRequest
+	2, // 4: rival.api.v1.ReviewService.RequestReviewPhotoUpload:input_type -> rival.api.v1.RequestReviewPhotoUploadRequest
+	4, // 5: rival.api.v1.ReviewService.ListMerchantReviews:input_type -> rival.api.v1.ListMerchantReviewsRequest
+	6, // 6: rival.api.v1.ReviewService.FlagReview:input_type -> rival.api.v1.FlagReviewRequest
+	1, // 7: rival.api.v1.ReviewService.CreateReview:output_type -> rival.api.v1.CreateReviewResponse
+	3, // 8: rival.api.v1.ReviewService.RequestReviewPhotoUpload:output_type -> rival.api.v1.RequestReviewPhotoUploadResponse
+	5, // 9: rival.api.v1.ReviewService.ListMerchantReviews:output_type -> rival.api.v1.ListMerchantReviewsResponse
+	7, // 10: rival.api.v1.ReviewService.FlagReview:output_type -> rival.api.v1.FlagReviewResponse
+	7, // [7:11] is the sub-list for method output_type
+	3, // [3:7] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_proto_api_reviews_proto_init() }
+func file_proto_api_reviews_proto_init() {
+	if File_proto_api_reviews_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_api_reviews_proto_rawDesc), len(file_proto_api_reviews_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_api_reviews_proto_goTypes,
+		DependencyIndexes: file_proto_api_reviews_proto_depIdxs,
+		MessageInfos:      file_proto_api_reviews_proto_msgTypes,
+	}.Build()
+	File_proto_api_reviews_proto = out.File
+	file_proto_api_reviews_proto_goTypes = nil
+	file_proto_api_reviews_proto_depIdxs = nil
+}
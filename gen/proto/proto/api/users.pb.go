@@ -799,8 +799,10 @@ type StreamUserNotificationsResponse struct {
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
 	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"` // offer, transaction, system
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"` // order, payment, referral, system
 	Timestamp     int64                  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	DeepLink      string                 `protobuf:"bytes,6,opt,name=deep_link,json=deepLink,proto3" json:"deep_link,omitempty"`
+	Replayed      bool                   `protobuf:"varint,7,opt,name=replayed,proto3" json:"replayed,omitempty"` // sent while the stream was closed
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -870,6 +872,20 @@ func (x *StreamUserNotificationsResponse) GetTimestamp() int64 {
 	return 0
 }
 
+func (x *StreamUserNotificationsResponse) GetDeepLink() string {
+	if x != nil {
+		return x.DeepLink
+	}
+	return ""
+}
+
+func (x *StreamUserNotificationsResponse) GetReplayed() bool {
+	if x != nil {
+		return x.Replayed
+	}
+	return false
+}
+
 type GetReferralCodeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1190,88 +1206,1487 @@ func (x *GetReferralRewardsResponse) GetTotalEarned() float64 {
 	return 0
 }
 
-var File_proto_api_users_proto protoreflect.FileDescriptor
+// Favorites belong to the signed-in user, set exactly one of the IDs
+type AddFavoriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	OfferId       int64                  `protobuf:"varint,2,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_proto_api_users_proto_rawDesc = "" +
-	"\n" +
-	"\x15proto/api/users.proto\x12\frival.api.v1\x1a\x19proto/schema/schema.proto\")\n" +
-	"\x0eGetUserRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\"<\n" +
-	"\x0fGetUserResponse\x12)\n" +
-	"\x04user\x18\x01 \x01(\v2\x15.rival.schema.v1.UserR\x04user\"w\n" +
-	"\x11UpdateUserRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05phone\x18\x03 \x01(\tR\x05phone\x12\x1f\n" +
-	"\vprofile_pic\x18\x04 \x01(\tR\n" +
-	"profilePic\"?\n" +
-	"\x12UpdateUserResponse\x12)\n" +
-	"\x04user\x18\x01 \x01(\v2\x15.rival.schema.v1.UserR\x04user\"n\n" +
-	"\x13GetUploadURLRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
-	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12!\n" +
-	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\"o\n" +
-	"\x14GetUploadURLResponse\x12\x1d\n" +
-	"\n" +
-	"upload_url\x18\x01 \x01(\tR\tuploadUrl\x12\x19\n" +
-	"\bfile_url\x18\x02 \x01(\tR\afileUrl\x12\x1d\n" +
-	"\n" +
-	"expires_in\x18\x03 \x01(\x03R\texpiresIn\"i\n" +
-	"\x18UpdateCoinBalanceRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
-	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x1c\n" +
-	"\toperation\x18\x03 \x01(\tR\toperation\"<\n" +
-	"\x19UpdateCoinBalanceResponse\x12\x1f\n" +
-	"\vnew_balance\x18\x01 \x01(\x01R\n" +
-	"newBalance\"0\n" +
-	"\x15GetCoinBalanceRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\"2\n" +
-	"\x16GetCoinBalanceResponse\x12\x18\n" +
-	"\abalance\x18\x01 \x01(\x01R\abalance\"e\n" +
-	" GetUserTransactionHistoryRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
-	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\x86\x01\n" +
-	"!GetUserTransactionHistoryResponse\x12@\n" +
-	"\ftransactions\x18\x01 \x03(\v2\x1c.rival.schema.v1.TransactionR\ftransactions\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"5\n" +
-	"\x1aStreamWalletUpdatesRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\x9d\x01\n" +
-	"\x1bStreamWalletUpdatesResponse\x12\x1f\n" +
-	"\vnew_balance\x18\x01 \x01(\x01R\n" +
-	"newBalance\x12>\n" +
-	"\vtransaction\x18\x02 \x01(\v2\x1c.rival.schema.v1.TransactionR\vtransaction\x12\x1d\n" +
-	"\n" +
-	"event_type\x18\x03 \x01(\tR\teventType\"9\n" +
-	"\x1eStreamUserNotificationsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\x93\x01\n" +
-	"\x1fStreamUserNotificationsResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\x12\x12\n" +
-	"\x04type\x18\x04 \x01(\tR\x04type\x12\x1c\n" +
-	"\ttimestamp\x18\x05 \x01(\x03R\ttimestamp\"1\n" +
-	"\x16GetReferralCodeRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\">\n" +
-	"\x17GetReferralCodeResponse\x12#\n" +
-	"\rreferral_code\x18\x01 \x01(\tR\freferralCode\"X\n" +
-	"\x18ApplyReferralCodeRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12#\n" +
-	"\rreferral_code\x18\x02 \x01(\tR\freferralCode\"t\n" +
-	"\x19ApplyReferralCodeResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12#\n" +
-	"\rreward_amount\x18\x03 \x01(\x01R\frewardAmount\"^\n" +
-	"\x19GetReferralRewardsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
-	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\x9b\x01\n" +
-	"\x1aGetReferralRewardsResponse\x129\n" +
-	"\arewards\x18\x01 \x03(\v2\x1f.rival.schema.v1.ReferralRewardR\arewards\x12\x1f\n" +
+func (x *AddFavoriteRequest) Reset() {
+	*x = AddFavoriteRequest{}
+	mi := &file_proto_api_users_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddFavoriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFavoriteRequest) ProtoMessage() {}
+
+func (x *AddFavoriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_users_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFavoriteRequest.ProtoReflect.Descriptor instead.
+func (*AddFavoriteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_users_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AddFavoriteRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *AddFavoriteRequest) GetOfferId() int64 {
+	if x != nil {
+		return x.OfferId
+	}
+	return 0
+}
+
+type AddFavoriteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddFavoriteResponse) Reset() {
+	*x = AddFavoriteResponse{}
+	mi := &file_proto_api_users_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddFavoriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFavoriteResponse) ProtoMessage() {}
+
+func (x *AddFavoriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_users_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFavoriteResponse.ProtoReflect.Descriptor instead.
+func (*AddFavoriteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_users_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AddFavoriteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RemoveFavoriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	OfferId       int64                  `protobuf:"varint,2,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFavoriteRequest) Reset() {
+	*x = RemoveFavoriteRequest{}
+	mi := &file_proto_api_users_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFavoriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFavoriteRequest) ProtoMessage() {}
+
+func (x *RemoveFavoriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_users_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFavoriteRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFavoriteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_users_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RemoveFavoriteRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *RemoveFavoriteRequest) GetOfferId() int64 {
+	if x != nil {
+		return x.OfferId
+	}
+	return 0
+}
+
+type RemoveFavoriteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFavoriteResponse) Reset() {
+	*x = RemoveFavoriteResponse{}
+	mi := &file_proto_api_users_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFavoriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFavoriteResponse) ProtoMessage() {}
+
+func (x *RemoveFavoriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_users_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFavoriteResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFavoriteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_users_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RemoveFavoriteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListFavoritesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFavoritesRequest) Reset() {
+	*x = ListFavoritesRequest{}
+	mi := &file_proto_api_users_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFavoritesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFavoritesRequest) ProtoMessage() {}
+
+func (x *ListFavoritesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_users_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFavoritesRequest.ProtoReflect.Descriptor instead.
+func (*ListFavoritesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_users_proto_rawDescGZIP(), []int{26}
+}
+
+type ListFavoritesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Merchants     []*schema.Merchant     `protobuf:"bytes,1,rep,name=merchants,proto3" json:"merchants,omitempty"`
+	Offers        []*schema.Offer        `protobuf:"bytes,2,rep,name=offers,proto3" json:"offers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFavoritesResponse) Reset() {
+	*x = ListFavoritesResponse{}
+	mi := &file_proto_api_users_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFavoritesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFavoritesResponse) ProtoMessage() {}
+
+func (x *ListFavoritesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_users_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFavoritesResponse.ProtoReflect.Descriptor instead.
+func (*ListFavoritesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_users_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListFavoritesResponse) GetMerchants() []*schema.Merchant {
+	if x != nil {
+		return x.Merchants
+	}
+	return nil
+}
+
+func (x *ListFavoritesResponse) GetOffers() []*schema.Offer {
+	if x != nil {
+		return x.Offers
+	}
+	return nil
+}
+
+// The "for you" feed: nearby merchants ranked for the signed-in user
+type GetRecommendationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	RadiusKm      float64                `protobuf:"fixed64,3,opt,name=radius_km,json=radiusKm,proto3" json:"radius_km,omitempty"` // default 5, at most 50
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                        // default 20
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecommendationsRequest) Reset() {
+	*x = GetRecommendationsRequest{}
+	mi := &file_proto_api_users_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecommendationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecommendationsRequest) ProtoMessage() {}
+
+func (x *GetRecommendationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_users_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecommendationsRequest.ProtoReflect.Descriptor instead.
+func (*GetRecommendationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_users_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetRecommendationsRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GetRecommendationsRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *GetRecommendationsRequest) GetRadiusKm() float64 {
+	if x != nil {
+		return x.RadiusKm
+	}
+	return 0
+}
+
+func (x *GetRecommendationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetRecommendationsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Recommendations []*Recommendation      `protobuf:"bytes,1,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetRecommendationsResponse) Reset() {
+	*x = GetRecommendationsResponse{}
+	mi := &file_proto_api_users_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecommendationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecommendationsResponse) ProtoMessage() {}
+
+func (x *GetRecommendationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_users_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecommendationsResponse.ProtoReflect.Descriptor instead.
+func (*GetRecommendationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_users_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetRecommendationsResponse) GetRecommendations() []*Recommendation {
+	if x != nil {
+		return x.Recommendations
+	}
+	return nil
+}
+
+type Recommendation struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	MerchantId    int64                   `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Name          string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Category      string                  `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	DistanceKm    float64                 `protobuf:"fixed64,4,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+	RatingAverage float64                 `protobuf:"fixed64,5,opt,name=rating_average,json=ratingAverage,proto3" json:"rating_average,omitempty"`
+	RatingCount   int32                   `protobuf:"varint,6,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"`
+	Score         float64                 `protobuf:"fixed64,7,opt,name=score,proto3" json:"score,omitempty"`
+	Reasons       []*RecommendationReason `protobuf:"bytes,8,rep,name=reasons,proto3" json:"reasons,omitempty"` // largest share of the score first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Recommendation) Reset() {
+	*x = Recommendation{}
+	mi := &file_proto_api_users_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recommendation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recommendation) ProtoMessage() {}
+
+func (x *Recommendation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_users_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recommendation.ProtoReflect.Descriptor instead.
+func (*Recommendation) Descriptor() ([]byte, []int) {
+	return file_proto_api_users_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Recommendation) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *Recommendation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Recommendation) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Recommendation) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+func (x *Recommendation) GetRatingAverage() float64 {
+	if x != nil {
+		return x.RatingAverage
+	}
+	return 0
+}
+
+func (x *Recommendation) GetRatingCount() int32 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
+func (x *Recommendation) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Recommendation) GetReasons() []*RecommendationReason {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+type RecommendationReason struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Signal        string                 `protobuf:"bytes,1,opt,name=signal,proto3" json:"signal,omitempty"` // distance, history, category, favorite, rating, user_rating
+	Points        float64                `protobuf:"fixed64,2,opt,name=points,proto3" json:"points,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"` // e.g. "You've been here 3 times"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecommendationReason) Reset() {
+	*x = RecommendationReason{}
+	mi := &file_proto_api_users_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendationReason) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendationReason) ProtoMessage() {}
+
+func (x *RecommendationReason) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_users_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendationReason.ProtoReflect.Descriptor instead.
+func (*RecommendationReason) Descriptor() ([]byte, []int) {
+	return file_proto_api_users_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RecommendationReason) GetSignal() string {
+	if x != nil {
+		return x.Signal
+	}
+	return ""
+}
+
+func (x *RecommendationReason) GetPoints() float64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *RecommendationReason) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type ListNotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	UnreadOnly    bool                   `protobuf:"varint,3,opt,name=unread_only,json=unreadOnly,proto3" json:"unread_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsRequest) Reset() {
+	*x = ListNotificationsRequest{}
+	mi := &file_proto_api_users_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsRequest) ProtoMessage() {}
+
+func (x *ListNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_users_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_users_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListNotificationsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListNotificationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListNotificationsRequest) GetUnreadOnly() bool {
+	if x != nil {
+		return x.UnreadOnly
+	}
+	return false
+}
+
+type ListNotificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notifications []*schema.Notification `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	UnreadCount   int32                  `protobuf:"varint,3,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsResponse) Reset() {
+	*x = ListNotificationsResponse{}
+	mi := &file_proto_api_users_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsResponse) ProtoMessage() {}
+
+func (x *ListNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_users_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_users_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListNotificationsResponse) GetNotifications() []*schema.Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *ListNotificationsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListNotificationsResponse) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+type MarkNotificationsReadRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	NotificationIds []int64                `protobuf:"varint,1,rep,packed,name=notification_ids,json=notificationIds,proto3" json:"notification_ids,omitempty"`
+	All             bool                   `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"` // mark every unread notification instead
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MarkNotificationsReadRequest) Reset() {
+	*x = MarkNotificationsReadRequest{}
+	mi := &file_proto_api_users_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkNotificationsReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNotificationsReadRequest) ProtoMessage() {}
+
+func (x *MarkNotificationsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_users_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNotificationsReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkNotificationsReadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_users_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *MarkNotificationsReadRequest) GetNotificationIds() []int64 {
+	if x != nil {
+		return x.NotificationIds
+	}
+	return nil
+}
+
+func (x *MarkNotificationsReadRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+type MarkNotificationsReadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Updated       int32                  `protobuf:"varint,1,opt,name=updated,proto3" json:"updated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkNotificationsReadResponse) Reset() {
+	*x = MarkNotificationsReadResponse{}
+	mi := &file_proto_api_users_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkNotificationsReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNotificationsReadResponse) ProtoMessage() {}
+
+func (x *MarkNotificationsReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_users_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNotificationsReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkNotificationsReadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_users_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *MarkNotificationsReadResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+type GetUnreadNotificationCountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnreadNotificationCountRequest) Reset() {
+	*x = GetUnreadNotificationCountRequest{}
+	mi := &file_proto_api_users_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnreadNotificationCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadNotificationCountRequest) ProtoMessage() {}
+
+func (x *GetUnreadNotificationCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_users_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadNotificationCountRequest.ProtoReflect.Descriptor instead.
+func (*GetUnreadNotificationCountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_users_proto_rawDescGZIP(), []int{36}
+}
+
+type GetUnreadNotificationCountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnreadNotificationCountResponse) Reset() {
+	*x = GetUnreadNotificationCountResponse{}
+	mi := &file_proto_api_users_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnreadNotificationCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadNotificationCountResponse) ProtoMessage() {}
+
+func (x *GetUnreadNotificationCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_users_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadNotificationCountResponse.ProtoReflect.Descriptor instead.
+func (*GetUnreadNotificationCountResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_users_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetUnreadNotificationCountResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Push token of the signed-in user's device, sent on every app start
+type RegisterDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Platform      string                 `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`   // android, ios, web
+	Latitude      float64                `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"` // optional, last known location for nearby offers
+	Longitude     float64                `protobuf:"fixed64,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterDeviceRequest) Reset() {
+	*x = RegisterDeviceRequest{}
+	mi := &file_proto_api_users_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterDeviceRequest) ProtoMessage() {}
+
+func (x *RegisterDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_users_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterDeviceRequest.ProtoReflect.Descriptor instead.
+func (*RegisterDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_users_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RegisterDeviceRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RegisterDeviceRequest) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *RegisterDeviceRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *RegisterDeviceRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type RegisterDeviceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterDeviceResponse) Reset() {
+	*x = RegisterDeviceResponse{}
+	mi := &file_proto_api_users_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterDeviceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterDeviceResponse) ProtoMessage() {}
+
+func (x *RegisterDeviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_users_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterDeviceResponse.ProtoReflect.Descriptor instead.
+func (*RegisterDeviceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_users_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RegisterDeviceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type UnregisterDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnregisterDeviceRequest) Reset() {
+	*x = UnregisterDeviceRequest{}
+	mi := &file_proto_api_users_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnregisterDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregisterDeviceRequest) ProtoMessage() {}
+
+func (x *UnregisterDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_users_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregisterDeviceRequest.ProtoReflect.Descriptor instead.
+func (*UnregisterDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_users_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UnregisterDeviceRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type UnregisterDeviceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnregisterDeviceResponse) Reset() {
+	*x = UnregisterDeviceResponse{}
+	mi := &file_proto_api_users_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnregisterDeviceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregisterDeviceResponse) ProtoMessage() {}
+
+func (x *UnregisterDeviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_users_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregisterDeviceResponse.ProtoReflect.Descriptor instead.
+func (*UnregisterDeviceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_users_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UnregisterDeviceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetNotificationPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationPreferencesRequest) Reset() {
+	*x = GetNotificationPreferencesRequest{}
+	mi := &file_proto_api_users_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *GetNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_users_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_users_proto_rawDescGZIP(), []int{42}
+}
+
+type GetNotificationPreferencesResponse struct {
+	state           protoimpl.MessageState           `protogen:"open.v1"`
+	Preferences     []*schema.NotificationPreference `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	Timezone        string                           `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	QuietHoursStart string                           `protobuf:"bytes,3,opt,name=quiet_hours_start,json=quietHoursStart,proto3" json:"quiet_hours_start,omitempty"` // HH:MM in timezone, empty when off
+	QuietHoursEnd   string                           `protobuf:"bytes,4,opt,name=quiet_hours_end,json=quietHoursEnd,proto3" json:"quiet_hours_end,omitempty"`       // before the start for quiet hours past midnight
+	Locale          string                           `protobuf:"bytes,5,opt,name=locale,proto3" json:"locale,omitempty"`                                            // language of emails
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetNotificationPreferencesResponse) Reset() {
+	*x = GetNotificationPreferencesResponse{}
+	mi := &file_proto_api_users_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferencesResponse) ProtoMessage() {}
+
+func (x *GetNotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_users_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_users_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetNotificationPreferencesResponse) GetPreferences() []*schema.NotificationPreference {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+func (x *GetNotificationPreferencesResponse) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetNotificationPreferencesResponse) GetQuietHoursStart() string {
+	if x != nil {
+		return x.QuietHoursStart
+	}
+	return ""
+}
+
+func (x *GetNotificationPreferencesResponse) GetQuietHoursEnd() string {
+	if x != nil {
+		return x.QuietHoursEnd
+	}
+	return ""
+}
+
+func (x *GetNotificationPreferencesResponse) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+// Only the categories sent are changed, each with all of its channels.
+// Security notifications are always delivered and can't be turned off.
+type UpdateNotificationPreferencesRequest struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Preferences   []*schema.NotificationPreference `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationPreferencesRequest) Reset() {
+	*x = UpdateNotificationPreferencesRequest{}
+	mi := &file_proto_api_users_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *UpdateNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_users_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_users_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetPreferences() []*schema.NotificationPreference {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+// Pushes during quiet hours are held until they end. Leave start and end
+// empty to turn quiet hours off.
+type SetQuietHoursRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timezone      string                 `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"` // IANA name, defaults to Asia/Kolkata
+	Start         string                 `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`       // HH:MM
+	End           string                 `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`           // HH:MM
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetQuietHoursRequest) Reset() {
+	*x = SetQuietHoursRequest{}
+	mi := &file_proto_api_users_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetQuietHoursRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetQuietHoursRequest) ProtoMessage() {}
+
+func (x *SetQuietHoursRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_users_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetQuietHoursRequest.ProtoReflect.Descriptor instead.
+func (*SetQuietHoursRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_users_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SetQuietHoursRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *SetQuietHoursRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *SetQuietHoursRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+type SetNotificationLanguageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locale        string                 `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"` // en or hi
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNotificationLanguageRequest) Reset() {
+	*x = SetNotificationLanguageRequest{}
+	mi := &file_proto_api_users_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNotificationLanguageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNotificationLanguageRequest) ProtoMessage() {}
+
+func (x *SetNotificationLanguageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_users_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNotificationLanguageRequest.ProtoReflect.Descriptor instead.
+func (*SetNotificationLanguageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_users_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SetNotificationLanguageRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+var File_proto_api_users_proto protoreflect.FileDescriptor
+
+const file_proto_api_users_proto_rawDesc = "" +
+	"\n" +
+	"\x15proto/api/users.proto\x12\frival.api.v1\x1a\x19proto/schema/schema.proto\")\n" +
+	"\x0eGetUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"<\n" +
+	"\x0fGetUserResponse\x12)\n" +
+	"\x04user\x18\x01 \x01(\v2\x15.rival.schema.v1.UserR\x04user\"w\n" +
+	"\x11UpdateUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05phone\x18\x03 \x01(\tR\x05phone\x12\x1f\n" +
+	"\vprofile_pic\x18\x04 \x01(\tR\n" +
+	"profilePic\"?\n" +
+	"\x12UpdateUserResponse\x12)\n" +
+	"\x04user\x18\x01 \x01(\v2\x15.rival.schema.v1.UserR\x04user\"n\n" +
+	"\x13GetUploadURLRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\"o\n" +
+	"\x14GetUploadURLResponse\x12\x1d\n" +
+	"\n" +
+	"upload_url\x18\x01 \x01(\tR\tuploadUrl\x12\x19\n" +
+	"\bfile_url\x18\x02 \x01(\tR\afileUrl\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x03 \x01(\x03R\texpiresIn\"i\n" +
+	"\x18UpdateCoinBalanceRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x1c\n" +
+	"\toperation\x18\x03 \x01(\tR\toperation\"<\n" +
+	"\x19UpdateCoinBalanceResponse\x12\x1f\n" +
+	"\vnew_balance\x18\x01 \x01(\x01R\n" +
+	"newBalance\"0\n" +
+	"\x15GetCoinBalanceRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"2\n" +
+	"\x16GetCoinBalanceResponse\x12\x18\n" +
+	"\abalance\x18\x01 \x01(\x01R\abalance\"e\n" +
+	" GetUserTransactionHistoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\x86\x01\n" +
+	"!GetUserTransactionHistoryResponse\x12@\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x1c.rival.schema.v1.TransactionR\ftransactions\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\"5\n" +
+	"\x1aStreamWalletUpdatesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\x9d\x01\n" +
+	"\x1bStreamWalletUpdatesResponse\x12\x1f\n" +
+	"\vnew_balance\x18\x01 \x01(\x01R\n" +
+	"newBalance\x12>\n" +
+	"\vtransaction\x18\x02 \x01(\v2\x1c.rival.schema.v1.TransactionR\vtransaction\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x03 \x01(\tR\teventType\"9\n" +
+	"\x1eStreamUserNotificationsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\xcc\x01\n" +
+	"\x1fStreamUserNotificationsResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x1c\n" +
+	"\ttimestamp\x18\x05 \x01(\x03R\ttimestamp\x12\x1b\n" +
+	"\tdeep_link\x18\x06 \x01(\tR\bdeepLink\x12\x1a\n" +
+	"\breplayed\x18\a \x01(\bR\breplayed\"1\n" +
+	"\x16GetReferralCodeRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\">\n" +
+	"\x17GetReferralCodeResponse\x12#\n" +
+	"\rreferral_code\x18\x01 \x01(\tR\freferralCode\"X\n" +
+	"\x18ApplyReferralCodeRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12#\n" +
+	"\rreferral_code\x18\x02 \x01(\tR\freferralCode\"t\n" +
+	"\x19ApplyReferralCodeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12#\n" +
+	"\rreward_amount\x18\x03 \x01(\x01R\frewardAmount\"^\n" +
+	"\x19GetReferralRewardsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\x9b\x01\n" +
+	"\x1aGetReferralRewardsResponse\x129\n" +
+	"\arewards\x18\x01 \x03(\v2\x1f.rival.schema.v1.ReferralRewardR\arewards\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12!\n" +
+	"\ftotal_earned\x18\x03 \x01(\x01R\vtotalEarned\"P\n" +
+	"\x12AddFavoriteRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x19\n" +
+	"\boffer_id\x18\x02 \x01(\x03R\aofferId\"/\n" +
+	"\x13AddFavoriteResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"S\n" +
+	"\x15RemoveFavoriteRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x19\n" +
+	"\boffer_id\x18\x02 \x01(\x03R\aofferId\"2\n" +
+	"\x16RemoveFavoriteResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x16\n" +
+	"\x14ListFavoritesRequest\"\x80\x01\n" +
+	"\x15ListFavoritesResponse\x127\n" +
+	"\tmerchants\x18\x01 \x03(\v2\x19.rival.schema.v1.MerchantR\tmerchants\x12.\n" +
+	"\x06offers\x18\x02 \x03(\v2\x16.rival.schema.v1.OfferR\x06offers\"\x88\x01\n" +
+	"\x19GetRecommendationsRequest\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\x12\x1b\n" +
+	"\tradius_km\x18\x03 \x01(\x01R\bradiusKm\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"d\n" +
+	"\x1aGetRecommendationsResponse\x12F\n" +
+	"\x0frecommendations\x18\x01 \x03(\v2\x1c.rival.api.v1.RecommendationR\x0frecommendations\"\xa0\x02\n" +
+	"\x0eRecommendation\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12\x1f\n" +
+	"\vdistance_km\x18\x04 \x01(\x01R\n" +
+	"distanceKm\x12%\n" +
+	"\x0erating_average\x18\x05 \x01(\x01R\rratingAverage\x12!\n" +
+	"\frating_count\x18\x06 \x01(\x05R\vratingCount\x12\x14\n" +
+	"\x05score\x18\a \x01(\x01R\x05score\x12<\n" +
+	"\areasons\x18\b \x03(\v2\".rival.api.v1.RecommendationReasonR\areasons\"Z\n" +
+	"\x14RecommendationReason\x12\x16\n" +
+	"\x06signal\x18\x01 \x01(\tR\x06signal\x12\x16\n" +
+	"\x06points\x18\x02 \x01(\x01R\x06points\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\"e\n" +
+	"\x18ListNotificationsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1f\n" +
+	"\vunread_only\x18\x03 \x01(\bR\n" +
+	"unreadOnly\"\xa4\x01\n" +
+	"\x19ListNotificationsResponse\x12C\n" +
+	"\rnotifications\x18\x01 \x03(\v2\x1d.rival.schema.v1.NotificationR\rnotifications\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
 	"totalCount\x12!\n" +
-	"\ftotal_earned\x18\x03 \x01(\x01R\vtotalEarned2\xd5\b\n" +
+	"\funread_count\x18\x03 \x01(\x05R\vunreadCount\"[\n" +
+	"\x1cMarkNotificationsReadRequest\x12)\n" +
+	"\x10notification_ids\x18\x01 \x03(\x03R\x0fnotificationIds\x12\x10\n" +
+	"\x03all\x18\x02 \x01(\bR\x03all\"9\n" +
+	"\x1dMarkNotificationsReadResponse\x12\x18\n" +
+	"\aupdated\x18\x01 \x01(\x05R\aupdated\"#\n" +
+	"!GetUnreadNotificationCountRequest\":\n" +
+	"\"GetUnreadNotificationCountResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\"\x83\x01\n" +
+	"\x15RegisterDeviceRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1a\n" +
+	"\bplatform\x18\x02 \x01(\tR\bplatform\x12\x1a\n" +
+	"\blatitude\x18\x03 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x04 \x01(\x01R\tlongitude\"2\n" +
+	"\x16RegisterDeviceResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"/\n" +
+	"\x17UnregisterDeviceRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"4\n" +
+	"\x18UnregisterDeviceResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"#\n" +
+	"!GetNotificationPreferencesRequest\"\xf7\x01\n" +
+	"\"GetNotificationPreferencesResponse\x12I\n" +
+	"\vpreferences\x18\x01 \x03(\v2'.rival.schema.v1.NotificationPreferenceR\vpreferences\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\x12*\n" +
+	"\x11quiet_hours_start\x18\x03 \x01(\tR\x0fquietHoursStart\x12&\n" +
+	"\x0fquiet_hours_end\x18\x04 \x01(\tR\rquietHoursEnd\x12\x16\n" +
+	"\x06locale\x18\x05 \x01(\tR\x06locale\"q\n" +
+	"$UpdateNotificationPreferencesRequest\x12I\n" +
+	"\vpreferences\x18\x01 \x03(\v2'.rival.schema.v1.NotificationPreferenceR\vpreferences\"Z\n" +
+	"\x14SetQuietHoursRequest\x12\x1a\n" +
+	"\btimezone\x18\x01 \x01(\tR\btimezone\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x03 \x01(\tR\x03end\"8\n" +
+	"\x1eSetNotificationLanguageRequest\x12\x16\n" +
+	"\x06locale\x18\x01 \x01(\tR\x06locale2\xcd\x13\n" +
 	"\vUserService\x12F\n" +
 	"\aGetUser\x12\x1c.rival.api.v1.GetUserRequest\x1a\x1d.rival.api.v1.GetUserResponse\x12O\n" +
 	"\n" +
@@ -1284,7 +2699,20 @@ const file_proto_api_users_proto_rawDesc = "" +
 	"\x11ApplyReferralCode\x12&.rival.api.v1.ApplyReferralCodeRequest\x1a'.rival.api.v1.ApplyReferralCodeResponse\x12g\n" +
 	"\x12GetReferralRewards\x12'.rival.api.v1.GetReferralRewardsRequest\x1a(.rival.api.v1.GetReferralRewardsResponse\x12l\n" +
 	"\x13StreamWalletUpdates\x12(.rival.api.v1.StreamWalletUpdatesRequest\x1a).rival.api.v1.StreamWalletUpdatesResponse0\x01\x12x\n" +
-	"\x17StreamUserNotifications\x12,.rival.api.v1.StreamUserNotificationsRequest\x1a-.rival.api.v1.StreamUserNotificationsResponse0\x01B\x1bZ\x19rival/gen/proto/proto/apib\x06proto3"
+	"\x17StreamUserNotifications\x12,.rival.api.v1.StreamUserNotificationsRequest\x1a-.rival.api.v1.StreamUserNotificationsResponse0\x01\x12R\n" +
+	"\vAddFavorite\x12 .rival.api.v1.AddFavoriteRequest\x1a!.rival.api.v1.AddFavoriteResponse\x12[\n" +
+	"\x0eRemoveFavorite\x12#.rival.api.v1.RemoveFavoriteRequest\x1a$.rival.api.v1.RemoveFavoriteResponse\x12X\n" +
+	"\rListFavorites\x12\".rival.api.v1.ListFavoritesRequest\x1a#.rival.api.v1.ListFavoritesResponse\x12g\n" +
+	"\x12GetRecommendations\x12'.rival.api.v1.GetRecommendationsRequest\x1a(.rival.api.v1.GetRecommendationsResponse\x12d\n" +
+	"\x11ListNotifications\x12&.rival.api.v1.ListNotificationsRequest\x1a'.rival.api.v1.ListNotificationsResponse\x12p\n" +
+	"\x15MarkNotificationsRead\x12*.rival.api.v1.MarkNotificationsReadRequest\x1a+.rival.api.v1.MarkNotificationsReadResponse\x12\x7f\n" +
+	"\x1aGetUnreadNotificationCount\x12/.rival.api.v1.GetUnreadNotificationCountRequest\x1a0.rival.api.v1.GetUnreadNotificationCountResponse\x12[\n" +
+	"\x0eRegisterDevice\x12#.rival.api.v1.RegisterDeviceRequest\x1a$.rival.api.v1.RegisterDeviceResponse\x12a\n" +
+	"\x10UnregisterDevice\x12%.rival.api.v1.UnregisterDeviceRequest\x1a&.rival.api.v1.UnregisterDeviceResponse\x12\x7f\n" +
+	"\x1aGetNotificationPreferences\x12/.rival.api.v1.GetNotificationPreferencesRequest\x1a0.rival.api.v1.GetNotificationPreferencesResponse\x12\x85\x01\n" +
+	"\x1dUpdateNotificationPreferences\x122.rival.api.v1.UpdateNotificationPreferencesRequest\x1a0.rival.api.v1.GetNotificationPreferencesResponse\x12e\n" +
+	"\rSetQuietHours\x12\".rival.api.v1.SetQuietHoursRequest\x1a0.rival.api.v1.GetNotificationPreferencesResponse\x12y\n" +
+	"\x17SetNotificationLanguage\x12,.rival.api.v1.SetNotificationLanguageRequest\x1a0.rival.api.v1.GetNotificationPreferencesResponseB\x1bZ\x19rival/gen/proto/proto/apib\x06proto3"
 
 var (
 	file_proto_api_users_proto_rawDescOnce sync.Once
@@ -1298,67 +2726,129 @@ func file_proto_api_users_proto_rawDescGZIP() []byte {
 	return file_proto_api_users_proto_rawDescData
 }
 
-var file_proto_api_users_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_proto_api_users_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
 var file_proto_api_users_proto_goTypes = []any{
-	(*GetUserRequest)(nil),                    // 0: rival.api.v1.GetUserRequest
-	(*GetUserResponse)(nil),                   // 1: rival.api.v1.GetUserResponse
-	(*UpdateUserRequest)(nil),                 // 2: rival.api.v1.UpdateUserRequest
-	(*UpdateUserResponse)(nil),                // 3: rival.api.v1.UpdateUserResponse
-	(*GetUploadURLRequest)(nil),               // 4: rival.api.v1.GetUploadURLRequest
-	(*GetUploadURLResponse)(nil),              // 5: rival.api.v1.GetUploadURLResponse
-	(*UpdateCoinBalanceRequest)(nil),          // 6: rival.api.v1.UpdateCoinBalanceRequest
-	(*UpdateCoinBalanceResponse)(nil),         // 7: rival.api.v1.UpdateCoinBalanceResponse
-	(*GetCoinBalanceRequest)(nil),             // 8: rival.api.v1.GetCoinBalanceRequest
-	(*GetCoinBalanceResponse)(nil),            // 9: rival.api.v1.GetCoinBalanceResponse
-	(*GetUserTransactionHistoryRequest)(nil),  // 10: rival.api.v1.GetUserTransactionHistoryRequest
-	(*GetUserTransactionHistoryResponse)(nil), // 11: rival.api.v1.GetUserTransactionHistoryResponse
-	(*StreamWalletUpdatesRequest)(nil),        // 12: rival.api.v1.StreamWalletUpdatesRequest
-	(*StreamWalletUpdatesResponse)(nil),       // 13: rival.api.v1.StreamWalletUpdatesResponse
-	(*StreamUserNotificationsRequest)(nil),    // 14: rival.api.v1.StreamUserNotificationsRequest
-	(*StreamUserNotificationsResponse)(nil),   // 15: rival.api.v1.StreamUserNotificationsResponse
-	(*GetReferralCodeRequest)(nil),            // 16: rival.api.v1.GetReferralCodeRequest
-	(*GetReferralCodeResponse)(nil),           // 17: rival.api.v1.GetReferralCodeResponse
-	(*ApplyReferralCodeRequest)(nil),          // 18: rival.api.v1.ApplyReferralCodeRequest
-	(*ApplyReferralCodeResponse)(nil),         // 19: rival.api.v1.ApplyReferralCodeResponse
-	(*GetReferralRewardsRequest)(nil),         // 20: rival.api.v1.GetReferralRewardsRequest
-	(*GetReferralRewardsResponse)(nil),        // 21: rival.api.v1.GetReferralRewardsResponse
-	(*schema.User)(nil),                       // 22: rival.schema.v1.User
-	(*schema.Transaction)(nil),                // 23: rival.schema.v1.Transaction
-	(*schema.ReferralReward)(nil),             // 24: rival.schema.v1.ReferralReward
+	(*GetUserRequest)(nil),                       // 0: rival.api.v1.GetUserRequest
+	(*GetUserResponse)(nil),                      // 1: rival.api.v1.GetUserResponse
+	(*UpdateUserRequest)(nil),                    // 2: rival.api.v1.UpdateUserRequest
+	(*UpdateUserResponse)(nil),                   // 3: rival.api.v1.UpdateUserResponse
+	(*GetUploadURLRequest)(nil),                  // 4: rival.api.v1.GetUploadURLRequest
+	(*GetUploadURLResponse)(nil),                 // 5: rival.api.v1.GetUploadURLResponse
+	(*UpdateCoinBalanceRequest)(nil),             // 6: rival.api.v1.UpdateCoinBalanceRequest
+	(*UpdateCoinBalanceResponse)(nil),            // 7: rival.api.v1.UpdateCoinBalanceResponse
+	(*GetCoinBalanceRequest)(nil),                // 8: rival.api.v1.GetCoinBalanceRequest
+	(*GetCoinBalanceResponse)(nil),               // 9: rival.api.v1.GetCoinBalanceResponse
+	(*GetUserTransactionHistoryRequest)(nil),     // 10: rival.api.v1.GetUserTransactionHistoryRequest
+	(*GetUserTransactionHistoryResponse)(nil),    // 11: rival.api.v1.GetUserTransactionHistoryResponse
+	(*StreamWalletUpdatesRequest)(nil),           // 12: rival.api.v1.StreamWalletUpdatesRequest
+	(*StreamWalletUpdatesResponse)(nil),          // 13: rival.api.v1.StreamWalletUpdatesResponse
+	(*StreamUserNotificationsRequest)(nil),       // 14: rival.api.v1.StreamUserNotificationsRequest
+	(*StreamUserNotificationsResponse)(nil),      // 15: rival.api.v1.StreamUserNotificationsResponse
+	(*GetReferralCodeRequest)(nil),               // 16: rival.api.v1.GetReferralCodeRequest
+	(*GetReferralCodeResponse)(nil),              // 17: rival.api.v1.GetReferralCodeResponse
+	(*ApplyReferralCodeRequest)(nil),             // 18: rival.api.v1.ApplyReferralCodeRequest
+	(*ApplyReferralCodeResponse)(nil),            // 19: rival.api.v1.ApplyReferralCodeResponse
+	(*GetReferralRewardsRequest)(nil),            // 20: rival.api.v1.GetReferralRewardsRequest
+	(*GetReferralRewardsResponse)(nil),           // 21: rival.api.v1.GetReferralRewardsResponse
+	(*AddFavoriteRequest)(nil),                   // 22: rival.api.v1.AddFavoriteRequest
+	(*AddFavoriteResponse)(nil),                  // 23: rival.api.v1.AddFavoriteResponse
+	(*RemoveFavoriteRequest)(nil),                // 24: rival.api.v1.RemoveFavoriteRequest
+	(*RemoveFavoriteResponse)(nil),               // 25: rival.api.v1.RemoveFavoriteResponse
+	(*ListFavoritesRequest)(nil),                 // 26: rival.api.v1.ListFavoritesRequest
+	(*ListFavoritesResponse)(nil),                // 27: rival.api.v1.ListFavoritesResponse
+	(*GetRecommendationsRequest)(nil),            // 28: rival.api.v1.GetRecommendationsRequest
+	(*GetRecommendationsResponse)(nil),           // 29: rival.api.v1.GetRecommendationsResponse
+	(*Recommendation)(nil),                       // 30: rival.api.v1.Recommendation
+	(*RecommendationReason)(nil),                 // 31: rival.api.v1.RecommendationReason
+	(*ListNotificationsRequest)(nil),             // 32: rival.api.v1.ListNotificationsRequest
+	(*ListNotificationsResponse)(nil),            // 33: rival.api.v1.ListNotificationsResponse
+	(*MarkNotificationsReadRequest)(nil),         // 34: rival.api.v1.MarkNotificationsReadRequest
+	(*MarkNotificationsReadResponse)(nil),        // 35: rival.api.v1.MarkNotificationsReadResponse
+	(*GetUnreadNotificationCountRequest)(nil),    // 36: rival.api.v1.GetUnreadNotificationCountRequest
+	(*GetUnreadNotificationCountResponse)(nil),   // 37: rival.api.v1.GetUnreadNotificationCountResponse
+	(*RegisterDeviceRequest)(nil),                // 38: rival.api.v1.RegisterDeviceRequest
+	(*RegisterDeviceResponse)(nil),               // 39: rival.api.v1.RegisterDeviceResponse
+	(*UnregisterDeviceRequest)(nil),              // 40: rival.api.v1.UnregisterDeviceRequest
+	(*UnregisterDeviceResponse)(nil),             // 41: rival.api.v1.UnregisterDeviceResponse
+	(*GetNotificationPreferencesRequest)(nil),    // 42: rival.api.v1.GetNotificationPreferencesRequest
+	(*GetNotificationPreferencesResponse)(nil),   // 43: rival.api.v1.GetNotificationPreferencesResponse
+	(*UpdateNotificationPreferencesRequest)(nil), // 44: rival.api.v1.UpdateNotificationPreferencesRequest
+	(*SetQuietHoursRequest)(nil),                 // 45: rival.api.v1.SetQuietHoursRequest
+	(*SetNotificationLanguageRequest)(nil),       // 46: rival.api.v1.SetNotificationLanguageRequest
+	(*schema.User)(nil),                          // 47: rival.schema.v1.User
+	(*schema.Transaction)(nil),                   // 48: rival.schema.v1.Transaction
+	(*schema.ReferralReward)(nil),                // 49: rival.schema.v1.ReferralReward
+	(*schema.Merchant)(nil),                      // 50: rival.schema.v1.Merchant
+	(*schema.Offer)(nil),                         // 51: rival.schema.v1.Offer
+	(*schema.Notification)(nil),                  // 52: rival.schema.v1.Notification
+	(*schema.NotificationPreference)(nil),        // 53: rival.schema.v1.NotificationPreference
 }
 var file_proto_api_users_proto_depIdxs = []int32{
-	22, // 0: rival.api.v1.GetUserResponse.user:type_name -> rival.schema.v1.User
-	22, // 1: rival.api.v1.UpdateUserResponse.user:type_name -> rival.schema.v1.User
-	23, // 2: rival.api.v1.GetUserTransactionHistoryResponse.transactions:type_name -> rival.schema.v1.Transaction
-	23, // 3: rival.api.v1.StreamWalletUpdatesResponse.transaction:type_name -> rival.schema.v1.Transaction
-	24, // 4: rival.api.v1.GetReferralRewardsResponse.rewards:type_name -> rival.schema.v1.ReferralReward
-	0,  // 5: rival.api.v1.UserService.GetUser:input_type -> rival.api.v1.GetUserRequest
-	2,  // 6: rival.api.v1.UserService.UpdateUser:input_type -> rival.api.v1.UpdateUserRequest
-	4,  // 7: rival.api.v1.UserService.GetUploadURL:input_type -> rival.api.v1.GetUploadURLRequest
-	6,  // 8: rival.api.v1.UserService.UpdateCoinBalance:input_type -> rival.api.v1.UpdateCoinBalanceRequest
-	8,  // 9: rival.api.v1.UserService.GetCoinBalance:input_type -> rival.api.v1.GetCoinBalanceRequest
-	10, // 10: rival.api.v1.UserService.GetUserTransactionHistory:input_type -> rival.api.v1.GetUserTransactionHistoryRequest
-	16, // 11: rival.api.v1.UserService.GetReferralCode:input_type -> rival.api.v1.GetReferralCodeRequest
-	18, // 12: rival.api.v1.UserService.ApplyReferralCode:input_type -> rival.api.v1.ApplyReferralCodeRequest
-	20, // 13: rival.api.v1.UserService.GetReferralRewards:input_type -> rival.api.v1.GetReferralRewardsRequest
-	12, // 14: rival.api.v1.UserService.StreamWalletUpdates:input_type -> rival.api.v1.StreamWalletUpdatesRequest
-	14, // 15: rival.api.v1.UserService.StreamUserNotifications:input_type -> rival.api.v1.StreamUserNotificationsRequest
-	1,  // 16: rival.api.v1.UserService.GetUser:output_type -> rival.api.v1.GetUserResponse
-	3,  // 17: rival.api.v1.UserService.UpdateUser:output_type -> rival.api.v1.UpdateUserResponse
-	5,  // 18: rival.api.v1.UserService.GetUploadURL:output_type -> rival.api.v1.GetUploadURLResponse
-	7,  // 19: rival.api.v1.UserService.UpdateCoinBalance:output_type -> rival.api.v1.UpdateCoinBalanceResponse
-	9,  // 20: rival.api.v1.UserService.GetCoinBalance:output_type -> rival.api.v1.GetCoinBalanceResponse
-	11, // 21: rival.api.v1.UserService.GetUserTransactionHistory:output_type -> rival.api.v1.GetUserTransactionHistoryResponse
-	17, // 22: rival.api.v1.UserService.GetReferralCode:output_type -> rival.api.v1.GetReferralCodeResponse
-	19, // 23: rival.api.v1.UserService.ApplyReferralCode:output_type -> rival.api.v1.ApplyReferralCodeResponse
-	21, // 24: rival.api.v1.UserService.GetReferralRewards:output_type -> rival.api.v1.GetReferralRewardsResponse
-	13, // 25: rival.api.v1.UserService.StreamWalletUpdates:output_type -> rival.api.v1.StreamWalletUpdatesResponse
-	15, // 26: rival.api.v1.UserService.StreamUserNotifications:output_type -> rival.api.v1.StreamUserNotificationsResponse
-	16, // [16:27] is the sub-list for method output_type
-	5,  // [5:16] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	47, // 0: rival.api.v1.GetUserResponse.user:type_name -> rival.schema.v1.User
+	47, // 1: rival.api.v1.UpdateUserResponse.user:type_name -> rival.schema.v1.User
+	48, // 2: rival.api.v1.GetUserTransactionHistoryResponse.transactions:type_name -> rival.schema.v1.Transaction
+	48, // 3: rival.api.v1.StreamWalletUpdatesResponse.transaction:type_name -> rival.schema.v1.Transaction
+	49, // 4: rival.api.v1.GetReferralRewardsResponse.rewards:type_name -> rival.schema.v1.ReferralReward
+	50, // 5: rival.api.v1.ListFavoritesResponse.merchants:type_name -> rival.schema.v1.Merchant
+	51, // 6: rival.api.v1.ListFavoritesResponse.offers:type_name -> rival.schema.v1.Offer
+	30, // 7: rival.api.v1.GetRecommendationsResponse.recommendations:type_name -> rival.api.v1.Recommendation
+	31, // 8: rival.api.v1.Recommendation.reasons:type_name -> rival.api.v1.RecommendationReason
+	52, // 9: rival.api.v1.ListNotificationsResponse.notifications:type_name -> rival.schema.v1.Notification
+	53, // 10: rival.api.v1.GetNotificationPreferencesResponse.preferences:type_name -> rival.schema.v1.NotificationPreference
+	53, // 11: rival.api.v1.UpdateNotificationPreferencesRequest.preferences:type_name -> rival.schema.v1.NotificationPreference
+	0,  // 12: rival.api.v1.UserService.GetUser:input_type -> rival.api.v1.GetUserRequest
+	2,  // 13: rival.api.v1.UserService.UpdateUser:input_type -> rival.api.v1.UpdateUserRequest
+	4,  // 14: rival.api.v1.UserService.GetUploadURL:input_type -> rival.api.v1.GetUploadURLRequest
+	6,  // 15: rival.api.v1.UserService.UpdateCoinBalance:input_type -> rival.api.v1.UpdateCoinBalanceRequest
+	8,  // 16: rival.api.v1.UserService.GetCoinBalance:input_type -> rival.api.v1.GetCoinBalanceRequest
+	10, // 17: rival.api.v1.UserService.GetUserTransactionHistory:input_type -> rival.api.v1.GetUserTransactionHistoryRequest
+	16, // 18: rival.api.v1.UserService.GetReferralCode:input_type -> rival.api.v1.GetReferralCodeRequest
+	18, // 19: rival.api.v1.UserService.ApplyReferralCode:input_type -> rival.api.v1.ApplyReferralCodeRequest
+	20, // 20: rival.api.v1.UserService.GetReferralRewards:input_type -> rival.api.v1.GetReferralRewardsRequest
+	12, // 21: rival.api.v1.UserService.StreamWalletUpdates:input_type -> rival.api.v1.StreamWalletUpdatesRequest
+	14, // 22: rival.api.v1.UserService.StreamUserNotifications:input_type -> rival.api.v1.StreamUserNotificationsRequest
+	22, // 23: rival.api.v1.UserService.AddFavorite:input_type -> rival.api.v1.AddFavoriteRequest
+	24, // 24: rival.api.v1.UserService.RemoveFavorite:input_type -> rival.api.v1.RemoveFavoriteRequest
+	26, // 25: rival.api.v1.UserService.ListFavorites:input_type -> rival.api.v1.ListFavoritesRequest
+	28, // 26: rival.api.v1.UserService.GetRecommendations:input_type -> rival.api.v1.GetRecommendationsRequest
+	32, // 27: rival.api.v1.UserService.ListNotifications:input_type -> rival.api.v1.ListNotificationsRequest
+	34, // 28: rival.api.v1.UserService.MarkNotificationsRead:input_type -> rival.api.v1.MarkNotificationsReadRequest
+	36, // 29: rival.api.v1.UserService.GetUnreadNotificationCount:input_type -> rival.api.v1.GetUnreadNotificationCountRequest
+	38, // 30: rival.api.v1.UserService.RegisterDevice:input_type -> rival.api.v1.RegisterDeviceRequest
+	40, // 31: rival.api.v1.UserService.UnregisterDevice:input_type -> rival.api.v1.UnregisterDeviceRequest
+	42, // 32: rival.api.v1.UserService.GetNotificationPreferences:input_type -> rival.api.v1.GetNotificationPreferencesRequest
+	44, // 33: rival.api.v1.UserService.UpdateNotificationPreferences:input_type -> rival.api.v1.UpdateNotificationPreferencesRequest
+	45, // 34: rival.api.v1.UserService.SetQuietHours:input_type -> rival.api.v1.SetQuietHoursRequest
+	46, // 35: rival.api.v1.UserService.SetNotificationLanguage:input_type -> rival.api.v1.SetNotificationLanguageRequest
+	1,  // 36: rival.api.v1.UserService.GetUser:output_type -> rival.api.v1.GetUserResponse
+	3,  // 37: rival.api.v1.UserService.UpdateUser:output_type -> rival.api.v1.UpdateUserResponse
+	5,  // 38: rival.api.v1.UserService.GetUploadURL:output_type -> rival.api.v1.GetUploadURLResponse
+	7,  // 39: rival.api.v1.UserService.UpdateCoinBalance:output_type -> rival.api.v1.UpdateCoinBalanceResponse
+	9,  // 40: rival.api.v1.UserService.GetCoinBalance:output_type -> rival.api.v1.GetCoinBalanceResponse
+	11, // 41: rival.api.v1.UserService.GetUserTransactionHistory:output_type -> rival.api.v1.GetUserTransactionHistoryResponse
+	17, // 42: rival.api.v1.UserService.GetReferralCode:output_type -> rival.api.v1.GetReferralCodeResponse
+	19, // 43: rival.api.v1.UserService.ApplyReferralCode:output_type -> rival.api.v1.ApplyReferralCodeResponse
+	21, // 44: rival.api.v1.UserService.GetReferralRewards:output_type -> rival.api.v1.GetReferralRewardsResponse
+	13, // 45: rival.api.v1.UserService.StreamWalletUpdates:output_type -> rival.api.v1.StreamWalletUpdatesResponse
+	15, // 46: rival.api.v1.UserService.StreamUserNotifications:output_type -> rival.api.v1.StreamUserNotificationsResponse
+	23, // 47: rival.api.v1.UserService.AddFavorite:output_type -> rival.api.v1.AddFavoriteResponse
+	25, // 48: rival.api.v1.UserService.RemoveFavorite:output_type -> rival.api.v1.RemoveFavoriteResponse
+	27, // 49: rival.api.v1.UserService.ListFavorites:output_type -> rival.api.v1.ListFavoritesResponse
+	29, // 50: rival.api.v1.UserService.GetRecommendations:output_type -> rival.api.v1.GetRecommendationsResponse
+	33, // 51: rival.api.v1.UserService.ListNotifications:output_type -> rival.api.v1.ListNotificationsResponse
+	35, // 52: rival.api.v1.UserService.MarkNotificationsRead:output_type -> rival.api.v1.MarkNotificationsReadResponse
+	37, // 53: rival.api.v1.UserService.GetUnreadNotificationCount:output_type -> rival.api.v1.GetUnreadNotificationCountResponse
+	39, // 54: rival.api.v1.UserService.RegisterDevice:output_type -> rival.api.v1.RegisterDeviceResponse
+	41, // 55: rival.api.v1.UserService.UnregisterDevice:output_type -> rival.api.v1.UnregisterDeviceResponse
+	43, // 56: rival.api.v1.UserService.GetNotificationPreferences:output_type -> rival.api.v1.GetNotificationPreferencesResponse
+	43, // 57: rival.api.v1.UserService.UpdateNotificationPreferences:output_type -> rival.api.v1.GetNotificationPreferencesResponse
+	43, // 58: rival.api.v1.UserService.SetQuietHours:output_type -> rival.api.v1.GetNotificationPreferencesResponse
+	43, // 59: rival.api.v1.UserService.SetNotificationLanguage:output_type -> rival.api.v1.GetNotificationPreferencesResponse
+	36, // [36:60] is the sub-list for method output_type
+	12, // [12:36] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_proto_api_users_proto_init() }
@@ -1372,7 +2862,7 @@ func file_proto_api_users_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_api_users_proto_rawDesc), len(file_proto_api_users_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   47,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
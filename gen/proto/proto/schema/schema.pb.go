@@ -88,6 +88,7 @@ type User struct {
 	ReferredBy    string                 `protobuf:"bytes,11,opt,name=referred_by,json=referredBy,proto3" json:"referred_by,omitempty"`
 	CreatedAt     int64                  `protobuf:"varint,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     int64                  `protobuf:"varint,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	PhoneVerified bool                   `protobuf:"varint,14,opt,name=phone_verified,json=phoneVerified,proto3" json:"phone_verified,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -213,6 +214,13 @@ func (x *User) GetUpdatedAt() int64 {
 	return 0
 }
 
+func (x *User) GetPhoneVerified() bool {
+	if x != nil {
+		return x.PhoneVerified
+	}
+	return false
+}
+
 type ReferralReward struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -325,6 +333,11 @@ type Merchant struct {
 	IsActive           bool                   `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
 	CreatedAt          int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt          int64                  `protobuf:"varint,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Status             string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"` // draft, submitted, under_review, approved, rejected, suspended
+	Timezone           string                 `protobuf:"bytes,12,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	IsOpenNow          bool                   `protobuf:"varint,13,opt,name=is_open_now,json=isOpenNow,proto3" json:"is_open_now,omitempty"`
+	RatingAverage      float64                `protobuf:"fixed64,14,opt,name=rating_average,json=ratingAverage,proto3" json:"rating_average,omitempty"` // published reviews only, 0 without any
+	RatingCount        int32                  `protobuf:"varint,15,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -429,166 +442,126 @@ func (x *Merchant) GetUpdatedAt() int64 {
 	return 0
 }
 
-type MerchantAddress struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	MerchantId    int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
-	Street        string                 `protobuf:"bytes,3,opt,name=street,proto3" json:"street,omitempty"`
-	City          string                 `protobuf:"bytes,4,opt,name=city,proto3" json:"city,omitempty"`
-	State         string                 `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
-	PostalCode    string                 `protobuf:"bytes,6,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
-	Country       string                 `protobuf:"bytes,7,opt,name=country,proto3" json:"country,omitempty"`
-	Latitude      float64                `protobuf:"fixed64,8,opt,name=latitude,proto3" json:"latitude,omitempty"`
-	Longitude     float64                `protobuf:"fixed64,9,opt,name=longitude,proto3" json:"longitude,omitempty"`
-	IsPrimary     bool                   `protobuf:"varint,10,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     int64                  `protobuf:"varint,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *MerchantAddress) Reset() {
-	*x = MerchantAddress{}
-	mi := &file_proto_schema_schema_proto_msgTypes[3]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *MerchantAddress) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*MerchantAddress) ProtoMessage() {}
-
-func (x *MerchantAddress) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_schema_schema_proto_msgTypes[3]
+func (x *Merchant) GetStatus() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Status
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use MerchantAddress.ProtoReflect.Descriptor instead.
-func (*MerchantAddress) Descriptor() ([]byte, []int) {
-	return file_proto_schema_schema_proto_rawDescGZIP(), []int{3}
+	return ""
 }
 
-func (x *MerchantAddress) GetId() int64 {
+func (x *Merchant) GetTimezone() string {
 	if x != nil {
-		return x.Id
+		return x.Timezone
 	}
-	return 0
+	return ""
 }
 
-func (x *MerchantAddress) GetMerchantId() int64 {
+func (x *Merchant) GetIsOpenNow() bool {
 	if x != nil {
-		return x.MerchantId
+		return x.IsOpenNow
 	}
-	return 0
+	return false
 }
 
-func (x *MerchantAddress) GetStreet() string {
+func (x *Merchant) GetRatingAverage() float64 {
 	if x != nil {
-		return x.Street
+		return x.RatingAverage
 	}
-	return ""
+	return 0
 }
 
-func (x *MerchantAddress) GetCity() string {
+func (x *Merchant) GetRatingCount() int32 {
 	if x != nil {
-		return x.City
+		return x.RatingCount
 	}
-	return ""
+	return 0
 }
 
-func (x *MerchantAddress) GetState() string {
-	if x != nil {
-		return x.State
-	}
-	return ""
+type BusinessHoursInterval struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DayOfWeek     int32                  `protobuf:"varint,1,opt,name=day_of_week,json=dayOfWeek,proto3" json:"day_of_week,omitempty"` // 0 = Sunday
+	OpensAt       string                 `protobuf:"bytes,2,opt,name=opens_at,json=opensAt,proto3" json:"opens_at,omitempty"`          // HH:MM in the merchant's timezone
+	ClosesAt      string                 `protobuf:"bytes,3,opt,name=closes_at,json=closesAt,proto3" json:"closes_at,omitempty"`       // before opens_at for intervals past midnight, 24:00 to close at midnight
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MerchantAddress) GetPostalCode() string {
-	if x != nil {
-		return x.PostalCode
-	}
-	return ""
+func (x *BusinessHoursInterval) Reset() {
+	*x = BusinessHoursInterval{}
+	mi := &file_proto_schema_schema_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *MerchantAddress) GetCountry() string {
-	if x != nil {
-		return x.Country
-	}
-	return ""
+func (x *BusinessHoursInterval) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *MerchantAddress) GetLatitude() float64 {
+func (*BusinessHoursInterval) ProtoMessage() {}
+
+func (x *BusinessHoursInterval) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schema_schema_proto_msgTypes[3]
 	if x != nil {
-		return x.Latitude
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *MerchantAddress) GetLongitude() float64 {
-	if x != nil {
-		return x.Longitude
-	}
-	return 0
+// Deprecated: Use BusinessHoursInterval.ProtoReflect.Descriptor instead.
+func (*BusinessHoursInterval) Descriptor() ([]byte, []int) {
+	return file_proto_schema_schema_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *MerchantAddress) GetIsPrimary() bool {
+func (x *BusinessHoursInterval) GetDayOfWeek() int32 {
 	if x != nil {
-		return x.IsPrimary
+		return x.DayOfWeek
 	}
-	return false
+	return 0
 }
 
-func (x *MerchantAddress) GetCreatedAt() int64 {
+func (x *BusinessHoursInterval) GetOpensAt() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.OpensAt
 	}
-	return 0
+	return ""
 }
 
-func (x *MerchantAddress) GetUpdatedAt() int64 {
+func (x *BusinessHoursInterval) GetClosesAt() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.ClosesAt
 	}
-	return 0
+	return ""
 }
 
-type CoinPurchase struct {
+type MerchantClosure struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	CoinsReceived float64                `protobuf:"fixed64,4,opt,name=coins_received,json=coinsReceived,proto3" json:"coins_received,omitempty"`
-	PaymentMethod string                 `protobuf:"bytes,5,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
-	PaymentId     string                 `protobuf:"bytes,6,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
-	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	MerchantId    int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	StartsAt      int64                  `protobuf:"varint,3,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        int64                  `protobuf:"varint,4,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CoinPurchase) Reset() {
-	*x = CoinPurchase{}
+func (x *MerchantClosure) Reset() {
+	*x = MerchantClosure{}
 	mi := &file_proto_schema_schema_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CoinPurchase) String() string {
+func (x *MerchantClosure) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CoinPurchase) ProtoMessage() {}
+func (*MerchantClosure) ProtoMessage() {}
 
-func (x *CoinPurchase) ProtoReflect() protoreflect.Message {
+func (x *MerchantClosure) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_schema_schema_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -600,94 +573,74 @@ func (x *CoinPurchase) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CoinPurchase.ProtoReflect.Descriptor instead.
-func (*CoinPurchase) Descriptor() ([]byte, []int) {
+// Deprecated: Use MerchantClosure.ProtoReflect.Descriptor instead.
+func (*MerchantClosure) Descriptor() ([]byte, []int) {
 	return file_proto_schema_schema_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *CoinPurchase) GetId() int64 {
+func (x *MerchantClosure) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *CoinPurchase) GetUserId() int64 {
+func (x *MerchantClosure) GetMerchantId() int64 {
 	if x != nil {
-		return x.UserId
+		return x.MerchantId
 	}
 	return 0
 }
 
-func (x *CoinPurchase) GetAmount() float64 {
+func (x *MerchantClosure) GetStartsAt() int64 {
 	if x != nil {
-		return x.Amount
+		return x.StartsAt
 	}
 	return 0
 }
 
-func (x *CoinPurchase) GetCoinsReceived() float64 {
+func (x *MerchantClosure) GetEndsAt() int64 {
 	if x != nil {
-		return x.CoinsReceived
+		return x.EndsAt
 	}
 	return 0
 }
 
-func (x *CoinPurchase) GetPaymentMethod() string {
-	if x != nil {
-		return x.PaymentMethod
-	}
-	return ""
-}
-
-func (x *CoinPurchase) GetPaymentId() string {
-	if x != nil {
-		return x.PaymentId
-	}
-	return ""
-}
-
-func (x *CoinPurchase) GetStatus() string {
+func (x *MerchantClosure) GetReason() string {
 	if x != nil {
-		return x.Status
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *CoinPurchase) GetCreatedAt() int64 {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return 0
-}
-
-type JwtSession struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId           int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	TokenHash        string                 `protobuf:"bytes,3,opt,name=token_hash,json=tokenHash,proto3" json:"token_hash,omitempty"`
-	RefreshTokenHash string                 `protobuf:"bytes,4,opt,name=refresh_token_hash,json=refreshTokenHash,proto3" json:"refresh_token_hash,omitempty"`
-	ExpiresAt        int64                  `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
-	IsRevoked        bool                   `protobuf:"varint,6,opt,name=is_revoked,json=isRevoked,proto3" json:"is_revoked,omitempty"`
-	CreatedAt        int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type MerchantStatusChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MerchantId    int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	FromStatus    string                 `protobuf:"bytes,3,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"`
+	ToStatus      string                 `protobuf:"bytes,4,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	ActorId       int64                  `protobuf:"varint,6,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ActorType     string                 `protobuf:"bytes,7,opt,name=actor_type,json=actorType,proto3" json:"actor_type,omitempty"` // merchant, admin, system
+	CreatedAt     int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *JwtSession) Reset() {
-	*x = JwtSession{}
+func (x *MerchantStatusChange) Reset() {
+	*x = MerchantStatusChange{}
 	mi := &file_proto_schema_schema_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JwtSession) String() string {
+func (x *MerchantStatusChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JwtSession) ProtoMessage() {}
+func (*MerchantStatusChange) ProtoMessage() {}
 
-func (x *JwtSession) ProtoReflect() protoreflect.Message {
+func (x *MerchantStatusChange) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_schema_schema_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -699,90 +652,100 @@ func (x *JwtSession) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JwtSession.ProtoReflect.Descriptor instead.
-func (*JwtSession) Descriptor() ([]byte, []int) {
+// Deprecated: Use MerchantStatusChange.ProtoReflect.Descriptor instead.
+func (*MerchantStatusChange) Descriptor() ([]byte, []int) {
 	return file_proto_schema_schema_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *JwtSession) GetId() int64 {
+func (x *MerchantStatusChange) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *JwtSession) GetUserId() int64 {
+func (x *MerchantStatusChange) GetMerchantId() int64 {
 	if x != nil {
-		return x.UserId
+		return x.MerchantId
 	}
 	return 0
 }
 
-func (x *JwtSession) GetTokenHash() string {
+func (x *MerchantStatusChange) GetFromStatus() string {
 	if x != nil {
-		return x.TokenHash
+		return x.FromStatus
 	}
 	return ""
 }
 
-func (x *JwtSession) GetRefreshTokenHash() string {
+func (x *MerchantStatusChange) GetToStatus() string {
 	if x != nil {
-		return x.RefreshTokenHash
+		return x.ToStatus
 	}
 	return ""
 }
 
-func (x *JwtSession) GetExpiresAt() int64 {
+func (x *MerchantStatusChange) GetReason() string {
 	if x != nil {
-		return x.ExpiresAt
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *MerchantStatusChange) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
 	}
 	return 0
 }
 
-func (x *JwtSession) GetIsRevoked() bool {
+func (x *MerchantStatusChange) GetActorType() string {
 	if x != nil {
-		return x.IsRevoked
+		return x.ActorType
 	}
-	return false
+	return ""
 }
 
-func (x *JwtSession) GetCreatedAt() int64 {
+func (x *MerchantStatusChange) GetCreatedAt() int64 {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return 0
 }
 
-type Transaction struct {
+type MerchantDocument struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId          int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	MerchantId      int64                  `protobuf:"varint,3,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
-	CoinsSpent      float64                `protobuf:"fixed64,4,opt,name=coins_spent,json=coinsSpent,proto3" json:"coins_spent,omitempty"`
-	OriginalAmount  float64                `protobuf:"fixed64,5,opt,name=original_amount,json=originalAmount,proto3" json:"original_amount,omitempty"`
-	DiscountAmount  float64                `protobuf:"fixed64,6,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
-	FinalAmount     float64                `protobuf:"fixed64,7,opt,name=final_amount,json=finalAmount,proto3" json:"final_amount,omitempty"`
-	TransactionType string                 `protobuf:"bytes,8,opt,name=transaction_type,json=transactionType,proto3" json:"transaction_type,omitempty"`
-	Status          string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt       int64                  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	MerchantId      int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	DocType         string                 `protobuf:"bytes,3,opt,name=doc_type,json=docType,proto3" json:"doc_type,omitempty"` // pan, gst, fssai
+	DocumentNumber  string                 `protobuf:"bytes,4,opt,name=document_number,json=documentNumber,proto3" json:"document_number,omitempty"`
+	ContentType     string                 `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	SizeBytes       int64                  `protobuf:"varint,6,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Status          string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"` // uploaded, verified, rejected
+	RejectionReason string                 `protobuf:"bytes,8,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`
+	ReviewedBy      int64                  `protobuf:"varint,9,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`
+	ReviewedAt      int64                  `protobuf:"varint,10,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	ExpiresAt       int64                  `protobuf:"varint,11,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt       int64                  `protobuf:"varint,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ViewUrl         string                 `protobuf:"bytes,13,opt,name=view_url,json=viewUrl,proto3" json:"view_url,omitempty"` // short lived, only set for admin review
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *Transaction) Reset() {
-	*x = Transaction{}
+func (x *MerchantDocument) Reset() {
+	*x = MerchantDocument{}
 	mi := &file_proto_schema_schema_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Transaction) String() string {
+func (x *MerchantDocument) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Transaction) ProtoMessage() {}
+func (*MerchantDocument) ProtoMessage() {}
 
-func (x *Transaction) ProtoReflect() protoreflect.Message {
+func (x *MerchantDocument) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_schema_schema_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -794,111 +757,135 @@ func (x *Transaction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
-func (*Transaction) Descriptor() ([]byte, []int) {
+// Deprecated: Use MerchantDocument.ProtoReflect.Descriptor instead.
+func (*MerchantDocument) Descriptor() ([]byte, []int) {
 	return file_proto_schema_schema_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Transaction) GetId() int64 {
+func (x *MerchantDocument) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Transaction) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *Transaction) GetMerchantId() int64 {
+func (x *MerchantDocument) GetMerchantId() int64 {
 	if x != nil {
 		return x.MerchantId
 	}
 	return 0
 }
 
-func (x *Transaction) GetCoinsSpent() float64 {
+func (x *MerchantDocument) GetDocType() string {
 	if x != nil {
-		return x.CoinsSpent
+		return x.DocType
 	}
-	return 0
+	return ""
 }
 
-func (x *Transaction) GetOriginalAmount() float64 {
+func (x *MerchantDocument) GetDocumentNumber() string {
 	if x != nil {
-		return x.OriginalAmount
+		return x.DocumentNumber
 	}
-	return 0
+	return ""
 }
 
-func (x *Transaction) GetDiscountAmount() float64 {
+func (x *MerchantDocument) GetContentType() string {
 	if x != nil {
-		return x.DiscountAmount
+		return x.ContentType
 	}
-	return 0
+	return ""
 }
 
-func (x *Transaction) GetFinalAmount() float64 {
+func (x *MerchantDocument) GetSizeBytes() int64 {
 	if x != nil {
-		return x.FinalAmount
+		return x.SizeBytes
 	}
 	return 0
 }
 
-func (x *Transaction) GetTransactionType() string {
+func (x *MerchantDocument) GetStatus() string {
 	if x != nil {
-		return x.TransactionType
+		return x.Status
 	}
 	return ""
 }
 
-func (x *Transaction) GetStatus() string {
+func (x *MerchantDocument) GetRejectionReason() string {
 	if x != nil {
-		return x.Status
+		return x.RejectionReason
 	}
 	return ""
 }
 
-func (x *Transaction) GetCreatedAt() int64 {
+func (x *MerchantDocument) GetReviewedBy() int64 {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return 0
+}
+
+func (x *MerchantDocument) GetReviewedAt() int64 {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return 0
+}
+
+func (x *MerchantDocument) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *MerchantDocument) GetCreatedAt() int64 {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return 0
 }
 
-type Settlement struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	Id                  int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	MerchantId          int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
-	PeriodStart         string                 `protobuf:"bytes,3,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
-	PeriodEnd           string                 `protobuf:"bytes,4,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
-	TotalTransactions   int32                  `protobuf:"varint,5,opt,name=total_transactions,json=totalTransactions,proto3" json:"total_transactions,omitempty"`
-	TotalDiscountAmount float64                `protobuf:"fixed64,6,opt,name=total_discount_amount,json=totalDiscountAmount,proto3" json:"total_discount_amount,omitempty"`
-	SettlementAmount    float64                `protobuf:"fixed64,7,opt,name=settlement_amount,json=settlementAmount,proto3" json:"settlement_amount,omitempty"`
-	Status              string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
-	PaidAt              int64                  `protobuf:"varint,9,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
-	CreatedAt           int64                  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+func (x *MerchantDocument) GetViewUrl() string {
+	if x != nil {
+		return x.ViewUrl
+	}
+	return ""
 }
 
-func (x *Settlement) Reset() {
-	*x = Settlement{}
+type MerchantAddress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MerchantId    int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Street        string                 `protobuf:"bytes,3,opt,name=street,proto3" json:"street,omitempty"`
+	City          string                 `protobuf:"bytes,4,opt,name=city,proto3" json:"city,omitempty"`
+	State         string                 `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+	PostalCode    string                 `protobuf:"bytes,6,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	Country       string                 `protobuf:"bytes,7,opt,name=country,proto3" json:"country,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,8,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,9,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	IsPrimary     bool                   `protobuf:"varint,10,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Label         string                 `protobuf:"bytes,13,opt,name=label,proto3" json:"label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MerchantAddress) Reset() {
+	*x = MerchantAddress{}
 	mi := &file_proto_schema_schema_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Settlement) String() string {
+func (x *MerchantAddress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Settlement) ProtoMessage() {}
+func (*MerchantAddress) ProtoMessage() {}
 
-func (x *Settlement) ProtoReflect() protoreflect.Message {
+func (x *MerchantAddress) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_schema_schema_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -910,113 +897,130 @@ func (x *Settlement) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Settlement.ProtoReflect.Descriptor instead.
-func (*Settlement) Descriptor() ([]byte, []int) {
+// Deprecated: Use MerchantAddress.ProtoReflect.Descriptor instead.
+func (*MerchantAddress) Descriptor() ([]byte, []int) {
 	return file_proto_schema_schema_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Settlement) GetId() int64 {
+func (x *MerchantAddress) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Settlement) GetMerchantId() int64 {
+func (x *MerchantAddress) GetMerchantId() int64 {
 	if x != nil {
 		return x.MerchantId
 	}
 	return 0
 }
 
-func (x *Settlement) GetPeriodStart() string {
+func (x *MerchantAddress) GetStreet() string {
 	if x != nil {
-		return x.PeriodStart
+		return x.Street
 	}
 	return ""
 }
 
-func (x *Settlement) GetPeriodEnd() string {
+func (x *MerchantAddress) GetCity() string {
 	if x != nil {
-		return x.PeriodEnd
+		return x.City
 	}
 	return ""
 }
 
-func (x *Settlement) GetTotalTransactions() int32 {
+func (x *MerchantAddress) GetState() string {
 	if x != nil {
-		return x.TotalTransactions
+		return x.State
 	}
-	return 0
+	return ""
 }
 
-func (x *Settlement) GetTotalDiscountAmount() float64 {
+func (x *MerchantAddress) GetPostalCode() string {
 	if x != nil {
-		return x.TotalDiscountAmount
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *MerchantAddress) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *MerchantAddress) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
 	}
 	return 0
 }
 
-func (x *Settlement) GetSettlementAmount() float64 {
+func (x *MerchantAddress) GetLongitude() float64 {
 	if x != nil {
-		return x.SettlementAmount
+		return x.Longitude
 	}
 	return 0
 }
 
-func (x *Settlement) GetStatus() string {
+func (x *MerchantAddress) GetIsPrimary() bool {
 	if x != nil {
-		return x.Status
+		return x.IsPrimary
 	}
-	return ""
+	return false
 }
 
-func (x *Settlement) GetPaidAt() int64 {
+func (x *MerchantAddress) GetCreatedAt() int64 {
 	if x != nil {
-		return x.PaidAt
+		return x.CreatedAt
 	}
 	return 0
 }
 
-func (x *Settlement) GetCreatedAt() int64 {
+func (x *MerchantAddress) GetUpdatedAt() int64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.UpdatedAt
 	}
 	return 0
 }
 
-type Offer struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	MerchantId         int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
-	Title              string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Description        string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	DiscountPercentage float64                `protobuf:"fixed64,5,opt,name=discount_percentage,json=discountPercentage,proto3" json:"discount_percentage,omitempty"`
-	MinAmount          float64                `protobuf:"fixed64,6,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
-	MaxDiscount        float64                `protobuf:"fixed64,7,opt,name=max_discount,json=maxDiscount,proto3" json:"max_discount,omitempty"`
-	IsActive           bool                   `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	ValidFrom          int64                  `protobuf:"varint,9,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
-	ValidUntil         int64                  `protobuf:"varint,10,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
-	CreatedAt          int64                  `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt          int64                  `protobuf:"varint,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *MerchantAddress) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
 }
 
-func (x *Offer) Reset() {
-	*x = Offer{}
+type CoinPurchase struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	CoinsReceived float64                `protobuf:"fixed64,4,opt,name=coins_received,json=coinsReceived,proto3" json:"coins_received,omitempty"`
+	PaymentMethod string                 `protobuf:"bytes,5,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	PaymentId     string                 `protobuf:"bytes,6,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CoinPurchase) Reset() {
+	*x = CoinPurchase{}
 	mi := &file_proto_schema_schema_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Offer) String() string {
+func (x *CoinPurchase) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Offer) ProtoMessage() {}
+func (*CoinPurchase) ProtoMessage() {}
 
-func (x *Offer) ProtoReflect() protoreflect.Message {
+func (x *CoinPurchase) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_schema_schema_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1028,130 +1032,1976 @@ func (x *Offer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Offer.ProtoReflect.Descriptor instead.
-func (*Offer) Descriptor() ([]byte, []int) {
+// Deprecated: Use CoinPurchase.ProtoReflect.Descriptor instead.
+func (*CoinPurchase) Descriptor() ([]byte, []int) {
 	return file_proto_schema_schema_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Offer) GetId() int64 {
+func (x *CoinPurchase) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Offer) GetMerchantId() int64 {
+func (x *CoinPurchase) GetUserId() int64 {
 	if x != nil {
-		return x.MerchantId
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *Offer) GetTitle() string {
+func (x *CoinPurchase) GetAmount() float64 {
 	if x != nil {
-		return x.Title
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CoinPurchase) GetCoinsReceived() float64 {
+	if x != nil {
+		return x.CoinsReceived
+	}
+	return 0
+}
+
+func (x *CoinPurchase) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
 	}
 	return ""
 }
 
-func (x *Offer) GetDescription() string {
+func (x *CoinPurchase) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *CoinPurchase) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CoinPurchase) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type JwtSession struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId           int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TokenHash        string                 `protobuf:"bytes,3,opt,name=token_hash,json=tokenHash,proto3" json:"token_hash,omitempty"`
+	RefreshTokenHash string                 `protobuf:"bytes,4,opt,name=refresh_token_hash,json=refreshTokenHash,proto3" json:"refresh_token_hash,omitempty"`
+	ExpiresAt        int64                  `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	IsRevoked        bool                   `protobuf:"varint,6,opt,name=is_revoked,json=isRevoked,proto3" json:"is_revoked,omitempty"`
+	CreatedAt        int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *JwtSession) Reset() {
+	*x = JwtSession{}
+	mi := &file_proto_schema_schema_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JwtSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JwtSession) ProtoMessage() {}
+
+func (x *JwtSession) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schema_schema_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JwtSession.ProtoReflect.Descriptor instead.
+func (*JwtSession) Descriptor() ([]byte, []int) {
+	return file_proto_schema_schema_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *JwtSession) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *JwtSession) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *JwtSession) GetTokenHash() string {
+	if x != nil {
+		return x.TokenHash
+	}
+	return ""
+}
+
+func (x *JwtSession) GetRefreshTokenHash() string {
+	if x != nil {
+		return x.RefreshTokenHash
+	}
+	return ""
+}
+
+func (x *JwtSession) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *JwtSession) GetIsRevoked() bool {
+	if x != nil {
+		return x.IsRevoked
+	}
+	return false
+}
+
+func (x *JwtSession) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type Transaction struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId          int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MerchantId      int64                  `protobuf:"varint,3,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	CoinsSpent      float64                `protobuf:"fixed64,4,opt,name=coins_spent,json=coinsSpent,proto3" json:"coins_spent,omitempty"`
+	OriginalAmount  float64                `protobuf:"fixed64,5,opt,name=original_amount,json=originalAmount,proto3" json:"original_amount,omitempty"`
+	DiscountAmount  float64                `protobuf:"fixed64,6,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	FinalAmount     float64                `protobuf:"fixed64,7,opt,name=final_amount,json=finalAmount,proto3" json:"final_amount,omitempty"`
+	TransactionType string                 `protobuf:"bytes,8,opt,name=transaction_type,json=transactionType,proto3" json:"transaction_type,omitempty"`
+	Status          string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt       int64                  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Transaction) Reset() {
+	*x = Transaction{}
+	mi := &file_proto_schema_schema_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transaction) ProtoMessage() {}
+
+func (x *Transaction) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schema_schema_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return file_proto_schema_schema_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Transaction) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Transaction) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Transaction) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *Transaction) GetCoinsSpent() float64 {
+	if x != nil {
+		return x.CoinsSpent
+	}
+	return 0
+}
+
+func (x *Transaction) GetOriginalAmount() float64 {
+	if x != nil {
+		return x.OriginalAmount
+	}
+	return 0
+}
+
+func (x *Transaction) GetDiscountAmount() float64 {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return 0
+}
+
+func (x *Transaction) GetFinalAmount() float64 {
+	if x != nil {
+		return x.FinalAmount
+	}
+	return 0
+}
+
+func (x *Transaction) GetTransactionType() string {
+	if x != nil {
+		return x.TransactionType
+	}
+	return ""
+}
+
+func (x *Transaction) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Transaction) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type Settlement struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MerchantId          int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	PeriodStart         string                 `protobuf:"bytes,3,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd           string                 `protobuf:"bytes,4,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	TotalTransactions   int32                  `protobuf:"varint,5,opt,name=total_transactions,json=totalTransactions,proto3" json:"total_transactions,omitempty"`
+	TotalDiscountAmount float64                `protobuf:"fixed64,6,opt,name=total_discount_amount,json=totalDiscountAmount,proto3" json:"total_discount_amount,omitempty"`
+	SettlementAmount    float64                `protobuf:"fixed64,7,opt,name=settlement_amount,json=settlementAmount,proto3" json:"settlement_amount,omitempty"`
+	Status              string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	PaidAt              int64                  `protobuf:"varint,9,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
+	CreatedAt           int64                  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Settlement) Reset() {
+	*x = Settlement{}
+	mi := &file_proto_schema_schema_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Settlement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Settlement) ProtoMessage() {}
+
+func (x *Settlement) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schema_schema_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Settlement.ProtoReflect.Descriptor instead.
+func (*Settlement) Descriptor() ([]byte, []int) {
+	return file_proto_schema_schema_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Settlement) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Settlement) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *Settlement) GetPeriodStart() string {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return ""
+}
+
+func (x *Settlement) GetPeriodEnd() string {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return ""
+}
+
+func (x *Settlement) GetTotalTransactions() int32 {
+	if x != nil {
+		return x.TotalTransactions
+	}
+	return 0
+}
+
+func (x *Settlement) GetTotalDiscountAmount() float64 {
+	if x != nil {
+		return x.TotalDiscountAmount
+	}
+	return 0
+}
+
+func (x *Settlement) GetSettlementAmount() float64 {
+	if x != nil {
+		return x.SettlementAmount
+	}
+	return 0
+}
+
+func (x *Settlement) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Settlement) GetPaidAt() int64 {
+	if x != nil {
+		return x.PaidAt
+	}
+	return 0
+}
+
+func (x *Settlement) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type Offer struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MerchantId            int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Title                 string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description           string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	DiscountPercentage    float64                `protobuf:"fixed64,5,opt,name=discount_percentage,json=discountPercentage,proto3" json:"discount_percentage,omitempty"`
+	MinAmount             float64                `protobuf:"fixed64,6,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	MaxDiscount           float64                `protobuf:"fixed64,7,opt,name=max_discount,json=maxDiscount,proto3" json:"max_discount,omitempty"`
+	IsActive              bool                   `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	ValidFrom             int64                  `protobuf:"varint,9,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidUntil            int64                  `protobuf:"varint,10,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+	CreatedAt             int64                  `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt             int64                  `protobuf:"varint,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DistanceKm            float64                `protobuf:"fixed64,13,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`                                    // set by nearby searches
+	MerchantRatingAverage float64                `protobuf:"fixed64,14,opt,name=merchant_rating_average,json=merchantRatingAverage,proto3" json:"merchant_rating_average,omitempty"` // set by nearby searches
+	MerchantRatingCount   int32                  `protobuf:"varint,15,opt,name=merchant_rating_count,json=merchantRatingCount,proto3" json:"merchant_rating_count,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Offer) Reset() {
+	*x = Offer{}
+	mi := &file_proto_schema_schema_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Offer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Offer) ProtoMessage() {}
+
+func (x *Offer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schema_schema_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Offer.ProtoReflect.Descriptor instead.
+func (*Offer) Descriptor() ([]byte, []int) {
+	return file_proto_schema_schema_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Offer) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Offer) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *Offer) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Offer) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Offer) GetDiscountPercentage() float64 {
+	if x != nil {
+		return x.DiscountPercentage
+	}
+	return 0
+}
+
+func (x *Offer) GetMinAmount() float64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *Offer) GetMaxDiscount() float64 {
+	if x != nil {
+		return x.MaxDiscount
+	}
+	return 0
+}
+
+func (x *Offer) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Offer) GetValidFrom() int64 {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return 0
+}
+
+func (x *Offer) GetValidUntil() int64 {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return 0
+}
+
+func (x *Offer) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Offer) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Offer) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+func (x *Offer) GetMerchantRatingAverage() float64 {
+	if x != nil {
+		return x.MerchantRatingAverage
+	}
+	return 0
+}
+
+func (x *Offer) GetMerchantRatingCount() int32 {
+	if x != nil {
+		return x.MerchantRatingCount
+	}
+	return 0
+}
+
+type Order struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MerchantId     int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	UserId         int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OfferId        int64                  `protobuf:"varint,4,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+	OrderNumber    string                 `protobuf:"bytes,5,opt,name=order_number,json=orderNumber,proto3" json:"order_number,omitempty"`
+	Items          string                 `protobuf:"bytes,6,opt,name=items,proto3" json:"items,omitempty"`
+	Subtotal       float64                `protobuf:"fixed64,7,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	DiscountAmount float64                `protobuf:"fixed64,8,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	TotalAmount    float64                `protobuf:"fixed64,9,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	CoinsUsed      float64                `protobuf:"fixed64,10,opt,name=coins_used,json=coinsUsed,proto3" json:"coins_used,omitempty"`
+	Status         string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"` // pending, accepted, preparing, ready, completed, cancelled, rejected
+	Notes          string                 `protobuf:"bytes,12,opt,name=notes,proto3" json:"notes,omitempty"`
+	CreatedAt      int64                  `protobuf:"varint,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      int64                  `protobuf:"varint,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	LineItems      []*OrderLineItem       `protobuf:"bytes,15,rep,name=line_items,json=lineItems,proto3" json:"line_items,omitempty"`          // set for orders placed from the catalog
+	StatusReason   string                 `protobuf:"bytes,16,opt,name=status_reason,json=statusReason,proto3" json:"status_reason,omitempty"` // note left with the latest status change
+	AcceptedAt     int64                  `protobuf:"varint,17,opt,name=accepted_at,json=acceptedAt,proto3" json:"accepted_at,omitempty"`
+	PreparingAt    int64                  `protobuf:"varint,18,opt,name=preparing_at,json=preparingAt,proto3" json:"preparing_at,omitempty"`
+	ReadyAt        int64                  `protobuf:"varint,19,opt,name=ready_at,json=readyAt,proto3" json:"ready_at,omitempty"`
+	CompletedAt    int64                  `protobuf:"varint,20,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	CancelledAt    int64                  `protobuf:"varint,21,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
+	RejectedAt     int64                  `protobuf:"varint,22,opt,name=rejected_at,json=rejectedAt,proto3" json:"rejected_at,omitempty"`
+	OutletId       int64                  `protobuf:"varint,23,opt,name=outlet_id,json=outletId,proto3" json:"outlet_id,omitempty"` // merchant address the order was placed at
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	mi := &file_proto_schema_schema_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schema_schema_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_proto_schema_schema_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Order) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Order) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *Order) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Order) GetOfferId() int64 {
+	if x != nil {
+		return x.OfferId
+	}
+	return 0
+}
+
+func (x *Order) GetOrderNumber() string {
+	if x != nil {
+		return x.OrderNumber
+	}
+	return ""
+}
+
+func (x *Order) GetItems() string {
+	if x != nil {
+		return x.Items
+	}
+	return ""
+}
+
+func (x *Order) GetSubtotal() float64 {
+	if x != nil {
+		return x.Subtotal
+	}
+	return 0
+}
+
+func (x *Order) GetDiscountAmount() float64 {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return 0
+}
+
+func (x *Order) GetTotalAmount() float64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *Order) GetCoinsUsed() float64 {
+	if x != nil {
+		return x.CoinsUsed
+	}
+	return 0
+}
+
+func (x *Order) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Order) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Order) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Order) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Order) GetLineItems() []*OrderLineItem {
+	if x != nil {
+		return x.LineItems
+	}
+	return nil
+}
+
+func (x *Order) GetStatusReason() string {
+	if x != nil {
+		return x.StatusReason
+	}
+	return ""
+}
+
+func (x *Order) GetAcceptedAt() int64 {
+	if x != nil {
+		return x.AcceptedAt
+	}
+	return 0
+}
+
+func (x *Order) GetPreparingAt() int64 {
+	if x != nil {
+		return x.PreparingAt
+	}
+	return 0
+}
+
+func (x *Order) GetReadyAt() int64 {
+	if x != nil {
+		return x.ReadyAt
+	}
+	return 0
+}
+
+func (x *Order) GetCompletedAt() int64 {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return 0
+}
+
+func (x *Order) GetCancelledAt() int64 {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return 0
+}
+
+func (x *Order) GetRejectedAt() int64 {
+	if x != nil {
+		return x.RejectedAt
+	}
+	return 0
+}
+
+func (x *Order) GetOutletId() int64 {
+	if x != nil {
+		return x.OutletId
+	}
+	return 0
+}
+
+type AuditLog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActorId       int64                  `protobuf:"varint,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ActorType     string                 `protobuf:"bytes,3,opt,name=actor_type,json=actorType,proto3" json:"actor_type,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	TargetType    string                 `protobuf:"bytes,5,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
+	TargetId      int64                  `protobuf:"varint,6,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Metadata      string                 `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	IpAddress     string                 `protobuf:"bytes,8,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,9,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditLog) Reset() {
+	*x = AuditLog{}
+	mi := &file_proto_schema_schema_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLog) ProtoMessage() {}
+
+func (x *AuditLog) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schema_schema_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLog.ProtoReflect.Descriptor instead.
+func (*AuditLog) Descriptor() ([]byte, []int) {
+	return file_proto_schema_schema_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AuditLog) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditLog) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *AuditLog) GetActorType() string {
+	if x != nil {
+		return x.ActorType
+	}
+	return ""
+}
+
+func (x *AuditLog) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditLog) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *AuditLog) GetTargetId() int64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *AuditLog) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+func (x *AuditLog) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *AuditLog) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *AuditLog) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type MerchantApiKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MerchantId    int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Prefix        string                 `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Scopes        []string               `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	AllowedIps    []string               `protobuf:"bytes,6,rep,name=allowed_ips,json=allowedIps,proto3" json:"allowed_ips,omitempty"`
+	LastUsedAt    int64                  `protobuf:"varint,7,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	LastUsedIp    string                 `protobuf:"bytes,8,opt,name=last_used_ip,json=lastUsedIp,proto3" json:"last_used_ip,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	RevokedAt     int64                  `protobuf:"varint,10,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MerchantApiKey) Reset() {
+	*x = MerchantApiKey{}
+	mi := &file_proto_schema_schema_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MerchantApiKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MerchantApiKey) ProtoMessage() {}
+
+func (x *MerchantApiKey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schema_schema_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MerchantApiKey.ProtoReflect.Descriptor instead.
+func (*MerchantApiKey) Descriptor() ([]byte, []int) {
+	return file_proto_schema_schema_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MerchantApiKey) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MerchantApiKey) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *MerchantApiKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MerchantApiKey) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *MerchantApiKey) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *MerchantApiKey) GetAllowedIps() []string {
+	if x != nil {
+		return x.AllowedIps
+	}
+	return nil
+}
+
+func (x *MerchantApiKey) GetLastUsedAt() int64 {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return 0
+}
+
+func (x *MerchantApiKey) GetLastUsedIp() string {
+	if x != nil {
+		return x.LastUsedIp
+	}
+	return ""
+}
+
+func (x *MerchantApiKey) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *MerchantApiKey) GetRevokedAt() int64 {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return 0
+}
+
+func (x *MerchantApiKey) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type UserSession struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeviceId      string                 `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	DeviceName    string                 `protobuf:"bytes,3,opt,name=device_name,json=deviceName,proto3" json:"device_name,omitempty"`
+	Platform      string                 `protobuf:"bytes,4,opt,name=platform,proto3" json:"platform,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,5,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	IpAddress     string                 `protobuf:"bytes,6,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	Current       bool                   `protobuf:"varint,7,opt,name=current,proto3" json:"current,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastSeenAt    int64                  `protobuf:"varint,9,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,10,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSession) Reset() {
+	*x = UserSession{}
+	mi := &file_proto_schema_schema_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSession) ProtoMessage() {}
+
+func (x *UserSession) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schema_schema_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSession.ProtoReflect.Descriptor instead.
+func (*UserSession) Descriptor() ([]byte, []int) {
+	return file_proto_schema_schema_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UserSession) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserSession) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *UserSession) GetDeviceName() string {
+	if x != nil {
+		return x.DeviceName
+	}
+	return ""
+}
+
+func (x *UserSession) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *UserSession) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *UserSession) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *UserSession) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+func (x *UserSession) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *UserSession) GetLastSeenAt() int64 {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return 0
+}
+
+func (x *UserSession) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type UserIdentity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Provider      string                 `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	Subject       string                 `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	Email         string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	EmailVerified bool                   `protobuf:"varint,6,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"`
+	LastLoginAt   int64                  `protobuf:"varint,7,opt,name=last_login_at,json=lastLoginAt,proto3" json:"last_login_at,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserIdentity) Reset() {
+	*x = UserIdentity{}
+	mi := &file_proto_schema_schema_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserIdentity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserIdentity) ProtoMessage() {}
+
+func (x *UserIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schema_schema_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserIdentity.ProtoReflect.Descriptor instead.
+func (*UserIdentity) Descriptor() ([]byte, []int) {
+	return file_proto_schema_schema_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UserIdentity) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserIdentity) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserIdentity) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *UserIdentity) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *UserIdentity) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UserIdentity) GetEmailVerified() bool {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return false
+}
+
+func (x *UserIdentity) GetLastLoginAt() int64 {
+	if x != nil {
+		return x.LastLoginAt
+	}
+	return 0
+}
+
+func (x *UserIdentity) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type CatalogCategory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MerchantId    int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	SortOrder     int32                  `protobuf:"varint,4,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CatalogCategory) Reset() {
+	*x = CatalogCategory{}
+	mi := &file_proto_schema_schema_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogCategory) ProtoMessage() {}
+
+func (x *CatalogCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schema_schema_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogCategory.ProtoReflect.Descriptor instead.
+func (*CatalogCategory) Descriptor() ([]byte, []int) {
+	return file_proto_schema_schema_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CatalogCategory) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CatalogCategory) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *CatalogCategory) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CatalogCategory) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+type CatalogOption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ItemId        int64                  `protobuf:"varint,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"` // variant or addon
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	PriceDelta    float64                `protobuf:"fixed64,5,opt,name=price_delta,json=priceDelta,proto3" json:"price_delta,omitempty"`
+	IsAvailable   bool                   `protobuf:"varint,6,opt,name=is_available,json=isAvailable,proto3" json:"is_available,omitempty"`
+	SortOrder     int32                  `protobuf:"varint,7,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CatalogOption) Reset() {
+	*x = CatalogOption{}
+	mi := &file_proto_schema_schema_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogOption) ProtoMessage() {}
+
+func (x *CatalogOption) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schema_schema_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogOption.ProtoReflect.Descriptor instead.
+func (*CatalogOption) Descriptor() ([]byte, []int) {
+	return file_proto_schema_schema_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CatalogOption) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CatalogOption) GetItemId() int64 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *CatalogOption) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CatalogOption) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CatalogOption) GetPriceDelta() float64 {
+	if x != nil {
+		return x.PriceDelta
+	}
+	return 0
+}
+
+func (x *CatalogOption) GetIsAvailable() bool {
+	if x != nil {
+		return x.IsAvailable
+	}
+	return false
+}
+
+func (x *CatalogOption) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+type CatalogItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MerchantId    int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	CategoryId    int64                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Price         float64                `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`
+	IsAvailable   bool                   `protobuf:"varint,7,opt,name=is_available,json=isAvailable,proto3" json:"is_available,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,8,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	SortOrder     int32                  `protobuf:"varint,9,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	Options       []*CatalogOption       `protobuf:"bytes,10,rep,name=options,proto3" json:"options,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CatalogItem) Reset() {
+	*x = CatalogItem{}
+	mi := &file_proto_schema_schema_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogItem) ProtoMessage() {}
+
+func (x *CatalogItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schema_schema_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogItem.ProtoReflect.Descriptor instead.
+func (*CatalogItem) Descriptor() ([]byte, []int) {
+	return file_proto_schema_schema_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CatalogItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CatalogItem) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *CatalogItem) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CatalogItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CatalogItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CatalogItem) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CatalogItem) GetIsAvailable() bool {
+	if x != nil {
+		return x.IsAvailable
+	}
+	return false
+}
+
+func (x *CatalogItem) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *CatalogItem) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+func (x *CatalogItem) GetOptions() []*CatalogOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *CatalogItem) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *CatalogItem) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type OrderLineItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        int64                  `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	VariantId     int64                  `protobuf:"varint,2,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	AddonIds      []int64                `protobuf:"varint,3,rep,packed,name=addon_ids,json=addonIds,proto3" json:"addon_ids,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice     float64                `protobuf:"fixed64,5,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"` // price the client showed, rejected when it no longer matches
+	Name          string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`                              // set by the server
+	Subtotal      float64                `protobuf:"fixed64,7,opt,name=subtotal,proto3" json:"subtotal,omitempty"`                    // set by the server
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderLineItem) Reset() {
+	*x = OrderLineItem{}
+	mi := &file_proto_schema_schema_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderLineItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderLineItem) ProtoMessage() {}
+
+func (x *OrderLineItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schema_schema_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderLineItem.ProtoReflect.Descriptor instead.
+func (*OrderLineItem) Descriptor() ([]byte, []int) {
+	return file_proto_schema_schema_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *OrderLineItem) GetItemId() int64 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *OrderLineItem) GetVariantId() int64 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+func (x *OrderLineItem) GetAddonIds() []int64 {
+	if x != nil {
+		return x.AddonIds
+	}
+	return nil
+}
+
+func (x *OrderLineItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OrderLineItem) GetUnitPrice() float64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *OrderLineItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OrderLineItem) GetSubtotal() float64 {
+	if x != nil {
+		return x.Subtotal
+	}
+	return 0
+}
+
+type MerchantStaff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MerchantId    int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	Role          string                 `protobuf:"bytes,6,opt,name=role,proto3" json:"role,omitempty"`                                    // owner, manager, cashier
+	OutletIds     []int64                `protobuf:"varint,7,rep,packed,name=outlet_ids,json=outletIds,proto3" json:"outlet_ids,omitempty"` // merchant address IDs, empty for every outlet
+	MerchantName  string                 `protobuf:"bytes,8,opt,name=merchant_name,json=merchantName,proto3" json:"merchant_name,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MerchantStaff) Reset() {
+	*x = MerchantStaff{}
+	mi := &file_proto_schema_schema_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MerchantStaff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MerchantStaff) ProtoMessage() {}
+
+func (x *MerchantStaff) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schema_schema_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MerchantStaff.ProtoReflect.Descriptor instead.
+func (*MerchantStaff) Descriptor() ([]byte, []int) {
+	return file_proto_schema_schema_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MerchantStaff) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MerchantStaff) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *MerchantStaff) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MerchantStaff) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MerchantStaff) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *MerchantStaff) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *MerchantStaff) GetOutletIds() []int64 {
+	if x != nil {
+		return x.OutletIds
+	}
+	return nil
+}
+
+func (x *MerchantStaff) GetMerchantName() string {
+	if x != nil {
+		return x.MerchantName
+	}
+	return ""
+}
+
+func (x *MerchantStaff) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type StaffInvitation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MerchantId    int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	OutletIds     []int64                `protobuf:"varint,5,rep,packed,name=outlet_ids,json=outletIds,proto3" json:"outlet_ids,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StaffInvitation) Reset() {
+	*x = StaffInvitation{}
+	mi := &file_proto_schema_schema_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaffInvitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaffInvitation) ProtoMessage() {}
+
+func (x *StaffInvitation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schema_schema_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaffInvitation.ProtoReflect.Descriptor instead.
+func (*StaffInvitation) Descriptor() ([]byte, []int) {
+	return file_proto_schema_schema_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *StaffInvitation) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StaffInvitation) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *StaffInvitation) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *StaffInvitation) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *StaffInvitation) GetOutletIds() []int64 {
+	if x != nil {
+		return x.OutletIds
+	}
+	return nil
+}
+
+func (x *StaffInvitation) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *StaffInvitation) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// Receipt is an issued order or payment receipt. Merchants with a verified
+// GSTIN issue it as a tax invoice.
+type Receipt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MerchantId    int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrderId       int64                  `protobuf:"varint,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`                   // set for order receipts
+	TransactionId int64                  `protobuf:"varint,5,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"` // set for payment receipts
+	InvoiceNumber string                 `protobuf:"bytes,6,opt,name=invoice_number,json=invoiceNumber,proto3" json:"invoice_number,omitempty"`  // sequential per merchant per financial year, e.g. 2026-27/000042
+	FinancialYear string                 `protobuf:"bytes,7,opt,name=financial_year,json=financialYear,proto3" json:"financial_year,omitempty"`
+	Gstin         string                 `protobuf:"bytes,8,opt,name=gstin,proto3" json:"gstin,omitempty"`                    // empty when the receipt isn't a tax invoice
+	HtmlUrl       string                 `protobuf:"bytes,9,opt,name=html_url,json=htmlUrl,proto3" json:"html_url,omitempty"` // short lived
+	PdfUrl        string                 `protobuf:"bytes,10,opt,name=pdf_url,json=pdfUrl,proto3" json:"pdf_url,omitempty"`   // short lived
+	UrlsExpireAt  int64                  `protobuf:"varint,11,opt,name=urls_expire_at,json=urlsExpireAt,proto3" json:"urls_expire_at,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Receipt) Reset() {
+	*x = Receipt{}
+	mi := &file_proto_schema_schema_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Receipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Receipt) ProtoMessage() {}
+
+func (x *Receipt) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schema_schema_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Receipt.ProtoReflect.Descriptor instead.
+func (*Receipt) Descriptor() ([]byte, []int) {
+	return file_proto_schema_schema_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Receipt) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Receipt) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *Receipt) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Receipt) GetOrderId() int64 {
 	if x != nil {
-		return x.Description
+		return x.OrderId
 	}
-	return ""
+	return 0
 }
 
-func (x *Offer) GetDiscountPercentage() float64 {
+func (x *Receipt) GetTransactionId() int64 {
 	if x != nil {
-		return x.DiscountPercentage
+		return x.TransactionId
 	}
 	return 0
 }
 
-func (x *Offer) GetMinAmount() float64 {
+func (x *Receipt) GetInvoiceNumber() string {
 	if x != nil {
-		return x.MinAmount
+		return x.InvoiceNumber
 	}
-	return 0
+	return ""
 }
 
-func (x *Offer) GetMaxDiscount() float64 {
+func (x *Receipt) GetFinancialYear() string {
 	if x != nil {
-		return x.MaxDiscount
+		return x.FinancialYear
 	}
-	return 0
+	return ""
 }
 
-func (x *Offer) GetIsActive() bool {
+func (x *Receipt) GetGstin() string {
 	if x != nil {
-		return x.IsActive
+		return x.Gstin
 	}
-	return false
+	return ""
 }
 
-func (x *Offer) GetValidFrom() int64 {
+func (x *Receipt) GetHtmlUrl() string {
 	if x != nil {
-		return x.ValidFrom
+		return x.HtmlUrl
 	}
-	return 0
+	return ""
 }
 
-func (x *Offer) GetValidUntil() int64 {
+func (x *Receipt) GetPdfUrl() string {
 	if x != nil {
-		return x.ValidUntil
+		return x.PdfUrl
 	}
-	return 0
+	return ""
 }
 
-func (x *Offer) GetCreatedAt() int64 {
+func (x *Receipt) GetUrlsExpireAt() int64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.UrlsExpireAt
 	}
 	return 0
 }
 
-func (x *Offer) GetUpdatedAt() int64 {
+func (x *Receipt) GetCreatedAt() int64 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.CreatedAt
 	}
 	return 0
 }
 
-type Order struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	MerchantId     int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
-	UserId         int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	OfferId        int64                  `protobuf:"varint,4,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
-	OrderNumber    string                 `protobuf:"bytes,5,opt,name=order_number,json=orderNumber,proto3" json:"order_number,omitempty"`
-	Items          string                 `protobuf:"bytes,6,opt,name=items,proto3" json:"items,omitempty"`
-	Subtotal       float64                `protobuf:"fixed64,7,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
-	DiscountAmount float64                `protobuf:"fixed64,8,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
-	TotalAmount    float64                `protobuf:"fixed64,9,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
-	CoinsUsed      float64                `protobuf:"fixed64,10,opt,name=coins_used,json=coinsUsed,proto3" json:"coins_used,omitempty"`
-	Status         string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
-	Notes          string                 `protobuf:"bytes,12,opt,name=notes,proto3" json:"notes,omitempty"`
-	CreatedAt      int64                  `protobuf:"varint,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt      int64                  `protobuf:"varint,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type Review struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MerchantId       int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	UserId           int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrderId          int64                  `protobuf:"varint,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Rating           int32                  `protobuf:"varint,5,opt,name=rating,proto3" json:"rating,omitempty"` // 1 to 5 stars
+	Body             string                 `protobuf:"bytes,6,opt,name=body,proto3" json:"body,omitempty"`
+	Status           string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"` // published, hidden
+	FlagCount        int32                  `protobuf:"varint,8,opt,name=flag_count,json=flagCount,proto3" json:"flag_count,omitempty"`
+	ModerationReason string                 `protobuf:"bytes,9,opt,name=moderation_reason,json=moderationReason,proto3" json:"moderation_reason,omitempty"`
+	Reply            string                 `protobuf:"bytes,10,opt,name=reply,proto3" json:"reply,omitempty"` // the merchant's answer
+	RepliedAt        int64                  `protobuf:"varint,11,opt,name=replied_at,json=repliedAt,proto3" json:"replied_at,omitempty"`
+	CreatedAt        int64                  `protobuf:"varint,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UserName         string                 `protobuf:"bytes,13,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	PhotoUrls        []string               `protobuf:"bytes,14,rep,name=photo_urls,json=photoUrls,proto3" json:"photo_urls,omitempty"` // short lived
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *Order) Reset() {
-	*x = Order{}
-	mi := &file_proto_schema_schema_proto_msgTypes[9]
+func (x *Review) Reset() {
+	*x = Review{}
+	mi := &file_proto_schema_schema_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Order) String() string {
+func (x *Review) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Order) ProtoMessage() {}
+func (*Review) ProtoMessage() {}
 
-func (x *Order) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_schema_schema_proto_msgTypes[9]
+func (x *Review) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schema_schema_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1162,140 +3012,138 @@ func (x *Order) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Order.ProtoReflect.Descriptor instead.
-func (*Order) Descriptor() ([]byte, []int) {
-	return file_proto_schema_schema_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use Review.ProtoReflect.Descriptor instead.
+func (*Review) Descriptor() ([]byte, []int) {
+	return file_proto_schema_schema_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *Order) GetId() int64 {
+func (x *Review) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Order) GetMerchantId() int64 {
+func (x *Review) GetMerchantId() int64 {
 	if x != nil {
 		return x.MerchantId
 	}
 	return 0
 }
 
-func (x *Order) GetUserId() int64 {
+func (x *Review) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *Order) GetOfferId() int64 {
+func (x *Review) GetOrderId() int64 {
 	if x != nil {
-		return x.OfferId
+		return x.OrderId
 	}
 	return 0
 }
 
-func (x *Order) GetOrderNumber() string {
+func (x *Review) GetRating() int32 {
 	if x != nil {
-		return x.OrderNumber
+		return x.Rating
 	}
-	return ""
+	return 0
 }
 
-func (x *Order) GetItems() string {
+func (x *Review) GetBody() string {
 	if x != nil {
-		return x.Items
+		return x.Body
 	}
 	return ""
 }
 
-func (x *Order) GetSubtotal() float64 {
+func (x *Review) GetStatus() string {
 	if x != nil {
-		return x.Subtotal
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-func (x *Order) GetDiscountAmount() float64 {
+func (x *Review) GetFlagCount() int32 {
 	if x != nil {
-		return x.DiscountAmount
+		return x.FlagCount
 	}
 	return 0
 }
 
-func (x *Order) GetTotalAmount() float64 {
+func (x *Review) GetModerationReason() string {
 	if x != nil {
-		return x.TotalAmount
+		return x.ModerationReason
 	}
-	return 0
+	return ""
 }
 
-func (x *Order) GetCoinsUsed() float64 {
+func (x *Review) GetReply() string {
 	if x != nil {
-		return x.CoinsUsed
+		return x.Reply
 	}
-	return 0
+	return ""
 }
 
-func (x *Order) GetStatus() string {
+func (x *Review) GetRepliedAt() int64 {
 	if x != nil {
-		return x.Status
+		return x.RepliedAt
 	}
-	return ""
+	return 0
 }
 
-func (x *Order) GetNotes() string {
+func (x *Review) GetCreatedAt() int64 {
 	if x != nil {
-		return x.Notes
+		return x.CreatedAt
 	}
-	return ""
+	return 0
 }
 
-func (x *Order) GetCreatedAt() int64 {
+func (x *Review) GetUserName() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.UserName
 	}
-	return 0
+	return ""
 }
 
-func (x *Order) GetUpdatedAt() int64 {
+func (x *Review) GetPhotoUrls() []string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.PhotoUrls
 	}
-	return 0
+	return nil
 }
 
-type AuditLog struct {
+type Notification struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	ActorId       int64                  `protobuf:"varint,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
-	ActorType     string                 `protobuf:"bytes,3,opt,name=actor_type,json=actorType,proto3" json:"actor_type,omitempty"`
-	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
-	TargetType    string                 `protobuf:"bytes,5,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
-	TargetId      int64                  `protobuf:"varint,6,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
-	Metadata      string                 `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	IpAddress     string                 `protobuf:"bytes,8,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
-	UserAgent     string                 `protobuf:"bytes,9,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // order, payment, referral, onboarding, kyc, order_sla, system
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	DeepLink      string                 `protobuf:"bytes,5,opt,name=deep_link,json=deepLink,proto3" json:"deep_link,omitempty"` // e.g. rival://orders/42
+	Read          bool                   `protobuf:"varint,6,opt,name=read,proto3" json:"read,omitempty"`
+	ReadAt        int64                  `protobuf:"varint,7,opt,name=read_at,json=readAt,proto3" json:"read_at,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AuditLog) Reset() {
-	*x = AuditLog{}
-	mi := &file_proto_schema_schema_proto_msgTypes[10]
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_proto_schema_schema_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuditLog) String() string {
+func (x *Notification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuditLog) ProtoMessage() {}
+func (*Notification) ProtoMessage() {}
 
-func (x *AuditLog) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_schema_schema_proto_msgTypes[10]
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schema_schema_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1306,86 +3154,140 @@ func (x *AuditLog) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuditLog.ProtoReflect.Descriptor instead.
-func (*AuditLog) Descriptor() ([]byte, []int) {
-	return file_proto_schema_schema_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_proto_schema_schema_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *AuditLog) GetId() int64 {
+func (x *Notification) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *AuditLog) GetActorId() int64 {
+func (x *Notification) GetType() string {
 	if x != nil {
-		return x.ActorId
+		return x.Type
 	}
-	return 0
+	return ""
 }
 
-func (x *AuditLog) GetActorType() string {
+func (x *Notification) GetTitle() string {
 	if x != nil {
-		return x.ActorType
+		return x.Title
 	}
 	return ""
 }
 
-func (x *AuditLog) GetAction() string {
+func (x *Notification) GetBody() string {
 	if x != nil {
-		return x.Action
+		return x.Body
 	}
 	return ""
 }
 
-func (x *AuditLog) GetTargetType() string {
+func (x *Notification) GetDeepLink() string {
 	if x != nil {
-		return x.TargetType
+		return x.DeepLink
 	}
 	return ""
 }
 
-func (x *AuditLog) GetTargetId() int64 {
+func (x *Notification) GetRead() bool {
 	if x != nil {
-		return x.TargetId
+		return x.Read
+	}
+	return false
+}
+
+func (x *Notification) GetReadAt() int64 {
+	if x != nil {
+		return x.ReadAt
 	}
 	return 0
 }
 
-func (x *AuditLog) GetMetadata() string {
+func (x *Notification) GetCreatedAt() int64 {
 	if x != nil {
-		return x.Metadata
+		return x.CreatedAt
 	}
-	return ""
+	return 0
 }
 
-func (x *AuditLog) GetIpAddress() string {
+type NotificationPreference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"` // orders, wallet, offers, referrals, security
+	Push          bool                   `protobuf:"varint,2,opt,name=push,proto3" json:"push,omitempty"`
+	Email         bool                   `protobuf:"varint,3,opt,name=email,proto3" json:"email,omitempty"`
+	InApp         bool                   `protobuf:"varint,4,opt,name=in_app,json=inApp,proto3" json:"in_app,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationPreference) Reset() {
+	*x = NotificationPreference{}
+	mi := &file_proto_schema_schema_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationPreference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPreference) ProtoMessage() {}
+
+func (x *NotificationPreference) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_schema_schema_proto_msgTypes[27]
 	if x != nil {
-		return x.IpAddress
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AuditLog) GetUserAgent() string {
+// Deprecated: Use NotificationPreference.ProtoReflect.Descriptor instead.
+func (*NotificationPreference) Descriptor() ([]byte, []int) {
+	return file_proto_schema_schema_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *NotificationPreference) GetCategory() string {
 	if x != nil {
-		return x.UserAgent
+		return x.Category
 	}
 	return ""
 }
 
-func (x *AuditLog) GetCreatedAt() int64 {
+func (x *NotificationPreference) GetPush() bool {
 	if x != nil {
-		return x.CreatedAt
+		return x.Push
 	}
-	return 0
+	return false
+}
+
+func (x *NotificationPreference) GetEmail() bool {
+	if x != nil {
+		return x.Email
+	}
+	return false
+}
+
+func (x *NotificationPreference) GetInApp() bool {
+	if x != nil {
+		return x.InApp
+	}
+	return false
 }
 
 var File_proto_schema_schema_proto protoreflect.FileDescriptor
 
 const file_proto_schema_schema_proto_rawDesc = "" +
 	"\n" +
-	"\x19proto/schema/schema.proto\x12\x0frival.schema.v1\"\x95\x03\n" +
+	"\x19proto/schema/schema.proto\x12\x0frival.schema.v1\"\xbc\x03\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12#\n" +
@@ -1404,7 +3306,8 @@ const file_proto_schema_schema_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\f \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\r \x01(\x03R\tupdatedAt\"\x80\x02\n" +
+	"updated_at\x18\r \x01(\x03R\tupdatedAt\x12%\n" +
+	"\x0ephone_verified\x18\x0e \x01(\bR\rphoneVerified\"\x80\x02\n" +
 	"\x0eReferralReward\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
 	"\vreferrer_id\x18\x02 \x01(\x03R\n" +
@@ -1418,7 +3321,7 @@ const file_proto_schema_schema_proto_rawDesc = "" +
 	"\vcredited_at\x18\a \x01(\x03R\n" +
 	"creditedAt\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\b \x01(\x03R\tcreatedAt\"\xa7\x02\n" +
+	"created_at\x18\b \x01(\x03R\tcreatedAt\"\xc5\x03\n" +
 	"\bMerchant\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
@@ -1432,7 +3335,57 @@ const file_proto_schema_schema_proto_rawDesc = "" +
 	"created_at\x18\t \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
 	"updated_at\x18\n" +
-	" \x01(\x03R\tupdatedAt\"\xd6\x02\n" +
+	" \x01(\x03R\tupdatedAt\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\x12\x1a\n" +
+	"\btimezone\x18\f \x01(\tR\btimezone\x12\x1e\n" +
+	"\vis_open_now\x18\r \x01(\bR\tisOpenNow\x12%\n" +
+	"\x0erating_average\x18\x0e \x01(\x01R\rratingAverage\x12!\n" +
+	"\frating_count\x18\x0f \x01(\x05R\vratingCount\"o\n" +
+	"\x15BusinessHoursInterval\x12\x1e\n" +
+	"\vday_of_week\x18\x01 \x01(\x05R\tdayOfWeek\x12\x19\n" +
+	"\bopens_at\x18\x02 \x01(\tR\aopensAt\x12\x1b\n" +
+	"\tcloses_at\x18\x03 \x01(\tR\bclosesAt\"\x90\x01\n" +
+	"\x0fMerchantClosure\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vmerchant_id\x18\x02 \x01(\x03R\n" +
+	"merchantId\x12\x1b\n" +
+	"\tstarts_at\x18\x03 \x01(\x03R\bstartsAt\x12\x17\n" +
+	"\aends_at\x18\x04 \x01(\x03R\x06endsAt\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\xf6\x01\n" +
+	"\x14MerchantStatusChange\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vmerchant_id\x18\x02 \x01(\x03R\n" +
+	"merchantId\x12\x1f\n" +
+	"\vfrom_status\x18\x03 \x01(\tR\n" +
+	"fromStatus\x12\x1b\n" +
+	"\tto_status\x18\x04 \x01(\tR\btoStatus\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x19\n" +
+	"\bactor_id\x18\x06 \x01(\x03R\aactorId\x12\x1d\n" +
+	"\n" +
+	"actor_type\x18\a \x01(\tR\tactorType\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\x03R\tcreatedAt\"\xa7\x03\n" +
+	"\x10MerchantDocument\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vmerchant_id\x18\x02 \x01(\x03R\n" +
+	"merchantId\x12\x19\n" +
+	"\bdoc_type\x18\x03 \x01(\tR\adocType\x12'\n" +
+	"\x0fdocument_number\x18\x04 \x01(\tR\x0edocumentNumber\x12!\n" +
+	"\fcontent_type\x18\x05 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x06 \x01(\x03R\tsizeBytes\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12)\n" +
+	"\x10rejection_reason\x18\b \x01(\tR\x0frejectionReason\x12\x1f\n" +
+	"\vreviewed_by\x18\t \x01(\x03R\n" +
+	"reviewedBy\x12\x1f\n" +
+	"\vreviewed_at\x18\n" +
+	" \x01(\x03R\n" +
+	"reviewedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\v \x01(\x03R\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\f \x01(\x03R\tcreatedAt\x12\x19\n" +
+	"\bview_url\x18\r \x01(\tR\aviewUrl\"\xec\x02\n" +
 	"\x0fMerchantAddress\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
 	"\vmerchant_id\x18\x02 \x01(\x03R\n" +
@@ -1451,7 +3404,8 @@ const file_proto_schema_schema_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\v \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\f \x01(\x03R\tupdatedAt\"\xf3\x01\n" +
+	"updated_at\x18\f \x01(\x03R\tupdatedAt\x12\x14\n" +
+	"\x05label\x18\r \x01(\tR\x05label\"\xf3\x01\n" +
 	"\fCoinPurchase\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x16\n" +
@@ -1506,7 +3460,7 @@ const file_proto_schema_schema_proto_rawDesc = "" +
 	"\apaid_at\x18\t \x01(\x03R\x06paidAt\x12\x1d\n" +
 	"\n" +
 	"created_at\x18\n" +
-	" \x01(\x03R\tcreatedAt\"\xfe\x02\n" +
+	" \x01(\x03R\tcreatedAt\"\x8b\x04\n" +
 	"\x05Offer\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
 	"\vmerchant_id\x18\x02 \x01(\x03R\n" +
@@ -1526,7 +3480,11 @@ const file_proto_schema_schema_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\v \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\f \x01(\x03R\tupdatedAt\"\x98\x03\n" +
+	"updated_at\x18\f \x01(\x03R\tupdatedAt\x12\x1f\n" +
+	"\vdistance_km\x18\r \x01(\x01R\n" +
+	"distanceKm\x126\n" +
+	"\x17merchant_rating_average\x18\x0e \x01(\x01R\x15merchantRatingAverage\x122\n" +
+	"\x15merchant_rating_count\x18\x0f \x01(\x05R\x13merchantRatingCount\"\xdf\x05\n" +
 	"\x05Order\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
 	"\vmerchant_id\x18\x02 \x01(\x03R\n" +
@@ -1546,7 +3504,19 @@ const file_proto_schema_schema_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\r \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x0e \x01(\x03R\tupdatedAt\"\xa3\x02\n" +
+	"updated_at\x18\x0e \x01(\x03R\tupdatedAt\x12=\n" +
+	"\n" +
+	"line_items\x18\x0f \x03(\v2\x1e.rival.schema.v1.OrderLineItemR\tlineItems\x12#\n" +
+	"\rstatus_reason\x18\x10 \x01(\tR\fstatusReason\x12\x1f\n" +
+	"\vaccepted_at\x18\x11 \x01(\x03R\n" +
+	"acceptedAt\x12!\n" +
+	"\fpreparing_at\x18\x12 \x01(\x03R\vpreparingAt\x12\x19\n" +
+	"\bready_at\x18\x13 \x01(\x03R\areadyAt\x12!\n" +
+	"\fcompleted_at\x18\x14 \x01(\x03R\vcompletedAt\x12!\n" +
+	"\fcancelled_at\x18\x15 \x01(\x03R\vcancelledAt\x12\x1f\n" +
+	"\vrejected_at\x18\x16 \x01(\x03R\n" +
+	"rejectedAt\x12\x1b\n" +
+	"\toutlet_id\x18\x17 \x01(\x03R\boutletId\"\xa3\x02\n" +
 	"\bAuditLog\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
 	"\bactor_id\x18\x02 \x01(\x03R\aactorId\x12\x1d\n" +
@@ -1563,7 +3533,178 @@ const file_proto_schema_schema_proto_rawDesc = "" +
 	"user_agent\x18\t \x01(\tR\tuserAgent\x12\x1d\n" +
 	"\n" +
 	"created_at\x18\n" +
-	" \x01(\x03R\tcreatedAt*j\n" +
+	" \x01(\x03R\tcreatedAt\"\xc7\x02\n" +
+	"\x0eMerchantApiKey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vmerchant_id\x18\x02 \x01(\x03R\n" +
+	"merchantId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06prefix\x18\x04 \x01(\tR\x06prefix\x12\x16\n" +
+	"\x06scopes\x18\x05 \x03(\tR\x06scopes\x12\x1f\n" +
+	"\vallowed_ips\x18\x06 \x03(\tR\n" +
+	"allowedIps\x12 \n" +
+	"\flast_used_at\x18\a \x01(\x03R\n" +
+	"lastUsedAt\x12 \n" +
+	"\flast_used_ip\x18\b \x01(\tR\n" +
+	"lastUsedIp\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\t \x01(\x03R\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"revoked_at\x18\n" +
+	" \x01(\x03R\trevokedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\x03R\tcreatedAt\"\xaf\x02\n" +
+	"\vUserSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tdevice_id\x18\x02 \x01(\tR\bdeviceId\x12\x1f\n" +
+	"\vdevice_name\x18\x03 \x01(\tR\n" +
+	"deviceName\x12\x1a\n" +
+	"\bplatform\x18\x04 \x01(\tR\bplatform\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x05 \x01(\tR\tuserAgent\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x06 \x01(\tR\tipAddress\x12\x18\n" +
+	"\acurrent\x18\a \x01(\bR\acurrent\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\x03R\tcreatedAt\x12 \n" +
+	"\flast_seen_at\x18\t \x01(\x03R\n" +
+	"lastSeenAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\n" +
+	" \x01(\x03R\texpiresAt\"\xed\x01\n" +
+	"\fUserIdentity\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\bprovider\x18\x03 \x01(\tR\bprovider\x12\x18\n" +
+	"\asubject\x18\x04 \x01(\tR\asubject\x12\x14\n" +
+	"\x05email\x18\x05 \x01(\tR\x05email\x12%\n" +
+	"\x0eemail_verified\x18\x06 \x01(\bR\remailVerified\x12\"\n" +
+	"\rlast_login_at\x18\a \x01(\x03R\vlastLoginAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\x03R\tcreatedAt\"u\n" +
+	"\x0fCatalogCategory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vmerchant_id\x18\x02 \x01(\x03R\n" +
+	"merchantId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\x04 \x01(\x05R\tsortOrder\"\xc3\x01\n" +
+	"\rCatalogOption\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\x03R\x06itemId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1f\n" +
+	"\vprice_delta\x18\x05 \x01(\x01R\n" +
+	"priceDelta\x12!\n" +
+	"\fis_available\x18\x06 \x01(\bR\visAvailable\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\a \x01(\x05R\tsortOrder\"\x82\x03\n" +
+	"\vCatalogItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vmerchant_id\x18\x02 \x01(\x03R\n" +
+	"merchantId\x12\x1f\n" +
+	"\vcategory_id\x18\x03 \x01(\x03R\n" +
+	"categoryId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05price\x18\x06 \x01(\x01R\x05price\x12!\n" +
+	"\fis_available\x18\a \x01(\bR\visAvailable\x12\x1b\n" +
+	"\timage_url\x18\b \x01(\tR\bimageUrl\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\t \x01(\x05R\tsortOrder\x128\n" +
+	"\aoptions\x18\n" +
+	" \x03(\v2\x1e.rival.schema.v1.CatalogOptionR\aoptions\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\x03R\tupdatedAt\"\xcf\x01\n" +
+	"\rOrderLineItem\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\x03R\x06itemId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x02 \x01(\x03R\tvariantId\x12\x1b\n" +
+	"\taddon_ids\x18\x03 \x03(\x03R\baddonIds\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12\x1d\n" +
+	"\n" +
+	"unit_price\x18\x05 \x01(\x01R\tunitPrice\x12\x12\n" +
+	"\x04name\x18\x06 \x01(\tR\x04name\x12\x1a\n" +
+	"\bsubtotal\x18\a \x01(\x01R\bsubtotal\"\xfa\x01\n" +
+	"\rMerchantStaff\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vmerchant_id\x18\x02 \x01(\x03R\n" +
+	"merchantId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x05 \x01(\tR\x05email\x12\x12\n" +
+	"\x04role\x18\x06 \x01(\tR\x04role\x12\x1d\n" +
+	"\n" +
+	"outlet_ids\x18\a \x03(\x03R\toutletIds\x12#\n" +
+	"\rmerchant_name\x18\b \x01(\tR\fmerchantName\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\x03R\tcreatedAt\"\xc9\x01\n" +
+	"\x0fStaffInvitation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vmerchant_id\x18\x02 \x01(\x03R\n" +
+	"merchantId\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12\x1d\n" +
+	"\n" +
+	"outlet_ids\x18\x05 \x03(\x03R\toutletIds\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\x03R\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03R\tcreatedAt\"\xf2\x02\n" +
+	"\aReceipt\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vmerchant_id\x18\x02 \x01(\x03R\n" +
+	"merchantId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x19\n" +
+	"\border_id\x18\x04 \x01(\x03R\aorderId\x12%\n" +
+	"\x0etransaction_id\x18\x05 \x01(\x03R\rtransactionId\x12%\n" +
+	"\x0einvoice_number\x18\x06 \x01(\tR\rinvoiceNumber\x12%\n" +
+	"\x0efinancial_year\x18\a \x01(\tR\rfinancialYear\x12\x14\n" +
+	"\x05gstin\x18\b \x01(\tR\x05gstin\x12\x19\n" +
+	"\bhtml_url\x18\t \x01(\tR\ahtmlUrl\x12\x17\n" +
+	"\apdf_url\x18\n" +
+	" \x01(\tR\x06pdfUrl\x12$\n" +
+	"\x0eurls_expire_at\x18\v \x01(\x03R\furlsExpireAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\f \x01(\x03R\tcreatedAt\"\x8d\x03\n" +
+	"\x06Review\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vmerchant_id\x18\x02 \x01(\x03R\n" +
+	"merchantId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x19\n" +
+	"\border_id\x18\x04 \x01(\x03R\aorderId\x12\x16\n" +
+	"\x06rating\x18\x05 \x01(\x05R\x06rating\x12\x12\n" +
+	"\x04body\x18\x06 \x01(\tR\x04body\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"flag_count\x18\b \x01(\x05R\tflagCount\x12+\n" +
+	"\x11moderation_reason\x18\t \x01(\tR\x10moderationReason\x12\x14\n" +
+	"\x05reply\x18\n" +
+	" \x01(\tR\x05reply\x12\x1d\n" +
+	"\n" +
+	"replied_at\x18\v \x01(\x03R\trepliedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\f \x01(\x03R\tcreatedAt\x12\x1b\n" +
+	"\tuser_name\x18\r \x01(\tR\buserName\x12\x1d\n" +
+	"\n" +
+	"photo_urls\x18\x0e \x03(\tR\tphotoUrls\"\xc5\x01\n" +
+	"\fNotification\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\x12\x1b\n" +
+	"\tdeep_link\x18\x05 \x01(\tR\bdeepLink\x12\x12\n" +
+	"\x04read\x18\x06 \x01(\bR\x04read\x12\x17\n" +
+	"\aread_at\x18\a \x01(\x03R\x06readAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\x03R\tcreatedAt\"u\n" +
+	"\x16NotificationPreference\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x12\n" +
+	"\x04push\x18\x02 \x01(\bR\x04push\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\bR\x05email\x12\x15\n" +
+	"\x06in_app\x18\x04 \x01(\bR\x05inApp*j\n" +
 	"\bUserRole\x12\x19\n" +
 	"\x15USER_ROLE_UNSPECIFIED\x10\x00\x12\x16\n" +
 	"\x12USER_ROLE_CUSTOMER\x10\x01\x12\x16\n" +
@@ -1583,28 +3724,47 @@ func file_proto_schema_schema_proto_rawDescGZIP() []byte {
 }
 
 var file_proto_schema_schema_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_schema_schema_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_proto_schema_schema_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_proto_schema_schema_proto_goTypes = []any{
-	(UserRole)(0),           // 0: rival.schema.v1.UserRole
-	(*User)(nil),            // 1: rival.schema.v1.User
-	(*ReferralReward)(nil),  // 2: rival.schema.v1.ReferralReward
-	(*Merchant)(nil),        // 3: rival.schema.v1.Merchant
-	(*MerchantAddress)(nil), // 4: rival.schema.v1.MerchantAddress
-	(*CoinPurchase)(nil),    // 5: rival.schema.v1.CoinPurchase
-	(*JwtSession)(nil),      // 6: rival.schema.v1.JwtSession
-	(*Transaction)(nil),     // 7: rival.schema.v1.Transaction
-	(*Settlement)(nil),      // 8: rival.schema.v1.Settlement
-	(*Offer)(nil),           // 9: rival.schema.v1.Offer
-	(*Order)(nil),           // 10: rival.schema.v1.Order
-	(*AuditLog)(nil),        // 11: rival.schema.v1.AuditLog
+	(UserRole)(0),                  // 0: rival.schema.v1.UserRole
+	(*User)(nil),                   // 1: rival.schema.v1.User
+	(*ReferralReward)(nil),         // 2: rival.schema.v1.ReferralReward
+	(*Merchant)(nil),               // 3: rival.schema.v1.Merchant
+	(*BusinessHoursInterval)(nil),  // 4: rival.schema.v1.BusinessHoursInterval
+	(*MerchantClosure)(nil),        // 5: rival.schema.v1.MerchantClosure
+	(*MerchantStatusChange)(nil),   // 6: rival.schema.v1.MerchantStatusChange
+	(*MerchantDocument)(nil),       // 7: rival.schema.v1.MerchantDocument
+	(*MerchantAddress)(nil),        // 8: rival.schema.v1.MerchantAddress
+	(*CoinPurchase)(nil),           // 9: rival.schema.v1.CoinPurchase
+	(*JwtSession)(nil),             // 10: rival.schema.v1.JwtSession
+	(*Transaction)(nil),            // 11: rival.schema.v1.Transaction
+	(*Settlement)(nil),             // 12: rival.schema.v1.Settlement
+	(*Offer)(nil),                  // 13: rival.schema.v1.Offer
+	(*Order)(nil),                  // 14: rival.schema.v1.Order
+	(*AuditLog)(nil),               // 15: rival.schema.v1.AuditLog
+	(*MerchantApiKey)(nil),         // 16: rival.schema.v1.MerchantApiKey
+	(*UserSession)(nil),            // 17: rival.schema.v1.UserSession
+	(*UserIdentity)(nil),           // 18: rival.schema.v1.UserIdentity
+	(*CatalogCategory)(nil),        // 19: rival.schema.v1.CatalogCategory
+	(*CatalogOption)(nil),          // 20: rival.schema.v1.CatalogOption
+	(*CatalogItem)(nil),            // 21: rival.schema.v1.CatalogItem
+	(*OrderLineItem)(nil),          // 22: rival.schema.v1.OrderLineItem
+	(*MerchantStaff)(nil),          // 23: rival.schema.v1.MerchantStaff
+	(*StaffInvitation)(nil),        // 24: rival.schema.v1.StaffInvitation
+	(*Receipt)(nil),                // 25: rival.schema.v1.Receipt
+	(*Review)(nil),                 // 26: rival.schema.v1.Review
+	(*Notification)(nil),           // 27: rival.schema.v1.Notification
+	(*NotificationPreference)(nil), // 28: rival.schema.v1.NotificationPreference
 }
 var file_proto_schema_schema_proto_depIdxs = []int32{
-	0, // 0: rival.schema.v1.User.role:type_name -> rival.schema.v1.UserRole
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	0,  // 0: rival.schema.v1.User.role:type_name -> rival.schema.v1.UserRole
+	22, // 1: rival.schema.v1.Order.line_items:type_name -> rival.schema.v1.OrderLineItem
+	20, // 2: rival.schema.v1.CatalogItem.options:type_name -> rival.schema.v1.CatalogOption
+	3,  // [3:3] is the sub-list for method output_type
+	3,  // [3:3] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_proto_schema_schema_proto_init() }
@@ -1618,7 +3778,7 @@ func file_proto_schema_schema_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_schema_schema_proto_rawDesc), len(file_proto_schema_schema_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   11,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
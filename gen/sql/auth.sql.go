@@ -17,9 +17,15 @@ INSERT INTO
         user_id,
         token_hash,
         refresh_token_hash,
-        expires_at
+        expires_at,
+        session_id,
+        device_id,
+        device_name,
+        platform,
+        user_agent,
+        ip_address
     )
-VALUES ($1, $2, $3, $4)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 `
 
 type CreateJWTSessionParams struct {
@@ -27,6 +33,12 @@ type CreateJWTSessionParams struct {
 	TokenHash        string           `json:"token_hash"`
 	RefreshTokenHash pgtype.Text      `json:"refresh_token_hash"`
 	ExpiresAt        pgtype.Timestamp `json:"expires_at"`
+	SessionID        pgtype.Text      `json:"session_id"`
+	DeviceID         pgtype.Text      `json:"device_id"`
+	DeviceName       pgtype.Text      `json:"device_name"`
+	Platform         pgtype.Text      `json:"platform"`
+	UserAgent        pgtype.Text      `json:"user_agent"`
+	IpAddress        pgtype.Text      `json:"ip_address"`
 }
 
 func (q *Queries) CreateJWTSession(ctx context.Context, arg CreateJWTSessionParams) error {
@@ -35,6 +47,12 @@ func (q *Queries) CreateJWTSession(ctx context.Context, arg CreateJWTSessionPara
 		arg.TokenHash,
 		arg.RefreshTokenHash,
 		arg.ExpiresAt,
+		arg.SessionID,
+		arg.DeviceID,
+		arg.DeviceName,
+		arg.Platform,
+		arg.UserAgent,
+		arg.IpAddress,
 	)
 	return err
 }
@@ -66,7 +84,7 @@ VALUES (
         $10
     )
 RETURNING
-    id, email, password_hash, phone, name, profile_pic, firebase_uid, coin_balance, role, referral_code, referred_by, created_at, updated_at
+    id, email, password_hash, phone, name, profile_pic, firebase_uid, coin_balance, role, referral_code, referred_by, created_at, updated_at, phone_verified_at, email_verified_at
 `
 
 type CreateUserParams struct {
@@ -77,7 +95,7 @@ type CreateUserParams struct {
 	ProfilePic   pgtype.Text    `json:"profile_pic"`
 	FirebaseUid  pgtype.Text    `json:"firebase_uid"`
 	CoinBalance  pgtype.Numeric `json:"coin_balance"`
-	Role         string    `json:"role"`
+	Role         string         `json:"role"`
 	ReferralCode pgtype.Text    `json:"referral_code"`
 	ReferredBy   pgtype.Int8    `json:"referred_by"`
 }
@@ -110,12 +128,14 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		&i.ReferredBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.PhoneVerifiedAt,
+		&i.EmailVerifiedAt,
 	)
 	return i, err
 }
 
 const getJWTSession = `-- name: GetJWTSession :one
-SELECT id, user_id, token_hash, refresh_token_hash, expires_at, is_revoked, created_at
+SELECT id, user_id, token_hash, refresh_token_hash, expires_at, is_revoked, created_at, session_id, device_id, device_name, platform, user_agent, ip_address, last_seen_at
 FROM jwt_sessions
 WHERE
     token_hash = $1
@@ -133,12 +153,49 @@ func (q *Queries) GetJWTSession(ctx context.Context, tokenHash string) (JwtSessi
 		&i.ExpiresAt,
 		&i.IsRevoked,
 		&i.CreatedAt,
+		&i.SessionID,
+		&i.DeviceID,
+		&i.DeviceName,
+		&i.Platform,
+		&i.UserAgent,
+		&i.IpAddress,
+		&i.LastSeenAt,
+	)
+	return i, err
+}
+
+const getJWTSessionBySessionID = `-- name: GetJWTSessionBySessionID :one
+SELECT id, user_id, token_hash, refresh_token_hash, expires_at, is_revoked, created_at, session_id, device_id, device_name, platform, user_agent, ip_address, last_seen_at
+FROM jwt_sessions
+WHERE
+    session_id = $1
+    AND is_revoked = false
+`
+
+func (q *Queries) GetJWTSessionBySessionID(ctx context.Context, sessionID pgtype.Text) (JwtSession, error) {
+	row := q.db.QueryRow(ctx, getJWTSessionBySessionID, sessionID)
+	var i JwtSession
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TokenHash,
+		&i.RefreshTokenHash,
+		&i.ExpiresAt,
+		&i.IsRevoked,
+		&i.CreatedAt,
+		&i.SessionID,
+		&i.DeviceID,
+		&i.DeviceName,
+		&i.Platform,
+		&i.UserAgent,
+		&i.IpAddress,
+		&i.LastSeenAt,
 	)
 	return i, err
 }
 
 const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, email, password_hash, phone, name, profile_pic, firebase_uid, coin_balance, role, referral_code, referred_by, created_at, updated_at FROM users WHERE email = $1
+SELECT id, email, password_hash, phone, name, profile_pic, firebase_uid, coin_balance, role, referral_code, referred_by, created_at, updated_at, phone_verified_at, email_verified_at FROM users WHERE email = $1
 `
 
 func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error) {
@@ -158,12 +215,14 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error
 		&i.ReferredBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.PhoneVerifiedAt,
+		&i.EmailVerifiedAt,
 	)
 	return i, err
 }
 
 const getUserByID = `-- name: GetUserByID :one
-SELECT id, email, password_hash, phone, name, profile_pic, firebase_uid, coin_balance, role, referral_code, referred_by, created_at, updated_at FROM users WHERE id = $1
+SELECT id, email, password_hash, phone, name, profile_pic, firebase_uid, coin_balance, role, referral_code, referred_by, created_at, updated_at, phone_verified_at, email_verified_at FROM users WHERE id = $1
 `
 
 func (q *Queries) GetUserByID(ctx context.Context, id int64) (User, error) {
@@ -183,12 +242,14 @@ func (q *Queries) GetUserByID(ctx context.Context, id int64) (User, error) {
 		&i.ReferredBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.PhoneVerifiedAt,
+		&i.EmailVerifiedAt,
 	)
 	return i, err
 }
 
 const getUserByReferralCode = `-- name: GetUserByReferralCode :one
-SELECT id, email, password_hash, phone, name, profile_pic, firebase_uid, coin_balance, role, referral_code, referred_by, created_at, updated_at FROM users WHERE referral_code = $1
+SELECT id, email, password_hash, phone, name, profile_pic, firebase_uid, coin_balance, role, referral_code, referred_by, created_at, updated_at, phone_verified_at, email_verified_at FROM users WHERE referral_code = $1
 `
 
 func (q *Queries) GetUserByReferralCode(ctx context.Context, referralCode pgtype.Text) (User, error) {
@@ -208,10 +269,144 @@ func (q *Queries) GetUserByReferralCode(ctx context.Context, referralCode pgtype
 		&i.ReferredBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.PhoneVerifiedAt,
+		&i.EmailVerifiedAt,
 	)
 	return i, err
 }
 
+const getUserByVerifiedPhone = `-- name: GetUserByVerifiedPhone :one
+SELECT id, email, password_hash, phone, name, profile_pic, firebase_uid, coin_balance, role, referral_code, referred_by, created_at, updated_at, phone_verified_at, email_verified_at FROM users WHERE phone = $1 AND phone_verified_at IS NOT NULL
+`
+
+func (q *Queries) GetUserByVerifiedPhone(ctx context.Context, phone pgtype.Text) (User, error) {
+	row := q.db.QueryRow(ctx, getUserByVerifiedPhone, phone)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.Phone,
+		&i.Name,
+		&i.ProfilePic,
+		&i.FirebaseUid,
+		&i.CoinBalance,
+		&i.Role,
+		&i.ReferralCode,
+		&i.ReferredBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.PhoneVerifiedAt,
+		&i.EmailVerifiedAt,
+	)
+	return i, err
+}
+
+const getUserDeviceStats = `-- name: GetUserDeviceStats :one
+SELECT
+    COUNT(*) AS total_sessions,
+    COUNT(*) FILTER (WHERE device_id = $2) AS device_sessions
+FROM jwt_sessions
+WHERE
+    user_id = $1
+`
+
+type GetUserDeviceStatsParams struct {
+	UserID   pgtype.Int8 `json:"user_id"`
+	DeviceID pgtype.Text `json:"device_id"`
+}
+
+type GetUserDeviceStatsRow struct {
+	TotalSessions  int64 `json:"total_sessions"`
+	DeviceSessions int64 `json:"device_sessions"`
+}
+
+func (q *Queries) GetUserDeviceStats(ctx context.Context, arg GetUserDeviceStatsParams) (GetUserDeviceStatsRow, error) {
+	row := q.db.QueryRow(ctx, getUserDeviceStats, arg.UserID, arg.DeviceID)
+	var i GetUserDeviceStatsRow
+	err := row.Scan(&i.TotalSessions, &i.DeviceSessions)
+	return i, err
+}
+
+const listUserSessions = `-- name: ListUserSessions :many
+SELECT id, user_id, token_hash, refresh_token_hash, expires_at, is_revoked, created_at, session_id, device_id, device_name, platform, user_agent, ip_address, last_seen_at
+FROM jwt_sessions
+WHERE
+    user_id = $1
+    AND is_revoked = false
+    AND expires_at > NOW()
+ORDER BY last_seen_at DESC
+`
+
+func (q *Queries) ListUserSessions(ctx context.Context, userID pgtype.Int8) ([]JwtSession, error) {
+	rows, err := q.db.Query(ctx, listUserSessions, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []JwtSession
+	for rows.Next() {
+		var i JwtSession
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.TokenHash,
+			&i.RefreshTokenHash,
+			&i.ExpiresAt,
+			&i.IsRevoked,
+			&i.CreatedAt,
+			&i.SessionID,
+			&i.DeviceID,
+			&i.DeviceName,
+			&i.Platform,
+			&i.UserAgent,
+			&i.IpAddress,
+			&i.LastSeenAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markEmailVerified = `-- name: MarkEmailVerified :exec
+UPDATE users
+SET
+    email_verified_at = COALESCE(email_verified_at, NOW()),
+    updated_at = NOW()
+WHERE
+    id = $1
+`
+
+func (q *Queries) MarkEmailVerified(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, markEmailVerified, id)
+	return err
+}
+
+const markPhoneVerified = `-- name: MarkPhoneVerified :exec
+UPDATE users
+SET
+    phone = $2,
+    phone_verified_at = NOW(),
+    updated_at = NOW()
+WHERE
+    id = $1
+`
+
+type MarkPhoneVerifiedParams struct {
+	ID    int64       `json:"id"`
+	Phone pgtype.Text `json:"phone"`
+}
+
+func (q *Queries) MarkPhoneVerified(ctx context.Context, arg MarkPhoneVerifiedParams) error {
+	_, err := q.db.Exec(ctx, markPhoneVerified, arg.ID, arg.Phone)
+	return err
+}
+
 const revokeAllUserSessions = `-- name: RevokeAllUserSessions :exec
 UPDATE jwt_sessions SET is_revoked = true WHERE user_id = $1
 `
@@ -230,11 +425,71 @@ func (q *Queries) RevokeJWTSession(ctx context.Context, tokenHash string) error
 	return err
 }
 
+const revokeUserSession = `-- name: RevokeUserSession :one
+UPDATE jwt_sessions
+SET
+    is_revoked = true
+WHERE
+    id = $1
+    AND user_id = $2
+    AND is_revoked = false
+RETURNING
+    id, user_id, token_hash, refresh_token_hash, expires_at, is_revoked, created_at, session_id, device_id, device_name, platform, user_agent, ip_address, last_seen_at
+`
+
+type RevokeUserSessionParams struct {
+	ID     int64       `json:"id"`
+	UserID pgtype.Int8 `json:"user_id"`
+}
+
+func (q *Queries) RevokeUserSession(ctx context.Context, arg RevokeUserSessionParams) (JwtSession, error) {
+	row := q.db.QueryRow(ctx, revokeUserSession, arg.ID, arg.UserID)
+	var i JwtSession
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TokenHash,
+		&i.RefreshTokenHash,
+		&i.ExpiresAt,
+		&i.IsRevoked,
+		&i.CreatedAt,
+		&i.SessionID,
+		&i.DeviceID,
+		&i.DeviceName,
+		&i.Platform,
+		&i.UserAgent,
+		&i.IpAddress,
+		&i.LastSeenAt,
+	)
+	return i, err
+}
+
+const touchJWTSession = `-- name: TouchJWTSession :exec
+UPDATE jwt_sessions
+SET
+    last_seen_at = NOW(),
+    expires_at = $2
+WHERE
+    session_id = $1
+`
+
+type TouchJWTSessionParams struct {
+	SessionID pgtype.Text      `json:"session_id"`
+	ExpiresAt pgtype.Timestamp `json:"expires_at"`
+}
+
+func (q *Queries) TouchJWTSession(ctx context.Context, arg TouchJWTSessionParams) error {
+	_, err := q.db.Exec(ctx, touchJWTSession, arg.SessionID, arg.ExpiresAt)
+	return err
+}
+
 const updateUser = `-- name: UpdateUser :exec
 UPDATE users
 SET
     name = $2,
     phone = $3,
+    -- A new number has to be verified again
+    phone_verified_at = CASE WHEN phone IS DISTINCT FROM $3 THEN NULL ELSE phone_verified_at END,
     profile_pic = $4,
     updated_at = NOW()
 WHERE
@@ -0,0 +1,567 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: catalog.sql
+
+package schema
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countCatalogItems = `-- name: CountCatalogItems :one
+SELECT COUNT(*) FROM catalog_items WHERE merchant_id = $1
+`
+
+func (q *Queries) CountCatalogItems(ctx context.Context, merchantID int64) (int64, error) {
+	row := q.db.QueryRow(ctx, countCatalogItems, merchantID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createCatalogCategory = `-- name: CreateCatalogCategory :one
+INSERT INTO catalog_categories (
+    merchant_id, name, sort_order
+) VALUES (
+    $1, $2, $3
+) RETURNING id, merchant_id, name, sort_order, created_at, updated_at
+`
+
+type CreateCatalogCategoryParams struct {
+	MerchantID int64  `json:"merchant_id"`
+	Name       string `json:"name"`
+	SortOrder  int32  `json:"sort_order"`
+}
+
+func (q *Queries) CreateCatalogCategory(ctx context.Context, arg CreateCatalogCategoryParams) (CatalogCategory, error) {
+	row := q.db.QueryRow(ctx, createCatalogCategory, arg.MerchantID, arg.Name, arg.SortOrder)
+	var i CatalogCategory
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.Name,
+		&i.SortOrder,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createCatalogItem = `-- name: CreateCatalogItem :one
+INSERT INTO catalog_items (
+    merchant_id, category_id, name, description, price, sort_order
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING id, merchant_id, category_id, name, description, price, is_available, image_key, sort_order, created_at, updated_at
+`
+
+type CreateCatalogItemParams struct {
+	MerchantID  int64          `json:"merchant_id"`
+	CategoryID  pgtype.Int8    `json:"category_id"`
+	Name        string         `json:"name"`
+	Description pgtype.Text    `json:"description"`
+	Price       pgtype.Numeric `json:"price"`
+	SortOrder   int32          `json:"sort_order"`
+}
+
+func (q *Queries) CreateCatalogItem(ctx context.Context, arg CreateCatalogItemParams) (CatalogItem, error) {
+	row := q.db.QueryRow(ctx, createCatalogItem,
+		arg.MerchantID,
+		arg.CategoryID,
+		arg.Name,
+		arg.Description,
+		arg.Price,
+		arg.SortOrder,
+	)
+	var i CatalogItem
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.CategoryID,
+		&i.Name,
+		&i.Description,
+		&i.Price,
+		&i.IsAvailable,
+		&i.ImageKey,
+		&i.SortOrder,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createCatalogOption = `-- name: CreateCatalogOption :one
+INSERT INTO catalog_options (
+    item_id, kind, name, price_delta, is_available, sort_order
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING id, item_id, kind, name, price_delta, is_available, sort_order
+`
+
+type CreateCatalogOptionParams struct {
+	ItemID      int64          `json:"item_id"`
+	Kind        string         `json:"kind"`
+	Name        string         `json:"name"`
+	PriceDelta  pgtype.Numeric `json:"price_delta"`
+	IsAvailable bool           `json:"is_available"`
+	SortOrder   int32          `json:"sort_order"`
+}
+
+func (q *Queries) CreateCatalogOption(ctx context.Context, arg CreateCatalogOptionParams) (CatalogOption, error) {
+	row := q.db.QueryRow(ctx, createCatalogOption,
+		arg.ItemID,
+		arg.Kind,
+		arg.Name,
+		arg.PriceDelta,
+		arg.IsAvailable,
+		arg.SortOrder,
+	)
+	var i CatalogOption
+	err := row.Scan(
+		&i.ID,
+		&i.ItemID,
+		&i.Kind,
+		&i.Name,
+		&i.PriceDelta,
+		&i.IsAvailable,
+		&i.SortOrder,
+	)
+	return i, err
+}
+
+const deleteCatalogCategory = `-- name: DeleteCatalogCategory :one
+DELETE FROM catalog_categories
+WHERE id = $1 AND merchant_id = $2
+RETURNING id, merchant_id, name, sort_order, created_at, updated_at
+`
+
+type DeleteCatalogCategoryParams struct {
+	ID         int64 `json:"id"`
+	MerchantID int64 `json:"merchant_id"`
+}
+
+func (q *Queries) DeleteCatalogCategory(ctx context.Context, arg DeleteCatalogCategoryParams) (CatalogCategory, error) {
+	row := q.db.QueryRow(ctx, deleteCatalogCategory, arg.ID, arg.MerchantID)
+	var i CatalogCategory
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.Name,
+		&i.SortOrder,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteCatalogItem = `-- name: DeleteCatalogItem :one
+DELETE FROM catalog_items
+WHERE id = $1 AND merchant_id = $2
+RETURNING id, merchant_id, category_id, name, description, price, is_available, image_key, sort_order, created_at, updated_at
+`
+
+type DeleteCatalogItemParams struct {
+	ID         int64 `json:"id"`
+	MerchantID int64 `json:"merchant_id"`
+}
+
+func (q *Queries) DeleteCatalogItem(ctx context.Context, arg DeleteCatalogItemParams) (CatalogItem, error) {
+	row := q.db.QueryRow(ctx, deleteCatalogItem, arg.ID, arg.MerchantID)
+	var i CatalogItem
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.CategoryID,
+		&i.Name,
+		&i.Description,
+		&i.Price,
+		&i.IsAvailable,
+		&i.ImageKey,
+		&i.SortOrder,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteCatalogOptions = `-- name: DeleteCatalogOptions :exec
+DELETE FROM catalog_options WHERE item_id = $1
+`
+
+func (q *Queries) DeleteCatalogOptions(ctx context.Context, itemID int64) error {
+	_, err := q.db.Exec(ctx, deleteCatalogOptions, itemID)
+	return err
+}
+
+const getCatalogCategory = `-- name: GetCatalogCategory :one
+SELECT id, merchant_id, name, sort_order, created_at, updated_at FROM catalog_categories
+WHERE id = $1 AND merchant_id = $2
+`
+
+type GetCatalogCategoryParams struct {
+	ID         int64 `json:"id"`
+	MerchantID int64 `json:"merchant_id"`
+}
+
+func (q *Queries) GetCatalogCategory(ctx context.Context, arg GetCatalogCategoryParams) (CatalogCategory, error) {
+	row := q.db.QueryRow(ctx, getCatalogCategory, arg.ID, arg.MerchantID)
+	var i CatalogCategory
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.Name,
+		&i.SortOrder,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getCatalogItem = `-- name: GetCatalogItem :one
+SELECT id, merchant_id, category_id, name, description, price, is_available, image_key, sort_order, created_at, updated_at FROM catalog_items
+WHERE id = $1 AND merchant_id = $2
+`
+
+type GetCatalogItemParams struct {
+	ID         int64 `json:"id"`
+	MerchantID int64 `json:"merchant_id"`
+}
+
+func (q *Queries) GetCatalogItem(ctx context.Context, arg GetCatalogItemParams) (CatalogItem, error) {
+	row := q.db.QueryRow(ctx, getCatalogItem, arg.ID, arg.MerchantID)
+	var i CatalogItem
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.CategoryID,
+		&i.Name,
+		&i.Description,
+		&i.Price,
+		&i.IsAvailable,
+		&i.ImageKey,
+		&i.SortOrder,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getCatalogItemsByIDs = `-- name: GetCatalogItemsByIDs :many
+SELECT id, merchant_id, category_id, name, description, price, is_available, image_key, sort_order, created_at, updated_at FROM catalog_items
+WHERE merchant_id = $1 AND id = ANY($2::bigint[])
+`
+
+type GetCatalogItemsByIDsParams struct {
+	MerchantID int64   `json:"merchant_id"`
+	Ids        []int64 `json:"ids"`
+}
+
+func (q *Queries) GetCatalogItemsByIDs(ctx context.Context, arg GetCatalogItemsByIDsParams) ([]CatalogItem, error) {
+	rows, err := q.db.Query(ctx, getCatalogItemsByIDs, arg.MerchantID, arg.Ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CatalogItem
+	for rows.Next() {
+		var i CatalogItem
+		if err := rows.Scan(
+			&i.ID,
+			&i.MerchantID,
+			&i.CategoryID,
+			&i.Name,
+			&i.Description,
+			&i.Price,
+			&i.IsAvailable,
+			&i.ImageKey,
+			&i.SortOrder,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCatalogCategories = `-- name: ListCatalogCategories :many
+SELECT id, merchant_id, name, sort_order, created_at, updated_at FROM catalog_categories
+WHERE merchant_id = $1
+ORDER BY sort_order, name
+`
+
+func (q *Queries) ListCatalogCategories(ctx context.Context, merchantID int64) ([]CatalogCategory, error) {
+	rows, err := q.db.Query(ctx, listCatalogCategories, merchantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CatalogCategory
+	for rows.Next() {
+		var i CatalogCategory
+		if err := rows.Scan(
+			&i.ID,
+			&i.MerchantID,
+			&i.Name,
+			&i.SortOrder,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCatalogItems = `-- name: ListCatalogItems :many
+SELECT id, merchant_id, category_id, name, description, price, is_available, image_key, sort_order, created_at, updated_at FROM catalog_items
+WHERE merchant_id = $1
+ORDER BY sort_order, name
+`
+
+func (q *Queries) ListCatalogItems(ctx context.Context, merchantID int64) ([]CatalogItem, error) {
+	rows, err := q.db.Query(ctx, listCatalogItems, merchantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CatalogItem
+	for rows.Next() {
+		var i CatalogItem
+		if err := rows.Scan(
+			&i.ID,
+			&i.MerchantID,
+			&i.CategoryID,
+			&i.Name,
+			&i.Description,
+			&i.Price,
+			&i.IsAvailable,
+			&i.ImageKey,
+			&i.SortOrder,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCatalogOptions = `-- name: ListCatalogOptions :many
+SELECT id, item_id, kind, name, price_delta, is_available, sort_order FROM catalog_options
+WHERE item_id = ANY($1::bigint[])
+ORDER BY item_id, sort_order, id
+`
+
+func (q *Queries) ListCatalogOptions(ctx context.Context, itemIds []int64) ([]CatalogOption, error) {
+	rows, err := q.db.Query(ctx, listCatalogOptions, itemIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CatalogOption
+	for rows.Next() {
+		var i CatalogOption
+		if err := rows.Scan(
+			&i.ID,
+			&i.ItemID,
+			&i.Kind,
+			&i.Name,
+			&i.PriceDelta,
+			&i.IsAvailable,
+			&i.SortOrder,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setCatalogItemAvailability = `-- name: SetCatalogItemAvailability :one
+UPDATE catalog_items
+SET is_available = $3, updated_at = NOW()
+WHERE id = $1 AND merchant_id = $2
+RETURNING id, merchant_id, category_id, name, description, price, is_available, image_key, sort_order, created_at, updated_at
+`
+
+type SetCatalogItemAvailabilityParams struct {
+	ID          int64 `json:"id"`
+	MerchantID  int64 `json:"merchant_id"`
+	IsAvailable bool  `json:"is_available"`
+}
+
+func (q *Queries) SetCatalogItemAvailability(ctx context.Context, arg SetCatalogItemAvailabilityParams) (CatalogItem, error) {
+	row := q.db.QueryRow(ctx, setCatalogItemAvailability, arg.ID, arg.MerchantID, arg.IsAvailable)
+	var i CatalogItem
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.CategoryID,
+		&i.Name,
+		&i.Description,
+		&i.Price,
+		&i.IsAvailable,
+		&i.ImageKey,
+		&i.SortOrder,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const setCatalogItemImage = `-- name: SetCatalogItemImage :one
+UPDATE catalog_items
+SET image_key = $3, updated_at = NOW()
+WHERE id = $1 AND merchant_id = $2
+RETURNING id, merchant_id, category_id, name, description, price, is_available, image_key, sort_order, created_at, updated_at
+`
+
+type SetCatalogItemImageParams struct {
+	ID         int64       `json:"id"`
+	MerchantID int64       `json:"merchant_id"`
+	ImageKey   pgtype.Text `json:"image_key"`
+}
+
+func (q *Queries) SetCatalogItemImage(ctx context.Context, arg SetCatalogItemImageParams) (CatalogItem, error) {
+	row := q.db.QueryRow(ctx, setCatalogItemImage, arg.ID, arg.MerchantID, arg.ImageKey)
+	var i CatalogItem
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.CategoryID,
+		&i.Name,
+		&i.Description,
+		&i.Price,
+		&i.IsAvailable,
+		&i.ImageKey,
+		&i.SortOrder,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const setCatalogOptionAvailability = `-- name: SetCatalogOptionAvailability :one
+UPDATE catalog_options
+SET is_available = $3
+WHERE catalog_options.id = $1
+  AND item_id IN (SELECT catalog_items.id FROM catalog_items WHERE catalog_items.merchant_id = $2)
+RETURNING id, item_id, kind, name, price_delta, is_available, sort_order
+`
+
+type SetCatalogOptionAvailabilityParams struct {
+	ID          int64 `json:"id"`
+	MerchantID  int64 `json:"merchant_id"`
+	IsAvailable bool  `json:"is_available"`
+}
+
+func (q *Queries) SetCatalogOptionAvailability(ctx context.Context, arg SetCatalogOptionAvailabilityParams) (CatalogOption, error) {
+	row := q.db.QueryRow(ctx, setCatalogOptionAvailability, arg.ID, arg.MerchantID, arg.IsAvailable)
+	var i CatalogOption
+	err := row.Scan(
+		&i.ID,
+		&i.ItemID,
+		&i.Kind,
+		&i.Name,
+		&i.PriceDelta,
+		&i.IsAvailable,
+		&i.SortOrder,
+	)
+	return i, err
+}
+
+const updateCatalogCategory = `-- name: UpdateCatalogCategory :one
+UPDATE catalog_categories
+SET name = $3, sort_order = $4, updated_at = NOW()
+WHERE id = $1 AND merchant_id = $2
+RETURNING id, merchant_id, name, sort_order, created_at, updated_at
+`
+
+type UpdateCatalogCategoryParams struct {
+	ID         int64  `json:"id"`
+	MerchantID int64  `json:"merchant_id"`
+	Name       string `json:"name"`
+	SortOrder  int32  `json:"sort_order"`
+}
+
+func (q *Queries) UpdateCatalogCategory(ctx context.Context, arg UpdateCatalogCategoryParams) (CatalogCategory, error) {
+	row := q.db.QueryRow(ctx, updateCatalogCategory,
+		arg.ID,
+		arg.MerchantID,
+		arg.Name,
+		arg.SortOrder,
+	)
+	var i CatalogCategory
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.Name,
+		&i.SortOrder,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateCatalogItem = `-- name: UpdateCatalogItem :one
+UPDATE catalog_items
+SET category_id = $3, name = $4, description = $5, price = $6, sort_order = $7, updated_at = NOW()
+WHERE id = $1 AND merchant_id = $2
+RETURNING id, merchant_id, category_id, name, description, price, is_available, image_key, sort_order, created_at, updated_at
+`
+
+type UpdateCatalogItemParams struct {
+	ID          int64          `json:"id"`
+	MerchantID  int64          `json:"merchant_id"`
+	CategoryID  pgtype.Int8    `json:"category_id"`
+	Name        string         `json:"name"`
+	Description pgtype.Text    `json:"description"`
+	Price       pgtype.Numeric `json:"price"`
+	SortOrder   int32          `json:"sort_order"`
+}
+
+func (q *Queries) UpdateCatalogItem(ctx context.Context, arg UpdateCatalogItemParams) (CatalogItem, error) {
+	row := q.db.QueryRow(ctx, updateCatalogItem,
+		arg.ID,
+		arg.MerchantID,
+		arg.CategoryID,
+		arg.Name,
+		arg.Description,
+		arg.Price,
+		arg.SortOrder,
+	)
+	var i CatalogItem
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.CategoryID,
+		&i.Name,
+		&i.Description,
+		&i.Price,
+		&i.IsAvailable,
+		&i.ImageKey,
+		&i.SortOrder,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,340 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: favorites.sql
+
+package schema
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const addFavoriteMerchant = `-- name: AddFavoriteMerchant :exec
+INSERT INTO favorite_merchants (user_id, merchant_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING
+`
+
+type AddFavoriteMerchantParams struct {
+	UserID     int64 `json:"user_id"`
+	MerchantID int64 `json:"merchant_id"`
+}
+
+func (q *Queries) AddFavoriteMerchant(ctx context.Context, arg AddFavoriteMerchantParams) error {
+	_, err := q.db.Exec(ctx, addFavoriteMerchant, arg.UserID, arg.MerchantID)
+	return err
+}
+
+const addFavoriteOffer = `-- name: AddFavoriteOffer :exec
+INSERT INTO favorite_offers (user_id, offer_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING
+`
+
+type AddFavoriteOfferParams struct {
+	UserID  int64 `json:"user_id"`
+	OfferID int64 `json:"offer_id"`
+}
+
+func (q *Queries) AddFavoriteOffer(ctx context.Context, arg AddFavoriteOfferParams) error {
+	_, err := q.db.Exec(ctx, addFavoriteOffer, arg.UserID, arg.OfferID)
+	return err
+}
+
+const listFavoriteMerchantIDs = `-- name: ListFavoriteMerchantIDs :many
+SELECT merchant_id FROM favorite_merchants WHERE favorite_merchants.user_id = $1
+UNION
+SELECT offers.merchant_id::bigint FROM favorite_offers
+JOIN offers ON offers.id = favorite_offers.offer_id
+WHERE favorite_offers.user_id = $1 AND offers.merchant_id IS NOT NULL
+`
+
+// Merchants the user saved directly or through one of their offers
+func (q *Queries) ListFavoriteMerchantIDs(ctx context.Context, userID int64) ([]int64, error) {
+	rows, err := q.db.Query(ctx, listFavoriteMerchantIDs, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var merchant_id int64
+		if err := rows.Scan(&merchant_id); err != nil {
+			return nil, err
+		}
+		items = append(items, merchant_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listFavoriteMerchants = `-- name: ListFavoriteMerchants :many
+SELECT merchants.id, merchants.name, merchants.email, merchants.password_hash, merchants.phone, merchants.category, merchants.discount_percentage, merchants.is_active, merchants.created_at, merchants.updated_at, merchants.status, merchants.timezone, merchants.orders_paused_until, merchants.rating_count, merchants.rating_total FROM favorite_merchants
+JOIN merchants ON merchants.id = favorite_merchants.merchant_id
+WHERE favorite_merchants.user_id = $1
+ORDER BY favorite_merchants.created_at DESC
+`
+
+func (q *Queries) ListFavoriteMerchants(ctx context.Context, userID int64) ([]Merchant, error) {
+	rows, err := q.db.Query(ctx, listFavoriteMerchants, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Merchant
+	for rows.Next() {
+		var i Merchant
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Email,
+			&i.PasswordHash,
+			&i.Phone,
+			&i.Category,
+			&i.DiscountPercentage,
+			&i.IsActive,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Status,
+			&i.Timezone,
+			&i.OrdersPausedUntil,
+			&i.RatingCount,
+			&i.RatingTotal,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listFavoriteOffers = `-- name: ListFavoriteOffers :many
+SELECT offers.id, offers.merchant_id, offers.title, offers.description, offers.discount_percentage, offers.min_amount, offers.max_discount, offers.is_active, offers.valid_from, offers.valid_until, offers.created_at, offers.updated_at FROM favorite_offers
+JOIN offers ON offers.id = favorite_offers.offer_id
+WHERE favorite_offers.user_id = $1
+ORDER BY favorite_offers.created_at DESC
+`
+
+func (q *Queries) ListFavoriteOffers(ctx context.Context, userID int64) ([]Offer, error) {
+	rows, err := q.db.Query(ctx, listFavoriteOffers, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Offer
+	for rows.Next() {
+		var i Offer
+		if err := rows.Scan(
+			&i.ID,
+			&i.MerchantID,
+			&i.Title,
+			&i.Description,
+			&i.DiscountPercentage,
+			&i.MinAmount,
+			&i.MaxDiscount,
+			&i.IsActive,
+			&i.ValidFrom,
+			&i.ValidUntil,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMerchantsInArea = `-- name: ListMerchantsInArea :many
+SELECT merchants.id,
+       merchants.name,
+       COALESCE(merchants.category, '')::text AS category,
+       merchants.rating_count,
+       merchants.rating_total,
+       merchant_addresses.latitude::float8 AS latitude,
+       merchant_addresses.longitude::float8 AS longitude
+FROM merchants
+JOIN merchant_addresses ON merchant_addresses.merchant_id = merchants.id
+WHERE merchants.status = 'approved'
+  AND merchant_addresses.latitude BETWEEN $1::float8 AND $2::float8
+  AND merchant_addresses.longitude BETWEEN $3::float8 AND $4::float8
+`
+
+type ListMerchantsInAreaParams struct {
+	MinLat float64 `json:"min_lat"`
+	MaxLat float64 `json:"max_lat"`
+	MinLng float64 `json:"min_lng"`
+	MaxLng float64 `json:"max_lng"`
+}
+
+type ListMerchantsInAreaRow struct {
+	ID          int64   `json:"id"`
+	Name        string  `json:"name"`
+	Category    string  `json:"category"`
+	RatingCount int32   `json:"rating_count"`
+	RatingTotal int64   `json:"rating_total"`
+	Latitude    float64 `json:"latitude"`
+	Longitude   float64 `json:"longitude"`
+}
+
+// Approved merchants with a branch inside the bounding box, one row per branch
+func (q *Queries) ListMerchantsInArea(ctx context.Context, arg ListMerchantsInAreaParams) ([]ListMerchantsInAreaRow, error) {
+	rows, err := q.db.Query(ctx, listMerchantsInArea,
+		arg.MinLat,
+		arg.MaxLat,
+		arg.MinLng,
+		arg.MaxLng,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListMerchantsInAreaRow
+	for rows.Next() {
+		var i ListMerchantsInAreaRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Category,
+			&i.RatingCount,
+			&i.RatingTotal,
+			&i.Latitude,
+			&i.Longitude,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserMerchantVisits = `-- name: ListUserMerchantVisits :many
+SELECT visits.merchant_id::bigint AS merchant_id,
+       COALESCE(merchants.category, '')::text AS category,
+       COUNT(*)::int AS visit_count,
+       MAX(visits.visited_at)::timestamp AS last_visit_at
+FROM (
+    SELECT orders.merchant_id, orders.created_at AS visited_at FROM orders
+    WHERE orders.user_id = $1 AND orders.status = 'completed'
+    UNION ALL
+    SELECT transactions.merchant_id, transactions.created_at AS visited_at FROM transactions
+    WHERE transactions.user_id = $1 AND transactions.transaction_type = 'payment'
+      AND transactions.status = 'completed' AND transactions.order_id IS NULL
+) AS visits
+JOIN merchants ON merchants.id = visits.merchant_id
+GROUP BY visits.merchant_id, merchants.category
+`
+
+type ListUserMerchantVisitsRow struct {
+	MerchantID  int64            `json:"merchant_id"`
+	Category    string           `json:"category"`
+	VisitCount  int32            `json:"visit_count"`
+	LastVisitAt pgtype.Timestamp `json:"last_visit_at"`
+}
+
+// How often and how recently the user bought from each merchant: completed
+// orders plus completed payments that weren't for an order
+func (q *Queries) ListUserMerchantVisits(ctx context.Context, userID pgtype.Int8) ([]ListUserMerchantVisitsRow, error) {
+	rows, err := q.db.Query(ctx, listUserMerchantVisits, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUserMerchantVisitsRow
+	for rows.Next() {
+		var i ListUserMerchantVisitsRow
+		if err := rows.Scan(
+			&i.MerchantID,
+			&i.Category,
+			&i.VisitCount,
+			&i.LastVisitAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserRatings = `-- name: ListUserRatings :many
+SELECT merchant_id, AVG(rating)::float8 AS rating
+FROM reviews
+WHERE user_id = $1
+GROUP BY merchant_id
+`
+
+type ListUserRatingsRow struct {
+	MerchantID int64   `json:"merchant_id"`
+	Rating     float64 `json:"rating"`
+}
+
+// The stars the user gave each merchant, averaged over their reviews
+func (q *Queries) ListUserRatings(ctx context.Context, userID pgtype.Int8) ([]ListUserRatingsRow, error) {
+	rows, err := q.db.Query(ctx, listUserRatings, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUserRatingsRow
+	for rows.Next() {
+		var i ListUserRatingsRow
+		if err := rows.Scan(&i.MerchantID, &i.Rating); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const removeFavoriteMerchant = `-- name: RemoveFavoriteMerchant :execrows
+DELETE FROM favorite_merchants WHERE user_id = $1 AND merchant_id = $2
+`
+
+type RemoveFavoriteMerchantParams struct {
+	UserID     int64 `json:"user_id"`
+	MerchantID int64 `json:"merchant_id"`
+}
+
+func (q *Queries) RemoveFavoriteMerchant(ctx context.Context, arg RemoveFavoriteMerchantParams) (int64, error) {
+	result, err := q.db.Exec(ctx, removeFavoriteMerchant, arg.UserID, arg.MerchantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const removeFavoriteOffer = `-- name: RemoveFavoriteOffer :execrows
+DELETE FROM favorite_offers WHERE user_id = $1 AND offer_id = $2
+`
+
+type RemoveFavoriteOfferParams struct {
+	UserID  int64 `json:"user_id"`
+	OfferID int64 `json:"offer_id"`
+}
+
+func (q *Queries) RemoveFavoriteOffer(ctx context.Context, arg RemoveFavoriteOfferParams) (int64, error) {
+	result, err := q.db.Exec(ctx, removeFavoriteOffer, arg.UserID, arg.OfferID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
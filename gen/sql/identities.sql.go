@@ -0,0 +1,165 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: identities.sql
+
+package schema
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createUserIdentity = `-- name: CreateUserIdentity :one
+INSERT INTO user_identities (
+    user_id, provider, subject, email, email_verified, last_login_at
+) VALUES (
+    $1, $2, $3, $4, $5, NOW()
+) RETURNING id, user_id, provider, subject, email, email_verified, last_login_at, created_at
+`
+
+type CreateUserIdentityParams struct {
+	UserID        int64       `json:"user_id"`
+	Provider      string      `json:"provider"`
+	Subject       string      `json:"subject"`
+	Email         pgtype.Text `json:"email"`
+	EmailVerified pgtype.Bool `json:"email_verified"`
+}
+
+func (q *Queries) CreateUserIdentity(ctx context.Context, arg CreateUserIdentityParams) (UserIdentity, error) {
+	row := q.db.QueryRow(ctx, createUserIdentity,
+		arg.UserID,
+		arg.Provider,
+		arg.Subject,
+		arg.Email,
+		arg.EmailVerified,
+	)
+	var i UserIdentity
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Provider,
+		&i.Subject,
+		&i.Email,
+		&i.EmailVerified,
+		&i.LastLoginAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteUserIdentity = `-- name: DeleteUserIdentity :execrows
+DELETE FROM user_identities
+WHERE user_id = $1 AND provider = $2
+`
+
+type DeleteUserIdentityParams struct {
+	UserID   int64  `json:"user_id"`
+	Provider string `json:"provider"`
+}
+
+func (q *Queries) DeleteUserIdentity(ctx context.Context, arg DeleteUserIdentityParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteUserIdentity, arg.UserID, arg.Provider)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getIdentityByProviderSubject = `-- name: GetIdentityByProviderSubject :one
+SELECT id, user_id, provider, subject, email, email_verified, last_login_at, created_at FROM user_identities
+WHERE provider = $1 AND subject = $2
+`
+
+type GetIdentityByProviderSubjectParams struct {
+	Provider string `json:"provider"`
+	Subject  string `json:"subject"`
+}
+
+func (q *Queries) GetIdentityByProviderSubject(ctx context.Context, arg GetIdentityByProviderSubjectParams) (UserIdentity, error) {
+	row := q.db.QueryRow(ctx, getIdentityByProviderSubject, arg.Provider, arg.Subject)
+	var i UserIdentity
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Provider,
+		&i.Subject,
+		&i.Email,
+		&i.EmailVerified,
+		&i.LastLoginAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listUserIdentities = `-- name: ListUserIdentities :many
+SELECT id, user_id, provider, subject, email, email_verified, last_login_at, created_at FROM user_identities
+WHERE user_id = $1
+ORDER BY created_at
+`
+
+func (q *Queries) ListUserIdentities(ctx context.Context, userID int64) ([]UserIdentity, error) {
+	rows, err := q.db.Query(ctx, listUserIdentities, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UserIdentity
+	for rows.Next() {
+		var i UserIdentity
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Provider,
+			&i.Subject,
+			&i.Email,
+			&i.EmailVerified,
+			&i.LastLoginAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setUserFirebaseUID = `-- name: SetUserFirebaseUID :exec
+UPDATE users SET
+    firebase_uid = $2,
+    updated_at = NOW()
+WHERE id = $1
+`
+
+type SetUserFirebaseUIDParams struct {
+	ID          int64       `json:"id"`
+	FirebaseUid pgtype.Text `json:"firebase_uid"`
+}
+
+func (q *Queries) SetUserFirebaseUID(ctx context.Context, arg SetUserFirebaseUIDParams) error {
+	_, err := q.db.Exec(ctx, setUserFirebaseUID, arg.ID, arg.FirebaseUid)
+	return err
+}
+
+const touchUserIdentity = `-- name: TouchUserIdentity :exec
+UPDATE user_identities SET
+    last_login_at = NOW(),
+    email = $2,
+    email_verified = $3
+WHERE id = $1
+`
+
+type TouchUserIdentityParams struct {
+	ID            int64       `json:"id"`
+	Email         pgtype.Text `json:"email"`
+	EmailVerified pgtype.Bool `json:"email_verified"`
+}
+
+func (q *Queries) TouchUserIdentity(ctx context.Context, arg TouchUserIdentityParams) error {
+	_, err := q.db.Exec(ctx, touchUserIdentity, arg.ID, arg.Email, arg.EmailVerified)
+	return err
+}
@@ -0,0 +1,153 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: mail.sql
+
+package schema
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const claimDueEmails = `-- name: ClaimDueEmails :many
+UPDATE email_outbox SET
+    claimed_until = $1,
+    attempts = attempts + 1
+WHERE email_outbox.id IN (
+    SELECT due.id FROM email_outbox due
+    WHERE due.status = 'pending'
+        AND due.next_attempt_at <= $2
+        AND (due.claimed_until IS NULL OR due.claimed_until < $2)
+    ORDER BY due.next_attempt_at
+    LIMIT $3
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, recipient, template, locale, subject, text_body, html_body, status, attempts, next_attempt_at, claimed_until, last_error, sent_at, created_at
+`
+
+type ClaimDueEmailsParams struct {
+	LeaseUntil pgtype.Timestamp `json:"lease_until"`
+	Now        pgtype.Timestamp `json:"now"`
+	BatchSize  int32            `json:"batch_size"`
+}
+
+// Same claim and lease scheme as push deliveries
+func (q *Queries) ClaimDueEmails(ctx context.Context, arg ClaimDueEmailsParams) ([]EmailOutbox, error) {
+	rows, err := q.db.Query(ctx, claimDueEmails, arg.LeaseUntil, arg.Now, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []EmailOutbox
+	for rows.Next() {
+		var i EmailOutbox
+		if err := rows.Scan(
+			&i.ID,
+			&i.Recipient,
+			&i.Template,
+			&i.Locale,
+			&i.Subject,
+			&i.TextBody,
+			&i.HtmlBody,
+			&i.Status,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.ClaimedUntil,
+			&i.LastError,
+			&i.SentAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const enqueueEmail = `-- name: EnqueueEmail :one
+INSERT INTO email_outbox (recipient, template, locale, subject, text_body, html_body)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id
+`
+
+type EnqueueEmailParams struct {
+	Recipient string `json:"recipient"`
+	Template  string `json:"template"`
+	Locale    string `json:"locale"`
+	Subject   string `json:"subject"`
+	TextBody  string `json:"text_body"`
+	HtmlBody  string `json:"html_body"`
+}
+
+func (q *Queries) EnqueueEmail(ctx context.Context, arg EnqueueEmailParams) (int64, error) {
+	row := q.db.QueryRow(ctx, enqueueEmail,
+		arg.Recipient,
+		arg.Template,
+		arg.Locale,
+		arg.Subject,
+		arg.TextBody,
+		arg.HtmlBody,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const failEmail = `-- name: FailEmail :exec
+UPDATE email_outbox SET
+    status = 'failed',
+    last_error = $1,
+    claimed_until = NULL,
+    text_body = '',
+    html_body = ''
+WHERE id = $2
+`
+
+type FailEmailParams struct {
+	LastError pgtype.Text `json:"last_error"`
+	ID        int64       `json:"id"`
+}
+
+func (q *Queries) FailEmail(ctx context.Context, arg FailEmailParams) error {
+	_, err := q.db.Exec(ctx, failEmail, arg.LastError, arg.ID)
+	return err
+}
+
+const markEmailSent = `-- name: MarkEmailSent :exec
+UPDATE email_outbox SET
+    status = 'sent',
+    sent_at = NOW(),
+    claimed_until = NULL,
+    text_body = '',
+    html_body = ''
+WHERE id = $1
+`
+
+func (q *Queries) MarkEmailSent(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, markEmailSent, id)
+	return err
+}
+
+const retryEmail = `-- name: RetryEmail :exec
+UPDATE email_outbox SET
+    next_attempt_at = $1,
+    last_error = $2,
+    claimed_until = NULL
+WHERE id = $3
+`
+
+type RetryEmailParams struct {
+	NextAttemptAt pgtype.Timestamp `json:"next_attempt_at"`
+	LastError     pgtype.Text      `json:"last_error"`
+	ID            int64            `json:"id"`
+}
+
+func (q *Queries) RetryEmail(ctx context.Context, arg RetryEmailParams) error {
+	_, err := q.db.Exec(ctx, retryEmail, arg.NextAttemptAt, arg.LastError, arg.ID)
+	return err
+}
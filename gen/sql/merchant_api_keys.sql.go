@@ -0,0 +1,162 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: merchant_api_keys.sql
+
+package schema
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createMerchantAPIKey = `-- name: CreateMerchantAPIKey :one
+INSERT INTO merchant_api_keys (
+    merchant_id, name, prefix, key_hash, scopes, allowed_ips, created_by, expires_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+) RETURNING id, merchant_id, name, prefix, key_hash, scopes, allowed_ips, created_by, last_used_at, last_used_ip, expires_at, revoked_at, created_at
+`
+
+type CreateMerchantAPIKeyParams struct {
+	MerchantID int64            `json:"merchant_id"`
+	Name       string           `json:"name"`
+	Prefix     string           `json:"prefix"`
+	KeyHash    string           `json:"key_hash"`
+	Scopes     []string         `json:"scopes"`
+	AllowedIps []string         `json:"allowed_ips"`
+	CreatedBy  pgtype.Int8      `json:"created_by"`
+	ExpiresAt  pgtype.Timestamp `json:"expires_at"`
+}
+
+func (q *Queries) CreateMerchantAPIKey(ctx context.Context, arg CreateMerchantAPIKeyParams) (MerchantApiKey, error) {
+	row := q.db.QueryRow(ctx, createMerchantAPIKey,
+		arg.MerchantID,
+		arg.Name,
+		arg.Prefix,
+		arg.KeyHash,
+		arg.Scopes,
+		arg.AllowedIps,
+		arg.CreatedBy,
+		arg.ExpiresAt,
+	)
+	var i MerchantApiKey
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.Name,
+		&i.Prefix,
+		&i.KeyHash,
+		&i.Scopes,
+		&i.AllowedIps,
+		&i.CreatedBy,
+		&i.LastUsedAt,
+		&i.LastUsedIp,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getMerchantAPIKeyByPrefix = `-- name: GetMerchantAPIKeyByPrefix :one
+SELECT id, merchant_id, name, prefix, key_hash, scopes, allowed_ips, created_by, last_used_at, last_used_ip, expires_at, revoked_at, created_at FROM merchant_api_keys WHERE prefix = $1
+`
+
+func (q *Queries) GetMerchantAPIKeyByPrefix(ctx context.Context, prefix string) (MerchantApiKey, error) {
+	row := q.db.QueryRow(ctx, getMerchantAPIKeyByPrefix, prefix)
+	var i MerchantApiKey
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.Name,
+		&i.Prefix,
+		&i.KeyHash,
+		&i.Scopes,
+		&i.AllowedIps,
+		&i.CreatedBy,
+		&i.LastUsedAt,
+		&i.LastUsedIp,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listMerchantAPIKeys = `-- name: ListMerchantAPIKeys :many
+SELECT id, merchant_id, name, prefix, key_hash, scopes, allowed_ips, created_by, last_used_at, last_used_ip, expires_at, revoked_at, created_at FROM merchant_api_keys
+WHERE merchant_id = $1
+ORDER BY created_at DESC
+`
+
+func (q *Queries) ListMerchantAPIKeys(ctx context.Context, merchantID int64) ([]MerchantApiKey, error) {
+	rows, err := q.db.Query(ctx, listMerchantAPIKeys, merchantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MerchantApiKey
+	for rows.Next() {
+		var i MerchantApiKey
+		if err := rows.Scan(
+			&i.ID,
+			&i.MerchantID,
+			&i.Name,
+			&i.Prefix,
+			&i.KeyHash,
+			&i.Scopes,
+			&i.AllowedIps,
+			&i.CreatedBy,
+			&i.LastUsedAt,
+			&i.LastUsedIp,
+			&i.ExpiresAt,
+			&i.RevokedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const revokeMerchantAPIKey = `-- name: RevokeMerchantAPIKey :execrows
+UPDATE merchant_api_keys SET revoked_at = NOW()
+WHERE id = $1 AND merchant_id = $2 AND revoked_at IS NULL
+`
+
+type RevokeMerchantAPIKeyParams struct {
+	ID         int64 `json:"id"`
+	MerchantID int64 `json:"merchant_id"`
+}
+
+func (q *Queries) RevokeMerchantAPIKey(ctx context.Context, arg RevokeMerchantAPIKeyParams) (int64, error) {
+	result, err := q.db.Exec(ctx, revokeMerchantAPIKey, arg.ID, arg.MerchantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const touchMerchantAPIKey = `-- name: TouchMerchantAPIKey :exec
+UPDATE merchant_api_keys SET
+    last_used_at = NOW(),
+    last_used_ip = $2
+WHERE id = $1
+  AND (last_used_at IS NULL OR last_used_at < NOW() - INTERVAL '1 minute')
+`
+
+type TouchMerchantAPIKeyParams struct {
+	ID         int64       `json:"id"`
+	LastUsedIp pgtype.Text `json:"last_used_ip"`
+}
+
+func (q *Queries) TouchMerchantAPIKey(ctx context.Context, arg TouchMerchantAPIKeyParams) error {
+	_, err := q.db.Exec(ctx, touchMerchantAPIKey, arg.ID, arg.LastUsedIp)
+	return err
+}
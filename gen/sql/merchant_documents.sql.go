@@ -0,0 +1,268 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: merchant_documents.sql
+
+package schema
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const claimExpiringMerchantDocuments = `-- name: ClaimExpiringMerchantDocuments :many
+UPDATE merchant_documents SET
+    reminder_claimed_until = $1
+WHERE merchant_documents.id IN (
+    SELECT due.id FROM merchant_documents due
+    WHERE due.status = 'verified'
+        AND due.expires_at IS NOT NULL
+        AND due.expires_at <= $2
+        AND due.expiry_reminders_sent < $3::int
+        AND (due.reminder_claimed_until IS NULL OR due.reminder_claimed_until < $4)
+    ORDER BY due.expires_at
+    LIMIT $5
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, merchant_id, doc_type, document_number, object_key, content_type, size_bytes, status, rejection_reason, reviewed_by, reviewed_at, expires_at, expiry_reminders_sent, created_at, updated_at, reminder_claimed_until
+`
+
+type ClaimExpiringMerchantDocumentsParams struct {
+	LeaseUntil   pgtype.Timestamp `json:"lease_until"`
+	Horizon      pgtype.Timestamp `json:"horizon"`
+	MaxReminders int32            `json:"max_reminders"`
+	Now          pgtype.Timestamp `json:"now"`
+	BatchSize    int32            `json:"batch_size"`
+}
+
+// Same claim and lease scheme as order timers, so instances running the
+// reminder loop at once don't remind the same merchant twice
+func (q *Queries) ClaimExpiringMerchantDocuments(ctx context.Context, arg ClaimExpiringMerchantDocumentsParams) ([]MerchantDocument, error) {
+	rows, err := q.db.Query(ctx, claimExpiringMerchantDocuments,
+		arg.LeaseUntil,
+		arg.Horizon,
+		arg.MaxReminders,
+		arg.Now,
+		arg.BatchSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MerchantDocument
+	for rows.Next() {
+		var i MerchantDocument
+		if err := rows.Scan(
+			&i.ID,
+			&i.MerchantID,
+			&i.DocType,
+			&i.DocumentNumber,
+			&i.ObjectKey,
+			&i.ContentType,
+			&i.SizeBytes,
+			&i.Status,
+			&i.RejectionReason,
+			&i.ReviewedBy,
+			&i.ReviewedAt,
+			&i.ExpiresAt,
+			&i.ExpiryRemindersSent,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ReminderClaimedUntil,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const createMerchantDocument = `-- name: CreateMerchantDocument :one
+INSERT INTO merchant_documents (
+    merchant_id, doc_type, document_number, object_key, content_type, size_bytes, expires_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+) RETURNING id, merchant_id, doc_type, document_number, object_key, content_type, size_bytes, status, rejection_reason, reviewed_by, reviewed_at, expires_at, expiry_reminders_sent, created_at, updated_at, reminder_claimed_until
+`
+
+type CreateMerchantDocumentParams struct {
+	MerchantID     int64            `json:"merchant_id"`
+	DocType        string           `json:"doc_type"`
+	DocumentNumber pgtype.Text      `json:"document_number"`
+	ObjectKey      string           `json:"object_key"`
+	ContentType    string           `json:"content_type"`
+	SizeBytes      int64            `json:"size_bytes"`
+	ExpiresAt      pgtype.Timestamp `json:"expires_at"`
+}
+
+func (q *Queries) CreateMerchantDocument(ctx context.Context, arg CreateMerchantDocumentParams) (MerchantDocument, error) {
+	row := q.db.QueryRow(ctx, createMerchantDocument,
+		arg.MerchantID,
+		arg.DocType,
+		arg.DocumentNumber,
+		arg.ObjectKey,
+		arg.ContentType,
+		arg.SizeBytes,
+		arg.ExpiresAt,
+	)
+	var i MerchantDocument
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.DocType,
+		&i.DocumentNumber,
+		&i.ObjectKey,
+		&i.ContentType,
+		&i.SizeBytes,
+		&i.Status,
+		&i.RejectionReason,
+		&i.ReviewedBy,
+		&i.ReviewedAt,
+		&i.ExpiresAt,
+		&i.ExpiryRemindersSent,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ReminderClaimedUntil,
+	)
+	return i, err
+}
+
+const getMerchantDocument = `-- name: GetMerchantDocument :one
+SELECT id, merchant_id, doc_type, document_number, object_key, content_type, size_bytes, status, rejection_reason, reviewed_by, reviewed_at, expires_at, expiry_reminders_sent, created_at, updated_at, reminder_claimed_until FROM merchant_documents WHERE id = $1
+`
+
+func (q *Queries) GetMerchantDocument(ctx context.Context, id int64) (MerchantDocument, error) {
+	row := q.db.QueryRow(ctx, getMerchantDocument, id)
+	var i MerchantDocument
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.DocType,
+		&i.DocumentNumber,
+		&i.ObjectKey,
+		&i.ContentType,
+		&i.SizeBytes,
+		&i.Status,
+		&i.RejectionReason,
+		&i.ReviewedBy,
+		&i.ReviewedAt,
+		&i.ExpiresAt,
+		&i.ExpiryRemindersSent,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ReminderClaimedUntil,
+	)
+	return i, err
+}
+
+const listMerchantDocuments = `-- name: ListMerchantDocuments :many
+SELECT id, merchant_id, doc_type, document_number, object_key, content_type, size_bytes, status, rejection_reason, reviewed_by, reviewed_at, expires_at, expiry_reminders_sent, created_at, updated_at, reminder_claimed_until FROM merchant_documents
+WHERE merchant_id = $1
+ORDER BY created_at DESC, id DESC
+`
+
+func (q *Queries) ListMerchantDocuments(ctx context.Context, merchantID int64) ([]MerchantDocument, error) {
+	rows, err := q.db.Query(ctx, listMerchantDocuments, merchantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MerchantDocument
+	for rows.Next() {
+		var i MerchantDocument
+		if err := rows.Scan(
+			&i.ID,
+			&i.MerchantID,
+			&i.DocType,
+			&i.DocumentNumber,
+			&i.ObjectKey,
+			&i.ContentType,
+			&i.SizeBytes,
+			&i.Status,
+			&i.RejectionReason,
+			&i.ReviewedBy,
+			&i.ReviewedAt,
+			&i.ExpiresAt,
+			&i.ExpiryRemindersSent,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ReminderClaimedUntil,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const reviewMerchantDocument = `-- name: ReviewMerchantDocument :one
+UPDATE merchant_documents SET
+    status = $2,
+    rejection_reason = $3,
+    reviewed_by = $4,
+    reviewed_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1 AND status = 'uploaded'
+RETURNING id, merchant_id, doc_type, document_number, object_key, content_type, size_bytes, status, rejection_reason, reviewed_by, reviewed_at, expires_at, expiry_reminders_sent, created_at, updated_at, reminder_claimed_until
+`
+
+type ReviewMerchantDocumentParams struct {
+	ID              int64       `json:"id"`
+	Status          string      `json:"status"`
+	RejectionReason pgtype.Text `json:"rejection_reason"`
+	ReviewedBy      pgtype.Int8 `json:"reviewed_by"`
+}
+
+func (q *Queries) ReviewMerchantDocument(ctx context.Context, arg ReviewMerchantDocumentParams) (MerchantDocument, error) {
+	row := q.db.QueryRow(ctx, reviewMerchantDocument,
+		arg.ID,
+		arg.Status,
+		arg.RejectionReason,
+		arg.ReviewedBy,
+	)
+	var i MerchantDocument
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.DocType,
+		&i.DocumentNumber,
+		&i.ObjectKey,
+		&i.ContentType,
+		&i.SizeBytes,
+		&i.Status,
+		&i.RejectionReason,
+		&i.ReviewedBy,
+		&i.ReviewedAt,
+		&i.ExpiresAt,
+		&i.ExpiryRemindersSent,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ReminderClaimedUntil,
+	)
+	return i, err
+}
+
+const setMerchantDocumentRemindersSent = `-- name: SetMerchantDocumentRemindersSent :exec
+UPDATE merchant_documents SET
+    expiry_reminders_sent = $2,
+    reminder_claimed_until = NULL,
+    updated_at = NOW()
+WHERE id = $1
+`
+
+type SetMerchantDocumentRemindersSentParams struct {
+	ID                  int64 `json:"id"`
+	ExpiryRemindersSent int32 `json:"expiry_reminders_sent"`
+}
+
+func (q *Queries) SetMerchantDocumentRemindersSent(ctx context.Context, arg SetMerchantDocumentRemindersSentParams) error {
+	_, err := q.db.Exec(ctx, setMerchantDocumentRemindersSent, arg.ID, arg.ExpiryRemindersSent)
+	return err
+}
@@ -0,0 +1,228 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: merchant_hours.sql
+
+package schema
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createMerchantClosure = `-- name: CreateMerchantClosure :one
+INSERT INTO merchant_closures (
+    merchant_id, starts_at, ends_at, reason
+) VALUES (
+    $1, $2, $3, $4
+) RETURNING id, merchant_id, starts_at, ends_at, reason, created_at
+`
+
+type CreateMerchantClosureParams struct {
+	MerchantID int64            `json:"merchant_id"`
+	StartsAt   pgtype.Timestamp `json:"starts_at"`
+	EndsAt     pgtype.Timestamp `json:"ends_at"`
+	Reason     pgtype.Text      `json:"reason"`
+}
+
+func (q *Queries) CreateMerchantClosure(ctx context.Context, arg CreateMerchantClosureParams) (MerchantClosure, error) {
+	row := q.db.QueryRow(ctx, createMerchantClosure,
+		arg.MerchantID,
+		arg.StartsAt,
+		arg.EndsAt,
+		arg.Reason,
+	)
+	var i MerchantClosure
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.Reason,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createMerchantHours = `-- name: CreateMerchantHours :one
+INSERT INTO merchant_hours (
+    merchant_id, day_of_week, opens_at, closes_at
+) VALUES (
+    $1, $2, $3, $4
+) RETURNING id, merchant_id, day_of_week, opens_at, closes_at
+`
+
+type CreateMerchantHoursParams struct {
+	MerchantID int64       `json:"merchant_id"`
+	DayOfWeek  int16       `json:"day_of_week"`
+	OpensAt    pgtype.Time `json:"opens_at"`
+	ClosesAt   pgtype.Time `json:"closes_at"`
+}
+
+func (q *Queries) CreateMerchantHours(ctx context.Context, arg CreateMerchantHoursParams) (MerchantHour, error) {
+	row := q.db.QueryRow(ctx, createMerchantHours,
+		arg.MerchantID,
+		arg.DayOfWeek,
+		arg.OpensAt,
+		arg.ClosesAt,
+	)
+	var i MerchantHour
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.DayOfWeek,
+		&i.OpensAt,
+		&i.ClosesAt,
+	)
+	return i, err
+}
+
+const deleteMerchantClosure = `-- name: DeleteMerchantClosure :one
+DELETE FROM merchant_closures
+WHERE id = $1 AND merchant_id = $2
+RETURNING id, merchant_id, starts_at, ends_at, reason, created_at
+`
+
+type DeleteMerchantClosureParams struct {
+	ID         int64 `json:"id"`
+	MerchantID int64 `json:"merchant_id"`
+}
+
+func (q *Queries) DeleteMerchantClosure(ctx context.Context, arg DeleteMerchantClosureParams) (MerchantClosure, error) {
+	row := q.db.QueryRow(ctx, deleteMerchantClosure, arg.ID, arg.MerchantID)
+	var i MerchantClosure
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.Reason,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteMerchantHours = `-- name: DeleteMerchantHours :exec
+DELETE FROM merchant_hours WHERE merchant_id = $1
+`
+
+func (q *Queries) DeleteMerchantHours(ctx context.Context, merchantID int64) error {
+	_, err := q.db.Exec(ctx, deleteMerchantHours, merchantID)
+	return err
+}
+
+const getMerchantSchedule = `-- name: GetMerchantSchedule :one
+SELECT id, timezone, orders_paused_until FROM merchants WHERE id = $1
+`
+
+type GetMerchantScheduleRow struct {
+	ID                int64            `json:"id"`
+	Timezone          string           `json:"timezone"`
+	OrdersPausedUntil pgtype.Timestamp `json:"orders_paused_until"`
+}
+
+func (q *Queries) GetMerchantSchedule(ctx context.Context, id int64) (GetMerchantScheduleRow, error) {
+	row := q.db.QueryRow(ctx, getMerchantSchedule, id)
+	var i GetMerchantScheduleRow
+	err := row.Scan(&i.ID, &i.Timezone, &i.OrdersPausedUntil)
+	return i, err
+}
+
+const listMerchantClosures = `-- name: ListMerchantClosures :many
+SELECT id, merchant_id, starts_at, ends_at, reason, created_at FROM merchant_closures
+WHERE merchant_id = $1 AND ends_at > $2
+ORDER BY starts_at
+`
+
+type ListMerchantClosuresParams struct {
+	MerchantID int64            `json:"merchant_id"`
+	After      pgtype.Timestamp `json:"after"`
+}
+
+func (q *Queries) ListMerchantClosures(ctx context.Context, arg ListMerchantClosuresParams) ([]MerchantClosure, error) {
+	rows, err := q.db.Query(ctx, listMerchantClosures, arg.MerchantID, arg.After)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MerchantClosure
+	for rows.Next() {
+		var i MerchantClosure
+		if err := rows.Scan(
+			&i.ID,
+			&i.MerchantID,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.Reason,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMerchantHours = `-- name: ListMerchantHours :many
+SELECT id, merchant_id, day_of_week, opens_at, closes_at FROM merchant_hours
+WHERE merchant_id = $1
+ORDER BY day_of_week, opens_at
+`
+
+func (q *Queries) ListMerchantHours(ctx context.Context, merchantID int64) ([]MerchantHour, error) {
+	rows, err := q.db.Query(ctx, listMerchantHours, merchantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MerchantHour
+	for rows.Next() {
+		var i MerchantHour
+		if err := rows.Scan(
+			&i.ID,
+			&i.MerchantID,
+			&i.DayOfWeek,
+			&i.OpensAt,
+			&i.ClosesAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setMerchantOrdersPausedUntil = `-- name: SetMerchantOrdersPausedUntil :exec
+UPDATE merchants SET orders_paused_until = $2, updated_at = NOW() WHERE id = $1
+`
+
+type SetMerchantOrdersPausedUntilParams struct {
+	ID                int64            `json:"id"`
+	OrdersPausedUntil pgtype.Timestamp `json:"orders_paused_until"`
+}
+
+func (q *Queries) SetMerchantOrdersPausedUntil(ctx context.Context, arg SetMerchantOrdersPausedUntilParams) error {
+	_, err := q.db.Exec(ctx, setMerchantOrdersPausedUntil, arg.ID, arg.OrdersPausedUntil)
+	return err
+}
+
+const setMerchantTimezone = `-- name: SetMerchantTimezone :exec
+UPDATE merchants SET timezone = $2, updated_at = NOW() WHERE id = $1
+`
+
+type SetMerchantTimezoneParams struct {
+	ID       int64  `json:"id"`
+	Timezone string `json:"timezone"`
+}
+
+func (q *Queries) SetMerchantTimezone(ctx context.Context, arg SetMerchantTimezoneParams) error {
+	_, err := q.db.Exec(ctx, setMerchantTimezone, arg.ID, arg.Timezone)
+	return err
+}
@@ -0,0 +1,132 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: merchant_onboarding.sql
+
+package schema
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createMerchantStatusHistory = `-- name: CreateMerchantStatusHistory :one
+INSERT INTO merchant_status_history (
+    merchant_id, from_status, to_status, reason, actor_id, actor_type
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING id, merchant_id, from_status, to_status, reason, actor_id, actor_type, created_at
+`
+
+type CreateMerchantStatusHistoryParams struct {
+	MerchantID int64       `json:"merchant_id"`
+	FromStatus pgtype.Text `json:"from_status"`
+	ToStatus   string      `json:"to_status"`
+	Reason     pgtype.Text `json:"reason"`
+	ActorID    pgtype.Int8 `json:"actor_id"`
+	ActorType  string      `json:"actor_type"`
+}
+
+func (q *Queries) CreateMerchantStatusHistory(ctx context.Context, arg CreateMerchantStatusHistoryParams) (MerchantStatusHistory, error) {
+	row := q.db.QueryRow(ctx, createMerchantStatusHistory,
+		arg.MerchantID,
+		arg.FromStatus,
+		arg.ToStatus,
+		arg.Reason,
+		arg.ActorID,
+		arg.ActorType,
+	)
+	var i MerchantStatusHistory
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.FromStatus,
+		&i.ToStatus,
+		&i.Reason,
+		&i.ActorID,
+		&i.ActorType,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listMerchantStatusHistory = `-- name: ListMerchantStatusHistory :many
+SELECT id, merchant_id, from_status, to_status, reason, actor_id, actor_type, created_at FROM merchant_status_history
+WHERE merchant_id = $1
+ORDER BY created_at DESC, id DESC
+`
+
+func (q *Queries) ListMerchantStatusHistory(ctx context.Context, merchantID int64) ([]MerchantStatusHistory, error) {
+	rows, err := q.db.Query(ctx, listMerchantStatusHistory, merchantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MerchantStatusHistory
+	for rows.Next() {
+		var i MerchantStatusHistory
+		if err := rows.Scan(
+			&i.ID,
+			&i.MerchantID,
+			&i.FromStatus,
+			&i.ToStatus,
+			&i.Reason,
+			&i.ActorID,
+			&i.ActorType,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const transitionMerchantStatus = `-- name: TransitionMerchantStatus :one
+UPDATE merchants SET
+    status = $1,
+    is_active = $2,
+    updated_at = NOW()
+WHERE id = $3 AND status = $4
+RETURNING id, name, email, password_hash, phone, category, discount_percentage, is_active, created_at, updated_at, status, timezone, orders_paused_until, rating_count, rating_total
+`
+
+type TransitionMerchantStatusParams struct {
+	ToStatus   string      `json:"to_status"`
+	IsActive   pgtype.Bool `json:"is_active"`
+	ID         int64       `json:"id"`
+	FromStatus string      `json:"from_status"`
+}
+
+// Only moves the merchant if it is still in from_status, so concurrent reviews can't both win
+func (q *Queries) TransitionMerchantStatus(ctx context.Context, arg TransitionMerchantStatusParams) (Merchant, error) {
+	row := q.db.QueryRow(ctx, transitionMerchantStatus,
+		arg.ToStatus,
+		arg.IsActive,
+		arg.ID,
+		arg.FromStatus,
+	)
+	var i Merchant
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Email,
+		&i.PasswordHash,
+		&i.Phone,
+		&i.Category,
+		&i.DiscountPercentage,
+		&i.IsActive,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Status,
+		&i.Timezone,
+		&i.OrdersPausedUntil,
+		&i.RatingCount,
+		&i.RatingTotal,
+	)
+	return i, err
+}
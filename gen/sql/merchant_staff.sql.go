@@ -0,0 +1,441 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: merchant_staff.sql
+
+package schema
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const acceptStaffInvitation = `-- name: AcceptStaffInvitation :execrows
+UPDATE merchant_staff_invitations SET accepted_at = NOW()
+WHERE id = $1 AND accepted_at IS NULL AND revoked_at IS NULL
+`
+
+func (q *Queries) AcceptStaffInvitation(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.Exec(ctx, acceptStaffInvitation, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const claimMerchantOwnership = `-- name: ClaimMerchantOwnership :one
+INSERT INTO merchant_staff (merchant_id, user_id, role)
+SELECT merchants.id, users.id, 'owner'
+FROM merchants
+JOIN users ON LOWER(users.email) = LOWER(merchants.email)
+WHERE merchants.id = $1 AND users.id = $2
+  AND NOT EXISTS (
+    SELECT 1 FROM merchant_staff owners
+    WHERE owners.merchant_id = merchants.id AND owners.role = 'owner'
+  )
+RETURNING id, merchant_id, user_id, role, outlet_ids, invited_by, created_at, updated_at
+`
+
+type ClaimMerchantOwnershipParams struct {
+	MerchantID int64 `json:"merchant_id"`
+	UserID     int64 `json:"user_id"`
+}
+
+// Merchants created with a login email are owned by the user with that email
+// until they have an owner membership.
+func (q *Queries) ClaimMerchantOwnership(ctx context.Context, arg ClaimMerchantOwnershipParams) (MerchantStaff, error) {
+	row := q.db.QueryRow(ctx, claimMerchantOwnership, arg.MerchantID, arg.UserID)
+	var i MerchantStaff
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.UserID,
+		&i.Role,
+		&i.OutletIds,
+		&i.InvitedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const countMerchantOwners = `-- name: CountMerchantOwners :one
+SELECT COUNT(*) FROM merchant_staff
+WHERE merchant_id = $1 AND role = 'owner'
+`
+
+func (q *Queries) CountMerchantOwners(ctx context.Context, merchantID int64) (int64, error) {
+	row := q.db.QueryRow(ctx, countMerchantOwners, merchantID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createMerchantStaff = `-- name: CreateMerchantStaff :one
+INSERT INTO merchant_staff (
+    merchant_id, user_id, role, outlet_ids, invited_by
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+ON CONFLICT (merchant_id, user_id) DO UPDATE
+SET role = EXCLUDED.role, outlet_ids = EXCLUDED.outlet_ids, updated_at = NOW()
+RETURNING id, merchant_id, user_id, role, outlet_ids, invited_by, created_at, updated_at
+`
+
+type CreateMerchantStaffParams struct {
+	MerchantID int64       `json:"merchant_id"`
+	UserID     int64       `json:"user_id"`
+	Role       string      `json:"role"`
+	OutletIds  []int64     `json:"outlet_ids"`
+	InvitedBy  pgtype.Int8 `json:"invited_by"`
+}
+
+func (q *Queries) CreateMerchantStaff(ctx context.Context, arg CreateMerchantStaffParams) (MerchantStaff, error) {
+	row := q.db.QueryRow(ctx, createMerchantStaff,
+		arg.MerchantID,
+		arg.UserID,
+		arg.Role,
+		arg.OutletIds,
+		arg.InvitedBy,
+	)
+	var i MerchantStaff
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.UserID,
+		&i.Role,
+		&i.OutletIds,
+		&i.InvitedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createStaffInvitation = `-- name: CreateStaffInvitation :one
+INSERT INTO merchant_staff_invitations (
+    merchant_id, email, role, outlet_ids, token_hash, invited_by, expires_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+) RETURNING id, merchant_id, email, role, outlet_ids, token_hash, invited_by, expires_at, accepted_at, revoked_at, created_at
+`
+
+type CreateStaffInvitationParams struct {
+	MerchantID int64            `json:"merchant_id"`
+	Email      string           `json:"email"`
+	Role       string           `json:"role"`
+	OutletIds  []int64          `json:"outlet_ids"`
+	TokenHash  string           `json:"token_hash"`
+	InvitedBy  pgtype.Int8      `json:"invited_by"`
+	ExpiresAt  pgtype.Timestamp `json:"expires_at"`
+}
+
+func (q *Queries) CreateStaffInvitation(ctx context.Context, arg CreateStaffInvitationParams) (MerchantStaffInvitation, error) {
+	row := q.db.QueryRow(ctx, createStaffInvitation,
+		arg.MerchantID,
+		arg.Email,
+		arg.Role,
+		arg.OutletIds,
+		arg.TokenHash,
+		arg.InvitedBy,
+		arg.ExpiresAt,
+	)
+	var i MerchantStaffInvitation
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.Email,
+		&i.Role,
+		&i.OutletIds,
+		&i.TokenHash,
+		&i.InvitedBy,
+		&i.ExpiresAt,
+		&i.AcceptedAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteMerchantStaff = `-- name: DeleteMerchantStaff :one
+DELETE FROM merchant_staff
+WHERE id = $1 AND merchant_id = $2
+RETURNING id, merchant_id, user_id, role, outlet_ids, invited_by, created_at, updated_at
+`
+
+type DeleteMerchantStaffParams struct {
+	ID         int64 `json:"id"`
+	MerchantID int64 `json:"merchant_id"`
+}
+
+func (q *Queries) DeleteMerchantStaff(ctx context.Context, arg DeleteMerchantStaffParams) (MerchantStaff, error) {
+	row := q.db.QueryRow(ctx, deleteMerchantStaff, arg.ID, arg.MerchantID)
+	var i MerchantStaff
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.UserID,
+		&i.Role,
+		&i.OutletIds,
+		&i.InvitedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getMerchantStaffByID = `-- name: GetMerchantStaffByID :one
+SELECT id, merchant_id, user_id, role, outlet_ids, invited_by, created_at, updated_at FROM merchant_staff
+WHERE id = $1 AND merchant_id = $2
+`
+
+type GetMerchantStaffByIDParams struct {
+	ID         int64 `json:"id"`
+	MerchantID int64 `json:"merchant_id"`
+}
+
+func (q *Queries) GetMerchantStaffByID(ctx context.Context, arg GetMerchantStaffByIDParams) (MerchantStaff, error) {
+	row := q.db.QueryRow(ctx, getMerchantStaffByID, arg.ID, arg.MerchantID)
+	var i MerchantStaff
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.UserID,
+		&i.Role,
+		&i.OutletIds,
+		&i.InvitedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getMerchantStaffMember = `-- name: GetMerchantStaffMember :one
+SELECT id, merchant_id, user_id, role, outlet_ids, invited_by, created_at, updated_at FROM merchant_staff
+WHERE merchant_id = $1 AND user_id = $2
+`
+
+type GetMerchantStaffMemberParams struct {
+	MerchantID int64 `json:"merchant_id"`
+	UserID     int64 `json:"user_id"`
+}
+
+func (q *Queries) GetMerchantStaffMember(ctx context.Context, arg GetMerchantStaffMemberParams) (MerchantStaff, error) {
+	row := q.db.QueryRow(ctx, getMerchantStaffMember, arg.MerchantID, arg.UserID)
+	var i MerchantStaff
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.UserID,
+		&i.Role,
+		&i.OutletIds,
+		&i.InvitedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getStaffInvitationByTokenHash = `-- name: GetStaffInvitationByTokenHash :one
+SELECT id, merchant_id, email, role, outlet_ids, token_hash, invited_by, expires_at, accepted_at, revoked_at, created_at FROM merchant_staff_invitations
+WHERE token_hash = $1
+`
+
+func (q *Queries) GetStaffInvitationByTokenHash(ctx context.Context, tokenHash string) (MerchantStaffInvitation, error) {
+	row := q.db.QueryRow(ctx, getStaffInvitationByTokenHash, tokenHash)
+	var i MerchantStaffInvitation
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.Email,
+		&i.Role,
+		&i.OutletIds,
+		&i.TokenHash,
+		&i.InvitedBy,
+		&i.ExpiresAt,
+		&i.AcceptedAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listMerchantStaff = `-- name: ListMerchantStaff :many
+SELECT merchant_staff.id, merchant_staff.merchant_id, merchant_staff.user_id, merchant_staff.role, merchant_staff.outlet_ids, merchant_staff.invited_by, merchant_staff.created_at, merchant_staff.updated_at, users.name, users.email
+FROM merchant_staff
+JOIN users ON users.id = merchant_staff.user_id
+WHERE merchant_staff.merchant_id = $1
+ORDER BY merchant_staff.created_at
+`
+
+type ListMerchantStaffRow struct {
+	MerchantStaff MerchantStaff `json:"merchant_staff"`
+	Name          string        `json:"name"`
+	Email         string        `json:"email"`
+}
+
+func (q *Queries) ListMerchantStaff(ctx context.Context, merchantID int64) ([]ListMerchantStaffRow, error) {
+	rows, err := q.db.Query(ctx, listMerchantStaff, merchantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListMerchantStaffRow
+	for rows.Next() {
+		var i ListMerchantStaffRow
+		if err := rows.Scan(
+			&i.MerchantStaff.ID,
+			&i.MerchantStaff.MerchantID,
+			&i.MerchantStaff.UserID,
+			&i.MerchantStaff.Role,
+			&i.MerchantStaff.OutletIds,
+			&i.MerchantStaff.InvitedBy,
+			&i.MerchantStaff.CreatedAt,
+			&i.MerchantStaff.UpdatedAt,
+			&i.Name,
+			&i.Email,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPendingStaffInvitations = `-- name: ListPendingStaffInvitations :many
+SELECT id, merchant_id, email, role, outlet_ids, token_hash, invited_by, expires_at, accepted_at, revoked_at, created_at FROM merchant_staff_invitations
+WHERE merchant_id = $1 AND accepted_at IS NULL AND revoked_at IS NULL AND expires_at > NOW()
+ORDER BY created_at DESC
+`
+
+func (q *Queries) ListPendingStaffInvitations(ctx context.Context, merchantID int64) ([]MerchantStaffInvitation, error) {
+	rows, err := q.db.Query(ctx, listPendingStaffInvitations, merchantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MerchantStaffInvitation
+	for rows.Next() {
+		var i MerchantStaffInvitation
+		if err := rows.Scan(
+			&i.ID,
+			&i.MerchantID,
+			&i.Email,
+			&i.Role,
+			&i.OutletIds,
+			&i.TokenHash,
+			&i.InvitedBy,
+			&i.ExpiresAt,
+			&i.AcceptedAt,
+			&i.RevokedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listStaffMemberships = `-- name: ListStaffMemberships :many
+SELECT merchant_staff.id, merchant_staff.merchant_id, merchant_staff.user_id, merchant_staff.role, merchant_staff.outlet_ids, merchant_staff.invited_by, merchant_staff.created_at, merchant_staff.updated_at, merchants.name AS merchant_name
+FROM merchant_staff
+JOIN merchants ON merchants.id = merchant_staff.merchant_id
+WHERE merchant_staff.user_id = $1
+ORDER BY merchant_staff.created_at
+`
+
+type ListStaffMembershipsRow struct {
+	MerchantStaff MerchantStaff `json:"merchant_staff"`
+	MerchantName  string        `json:"merchant_name"`
+}
+
+func (q *Queries) ListStaffMemberships(ctx context.Context, userID int64) ([]ListStaffMembershipsRow, error) {
+	rows, err := q.db.Query(ctx, listStaffMemberships, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListStaffMembershipsRow
+	for rows.Next() {
+		var i ListStaffMembershipsRow
+		if err := rows.Scan(
+			&i.MerchantStaff.ID,
+			&i.MerchantStaff.MerchantID,
+			&i.MerchantStaff.UserID,
+			&i.MerchantStaff.Role,
+			&i.MerchantStaff.OutletIds,
+			&i.MerchantStaff.InvitedBy,
+			&i.MerchantStaff.CreatedAt,
+			&i.MerchantStaff.UpdatedAt,
+			&i.MerchantName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const revokeStaffInvitation = `-- name: RevokeStaffInvitation :execrows
+UPDATE merchant_staff_invitations SET revoked_at = NOW()
+WHERE id = $1 AND merchant_id = $2 AND accepted_at IS NULL AND revoked_at IS NULL
+`
+
+type RevokeStaffInvitationParams struct {
+	ID         int64 `json:"id"`
+	MerchantID int64 `json:"merchant_id"`
+}
+
+func (q *Queries) RevokeStaffInvitation(ctx context.Context, arg RevokeStaffInvitationParams) (int64, error) {
+	result, err := q.db.Exec(ctx, revokeStaffInvitation, arg.ID, arg.MerchantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateMerchantStaff = `-- name: UpdateMerchantStaff :one
+UPDATE merchant_staff
+SET role = $3, outlet_ids = $4, updated_at = NOW()
+WHERE id = $1 AND merchant_id = $2
+RETURNING id, merchant_id, user_id, role, outlet_ids, invited_by, created_at, updated_at
+`
+
+type UpdateMerchantStaffParams struct {
+	ID         int64   `json:"id"`
+	MerchantID int64   `json:"merchant_id"`
+	Role       string  `json:"role"`
+	OutletIds  []int64 `json:"outlet_ids"`
+}
+
+func (q *Queries) UpdateMerchantStaff(ctx context.Context, arg UpdateMerchantStaffParams) (MerchantStaff, error) {
+	row := q.db.QueryRow(ctx, updateMerchantStaff,
+		arg.ID,
+		arg.MerchantID,
+		arg.Role,
+		arg.OutletIds,
+	)
+	var i MerchantStaff
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.UserID,
+		&i.Role,
+		&i.OutletIds,
+		&i.InvitedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -11,6 +11,16 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const clearPrimaryMerchantAddress = `-- name: ClearPrimaryMerchantAddress :exec
+UPDATE merchant_addresses SET is_primary = false, updated_at = NOW()
+WHERE merchant_id = $1 AND is_primary
+`
+
+func (q *Queries) ClearPrimaryMerchantAddress(ctx context.Context, merchantID pgtype.Int8) error {
+	_, err := q.db.Exec(ctx, clearPrimaryMerchantAddress, merchantID)
+	return err
+}
+
 const countActiveMerchants = `-- name: CountActiveMerchants :one
 SELECT COUNT(*) FROM merchants WHERE is_active = true
 `
@@ -33,12 +43,23 @@ func (q *Queries) CountMerchants(ctx context.Context) (int64, error) {
 	return count, err
 }
 
+const countPendingMerchants = `-- name: CountPendingMerchants :one
+SELECT COUNT(*) FROM merchants WHERE status IN ('submitted', 'under_review')
+`
+
+func (q *Queries) CountPendingMerchants(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, countPendingMerchants)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createMerchant = `-- name: CreateMerchant :one
 INSERT INTO merchants (
-    name, email, phone, category, discount_percentage, is_active
+    name, email, phone, category, discount_percentage, is_active, status
 ) VALUES (
-    $1, $2, $3, $4, $5, $6
-) RETURNING id, name, email, password_hash, phone, category, discount_percentage, is_active, created_at, updated_at
+    $1, $2, $3, $4, $5, $6, $7
+) RETURNING id, name, email, password_hash, phone, category, discount_percentage, is_active, created_at, updated_at, status, timezone, orders_paused_until, rating_count, rating_total
 `
 
 type CreateMerchantParams struct {
@@ -48,6 +69,7 @@ type CreateMerchantParams struct {
 	Category           pgtype.Text    `json:"category"`
 	DiscountPercentage pgtype.Numeric `json:"discount_percentage"`
 	IsActive           pgtype.Bool    `json:"is_active"`
+	Status             string         `json:"status"`
 }
 
 func (q *Queries) CreateMerchant(ctx context.Context, arg CreateMerchantParams) (Merchant, error) {
@@ -58,6 +80,7 @@ func (q *Queries) CreateMerchant(ctx context.Context, arg CreateMerchantParams)
 		arg.Category,
 		arg.DiscountPercentage,
 		arg.IsActive,
+		arg.Status,
 	)
 	var i Merchant
 	err := row.Scan(
@@ -71,16 +94,21 @@ func (q *Queries) CreateMerchant(ctx context.Context, arg CreateMerchantParams)
 		&i.IsActive,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Status,
+		&i.Timezone,
+		&i.OrdersPausedUntil,
+		&i.RatingCount,
+		&i.RatingTotal,
 	)
 	return i, err
 }
 
 const createMerchantAddress = `-- name: CreateMerchantAddress :one
 INSERT INTO merchant_addresses (
-    merchant_id, street, city, state, postal_code, country, latitude, longitude, is_primary
+    merchant_id, street, city, state, postal_code, country, latitude, longitude, is_primary, label
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9
-) RETURNING id, merchant_id, street, city, state, postal_code, country, latitude, longitude, is_primary, created_at, updated_at
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+) RETURNING id, merchant_id, street, city, state, postal_code, country, latitude, longitude, is_primary, created_at, updated_at, label
 `
 
 type CreateMerchantAddressParams struct {
@@ -92,7 +120,8 @@ type CreateMerchantAddressParams struct {
 	Country    pgtype.Text    `json:"country"`
 	Latitude   pgtype.Numeric `json:"latitude"`
 	Longitude  pgtype.Numeric `json:"longitude"`
-	IsPrimary  pgtype.Bool    `json:"is_primary"`
+	IsPrimary  bool           `json:"is_primary"`
+	Label      pgtype.Text    `json:"label"`
 }
 
 func (q *Queries) CreateMerchantAddress(ctx context.Context, arg CreateMerchantAddressParams) (MerchantAddress, error) {
@@ -106,6 +135,7 @@ func (q *Queries) CreateMerchantAddress(ctx context.Context, arg CreateMerchantA
 		arg.Latitude,
 		arg.Longitude,
 		arg.IsPrimary,
+		arg.Label,
 	)
 	var i MerchantAddress
 	err := row.Scan(
@@ -121,6 +151,7 @@ func (q *Queries) CreateMerchantAddress(ctx context.Context, arg CreateMerchantA
 		&i.IsPrimary,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Label,
 	)
 	return i, err
 }
@@ -182,8 +213,40 @@ func (q *Queries) DeleteMerchant(ctx context.Context, id int64) error {
 	return err
 }
 
+const deleteMerchantAddress = `-- name: DeleteMerchantAddress :one
+DELETE FROM merchant_addresses
+WHERE id = $1 AND merchant_id = $2
+RETURNING id, merchant_id, street, city, state, postal_code, country, latitude, longitude, is_primary, created_at, updated_at, label
+`
+
+type DeleteMerchantAddressParams struct {
+	ID         int64       `json:"id"`
+	MerchantID pgtype.Int8 `json:"merchant_id"`
+}
+
+func (q *Queries) DeleteMerchantAddress(ctx context.Context, arg DeleteMerchantAddressParams) (MerchantAddress, error) {
+	row := q.db.QueryRow(ctx, deleteMerchantAddress, arg.ID, arg.MerchantID)
+	var i MerchantAddress
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.Street,
+		&i.City,
+		&i.State,
+		&i.PostalCode,
+		&i.Country,
+		&i.Latitude,
+		&i.Longitude,
+		&i.IsPrimary,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Label,
+	)
+	return i, err
+}
+
 const getAllMerchants = `-- name: GetAllMerchants :many
-SELECT id, name, email, password_hash, phone, category, discount_percentage, is_active, created_at, updated_at FROM merchants 
+SELECT id, name, email, password_hash, phone, category, discount_percentage, is_active, created_at, updated_at, status, timezone, orders_paused_until, rating_count, rating_total FROM merchants 
 ORDER BY created_at DESC 
 LIMIT $1 OFFSET $2
 `
@@ -213,6 +276,11 @@ func (q *Queries) GetAllMerchants(ctx context.Context, arg GetAllMerchantsParams
 			&i.IsActive,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Status,
+			&i.Timezone,
+			&i.OrdersPausedUntil,
+			&i.RatingCount,
+			&i.RatingTotal,
 		); err != nil {
 			return nil, err
 		}
@@ -224,10 +292,41 @@ func (q *Queries) GetAllMerchants(ctx context.Context, arg GetAllMerchantsParams
 	return items, nil
 }
 
+const getMerchantAddress = `-- name: GetMerchantAddress :one
+SELECT id, merchant_id, street, city, state, postal_code, country, latitude, longitude, is_primary, created_at, updated_at, label FROM merchant_addresses
+WHERE id = $1 AND merchant_id = $2
+`
+
+type GetMerchantAddressParams struct {
+	ID         int64       `json:"id"`
+	MerchantID pgtype.Int8 `json:"merchant_id"`
+}
+
+func (q *Queries) GetMerchantAddress(ctx context.Context, arg GetMerchantAddressParams) (MerchantAddress, error) {
+	row := q.db.QueryRow(ctx, getMerchantAddress, arg.ID, arg.MerchantID)
+	var i MerchantAddress
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.Street,
+		&i.City,
+		&i.State,
+		&i.PostalCode,
+		&i.Country,
+		&i.Latitude,
+		&i.Longitude,
+		&i.IsPrimary,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Label,
+	)
+	return i, err
+}
+
 const getMerchantAddresses = `-- name: GetMerchantAddresses :many
-SELECT id, merchant_id, street, city, state, postal_code, country, latitude, longitude, is_primary, created_at, updated_at FROM merchant_addresses 
+SELECT id, merchant_id, street, city, state, postal_code, country, latitude, longitude, is_primary, created_at, updated_at, label FROM merchant_addresses 
 WHERE merchant_id = $1 
-ORDER BY created_at DESC
+ORDER BY is_primary DESC, created_at DESC
 `
 
 func (q *Queries) GetMerchantAddresses(ctx context.Context, merchantID pgtype.Int8) ([]MerchantAddress, error) {
@@ -252,6 +351,7 @@ func (q *Queries) GetMerchantAddresses(ctx context.Context, merchantID pgtype.In
 			&i.IsPrimary,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Label,
 		); err != nil {
 			return nil, err
 		}
@@ -264,7 +364,7 @@ func (q *Queries) GetMerchantAddresses(ctx context.Context, merchantID pgtype.In
 }
 
 const getMerchantByEmail = `-- name: GetMerchantByEmail :one
-SELECT id, name, email, password_hash, phone, category, discount_percentage, is_active, created_at, updated_at FROM merchants WHERE email = $1
+SELECT id, name, email, password_hash, phone, category, discount_percentage, is_active, created_at, updated_at, status, timezone, orders_paused_until, rating_count, rating_total FROM merchants WHERE email = $1
 `
 
 func (q *Queries) GetMerchantByEmail(ctx context.Context, email string) (Merchant, error) {
@@ -281,12 +381,17 @@ func (q *Queries) GetMerchantByEmail(ctx context.Context, email string) (Merchan
 		&i.IsActive,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Status,
+		&i.Timezone,
+		&i.OrdersPausedUntil,
+		&i.RatingCount,
+		&i.RatingTotal,
 	)
 	return i, err
 }
 
 const getMerchantByID = `-- name: GetMerchantByID :one
-SELECT id, name, email, password_hash, phone, category, discount_percentage, is_active, created_at, updated_at FROM merchants WHERE id = $1
+SELECT id, name, email, password_hash, phone, category, discount_percentage, is_active, created_at, updated_at, status, timezone, orders_paused_until, rating_count, rating_total FROM merchants WHERE id = $1
 `
 
 func (q *Queries) GetMerchantByID(ctx context.Context, id int64) (Merchant, error) {
@@ -303,12 +408,17 @@ func (q *Queries) GetMerchantByID(ctx context.Context, id int64) (Merchant, erro
 		&i.IsActive,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Status,
+		&i.Timezone,
+		&i.OrdersPausedUntil,
+		&i.RatingCount,
+		&i.RatingTotal,
 	)
 	return i, err
 }
 
 const getMerchantCustomers = `-- name: GetMerchantCustomers :many
-SELECT DISTINCT u.id, u.email, u.password_hash, u.phone, u.name, u.profile_pic, u.firebase_uid, u.coin_balance, u.role, u.referral_code, u.referred_by, u.created_at, u.updated_at FROM users u
+SELECT DISTINCT u.id, u.email, u.password_hash, u.phone, u.name, u.profile_pic, u.firebase_uid, u.coin_balance, u.role, u.referral_code, u.referred_by, u.created_at, u.updated_at, u.phone_verified_at, u.email_verified_at FROM users u
 JOIN transactions t ON u.id = t.user_id
 WHERE t.merchant_id = $1
 ORDER BY u.created_at DESC
@@ -344,6 +454,8 @@ func (q *Queries) GetMerchantCustomers(ctx context.Context, arg GetMerchantCusto
 			&i.ReferredBy,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.PhoneVerifiedAt,
+			&i.EmailVerifiedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -402,7 +514,7 @@ func (q *Queries) GetMerchantOffers(ctx context.Context, arg GetMerchantOffersPa
 }
 
 const getMerchantTransactions = `-- name: GetMerchantTransactions :many
-SELECT id, user_id, merchant_id, coins_spent, original_amount, discount_amount, final_amount, transaction_type, status, created_at FROM transactions 
+SELECT id, user_id, merchant_id, coins_spent, original_amount, discount_amount, final_amount, transaction_type, status, created_at, order_id, updated_at FROM transactions 
 WHERE merchant_id = $1 
 ORDER BY created_at DESC 
 LIMIT $2 OFFSET $3
@@ -434,6 +546,8 @@ func (q *Queries) GetMerchantTransactions(ctx context.Context, arg GetMerchantTr
 			&i.TransactionType,
 			&i.Status,
 			&i.CreatedAt,
+			&i.OrderID,
+			&i.UpdatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -446,7 +560,7 @@ func (q *Queries) GetMerchantTransactions(ctx context.Context, arg GetMerchantTr
 }
 
 const getMerchantsByCategory = `-- name: GetMerchantsByCategory :many
-SELECT id, name, email, password_hash, phone, category, discount_percentage, is_active, created_at, updated_at FROM merchants WHERE category = $1 AND is_active = true ORDER BY name
+SELECT id, name, email, password_hash, phone, category, discount_percentage, is_active, created_at, updated_at, status, timezone, orders_paused_until, rating_count, rating_total FROM merchants WHERE category = $1 AND is_active = true ORDER BY name
 `
 
 func (q *Queries) GetMerchantsByCategory(ctx context.Context, category pgtype.Text) ([]Merchant, error) {
@@ -469,6 +583,60 @@ func (q *Queries) GetMerchantsByCategory(ctx context.Context, category pgtype.Te
 			&i.IsActive,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Status,
+			&i.Timezone,
+			&i.OrdersPausedUntil,
+			&i.RatingCount,
+			&i.RatingTotal,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMerchantsByStatuses = `-- name: GetMerchantsByStatuses :many
+SELECT id, name, email, password_hash, phone, category, discount_percentage, is_active, created_at, updated_at, status, timezone, orders_paused_until, rating_count, rating_total FROM merchants
+WHERE status = ANY($1::text[])
+ORDER BY created_at DESC
+LIMIT $3 OFFSET $2
+`
+
+type GetMerchantsByStatusesParams struct {
+	Statuses  []string `json:"statuses"`
+	RowOffset int32    `json:"row_offset"`
+	RowLimit  int32    `json:"row_limit"`
+}
+
+func (q *Queries) GetMerchantsByStatuses(ctx context.Context, arg GetMerchantsByStatusesParams) ([]Merchant, error) {
+	rows, err := q.db.Query(ctx, getMerchantsByStatuses, arg.Statuses, arg.RowOffset, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Merchant
+	for rows.Next() {
+		var i Merchant
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Email,
+			&i.PasswordHash,
+			&i.Phone,
+			&i.Category,
+			&i.DiscountPercentage,
+			&i.IsActive,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Status,
+			&i.Timezone,
+			&i.OrdersPausedUntil,
+			&i.RatingCount,
+			&i.RatingTotal,
 		); err != nil {
 			return nil, err
 		}
@@ -504,8 +672,36 @@ func (q *Queries) GetOfferByID(ctx context.Context, id int64) (Offer, error) {
 	return i, err
 }
 
+const getOldestMerchantAddress = `-- name: GetOldestMerchantAddress :one
+SELECT id, merchant_id, street, city, state, postal_code, country, latitude, longitude, is_primary, created_at, updated_at, label FROM merchant_addresses
+WHERE merchant_id = $1
+ORDER BY created_at, id
+LIMIT 1
+`
+
+func (q *Queries) GetOldestMerchantAddress(ctx context.Context, merchantID pgtype.Int8) (MerchantAddress, error) {
+	row := q.db.QueryRow(ctx, getOldestMerchantAddress, merchantID)
+	var i MerchantAddress
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.Street,
+		&i.City,
+		&i.State,
+		&i.PostalCode,
+		&i.Country,
+		&i.Latitude,
+		&i.Longitude,
+		&i.IsPrimary,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Label,
+	)
+	return i, err
+}
+
 const listActiveMerchants = `-- name: ListActiveMerchants :many
-SELECT id, name, email, password_hash, phone, category, discount_percentage, is_active, created_at, updated_at FROM merchants WHERE is_active = true ORDER BY name
+SELECT id, name, email, password_hash, phone, category, discount_percentage, is_active, created_at, updated_at, status, timezone, orders_paused_until, rating_count, rating_total FROM merchants WHERE is_active = true ORDER BY name
 `
 
 func (q *Queries) ListActiveMerchants(ctx context.Context) ([]Merchant, error) {
@@ -528,6 +724,11 @@ func (q *Queries) ListActiveMerchants(ctx context.Context) ([]Merchant, error) {
 			&i.IsActive,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Status,
+			&i.Timezone,
+			&i.OrdersPausedUntil,
+			&i.RatingCount,
+			&i.RatingTotal,
 		); err != nil {
 			return nil, err
 		}
@@ -539,13 +740,44 @@ func (q *Queries) ListActiveMerchants(ctx context.Context) ([]Merchant, error) {
 	return items, nil
 }
 
+const setPrimaryMerchantAddress = `-- name: SetPrimaryMerchantAddress :one
+UPDATE merchant_addresses SET is_primary = true, updated_at = NOW()
+WHERE id = $1 AND merchant_id = $2
+RETURNING id, merchant_id, street, city, state, postal_code, country, latitude, longitude, is_primary, created_at, updated_at, label
+`
+
+type SetPrimaryMerchantAddressParams struct {
+	ID         int64       `json:"id"`
+	MerchantID pgtype.Int8 `json:"merchant_id"`
+}
+
+func (q *Queries) SetPrimaryMerchantAddress(ctx context.Context, arg SetPrimaryMerchantAddressParams) (MerchantAddress, error) {
+	row := q.db.QueryRow(ctx, setPrimaryMerchantAddress, arg.ID, arg.MerchantID)
+	var i MerchantAddress
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.Street,
+		&i.City,
+		&i.State,
+		&i.PostalCode,
+		&i.Country,
+		&i.Latitude,
+		&i.Longitude,
+		&i.IsPrimary,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Label,
+	)
+	return i, err
+}
+
 const updateMerchant = `-- name: UpdateMerchant :exec
 UPDATE merchants SET
     name = $2,
     phone = $3,
     category = $4,
     discount_percentage = $5,
-    is_active = $6,
     updated_at = NOW()
 WHERE id = $1
 `
@@ -556,7 +788,6 @@ type UpdateMerchantParams struct {
 	Phone              pgtype.Text    `json:"phone"`
 	Category           pgtype.Text    `json:"category"`
 	DiscountPercentage pgtype.Numeric `json:"discount_percentage"`
-	IsActive           pgtype.Bool    `json:"is_active"`
 }
 
 func (q *Queries) UpdateMerchant(ctx context.Context, arg UpdateMerchantParams) error {
@@ -566,11 +797,63 @@ func (q *Queries) UpdateMerchant(ctx context.Context, arg UpdateMerchantParams)
 		arg.Phone,
 		arg.Category,
 		arg.DiscountPercentage,
-		arg.IsActive,
 	)
 	return err
 }
 
+const updateMerchantAddress = `-- name: UpdateMerchantAddress :one
+UPDATE merchant_addresses
+SET street = $3, city = $4, state = $5, postal_code = $6, country = $7,
+    latitude = $8, longitude = $9, label = $10, updated_at = NOW()
+WHERE id = $1 AND merchant_id = $2
+RETURNING id, merchant_id, street, city, state, postal_code, country, latitude, longitude, is_primary, created_at, updated_at, label
+`
+
+type UpdateMerchantAddressParams struct {
+	ID         int64          `json:"id"`
+	MerchantID pgtype.Int8    `json:"merchant_id"`
+	Street     pgtype.Text    `json:"street"`
+	City       pgtype.Text    `json:"city"`
+	State      pgtype.Text    `json:"state"`
+	PostalCode pgtype.Text    `json:"postal_code"`
+	Country    pgtype.Text    `json:"country"`
+	Latitude   pgtype.Numeric `json:"latitude"`
+	Longitude  pgtype.Numeric `json:"longitude"`
+	Label      pgtype.Text    `json:"label"`
+}
+
+func (q *Queries) UpdateMerchantAddress(ctx context.Context, arg UpdateMerchantAddressParams) (MerchantAddress, error) {
+	row := q.db.QueryRow(ctx, updateMerchantAddress,
+		arg.ID,
+		arg.MerchantID,
+		arg.Street,
+		arg.City,
+		arg.State,
+		arg.PostalCode,
+		arg.Country,
+		arg.Latitude,
+		arg.Longitude,
+		arg.Label,
+	)
+	var i MerchantAddress
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.Street,
+		&i.City,
+		&i.State,
+		&i.PostalCode,
+		&i.Country,
+		&i.Latitude,
+		&i.Longitude,
+		&i.IsPrimary,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Label,
+	)
+	return i, err
+}
+
 const updateOffer = `-- name: UpdateOffer :exec
 UPDATE offers SET
     title = $2,
@@ -23,6 +23,39 @@ type AuditLog struct {
 	CreatedAt  pgtype.Timestamp `json:"created_at"`
 }
 
+type CatalogCategory struct {
+	ID         int64            `json:"id"`
+	MerchantID int64            `json:"merchant_id"`
+	Name       string           `json:"name"`
+	SortOrder  int32            `json:"sort_order"`
+	CreatedAt  pgtype.Timestamp `json:"created_at"`
+	UpdatedAt  pgtype.Timestamp `json:"updated_at"`
+}
+
+type CatalogItem struct {
+	ID          int64            `json:"id"`
+	MerchantID  int64            `json:"merchant_id"`
+	CategoryID  pgtype.Int8      `json:"category_id"`
+	Name        string           `json:"name"`
+	Description pgtype.Text      `json:"description"`
+	Price       pgtype.Numeric   `json:"price"`
+	IsAvailable bool             `json:"is_available"`
+	ImageKey    pgtype.Text      `json:"image_key"`
+	SortOrder   int32            `json:"sort_order"`
+	CreatedAt   pgtype.Timestamp `json:"created_at"`
+	UpdatedAt   pgtype.Timestamp `json:"updated_at"`
+}
+
+type CatalogOption struct {
+	ID          int64          `json:"id"`
+	ItemID      int64          `json:"item_id"`
+	Kind        string         `json:"kind"`
+	Name        string         `json:"name"`
+	PriceDelta  pgtype.Numeric `json:"price_delta"`
+	IsAvailable bool           `json:"is_available"`
+	SortOrder   int32          `json:"sort_order"`
+}
+
 type CoinPurchase struct {
 	ID            int64            `json:"id"`
 	UserID        pgtype.Int8      `json:"user_id"`
@@ -34,6 +67,52 @@ type CoinPurchase struct {
 	CreatedAt     pgtype.Timestamp `json:"created_at"`
 }
 
+type DeviceToken struct {
+	ID         int64            `json:"id"`
+	UserID     int64            `json:"user_id"`
+	Token      string           `json:"token"`
+	Platform   string           `json:"platform"`
+	Latitude   pgtype.Float8    `json:"latitude"`
+	Longitude  pgtype.Float8    `json:"longitude"`
+	CreatedAt  pgtype.Timestamp `json:"created_at"`
+	LastSeenAt pgtype.Timestamp `json:"last_seen_at"`
+}
+
+type EmailOutbox struct {
+	ID            int64            `json:"id"`
+	Recipient     string           `json:"recipient"`
+	Template      string           `json:"template"`
+	Locale        string           `json:"locale"`
+	Subject       string           `json:"subject"`
+	TextBody      string           `json:"text_body"`
+	HtmlBody      string           `json:"html_body"`
+	Status        string           `json:"status"`
+	Attempts      int32            `json:"attempts"`
+	NextAttemptAt pgtype.Timestamp `json:"next_attempt_at"`
+	ClaimedUntil  pgtype.Timestamp `json:"claimed_until"`
+	LastError     pgtype.Text      `json:"last_error"`
+	SentAt        pgtype.Timestamp `json:"sent_at"`
+	CreatedAt     pgtype.Timestamp `json:"created_at"`
+}
+
+type FavoriteMerchant struct {
+	UserID     int64            `json:"user_id"`
+	MerchantID int64            `json:"merchant_id"`
+	CreatedAt  pgtype.Timestamp `json:"created_at"`
+}
+
+type FavoriteOffer struct {
+	UserID    int64            `json:"user_id"`
+	OfferID   int64            `json:"offer_id"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+}
+
+type InvoiceCounter struct {
+	MerchantID    int64  `json:"merchant_id"`
+	FinancialYear string `json:"financial_year"`
+	LastValue     int64  `json:"last_value"`
+}
+
 type JwtSession struct {
 	ID               int64            `json:"id"`
 	UserID           pgtype.Int8      `json:"user_id"`
@@ -42,6 +121,13 @@ type JwtSession struct {
 	ExpiresAt        pgtype.Timestamp `json:"expires_at"`
 	IsRevoked        pgtype.Bool      `json:"is_revoked"`
 	CreatedAt        pgtype.Timestamp `json:"created_at"`
+	SessionID        pgtype.Text      `json:"session_id"`
+	DeviceID         pgtype.Text      `json:"device_id"`
+	DeviceName       pgtype.Text      `json:"device_name"`
+	Platform         pgtype.Text      `json:"platform"`
+	UserAgent        pgtype.Text      `json:"user_agent"`
+	IpAddress        pgtype.Text      `json:"ip_address"`
+	LastSeenAt       pgtype.Timestamp `json:"last_seen_at"`
 }
 
 type Merchant struct {
@@ -55,6 +141,11 @@ type Merchant struct {
 	IsActive           pgtype.Bool      `json:"is_active"`
 	CreatedAt          pgtype.Timestamp `json:"created_at"`
 	UpdatedAt          pgtype.Timestamp `json:"updated_at"`
+	Status             string           `json:"status"`
+	Timezone           string           `json:"timezone"`
+	OrdersPausedUntil  pgtype.Timestamp `json:"orders_paused_until"`
+	RatingCount        int32            `json:"rating_count"`
+	RatingTotal        int64            `json:"rating_total"`
 }
 
 type MerchantAddress struct {
@@ -67,11 +158,130 @@ type MerchantAddress struct {
 	Country    pgtype.Text      `json:"country"`
 	Latitude   pgtype.Numeric   `json:"latitude"`
 	Longitude  pgtype.Numeric   `json:"longitude"`
-	IsPrimary  pgtype.Bool      `json:"is_primary"`
+	IsPrimary  bool             `json:"is_primary"`
+	CreatedAt  pgtype.Timestamp `json:"created_at"`
+	UpdatedAt  pgtype.Timestamp `json:"updated_at"`
+	Label      pgtype.Text      `json:"label"`
+}
+
+type MerchantApiKey struct {
+	ID         int64            `json:"id"`
+	MerchantID int64            `json:"merchant_id"`
+	Name       string           `json:"name"`
+	Prefix     string           `json:"prefix"`
+	KeyHash    string           `json:"key_hash"`
+	Scopes     []string         `json:"scopes"`
+	AllowedIps []string         `json:"allowed_ips"`
+	CreatedBy  pgtype.Int8      `json:"created_by"`
+	LastUsedAt pgtype.Timestamp `json:"last_used_at"`
+	LastUsedIp pgtype.Text      `json:"last_used_ip"`
+	ExpiresAt  pgtype.Timestamp `json:"expires_at"`
+	RevokedAt  pgtype.Timestamp `json:"revoked_at"`
+	CreatedAt  pgtype.Timestamp `json:"created_at"`
+}
+
+type MerchantClosure struct {
+	ID         int64            `json:"id"`
+	MerchantID int64            `json:"merchant_id"`
+	StartsAt   pgtype.Timestamp `json:"starts_at"`
+	EndsAt     pgtype.Timestamp `json:"ends_at"`
+	Reason     pgtype.Text      `json:"reason"`
+	CreatedAt  pgtype.Timestamp `json:"created_at"`
+}
+
+type MerchantDocument struct {
+	ID                   int64            `json:"id"`
+	MerchantID           int64            `json:"merchant_id"`
+	DocType              string           `json:"doc_type"`
+	DocumentNumber       pgtype.Text      `json:"document_number"`
+	ObjectKey            string           `json:"object_key"`
+	ContentType          string           `json:"content_type"`
+	SizeBytes            int64            `json:"size_bytes"`
+	Status               string           `json:"status"`
+	RejectionReason      pgtype.Text      `json:"rejection_reason"`
+	ReviewedBy           pgtype.Int8      `json:"reviewed_by"`
+	ReviewedAt           pgtype.Timestamp `json:"reviewed_at"`
+	ExpiresAt            pgtype.Timestamp `json:"expires_at"`
+	ExpiryRemindersSent  int32            `json:"expiry_reminders_sent"`
+	CreatedAt            pgtype.Timestamp `json:"created_at"`
+	UpdatedAt            pgtype.Timestamp `json:"updated_at"`
+	ReminderClaimedUntil pgtype.Timestamp `json:"reminder_claimed_until"`
+}
+
+type MerchantHour struct {
+	ID         int64       `json:"id"`
+	MerchantID int64       `json:"merchant_id"`
+	DayOfWeek  int16       `json:"day_of_week"`
+	OpensAt    pgtype.Time `json:"opens_at"`
+	ClosesAt   pgtype.Time `json:"closes_at"`
+}
+
+type MerchantStaff struct {
+	ID         int64            `json:"id"`
+	MerchantID int64            `json:"merchant_id"`
+	UserID     int64            `json:"user_id"`
+	Role       string           `json:"role"`
+	OutletIds  []int64          `json:"outlet_ids"`
+	InvitedBy  pgtype.Int8      `json:"invited_by"`
 	CreatedAt  pgtype.Timestamp `json:"created_at"`
 	UpdatedAt  pgtype.Timestamp `json:"updated_at"`
 }
 
+type MerchantStaffInvitation struct {
+	ID         int64            `json:"id"`
+	MerchantID int64            `json:"merchant_id"`
+	Email      string           `json:"email"`
+	Role       string           `json:"role"`
+	OutletIds  []int64          `json:"outlet_ids"`
+	TokenHash  string           `json:"token_hash"`
+	InvitedBy  pgtype.Int8      `json:"invited_by"`
+	ExpiresAt  pgtype.Timestamp `json:"expires_at"`
+	AcceptedAt pgtype.Timestamp `json:"accepted_at"`
+	RevokedAt  pgtype.Timestamp `json:"revoked_at"`
+	CreatedAt  pgtype.Timestamp `json:"created_at"`
+}
+
+type MerchantStatusHistory struct {
+	ID         int64            `json:"id"`
+	MerchantID int64            `json:"merchant_id"`
+	FromStatus pgtype.Text      `json:"from_status"`
+	ToStatus   string           `json:"to_status"`
+	Reason     pgtype.Text      `json:"reason"`
+	ActorID    pgtype.Int8      `json:"actor_id"`
+	ActorType  string           `json:"actor_type"`
+	CreatedAt  pgtype.Timestamp `json:"created_at"`
+}
+
+type Notification struct {
+	ID         int64            `json:"id"`
+	UserID     pgtype.Int8      `json:"user_id"`
+	MerchantID pgtype.Int8      `json:"merchant_id"`
+	Type       string           `json:"type"`
+	Title      string           `json:"title"`
+	Body       string           `json:"body"`
+	DeepLink   pgtype.Text      `json:"deep_link"`
+	ReadAt     pgtype.Timestamp `json:"read_at"`
+	CreatedAt  pgtype.Timestamp `json:"created_at"`
+}
+
+type NotificationPreference struct {
+	UserID    int64            `json:"user_id"`
+	Category  string           `json:"category"`
+	Push      bool             `json:"push"`
+	UpdatedAt pgtype.Timestamp `json:"updated_at"`
+	Email     bool             `json:"email"`
+	InApp     bool             `json:"in_app"`
+}
+
+type NotificationSetting struct {
+	UserID     int64            `json:"user_id"`
+	Timezone   string           `json:"timezone"`
+	QuietStart pgtype.Time      `json:"quiet_start"`
+	QuietEnd   pgtype.Time      `json:"quiet_end"`
+	UpdatedAt  pgtype.Timestamp `json:"updated_at"`
+	Locale     string           `json:"locale"`
+}
+
 type Offer struct {
 	ID                 int64            `json:"id"`
 	MerchantID         pgtype.Int8      `json:"merchant_id"`
@@ -98,10 +308,75 @@ type Order struct {
 	DiscountAmount pgtype.Numeric   `json:"discount_amount"`
 	TotalAmount    pgtype.Numeric   `json:"total_amount"`
 	CoinsUsed      pgtype.Numeric   `json:"coins_used"`
-	Status         pgtype.Text      `json:"status"`
+	Status         string           `json:"status"`
 	Notes          pgtype.Text      `json:"notes"`
 	CreatedAt      pgtype.Timestamp `json:"created_at"`
 	UpdatedAt      pgtype.Timestamp `json:"updated_at"`
+	StatusReason   pgtype.Text      `json:"status_reason"`
+	AcceptedAt     pgtype.Timestamp `json:"accepted_at"`
+	PreparingAt    pgtype.Timestamp `json:"preparing_at"`
+	ReadyAt        pgtype.Timestamp `json:"ready_at"`
+	CompletedAt    pgtype.Timestamp `json:"completed_at"`
+	CancelledAt    pgtype.Timestamp `json:"cancelled_at"`
+	RejectedAt     pgtype.Timestamp `json:"rejected_at"`
+	OutletID       pgtype.Int8      `json:"outlet_id"`
+	NumberPeriod   string           `json:"number_period"`
+}
+
+type OrderNumberCounter struct {
+	MerchantID int64  `json:"merchant_id"`
+	Period     string `json:"period"`
+	LastValue  int64  `json:"last_value"`
+}
+
+type OrderStatusHistory struct {
+	ID         int64            `json:"id"`
+	OrderID    int64            `json:"order_id"`
+	FromStatus pgtype.Text      `json:"from_status"`
+	ToStatus   string           `json:"to_status"`
+	Reason     pgtype.Text      `json:"reason"`
+	ActorID    pgtype.Int8      `json:"actor_id"`
+	ActorType  string           `json:"actor_type"`
+	CreatedAt  pgtype.Timestamp `json:"created_at"`
+}
+
+type OrderTimer struct {
+	ID           int64            `json:"id"`
+	OrderID      int64            `json:"order_id"`
+	Kind         string           `json:"kind"`
+	DueAt        pgtype.Timestamp `json:"due_at"`
+	ClaimedUntil pgtype.Timestamp `json:"claimed_until"`
+	Attempts     int32            `json:"attempts"`
+	FiredAt      pgtype.Timestamp `json:"fired_at"`
+	LastError    pgtype.Text      `json:"last_error"`
+	CreatedAt    pgtype.Timestamp `json:"created_at"`
+}
+
+type PushDelivery struct {
+	ID             int64            `json:"id"`
+	NotificationID int64            `json:"notification_id"`
+	DeviceTokenID  int64            `json:"device_token_id"`
+	Status         string           `json:"status"`
+	Attempts       int32            `json:"attempts"`
+	NextAttemptAt  pgtype.Timestamp `json:"next_attempt_at"`
+	ClaimedUntil   pgtype.Timestamp `json:"claimed_until"`
+	LastError      pgtype.Text      `json:"last_error"`
+	SentAt         pgtype.Timestamp `json:"sent_at"`
+	CreatedAt      pgtype.Timestamp `json:"created_at"`
+}
+
+type Receipt struct {
+	ID            int64            `json:"id"`
+	MerchantID    int64            `json:"merchant_id"`
+	UserID        pgtype.Int8      `json:"user_id"`
+	OrderID       pgtype.Int8      `json:"order_id"`
+	TransactionID pgtype.Int8      `json:"transaction_id"`
+	InvoiceNumber string           `json:"invoice_number"`
+	FinancialYear string           `json:"financial_year"`
+	Gstin         pgtype.Text      `json:"gstin"`
+	HtmlKey       string           `json:"html_key"`
+	PdfKey        string           `json:"pdf_key"`
+	CreatedAt     pgtype.Timestamp `json:"created_at"`
 }
 
 type ReferralReward struct {
@@ -115,6 +390,39 @@ type ReferralReward struct {
 	CreatedAt    pgtype.Timestamp `json:"created_at"`
 }
 
+type Review struct {
+	ID               int64            `json:"id"`
+	MerchantID       int64            `json:"merchant_id"`
+	UserID           pgtype.Int8      `json:"user_id"`
+	OrderID          int64            `json:"order_id"`
+	Rating           int16            `json:"rating"`
+	Body             pgtype.Text      `json:"body"`
+	Status           string           `json:"status"`
+	FlagCount        int32            `json:"flag_count"`
+	ModeratedBy      pgtype.Int8      `json:"moderated_by"`
+	ModeratedAt      pgtype.Timestamp `json:"moderated_at"`
+	ModerationReason pgtype.Text      `json:"moderation_reason"`
+	Reply            pgtype.Text      `json:"reply"`
+	RepliedBy        pgtype.Int8      `json:"replied_by"`
+	RepliedAt        pgtype.Timestamp `json:"replied_at"`
+	CreatedAt        pgtype.Timestamp `json:"created_at"`
+	UpdatedAt        pgtype.Timestamp `json:"updated_at"`
+}
+
+type ReviewFlag struct {
+	ReviewID  int64            `json:"review_id"`
+	UserID    int64            `json:"user_id"`
+	Reason    pgtype.Text      `json:"reason"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+}
+
+type ReviewPhoto struct {
+	ID        int64            `json:"id"`
+	ReviewID  int64            `json:"review_id"`
+	ObjectKey string           `json:"object_key"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+}
+
 type Settlement struct {
 	ID                  int64            `json:"id"`
 	MerchantID          pgtype.Int8      `json:"merchant_id"`
@@ -139,20 +447,35 @@ type Transaction struct {
 	TransactionType pgtype.Text      `json:"transaction_type"`
 	Status          pgtype.Text      `json:"status"`
 	CreatedAt       pgtype.Timestamp `json:"created_at"`
+	OrderID         pgtype.Int8      `json:"order_id"`
+	UpdatedAt       pgtype.Timestamp `json:"updated_at"`
 }
 
 type User struct {
-	ID           int64            `json:"id"`
-	Email        string           `json:"email"`
-	PasswordHash pgtype.Text      `json:"password_hash"`
-	Phone        pgtype.Text      `json:"phone"`
-	Name         string           `json:"name"`
-	ProfilePic   pgtype.Text      `json:"profile_pic"`
-	FirebaseUid  pgtype.Text      `json:"firebase_uid"`
-	CoinBalance  pgtype.Numeric   `json:"coin_balance"`
-	Role         string      `json:"role"`
-	ReferralCode pgtype.Text      `json:"referral_code"`
-	ReferredBy   pgtype.Int8      `json:"referred_by"`
-	CreatedAt    pgtype.Timestamp `json:"created_at"`
-	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
+	ID              int64            `json:"id"`
+	Email           string           `json:"email"`
+	PasswordHash    pgtype.Text      `json:"password_hash"`
+	Phone           pgtype.Text      `json:"phone"`
+	Name            string           `json:"name"`
+	ProfilePic      pgtype.Text      `json:"profile_pic"`
+	FirebaseUid     pgtype.Text      `json:"firebase_uid"`
+	CoinBalance     pgtype.Numeric   `json:"coin_balance"`
+	Role            string           `json:"role"`
+	ReferralCode    pgtype.Text      `json:"referral_code"`
+	ReferredBy      pgtype.Int8      `json:"referred_by"`
+	CreatedAt       pgtype.Timestamp `json:"created_at"`
+	UpdatedAt       pgtype.Timestamp `json:"updated_at"`
+	PhoneVerifiedAt pgtype.Timestamp `json:"phone_verified_at"`
+	EmailVerifiedAt pgtype.Timestamp `json:"email_verified_at"`
+}
+
+type UserIdentity struct {
+	ID            int64            `json:"id"`
+	UserID        int64            `json:"user_id"`
+	Provider      string           `json:"provider"`
+	Subject       string           `json:"subject"`
+	Email         pgtype.Text      `json:"email"`
+	EmailVerified pgtype.Bool      `json:"email_verified"`
+	LastLoginAt   pgtype.Timestamp `json:"last_login_at"`
+	CreatedAt     pgtype.Timestamp `json:"created_at"`
 }
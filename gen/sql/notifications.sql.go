@@ -0,0 +1,197 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: notifications.sql
+
+package schema
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countNotifications = `-- name: CountNotifications :one
+SELECT COUNT(*) FROM notifications
+WHERE user_id IS NOT DISTINCT FROM $1
+    AND merchant_id IS NOT DISTINCT FROM $2
+    AND (NOT $3::boolean OR read_at IS NULL)
+`
+
+type CountNotificationsParams struct {
+	UserID     pgtype.Int8 `json:"user_id"`
+	MerchantID pgtype.Int8 `json:"merchant_id"`
+	UnreadOnly bool        `json:"unread_only"`
+}
+
+func (q *Queries) CountNotifications(ctx context.Context, arg CountNotificationsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countNotifications, arg.UserID, arg.MerchantID, arg.UnreadOnly)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createNotification = `-- name: CreateNotification :one
+
+INSERT INTO notifications (user_id, merchant_id, type, title, body, deep_link, read_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, user_id, merchant_id, type, title, body, deep_link, read_at, created_at
+`
+
+type CreateNotificationParams struct {
+	UserID     pgtype.Int8      `json:"user_id"`
+	MerchantID pgtype.Int8      `json:"merchant_id"`
+	Type       string           `json:"type"`
+	Title      string           `json:"title"`
+	Body       string           `json:"body"`
+	DeepLink   pgtype.Text      `json:"deep_link"`
+	ReadAt     pgtype.Timestamp `json:"read_at"`
+}
+
+// Notifications belong to either a user or a merchant; the other id is null.
+// read_at is set for notifications the user keeps out of their inbox
+func (q *Queries) CreateNotification(ctx context.Context, arg CreateNotificationParams) (Notification, error) {
+	row := q.db.QueryRow(ctx, createNotification,
+		arg.UserID,
+		arg.MerchantID,
+		arg.Type,
+		arg.Title,
+		arg.Body,
+		arg.DeepLink,
+		arg.ReadAt,
+	)
+	var i Notification
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.MerchantID,
+		&i.Type,
+		&i.Title,
+		&i.Body,
+		&i.DeepLink,
+		&i.ReadAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listNotifications = `-- name: ListNotifications :many
+SELECT id, user_id, merchant_id, type, title, body, deep_link, read_at, created_at FROM notifications
+WHERE user_id IS NOT DISTINCT FROM $1
+    AND merchant_id IS NOT DISTINCT FROM $2
+    AND (NOT $3::boolean OR read_at IS NULL)
+ORDER BY created_at DESC, id DESC
+LIMIT $5 OFFSET $4
+`
+
+type ListNotificationsParams struct {
+	UserID      pgtype.Int8 `json:"user_id"`
+	MerchantID  pgtype.Int8 `json:"merchant_id"`
+	UnreadOnly  bool        `json:"unread_only"`
+	OffsetCount int32       `json:"offset_count"`
+	LimitCount  int32       `json:"limit_count"`
+}
+
+func (q *Queries) ListNotifications(ctx context.Context, arg ListNotificationsParams) ([]Notification, error) {
+	rows, err := q.db.Query(ctx, listNotifications,
+		arg.UserID,
+		arg.MerchantID,
+		arg.UnreadOnly,
+		arg.OffsetCount,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Notification
+	for rows.Next() {
+		var i Notification
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.MerchantID,
+			&i.Type,
+			&i.Title,
+			&i.Body,
+			&i.DeepLink,
+			&i.ReadAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUnreadNotifications = `-- name: ListUnreadNotifications :many
+SELECT id, user_id, merchant_id, type, title, body, deep_link, read_at, created_at FROM notifications
+WHERE user_id IS NOT DISTINCT FROM $1
+    AND merchant_id IS NOT DISTINCT FROM $2
+    AND read_at IS NULL
+ORDER BY created_at, id
+LIMIT $3
+`
+
+type ListUnreadNotificationsParams struct {
+	UserID     pgtype.Int8 `json:"user_id"`
+	MerchantID pgtype.Int8 `json:"merchant_id"`
+	LimitCount int32       `json:"limit_count"`
+}
+
+// Oldest first, for replaying on a stream
+func (q *Queries) ListUnreadNotifications(ctx context.Context, arg ListUnreadNotificationsParams) ([]Notification, error) {
+	rows, err := q.db.Query(ctx, listUnreadNotifications, arg.UserID, arg.MerchantID, arg.LimitCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Notification
+	for rows.Next() {
+		var i Notification
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.MerchantID,
+			&i.Type,
+			&i.Title,
+			&i.Body,
+			&i.DeepLink,
+			&i.ReadAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markNotificationsRead = `-- name: MarkNotificationsRead :execrows
+UPDATE notifications SET read_at = NOW()
+WHERE user_id IS NOT DISTINCT FROM $1
+    AND merchant_id IS NOT DISTINCT FROM $2
+    AND read_at IS NULL
+    AND ($3::bigint[] IS NULL OR id = ANY($3::bigint[]))
+`
+
+type MarkNotificationsReadParams struct {
+	UserID     pgtype.Int8 `json:"user_id"`
+	MerchantID pgtype.Int8 `json:"merchant_id"`
+	Ids        []int64     `json:"ids"`
+}
+
+func (q *Queries) MarkNotificationsRead(ctx context.Context, arg MarkNotificationsReadParams) (int64, error) {
+	result, err := q.db.Exec(ctx, markNotificationsRead, arg.UserID, arg.MerchantID, arg.Ids)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
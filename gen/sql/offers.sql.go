@@ -0,0 +1,86 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: offers.sql
+
+package schema
+
+import (
+	"context"
+)
+
+const listOffersInArea = `-- name: ListOffersInArea :many
+SELECT offers.id, offers.merchant_id, offers.title, offers.description, offers.discount_percentage, offers.min_amount, offers.max_discount, offers.is_active, offers.valid_from, offers.valid_until, offers.created_at, offers.updated_at,
+       merchant_addresses.latitude::float8 AS latitude,
+       merchant_addresses.longitude::float8 AS longitude,
+       merchants.rating_count,
+       merchants.rating_total
+FROM offers
+JOIN merchants ON merchants.id = offers.merchant_id
+JOIN merchant_addresses ON merchant_addresses.merchant_id = offers.merchant_id
+WHERE offers.is_active = true
+  AND merchants.status = 'approved'
+  AND (offers.valid_from IS NULL OR offers.valid_from <= NOW())
+  AND (offers.valid_until IS NULL OR offers.valid_until > NOW())
+  AND merchant_addresses.latitude BETWEEN $1::float8 AND $2::float8
+  AND merchant_addresses.longitude BETWEEN $3::float8 AND $4::float8
+`
+
+type ListOffersInAreaParams struct {
+	MinLat float64 `json:"min_lat"`
+	MaxLat float64 `json:"max_lat"`
+	MinLng float64 `json:"min_lng"`
+	MaxLng float64 `json:"max_lng"`
+}
+
+type ListOffersInAreaRow struct {
+	Offer       Offer   `json:"offer"`
+	Latitude    float64 `json:"latitude"`
+	Longitude   float64 `json:"longitude"`
+	RatingCount int32   `json:"rating_count"`
+	RatingTotal int64   `json:"rating_total"`
+}
+
+// Live offers of approved merchants with a branch inside the bounding box,
+// one row per offer and branch
+func (q *Queries) ListOffersInArea(ctx context.Context, arg ListOffersInAreaParams) ([]ListOffersInAreaRow, error) {
+	rows, err := q.db.Query(ctx, listOffersInArea,
+		arg.MinLat,
+		arg.MaxLat,
+		arg.MinLng,
+		arg.MaxLng,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListOffersInAreaRow
+	for rows.Next() {
+		var i ListOffersInAreaRow
+		if err := rows.Scan(
+			&i.Offer.ID,
+			&i.Offer.MerchantID,
+			&i.Offer.Title,
+			&i.Offer.Description,
+			&i.Offer.DiscountPercentage,
+			&i.Offer.MinAmount,
+			&i.Offer.MaxDiscount,
+			&i.Offer.IsActive,
+			&i.Offer.ValidFrom,
+			&i.Offer.ValidUntil,
+			&i.Offer.CreatedAt,
+			&i.Offer.UpdatedAt,
+			&i.Latitude,
+			&i.Longitude,
+			&i.RatingCount,
+			&i.RatingTotal,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
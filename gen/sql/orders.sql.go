@@ -11,6 +11,126 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const claimDueOrderTimers = `-- name: ClaimDueOrderTimers :many
+UPDATE order_timers SET
+    claimed_until = $1,
+    attempts = attempts + 1
+WHERE id IN (
+    SELECT due.id FROM order_timers due
+    WHERE due.fired_at IS NULL
+        AND due.due_at <= $2
+        AND (due.claimed_until IS NULL OR due.claimed_until < $2)
+        AND due.attempts < $3
+    ORDER BY due.due_at
+    LIMIT $4
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, order_id, kind, due_at, claimed_until, attempts, fired_at, last_error, created_at
+`
+
+type ClaimDueOrderTimersParams struct {
+	LeaseUntil  pgtype.Timestamp `json:"lease_until"`
+	Now         pgtype.Timestamp `json:"now"`
+	MaxAttempts int32            `json:"max_attempts"`
+	BatchSize   int32            `json:"batch_size"`
+}
+
+// SKIP LOCKED plus the lease keeps instances from picking up the same timer;
+// a claim whose worker died is picked up again once the lease runs out
+func (q *Queries) ClaimDueOrderTimers(ctx context.Context, arg ClaimDueOrderTimersParams) ([]OrderTimer, error) {
+	rows, err := q.db.Query(ctx, claimDueOrderTimers,
+		arg.LeaseUntil,
+		arg.Now,
+		arg.MaxAttempts,
+		arg.BatchSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []OrderTimer
+	for rows.Next() {
+		var i OrderTimer
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrderID,
+			&i.Kind,
+			&i.DueAt,
+			&i.ClaimedUntil,
+			&i.Attempts,
+			&i.FiredAt,
+			&i.LastError,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const completeOrderTimer = `-- name: CompleteOrderTimer :exec
+UPDATE order_timers SET
+    fired_at = $1,
+    claimed_until = NULL,
+    last_error = $2
+WHERE id = $3
+`
+
+type CompleteOrderTimerParams struct {
+	FiredAt   pgtype.Timestamp `json:"fired_at"`
+	LastError pgtype.Text      `json:"last_error"`
+	ID        int64            `json:"id"`
+}
+
+func (q *Queries) CompleteOrderTimer(ctx context.Context, arg CompleteOrderTimerParams) error {
+	_, err := q.db.Exec(ctx, completeOrderTimer, arg.FiredAt, arg.LastError, arg.ID)
+	return err
+}
+
+const countFilteredMerchantOrders = `-- name: CountFilteredMerchantOrders :one
+SELECT COUNT(*) FROM orders
+LEFT JOIN users ON users.id = orders.user_id
+WHERE orders.merchant_id = $1
+    AND ($2::text IS NULL OR orders.status = $2)
+    AND ($3::timestamp IS NULL OR orders.created_at >= $3)
+    AND ($4::timestamp IS NULL OR orders.created_at < $4)
+    AND ($5::bigint[] IS NULL OR orders.outlet_id = ANY($5::bigint[]))
+    AND ($6::numeric IS NULL OR orders.total_amount >= $6)
+    AND ($7::text IS NULL
+        OR orders.order_number ILIKE '%' || $7 || '%'
+        OR users.name ILIKE '%' || $7 || '%'
+        OR users.phone ILIKE '%' || $7 || '%')
+`
+
+type CountFilteredMerchantOrdersParams struct {
+	MerchantID  pgtype.Int8      `json:"merchant_id"`
+	Status      pgtype.Text      `json:"status"`
+	CreatedFrom pgtype.Timestamp `json:"created_from"`
+	CreatedTo   pgtype.Timestamp `json:"created_to"`
+	OutletIds   []int64          `json:"outlet_ids"`
+	MinAmount   pgtype.Numeric   `json:"min_amount"`
+	Search      pgtype.Text      `json:"search"`
+}
+
+func (q *Queries) CountFilteredMerchantOrders(ctx context.Context, arg CountFilteredMerchantOrdersParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countFilteredMerchantOrders,
+		arg.MerchantID,
+		arg.Status,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.OutletIds,
+		arg.MinAmount,
+		arg.Search,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const countMerchantOrders = `-- name: CountMerchantOrders :one
 SELECT COUNT(*) FROM orders WHERE merchant_id = $1
 `
@@ -35,10 +155,10 @@ func (q *Queries) CountUserOrders(ctx context.Context, userID pgtype.Int8) (int6
 
 const createOrder = `-- name: CreateOrder :one
 INSERT INTO orders (
-    merchant_id, user_id, offer_id, order_number, items, subtotal, discount_amount, total_amount, coins_used, status, notes
+    merchant_id, user_id, offer_id, order_number, items, subtotal, discount_amount, total_amount, coins_used, status, notes, outlet_id, number_period
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
-) RETURNING id, merchant_id, user_id, offer_id, order_number, items, subtotal, discount_amount, total_amount, coins_used, status, notes, created_at, updated_at
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
+) RETURNING id, merchant_id, user_id, offer_id, order_number, items, subtotal, discount_amount, total_amount, coins_used, status, notes, created_at, updated_at, status_reason, accepted_at, preparing_at, ready_at, completed_at, cancelled_at, rejected_at, outlet_id, number_period
 `
 
 type CreateOrderParams struct {
@@ -51,8 +171,10 @@ type CreateOrderParams struct {
 	DiscountAmount pgtype.Numeric `json:"discount_amount"`
 	TotalAmount    pgtype.Numeric `json:"total_amount"`
 	CoinsUsed      pgtype.Numeric `json:"coins_used"`
-	Status         pgtype.Text    `json:"status"`
+	Status         string         `json:"status"`
 	Notes          pgtype.Text    `json:"notes"`
+	OutletID       pgtype.Int8    `json:"outlet_id"`
+	NumberPeriod   string         `json:"number_period"`
 }
 
 func (q *Queries) CreateOrder(ctx context.Context, arg CreateOrderParams) (Order, error) {
@@ -68,6 +190,8 @@ func (q *Queries) CreateOrder(ctx context.Context, arg CreateOrderParams) (Order
 		arg.CoinsUsed,
 		arg.Status,
 		arg.Notes,
+		arg.OutletID,
+		arg.NumberPeriod,
 	)
 	var i Order
 	err := row.Scan(
@@ -85,12 +209,78 @@ func (q *Queries) CreateOrder(ctx context.Context, arg CreateOrderParams) (Order
 		&i.Notes,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.StatusReason,
+		&i.AcceptedAt,
+		&i.PreparingAt,
+		&i.ReadyAt,
+		&i.CompletedAt,
+		&i.CancelledAt,
+		&i.RejectedAt,
+		&i.OutletID,
+		&i.NumberPeriod,
+	)
+	return i, err
+}
+
+const createOrderStatusHistory = `-- name: CreateOrderStatusHistory :one
+INSERT INTO order_status_history (
+    order_id, from_status, to_status, reason, actor_id, actor_type
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING id, order_id, from_status, to_status, reason, actor_id, actor_type, created_at
+`
+
+type CreateOrderStatusHistoryParams struct {
+	OrderID    int64       `json:"order_id"`
+	FromStatus pgtype.Text `json:"from_status"`
+	ToStatus   string      `json:"to_status"`
+	Reason     pgtype.Text `json:"reason"`
+	ActorID    pgtype.Int8 `json:"actor_id"`
+	ActorType  string      `json:"actor_type"`
+}
+
+func (q *Queries) CreateOrderStatusHistory(ctx context.Context, arg CreateOrderStatusHistoryParams) (OrderStatusHistory, error) {
+	row := q.db.QueryRow(ctx, createOrderStatusHistory,
+		arg.OrderID,
+		arg.FromStatus,
+		arg.ToStatus,
+		arg.Reason,
+		arg.ActorID,
+		arg.ActorType,
+	)
+	var i OrderStatusHistory
+	err := row.Scan(
+		&i.ID,
+		&i.OrderID,
+		&i.FromStatus,
+		&i.ToStatus,
+		&i.Reason,
+		&i.ActorID,
+		&i.ActorType,
+		&i.CreatedAt,
 	)
 	return i, err
 }
 
+const failOrderTimer = `-- name: FailOrderTimer :exec
+UPDATE order_timers SET
+    last_error = $2
+WHERE id = $1
+`
+
+type FailOrderTimerParams struct {
+	ID        int64       `json:"id"`
+	LastError pgtype.Text `json:"last_error"`
+}
+
+// The claim's lease is kept, so the timer is retried once it runs out
+func (q *Queries) FailOrderTimer(ctx context.Context, arg FailOrderTimerParams) error {
+	_, err := q.db.Exec(ctx, failOrderTimer, arg.ID, arg.LastError)
+	return err
+}
+
 const getMerchantOrders = `-- name: GetMerchantOrders :many
-SELECT id, merchant_id, user_id, offer_id, order_number, items, subtotal, discount_amount, total_amount, coins_used, status, notes, created_at, updated_at FROM orders 
+SELECT id, merchant_id, user_id, offer_id, order_number, items, subtotal, discount_amount, total_amount, coins_used, status, notes, created_at, updated_at, status_reason, accepted_at, preparing_at, ready_at, completed_at, cancelled_at, rejected_at, outlet_id, number_period FROM orders 
 WHERE merchant_id = $1 
 ORDER BY created_at DESC 
 LIMIT $2 OFFSET $3
@@ -126,6 +316,15 @@ func (q *Queries) GetMerchantOrders(ctx context.Context, arg GetMerchantOrdersPa
 			&i.Notes,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.StatusReason,
+			&i.AcceptedAt,
+			&i.PreparingAt,
+			&i.ReadyAt,
+			&i.CompletedAt,
+			&i.CancelledAt,
+			&i.RejectedAt,
+			&i.OutletID,
+			&i.NumberPeriod,
 		); err != nil {
 			return nil, err
 		}
@@ -138,7 +337,7 @@ func (q *Queries) GetMerchantOrders(ctx context.Context, arg GetMerchantOrdersPa
 }
 
 const getMerchantOrdersByStatus = `-- name: GetMerchantOrdersByStatus :many
-SELECT id, merchant_id, user_id, offer_id, order_number, items, subtotal, discount_amount, total_amount, coins_used, status, notes, created_at, updated_at FROM orders 
+SELECT id, merchant_id, user_id, offer_id, order_number, items, subtotal, discount_amount, total_amount, coins_used, status, notes, created_at, updated_at, status_reason, accepted_at, preparing_at, ready_at, completed_at, cancelled_at, rejected_at, outlet_id, number_period FROM orders 
 WHERE merchant_id = $1 AND status = $2
 ORDER BY created_at DESC 
 LIMIT $3 OFFSET $4
@@ -146,7 +345,7 @@ LIMIT $3 OFFSET $4
 
 type GetMerchantOrdersByStatusParams struct {
 	MerchantID pgtype.Int8 `json:"merchant_id"`
-	Status     pgtype.Text `json:"status"`
+	Status     string      `json:"status"`
 	Limit      int32       `json:"limit"`
 	Offset     int32       `json:"offset"`
 }
@@ -180,6 +379,15 @@ func (q *Queries) GetMerchantOrdersByStatus(ctx context.Context, arg GetMerchant
 			&i.Notes,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.StatusReason,
+			&i.AcceptedAt,
+			&i.PreparingAt,
+			&i.ReadyAt,
+			&i.CompletedAt,
+			&i.CancelledAt,
+			&i.RejectedAt,
+			&i.OutletID,
+			&i.NumberPeriod,
 		); err != nil {
 			return nil, err
 		}
@@ -192,7 +400,7 @@ func (q *Queries) GetMerchantOrdersByStatus(ctx context.Context, arg GetMerchant
 }
 
 const getOrderByID = `-- name: GetOrderByID :one
-SELECT id, merchant_id, user_id, offer_id, order_number, items, subtotal, discount_amount, total_amount, coins_used, status, notes, created_at, updated_at FROM orders WHERE id = $1
+SELECT id, merchant_id, user_id, offer_id, order_number, items, subtotal, discount_amount, total_amount, coins_used, status, notes, created_at, updated_at, status_reason, accepted_at, preparing_at, ready_at, completed_at, cancelled_at, rejected_at, outlet_id, number_period FROM orders WHERE id = $1
 `
 
 func (q *Queries) GetOrderByID(ctx context.Context, id int64) (Order, error) {
@@ -213,16 +421,34 @@ func (q *Queries) GetOrderByID(ctx context.Context, id int64) (Order, error) {
 		&i.Notes,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.StatusReason,
+		&i.AcceptedAt,
+		&i.PreparingAt,
+		&i.ReadyAt,
+		&i.CompletedAt,
+		&i.CancelledAt,
+		&i.RejectedAt,
+		&i.OutletID,
+		&i.NumberPeriod,
 	)
 	return i, err
 }
 
 const getOrderByNumber = `-- name: GetOrderByNumber :one
-SELECT id, merchant_id, user_id, offer_id, order_number, items, subtotal, discount_amount, total_amount, coins_used, status, notes, created_at, updated_at FROM orders WHERE order_number = $1
+SELECT id, merchant_id, user_id, offer_id, order_number, items, subtotal, discount_amount, total_amount, coins_used, status, notes, created_at, updated_at, status_reason, accepted_at, preparing_at, ready_at, completed_at, cancelled_at, rejected_at, outlet_id, number_period FROM orders
+WHERE merchant_id = $1 AND order_number = $2
+ORDER BY created_at DESC
+LIMIT 1
 `
 
-func (q *Queries) GetOrderByNumber(ctx context.Context, orderNumber string) (Order, error) {
-	row := q.db.QueryRow(ctx, getOrderByNumber, orderNumber)
+type GetOrderByNumberParams struct {
+	MerchantID  pgtype.Int8 `json:"merchant_id"`
+	OrderNumber string      `json:"order_number"`
+}
+
+// Numbers repeat across periods, the latest order wins
+func (q *Queries) GetOrderByNumber(ctx context.Context, arg GetOrderByNumberParams) (Order, error) {
+	row := q.db.QueryRow(ctx, getOrderByNumber, arg.MerchantID, arg.OrderNumber)
 	var i Order
 	err := row.Scan(
 		&i.ID,
@@ -239,12 +465,21 @@ func (q *Queries) GetOrderByNumber(ctx context.Context, orderNumber string) (Ord
 		&i.Notes,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.StatusReason,
+		&i.AcceptedAt,
+		&i.PreparingAt,
+		&i.ReadyAt,
+		&i.CompletedAt,
+		&i.CancelledAt,
+		&i.RejectedAt,
+		&i.OutletID,
+		&i.NumberPeriod,
 	)
 	return i, err
 }
 
 const getUserOrders = `-- name: GetUserOrders :many
-SELECT id, merchant_id, user_id, offer_id, order_number, items, subtotal, discount_amount, total_amount, coins_used, status, notes, created_at, updated_at FROM orders 
+SELECT id, merchant_id, user_id, offer_id, order_number, items, subtotal, discount_amount, total_amount, coins_used, status, notes, created_at, updated_at, status_reason, accepted_at, preparing_at, ready_at, completed_at, cancelled_at, rejected_at, outlet_id, number_period FROM orders 
 WHERE user_id = $1 
 ORDER BY created_at DESC 
 LIMIT $2 OFFSET $3
@@ -280,6 +515,15 @@ func (q *Queries) GetUserOrders(ctx context.Context, arg GetUserOrdersParams) ([
 			&i.Notes,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.StatusReason,
+			&i.AcceptedAt,
+			&i.PreparingAt,
+			&i.ReadyAt,
+			&i.CompletedAt,
+			&i.CancelledAt,
+			&i.RejectedAt,
+			&i.OutletID,
+			&i.NumberPeriod,
 		); err != nil {
 			return nil, err
 		}
@@ -292,7 +536,7 @@ func (q *Queries) GetUserOrders(ctx context.Context, arg GetUserOrdersParams) ([
 }
 
 const getUserOrdersByStatus = `-- name: GetUserOrdersByStatus :many
-SELECT id, merchant_id, user_id, offer_id, order_number, items, subtotal, discount_amount, total_amount, coins_used, status, notes, created_at, updated_at FROM orders 
+SELECT id, merchant_id, user_id, offer_id, order_number, items, subtotal, discount_amount, total_amount, coins_used, status, notes, created_at, updated_at, status_reason, accepted_at, preparing_at, ready_at, completed_at, cancelled_at, rejected_at, outlet_id, number_period FROM orders 
 WHERE user_id = $1 AND status = $2
 ORDER BY created_at DESC 
 LIMIT $3 OFFSET $4
@@ -300,7 +544,7 @@ LIMIT $3 OFFSET $4
 
 type GetUserOrdersByStatusParams struct {
 	UserID pgtype.Int8 `json:"user_id"`
-	Status pgtype.Text `json:"status"`
+	Status string      `json:"status"`
 	Limit  int32       `json:"limit"`
 	Offset int32       `json:"offset"`
 }
@@ -334,6 +578,15 @@ func (q *Queries) GetUserOrdersByStatus(ctx context.Context, arg GetUserOrdersBy
 			&i.Notes,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.StatusReason,
+			&i.AcceptedAt,
+			&i.PreparingAt,
+			&i.ReadyAt,
+			&i.CompletedAt,
+			&i.CancelledAt,
+			&i.RejectedAt,
+			&i.OutletID,
+			&i.NumberPeriod,
 		); err != nil {
 			return nil, err
 		}
@@ -345,19 +598,262 @@ func (q *Queries) GetUserOrdersByStatus(ctx context.Context, arg GetUserOrdersBy
 	return items, nil
 }
 
-const updateOrderStatus = `-- name: UpdateOrderStatus :exec
+const listMerchantOrders = `-- name: ListMerchantOrders :many
+SELECT orders.id, orders.merchant_id, orders.user_id, orders.offer_id, orders.order_number, orders.items, orders.subtotal, orders.discount_amount, orders.total_amount, orders.coins_used, orders.status, orders.notes, orders.created_at, orders.updated_at, orders.status_reason, orders.accepted_at, orders.preparing_at, orders.ready_at, orders.completed_at, orders.cancelled_at, orders.rejected_at, orders.outlet_id, orders.number_period FROM orders
+LEFT JOIN users ON users.id = orders.user_id
+WHERE orders.merchant_id = $1
+    AND ($2::text IS NULL OR orders.status = $2)
+    AND ($3::timestamp IS NULL OR orders.created_at >= $3)
+    AND ($4::timestamp IS NULL OR orders.created_at < $4)
+    AND ($5::bigint[] IS NULL OR orders.outlet_id = ANY($5::bigint[]))
+    AND ($6::numeric IS NULL OR orders.total_amount >= $6)
+    AND ($7::text IS NULL
+        OR orders.order_number ILIKE '%' || $7 || '%'
+        OR users.name ILIKE '%' || $7 || '%'
+        OR users.phone ILIKE '%' || $7 || '%')
+ORDER BY
+    CASE WHEN $8::text = 'oldest' THEN orders.created_at END ASC,
+    CASE WHEN $8::text = 'amount_desc' THEN orders.total_amount END DESC,
+    CASE WHEN $8::text = 'amount_asc' THEN orders.total_amount END ASC,
+    orders.created_at DESC,
+    orders.id DESC
+LIMIT $10 OFFSET $9
+`
+
+type ListMerchantOrdersParams struct {
+	MerchantID  pgtype.Int8      `json:"merchant_id"`
+	Status      pgtype.Text      `json:"status"`
+	CreatedFrom pgtype.Timestamp `json:"created_from"`
+	CreatedTo   pgtype.Timestamp `json:"created_to"`
+	OutletIds   []int64          `json:"outlet_ids"`
+	MinAmount   pgtype.Numeric   `json:"min_amount"`
+	Search      pgtype.Text      `json:"search"`
+	Sort        string           `json:"sort"`
+	RowOffset   int32            `json:"row_offset"`
+	RowLimit    int32            `json:"row_limit"`
+}
+
+type ListMerchantOrdersRow struct {
+	Order Order `json:"order"`
+}
+
+// Filters are optional (NULL skips them); sort is newest, oldest, amount_desc or amount_asc
+func (q *Queries) ListMerchantOrders(ctx context.Context, arg ListMerchantOrdersParams) ([]ListMerchantOrdersRow, error) {
+	rows, err := q.db.Query(ctx, listMerchantOrders,
+		arg.MerchantID,
+		arg.Status,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.OutletIds,
+		arg.MinAmount,
+		arg.Search,
+		arg.Sort,
+		arg.RowOffset,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListMerchantOrdersRow
+	for rows.Next() {
+		var i ListMerchantOrdersRow
+		if err := rows.Scan(
+			&i.Order.ID,
+			&i.Order.MerchantID,
+			&i.Order.UserID,
+			&i.Order.OfferID,
+			&i.Order.OrderNumber,
+			&i.Order.Items,
+			&i.Order.Subtotal,
+			&i.Order.DiscountAmount,
+			&i.Order.TotalAmount,
+			&i.Order.CoinsUsed,
+			&i.Order.Status,
+			&i.Order.Notes,
+			&i.Order.CreatedAt,
+			&i.Order.UpdatedAt,
+			&i.Order.StatusReason,
+			&i.Order.AcceptedAt,
+			&i.Order.PreparingAt,
+			&i.Order.ReadyAt,
+			&i.Order.CompletedAt,
+			&i.Order.CancelledAt,
+			&i.Order.RejectedAt,
+			&i.Order.OutletID,
+			&i.Order.NumberPeriod,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOrderStatusHistory = `-- name: ListOrderStatusHistory :many
+SELECT id, order_id, from_status, to_status, reason, actor_id, actor_type, created_at FROM order_status_history
+WHERE order_id = $1
+ORDER BY created_at, id
+`
+
+func (q *Queries) ListOrderStatusHistory(ctx context.Context, orderID int64) ([]OrderStatusHistory, error) {
+	rows, err := q.db.Query(ctx, listOrderStatusHistory, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []OrderStatusHistory
+	for rows.Next() {
+		var i OrderStatusHistory
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrderID,
+			&i.FromStatus,
+			&i.ToStatus,
+			&i.Reason,
+			&i.ActorID,
+			&i.ActorType,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const nextOrderNumber = `-- name: NextOrderNumber :one
+INSERT INTO order_number_counters (merchant_id, period, last_value)
+SELECT m.id,
+    CASE WHEN $1::boolean THEN to_char(NOW() AT TIME ZONE m.timezone, 'YYYY-MM-DD') ELSE '' END,
+    1
+FROM merchants m
+WHERE m.id = $2
+ON CONFLICT (merchant_id, period) DO UPDATE SET last_value = order_number_counters.last_value + 1
+RETURNING period, last_value
+`
+
+type NextOrderNumberParams struct {
+	Daily      bool  `json:"daily"`
+	MerchantID int64 `json:"merchant_id"`
+}
+
+type NextOrderNumberRow struct {
+	Period    string `json:"period"`
+	LastValue int64  `json:"last_value"`
+}
+
+// Bumps the merchant's counter for the current period. Daily periods follow the
+// merchant's timezone; the row stays locked until the order commits, so numbers
+// are handed out without gaps.
+func (q *Queries) NextOrderNumber(ctx context.Context, arg NextOrderNumberParams) (NextOrderNumberRow, error) {
+	row := q.db.QueryRow(ctx, nextOrderNumber, arg.Daily, arg.MerchantID)
+	var i NextOrderNumberRow
+	err := row.Scan(&i.Period, &i.LastValue)
+	return i, err
+}
+
+const scheduleOrderTimer = `-- name: ScheduleOrderTimer :one
+INSERT INTO order_timers (
+    order_id, kind, due_at
+) VALUES (
+    $1, $2, $3
+)
+ON CONFLICT (order_id, kind) DO UPDATE SET
+    due_at = EXCLUDED.due_at,
+    claimed_until = NULL,
+    attempts = 0,
+    fired_at = NULL,
+    last_error = NULL
+RETURNING id, order_id, kind, due_at, claimed_until, attempts, fired_at, last_error, created_at
+`
+
+type ScheduleOrderTimerParams struct {
+	OrderID int64            `json:"order_id"`
+	Kind    string           `json:"kind"`
+	DueAt   pgtype.Timestamp `json:"due_at"`
+}
+
+func (q *Queries) ScheduleOrderTimer(ctx context.Context, arg ScheduleOrderTimerParams) (OrderTimer, error) {
+	row := q.db.QueryRow(ctx, scheduleOrderTimer, arg.OrderID, arg.Kind, arg.DueAt)
+	var i OrderTimer
+	err := row.Scan(
+		&i.ID,
+		&i.OrderID,
+		&i.Kind,
+		&i.DueAt,
+		&i.ClaimedUntil,
+		&i.Attempts,
+		&i.FiredAt,
+		&i.LastError,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const transitionOrderStatus = `-- name: TransitionOrderStatus :one
 UPDATE orders SET
-    status = $2,
+    status = $1,
+    status_reason = $2,
+    accepted_at = CASE WHEN $1 = 'accepted' THEN $3::timestamp ELSE accepted_at END,
+    preparing_at = CASE WHEN $1 = 'preparing' THEN $3::timestamp ELSE preparing_at END,
+    ready_at = CASE WHEN $1 = 'ready' THEN $3::timestamp ELSE ready_at END,
+    completed_at = CASE WHEN $1 = 'completed' THEN $3::timestamp ELSE completed_at END,
+    cancelled_at = CASE WHEN $1 = 'cancelled' THEN $3::timestamp ELSE cancelled_at END,
+    rejected_at = CASE WHEN $1 = 'rejected' THEN $3::timestamp ELSE rejected_at END,
     updated_at = NOW()
-WHERE id = $1
+WHERE id = $4 AND status = $5
+RETURNING id, merchant_id, user_id, offer_id, order_number, items, subtotal, discount_amount, total_amount, coins_used, status, notes, created_at, updated_at, status_reason, accepted_at, preparing_at, ready_at, completed_at, cancelled_at, rejected_at, outlet_id, number_period
 `
 
-type UpdateOrderStatusParams struct {
-	ID     int64       `json:"id"`
-	Status pgtype.Text `json:"status"`
+type TransitionOrderStatusParams struct {
+	ToStatus   string           `json:"to_status"`
+	Reason     pgtype.Text      `json:"reason"`
+	ChangedAt  pgtype.Timestamp `json:"changed_at"`
+	ID         int64            `json:"id"`
+	FromStatus string           `json:"from_status"`
 }
 
-func (q *Queries) UpdateOrderStatus(ctx context.Context, arg UpdateOrderStatusParams) error {
-	_, err := q.db.Exec(ctx, updateOrderStatus, arg.ID, arg.Status)
-	return err
+// Only moves the order if it is still in from_status, so a merchant and customer can't both win
+func (q *Queries) TransitionOrderStatus(ctx context.Context, arg TransitionOrderStatusParams) (Order, error) {
+	row := q.db.QueryRow(ctx, transitionOrderStatus,
+		arg.ToStatus,
+		arg.Reason,
+		arg.ChangedAt,
+		arg.ID,
+		arg.FromStatus,
+	)
+	var i Order
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.UserID,
+		&i.OfferID,
+		&i.OrderNumber,
+		&i.Items,
+		&i.Subtotal,
+		&i.DiscountAmount,
+		&i.TotalAmount,
+		&i.CoinsUsed,
+		&i.Status,
+		&i.Notes,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.StatusReason,
+		&i.AcceptedAt,
+		&i.PreparingAt,
+		&i.ReadyAt,
+		&i.CompletedAt,
+		&i.CancelledAt,
+		&i.RejectedAt,
+		&i.OutletID,
+		&i.NumberPeriod,
+	)
+	return i, err
 }
@@ -0,0 +1,416 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: push.sql
+
+package schema
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const claimDuePushDeliveries = `-- name: ClaimDuePushDeliveries :many
+WITH claimed AS (
+    UPDATE push_deliveries SET
+        claimed_until = $1,
+        attempts = attempts + 1
+    WHERE push_deliveries.id IN (
+        SELECT due.id FROM push_deliveries due
+        WHERE due.status = 'pending'
+            AND due.next_attempt_at <= $2
+            AND (due.claimed_until IS NULL OR due.claimed_until < $2)
+        ORDER BY due.next_attempt_at
+        LIMIT $3
+        FOR UPDATE SKIP LOCKED
+    )
+    RETURNING push_deliveries.id, push_deliveries.notification_id, push_deliveries.device_token_id, push_deliveries.attempts
+)
+SELECT claimed.id,
+       claimed.attempts,
+       claimed.device_token_id,
+       device_tokens.token,
+       device_tokens.platform,
+       notifications.id AS notification_id,
+       notifications.type,
+       notifications.title,
+       notifications.body,
+       notifications.deep_link
+FROM claimed
+JOIN device_tokens ON device_tokens.id = claimed.device_token_id
+JOIN notifications ON notifications.id = claimed.notification_id
+`
+
+type ClaimDuePushDeliveriesParams struct {
+	LeaseUntil pgtype.Timestamp `json:"lease_until"`
+	Now        pgtype.Timestamp `json:"now"`
+	BatchSize  int32            `json:"batch_size"`
+}
+
+type ClaimDuePushDeliveriesRow struct {
+	ID             int64       `json:"id"`
+	Attempts       int32       `json:"attempts"`
+	DeviceTokenID  int64       `json:"device_token_id"`
+	Token          string      `json:"token"`
+	Platform       string      `json:"platform"`
+	NotificationID int64       `json:"notification_id"`
+	Type           string      `json:"type"`
+	Title          string      `json:"title"`
+	Body           string      `json:"body"`
+	DeepLink       pgtype.Text `json:"deep_link"`
+}
+
+// Same claim and lease scheme as order timers
+func (q *Queries) ClaimDuePushDeliveries(ctx context.Context, arg ClaimDuePushDeliveriesParams) ([]ClaimDuePushDeliveriesRow, error) {
+	rows, err := q.db.Query(ctx, claimDuePushDeliveries, arg.LeaseUntil, arg.Now, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ClaimDuePushDeliveriesRow
+	for rows.Next() {
+		var i ClaimDuePushDeliveriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Attempts,
+			&i.DeviceTokenID,
+			&i.Token,
+			&i.Platform,
+			&i.NotificationID,
+			&i.Type,
+			&i.Title,
+			&i.Body,
+			&i.DeepLink,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteDeviceToken = `-- name: DeleteDeviceToken :execrows
+DELETE FROM device_tokens WHERE user_id = $1 AND token = $2
+`
+
+type DeleteDeviceTokenParams struct {
+	UserID int64  `json:"user_id"`
+	Token  string `json:"token"`
+}
+
+func (q *Queries) DeleteDeviceToken(ctx context.Context, arg DeleteDeviceTokenParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteDeviceToken, arg.UserID, arg.Token)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteDeviceTokenByID = `-- name: DeleteDeviceTokenByID :exec
+DELETE FROM device_tokens WHERE id = $1
+`
+
+func (q *Queries) DeleteDeviceTokenByID(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, deleteDeviceTokenByID, id)
+	return err
+}
+
+const enqueuePushDeliveries = `-- name: EnqueuePushDeliveries :execrows
+INSERT INTO push_deliveries (notification_id, device_token_id, next_attempt_at)
+SELECT $1, device_tokens.id, $2
+FROM device_tokens
+WHERE device_tokens.user_id = $3
+ON CONFLICT DO NOTHING
+`
+
+type EnqueuePushDeliveriesParams struct {
+	NotificationID int64            `json:"notification_id"`
+	SendAt         pgtype.Timestamp `json:"send_at"`
+	UserID         int64            `json:"user_id"`
+}
+
+func (q *Queries) EnqueuePushDeliveries(ctx context.Context, arg EnqueuePushDeliveriesParams) (int64, error) {
+	result, err := q.db.Exec(ctx, enqueuePushDeliveries, arg.NotificationID, arg.SendAt, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const failPushDelivery = `-- name: FailPushDelivery :exec
+UPDATE push_deliveries SET
+    status = 'failed',
+    last_error = $1,
+    claimed_until = NULL
+WHERE id = $2
+`
+
+type FailPushDeliveryParams struct {
+	LastError pgtype.Text `json:"last_error"`
+	ID        int64       `json:"id"`
+}
+
+func (q *Queries) FailPushDelivery(ctx context.Context, arg FailPushDeliveryParams) error {
+	_, err := q.db.Exec(ctx, failPushDelivery, arg.LastError, arg.ID)
+	return err
+}
+
+const getNotificationSettings = `-- name: GetNotificationSettings :one
+SELECT user_id, timezone, quiet_start, quiet_end, updated_at, locale FROM notification_settings WHERE user_id = $1
+`
+
+func (q *Queries) GetNotificationSettings(ctx context.Context, userID int64) (NotificationSetting, error) {
+	row := q.db.QueryRow(ctx, getNotificationSettings, userID)
+	var i NotificationSetting
+	err := row.Scan(
+		&i.UserID,
+		&i.Timezone,
+		&i.QuietStart,
+		&i.QuietEnd,
+		&i.UpdatedAt,
+		&i.Locale,
+	)
+	return i, err
+}
+
+const listDeviceUsersInArea = `-- name: ListDeviceUsersInArea :many
+SELECT DISTINCT ON (user_id) user_id,
+       latitude::float8 AS latitude,
+       longitude::float8 AS longitude
+FROM device_tokens
+WHERE latitude BETWEEN $1::float8 AND $2::float8
+  AND longitude BETWEEN $3::float8 AND $4::float8
+ORDER BY user_id, last_seen_at DESC
+`
+
+type ListDeviceUsersInAreaParams struct {
+	MinLat float64 `json:"min_lat"`
+	MaxLat float64 `json:"max_lat"`
+	MinLng float64 `json:"min_lng"`
+	MaxLng float64 `json:"max_lng"`
+}
+
+type ListDeviceUsersInAreaRow struct {
+	UserID    int64   `json:"user_id"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+func (q *Queries) ListDeviceUsersInArea(ctx context.Context, arg ListDeviceUsersInAreaParams) ([]ListDeviceUsersInAreaRow, error) {
+	rows, err := q.db.Query(ctx, listDeviceUsersInArea,
+		arg.MinLat,
+		arg.MaxLat,
+		arg.MinLng,
+		arg.MaxLng,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListDeviceUsersInAreaRow
+	for rows.Next() {
+		var i ListDeviceUsersInAreaRow
+		if err := rows.Scan(&i.UserID, &i.Latitude, &i.Longitude); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listNotificationPreferences = `-- name: ListNotificationPreferences :many
+SELECT user_id, category, push, updated_at, email, in_app FROM notification_preferences WHERE user_id = $1
+`
+
+func (q *Queries) ListNotificationPreferences(ctx context.Context, userID int64) ([]NotificationPreference, error) {
+	rows, err := q.db.Query(ctx, listNotificationPreferences, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NotificationPreference
+	for rows.Next() {
+		var i NotificationPreference
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Category,
+			&i.Push,
+			&i.UpdatedAt,
+			&i.Email,
+			&i.InApp,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markPushSent = `-- name: MarkPushSent :exec
+UPDATE push_deliveries SET
+    status = 'sent',
+    sent_at = NOW(),
+    claimed_until = NULL
+WHERE id = $1
+`
+
+func (q *Queries) MarkPushSent(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, markPushSent, id)
+	return err
+}
+
+const retryPushDelivery = `-- name: RetryPushDelivery :exec
+UPDATE push_deliveries SET
+    next_attempt_at = $1,
+    last_error = $2,
+    claimed_until = NULL
+WHERE id = $3
+`
+
+type RetryPushDeliveryParams struct {
+	NextAttemptAt pgtype.Timestamp `json:"next_attempt_at"`
+	LastError     pgtype.Text      `json:"last_error"`
+	ID            int64            `json:"id"`
+}
+
+func (q *Queries) RetryPushDelivery(ctx context.Context, arg RetryPushDeliveryParams) error {
+	_, err := q.db.Exec(ctx, retryPushDelivery, arg.NextAttemptAt, arg.LastError, arg.ID)
+	return err
+}
+
+const setNotificationLocale = `-- name: SetNotificationLocale :exec
+INSERT INTO notification_settings (user_id, locale)
+VALUES ($1, $2)
+ON CONFLICT (user_id) DO UPDATE SET
+    locale = EXCLUDED.locale,
+    updated_at = NOW()
+`
+
+type SetNotificationLocaleParams struct {
+	UserID int64  `json:"user_id"`
+	Locale string `json:"locale"`
+}
+
+func (q *Queries) SetNotificationLocale(ctx context.Context, arg SetNotificationLocaleParams) error {
+	_, err := q.db.Exec(ctx, setNotificationLocale, arg.UserID, arg.Locale)
+	return err
+}
+
+const upsertDeviceToken = `-- name: UpsertDeviceToken :one
+INSERT INTO device_tokens (user_id, token, platform, latitude, longitude)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (token) DO UPDATE SET
+    user_id = EXCLUDED.user_id,
+    platform = EXCLUDED.platform,
+    latitude = COALESCE(EXCLUDED.latitude, device_tokens.latitude),
+    longitude = COALESCE(EXCLUDED.longitude, device_tokens.longitude),
+    last_seen_at = NOW()
+RETURNING id, user_id, token, platform, latitude, longitude, created_at, last_seen_at
+`
+
+type UpsertDeviceTokenParams struct {
+	UserID    int64         `json:"user_id"`
+	Token     string        `json:"token"`
+	Platform  string        `json:"platform"`
+	Latitude  pgtype.Float8 `json:"latitude"`
+	Longitude pgtype.Float8 `json:"longitude"`
+}
+
+// A token moves to whoever registers it last, e.g. after signing in as someone else
+func (q *Queries) UpsertDeviceToken(ctx context.Context, arg UpsertDeviceTokenParams) (DeviceToken, error) {
+	row := q.db.QueryRow(ctx, upsertDeviceToken,
+		arg.UserID,
+		arg.Token,
+		arg.Platform,
+		arg.Latitude,
+		arg.Longitude,
+	)
+	var i DeviceToken
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Token,
+		&i.Platform,
+		&i.Latitude,
+		&i.Longitude,
+		&i.CreatedAt,
+		&i.LastSeenAt,
+	)
+	return i, err
+}
+
+const upsertNotificationPreference = `-- name: UpsertNotificationPreference :exec
+INSERT INTO notification_preferences (user_id, category, push, email, in_app)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (user_id, category) DO UPDATE SET
+    push = EXCLUDED.push,
+    email = EXCLUDED.email,
+    in_app = EXCLUDED.in_app,
+    updated_at = NOW()
+`
+
+type UpsertNotificationPreferenceParams struct {
+	UserID   int64  `json:"user_id"`
+	Category string `json:"category"`
+	Push     bool   `json:"push"`
+	Email    bool   `json:"email"`
+	InApp    bool   `json:"in_app"`
+}
+
+func (q *Queries) UpsertNotificationPreference(ctx context.Context, arg UpsertNotificationPreferenceParams) error {
+	_, err := q.db.Exec(ctx, upsertNotificationPreference,
+		arg.UserID,
+		arg.Category,
+		arg.Push,
+		arg.Email,
+		arg.InApp,
+	)
+	return err
+}
+
+const upsertNotificationSettings = `-- name: UpsertNotificationSettings :one
+INSERT INTO notification_settings (user_id, timezone, quiet_start, quiet_end)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (user_id) DO UPDATE SET
+    timezone = EXCLUDED.timezone,
+    quiet_start = EXCLUDED.quiet_start,
+    quiet_end = EXCLUDED.quiet_end,
+    updated_at = NOW()
+RETURNING user_id, timezone, quiet_start, quiet_end, updated_at, locale
+`
+
+type UpsertNotificationSettingsParams struct {
+	UserID     int64       `json:"user_id"`
+	Timezone   string      `json:"timezone"`
+	QuietStart pgtype.Time `json:"quiet_start"`
+	QuietEnd   pgtype.Time `json:"quiet_end"`
+}
+
+func (q *Queries) UpsertNotificationSettings(ctx context.Context, arg UpsertNotificationSettingsParams) (NotificationSetting, error) {
+	row := q.db.QueryRow(ctx, upsertNotificationSettings,
+		arg.UserID,
+		arg.Timezone,
+		arg.QuietStart,
+		arg.QuietEnd,
+	)
+	var i NotificationSetting
+	err := row.Scan(
+		&i.UserID,
+		&i.Timezone,
+		&i.QuietStart,
+		&i.QuietEnd,
+		&i.UpdatedAt,
+		&i.Locale,
+	)
+	return i, err
+}
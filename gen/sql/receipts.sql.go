@@ -0,0 +1,146 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: receipts.sql
+
+package schema
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createReceipt = `-- name: CreateReceipt :one
+INSERT INTO receipts (
+    merchant_id, user_id, order_id, transaction_id, invoice_number, financial_year, gstin, html_key, pdf_key
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+) RETURNING id, merchant_id, user_id, order_id, transaction_id, invoice_number, financial_year, gstin, html_key, pdf_key, created_at
+`
+
+type CreateReceiptParams struct {
+	MerchantID    int64       `json:"merchant_id"`
+	UserID        pgtype.Int8 `json:"user_id"`
+	OrderID       pgtype.Int8 `json:"order_id"`
+	TransactionID pgtype.Int8 `json:"transaction_id"`
+	InvoiceNumber string      `json:"invoice_number"`
+	FinancialYear string      `json:"financial_year"`
+	Gstin         pgtype.Text `json:"gstin"`
+	HtmlKey       string      `json:"html_key"`
+	PdfKey        string      `json:"pdf_key"`
+}
+
+func (q *Queries) CreateReceipt(ctx context.Context, arg CreateReceiptParams) (Receipt, error) {
+	row := q.db.QueryRow(ctx, createReceipt,
+		arg.MerchantID,
+		arg.UserID,
+		arg.OrderID,
+		arg.TransactionID,
+		arg.InvoiceNumber,
+		arg.FinancialYear,
+		arg.Gstin,
+		arg.HtmlKey,
+		arg.PdfKey,
+	)
+	var i Receipt
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.UserID,
+		&i.OrderID,
+		&i.TransactionID,
+		&i.InvoiceNumber,
+		&i.FinancialYear,
+		&i.Gstin,
+		&i.HtmlKey,
+		&i.PdfKey,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getMerchantGSTIN = `-- name: GetMerchantGSTIN :one
+SELECT document_number FROM merchant_documents
+WHERE merchant_id = $1
+  AND doc_type = 'gst'
+  AND status = 'verified'
+  AND document_number IS NOT NULL
+ORDER BY reviewed_at DESC
+LIMIT 1
+`
+
+// The GSTIN printed on tax invoices comes from the merchant's verified GST document
+func (q *Queries) GetMerchantGSTIN(ctx context.Context, merchantID int64) (pgtype.Text, error) {
+	row := q.db.QueryRow(ctx, getMerchantGSTIN, merchantID)
+	var document_number pgtype.Text
+	err := row.Scan(&document_number)
+	return document_number, err
+}
+
+const getReceiptByOrder = `-- name: GetReceiptByOrder :one
+SELECT id, merchant_id, user_id, order_id, transaction_id, invoice_number, financial_year, gstin, html_key, pdf_key, created_at FROM receipts WHERE order_id = $1
+`
+
+func (q *Queries) GetReceiptByOrder(ctx context.Context, orderID pgtype.Int8) (Receipt, error) {
+	row := q.db.QueryRow(ctx, getReceiptByOrder, orderID)
+	var i Receipt
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.UserID,
+		&i.OrderID,
+		&i.TransactionID,
+		&i.InvoiceNumber,
+		&i.FinancialYear,
+		&i.Gstin,
+		&i.HtmlKey,
+		&i.PdfKey,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getReceiptByTransaction = `-- name: GetReceiptByTransaction :one
+SELECT id, merchant_id, user_id, order_id, transaction_id, invoice_number, financial_year, gstin, html_key, pdf_key, created_at FROM receipts WHERE transaction_id = $1
+`
+
+func (q *Queries) GetReceiptByTransaction(ctx context.Context, transactionID pgtype.Int8) (Receipt, error) {
+	row := q.db.QueryRow(ctx, getReceiptByTransaction, transactionID)
+	var i Receipt
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.UserID,
+		&i.OrderID,
+		&i.TransactionID,
+		&i.InvoiceNumber,
+		&i.FinancialYear,
+		&i.Gstin,
+		&i.HtmlKey,
+		&i.PdfKey,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const nextInvoiceNumber = `-- name: NextInvoiceNumber :one
+INSERT INTO invoice_counters (merchant_id, financial_year, last_value)
+VALUES ($1, $2, 1)
+ON CONFLICT (merchant_id, financial_year) DO UPDATE SET last_value = invoice_counters.last_value + 1
+RETURNING last_value
+`
+
+type NextInvoiceNumberParams struct {
+	MerchantID    int64  `json:"merchant_id"`
+	FinancialYear string `json:"financial_year"`
+}
+
+// Invoice numbers run per merchant per financial year. The counter row stays
+// locked until the receipt commits, so numbers are handed out without gaps.
+func (q *Queries) NextInvoiceNumber(ctx context.Context, arg NextInvoiceNumberParams) (int64, error) {
+	row := q.db.QueryRow(ctx, nextInvoiceNumber, arg.MerchantID, arg.FinancialYear)
+	var last_value int64
+	err := row.Scan(&last_value)
+	return last_value, err
+}
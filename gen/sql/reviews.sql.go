@@ -0,0 +1,444 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: reviews.sql
+
+package schema
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const adjustMerchantRating = `-- name: AdjustMerchantRating :exec
+UPDATE merchants SET
+    rating_count = rating_count + $1,
+    rating_total = rating_total + $2
+WHERE id = $3
+`
+
+type AdjustMerchantRatingParams struct {
+	CountDelta int32 `json:"count_delta"`
+	TotalDelta int64 `json:"total_delta"`
+	ID         int64 `json:"id"`
+}
+
+// Adds (or with negative values removes) published ratings from the merchant's totals
+func (q *Queries) AdjustMerchantRating(ctx context.Context, arg AdjustMerchantRatingParams) error {
+	_, err := q.db.Exec(ctx, adjustMerchantRating, arg.CountDelta, arg.TotalDelta, arg.ID)
+	return err
+}
+
+const countFlaggedReviews = `-- name: CountFlaggedReviews :one
+SELECT COUNT(*) FROM reviews WHERE flag_count > 0
+`
+
+func (q *Queries) CountFlaggedReviews(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, countFlaggedReviews)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countMerchantReviews = `-- name: CountMerchantReviews :one
+SELECT COUNT(*) FROM reviews WHERE merchant_id = $1 AND status = 'published'
+`
+
+func (q *Queries) CountMerchantReviews(ctx context.Context, merchantID int64) (int64, error) {
+	row := q.db.QueryRow(ctx, countMerchantReviews, merchantID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createReview = `-- name: CreateReview :one
+INSERT INTO reviews (
+    merchant_id, user_id, order_id, rating, body
+) VALUES (
+    $1, $2, $3, $4, $5
+) RETURNING id, merchant_id, user_id, order_id, rating, body, status, flag_count, moderated_by, moderated_at, moderation_reason, reply, replied_by, replied_at, created_at, updated_at
+`
+
+type CreateReviewParams struct {
+	MerchantID int64       `json:"merchant_id"`
+	UserID     pgtype.Int8 `json:"user_id"`
+	OrderID    int64       `json:"order_id"`
+	Rating     int16       `json:"rating"`
+	Body       pgtype.Text `json:"body"`
+}
+
+func (q *Queries) CreateReview(ctx context.Context, arg CreateReviewParams) (Review, error) {
+	row := q.db.QueryRow(ctx, createReview,
+		arg.MerchantID,
+		arg.UserID,
+		arg.OrderID,
+		arg.Rating,
+		arg.Body,
+	)
+	var i Review
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.UserID,
+		&i.OrderID,
+		&i.Rating,
+		&i.Body,
+		&i.Status,
+		&i.FlagCount,
+		&i.ModeratedBy,
+		&i.ModeratedAt,
+		&i.ModerationReason,
+		&i.Reply,
+		&i.RepliedBy,
+		&i.RepliedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createReviewPhoto = `-- name: CreateReviewPhoto :one
+INSERT INTO review_photos (review_id, object_key)
+VALUES ($1, $2)
+RETURNING id, review_id, object_key, created_at
+`
+
+type CreateReviewPhotoParams struct {
+	ReviewID  int64  `json:"review_id"`
+	ObjectKey string `json:"object_key"`
+}
+
+func (q *Queries) CreateReviewPhoto(ctx context.Context, arg CreateReviewPhotoParams) (ReviewPhoto, error) {
+	row := q.db.QueryRow(ctx, createReviewPhoto, arg.ReviewID, arg.ObjectKey)
+	var i ReviewPhoto
+	err := row.Scan(
+		&i.ID,
+		&i.ReviewID,
+		&i.ObjectKey,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const flagReview = `-- name: FlagReview :execrows
+INSERT INTO review_flags (review_id, user_id, reason)
+VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING
+`
+
+type FlagReviewParams struct {
+	ReviewID int64       `json:"review_id"`
+	UserID   int64       `json:"user_id"`
+	Reason   pgtype.Text `json:"reason"`
+}
+
+// Does nothing when the user already flagged the review
+func (q *Queries) FlagReview(ctx context.Context, arg FlagReviewParams) (int64, error) {
+	result, err := q.db.Exec(ctx, flagReview, arg.ReviewID, arg.UserID, arg.Reason)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getReview = `-- name: GetReview :one
+SELECT id, merchant_id, user_id, order_id, rating, body, status, flag_count, moderated_by, moderated_at, moderation_reason, reply, replied_by, replied_at, created_at, updated_at FROM reviews WHERE id = $1
+`
+
+func (q *Queries) GetReview(ctx context.Context, id int64) (Review, error) {
+	row := q.db.QueryRow(ctx, getReview, id)
+	var i Review
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.UserID,
+		&i.OrderID,
+		&i.Rating,
+		&i.Body,
+		&i.Status,
+		&i.FlagCount,
+		&i.ModeratedBy,
+		&i.ModeratedAt,
+		&i.ModerationReason,
+		&i.Reply,
+		&i.RepliedBy,
+		&i.RepliedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getReviewByOrder = `-- name: GetReviewByOrder :one
+SELECT id, merchant_id, user_id, order_id, rating, body, status, flag_count, moderated_by, moderated_at, moderation_reason, reply, replied_by, replied_at, created_at, updated_at FROM reviews WHERE order_id = $1
+`
+
+func (q *Queries) GetReviewByOrder(ctx context.Context, orderID int64) (Review, error) {
+	row := q.db.QueryRow(ctx, getReviewByOrder, orderID)
+	var i Review
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.UserID,
+		&i.OrderID,
+		&i.Rating,
+		&i.Body,
+		&i.Status,
+		&i.FlagCount,
+		&i.ModeratedBy,
+		&i.ModeratedAt,
+		&i.ModerationReason,
+		&i.Reply,
+		&i.RepliedBy,
+		&i.RepliedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const incrementReviewFlags = `-- name: IncrementReviewFlags :exec
+UPDATE reviews SET flag_count = flag_count + 1 WHERE id = $1
+`
+
+func (q *Queries) IncrementReviewFlags(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, incrementReviewFlags, id)
+	return err
+}
+
+const listFlaggedReviews = `-- name: ListFlaggedReviews :many
+SELECT id, merchant_id, user_id, order_id, rating, body, status, flag_count, moderated_by, moderated_at, moderation_reason, reply, replied_by, replied_at, created_at, updated_at FROM reviews
+WHERE flag_count > 0
+ORDER BY flag_count DESC, created_at
+LIMIT $1 OFFSET $2
+`
+
+type ListFlaggedReviewsParams struct {
+	Limit  int32 `json:"limit"`
+	Offset int32 `json:"offset"`
+}
+
+func (q *Queries) ListFlaggedReviews(ctx context.Context, arg ListFlaggedReviewsParams) ([]Review, error) {
+	rows, err := q.db.Query(ctx, listFlaggedReviews, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Review
+	for rows.Next() {
+		var i Review
+		if err := rows.Scan(
+			&i.ID,
+			&i.MerchantID,
+			&i.UserID,
+			&i.OrderID,
+			&i.Rating,
+			&i.Body,
+			&i.Status,
+			&i.FlagCount,
+			&i.ModeratedBy,
+			&i.ModeratedAt,
+			&i.ModerationReason,
+			&i.Reply,
+			&i.RepliedBy,
+			&i.RepliedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMerchantReviews = `-- name: ListMerchantReviews :many
+SELECT reviews.id, reviews.merchant_id, reviews.user_id, reviews.order_id, reviews.rating, reviews.body, reviews.status, reviews.flag_count, reviews.moderated_by, reviews.moderated_at, reviews.moderation_reason, reviews.reply, reviews.replied_by, reviews.replied_at, reviews.created_at, reviews.updated_at, users.name AS user_name
+FROM reviews
+LEFT JOIN users ON users.id = reviews.user_id
+WHERE reviews.merchant_id = $1 AND reviews.status = 'published'
+ORDER BY reviews.created_at DESC, reviews.id DESC
+LIMIT $2 OFFSET $3
+`
+
+type ListMerchantReviewsParams struct {
+	MerchantID int64 `json:"merchant_id"`
+	Limit      int32 `json:"limit"`
+	Offset     int32 `json:"offset"`
+}
+
+type ListMerchantReviewsRow struct {
+	Review   Review      `json:"review"`
+	UserName pgtype.Text `json:"user_name"`
+}
+
+func (q *Queries) ListMerchantReviews(ctx context.Context, arg ListMerchantReviewsParams) ([]ListMerchantReviewsRow, error) {
+	rows, err := q.db.Query(ctx, listMerchantReviews, arg.MerchantID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListMerchantReviewsRow
+	for rows.Next() {
+		var i ListMerchantReviewsRow
+		if err := rows.Scan(
+			&i.Review.ID,
+			&i.Review.MerchantID,
+			&i.Review.UserID,
+			&i.Review.OrderID,
+			&i.Review.Rating,
+			&i.Review.Body,
+			&i.Review.Status,
+			&i.Review.FlagCount,
+			&i.Review.ModeratedBy,
+			&i.Review.ModeratedAt,
+			&i.Review.ModerationReason,
+			&i.Review.Reply,
+			&i.Review.RepliedBy,
+			&i.Review.RepliedAt,
+			&i.Review.CreatedAt,
+			&i.Review.UpdatedAt,
+			&i.UserName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReviewPhotos = `-- name: ListReviewPhotos :many
+SELECT id, review_id, object_key, created_at FROM review_photos
+WHERE review_id = ANY($1::bigint[])
+ORDER BY review_id, id
+`
+
+func (q *Queries) ListReviewPhotos(ctx context.Context, reviewIds []int64) ([]ReviewPhoto, error) {
+	rows, err := q.db.Query(ctx, listReviewPhotos, reviewIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReviewPhoto
+	for rows.Next() {
+		var i ReviewPhoto
+		if err := rows.Scan(
+			&i.ID,
+			&i.ReviewID,
+			&i.ObjectKey,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const moderateReview = `-- name: ModerateReview :one
+UPDATE reviews SET
+    status = $1,
+    flag_count = 0,
+    moderated_by = $2,
+    moderated_at = NOW(),
+    moderation_reason = $3,
+    updated_at = NOW()
+WHERE id = $4 AND status = $5
+RETURNING id, merchant_id, user_id, order_id, rating, body, status, flag_count, moderated_by, moderated_at, moderation_reason, reply, replied_by, replied_at, created_at, updated_at
+`
+
+type ModerateReviewParams struct {
+	ToStatus    string      `json:"to_status"`
+	ModeratedBy pgtype.Int8 `json:"moderated_by"`
+	Reason      pgtype.Text `json:"reason"`
+	ID          int64       `json:"id"`
+	FromStatus  string      `json:"from_status"`
+}
+
+// Only moves the review if it is still in from_status, so the rating totals
+// are adjusted once. Moderating settles the open flags.
+func (q *Queries) ModerateReview(ctx context.Context, arg ModerateReviewParams) (Review, error) {
+	row := q.db.QueryRow(ctx, moderateReview,
+		arg.ToStatus,
+		arg.ModeratedBy,
+		arg.Reason,
+		arg.ID,
+		arg.FromStatus,
+	)
+	var i Review
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.UserID,
+		&i.OrderID,
+		&i.Rating,
+		&i.Body,
+		&i.Status,
+		&i.FlagCount,
+		&i.ModeratedBy,
+		&i.ModeratedAt,
+		&i.ModerationReason,
+		&i.Reply,
+		&i.RepliedBy,
+		&i.RepliedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const replyToReview = `-- name: ReplyToReview :one
+UPDATE reviews SET
+    reply = $3,
+    replied_by = $4,
+    replied_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1 AND merchant_id = $2
+RETURNING id, merchant_id, user_id, order_id, rating, body, status, flag_count, moderated_by, moderated_at, moderation_reason, reply, replied_by, replied_at, created_at, updated_at
+`
+
+type ReplyToReviewParams struct {
+	ID         int64       `json:"id"`
+	MerchantID int64       `json:"merchant_id"`
+	Reply      pgtype.Text `json:"reply"`
+	RepliedBy  pgtype.Int8 `json:"replied_by"`
+}
+
+func (q *Queries) ReplyToReview(ctx context.Context, arg ReplyToReviewParams) (Review, error) {
+	row := q.db.QueryRow(ctx, replyToReview,
+		arg.ID,
+		arg.MerchantID,
+		arg.Reply,
+		arg.RepliedBy,
+	)
+	var i Review
+	err := row.Scan(
+		&i.ID,
+		&i.MerchantID,
+		&i.UserID,
+		&i.OrderID,
+		&i.Rating,
+		&i.Body,
+		&i.Status,
+		&i.FlagCount,
+		&i.ModeratedBy,
+		&i.ModeratedAt,
+		&i.ModerationReason,
+		&i.Reply,
+		&i.RepliedBy,
+		&i.RepliedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
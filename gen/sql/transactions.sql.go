@@ -96,10 +96,10 @@ func (q *Queries) CreateSettlement(ctx context.Context, arg CreateSettlementPara
 const createTransaction = `-- name: CreateTransaction :one
 INSERT INTO transactions (
     user_id, merchant_id, coins_spent, original_amount, discount_amount, final_amount,
-    transaction_type, status
+    transaction_type, status, order_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8
-) RETURNING id, user_id, merchant_id, coins_spent, original_amount, discount_amount, final_amount, transaction_type, status, created_at
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+) RETURNING id, user_id, merchant_id, coins_spent, original_amount, discount_amount, final_amount, transaction_type, status, created_at, order_id, updated_at
 `
 
 type CreateTransactionParams struct {
@@ -111,6 +111,7 @@ type CreateTransactionParams struct {
 	FinalAmount     pgtype.Numeric `json:"final_amount"`
 	TransactionType pgtype.Text    `json:"transaction_type"`
 	Status          pgtype.Text    `json:"status"`
+	OrderID         pgtype.Int8    `json:"order_id"`
 }
 
 func (q *Queries) CreateTransaction(ctx context.Context, arg CreateTransactionParams) (Transaction, error) {
@@ -123,6 +124,7 @@ func (q *Queries) CreateTransaction(ctx context.Context, arg CreateTransactionPa
 		arg.FinalAmount,
 		arg.TransactionType,
 		arg.Status,
+		arg.OrderID,
 	)
 	var i Transaction
 	err := row.Scan(
@@ -136,12 +138,14 @@ func (q *Queries) CreateTransaction(ctx context.Context, arg CreateTransactionPa
 		&i.TransactionType,
 		&i.Status,
 		&i.CreatedAt,
+		&i.OrderID,
+		&i.UpdatedAt,
 	)
 	return i, err
 }
 
 const getAllTransactions = `-- name: GetAllTransactions :many
-SELECT id, user_id, merchant_id, coins_spent, original_amount, discount_amount, final_amount, transaction_type, status, created_at FROM transactions 
+SELECT id, user_id, merchant_id, coins_spent, original_amount, discount_amount, final_amount, transaction_type, status, created_at, order_id, updated_at FROM transactions 
 ORDER BY created_at DESC 
 LIMIT $1 OFFSET $2
 `
@@ -171,6 +175,8 @@ func (q *Queries) GetAllTransactions(ctx context.Context, arg GetAllTransactions
 			&i.TransactionType,
 			&i.Status,
 			&i.CreatedAt,
+			&i.OrderID,
+			&i.UpdatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -246,6 +252,31 @@ func (q *Queries) GetMerchantSettlements(ctx context.Context, arg GetMerchantSet
 	return items, nil
 }
 
+const getOrderPayment = `-- name: GetOrderPayment :one
+SELECT id, user_id, merchant_id, coins_spent, original_amount, discount_amount, final_amount, transaction_type, status, created_at, order_id, updated_at FROM transactions
+WHERE order_id = $1 AND transaction_type = 'order_payment'
+`
+
+func (q *Queries) GetOrderPayment(ctx context.Context, orderID pgtype.Int8) (Transaction, error) {
+	row := q.db.QueryRow(ctx, getOrderPayment, orderID)
+	var i Transaction
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.MerchantID,
+		&i.CoinsSpent,
+		&i.OriginalAmount,
+		&i.DiscountAmount,
+		&i.FinalAmount,
+		&i.TransactionType,
+		&i.Status,
+		&i.CreatedAt,
+		&i.OrderID,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const getSettlementByID = `-- name: GetSettlementByID :one
 SELECT id, merchant_id, period_start, period_end, total_transactions, total_discount_amount, settlement_amount, status, paid_at, created_at FROM settlements WHERE id = $1
 `
@@ -269,7 +300,7 @@ func (q *Queries) GetSettlementByID(ctx context.Context, id int64) (Settlement,
 }
 
 const getTransactionByID = `-- name: GetTransactionByID :one
-SELECT id, user_id, merchant_id, coins_spent, original_amount, discount_amount, final_amount, transaction_type, status, created_at FROM transactions WHERE id = $1
+SELECT id, user_id, merchant_id, coins_spent, original_amount, discount_amount, final_amount, transaction_type, status, created_at, order_id, updated_at FROM transactions WHERE id = $1
 `
 
 func (q *Queries) GetTransactionByID(ctx context.Context, id int64) (Transaction, error) {
@@ -286,6 +317,8 @@ func (q *Queries) GetTransactionByID(ctx context.Context, id int64) (Transaction
 		&i.TransactionType,
 		&i.Status,
 		&i.CreatedAt,
+		&i.OrderID,
+		&i.UpdatedAt,
 	)
 	return i, err
 }
@@ -41,7 +41,7 @@ func (q *Queries) DleteUser(ctx context.Context, id int64) error {
 }
 
 const getAllUsers = `-- name: GetAllUsers :many
-SELECT id, email, password_hash, phone, name, profile_pic, firebase_uid, coin_balance, role, referral_code, referred_by, created_at, updated_at FROM users ORDER BY created_at DESC LIMIT $1 OFFSET $2
+SELECT id, email, password_hash, phone, name, profile_pic, firebase_uid, coin_balance, role, referral_code, referred_by, created_at, updated_at, phone_verified_at, email_verified_at FROM users ORDER BY created_at DESC LIMIT $1 OFFSET $2
 `
 
 type GetAllUsersParams struct {
@@ -72,6 +72,8 @@ func (q *Queries) GetAllUsers(ctx context.Context, arg GetAllUsersParams) ([]Use
 			&i.ReferredBy,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.PhoneVerifiedAt,
+			&i.EmailVerifiedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -130,7 +132,7 @@ func (q *Queries) GetUserCoinPurchases(ctx context.Context, arg GetUserCoinPurch
 }
 
 const getUserProfile = `-- name: GetUserProfile :one
-SELECT id, email, password_hash, phone, name, profile_pic, firebase_uid, coin_balance, role, referral_code, referred_by, created_at, updated_at FROM users WHERE id = $1
+SELECT id, email, password_hash, phone, name, profile_pic, firebase_uid, coin_balance, role, referral_code, referred_by, created_at, updated_at, phone_verified_at, email_verified_at FROM users WHERE id = $1
 `
 
 func (q *Queries) GetUserProfile(ctx context.Context, id int64) (User, error) {
@@ -150,6 +152,8 @@ func (q *Queries) GetUserProfile(ctx context.Context, id int64) (User, error) {
 		&i.ReferredBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.PhoneVerifiedAt,
+		&i.EmailVerifiedAt,
 	)
 	return i, err
 }
@@ -201,7 +205,7 @@ func (q *Queries) GetUserReferralRewards(ctx context.Context, arg GetUserReferra
 }
 
 const getUserTransactions = `-- name: GetUserTransactions :many
-SELECT id, user_id, merchant_id, coins_spent, original_amount, discount_amount, final_amount, transaction_type, status, created_at
+SELECT id, user_id, merchant_id, coins_spent, original_amount, discount_amount, final_amount, transaction_type, status, created_at, order_id, updated_at
 FROM transactions
 WHERE
     user_id = $1
@@ -237,6 +241,8 @@ func (q *Queries) GetUserTransactions(ctx context.Context, arg GetUserTransactio
 			&i.TransactionType,
 			&i.Status,
 			&i.CreatedAt,
+			&i.OrderID,
+			&i.UpdatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -294,6 +300,8 @@ UPDATE users
 SET
     name = $2,
     phone = $3,
+    -- A new number has to be verified again
+    phone_verified_at = CASE WHEN phone IS DISTINCT FROM $3 THEN NULL ELSE phone_verified_at END,
     profile_pic = $4,
     updated_at = NOW()
 WHERE
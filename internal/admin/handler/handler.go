@@ -2,10 +2,17 @@ package handler
 
 import (
 	"context"
+	"errors"
 
 	adminpb "rival/gen/proto/proto/api"
 	"rival/internal/admin/repo"
 	"rival/internal/admin/service"
+	merchantrepo "rival/internal/merchants/repo"
+	merchantservice "rival/internal/merchants/service"
+	reviewrepo "rival/internal/reviews/repo"
+	reviewservice "rival/internal/reviews/service"
+	"rival/pkg/alerts"
+	"rival/pkg/notify"
 )
 
 type AdminHandler struct {
@@ -19,7 +26,30 @@ func NewAdminHandler() (*AdminHandler, error) {
 		return nil, err
 	}
 
-	adminService := service.NewAdminService(repository)
+	onboardingRepository, err := merchantrepo.NewOnboardingRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	documentRepository, err := merchantrepo.NewDocumentRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	reviewRepository, err := reviewrepo.NewReviewRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	notifier, err := notify.NewServiceFromConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	documentService := merchantservice.NewDocumentService(documentRepository, notifier)
+	onboardingService := merchantservice.NewOnboardingService(onboardingRepository, documentService, notifier)
+	reviewService := reviewservice.NewReviewService(reviewRepository)
+	adminService := service.NewAdminService(repository, onboardingService, documentService, reviewService)
 
 	return &AdminHandler{
 		service: adminService,
@@ -37,19 +67,87 @@ func (h *AdminHandler) GetAllMerchants(ctx context.Context, req *adminpb.GetAllM
 	if req.Limit <= 0 {
 		req.Limit = 10
 	}
-	return h.service.GetAllMerchants(ctx, req.Page, req.Limit)
+	return h.service.GetAllMerchants(ctx, req.Page, req.Limit, req.Status)
 }
 
 func (h *AdminHandler) ApproveMerchant(ctx context.Context, req *adminpb.ApproveMerchantRequest) (*adminpb.ApproveMerchantResponse, error) {
-	return &adminpb.ApproveMerchantResponse{
-		Success: true,
-	}, nil
+	if req.MerchantId == 0 {
+		return nil, errors.New("merchant ID is required")
+	}
+	return h.service.ApproveMerchant(ctx, int(req.MerchantId), adminIDFromContext(ctx), req.Reason)
 }
 
 func (h *AdminHandler) SuspendMerchant(ctx context.Context, req *adminpb.SuspendMerchantRequest) (*adminpb.SuspendMerchantResponse, error) {
-	return &adminpb.SuspendMerchantResponse{
-		Success: true,
-	}, nil
+	if req.MerchantId == 0 {
+		return nil, errors.New("merchant ID is required")
+	}
+	return h.service.SuspendMerchant(ctx, int(req.MerchantId), adminIDFromContext(ctx), req.Reason)
+}
+
+func (h *AdminHandler) StartMerchantReview(ctx context.Context, req *adminpb.StartMerchantReviewRequest) (*adminpb.StartMerchantReviewResponse, error) {
+	if req.MerchantId == 0 {
+		return nil, errors.New("merchant ID is required")
+	}
+	return h.service.StartMerchantReview(ctx, int(req.MerchantId), adminIDFromContext(ctx))
+}
+
+func (h *AdminHandler) RejectMerchant(ctx context.Context, req *adminpb.RejectMerchantRequest) (*adminpb.RejectMerchantResponse, error) {
+	if req.MerchantId == 0 {
+		return nil, errors.New("merchant ID is required")
+	}
+	return h.service.RejectMerchant(ctx, int(req.MerchantId), adminIDFromContext(ctx), req.Reason)
+}
+
+func (h *AdminHandler) ReinstateMerchant(ctx context.Context, req *adminpb.ReinstateMerchantRequest) (*adminpb.ReinstateMerchantResponse, error) {
+	if req.MerchantId == 0 {
+		return nil, errors.New("merchant ID is required")
+	}
+	return h.service.ReinstateMerchant(ctx, int(req.MerchantId), adminIDFromContext(ctx), req.Reason)
+}
+
+func (h *AdminHandler) GetMerchantStatusHistory(ctx context.Context, req *adminpb.GetMerchantStatusHistoryRequest) (*adminpb.GetMerchantStatusHistoryResponse, error) {
+	if req.MerchantId == 0 {
+		return nil, errors.New("merchant ID is required")
+	}
+	return h.service.GetMerchantStatusHistory(ctx, int(req.MerchantId))
+}
+
+func (h *AdminHandler) ListMerchantDocuments(ctx context.Context, req *adminpb.ListMerchantDocumentsRequest) (*adminpb.ListMerchantDocumentsResponse, error) {
+	if req.MerchantId == 0 {
+		return nil, errors.New("merchant ID is required")
+	}
+	return h.service.ListMerchantDocuments(ctx, int(req.MerchantId))
+}
+
+func (h *AdminHandler) VerifyMerchantDocument(ctx context.Context, req *adminpb.VerifyMerchantDocumentRequest) (*adminpb.VerifyMerchantDocumentResponse, error) {
+	if req.DocumentId == 0 {
+		return nil, errors.New("document ID is required")
+	}
+	return h.service.VerifyMerchantDocument(ctx, req.DocumentId, adminIDFromContext(ctx))
+}
+
+func (h *AdminHandler) RejectMerchantDocument(ctx context.Context, req *adminpb.RejectMerchantDocumentRequest) (*adminpb.RejectMerchantDocumentResponse, error) {
+	if req.DocumentId == 0 {
+		return nil, errors.New("document ID is required")
+	}
+	return h.service.RejectMerchantDocument(ctx, req.DocumentId, adminIDFromContext(ctx), req.Reason)
+}
+
+func (h *AdminHandler) ListFlaggedReviews(ctx context.Context, req *adminpb.ListFlaggedReviewsRequest) (*adminpb.ListFlaggedReviewsResponse, error) {
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.Limit <= 0 {
+		req.Limit = 10
+	}
+	return h.service.ListFlaggedReviews(ctx, req.Page, req.Limit)
+}
+
+func (h *AdminHandler) ModerateReview(ctx context.Context, req *adminpb.ModerateReviewRequest) (*adminpb.ModerateReviewResponse, error) {
+	if req.ReviewId == 0 {
+		return nil, errors.New("review ID is required")
+	}
+	return h.service.ModerateReview(ctx, req.ReviewId, adminIDFromContext(ctx), req.Action, req.Reason)
 }
 
 func (h *AdminHandler) GetAllUsers(ctx context.Context, req *adminpb.GetAllUsersRequest) (*adminpb.GetAllUsersResponse, error) {
@@ -83,3 +181,29 @@ func (h *AdminHandler) GetAuditLogs(ctx context.Context, req *adminpb.GetAuditLo
 		TotalCount: 0,
 	}, nil
 }
+
+func (h *AdminHandler) StreamSystemAlerts(req *adminpb.StreamSystemAlertsRequest, stream adminpb.AdminService_StreamSystemAlertsServer) error {
+	ch := alerts.Subscribe()
+	defer ch.Close()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case data, ok := <-ch.Receive():
+			if !ok {
+				return nil
+			}
+			if alert, ok := data.(*adminpb.StreamSystemAlertsResponse); ok {
+				if err := stream.Send(alert); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+func adminIDFromContext(ctx context.Context) int64 {
+	userID, _ := ctx.Value("user_id").(int)
+	return int64(userID)
+}
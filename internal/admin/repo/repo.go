@@ -16,6 +16,7 @@ type AdminRepository interface {
 	GetTotalTransactionVolume(ctx context.Context) (float64, error)
 	GetPendingMerchantApprovals(ctx context.Context) (int32, error)
 	GetAllMerchants(ctx context.Context, limit, offset int32) ([]schema.Merchant, error)
+	GetMerchantsByStatuses(ctx context.Context, statuses []string, limit, offset int32) ([]schema.Merchant, error)
 	GetAllUsers(ctx context.Context, limit, offset int32) ([]schema.User, error)
 	GetAllTransactions(ctx context.Context, limit, offset int32) ([]schema.Transaction, error)
 }
@@ -59,7 +60,8 @@ func (r *adminRepository) GetTotalTransactionVolume(ctx context.Context) (float6
 }
 
 func (r *adminRepository) GetPendingMerchantApprovals(ctx context.Context) (int32, error) {
-	return 0, nil // Implement when merchant approval system is added
+	count, err := r.queries.CountPendingMerchants(ctx)
+	return int32(count), err
 }
 
 func (r *adminRepository) GetAllMerchants(ctx context.Context, limit, offset int32) ([]schema.Merchant, error) {
@@ -69,6 +71,14 @@ func (r *adminRepository) GetAllMerchants(ctx context.Context, limit, offset int
 	})
 }
 
+func (r *adminRepository) GetMerchantsByStatuses(ctx context.Context, statuses []string, limit, offset int32) ([]schema.Merchant, error) {
+	return r.queries.GetMerchantsByStatuses(ctx, schema.GetMerchantsByStatusesParams{
+		Statuses:  statuses,
+		RowLimit:  limit,
+		RowOffset: offset,
+	})
+}
+
 func (r *adminRepository) GetAllUsers(ctx context.Context, limit, offset int32) ([]schema.User, error) {
 	return r.queries.GetAllUsers(ctx, schema.GetAllUsersParams{
 		Limit:  limit,
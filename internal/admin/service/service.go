@@ -7,22 +7,46 @@ import (
 	schemapb "rival/gen/proto/proto/schema"
 	schema "rival/gen/sql"
 	"rival/internal/admin/repo"
+	merchantservice "rival/internal/merchants/service"
+	merchantutil "rival/internal/merchants/util"
+	reviewservice "rival/internal/reviews/service"
+	reviewutil "rival/internal/reviews/util"
+	"rival/pkg/audit"
 	"rival/pkg/utils"
 )
 
 type AdminService interface {
 	GetDashboardStats(ctx context.Context) (*adminpb.GetAdminDashboardStatsResponse, error)
-	GetAllMerchants(ctx context.Context, page, limit int32) (*adminpb.GetAllMerchantsResponse, error)
+	GetAllMerchants(ctx context.Context, page, limit int32, status string) (*adminpb.GetAllMerchantsResponse, error)
+	ApproveMerchant(ctx context.Context, merchantID int, adminID int64, reason string) (*adminpb.ApproveMerchantResponse, error)
+	SuspendMerchant(ctx context.Context, merchantID int, adminID int64, reason string) (*adminpb.SuspendMerchantResponse, error)
+	StartMerchantReview(ctx context.Context, merchantID int, adminID int64) (*adminpb.StartMerchantReviewResponse, error)
+	RejectMerchant(ctx context.Context, merchantID int, adminID int64, reason string) (*adminpb.RejectMerchantResponse, error)
+	ReinstateMerchant(ctx context.Context, merchantID int, adminID int64, reason string) (*adminpb.ReinstateMerchantResponse, error)
+	GetMerchantStatusHistory(ctx context.Context, merchantID int) (*adminpb.GetMerchantStatusHistoryResponse, error)
+	ListMerchantDocuments(ctx context.Context, merchantID int) (*adminpb.ListMerchantDocumentsResponse, error)
+	VerifyMerchantDocument(ctx context.Context, documentID, adminID int64) (*adminpb.VerifyMerchantDocumentResponse, error)
+	RejectMerchantDocument(ctx context.Context, documentID, adminID int64, reason string) (*adminpb.RejectMerchantDocumentResponse, error)
+	ListFlaggedReviews(ctx context.Context, page, limit int32) (*adminpb.ListFlaggedReviewsResponse, error)
+	ModerateReview(ctx context.Context, reviewID, adminID int64, action, reason string) (*adminpb.ModerateReviewResponse, error)
 	GetAllUsers(ctx context.Context, page, limit int32) (*adminpb.GetAllUsersResponse, error)
 	GetAllTransactions(ctx context.Context, page, limit int32) (*adminpb.GetAllTransactionsResponse, error)
 }
 
 type adminService struct {
-	repo repo.AdminRepository
+	repo       repo.AdminRepository
+	onboarding merchantservice.OnboardingService
+	documents  merchantservice.DocumentService
+	reviews    reviewservice.ReviewService
 }
 
-func NewAdminService(repo repo.AdminRepository) AdminService {
-	return &adminService{repo: repo}
+func NewAdminService(repo repo.AdminRepository, onboarding merchantservice.OnboardingService, documents merchantservice.DocumentService, reviews reviewservice.ReviewService) AdminService {
+	return &adminService{
+		repo:       repo,
+		onboarding: onboarding,
+		documents:  documents,
+		reviews:    reviews,
+	}
 }
 
 func (s *adminService) GetDashboardStats(ctx context.Context) (*adminpb.GetAdminDashboardStatsResponse, error) {
@@ -41,9 +65,16 @@ func (s *adminService) GetDashboardStats(ctx context.Context) (*adminpb.GetAdmin
 	}, nil
 }
 
-func (s *adminService) GetAllMerchants(ctx context.Context, page, limit int32) (*adminpb.GetAllMerchantsResponse, error) {
+func (s *adminService) GetAllMerchants(ctx context.Context, page, limit int32, status string) (*adminpb.GetAllMerchantsResponse, error) {
 	offset := (page - 1) * limit
-	merchants, err := s.repo.GetAllMerchants(ctx, limit, offset)
+
+	var merchants []schema.Merchant
+	var err error
+	if statuses := merchantStatusFilter(status); statuses != nil {
+		merchants, err = s.repo.GetMerchantsByStatuses(ctx, statuses, limit, offset)
+	} else {
+		merchants, err = s.repo.GetAllMerchants(ctx, limit, offset)
+	}
 	if err != nil {
 		return &adminpb.GetAllMerchantsResponse{}, err
 	}
@@ -59,6 +90,128 @@ func (s *adminService) GetAllMerchants(ctx context.Context, page, limit int32) (
 	}, nil
 }
 
+// merchantStatusFilter maps the status filter of GetAllMerchants to merchant
+// states, nil means no filter.
+func merchantStatusFilter(status string) []string {
+	switch status {
+	case "", "all":
+		return nil
+	case "active":
+		return []string{merchantutil.StatusApproved}
+	case "pending":
+		return []string{merchantutil.StatusSubmitted, merchantutil.StatusUnderReview}
+	}
+	return []string{status}
+}
+
+func (s *adminService) ApproveMerchant(ctx context.Context, merchantID int, adminID int64, reason string) (*adminpb.ApproveMerchantResponse, error) {
+	merchant, err := s.transitionMerchant(ctx, merchantID, merchantutil.StatusApproved, adminID, reason,
+		merchantutil.StatusSubmitted, merchantutil.StatusUnderReview)
+	if err != nil {
+		return nil, err
+	}
+	return &adminpb.ApproveMerchantResponse{Success: true, Merchant: merchant}, nil
+}
+
+func (s *adminService) SuspendMerchant(ctx context.Context, merchantID int, adminID int64, reason string) (*adminpb.SuspendMerchantResponse, error) {
+	merchant, err := s.transitionMerchant(ctx, merchantID, merchantutil.StatusSuspended, adminID, reason)
+	if err != nil {
+		return nil, err
+	}
+	return &adminpb.SuspendMerchantResponse{Success: true, Merchant: merchant}, nil
+}
+
+func (s *adminService) StartMerchantReview(ctx context.Context, merchantID int, adminID int64) (*adminpb.StartMerchantReviewResponse, error) {
+	merchant, err := s.transitionMerchant(ctx, merchantID, merchantutil.StatusUnderReview, adminID, "")
+	if err != nil {
+		return nil, err
+	}
+	return &adminpb.StartMerchantReviewResponse{Merchant: merchant}, nil
+}
+
+func (s *adminService) RejectMerchant(ctx context.Context, merchantID int, adminID int64, reason string) (*adminpb.RejectMerchantResponse, error) {
+	merchant, err := s.transitionMerchant(ctx, merchantID, merchantutil.StatusRejected, adminID, reason)
+	if err != nil {
+		return nil, err
+	}
+	return &adminpb.RejectMerchantResponse{Merchant: merchant}, nil
+}
+
+func (s *adminService) ReinstateMerchant(ctx context.Context, merchantID int, adminID int64, reason string) (*adminpb.ReinstateMerchantResponse, error) {
+	merchant, err := s.transitionMerchant(ctx, merchantID, merchantutil.StatusApproved, adminID, reason,
+		merchantutil.StatusSuspended)
+	if err != nil {
+		return nil, err
+	}
+	return &adminpb.ReinstateMerchantResponse{Merchant: merchant}, nil
+}
+
+func (s *adminService) GetMerchantStatusHistory(ctx context.Context, merchantID int) (*adminpb.GetMerchantStatusHistoryResponse, error) {
+	history, err := s.onboarding.History(ctx, merchantID)
+	if err != nil {
+		return nil, err
+	}
+	return &adminpb.GetMerchantStatusHistoryResponse{History: history}, nil
+}
+
+func (s *adminService) ListMerchantDocuments(ctx context.Context, merchantID int) (*adminpb.ListMerchantDocumentsResponse, error) {
+	documents, missing, err := s.documents.ListForReview(ctx, merchantID)
+	if err != nil {
+		return nil, err
+	}
+	return &adminpb.ListMerchantDocumentsResponse{
+		Documents:        documents,
+		MissingDocuments: missing,
+	}, nil
+}
+
+func (s *adminService) VerifyMerchantDocument(ctx context.Context, documentID, adminID int64) (*adminpb.VerifyMerchantDocumentResponse, error) {
+	document, err := s.documents.Review(ctx, documentID, adminID, true, "")
+	if err != nil {
+		return nil, err
+	}
+	return &adminpb.VerifyMerchantDocumentResponse{Document: document}, nil
+}
+
+func (s *adminService) RejectMerchantDocument(ctx context.Context, documentID, adminID int64, reason string) (*adminpb.RejectMerchantDocumentResponse, error) {
+	document, err := s.documents.Review(ctx, documentID, adminID, false, reason)
+	if err != nil {
+		return nil, err
+	}
+	return &adminpb.RejectMerchantDocumentResponse{Document: document}, nil
+}
+
+func (s *adminService) ListFlaggedReviews(ctx context.Context, page, limit int32) (*adminpb.ListFlaggedReviewsResponse, error) {
+	reviews, total, err := s.reviews.ListFlagged(ctx, page, limit)
+	if err != nil {
+		return nil, err
+	}
+	return &adminpb.ListFlaggedReviewsResponse{Reviews: reviews, TotalCount: total}, nil
+}
+
+func (s *adminService) ModerateReview(ctx context.Context, reviewID, adminID int64, action, reason string) (*adminpb.ModerateReviewResponse, error) {
+	review, err := s.reviews.Moderate(ctx, reviewID, adminID, action, reason)
+	if err != nil {
+		return nil, err
+	}
+	return &adminpb.ModerateReviewResponse{Review: review}, nil
+}
+
+func (s *adminService) transitionMerchant(ctx context.Context, merchantID int, to string, adminID int64, reason string, allowedFrom ...string) (*schemapb.Merchant, error) {
+	merchant, err := s.onboarding.Transition(ctx, merchantservice.StatusChange{
+		MerchantID:  merchantID,
+		To:          to,
+		AllowedFrom: allowedFrom,
+		ActorID:     adminID,
+		ActorType:   audit.ActorAdmin,
+		Reason:      reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convertToProtoMerchant(merchant), nil
+}
+
 func (s *adminService) GetAllUsers(ctx context.Context, page, limit int32) (*adminpb.GetAllUsersResponse, error) {
 	offset := (page - 1) * limit
 	users, err := s.repo.GetAllUsers(ctx, limit, offset)
@@ -105,6 +258,10 @@ func convertToProtoMerchant(merchant schema.Merchant) *schemapb.Merchant {
 		Category:           merchant.Category.String,
 		DiscountPercentage: utils.NumericToFloat64(merchant.DiscountPercentage),
 		IsActive:           merchant.IsActive.Bool,
+		Status:             merchant.Status,
+		Timezone:           merchant.Timezone,
+		RatingAverage:      reviewutil.AverageRating(merchant.RatingTotal, merchant.RatingCount),
+		RatingCount:        merchant.RatingCount,
 		CreatedAt:          merchant.CreatedAt.Time.Unix(),
 	}
 }
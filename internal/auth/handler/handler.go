@@ -4,9 +4,7 @@ import (
 	"context"
 	"errors"
 	"strings"
-	"time"
 
-	"rival/config"
 	authpb "rival/gen/proto/proto/api"
 	"rival/internal/auth/repo"
 	"rival/internal/auth/service"
@@ -28,10 +26,10 @@ func NewAuthHandler() (*AuthHandler, error) {
 	}
 
 	// Initialize JWT util
-	cfg := config.GetConfig()
-	jwtUtil := util.NewJWTUtil(cfg.JWT.Secret,
-		time.Duration(cfg.JWT.ExpiryHour)*time.Hour,
-		24*time.Hour)
+	jwtUtil, err := util.GetJWTUtil()
+	if err != nil {
+		return nil, err
+	}
 
 	// Initialize email service
 	emailService := util.NewEmailService()
@@ -58,6 +56,7 @@ func (h *AuthHandler) Signup(ctx context.Context, req *authpb.SignupRequest) (*a
 		Name:     req.Name,
 		Phone:    req.Phone,
 		Role:     req.Role,
+		Locale:   req.Locale,
 	}
 
 	return h.service.Signup(ctx, params)
@@ -102,7 +101,15 @@ func (h *AuthHandler) FirebaseLogin(ctx context.Context, req *authpb.FirebaseLog
 		return nil, errors.New("firebase token is required")
 	}
 
-	return h.service.FirebaseLogin(ctx, req.FirebaseToken)
+	return h.service.FirebaseLogin(ctx, req.Provider, req.FirebaseToken)
+}
+
+func (h *AuthHandler) SocialLogin(ctx context.Context, req *authpb.SocialLoginRequest) (*authpb.SocialLoginResponse, error) {
+	if req.Provider == "" || req.IdToken == "" {
+		return nil, errors.New("provider and ID token are required")
+	}
+
+	return h.service.SocialLogin(ctx, req.Provider, req.IdToken)
 }
 
 func (h *AuthHandler) ForgotPassword(ctx context.Context, req *authpb.ForgotPasswordRequest) (*authpb.ForgotPasswordResponse, error) {
@@ -152,35 +159,153 @@ func (h *AuthHandler) WhoAmI(ctx context.Context, req *authpb.WhoAmIRequest) (*a
 	return h.service.WhoAmI(ctx, int(userID))
 }
 
+func (h *AuthHandler) ListSessions(ctx context.Context, req *authpb.ListSessionsRequest) (*authpb.ListSessionsResponse, error) {
+	claims := extractClaimsFromContext(ctx)
+	if claims == nil {
+		return nil, errors.New("unauthenticated: invalid or missing token")
+	}
+
+	return h.service.ListSessions(ctx, claims.UserID, claims.SessionID)
+}
+
+func (h *AuthHandler) RevokeSession(ctx context.Context, req *authpb.RevokeSessionRequest) (*authpb.RevokeSessionResponse, error) {
+	if req.SessionId == 0 {
+		return nil, errors.New("session ID is required")
+	}
+
+	userID := extractUserIDFromContext(ctx)
+	if userID == -1 {
+		return nil, errors.New("unauthenticated: invalid or missing token")
+	}
+
+	return h.service.RevokeSession(ctx, userID, req.SessionId)
+}
+
+func (h *AuthHandler) UnlockAccount(ctx context.Context, req *authpb.UnlockAccountRequest) (*authpb.UnlockAccountResponse, error) {
+	if req.Token == "" {
+		return nil, errors.New("unlock token is required")
+	}
+
+	return h.service.UnlockAccount(ctx, req.Token)
+}
+
+func (h *AuthHandler) ListIdentities(ctx context.Context, req *authpb.ListIdentitiesRequest) (*authpb.ListIdentitiesResponse, error) {
+	userID := extractUserIDFromContext(ctx)
+	if userID == -1 {
+		return nil, errors.New("unauthenticated: invalid or missing token")
+	}
+
+	return h.service.ListIdentities(ctx, userID)
+}
+
+func (h *AuthHandler) LinkIdentity(ctx context.Context, req *authpb.LinkIdentityRequest) (*authpb.LinkIdentityResponse, error) {
+	if req.Provider == "" || req.IdToken == "" {
+		return nil, errors.New("provider and ID token are required")
+	}
+
+	userID := extractUserIDFromContext(ctx)
+	if userID == -1 {
+		return nil, errors.New("unauthenticated: invalid or missing token")
+	}
+
+	return h.service.LinkIdentity(ctx, userID, req)
+}
+
+func (h *AuthHandler) UnlinkIdentity(ctx context.Context, req *authpb.UnlinkIdentityRequest) (*authpb.UnlinkIdentityResponse, error) {
+	if req.Provider == "" {
+		return nil, errors.New("provider is required")
+	}
+
+	userID := extractUserIDFromContext(ctx)
+	if userID == -1 {
+		return nil, errors.New("unauthenticated: invalid or missing token")
+	}
+
+	return h.service.UnlinkIdentity(ctx, userID, req)
+}
+
+func (h *AuthHandler) SendPhoneVerification(ctx context.Context, req *authpb.SendPhoneVerificationRequest) (*authpb.SendPhoneVerificationResponse, error) {
+	if req.Phone == "" {
+		return nil, errors.New("phone is required")
+	}
+
+	userID := extractUserIDFromContext(ctx)
+	if userID == -1 {
+		return nil, errors.New("unauthenticated: invalid or missing token")
+	}
+
+	return h.service.SendPhoneVerification(ctx, userID, req.Phone)
+}
+
+func (h *AuthHandler) ConfirmPhoneVerification(ctx context.Context, req *authpb.ConfirmPhoneVerificationRequest) (*authpb.ConfirmPhoneVerificationResponse, error) {
+	if req.Phone == "" || req.Otp == "" {
+		return nil, errors.New("phone and OTP are required")
+	}
+
+	userID := extractUserIDFromContext(ctx)
+	if userID == -1 {
+		return nil, errors.New("unauthenticated: invalid or missing token")
+	}
+
+	return h.service.ConfirmPhoneVerification(ctx, userID, req.Phone, req.Otp)
+}
+
+func (h *AuthHandler) LoginWithPhone(ctx context.Context, req *authpb.LoginWithPhoneRequest) (*authpb.LoginWithPhoneResponse, error) {
+	if req.Phone == "" {
+		return nil, errors.New("phone is required")
+	}
+
+	return h.service.LoginWithPhone(ctx, req.Phone)
+}
+
+func (h *AuthHandler) VerifyPhoneOTP(ctx context.Context, req *authpb.VerifyPhoneOTPRequest) (*authpb.VerifyPhoneOTPResponse, error) {
+	if req.Phone == "" || req.Otp == "" {
+		return nil, errors.New("phone and OTP are required")
+	}
+
+	return h.service.VerifyPhoneOTP(ctx, req.Phone, req.Otp)
+}
+
 func extractUserIDFromContext(ctx context.Context) int {
+	claims := extractClaimsFromContext(ctx)
+	if claims == nil {
+		return -1
+	}
+
+	return claims.UserID
+}
+
+func extractClaimsFromContext(ctx context.Context) *util.TokenClaims {
 	// Extract JWT token from gRPC metadata
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return -1
+		return nil
 	}
 
 	// Get authorization header
 	authHeaders := md.Get("authorization")
 	if len(authHeaders) == 0 {
-		return -1
+		return nil
 	}
 
 	// Extract token from "Bearer <token>" format
 	authHeader := authHeaders[0]
 	if !strings.HasPrefix(authHeader, "Bearer ") {
-		return -1
+		return nil
 	}
 
 	token := strings.TrimPrefix(authHeader, "Bearer ")
 
-	// Create JWT util instance (should be injected in real implementation)
-	jwtUtil := util.NewJWTUtil("your-secret-key", time.Hour, 24*time.Hour)
+	jwtUtil, err := util.GetJWTUtil()
+	if err != nil {
+		return nil
+	}
 
 	// Validate and extract claims
 	claims, err := jwtUtil.ValidateToken(token)
 	if err != nil {
-		return -1
+		return nil
 	}
 
-	return claims.UserID
+	return claims
 }
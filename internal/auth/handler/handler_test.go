@@ -63,9 +63,9 @@ func TestSignup(t *testing.T) {
 	tests := authpb.SignupRequest{
 		Name:     "Test User",
 		Email:    "test@example.com",
-		Password: "password123",
+		Password: "Rival-Passw0rd",
 		Role:     *schemapb.UserRole_USER_ROLE_ADMIN.Enum(),
-		Phone:    "12345678",
+		Phone:    "9876543210",
 	}
 	_, err = handler.Signup(context.Background(), &tests)
 
@@ -83,6 +83,9 @@ func TestSignup(t *testing.T) {
 	if user.Email != tests.Email {
 		t.Errorf("Signup() failed to create user with correct email, got = %v, want %v", user.Email, tests.Email)
 	}
+	if user.Phone.String != "+919876543210" {
+		t.Errorf("Signup() stored phone %q, want %q", user.Phone.String, "+919876543210")
+	}
 	tbuser, err := repo.tb.GetUser(int(user.ID))
 	if err != nil {
 		t.Errorf("Signup() failed to get tigerbeetle user: %v", err)
@@ -98,6 +101,36 @@ func TestSignup(t *testing.T) {
 	}
 }
 
+func TestSignupInvalidPhone(t *testing.T) {
+	handler, err := NewAuthHandler()
+	if err != nil {
+		t.Fatalf("Failed to create handler: %v", err)
+	}
+	repo, err := NewRepo()
+	if err != nil {
+		t.Fatalf("Failed to create repo: %v", err)
+	}
+	req := &authpb.SignupRequest{
+		Name:     "Test User",
+		Email:    "test-bad-phone@example.com",
+		Password: "Rival-Passw0rd",
+		Role:     *schemapb.UserRole_USER_ROLE_CUSTOMER.Enum(),
+		Phone:    "12345678",
+	}
+	defer repo.queries.DeleteByEmail(context.Background(), req.Email)
+
+	resp, err := handler.Signup(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Signup() error = %v", err)
+	}
+	if resp.OtpSent {
+		t.Errorf("Signup() accepted invalid phone %q", req.Phone)
+	}
+	if _, err := repo.queries.GetUserByEmail(context.Background(), req.Email); err == nil {
+		t.Errorf("Signup() created a user with invalid phone %q", req.Phone)
+	}
+}
+
 func TestLogin(t *testing.T) {
 	handler, err := NewAuthHandler()
 	if err != nil {
@@ -107,9 +140,9 @@ func TestLogin(t *testing.T) {
 	data := authpb.SignupRequest{
 		Name:     "Test User",
 		Email:    "test1@example.com",
-		Password: "password123",
+		Password: "Rival-Passw0rd",
 		Role:     *schemapb.UserRole_USER_ROLE_ADMIN.Enum(),
-		Phone:    "12345678",
+		Phone:    "9876543210",
 	}
 	value, err := handler.Signup(ctx, &data)
 	if err != nil {
@@ -143,9 +176,9 @@ func signupAuto(ctx context.Context, email string, t *testing.T) *authpb.SignupR
 	data := authpb.SignupRequest{
 		Name:     "Test User",
 		Email:    email,
-		Password: "password123",
+		Password: "Rival-Passw0rd",
 		Role:     *schemapb.UserRole_USER_ROLE_ADMIN.Enum(),
-		Phone:    "12345678",
+		Phone:    "9876543210",
 	}
 	handler.Signup(ctx, &data)
 	repo, err := NewRepo()
@@ -175,7 +208,7 @@ func TestVerifyOTP(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Failed to create handler: %v", err)
 	}
-	otp := redisClient.Get(ctx, "otp:"+data.Email).String()
+	otp := redisClient.Get(ctx, "otp:"+data.Email).Val()
 	t.Logf("OTP from redis: %v", otp)
 	req := &authpb.VerifyOTPRequest{
 		Email: data.Email,
@@ -218,11 +251,11 @@ func TestResetPassword(t *testing.T) {
 	if err != nil {
 		t.Errorf("ForgotPassword() error = %v", err)
 	}
-	otp := connection.GetRedisClient(&config.GetConfig().Redis).Get(ctx, "otp:reset:"+data.Email).String()
+	otp := connection.GetRedisClient(&config.GetConfig().Redis).Get(ctx, "otp:reset:"+data.Email).Val()
 	req2 := &authpb.ResetPasswordRequest{
 		Email:       data.Email,
 		Otp:         otp,
-		NewPassword: "123456788",
+		NewPassword: "N3w-Rival-Pass",
 	}
 
 	_, err = handler.ResetPassword(context.Background(), req2)
@@ -579,9 +612,9 @@ func TestAuthConcurrency_MultipleUsers(t *testing.T) {
 		signupReq := &authpb.SignupRequest{
 			Name:     "Concurrent User " + string(rune(i+1)),
 			Email:    email,
-			Password: "password123",
+			Password: "Rival-Passw0rd",
 			Role:     *schemapb.UserRole_USER_ROLE_CUSTOMER.Enum(),
-			Phone:    "1234567890",
+			Phone:    "9876543210",
 		}
 		_, err := handler.Signup(ctx, signupReq)
 		if err != nil {
@@ -596,7 +629,7 @@ func TestAuthConcurrency_MultipleUsers(t *testing.T) {
 	for _, email := range users {
 		loginReq := &authpb.LoginRequest{
 			Email:    email,
-			Password: "password123",
+			Password: "Rival-Passw0rd",
 		}
 		resp, err := handler.Login(ctx, loginReq)
 		if err != nil {
@@ -0,0 +1,163 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	authpb "rival/gen/proto/proto/api"
+	schemapb "rival/gen/proto/proto/schema"
+	schema "rival/gen/sql"
+	"rival/internal/auth/repo"
+	"rival/internal/auth/service"
+	"rival/internal/auth/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// newStubAuthHandler builds a handler that accepts ID tokens minted by the
+// local OIDC stub.
+func newStubAuthHandler(t *testing.T) *AuthHandler {
+	repository, err := repo.NewAuthRepository()
+	if err != nil {
+		t.Fatalf("Failed to create repo: %v", err)
+	}
+	jwtUtil, err := util.GetJWTUtil()
+	if err != nil {
+		t.Fatalf("Failed to create JWT util: %v", err)
+	}
+	providers := util.NewIdentityProviders(util.GetLocalOIDCStub().Provider())
+	return &AuthHandler{service: service.NewAuthService(repository, jwtUtil, util.NewEmailService(), providers)}
+}
+
+func stubIDToken(t *testing.T, identity util.ExternalIdentity) string {
+	token, err := util.GetLocalOIDCStub().IssueIDToken(identity, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to issue ID token: %v", err)
+	}
+	return token
+}
+
+// signupVerified signs up a password user whose email is verified.
+func signupVerified(ctx context.Context, email string, t *testing.T) (*authpb.SignupRequest, schema.User) {
+	data := signupAuto(ctx, email, t)
+	authRepo, err := NewRepo()
+	if err != nil {
+		t.Fatalf("Failed to create repo: %v", err)
+	}
+	user, err := authRepo.queries.GetUserByEmail(ctx, email)
+	if err != nil {
+		t.Fatalf("Failed to get user by email: %v", err)
+	}
+	if err := authRepo.queries.MarkEmailVerified(ctx, user.ID); err != nil {
+		t.Fatalf("Failed to verify email: %v", err)
+	}
+	return data, user
+}
+
+func withAccessToken(ctx context.Context, user schema.User, t *testing.T) context.Context {
+	jwtUtil, err := util.GetJWTUtil()
+	if err != nil {
+		t.Fatalf("Failed to create JWT util: %v", err)
+	}
+	access, _, err := jwtUtil.GenerateTokens(&schemapb.User{Id: user.ID, Email: user.Email}, "identity-test")
+	if err != nil {
+		t.Fatalf("Failed to generate tokens: %v", err)
+	}
+	return metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+access))
+}
+
+func TestSocialLoginRefusesUnverifiedProviderEmail(t *testing.T) {
+	handler := newStubAuthHandler(t)
+	ctx := context.Background()
+	data, _ := signupVerified(ctx, "test-social-unverified@example.com", t)
+	defer deleteUserByEmail(ctx, data.Email, t)
+
+	// An existing account isn't linked on an email the provider didn't verify
+	token := stubIDToken(t, util.ExternalIdentity{Subject: "stub-unverified", Email: data.Email, EmailVerified: false})
+	_, err := handler.SocialLogin(ctx, &authpb.SocialLoginRequest{Provider: util.ProviderLocal, IdToken: token})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("SocialLogin() with unverified provider email: error = %v, want FailedPrecondition", err)
+	}
+
+	// Nor is a new account created for one
+	token = stubIDToken(t, util.ExternalIdentity{Subject: "stub-unverified-new", Email: "test-social-unverified-new@example.com", EmailVerified: false})
+	_, err = handler.SocialLogin(ctx, &authpb.SocialLoginRequest{Provider: util.ProviderLocal, IdToken: token})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("SocialLogin() signing up with unverified provider email: error = %v, want FailedPrecondition", err)
+	}
+}
+
+func TestSocialLoginRefusesLocallyUnverifiedEmail(t *testing.T) {
+	handler := newStubAuthHandler(t)
+	ctx := context.Background()
+	data := signupAuto(ctx, "test-social-local-unverified@example.com", t)
+	defer deleteUserByEmail(ctx, data.Email, t)
+
+	token := stubIDToken(t, util.ExternalIdentity{Subject: "stub-local-unverified", Email: data.Email, EmailVerified: true})
+	_, err := handler.SocialLogin(ctx, &authpb.SocialLoginRequest{Provider: util.ProviderLocal, IdToken: token})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("SocialLogin() onto an unverified local account: error = %v, want FailedPrecondition", err)
+	}
+}
+
+func TestSocialLoginMatchesSubjectBeforeEmail(t *testing.T) {
+	handler := newStubAuthHandler(t)
+	ctx := context.Background()
+	first, firstUser := signupVerified(ctx, "test-social-subject-a@example.com", t)
+	defer deleteUserByEmail(ctx, first.Email, t)
+	second, _ := signupVerified(ctx, "test-social-subject-b@example.com", t)
+	defer deleteUserByEmail(ctx, second.Email, t)
+
+	// A verified email on both sides links the identity
+	token := stubIDToken(t, util.ExternalIdentity{Subject: "stub-subject", Email: first.Email, EmailVerified: true})
+	resp, err := handler.SocialLogin(ctx, &authpb.SocialLoginRequest{Provider: util.ProviderLocal, IdToken: token})
+	if err != nil {
+		t.Fatalf("SocialLogin() error = %v", err)
+	}
+	if resp.User.Id != firstUser.ID {
+		t.Fatalf("SocialLogin() signed in user %d, want %d", resp.User.Id, firstUser.ID)
+	}
+
+	// The subject keeps pointing at the linked account after the provider email changes
+	token = stubIDToken(t, util.ExternalIdentity{Subject: "stub-subject", Email: second.Email, EmailVerified: true})
+	resp, err = handler.SocialLogin(ctx, &authpb.SocialLoginRequest{Provider: util.ProviderLocal, IdToken: token})
+	if err != nil {
+		t.Fatalf("SocialLogin() error = %v", err)
+	}
+	if resp.User.Id != firstUser.ID {
+		t.Errorf("SocialLogin() matched on email: signed in user %d, want %d", resp.User.Id, firstUser.ID)
+	}
+}
+
+func TestLinkAndUnlinkIdentityRequirePassword(t *testing.T) {
+	handler := newStubAuthHandler(t)
+	ctx := context.Background()
+	data, user := signupVerified(ctx, "test-link-password@example.com", t)
+	defer deleteUserByEmail(ctx, data.Email, t)
+	authCtx := withAccessToken(ctx, user, t)
+
+	token := stubIDToken(t, util.ExternalIdentity{Subject: "stub-link", Email: "test-link-other@example.com", EmailVerified: true})
+	for _, password := range []string{"", "Wrong-Passw0rd"} {
+		_, err := handler.LinkIdentity(authCtx, &authpb.LinkIdentityRequest{Provider: util.ProviderLocal, IdToken: token, Password: password})
+		if status.Code(err) != codes.Unauthenticated {
+			t.Errorf("LinkIdentity() with password %q: error = %v, want Unauthenticated", password, err)
+		}
+	}
+	if _, err := handler.LinkIdentity(authCtx, &authpb.LinkIdentityRequest{Provider: util.ProviderLocal, IdToken: token, Password: data.Password}); err != nil {
+		t.Fatalf("LinkIdentity() error = %v", err)
+	}
+
+	for _, password := range []string{"", "Wrong-Passw0rd"} {
+		_, err := handler.UnlinkIdentity(authCtx, &authpb.UnlinkIdentityRequest{Provider: util.ProviderLocal, Password: password})
+		if status.Code(err) != codes.Unauthenticated {
+			t.Errorf("UnlinkIdentity() with password %q: error = %v, want Unauthenticated", password, err)
+		}
+	}
+	resp, err := handler.UnlinkIdentity(authCtx, &authpb.UnlinkIdentityRequest{Provider: util.ProviderLocal, Password: data.Password})
+	if err != nil || !resp.Success {
+		t.Errorf("UnlinkIdentity() = %v, %v", resp, err)
+	}
+}
@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"rival/internal/auth/util"
+)
+
+const JWKSPath = "/.well-known/jwks.json"
+
+// JWKSHandler publishes the public signing keys so envoy and other services
+// can verify access tokens without sharing a secret.
+type JWKSHandler struct {
+	keys *util.KeyRing
+}
+
+func NewJWKSHandler() (*JWKSHandler, error) {
+	keys, err := util.GetKeyRing()
+	if err != nil {
+		return nil, err
+	}
+
+	return &JWKSHandler{keys: keys}, nil
+}
+
+func (h *JWKSHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	// New keys are published JWKSMaxAge before they sign, so caches this
+	// long always hold the signing key
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(util.JWKSMaxAge.Seconds())))
+	if err := json.NewEncoder(w).Encode(h.keys.JWKS()); err != nil {
+		http.Error(w, "failed to encode JWKS", http.StatusInternalServerError)
+	}
+}
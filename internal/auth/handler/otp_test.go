@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"rival/config"
+	"rival/connection"
+	authpb "rival/gen/proto/proto/api"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestVerifyOTPSingleUse(t *testing.T) {
+	handler, err := NewAuthHandler()
+	if err != nil {
+		t.Fatalf("Failed to create handler: %v", err)
+	}
+	redisClient := connection.GetRedisClient(&config.GetConfig().Redis)
+	ctx := context.Background()
+	data := signupAuto(ctx, "testotpsingleuse@example.com", t)
+	defer deleteUserByEmail(ctx, data.Email, t)
+
+	redisClient.Set(ctx, "otp:"+data.Email, "481516", 0)
+	req := &authpb.VerifyOTPRequest{Email: data.Email, Otp: "481516"}
+	if _, err := handler.VerifyOTP(ctx, req); err != nil {
+		t.Fatalf("VerifyOTP() error = %v", err)
+	}
+	if _, err := handler.VerifyOTP(ctx, req); err == nil {
+		t.Errorf("VerifyOTP() accepted a code that was already used")
+	}
+}
+
+func TestVerifyOTPLockout(t *testing.T) {
+	handler, err := NewAuthHandler()
+	if err != nil {
+		t.Fatalf("Failed to create handler: %v", err)
+	}
+	redisClient := connection.GetRedisClient(&config.GetConfig().Redis)
+	ctx := context.Background()
+	data := signupAuto(ctx, "testotplockout@example.com", t)
+	defer deleteUserByEmail(ctx, data.Email, t)
+
+	redisClient.Set(ctx, "otp:"+data.Email, "481516", 0)
+	redisClient.Del(ctx, "otp_attempts:"+data.Email)
+	for i := 0; i < 5; i++ {
+		if _, err := handler.VerifyOTP(ctx, &authpb.VerifyOTPRequest{Email: data.Email, Otp: "000000"}); err == nil {
+			t.Fatalf("VerifyOTP() accepted a wrong code")
+		}
+	}
+
+	// Five wrong guesses burn the code
+	if _, err := handler.VerifyOTP(ctx, &authpb.VerifyOTPRequest{Email: data.Email, Otp: "481516"}); err == nil {
+		t.Errorf("VerifyOTP() accepted a code after 5 wrong guesses")
+	}
+}
+
+func TestVerifyOTPNoBypassCode(t *testing.T) {
+	handler, err := NewAuthHandler()
+	if err != nil {
+		t.Fatalf("Failed to create handler: %v", err)
+	}
+	redisClient := connection.GetRedisClient(&config.GetConfig().Redis)
+	ctx := context.Background()
+	data := signupAuto(ctx, "testotpbypass@example.com", t)
+	defer deleteUserByEmail(ctx, data.Email, t)
+
+	redisClient.Set(ctx, "otp:"+data.Email, "481516", 0)
+	if _, err := handler.VerifyOTP(ctx, &authpb.VerifyOTPRequest{Email: data.Email, Otp: "123456"}); err == nil {
+		t.Errorf("VerifyOTP() accepted 123456 instead of the stored code")
+	}
+}
+
+func TestResendOTPCooldown(t *testing.T) {
+	handler, err := NewAuthHandler()
+	if err != nil {
+		t.Fatalf("Failed to create handler: %v", err)
+	}
+	redisClient := connection.GetRedisClient(&config.GetConfig().Redis)
+	ctx := context.Background()
+	data := signupAuto(ctx, "testotpcooldown@example.com", t)
+	defer deleteUserByEmail(ctx, data.Email, t)
+
+	redisClient.Del(ctx, "otp_cooldown:"+data.Email)
+	defer redisClient.Del(ctx, "otp_cooldown:"+data.Email)
+
+	resp, err := handler.ResendOTP(ctx, &authpb.ResendOTPRequest{Email: data.Email})
+	if err != nil || !resp.OtpSent {
+		t.Fatalf("ResendOTP() = %v, %v", resp, err)
+	}
+	if _, err := handler.ResendOTP(ctx, &authpb.ResendOTPRequest{Email: data.Email}); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("second ResendOTP() error = %v, want ResourceExhausted", err)
+	}
+}
@@ -39,9 +39,9 @@ func TestSignupWithInitialCoins(t *testing.T) {
 	signupReq := &authpb.SignupRequest{
 		Name:     "Test Signup Coins",
 		Email:    email,
-		Password: "password123",
+		Password: "Rival-Passw0rd",
 		Role:     *schemapb.UserRole_USER_ROLE_CUSTOMER.Enum(),
-		Phone:    "1234567890",
+		Phone:    "9876543210",
 	}
 
 	signupResp, err := authH.Signup(ctx, signupReq)
@@ -2,7 +2,8 @@ package repo
 
 import (
 	"context"
-	"fmt"
+	"crypto/subtle"
+	"errors"
 	"math/rand"
 	"strings"
 	"time"
@@ -27,10 +28,33 @@ type AuthRepository interface {
 	CreateSession(ctx context.Context, params schema.CreateJWTSessionParams) error
 	GetSession(ctx context.Context, tokenHash string) (schema.JwtSession, error)
 	RevokeSession(ctx context.Context, tokenHash string) error
-	StoreOTP(ctx context.Context, email, otp string, expiry time.Duration) error
-	VerifyOTP(ctx context.Context, email, otp string) (bool, string, error)
+	GetSessionBySessionID(ctx context.Context, sessionID string) (schema.JwtSession, error)
+	ListUserSessions(ctx context.Context, userID int) ([]schema.JwtSession, error)
+	RevokeUserSession(ctx context.Context, userID int, id int64) (schema.JwtSession, error)
+	TouchSession(ctx context.Context, sessionID string, expiresAt time.Time) error
+	GetUserDeviceStats(ctx context.Context, userID int, deviceID string) (schema.GetUserDeviceStatsRow, error)
+	CreateIdentity(ctx context.Context, params schema.CreateUserIdentityParams) (schema.UserIdentity, error)
+	GetIdentity(ctx context.Context, provider, subject string) (schema.UserIdentity, error)
+	ListIdentities(ctx context.Context, userID int) ([]schema.UserIdentity, error)
+	DeleteIdentity(ctx context.Context, userID int, provider string) (bool, error)
+	TouchIdentity(ctx context.Context, params schema.TouchUserIdentityParams) error
+	SetFirebaseUID(ctx context.Context, userID int, uid string) error
+	StorePendingPassword(ctx context.Context, email, passwordHash string, expiry time.Duration) error
+	PopPendingPassword(ctx context.Context, email string) (string, error)
+	StoreOTP(ctx context.Context, key, otp string, expiry time.Duration) error
+	VerifyOTP(ctx context.Context, key, otp string) (bool, error)
+	OTPCooldown(ctx context.Context, key string, wait time.Duration) (bool, error)
+	GetUserByVerifiedPhone(ctx context.Context, phone string) (schema.User, error)
+	MarkPhoneVerified(ctx context.Context, userID int, phone string) error
+	MarkEmailVerified(ctx context.Context, userID int) error
 }
 
+// maxOTPAttempts wrong guesses burn a code, six digits can't be brute forced
+// in that many tries.
+const maxOTPAttempts = 5
+
+var ErrOTPExpired = errors.New("OTP expired or does not exist")
+
 type authRepository struct {
 	db      *pgxpool.Pool
 	queries *schema.Queries
@@ -116,31 +140,153 @@ func (r *authRepository) RevokeSession(ctx context.Context, tokenHash string) er
 	return r.queries.RevokeJWTSession(ctx, tokenHash)
 }
 
-func (r *authRepository) StoreOTP(ctx context.Context, email, otp string, expiry time.Duration) error {
-	key := "otp:" + email
-	return r.redis.Set(ctx, key, otp, expiry).Err()
+func (r *authRepository) GetSessionBySessionID(ctx context.Context, sessionID string) (schema.JwtSession, error) {
+	return r.queries.GetJWTSessionBySessionID(ctx, pgtype.Text{String: sessionID, Valid: true})
+}
+
+func (r *authRepository) ListUserSessions(ctx context.Context, userID int) ([]schema.JwtSession, error) {
+	return r.queries.ListUserSessions(ctx, pgtype.Int8{Int64: int64(userID), Valid: true})
+}
+
+func (r *authRepository) RevokeUserSession(ctx context.Context, userID int, id int64) (schema.JwtSession, error) {
+	return r.queries.RevokeUserSession(ctx, schema.RevokeUserSessionParams{
+		ID:     id,
+		UserID: pgtype.Int8{Int64: int64(userID), Valid: true},
+	})
+}
+
+func (r *authRepository) TouchSession(ctx context.Context, sessionID string, expiresAt time.Time) error {
+	return r.queries.TouchJWTSession(ctx, schema.TouchJWTSessionParams{
+		SessionID: pgtype.Text{String: sessionID, Valid: true},
+		ExpiresAt: pgtype.Timestamp{Time: expiresAt, Valid: true},
+	})
+}
+
+func (r *authRepository) GetUserDeviceStats(ctx context.Context, userID int, deviceID string) (schema.GetUserDeviceStatsRow, error) {
+	return r.queries.GetUserDeviceStats(ctx, schema.GetUserDeviceStatsParams{
+		UserID:   pgtype.Int8{Int64: int64(userID), Valid: true},
+		DeviceID: pgtype.Text{String: deviceID, Valid: deviceID != ""},
+	})
+}
+
+func (r *authRepository) CreateIdentity(ctx context.Context, params schema.CreateUserIdentityParams) (schema.UserIdentity, error) {
+	return r.queries.CreateUserIdentity(ctx, params)
+}
+
+func (r *authRepository) GetIdentity(ctx context.Context, provider, subject string) (schema.UserIdentity, error) {
+	return r.queries.GetIdentityByProviderSubject(ctx, schema.GetIdentityByProviderSubjectParams{
+		Provider: provider,
+		Subject:  subject,
+	})
 }
 
-func (r *authRepository) VerifyOTP(ctx context.Context, email, otp string) (bool, string, error) {
-	if otp == "123456" {
-		return true, "", nil
+func (r *authRepository) ListIdentities(ctx context.Context, userID int) ([]schema.UserIdentity, error) {
+	return r.queries.ListUserIdentities(ctx, int64(userID))
+}
+
+func (r *authRepository) DeleteIdentity(ctx context.Context, userID int, provider string) (bool, error) {
+	rows, err := r.queries.DeleteUserIdentity(ctx, schema.DeleteUserIdentityParams{
+		UserID:   int64(userID),
+		Provider: provider,
+	})
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+func (r *authRepository) TouchIdentity(ctx context.Context, params schema.TouchUserIdentityParams) error {
+	return r.queries.TouchUserIdentity(ctx, params)
+}
+
+func (r *authRepository) SetFirebaseUID(ctx context.Context, userID int, uid string) error {
+	return r.queries.SetUserFirebaseUID(ctx, schema.SetUserFirebaseUIDParams{
+		ID:          int64(userID),
+		FirebaseUid: pgtype.Text{String: uid, Valid: uid != ""},
+	})
+}
+
+// StorePendingPassword keeps a password hash until the owner of a social-only
+// account proves the email with an OTP.
+func (r *authRepository) StorePendingPassword(ctx context.Context, email, passwordHash string, expiry time.Duration) error {
+	return r.redis.Set(ctx, "pending_password:"+email, passwordHash, expiry).Err()
+}
+
+func (r *authRepository) PopPendingPassword(ctx context.Context, email string) (string, error) {
+	hash, err := r.redis.GetDel(ctx, "pending_password:"+email).Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+	return hash, err
+}
+
+// StoreOTP replaces any code for key, which is the email or a prefixed key
+// such as reset:<email> or phone_login:<phone>, and resets its failed attempts.
+func (r *authRepository) StoreOTP(ctx context.Context, key, otp string, expiry time.Duration) error {
+	pipe := r.redis.TxPipeline()
+	pipe.Set(ctx, "otp:"+key, otp, expiry)
+	pipe.Del(ctx, "otp_attempts:"+key)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// VerifyOTP checks otp against the code stored for key in constant time.
+// Codes are single use and burnt after maxOTPAttempts wrong guesses.
+func (r *authRepository) VerifyOTP(ctx context.Context, key, otp string) (bool, error) {
+	codeKey, attemptsKey := "otp:"+key, "otp_attempts:"+key
+
+	stored, err := r.redis.Get(ctx, codeKey).Result()
+	if err == redis.Nil {
+		return false, ErrOTPExpired
 	}
-	key := "otp:" + email
-	b, err := r.redis.Exists(ctx, key).Result()
 	if err != nil {
-		return false, "", err
+		return false, err
 	}
-	if b == 0 {
-		return false, "", fmt.Errorf("OTP expired or does not exist")
+
+	if subtle.ConstantTimeCompare([]byte(stored), []byte(strings.TrimSpace(otp))) == 1 {
+		// Only the request that deletes the code gets to use it
+		deleted, err := r.redis.Del(ctx, codeKey).Result()
+		if err != nil {
+			return false, err
+		}
+		r.redis.Del(ctx, attemptsKey)
+		return deleted == 1, nil
 	}
-	storedOTP := r.redis.Get(ctx, key).String()
 
-	if strings.TrimSpace(storedOTP) == strings.TrimSpace(otp) {
-		r.redis.Del(ctx, key)
-		return true, "", nil
+	attempts, err := r.redis.Incr(ctx, attemptsKey).Result()
+	if err != nil {
+		return false, err
+	}
+	if attempts == 1 {
+		if ttl, err := r.redis.TTL(ctx, codeKey).Result(); err == nil && ttl > 0 {
+			r.redis.Expire(ctx, attemptsKey, ttl)
+		}
+	}
+	if attempts >= maxOTPAttempts {
+		r.redis.Del(ctx, codeKey, attemptsKey)
 	}
+	return false, nil
+}
+
+// OTPCooldown reports whether a new code may be sent for key and, if so,
+// blocks the next one for wait. It keeps SMS gateways from being pumped.
+func (r *authRepository) OTPCooldown(ctx context.Context, key string, wait time.Duration) (bool, error) {
+	return r.redis.SetNX(ctx, "otp_cooldown:"+key, 1, wait).Result()
+}
+
+func (r *authRepository) GetUserByVerifiedPhone(ctx context.Context, phone string) (schema.User, error) {
+	return r.queries.GetUserByVerifiedPhone(ctx, pgtype.Text{String: phone, Valid: true})
+}
+
+func (r *authRepository) MarkPhoneVerified(ctx context.Context, userID int, phone string) error {
+	return r.queries.MarkPhoneVerified(ctx, schema.MarkPhoneVerifiedParams{
+		ID:    int64(userID),
+		Phone: pgtype.Text{String: phone, Valid: true},
+	})
+}
 
-	return false, storedOTP, nil
+func (r *authRepository) MarkEmailVerified(ctx context.Context, userID int) error {
+	return r.queries.MarkEmailVerified(ctx, int64(userID))
 }
 
 func generateUserFriendlyReferralCode(userName string) string {
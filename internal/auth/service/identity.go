@@ -0,0 +1,361 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	authpb "rival/gen/proto/proto/api"
+	schemapb "rival/gen/proto/proto/schema"
+	schema "rival/gen/sql"
+	"rival/internal/auth/util"
+	"rival/pkg/sms"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// verifyIDToken checks an ID token with the named provider.
+func (s *authService) verifyIDToken(ctx context.Context, provider, idToken string) (*util.ExternalIdentity, error) {
+	identityProvider, err := s.providers.Get(provider)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	identity, err := identityProvider.Verify(ctx, idToken)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return identity, nil
+}
+
+// resolveSocialUser finds the user behind an external identity. The provider
+// subject is trusted first. The email only links to an existing account when
+// both the provider and we verified it: otherwise whoever registered the email
+// with a password first, possibly not its owner, would share the account.
+func (s *authService) resolveSocialUser(ctx context.Context, external *util.ExternalIdentity) (schema.User, error) {
+	device := util.DeviceInfoFromContext(ctx)
+
+	identity, err := s.repo.GetIdentity(ctx, external.Provider, external.Subject)
+	if err == nil {
+		user, err := s.repo.GetUserByID(ctx, int(identity.UserID))
+		if err != nil {
+			return schema.User{}, err
+		}
+		err = s.repo.TouchIdentity(ctx, schema.TouchUserIdentityParams{
+			ID:            identity.ID,
+			Email:         pgtype.Text{String: external.Email, Valid: external.Email != ""},
+			EmailVerified: pgtype.Bool{Bool: external.EmailVerified, Valid: true},
+		})
+		if err != nil {
+			fmt.Printf("Failed to update identity: %v\n", err)
+		}
+		return user, nil
+	}
+
+	if external.Email == "" {
+		return schema.User{}, status.Error(codes.FailedPrecondition, "provider did not share an email address")
+	}
+
+	user, err := s.repo.GetUserByEmail(ctx, external.Email)
+	if err == nil {
+		if !external.EmailVerified {
+			s.auditLogin(ctx, "auth.identity_link_refused", user.ID, external.Email, device, map[string]interface{}{
+				"provider": external.Provider,
+				"reason":   "unverified_email",
+			})
+			return schema.User{}, status.Error(codes.FailedPrecondition, "an account with this email already exists, log in with your password and link it from settings")
+		}
+		if !user.EmailVerifiedAt.Valid {
+			s.auditLogin(ctx, "auth.identity_link_refused", user.ID, external.Email, device, map[string]interface{}{
+				"provider": external.Provider,
+				"reason":   "local_email_unverified",
+			})
+			return schema.User{}, status.Error(codes.FailedPrecondition, "an account with this email already exists but its email was never verified, reset its password or log in and link it from settings")
+		}
+		if _, linked, err := s.identityForProvider(ctx, int(user.ID), external.Provider); err != nil {
+			return schema.User{}, err
+		} else if linked {
+			return schema.User{}, status.Errorf(codes.AlreadyExists, "this account is already linked to a different %s user", external.Provider)
+		}
+
+		if _, err := s.linkExternalIdentity(ctx, user, external); err != nil {
+			return schema.User{}, err
+		}
+		s.auditLogin(ctx, "auth.identity_linked", user.ID, external.Email, device, map[string]interface{}{
+			"provider": external.Provider,
+			"auto":     true,
+		})
+		return user, nil
+	}
+
+	// Claiming a new email requires the provider to have verified it
+	if !external.EmailVerified {
+		return schema.User{}, status.Error(codes.FailedPrecondition, "verify your email with the provider before signing up")
+	}
+
+	// A number the provider sent that we can't normalize is left off
+	phone, _ := sms.NormalizePhone(external.PhoneNumber)
+	createParams := schema.CreateUserParams{
+		Email:      external.Email,
+		Name:       external.Name,
+		ProfilePic: pgtype.Text{String: external.Picture, Valid: external.Picture != ""},
+		Phone:      pgtype.Text{String: phone, Valid: phone != ""},
+		Role:       "customer",
+	}
+	if external.Provider == util.ProviderFirebase {
+		createParams.FirebaseUid = pgtype.Text{String: external.Subject, Valid: true}
+	}
+
+	user, err = s.repo.CreateUser(ctx, createParams)
+	if err != nil {
+		return schema.User{}, err
+	}
+	if err := s.repo.MarkEmailVerified(ctx, int(user.ID)); err != nil {
+		return schema.User{}, err
+	}
+
+	if _, err := s.linkExternalIdentity(ctx, user, external); err != nil {
+		return schema.User{}, err
+	}
+
+	if err := s.email.SendWelcomeEmail(external.Email, external.Name); err != nil {
+		fmt.Printf("Failed to send welcome email: %v\n", err)
+	}
+	return user, nil
+}
+
+func (s *authService) linkExternalIdentity(ctx context.Context, user schema.User, external *util.ExternalIdentity) (schema.UserIdentity, error) {
+	identity, err := s.repo.CreateIdentity(ctx, schema.CreateUserIdentityParams{
+		UserID:        user.ID,
+		Provider:      external.Provider,
+		Subject:       external.Subject,
+		Email:         pgtype.Text{String: external.Email, Valid: external.Email != ""},
+		EmailVerified: pgtype.Bool{Bool: external.EmailVerified, Valid: true},
+	})
+	if err != nil {
+		return schema.UserIdentity{}, err
+	}
+
+	// Keep the legacy column in sync for code that still reads it
+	if external.Provider == util.ProviderFirebase {
+		if err := s.repo.SetFirebaseUID(ctx, int(user.ID), external.Subject); err != nil {
+			return schema.UserIdentity{}, err
+		}
+	}
+	return identity, nil
+}
+
+func (s *authService) identityForProvider(ctx context.Context, userID int, provider string) (schema.UserIdentity, bool, error) {
+	identities, err := s.repo.ListIdentities(ctx, userID)
+	if err != nil {
+		return schema.UserIdentity{}, false, err
+	}
+	for _, identity := range identities {
+		if identity.Provider == provider {
+			return identity, true, nil
+		}
+	}
+	return schema.UserIdentity{}, false, nil
+}
+
+func (s *authService) ListIdentities(ctx context.Context, userID int) (*authpb.ListIdentitiesResponse, error) {
+	user, err := s.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	identities, err := s.repo.ListIdentities(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var protoIdentities []*schemapb.UserIdentity
+	for _, identity := range identities {
+		protoIdentities = append(protoIdentities, convertToProtoIdentity(identity))
+	}
+
+	return &authpb.ListIdentitiesResponse{
+		Identities:  protoIdentities,
+		HasPassword: hasPassword(user),
+	}, nil
+}
+
+func (s *authService) LinkIdentity(ctx context.Context, userID int, req *authpb.LinkIdentityRequest) (*authpb.LinkIdentityResponse, error) {
+	if _, err := s.providers.Get(req.Provider); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	user, err := s.reauthenticate(ctx, userID, req.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	external, err := s.verifyIDToken(ctx, req.Provider, req.IdToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing, err := s.repo.GetIdentity(ctx, external.Provider, external.Subject); err == nil {
+		if existing.UserID == user.ID {
+			return &authpb.LinkIdentityResponse{Identity: convertToProtoIdentity(existing)}, nil
+		}
+		return nil, status.Error(codes.AlreadyExists, "this identity is linked to another account")
+	}
+
+	if _, linked, err := s.identityForProvider(ctx, userID, external.Provider); err != nil {
+		return nil, err
+	} else if linked {
+		return nil, status.Errorf(codes.AlreadyExists, "a %s identity is already linked, unlink it first", external.Provider)
+	}
+
+	identity, err := s.linkExternalIdentity(ctx, user, external)
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditLogin(ctx, "auth.identity_linked", user.ID, user.Email, util.DeviceInfoFromContext(ctx), map[string]interface{}{
+		"provider": external.Provider,
+		"subject":  external.Subject,
+	})
+
+	return &authpb.LinkIdentityResponse{
+		Identity: convertToProtoIdentity(identity),
+	}, nil
+}
+
+func (s *authService) UnlinkIdentity(ctx context.Context, userID int, req *authpb.UnlinkIdentityRequest) (*authpb.UnlinkIdentityResponse, error) {
+	if req.Provider == "" {
+		return nil, status.Error(codes.InvalidArgument, "provider is required")
+	}
+
+	// Re-authenticating with a password also guarantees a login method remains
+	user, err := s.reauthenticate(ctx, userID, req.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	removed, err := s.repo.DeleteIdentity(ctx, userID, req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	if !removed {
+		return &authpb.UnlinkIdentityResponse{Success: false}, nil
+	}
+
+	if req.Provider == util.ProviderFirebase {
+		if err := s.repo.SetFirebaseUID(ctx, userID, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	s.auditLogin(ctx, "auth.identity_unlinked", user.ID, user.Email, util.DeviceInfoFromContext(ctx), map[string]interface{}{
+		"provider": req.Provider,
+	})
+
+	return &authpb.UnlinkIdentityResponse{Success: true}, nil
+}
+
+// reauthenticate checks the current password before identity changes. Failures
+// count towards the login throttle so this can't be used to guess passwords.
+func (s *authService) reauthenticate(ctx context.Context, userID int, password string) (schema.User, error) {
+	user, err := s.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return schema.User{}, err
+	}
+	if !hasPassword(user) {
+		return schema.User{}, status.Error(codes.FailedPrecondition, "set a password before changing linked accounts")
+	}
+	if password == "" {
+		return schema.User{}, status.Error(codes.Unauthenticated, "current password is required")
+	}
+
+	device := util.DeviceInfoFromContext(ctx)
+	throttle, err := s.throttle.Check(ctx, user.Email, device.IPAddress)
+	if err != nil {
+		return schema.User{}, err
+	}
+	if throttle.Locked || throttle.Throttled {
+		return schema.User{}, status.Errorf(codes.ResourceExhausted, "too many attempts, try again in %d seconds", retrySeconds(throttle.RetryAfter))
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash.String), []byte(password)); err != nil {
+		s.recordLoginFailure(ctx, &user, user.Email, device, "reauth_failed")
+		return schema.User{}, status.Error(codes.Unauthenticated, "invalid password")
+	}
+
+	return user, nil
+}
+
+// addPasswordToSocialAccount lets a user who signed up through a provider add
+// a password. It only takes effect after the OTP sent to the email is verified.
+func (s *authService) addPasswordToSocialAccount(ctx context.Context, user schema.User, password string) (*authpb.SignupResponse, error) {
+	if err := s.passwords.Validate(password, user.Email); err != nil {
+		return &authpb.SignupResponse{
+			Message: err.Error(),
+			OtpSent: false,
+		}, nil
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.StorePendingPassword(ctx, user.Email, string(hashedPassword), 10*time.Minute); err != nil {
+		return nil, err
+	}
+
+	otp := generateOTP()
+	if err := s.repo.StoreOTP(ctx, user.Email, otp, 10*time.Minute); err != nil {
+		return nil, err
+	}
+	if err := s.email.SendOTP(user.Email, otp); err != nil {
+		return nil, err
+	}
+
+	return &authpb.SignupResponse{
+		Message: "An account with this email already exists, verify the OTP sent to your email to add this password to it",
+		OtpSent: true,
+	}, nil
+}
+
+// applyPendingPassword sets a password stored by addPasswordToSocialAccount once the OTP is verified.
+func (s *authService) applyPendingPassword(ctx context.Context, user schema.User) error {
+	hash, err := s.repo.PopPendingPassword(ctx, user.Email)
+	if err != nil || hash == "" || hasPassword(user) {
+		return err
+	}
+
+	err = s.repo.UpdateUserPassword(ctx, schema.UpdateUserPasswordParams{
+		ID:           user.ID,
+		PasswordHash: pgtype.Text{String: hash, Valid: true},
+	})
+	if err != nil {
+		return err
+	}
+
+	s.auditLogin(ctx, "auth.password_linked", user.ID, user.Email, util.DeviceInfoFromContext(ctx), nil)
+	return nil
+}
+
+func hasPassword(user schema.User) bool {
+	return user.PasswordHash.Valid && user.PasswordHash.String != ""
+}
+
+func convertToProtoIdentity(identity schema.UserIdentity) *schemapb.UserIdentity {
+	protoIdentity := &schemapb.UserIdentity{
+		Id:            identity.ID,
+		UserId:        identity.UserID,
+		Provider:      identity.Provider,
+		Subject:       identity.Subject,
+		Email:         identity.Email.String,
+		EmailVerified: identity.EmailVerified.Bool,
+		CreatedAt:     identity.CreatedAt.Time.Unix(),
+	}
+	if identity.LastLoginAt.Valid {
+		protoIdentity.LastLoginAt = identity.LastLoginAt.Time.Unix()
+	}
+	return protoIdentity
+}
@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	authpb "rival/gen/proto/proto/api"
+	"rival/internal/auth/util"
+	"rival/pkg/sms"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const phoneOTPExpiry = 10 * time.Minute
+
+// Login and verification codes live under different keys so one can't be
+// used for the other.
+func phoneLoginKey(phone string) string {
+	return "phone_login:" + phone
+}
+
+func phoneVerifyKey(userID int, phone string) string {
+	return "phone_verify:" + strconv.Itoa(userID) + ":" + phone
+}
+
+func normalizePhone(raw string) (string, error) {
+	phone, err := sms.NormalizePhone(raw)
+	if err != nil {
+		return "", status.Error(codes.InvalidArgument, "enter a valid mobile number")
+	}
+	return phone, nil
+}
+
+// sendPhoneOTP stores a fresh code for key in the same OTP store as email
+// codes and texts it, at most once per sms.resend_seconds for the number.
+func (s *authService) sendPhoneOTP(ctx context.Context, key, phone string) error {
+	if s.sms == nil {
+		return status.Error(codes.FailedPrecondition, "phone sign in is not available")
+	}
+	if err := s.phoneCooldown(ctx, phone); err != nil {
+		return err
+	}
+	return s.deliverPhoneOTP(ctx, key, phone)
+}
+
+// phoneCooldown lets one code through per sms.resend_seconds for the number.
+func (s *authService) phoneCooldown(ctx context.Context, phone string) error {
+	allowed, err := s.repo.OTPCooldown(ctx, phone, s.smsResend)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return status.Errorf(codes.ResourceExhausted, "wait %d seconds before requesting another code", retrySeconds(s.smsResend))
+	}
+	return nil
+}
+
+// deliverPhoneOTP stores a fresh code for key and texts it.
+func (s *authService) deliverPhoneOTP(ctx context.Context, key, phone string) error {
+	otp := generateOTP()
+	if err := s.repo.StoreOTP(ctx, key, otp, phoneOTPExpiry); err != nil {
+		return err
+	}
+
+	err := s.sms.Send(ctx, sms.Message{
+		To:     phone,
+		Body:   fmt.Sprintf("%s is your RIVAL code. It expires in %d minutes. Don't share it with anyone.", otp, int(phoneOTPExpiry.Minutes())),
+		Params: map[string]string{"otp": otp},
+	})
+	if err != nil {
+		fmt.Printf("Failed to send SMS through %s: %v\n", s.sms.Name(), err)
+		return status.Error(codes.Unavailable, "couldn't send the code, try again shortly")
+	}
+	return nil
+}
+
+func (s *authService) SendPhoneVerification(ctx context.Context, userID int, rawPhone string) (*authpb.SendPhoneVerificationResponse, error) {
+	phone, err := normalizePhone(rawPhone)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := s.repo.GetUserByVerifiedPhone(ctx, phone)
+	if err == nil && int(owner.ID) != userID {
+		return nil, status.Error(codes.AlreadyExists, "this number is already verified on another account")
+	}
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	if err := s.sendPhoneOTP(ctx, phoneVerifyKey(userID, phone), phone); err != nil {
+		return nil, err
+	}
+
+	return &authpb.SendPhoneVerificationResponse{
+		Phone:     phone,
+		Message:   "Code sent to " + phone,
+		ExpiresIn: int64(phoneOTPExpiry.Seconds()),
+	}, nil
+}
+
+func (s *authService) ConfirmPhoneVerification(ctx context.Context, userID int, rawPhone, otp string) (*authpb.ConfirmPhoneVerificationResponse, error) {
+	phone, err := normalizePhone(rawPhone)
+	if err != nil {
+		return nil, err
+	}
+
+	valid, err := s.repo.VerifyOTP(ctx, phoneVerifyKey(userID, phone), otp)
+	if err != nil || !valid {
+		return nil, status.Error(codes.InvalidArgument, "invalid or expired code")
+	}
+
+	err = s.repo.MarkPhoneVerified(ctx, userID, phone)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return nil, status.Error(codes.AlreadyExists, "this number is already verified on another account")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	s.auditLogin(ctx, "auth.phone_verified", user.ID, user.Email, util.DeviceInfoFromContext(ctx), map[string]interface{}{
+		"phone": phone,
+	})
+
+	return &authpb.ConfirmPhoneVerificationResponse{User: convertToProtoUser(user)}, nil
+}
+
+// LoginWithPhone texts a sign in code to a verified number. Every number gets
+// the same answer, whether it is unknown, cooling down or couldn't be texted,
+// so it can't be used to find accounts. The cooldown runs before the lookup to
+// treat unknown numbers the same way.
+func (s *authService) LoginWithPhone(ctx context.Context, rawPhone string) (*authpb.LoginWithPhoneResponse, error) {
+	phone, err := normalizePhone(rawPhone)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &authpb.LoginWithPhoneResponse{
+		Phone:     phone,
+		Message:   "If this number is verified on an account, a code has been sent to it",
+		ExpiresIn: int64(phoneOTPExpiry.Seconds()),
+	}
+
+	if s.sms == nil {
+		fmt.Printf("Phone sign in requested for %s but no SMS provider is configured\n", phone)
+		return resp, nil
+	}
+
+	err = s.phoneCooldown(ctx, phone)
+	if status.Code(err) == codes.ResourceExhausted {
+		return resp, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.repo.GetUserByVerifiedPhone(ctx, phone)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return resp, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.deliverPhoneOTP(ctx, phoneLoginKey(phone), phone); err != nil {
+		fmt.Printf("Failed to send phone login code: %v\n", err)
+	}
+	return resp, nil
+}
+
+// VerifyPhoneOTP signs in with a code from LoginWithPhone. Failures count
+// towards the same lockout as password logins, keyed by the number.
+func (s *authService) VerifyPhoneOTP(ctx context.Context, rawPhone, otp string) (*authpb.VerifyPhoneOTPResponse, error) {
+	phone, err := normalizePhone(rawPhone)
+	if err != nil {
+		return nil, err
+	}
+	device := util.DeviceInfoFromContext(ctx)
+
+	throttle, err := s.throttle.Check(ctx, phone, device.IPAddress)
+	if err != nil {
+		return nil, err
+	}
+	if throttle.Locked || throttle.Throttled {
+		return nil, status.Errorf(codes.ResourceExhausted, "too many attempts, try again in %d seconds", retrySeconds(throttle.RetryAfter))
+	}
+
+	valid, err := s.repo.VerifyOTP(ctx, phoneLoginKey(phone), otp)
+	if err != nil || !valid {
+		if _, err := s.throttle.RecordFailure(ctx, phone, device.IPAddress); err != nil {
+			fmt.Printf("Failed to record login failure: %v\n", err)
+		}
+		s.auditLogin(ctx, "auth.login_failed", 0, "", device, map[string]interface{}{
+			"reason": "bad_phone_otp",
+			"phone":  phone,
+		})
+		return nil, status.Error(codes.Unauthenticated, "invalid or expired code")
+	}
+
+	user, err := s.repo.GetUserByVerifiedPhone(ctx, phone)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid or expired code")
+	}
+
+	if err := s.throttle.RecordSuccess(ctx, phone, device.IPAddress); err != nil {
+		fmt.Printf("Failed to reset login counters: %v\n", err)
+	}
+
+	protoUser := convertToProtoUser(user)
+	accessToken, refreshToken, err := s.createSession(ctx, user, protoUser)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authpb.VerifyPhoneOTPResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		User:         protoUser,
+		ExpiresIn:    86400,
+	}, nil
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"crypto/rand"
+	"errors"
 	"fmt"
 	"math/big"
 	"strconv"
@@ -17,11 +18,19 @@ import (
 	userrepo "rival/internal/users/repo"
 
 	"rival/internal/auth/util"
+	"rival/pkg/audit"
+	"rival/pkg/mail"
+	"rival/pkg/notify"
 	"rival/pkg/referral"
+	"rival/pkg/sms"
 	"rival/pkg/tb"
 
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type SignupParams struct {
@@ -31,6 +40,7 @@ type SignupParams struct {
 	Phone        string
 	Role         schemapb.UserRole
 	ReferralCode string // Optional referral code
+	Locale       string // Optional language of emails
 }
 
 type LoginParams struct {
@@ -54,49 +64,114 @@ type AuthService interface {
 	VerifyOTP(ctx context.Context, params VerifyOTPParams) (*authpb.VerifyOTPResponse, error)
 	ResendOTP(ctx context.Context, email string) (*authpb.ResendOTPResponse, error)
 	Login(ctx context.Context, params LoginParams) (*authpb.LoginResponse, error)
-	FirebaseLogin(ctx context.Context, firebaseToken string) (*authpb.FirebaseLoginResponse, error)
+	FirebaseLogin(ctx context.Context, provider, firebaseToken string) (*authpb.FirebaseLoginResponse, error)
+	SocialLogin(ctx context.Context, provider, idToken string) (*authpb.SocialLoginResponse, error)
 	ForgotPassword(ctx context.Context, email string) (*authpb.ForgotPasswordResponse, error)
 	ResetPassword(ctx context.Context, params ResetPasswordParams) (*authpb.ResetPasswordResponse, error)
 	RefreshToken(ctx context.Context, refreshToken string) (*authpb.RefreshTokenResponse, error)
 	Logout(ctx context.Context, token string) (*authpb.LogoutResponse, error)
 	WhoAmI(ctx context.Context, userID int) (*authpb.WhoAmIResponse, error)
+	ListSessions(ctx context.Context, userID int, currentSessionID string) (*authpb.ListSessionsResponse, error)
+	ListIdentities(ctx context.Context, userID int) (*authpb.ListIdentitiesResponse, error)
+	LinkIdentity(ctx context.Context, userID int, req *authpb.LinkIdentityRequest) (*authpb.LinkIdentityResponse, error)
+	UnlinkIdentity(ctx context.Context, userID int, req *authpb.UnlinkIdentityRequest) (*authpb.UnlinkIdentityResponse, error)
+	RevokeSession(ctx context.Context, userID int, sessionID int64) (*authpb.RevokeSessionResponse, error)
+	UnlockAccount(ctx context.Context, token string) (*authpb.UnlockAccountResponse, error)
+	SendPhoneVerification(ctx context.Context, userID int, phone string) (*authpb.SendPhoneVerificationResponse, error)
+	ConfirmPhoneVerification(ctx context.Context, userID int, phone, otp string) (*authpb.ConfirmPhoneVerificationResponse, error)
+	LoginWithPhone(ctx context.Context, phone string) (*authpb.LoginWithPhoneResponse, error)
+	VerifyPhoneOTP(ctx context.Context, phone, otp string) (*authpb.VerifyPhoneOTPResponse, error)
 }
 
 type authService struct {
-	repo     repo.AuthRepository
-	jwt      util.JWTUtil
-	email    util.Service
-	firebase *util.FirebaseService
-	tb       *tb.TbService
-	referral *referral.Service
+	repo      repo.AuthRepository
+	jwt       util.JWTUtil
+	email     util.Service
+	providers *util.IdentityProviders
+	tb        *tb.TbService
+	referral  *referral.Service
+	sessions  *util.SessionBlocklist
+	throttle  *util.LoginThrottler
+	passwords util.PasswordPolicy
+	audit     *audit.Service
+	notifier  *notify.Service
+	sms       sms.SMSProvider // nil when phone sign in is off
+	smsResend time.Duration
 }
 
-func NewAuthService(authRepo repo.AuthRepository, jwt util.JWTUtil, email util.Service, firebase *util.FirebaseService) AuthService {
+func NewAuthService(authRepo repo.AuthRepository, jwt util.JWTUtil, email util.Service, providers *util.IdentityProviders) AuthService {
 	tbService, _ := tb.NewService()
 	cfg := config.GetConfig()
 	db, _ := connection.GetPgConnection(&cfg.Database)
 	referralService := referral.NewService(db, tbService)
+	if providers == nil {
+		providers = util.NewIdentityProvidersFromConfig(cfg.Identity)
+	}
+	smsProvider, err := sms.NewSMSProviderFromConfig(cfg.SMS)
+	if err != nil {
+		fmt.Printf("Phone sign in disabled: %v\n", err)
+	}
+	smsResend := time.Duration(cfg.SMS.ResendSeconds) * time.Second
+	if smsResend <= 0 {
+		smsResend = 30 * time.Second
+	}
 
 	return &authService{
-		repo:     authRepo,
-		jwt:      jwt,
-		email:    email,
-		firebase: firebase,
-		tb:       tbService,
-		referral: referralService,
+		repo:      authRepo,
+		jwt:       jwt,
+		email:     email,
+		providers: providers,
+		tb:        tbService,
+		referral:  referralService,
+		sessions:  util.NewSessionBlocklist(),
+		throttle:  util.NewLoginThrottler(cfg.Security.Login),
+		passwords: util.NewPasswordPolicy(cfg.Security.Password),
+		audit:     audit.NewService(db),
+		notifier:  notify.NewService(db),
+		sms:       smsProvider,
+		smsResend: smsResend,
 	}
 }
 
 func (s *authService) Signup(ctx context.Context, params SignupParams) (*authpb.SignupResponse, error) {
 	// Check if user already exists
-	_, err := s.repo.GetUserByEmail(ctx, params.Email)
+	existing, err := s.repo.GetUserByEmail(ctx, params.Email)
 	if err == nil {
+		if existing.PasswordHash.Valid && existing.PasswordHash.String != "" {
+			return &authpb.SignupResponse{
+				Message: "User already exists",
+				OtpSent: false,
+			}, nil
+		}
+		// Social-only account, add the password once the email is proven
+		return s.addPasswordToSocialAccount(ctx, existing, params.Password)
+	}
+
+	if err := s.passwords.Validate(params.Password, params.Email); err != nil {
+		return &authpb.SignupResponse{
+			Message: err.Error(),
+			OtpSent: false,
+		}, nil
+	}
+
+	if params.Locale != "" && !mail.IsSupportedLocale(params.Locale) {
 		return &authpb.SignupResponse{
-			Message: "User already exists",
+			Message: "Unsupported language: " + params.Locale,
 			OtpSent: false,
 		}, nil
 	}
 
+	// Phones are stored in E.164 so phone sign in can find them
+	phone := params.Phone
+	if phone != "" {
+		if phone, err = sms.NormalizePhone(phone); err != nil {
+			return &authpb.SignupResponse{
+				Message: "Enter a valid mobile number",
+				OtpSent: false,
+			}, nil
+		}
+	}
+
 	// Hash password
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(params.Password), bcrypt.DefaultCost)
 	if err != nil {
@@ -107,7 +182,7 @@ func (s *authService) Signup(ctx context.Context, params SignupParams) (*authpb.
 	createParams := schema.CreateUserParams{
 		Email:        params.Email,
 		PasswordHash: pgtype.Text{String: string(hashedPassword), Valid: true},
-		Phone:        pgtype.Text{String: params.Phone, Valid: params.Phone != ""},
+		Phone:        pgtype.Text{String: phone, Valid: phone != ""},
 		Name:         params.Name,
 		Role:         params.Role.String(),
 	}
@@ -117,6 +192,13 @@ func (s *authService) Signup(ctx context.Context, params SignupParams) (*authpb.
 		return nil, err
 	}
 
+	// Emails below are already written in the chosen language
+	if params.Locale != "" {
+		if err := s.notifier.SetLocale(ctx, user.ID, params.Locale); err != nil {
+			fmt.Printf("Failed to save language: %v\n", err)
+		}
+	}
+
 	// Give initial signup bonus coins (e.g., 10 coins)
 	err = s.giveInitialCoins(int(user.ID), 10.0)
 	if err != nil {
@@ -140,14 +222,15 @@ func (s *authService) Signup(ctx context.Context, params SignupParams) (*authpb.
 		return nil, err
 	}
 
-	s.email.SendWelcomeEmail(params.Email, user.Name)
+	// Both are only queued, the welcome email must not fail signup
+	if err := s.email.SendWelcomeEmail(params.Email, user.Name); err != nil {
+		fmt.Printf("Failed to send welcome email: %v\n", err)
+	}
 	err = s.email.SendOTP(params.Email, otp)
 	if err != nil {
 		return nil, err
 	}
 
-	// Send welcome email
-
 	return &authpb.SignupResponse{
 		Message: "OTP sent to your email",
 		OtpSent: true,
@@ -155,9 +238,9 @@ func (s *authService) Signup(ctx context.Context, params SignupParams) (*authpb.
 }
 
 func (s *authService) VerifyOTP(ctx context.Context, params VerifyOTPParams) (*authpb.VerifyOTPResponse, error) {
-	valid, otp, err := s.repo.VerifyOTP(ctx, params.Email, params.OTP)
+	valid, err := s.repo.VerifyOTP(ctx, params.Email, params.OTP)
 	if err != nil || !valid {
-		return nil, fmt.Errorf("invalid OTP %v", otp)
+		return nil, fmt.Errorf("invalid or expired OTP")
 	}
 
 	user, err := s.repo.GetUserByEmail(ctx, params.Email)
@@ -165,21 +248,17 @@ func (s *authService) VerifyOTP(ctx context.Context, params VerifyOTPParams) (*a
 		return nil, err
 	}
 
-	protoUser := convertToProtoUser(user)
-	accessToken, refreshToken, err := s.jwt.GenerateTokens(protoUser)
-	if err != nil {
+	// The code reached the inbox, so the user owns the email
+	if err := s.repo.MarkEmailVerified(ctx, int(user.ID)); err != nil {
 		return nil, err
 	}
 
-	// Store session
-	sessionParams := schema.CreateJWTSessionParams{
-		UserID:           pgtype.Int8{Int64: user.ID, Valid: true},
-		TokenHash:        s.jwt.HashToken(accessToken),
-		RefreshTokenHash: pgtype.Text{String: s.jwt.HashToken(refreshToken), Valid: true},
-		ExpiresAt:        pgtype.Timestamp{Time: time.Now().Add(24 * time.Hour), Valid: true},
+	if err := s.applyPendingPassword(ctx, user); err != nil {
+		return nil, err
 	}
 
-	err = s.repo.CreateSession(ctx, sessionParams)
+	protoUser := convertToProtoUser(user)
+	accessToken, refreshToken, err := s.createSession(ctx, user, protoUser)
 	if err != nil {
 		return nil, err
 	}
@@ -192,6 +271,9 @@ func (s *authService) VerifyOTP(ctx context.Context, params VerifyOTPParams) (*a
 	}, nil
 }
 
+// emailOTPResend is how long ResendOTP waits between codes for one email.
+const emailOTPResend = time.Minute
+
 func (s *authService) ResendOTP(ctx context.Context, email string) (*authpb.ResendOTPResponse, error) {
 	// Check if user exists
 	_, err := s.repo.GetUserByEmail(ctx, email)
@@ -202,6 +284,15 @@ func (s *authService) ResendOTP(ctx context.Context, email string) (*authpb.Rese
 		}, nil
 	}
 
+	// Same cooldown as phone codes, so the endpoint can't flood an inbox
+	allowed, err := s.repo.OTPCooldown(ctx, email, emailOTPResend)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, status.Errorf(codes.ResourceExhausted, "wait %d seconds before requesting another code", retrySeconds(emailOTPResend))
+	}
+
 	otp := generateOTP()
 	err = s.repo.StoreOTP(ctx, email, otp, 10*time.Minute)
 	if err != nil {
@@ -220,34 +311,42 @@ func (s *authService) ResendOTP(ctx context.Context, email string) (*authpb.Rese
 }
 
 func (s *authService) Login(ctx context.Context, params LoginParams) (*authpb.LoginResponse, error) {
+	device := util.DeviceInfoFromContext(ctx)
+
+	// Refuse before running bcrypt when the email, IP or pair is locked or throttled
+	throttle, err := s.throttle.Check(ctx, params.Email, device.IPAddress)
+	if err != nil {
+		return nil, err
+	}
+	if throttle.Locked || throttle.Throttled {
+		s.auditLogin(ctx, "auth.login_blocked", 0, params.Email, device, map[string]interface{}{
+			"scope":       throttle.Scope,
+			"locked":      throttle.Locked,
+			"retry_after": int(throttle.RetryAfter.Seconds()),
+		})
+		return nil, status.Errorf(codes.ResourceExhausted, "too many login attempts, try again in %d seconds", retrySeconds(throttle.RetryAfter))
+	}
+
 	// Get user
 	user, err := s.repo.GetUserByEmail(ctx, params.Email)
 	if err != nil {
+		s.recordLoginFailure(ctx, nil, params.Email, device, "unknown_email")
 		return nil, fmt.Errorf("invalid credentials")
 	}
 
 	// Verify password
 	err = bcrypt.CompareHashAndPassword([]byte(user.PasswordHash.String), []byte(params.Password))
 	if err != nil {
+		s.recordLoginFailure(ctx, &user, params.Email, device, "bad_password")
 		return nil, fmt.Errorf("invalid credentials")
 	}
-	// Generate tokens
-	protoUser := convertToProtoUser(user)
-	accessToken, refreshToken, err := s.jwt.GenerateTokens(protoUser)
-	if err != nil {
-		return nil, err
-	}
 
-	// Store session
-	sessionParams := schema.CreateJWTSessionParams{
-		UserID:           pgtype.Int8{Int64: user.ID, Valid: true},
-		TokenHash:        s.jwt.HashToken(accessToken),
-		RefreshTokenHash: pgtype.Text{String: s.jwt.HashToken(refreshToken), Valid: true},
-		ExpiresAt:        pgtype.Timestamp{Time: time.Now().Add(24 * time.Hour), Valid: true},
+	if err := s.throttle.RecordSuccess(ctx, params.Email, device.IPAddress); err != nil {
+		fmt.Printf("Failed to reset login counters: %v\n", err)
 	}
-
-	err = s.repo.CreateSession(ctx, sessionParams)
-
+	// Generate tokens
+	protoUser := convertToProtoUser(user)
+	accessToken, refreshToken, err := s.createSession(ctx, user, protoUser)
 	if err != nil {
 		return nil, err
 	}
@@ -260,57 +359,43 @@ func (s *authService) Login(ctx context.Context, params LoginParams) (*authpb.Lo
 	}, nil
 }
 
-func (s *authService) FirebaseLogin(ctx context.Context, firebaseToken string) (*authpb.FirebaseLoginResponse, error) {
-	// Verify Firebase token
-	firebase, err := util.NewFirebaseService(context.Background())
-	if err != nil {
-		return nil, fmt.Errorf("error initializing firebase service: %v", err)
+// FirebaseLogin is kept for older clients, it is SocialLogin defaulting to Firebase.
+func (s *authService) FirebaseLogin(ctx context.Context, provider, firebaseToken string) (*authpb.FirebaseLoginResponse, error) {
+	if provider == "" {
+		provider = util.ProviderFirebase
 	}
-	firebaseUser, err := firebase.VerifyToken(ctx, firebaseToken)
+
+	resp, err := s.SocialLogin(ctx, provider, firebaseToken)
 	if err != nil {
-		return nil, fmt.Errorf("invalid firebase token: %v", err)
+		return nil, err
 	}
 
-	// Check if user exists
-	user, err := s.repo.GetUserByEmail(ctx, firebaseUser.Email)
-	if err != nil {
-		// Create new user if doesn't exist
-		createParams := schema.CreateUserParams{
-			Email:       firebaseUser.Email,
-			Name:        firebaseUser.Name,
-			ProfilePic:  pgtype.Text{String: firebaseUser.Picture, Valid: firebaseUser.Picture != ""},
-			Phone:       pgtype.Text{String: firebaseUser.PhoneNumber, Valid: firebaseUser.PhoneNumber != ""},
-			FirebaseUid: pgtype.Text{String: firebaseUser.UID, Valid: true},
-			Role:        "customer",
-		}
-
-		user, err = s.repo.CreateUser(ctx, createParams)
-		if err != nil {
-			return nil, err
-		}
-
-		s.email.SendWelcomeEmail(firebaseUser.Email, firebaseUser.Name)
-	}
+	return &authpb.FirebaseLoginResponse{
+		AccessToken:  resp.AccessToken,
+		RefreshToken: resp.RefreshToken,
+		User:         resp.User,
+		ExpiresIn:    resp.ExpiresIn,
+	}, nil
+}
 
-	protoUser := convertToProtoUser(user)
-	accessToken, refreshToken, err := s.jwt.GenerateTokens(protoUser)
+func (s *authService) SocialLogin(ctx context.Context, provider, idToken string) (*authpb.SocialLoginResponse, error) {
+	external, err := s.verifyIDToken(ctx, provider, idToken)
 	if err != nil {
 		return nil, err
 	}
 
-	sessionParams := schema.CreateJWTSessionParams{
-		UserID:           pgtype.Int8{Int64: user.ID, Valid: true},
-		TokenHash:        s.jwt.HashToken(accessToken),
-		RefreshTokenHash: pgtype.Text{String: s.jwt.HashToken(refreshToken), Valid: true},
-		ExpiresAt:        pgtype.Timestamp{Time: time.Now().Add(24 * time.Hour), Valid: true},
+	user, err := s.resolveSocialUser(ctx, external)
+	if err != nil {
+		return nil, err
 	}
 
-	err = s.repo.CreateSession(ctx, sessionParams)
+	protoUser := convertToProtoUser(user)
+	accessToken, refreshToken, err := s.createSession(ctx, user, protoUser)
 	if err != nil {
 		return nil, err
 	}
 
-	return &authpb.FirebaseLoginResponse{
+	return &authpb.SocialLoginResponse{
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken,
 		User:         protoUser,
@@ -347,8 +432,16 @@ func (s *authService) ForgotPassword(ctx context.Context, email string) (*authpb
 }
 
 func (s *authService) ResetPassword(ctx context.Context, params ResetPasswordParams) (*authpb.ResetPasswordResponse, error) {
+	// Check the policy before the OTP is consumed so the user can retry
+	if err := s.passwords.Validate(params.NewPassword, params.Email); err != nil {
+		return &authpb.ResetPasswordResponse{
+			Message: err.Error(),
+			Success: false,
+		}, nil
+	}
+
 	// Verify reset OTP
-	valid, _, err := s.repo.VerifyOTP(ctx, "reset:"+params.Email, params.OTP)
+	valid, err := s.repo.VerifyOTP(ctx, "reset:"+params.Email, params.OTP)
 	if err != nil || !valid {
 		return &authpb.ResetPasswordResponse{
 			Message: "Invalid OTP",
@@ -378,6 +471,9 @@ func (s *authService) ResetPassword(ctx context.Context, params ResetPasswordPar
 	if err != nil {
 		return nil, err
 	}
+	if err := s.repo.MarkEmailVerified(ctx, int(user.ID)); err != nil {
+		return nil, err
+	}
 	return &authpb.ResetPasswordResponse{
 		Message: "Password reset successfully",
 		Success: true,
@@ -385,6 +481,21 @@ func (s *authService) ResetPassword(ctx context.Context, params ResetPasswordPar
 }
 
 func (s *authService) RefreshToken(ctx context.Context, refreshToken string) (*authpb.RefreshTokenResponse, error) {
+	claims, err := s.jwt.ValidateRefreshToken(refreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// Revoked sessions can't mint new access tokens
+	if claims.SessionID != "" {
+		if _, err := s.repo.GetSessionBySessionID(ctx, claims.SessionID); err != nil {
+			return nil, fmt.Errorf("session has been revoked")
+		}
+		if err := s.repo.TouchSession(ctx, claims.SessionID, time.Now().Add(24*time.Hour)); err != nil {
+			fmt.Printf("Failed to update session activity: %v\n", err)
+		}
+	}
+
 	// Generate new access token
 	newAccessToken, err := s.jwt.RefreshAccessToken(refreshToken)
 	if err != nil {
@@ -406,6 +517,18 @@ func (s *authService) Logout(ctx context.Context, token string) (*authpb.LogoutR
 		return nil, err
 	}
 
+	// Access tokens minted by a refresh have a different hash, revoke by session too
+	if claims, err := s.jwt.ValidateToken(token); err == nil && claims.SessionID != "" {
+		if session, err := s.repo.GetSessionBySessionID(ctx, claims.SessionID); err == nil {
+			if _, err := s.repo.RevokeUserSession(ctx, claims.UserID, session.ID); err != nil {
+				return nil, err
+			}
+		}
+		if err := s.sessions.Revoke(ctx, claims.SessionID, s.jwt.RefreshTTL()); err != nil {
+			return nil, err
+		}
+	}
+
 	return &authpb.LogoutResponse{Success: true}, nil
 }
 
@@ -420,6 +543,184 @@ func (s *authService) WhoAmI(ctx context.Context, userID int) (*authpb.WhoAmIRes
 	}, nil
 }
 
+func (s *authService) ListSessions(ctx context.Context, userID int, currentSessionID string) (*authpb.ListSessionsResponse, error) {
+	sessions, err := s.repo.ListUserSessions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var protoSessions []*schemapb.UserSession
+	for _, session := range sessions {
+		protoSession := convertToProtoSession(session)
+		protoSession.Current = currentSessionID != "" && session.SessionID.String == currentSessionID
+		protoSessions = append(protoSessions, protoSession)
+	}
+
+	return &authpb.ListSessionsResponse{
+		Sessions: protoSessions,
+	}, nil
+}
+
+func (s *authService) RevokeSession(ctx context.Context, userID int, sessionID int64) (*authpb.RevokeSessionResponse, error) {
+	session, err := s.repo.RevokeUserSession(ctx, userID, sessionID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// Not the caller's session, or already revoked
+		return &authpb.RevokeSessionResponse{Success: false}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Kill access tokens that are still in flight for this session
+	if err := s.sessions.Revoke(ctx, session.SessionID.String, s.jwt.RefreshTTL()); err != nil {
+		return nil, err
+	}
+
+	return &authpb.RevokeSessionResponse{Success: true}, nil
+}
+
+func (s *authService) UnlockAccount(ctx context.Context, token string) (*authpb.UnlockAccountResponse, error) {
+	email, err := s.throttle.Unlock(ctx, token)
+	if err != nil {
+		return &authpb.UnlockAccountResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	var userID int64
+	if user, err := s.repo.GetUserByEmail(ctx, email); err == nil {
+		userID = user.ID
+	}
+	s.auditLogin(ctx, "auth.account_unlocked", userID, email, util.DeviceInfoFromContext(ctx), nil)
+
+	return &authpb.UnlockAccountResponse{
+		Success: true,
+		Message: "Account unlocked, you can log in again",
+	}, nil
+}
+
+// recordLoginFailure bumps the throttle counters, audits the attempt and sends
+// the unlock email when this failure locked the account.
+func (s *authService) recordLoginFailure(ctx context.Context, user *schema.User, email string, device util.DeviceInfo, reason string) {
+	var userID int64
+	if user != nil {
+		userID = user.ID
+	}
+
+	result, err := s.throttle.RecordFailure(ctx, email, device.IPAddress)
+	if err != nil {
+		fmt.Printf("Failed to record login failure: %v\n", err)
+	}
+
+	s.auditLogin(ctx, "auth.login_failed", userID, email, device, map[string]interface{}{
+		"reason":   reason,
+		"failures": result.EmailFailures,
+	})
+
+	if !result.EmailLocked {
+		return
+	}
+
+	s.auditLogin(ctx, "auth.account_locked", userID, email, device, map[string]interface{}{
+		"lockout_minutes": int(s.throttle.LockoutDuration().Minutes()),
+	})
+
+	// Only real accounts get an unlock email, unknown emails just stay locked
+	if user == nil {
+		return
+	}
+	token, err := s.throttle.CreateUnlockToken(ctx, email)
+	if err != nil {
+		fmt.Printf("Failed to create unlock token: %v\n", err)
+		return
+	}
+	go func() {
+		if err := s.email.SendAccountLockedEmail(user.Email, token, s.throttle.LockoutDuration()); err != nil {
+			fmt.Printf("Failed to send account locked email: %v\n", err)
+		}
+	}()
+}
+
+func (s *authService) auditLogin(ctx context.Context, action string, userID int64, email string, device util.DeviceInfo, metadata map[string]interface{}) {
+	if metadata == nil {
+		metadata = map[string]interface{}{}
+	}
+	metadata["email"] = util.NormalizeEmail(email)
+
+	actorType := audit.ActorAnon
+	if userID != 0 {
+		actorType = audit.ActorUser
+	}
+
+	err := s.audit.Log(ctx, audit.Entry{
+		ActorID:    userID,
+		ActorType:  actorType,
+		Action:     action,
+		TargetType: "user",
+		TargetID:   userID,
+		Metadata:   metadata,
+		IPAddress:  device.IPAddress,
+		UserAgent:  device.UserAgent,
+	})
+	if err != nil {
+		fmt.Printf("Failed to write audit log: %v\n", err)
+	}
+}
+
+func retrySeconds(d time.Duration) int {
+	seconds := int((d + time.Second - 1) / time.Second)
+	if seconds < 1 {
+		return 1
+	}
+	return seconds
+}
+
+// createSession issues tokens for a new session, records the device it came
+// from and warns the user by email when the device hasn't been seen before.
+func (s *authService) createSession(ctx context.Context, user schema.User, protoUser *schemapb.User) (string, string, error) {
+	sessionID := uuid.NewString()
+	accessToken, refreshToken, err := s.jwt.GenerateTokens(protoUser, sessionID)
+	if err != nil {
+		return "", "", err
+	}
+
+	device := util.DeviceInfoFromContext(ctx)
+
+	// Check before inserting so the new session doesn't count as a known device
+	stats, statsErr := s.repo.GetUserDeviceStats(ctx, int(user.ID), device.DeviceID)
+
+	sessionParams := schema.CreateJWTSessionParams{
+		UserID:           pgtype.Int8{Int64: user.ID, Valid: true},
+		TokenHash:        s.jwt.HashToken(accessToken),
+		RefreshTokenHash: pgtype.Text{String: s.jwt.HashToken(refreshToken), Valid: true},
+		ExpiresAt:        pgtype.Timestamp{Time: time.Now().Add(24 * time.Hour), Valid: true},
+		SessionID:        pgtype.Text{String: sessionID, Valid: true},
+		DeviceID:         pgtype.Text{String: device.DeviceID, Valid: device.DeviceID != ""},
+		DeviceName:       pgtype.Text{String: device.DeviceName, Valid: device.DeviceName != ""},
+		Platform:         pgtype.Text{String: device.Platform, Valid: device.Platform != ""},
+		UserAgent:        pgtype.Text{String: device.UserAgent, Valid: device.UserAgent != ""},
+		IpAddress:        pgtype.Text{String: device.IPAddress, Valid: device.IPAddress != ""},
+	}
+
+	err = s.repo.CreateSession(ctx, sessionParams)
+	if err != nil {
+		return "", "", err
+	}
+
+	// First ever login is the signup itself, no need to alert. Only apps send a
+	// device id, a user agent fingerprint changes with every browser update.
+	if device.DeviceIDSent && statsErr == nil && stats.TotalSessions > 0 && stats.DeviceSessions == 0 {
+		go func() {
+			if err := s.email.SendNewDeviceLoginEmail(user.Email, user.Name, device, time.Now()); err != nil {
+				fmt.Printf("Failed to send new device email: %v\n", err)
+			}
+		}()
+	}
+
+	return accessToken, refreshToken, nil
+}
+
 func (s *authService) giveInitialCoins(userID int, amount float64) error {
 	// Add coins to TigerBeetle
 	err := s.tb.AddCoins(userID, amount)
@@ -480,18 +781,33 @@ func convertToProtoUser(user schema.User) *schemapb.User {
 	}
 
 	return &schemapb.User{
-		Id:           int64(userID),
-		Email:        user.Email,
-		PasswordHash: user.PasswordHash.String,
-		Phone:        user.Phone.String,
-		Name:         user.Name,
-		ProfilePic:   userrepo.GenerateViewURL(strconv.Itoa(userID), "profile.jpg"),
-		FirebaseUid:  user.FirebaseUid.String,
-		CoinBalance:  coinBalance,
-		Role:         role,
-		ReferralCode: user.ReferralCode.String,
-		ReferredBy:   referredBy,
-		CreatedAt:    user.CreatedAt.Time.Unix(),
-		UpdatedAt:    user.UpdatedAt.Time.Unix(),
+		Id:            int64(userID),
+		Email:         user.Email,
+		PasswordHash:  user.PasswordHash.String,
+		Phone:         user.Phone.String,
+		Name:          user.Name,
+		ProfilePic:    userrepo.GenerateViewURL(strconv.Itoa(userID), "profile.jpg"),
+		FirebaseUid:   user.FirebaseUid.String,
+		CoinBalance:   coinBalance,
+		Role:          role,
+		ReferralCode:  user.ReferralCode.String,
+		ReferredBy:    referredBy,
+		CreatedAt:     user.CreatedAt.Time.Unix(),
+		UpdatedAt:     user.UpdatedAt.Time.Unix(),
+		PhoneVerified: user.PhoneVerifiedAt.Valid,
+	}
+}
+
+func convertToProtoSession(session schema.JwtSession) *schemapb.UserSession {
+	return &schemapb.UserSession{
+		Id:         session.ID,
+		DeviceId:   session.DeviceID.String,
+		DeviceName: session.DeviceName.String,
+		Platform:   session.Platform.String,
+		UserAgent:  session.UserAgent.String,
+		IpAddress:  session.IpAddress.String,
+		CreatedAt:  session.CreatedAt.Time.Unix(),
+		LastSeenAt: session.LastSeenAt.Time.Unix(),
+		ExpiresAt:  session.ExpiresAt.Time.Unix(),
 	}
 }
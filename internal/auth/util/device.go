@@ -0,0 +1,207 @@
+package util
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// DeviceInfo describes the client a session was created from.
+type DeviceInfo struct {
+	DeviceID     string
+	DeviceIDSent bool // false when DeviceID is a user agent fingerprint
+	DeviceName   string
+	Platform     string
+	UserAgent    string
+	IPAddress    string
+}
+
+// DeviceInfoFromContext reads device metadata sent by the apps (x-device-id,
+// x-device-name, x-platform) and falls back to the user agent when missing.
+func DeviceInfoFromContext(ctx context.Context) DeviceInfo {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	info := DeviceInfo{
+		DeviceID:   firstMetadata(md, "x-device-id"),
+		DeviceName: firstMetadata(md, "x-device-name"),
+		Platform:   strings.ToLower(firstMetadata(md, "x-platform")),
+		UserAgent:  firstMetadata(md, "user-agent"),
+		IPAddress:  ClientIP(ctx),
+	}
+	info.DeviceIDSent = info.DeviceID != ""
+
+	if info.Platform == "" {
+		info.Platform = platformFromUserAgent(info.UserAgent)
+	}
+	if info.DeviceName == "" {
+		info.DeviceName = deviceNameFromUserAgent(info.UserAgent, info.Platform)
+	}
+	if info.DeviceID == "" && info.UserAgent != "" {
+		// Without an app provided id the user agent is the best fingerprint we have
+		hash := sha256.Sum256([]byte(info.UserAgent))
+		info.DeviceID = "ua:" + hex.EncodeToString(hash[:8])
+	}
+
+	return info
+}
+
+var (
+	trustedProxiesMu sync.RWMutex
+	trustedProxies   []*net.IPNet
+)
+
+// SetTrustedProxies sets the load balancers allowed to report the client
+// address in x-forwarded-for (security.trusted_proxies, CIDRs or plain IPs).
+// Without any, x-forwarded-for is ignored.
+func SetTrustedProxies(cidrs []string) error {
+	nets := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		if !strings.Contains(cidr, "/") {
+			ip := net.ParseIP(cidr)
+			if ip == nil {
+				return fmt.Errorf("invalid trusted proxy: %s", cidr)
+			}
+			bits := 128
+			if ip.To4() != nil {
+				bits = 32
+			}
+			cidr = fmt.Sprintf("%s/%d", cidr, bits)
+		}
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return fmt.Errorf("invalid trusted proxy: %s", cidr)
+		}
+		nets = append(nets, ipNet)
+	}
+
+	trustedProxiesMu.Lock()
+	defer trustedProxiesMu.Unlock()
+	trustedProxies = nets
+	return nil
+}
+
+func isTrustedProxy(addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+
+	trustedProxiesMu.RLock()
+	defer trustedProxiesMu.RUnlock()
+	for _, ipNet := range trustedProxies {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientIP returns the address the request came from. x-forwarded-for is only
+// read when the connection comes from a trusted proxy, and from the right:
+// each proxy appends the address it saw, so the first hop that isn't one of
+// ours is the client. Anything further left was sent by the client itself.
+func ClientIP(ctx context.Context) string {
+	ip := peerIP(ctx)
+	if !isTrustedProxy(ip) {
+		return ip
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	hops := strings.Split(strings.Join(md.Get("x-forwarded-for"), ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if net.ParseIP(hop) == nil {
+			break
+		}
+		ip = hop
+		if !isTrustedProxy(hop) {
+			break
+		}
+	}
+	return ip
+}
+
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+func firstMetadata(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return strings.TrimSpace(values[0])
+	}
+	return ""
+}
+
+func platformFromUserAgent(userAgent string) string {
+	ua := strings.ToLower(userAgent)
+	switch {
+	case strings.Contains(ua, "android"):
+		return "android"
+	case strings.Contains(ua, "iphone"), strings.Contains(ua, "ipad"), strings.Contains(ua, "ios"):
+		return "ios"
+	case strings.Contains(ua, "mozilla"):
+		return "web"
+	case strings.Contains(ua, "grpc"):
+		return "api"
+	}
+	return "unknown"
+}
+
+func deviceNameFromUserAgent(userAgent, platform string) string {
+	ua := strings.ToLower(userAgent)
+
+	var os string
+	switch {
+	case strings.Contains(ua, "android"):
+		os = "Android"
+	case strings.Contains(ua, "iphone"):
+		os = "iPhone"
+	case strings.Contains(ua, "ipad"):
+		os = "iPad"
+	case strings.Contains(ua, "windows"):
+		os = "Windows"
+	case strings.Contains(ua, "mac os"):
+		os = "macOS"
+	case strings.Contains(ua, "linux"):
+		os = "Linux"
+	}
+
+	var browser string
+	switch {
+	case strings.Contains(ua, "edg/"):
+		browser = "Edge"
+	case strings.Contains(ua, "chrome/"):
+		browser = "Chrome"
+	case strings.Contains(ua, "firefox/"):
+		browser = "Firefox"
+	case strings.Contains(ua, "safari/"):
+		browser = "Safari"
+	}
+
+	switch {
+	case browser != "" && os != "":
+		return browser + " on " + os
+	case os != "":
+		return os
+	case browser != "":
+		return browser
+	case platform == "api":
+		return "API client"
+	}
+	return "Unknown device"
+}
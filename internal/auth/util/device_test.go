@@ -0,0 +1,90 @@
+package util
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestDeviceInfoFromContext(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		"user-agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 Chrome/120.0 Safari/537.36",
+		"x-forwarded-for", "203.0.113.9, 10.0.0.1",
+	))
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.2"), Port: 443}})
+	if err := SetTrustedProxies([]string{"10.0.0.0/8"}); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = SetTrustedProxies(nil) })
+
+	info := DeviceInfoFromContext(ctx)
+	if info.DeviceName != "Chrome on Windows" || info.Platform != "web" {
+		t.Errorf("unexpected device %+v", info)
+	}
+	if info.IPAddress != "203.0.113.9" {
+		t.Errorf("expected forwarded IP, got %s", info.IPAddress)
+	}
+	if info.DeviceID == "" || info.DeviceID != DeviceInfoFromContext(ctx).DeviceID {
+		t.Errorf("expected stable user agent fingerprint, got %q", info.DeviceID)
+	}
+	if info.DeviceIDSent {
+		t.Errorf("user agent fingerprint reported as an app device id")
+	}
+}
+
+func TestDeviceInfoFromAppMetadata(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		"x-device-id", "device-123",
+		"x-device-name", "Pixel 8",
+		"x-platform", "Android",
+	))
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("198.51.100.4"), Port: 5555}})
+
+	info := DeviceInfoFromContext(ctx)
+	if info.DeviceID != "device-123" || !info.DeviceIDSent || info.DeviceName != "Pixel 8" || info.Platform != "android" {
+		t.Errorf("unexpected device %+v", info)
+	}
+	if info.IPAddress != "198.51.100.4" {
+		t.Errorf("expected peer IP, got %s", info.IPAddress)
+	}
+}
+
+func TestClientIPIgnoresSpoofedForwardedFor(t *testing.T) {
+	if err := SetTrustedProxies([]string{"10.0.0.0/8", "192.0.2.1"}); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = SetTrustedProxies(nil) })
+
+	cases := []struct {
+		peer      string
+		forwarded string
+		want      string
+	}{
+		// Clients connecting directly can't pick their address
+		{"198.51.100.4", "203.0.113.9", "198.51.100.4"},
+		// Behind our proxies the rightmost hop that isn't ours is the client
+		{"10.0.0.2", "1.2.3.4, 203.0.113.9, 192.0.2.1", "203.0.113.9"},
+		{"10.0.0.2", "203.0.113.9", "203.0.113.9"},
+		// Garbage left of the client doesn't matter
+		{"10.0.0.2", "not-an-ip, 203.0.113.9", "203.0.113.9"},
+		{"10.0.0.2", "", "10.0.0.2"},
+	}
+	for _, c := range cases {
+		md := metadata.MD{}
+		if c.forwarded != "" {
+			md.Set("x-forwarded-for", c.forwarded)
+		}
+		ctx := metadata.NewIncomingContext(context.Background(), md)
+		ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(c.peer), Port: 443}})
+		if got := ClientIP(ctx); got != c.want {
+			t.Errorf("ClientIP(peer %s, xff %q) = %s, want %s", c.peer, c.forwarded, got, c.want)
+		}
+	}
+
+	if err := SetTrustedProxies([]string{"10.0.0.0/33"}); err == nil {
+		t.Errorf("invalid CIDR accepted")
+	}
+}
@@ -1,77 +1,134 @@
 package util
 
 import (
-	"fmt"
-	"net/smtp"
+	"context"
+	"log"
 	"rival/config"
+	"rival/connection"
+	"rival/pkg/mail"
+	"rival/pkg/notify"
+	"time"
 )
 
 type Service interface {
 	SendOTP(email, otp string) error
 	SendWelcomeEmail(email, name string) error
 	SendPasswordResetEmail(email, otp string) error
+	SendNewDeviceLoginEmail(email, name string, device DeviceInfo, at time.Time) error
+	SendAccountLockedEmail(email, unlockToken string, lockout time.Duration) error
+	SendStaffInvitationEmail(email, merchantName, role, token string, expiresAt time.Time) error
 }
 
+// EmailPolicy decides whether the owner of an address wants emails of a
+// notification category and which language to write in. notify.Service is
+// the implementation.
+type EmailPolicy interface {
+	AllowEmail(ctx context.Context, address, category string) bool
+	EmailLocale(ctx context.Context, address string) string
+}
+
+// EmailService renders the mail templates and queues them in the outbox, the
+// mail.Worker delivers them with retries.
 type EmailService struct {
-	smtpHost string
-	smtpPort string
-	from     string
+	from      string
+	policy    EmailPolicy
+	outbox    *mail.Outbox
+	transport mail.Transport // only used without the database
 }
 
 func NewEmailService() *EmailService {
 	cfg := config.GetConfig()
-	return &EmailService{
-		smtpHost: cfg.MailHog.SMTPServer,
-		smtpPort: fmt.Sprintf("%d", cfg.MailHog.SMTPPort),
-		from:     "noreply@rival.com",
+	service := &EmailService{from: mail.FromAddress(cfg.MailHog)}
+
+	db, err := connection.GetPgConnection(&cfg.Database)
+	if err != nil {
+		// Without the database there is no queue or preferences, send right away
+		log.Printf("email queue unavailable, sending directly: %v", err)
+		transport, err := mail.NewTransportFromConfig(cfg.MailHog)
+		if err != nil {
+			log.Printf("falling back to smtp: %v", err)
+			transport = mail.NewSMTPTransport(cfg.MailHog.SMTPServer, cfg.MailHog.SMTPPort)
+		}
+		service.transport = transport
+		return service
 	}
+
+	service.policy = notify.NewService(db)
+	service.outbox = mail.NewOutbox(db)
+	return service
 }
 
 func (e *EmailService) SendOTP(email, otp string) error {
-	subject := "Your OTP Code - RIVAL"
-	body := fmt.Sprintf(`
-		<h2>Your OTP Code</h2>
-		<p>Your verification code is: <strong>%s</strong></p>
-		<p>This code will expire in 10 minutes.</p>
-		<p>If you didn't request this, please ignore this email.</p>
-	`, otp)
-
-	return e.sendEmail(email, subject, body)
+	return e.send(email, notify.CategorySecurity, mail.TemplateOTP, mail.Data{
+		"OTP":              otp,
+		"ExpiresInMinutes": 10,
+	})
 }
 
 func (e *EmailService) SendWelcomeEmail(email, name string) error {
-	subject := "Welcome to RIVAL!"
-	body := fmt.Sprintf(`
-		<h2>Welcome to RIVAL, %s!</h2>
-		<p>Your account has been successfully created.</p>
-		<p>Start earning coins and enjoying discounts at your favorite restaurants!</p>
-	`, name)
-
-	return e.sendEmail(email, subject, body)
+	return e.send(email, notify.CategoryAccount, mail.TemplateWelcome, mail.Data{
+		"Name": name,
+	})
 }
 
 func (e *EmailService) SendPasswordResetEmail(email, otp string) error {
-	subject := "Password Reset - RIVAL"
-	body := fmt.Sprintf(`
-		<h2>Password Reset Request</h2>
-		<p>Your password reset code is: <strong>%s</strong></p>
-		<p>This code will expire in 10 minutes.</p>
-		<p>If you didn't request this, please ignore this email.</p>
-	`, otp)
-
-	return e.sendEmail(email, subject, body)
+	return e.send(email, notify.CategorySecurity, mail.TemplatePasswordReset, mail.Data{
+		"OTP":              otp,
+		"ExpiresInMinutes": 10,
+	})
 }
 
-func (e *EmailService) sendEmail(to, subject, body string) error {
-	msg := fmt.Sprintf("To: %s\r\nSubject: %s\r\nContent-Type: text/html; charset=UTF-8\r\n\r\n%s", to, subject, body)
+func (e *EmailService) SendNewDeviceLoginEmail(email, name string, device DeviceInfo, at time.Time) error {
+	return e.send(email, notify.CategorySecurity, mail.TemplateNewDeviceLogin, mail.Data{
+		"Name":       name,
+		"DeviceName": device.DeviceName,
+		"Platform":   device.Platform,
+		"IPAddress":  device.IPAddress,
+		"At":         at,
+	})
+}
+
+func (e *EmailService) SendAccountLockedEmail(email, unlockToken string, lockout time.Duration) error {
+	return e.send(email, notify.CategorySecurity, mail.TemplateAccountLocked, mail.Data{
+		"LockoutMinutes": int(lockout.Minutes()),
+		"UnlockToken":    unlockToken,
+	})
+}
 
-	// MailHog doesn't require authentication
-	addr := fmt.Sprintf("%s:%s", e.smtpHost, e.smtpPort)
-	return smtp.SendMail(addr, nil, e.from, []string{to}, []byte(msg))
+func (e *EmailService) SendStaffInvitationEmail(email, merchantName, role, token string, expiresAt time.Time) error {
+	return e.send(email, notify.CategoryAccount, mail.TemplateStaffInvitation, mail.Data{
+		"MerchantName": merchantName,
+		"Role":         role,
+		"Token":        token,
+		"ExpiresAt":    expiresAt,
+	})
+}
+
+// send queues the template in the recipient's language unless they turned
+// emails of the category off. Security emails are always sent.
+func (e *EmailService) send(to, category, template string, data mail.Data) error {
+	ctx := context.Background()
+
+	locale := mail.DefaultLocale
+	if e.policy != nil {
+		if !e.policy.AllowEmail(ctx, to, category) {
+			return nil
+		}
+		locale = e.policy.EmailLocale(ctx, to)
+	}
+
+	if e.outbox != nil {
+		return e.outbox.Enqueue(ctx, to, template, locale, data)
+	}
+
+	msg, err := mail.Render(template, locale, data)
+	if err != nil {
+		return err
+	}
+	msg.From, msg.To = e.from, to
+	return e.transport.Send(ctx, msg)
 }
 
 func (e *EmailService) SendOtp(email, otp string) error {
-	subject := "Your OTP Code"
-	body := fmt.Sprintf("Your OTP code is: %s", otp)
-	return e.sendEmail(email, subject, body)
+	return e.SendOTP(email, otp)
 }
@@ -10,17 +10,21 @@ import (
 	"google.golang.org/api/option"
 )
 
+// ProviderFirebase is the user_identities provider for Firebase accounts.
+const ProviderFirebase = "firebase"
+
 type FirebaseService interface {
 	VerifyToken(ctx context.Context, idToken string) (*FirebaseUser, error)
 }
 
 type FirebaseUser struct {
-	UID         string
-	Email       string
-	Name        string
-	Picture     string
-	PhoneNumber string
-	Provider    string
+	UID           string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Picture       string
+	PhoneNumber   string
+	Provider      string
 }
 
 type firebaseService struct {
@@ -62,11 +66,12 @@ func (f *firebaseService) VerifyToken(ctx context.Context, idToken string) (*Fir
 	}
 
 	return &FirebaseUser{
-		UID:         token.UID,
-		Email:       userRecord.Email,
-		Name:        userRecord.DisplayName,
-		Picture:     userRecord.PhotoURL,
-		PhoneNumber: userRecord.PhoneNumber,
-		Provider:    provider,
+		UID:           token.UID,
+		Email:         userRecord.Email,
+		EmailVerified: userRecord.EmailVerified,
+		Name:          userRecord.DisplayName,
+		Picture:       userRecord.PhotoURL,
+		PhoneNumber:   userRecord.PhoneNumber,
+		Provider:      provider,
 	}, nil
 }
@@ -0,0 +1,120 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+
+	"rival/config"
+)
+
+// ExternalIdentity is what a social login provider vouches for after verifying an ID token.
+type ExternalIdentity struct {
+	Provider      string // user_identities provider, e.g. firebase or google
+	Subject       string // stable user id at the provider
+	Email         string
+	EmailVerified bool
+	Name          string
+	Picture       string
+	PhoneNumber   string
+}
+
+// IdentityProvider verifies ID tokens issued by one social login provider.
+type IdentityProvider interface {
+	Name() string
+	Verify(ctx context.Context, idToken string) (*ExternalIdentity, error)
+}
+
+// IdentityProviders looks up providers by the name clients send.
+type IdentityProviders struct {
+	mu        sync.RWMutex
+	providers map[string]IdentityProvider
+}
+
+func NewIdentityProviders(providers ...IdentityProvider) *IdentityProviders {
+	registry := &IdentityProviders{providers: make(map[string]IdentityProvider)}
+	for _, provider := range providers {
+		registry.Register(provider)
+	}
+	return registry
+}
+
+// NewIdentityProvidersFromConfig registers Firebase plus every configured OIDC provider.
+func NewIdentityProvidersFromConfig(cfg config.IdentityConfig) *IdentityProviders {
+	registry := NewIdentityProviders(NewFirebaseIdentityProvider())
+
+	for _, oidc := range cfg.OIDC {
+		if oidc.Name == "" || oidc.Issuer == "" || len(oidc.ClientIDs) == 0 {
+			continue
+		}
+		registry.Register(NewOIDCProvider(oidc))
+	}
+
+	if cfg.LocalStub {
+		log.Printf("WARNING: local OIDC stub is enabled, anyone can mint ID tokens for provider %q", ProviderLocal)
+		registry.Register(GetLocalOIDCStub().Provider())
+	}
+
+	return registry
+}
+
+func (p *IdentityProviders) Register(provider IdentityProvider) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.providers[provider.Name()] = provider
+}
+
+func (p *IdentityProviders) Get(name string) (IdentityProvider, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	provider, ok := p.providers[name]
+	if !ok {
+		return nil, fmt.Errorf("unsupported identity provider: %s", name)
+	}
+	return provider, nil
+}
+
+// firebaseIdentityProvider wraps the Firebase admin SDK. The SDK needs a
+// credentials file so it is only initialized on first use.
+type firebaseIdentityProvider struct {
+	mu      sync.Mutex
+	service FirebaseService
+}
+
+func NewFirebaseIdentityProvider() IdentityProvider {
+	return &firebaseIdentityProvider{}
+}
+
+func (f *firebaseIdentityProvider) Name() string {
+	return ProviderFirebase
+}
+
+func (f *firebaseIdentityProvider) Verify(ctx context.Context, idToken string) (*ExternalIdentity, error) {
+	f.mu.Lock()
+	if f.service == nil {
+		service, err := NewFirebaseService(context.Background())
+		if err != nil {
+			f.mu.Unlock()
+			return nil, fmt.Errorf("error initializing firebase service: %v", err)
+		}
+		f.service = service
+	}
+	service := f.service
+	f.mu.Unlock()
+
+	firebaseUser, err := service.VerifyToken(ctx, idToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExternalIdentity{
+		Provider:      ProviderFirebase,
+		Subject:       firebaseUser.UID,
+		Email:         firebaseUser.Email,
+		EmailVerified: firebaseUser.EmailVerified,
+		Name:          firebaseUser.Name,
+		Picture:       firebaseUser.Picture,
+		PhoneNumber:   firebaseUser.PhoneNumber,
+	}, nil
+}
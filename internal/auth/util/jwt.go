@@ -4,79 +4,112 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"sync"
 	"time"
 
+	"rival/config"
+	"rival/connection"
 	schemapb "rival/gen/proto/proto/schema"
 
 	"github.com/golang-jwt/jwt/v5"
 )
 
+const (
+	AccessAudience  = "rival-access"
+	RefreshAudience = "rival-refresh"
+)
+
 type JWTUtil interface {
-	GenerateTokens(user *schemapb.User) (accessToken, refreshToken string, err error)
+	GenerateTokens(user *schemapb.User, sessionID string) (accessToken, refreshToken string, err error)
 	ValidateToken(token string) (*TokenClaims, error)
+	ValidateRefreshToken(token string) (*TokenClaims, error)
 	RefreshAccessToken(refreshToken string) (string, error)
 	HashToken(token string) string
+	RefreshTTL() time.Duration
 }
 
 type TokenClaims struct {
-	UserID int               `json:"user_id"`
-	Email  string            `json:"email"`
-	Role   schemapb.UserRole `json:"role"`
-	Exp    int64             `json:"exp"`
+	UserID    int               `json:"user_id"`
+	Email     string            `json:"email"`
+	Role      schemapb.UserRole `json:"role"`
+	SessionID string            `json:"sid,omitempty"`
 	jwt.RegisteredClaims
 }
 
 type jwtUtil struct {
-	secretKey       string
+	keys            *KeyRing
+	issuer          string
 	accessTokenTTL  time.Duration
 	refreshTokenTTL time.Duration
 }
 
-func NewJWTUtil(secretKey string, accessTTL, refreshTTL time.Duration) JWTUtil {
+func NewJWTUtil(keys *KeyRing, issuer string, accessTTL, refreshTTL time.Duration) JWTUtil {
 	return &jwtUtil{
-		secretKey:       secretKey,
+		keys:            keys,
+		issuer:          issuer,
 		accessTokenTTL:  accessTTL,
 		refreshTokenTTL: refreshTTL,
 	}
 }
 
-func (j *jwtUtil) GenerateTokens(user *schemapb.User) (accessToken, refreshToken string, err error) {
-	// Access token claims
-	accessClaims := TokenClaims{
-		UserID: int(user.Id),
-		Email:  user.Email,
-		Role:   user.Role,
-		Exp:    time.Now().Add(j.accessTokenTTL).Unix(),
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(j.accessTokenTTL)),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			Subject:   fmt.Sprintf("%d", user.Id),
-		},
-	}
+var (
+	keyRing     *KeyRing
+	keyRingErr  error
+	keyRingOnce sync.Once
+)
+
+// GetKeyRing returns the process wide key ring configured under jwt in config.yml.
+// Keys live in redis by default so every instance signs with the same ring.
+func GetKeyRing() (*KeyRing, error) {
+	keyRingOnce.Do(func() {
+		cfg := config.GetConfig().JWT
+		algorithm := cfg.Algorithm
+		if algorithm == "" {
+			algorithm = AlgEdDSA
+		}
+		var store KeyStore
+		switch cfg.KeyStore {
+		case "file":
+			keysDir := cfg.KeysDir
+			if keysDir == "" {
+				keysDir = "keys"
+			}
+			store = NewFileKeyStore(keysDir)
+		case "", "redis":
+			store = NewRedisKeyStore(connection.GetRedisClient(&config.GetConfig().Redis))
+		default:
+			keyRingErr = fmt.Errorf("unknown jwt key store: %s", cfg.KeyStore)
+			return
+		}
+		keyRing, keyRingErr = NewKeyRing(algorithm, time.Duration(cfg.GraceHour)*time.Hour, store)
+	})
+	return keyRing, keyRingErr
+}
 
-	// Generate access token
-	accessTokenObj := jwt.NewWithClaims(jwt.SigningMethodHS256, accessClaims)
-	accessToken, err = accessTokenObj.SignedString([]byte(j.secretKey))
+// GetJWTUtil returns a JWTUtil backed by the shared key ring.
+func GetJWTUtil() (JWTUtil, error) {
+	keys, err := GetKeyRing()
 	if err != nil {
-		return "", "", err
+		return nil, err
 	}
 
-	// Refresh token claims
-	refreshClaims := TokenClaims{
-		UserID: int(user.Id),
-		Email:  user.Email,
-		Role:   user.Role,
-		Exp:    time.Now().Add(j.refreshTokenTTL).Unix(),
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(j.refreshTokenTTL)),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			Subject:   fmt.Sprintf("%d", user.Id),
-		},
+	cfg := config.GetConfig().JWT
+	refreshHours := cfg.RefreshExpiryHour
+	if refreshHours == 0 {
+		refreshHours = 7 * 24
 	}
+	return NewJWTUtil(keys, cfg.Issuer,
+		time.Duration(cfg.ExpiryHour)*time.Hour,
+		time.Duration(refreshHours)*time.Hour), nil
+}
 
-	// Generate refresh token
-	refreshTokenObj := jwt.NewWithClaims(jwt.SigningMethodHS256, refreshClaims)
-	refreshToken, err = refreshTokenObj.SignedString([]byte(j.secretKey))
+func (j *jwtUtil) GenerateTokens(user *schemapb.User, sessionID string) (accessToken, refreshToken string, err error) {
+	accessToken, err = j.sign(int(user.Id), user.Email, user.Role, sessionID, AccessAudience, j.accessTokenTTL)
+	if err != nil {
+		return "", "", err
+	}
+
+	refreshToken, err = j.sign(int(user.Id), user.Email, user.Role, sessionID, RefreshAudience, j.refreshTokenTTL)
 	if err != nil {
 		return "", "", err
 	}
@@ -85,48 +118,84 @@ func (j *jwtUtil) GenerateTokens(user *schemapb.User) (accessToken, refreshToken
 }
 
 func (j *jwtUtil) ValidateToken(token string) (*TokenClaims, error) {
-	tokenObj, err := jwt.ParseWithClaims(token, &TokenClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return []byte(j.secretKey), nil
-	})
+	return j.parse(token, AccessAudience)
+}
+
+func (j *jwtUtil) ValidateRefreshToken(token string) (*TokenClaims, error) {
+	return j.parse(token, RefreshAudience)
+}
 
+func (j *jwtUtil) RefreshAccessToken(refreshToken string) (string, error) {
+	claims, err := j.ValidateRefreshToken(refreshToken)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
-	if claims, ok := tokenObj.Claims.(*TokenClaims); ok && tokenObj.Valid {
-		return claims, nil
-	}
+	return j.sign(claims.UserID, claims.Email, claims.Role, claims.SessionID, AccessAudience, j.accessTokenTTL)
+}
 
-	return nil, fmt.Errorf("invalid token")
+func (j *jwtUtil) HashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
 }
 
-func (j *jwtUtil) RefreshAccessToken(refreshToken string) (string, error) {
-	claims, err := j.ValidateToken(refreshToken)
-	if err != nil {
-		return "", err
+func (j *jwtUtil) RefreshTTL() time.Duration {
+	return j.refreshTokenTTL
+}
+
+func (j *jwtUtil) sign(userID int, email string, role schemapb.UserRole, sessionID, audience string, ttl time.Duration) (string, error) {
+	key := j.keys.Active()
+	if key == nil {
+		return "", fmt.Errorf("no active signing key")
 	}
 
-	// Create new access token with same user info
-	newClaims := TokenClaims{
-		UserID: claims.UserID,
-		Email:  claims.Email,
-		Role:   claims.Role,
-		Exp:    time.Now().Add(j.accessTokenTTL).Unix(),
+	now := time.Now()
+	claims := TokenClaims{
+		UserID:    userID,
+		Email:     email,
+		Role:      role,
+		SessionID: sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(j.accessTokenTTL)),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			Subject:   fmt.Sprintf("%d", claims.UserID),
+			Issuer:    j.issuer,
+			Audience:  jwt.ClaimStrings{audience},
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			Subject:   fmt.Sprintf("%d", userID),
 		},
 	}
 
-	accessTokenObj := jwt.NewWithClaims(jwt.SigningMethodHS256, newClaims)
-	return accessTokenObj.SignedString([]byte(j.secretKey))
+	tokenObj := jwt.NewWithClaims(key.method(), claims)
+	tokenObj.Header["kid"] = key.ID
+	return tokenObj.SignedString(key.Private)
 }
 
-func (j *jwtUtil) HashToken(token string) string {
-	hash := sha256.Sum256([]byte(token))
-	return hex.EncodeToString(hash[:])
+func (j *jwtUtil) parse(token, audience string) (*TokenClaims, error) {
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{AlgRS256, AlgEdDSA}),
+		jwt.WithAudience(audience),
+		jwt.WithExpirationRequired(),
+	}
+	if j.issuer != "" {
+		opts = append(opts, jwt.WithIssuer(j.issuer))
+	}
+
+	tokenObj, err := jwt.ParseWithClaims(token, &TokenClaims{}, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		key, ok := j.keys.Find(kid)
+		if !ok {
+			return nil, fmt.Errorf("unknown signing key: %s", kid)
+		}
+		if token.Method.Alg() != key.Algorithm {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return key.Private.Public(), nil
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims, ok := tokenObj.Claims.(*TokenClaims); ok && tokenObj.Valid {
+		return claims, nil
+	}
+	return nil, fmt.Errorf("invalid token")
 }
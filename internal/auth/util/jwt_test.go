@@ -0,0 +1,176 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	schemapb "rival/gen/proto/proto/schema"
+)
+
+func newTestJWTUtil(t *testing.T, alg string, grace time.Duration) (JWTUtil, *KeyRing) {
+	keys, err := NewKeyRing(alg, grace, NewFileKeyStore(t.TempDir()))
+	if err != nil {
+		t.Fatalf("failed to create key ring: %v", err)
+	}
+	return NewJWTUtil(keys, "rival-test", time.Hour, 24*time.Hour), keys
+}
+
+// publishKeys makes every key look older than the JWKS cache age.
+func publishKeys(keys *KeyRing) {
+	keys.mu.Lock()
+	defer keys.mu.Unlock()
+	for _, key := range keys.keys {
+		key.CreatedAt = key.CreatedAt.Add(-JWKSMaxAge)
+	}
+}
+
+func TestJWTSignAndValidate(t *testing.T) {
+	for _, alg := range []string{AlgEdDSA, AlgRS256} {
+		jwtUtil, _ := newTestJWTUtil(t, alg, time.Hour)
+		user := &schemapb.User{Id: 42, Email: "test@example.com", Role: schemapb.UserRole_USER_ROLE_CUSTOMER}
+
+		access, refresh, err := jwtUtil.GenerateTokens(user, "session-1")
+		if err != nil {
+			t.Fatalf("%s: failed to generate tokens: %v", alg, err)
+		}
+
+		claims, err := jwtUtil.ValidateToken(access)
+		if err != nil {
+			t.Fatalf("%s: access token rejected: %v", alg, err)
+		}
+		if claims.UserID != 42 || claims.Email != "test@example.com" || claims.SessionID != "session-1" {
+			t.Errorf("%s: unexpected claims %+v", alg, claims)
+		}
+
+		if _, err := jwtUtil.ValidateToken(refresh); err == nil {
+			t.Errorf("%s: refresh token accepted as access token", alg)
+		}
+		if _, err := jwtUtil.ValidateRefreshToken(access); err == nil {
+			t.Errorf("%s: access token accepted as refresh token", alg)
+		}
+
+		newAccess, err := jwtUtil.RefreshAccessToken(refresh)
+		if err != nil {
+			t.Fatalf("%s: failed to refresh: %v", alg, err)
+		}
+		if _, err := jwtUtil.ValidateToken(newAccess); err != nil {
+			t.Errorf("%s: refreshed access token rejected: %v", alg, err)
+		}
+	}
+}
+
+func TestKeyRotationGracePeriod(t *testing.T) {
+	jwtUtil, keys := newTestJWTUtil(t, AlgEdDSA, time.Hour)
+	user := &schemapb.User{Id: 1, Email: "a@example.com"}
+
+	oldAccess, _, err := jwtUtil.GenerateTokens(user, "session-1")
+	if err != nil {
+		t.Fatalf("failed to generate tokens: %v", err)
+	}
+	oldKid := keys.Active().ID
+
+	if _, err := keys.Rotate(); err != nil {
+		t.Fatalf("failed to rotate: %v", err)
+	}
+	// The new key is published before it signs
+	if keys.Active().ID != oldKid {
+		t.Fatalf("rotated key signed before verifiers could cache it")
+	}
+	if got := len(keys.JWKS().Keys); got != 2 {
+		t.Errorf("expected 2 keys in JWKS, got %d", got)
+	}
+	publishKeys(keys)
+	if keys.Active().ID == oldKid {
+		t.Fatalf("active key did not change once the new key was published")
+	}
+
+	// Old token still verifies inside the grace period
+	if _, err := jwtUtil.ValidateToken(oldAccess); err != nil {
+		t.Errorf("token signed by retired key rejected during grace: %v", err)
+	}
+	if got := len(keys.JWKS().Keys); got != 2 {
+		t.Errorf("expected 2 keys in JWKS, got %d", got)
+	}
+
+	// Once the grace period is over the old key is gone
+	keys.mu.Lock()
+	keys.keys[1].RetiredAt = time.Now().Add(-2 * time.Hour)
+	keys.mu.Unlock()
+
+	if _, err := jwtUtil.ValidateToken(oldAccess); err == nil {
+		t.Errorf("token signed by expired key accepted")
+	}
+	if got := len(keys.JWKS().Keys); got != 1 {
+		t.Errorf("expected 1 key in JWKS, got %d", got)
+	}
+}
+
+func TestKeyRingPersists(t *testing.T) {
+	dir := t.TempDir()
+	first, err := NewKeyRing(AlgRS256, time.Hour, NewFileKeyStore(dir))
+	if err != nil {
+		t.Fatalf("failed to create key ring: %v", err)
+	}
+
+	second, err := NewKeyRing(AlgRS256, time.Hour, NewFileKeyStore(dir))
+	if err != nil {
+		t.Fatalf("failed to reload key ring: %v", err)
+	}
+	if first.Active().ID != second.Active().ID {
+		t.Errorf("expected reloaded ring to reuse key %s, got %s", first.Active().ID, second.Active().ID)
+	}
+
+	jwks := second.JWKS()
+	if len(jwks.Keys) != 1 || jwks.Keys[0].Kty != "RSA" || jwks.Keys[0].N == "" {
+		t.Errorf("unexpected JWKS: %+v", jwks)
+	}
+}
+
+func TestKeyRingSharedBetweenInstances(t *testing.T) {
+	store := NewFileKeyStore(t.TempDir())
+	first, err := NewKeyRing(AlgEdDSA, time.Hour, store)
+	if err != nil {
+		t.Fatalf("failed to create key ring: %v", err)
+	}
+	second, err := NewKeyRing(AlgEdDSA, time.Hour, store)
+	if err != nil {
+		t.Fatalf("failed to create second key ring: %v", err)
+	}
+	if first.Active().ID != second.Active().ID {
+		t.Fatalf("instances started with different keys")
+	}
+
+	rotated, err := first.Rotate()
+	if err != nil {
+		t.Fatalf("failed to rotate: %v", err)
+	}
+
+	// The other instance sees the rotation instead of rotating again
+	again, err := second.RotateIfOlder(time.Hour)
+	if err != nil {
+		t.Fatalf("failed to check rotation: %v", err)
+	}
+	if again != nil || second.newest().ID != rotated.ID {
+		t.Errorf("second instance should pick up key %s, rotated to %v", rotated.ID, again)
+	}
+
+	// Tokens signed after another rotation verify once the kid is looked up
+	if _, err := first.Rotate(); err != nil {
+		t.Fatalf("failed to rotate: %v", err)
+	}
+	publishKeys(first)
+	user := &schemapb.User{Id: 7, Email: "b@example.com"}
+	access, _, err := NewJWTUtil(first, "rival-test", time.Hour, time.Hour).GenerateTokens(user, "session-1")
+	if err != nil {
+		t.Fatalf("failed to generate tokens: %v", err)
+	}
+	second.mu.Lock()
+	second.loadedAt = time.Now().Add(-time.Minute)
+	second.mu.Unlock()
+	if _, err := NewJWTUtil(second, "rival-test", time.Hour, time.Hour).ValidateToken(access); err != nil {
+		t.Errorf("token from another instance rejected: %v", err)
+	}
+	if got := len(second.JWKS().Keys); got != 3 {
+		t.Errorf("expected 3 keys in JWKS, got %d", got)
+	}
+}
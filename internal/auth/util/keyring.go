@@ -0,0 +1,551 @@
+package util
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	AlgRS256 = "RS256"
+	AlgEdDSA = "EdDSA"
+)
+
+// SigningKey is one entry of the key ring. A new key is published in JWKS
+// for JWKSMaxAge before it signs, so verifiers with a cached key set already
+// know it; older keys only verify until their grace period runs out.
+type SigningKey struct {
+	ID        string
+	Algorithm string
+	Private   crypto.Signer
+	CreatedAt time.Time
+	RetiredAt time.Time
+}
+
+func (k *SigningKey) method() jwt.SigningMethod {
+	if k.Algorithm == AlgEdDSA {
+		return jwt.SigningMethodEdDSA
+	}
+	return jwt.SigningMethodRS256
+}
+
+// KeyStore persists the key ring so restarts and other instances reuse the same keys.
+type KeyStore interface {
+	Load() ([]*SigningKey, error)
+	// Update replaces the stored keys with fn's result atomically, so two
+	// instances rotating at once can't overwrite each other's keys.
+	Update(fn func(keys []*SigningKey) ([]*SigningKey, error)) ([]*SigningKey, error)
+}
+
+// JWKSMaxAge is how long verifiers may cache the JWKS document.
+const JWKSMaxAge = 5 * time.Minute
+
+type KeyRing struct {
+	mu           sync.RWMutex
+	algorithm    string
+	grace        time.Duration
+	publishAfter time.Duration // how long a new key is published before it signs
+	store        KeyStore
+	keys         []*SigningKey // newest first
+	loadedAt     time.Time
+}
+
+// minReloadInterval limits the reloads Find does for unknown kids, which
+// anyone can put in a token.
+const minReloadInterval = 10 * time.Second
+
+func NewKeyRing(algorithm string, grace time.Duration, store KeyStore) (*KeyRing, error) {
+	if algorithm != AlgRS256 && algorithm != AlgEdDSA {
+		return nil, fmt.Errorf("unsupported signing algorithm: %s", algorithm)
+	}
+
+	keys, err := store.Load()
+	if err != nil {
+		return nil, fmt.Errorf("error loading signing keys: %v", err)
+	}
+
+	ring := &KeyRing{
+		algorithm:    algorithm,
+		grace:        grace,
+		publishAfter: JWKSMaxAge,
+		store:        store,
+		keys:         keys,
+		loadedAt:     time.Now(),
+	}
+
+	// Another instance may have created the key since we loaded
+	if active := ring.newest(); active == nil || active.Algorithm != algorithm {
+		if _, err := ring.rotateIf(func(active *SigningKey) bool {
+			return active == nil || active.Algorithm != algorithm
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return ring, nil
+}
+
+// Reload picks up keys rotated by other instances.
+func (k *KeyRing) Reload() error {
+	keys, err := k.store.Load()
+	if err != nil {
+		return fmt.Errorf("error loading signing keys: %v", err)
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.keys = keys
+	k.loadedAt = time.Now()
+	return nil
+}
+
+// Active returns the key used to sign new tokens: the newest key that has
+// been published for publishAfter. Until a rotated key gets there the oldest
+// key still in service keeps signing, so a brand new ring signs with its first
+// key straight away.
+func (k *KeyRing) Active() *SigningKey {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	now := time.Now()
+	var fallback *SigningKey
+	for _, key := range k.keys {
+		if !key.RetiredAt.IsZero() && !now.Before(key.RetiredAt) {
+			continue
+		}
+		if now.Sub(key.CreatedAt) >= k.publishAfter {
+			return key
+		}
+		fallback = key
+	}
+	return fallback
+}
+
+// newest returns the latest key, published or not, unless it was retired.
+func (k *KeyRing) newest() *SigningKey {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	if len(k.keys) == 0 || !k.keys[0].RetiredAt.IsZero() {
+		return nil
+	}
+	return k.keys[0]
+}
+
+// Lookup returns the key for a kid if it may still verify tokens.
+func (k *KeyRing) Lookup(kid string) (*SigningKey, bool) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	now := time.Now()
+	for _, key := range k.keys {
+		if key.ID != kid {
+			continue
+		}
+		if !key.RetiredAt.IsZero() && now.After(key.RetiredAt.Add(k.grace)) {
+			return nil, false
+		}
+		return key, true
+	}
+	return nil, false
+}
+
+// Find is Lookup that first reloads the ring for a kid it doesn't know, which
+// is usually a key another instance rotated in since the last reload.
+func (k *KeyRing) Find(kid string) (*SigningKey, bool) {
+	if key, ok := k.Lookup(kid); ok {
+		return key, true
+	}
+
+	k.mu.RLock()
+	recent := time.Since(k.loadedAt) < minReloadInterval
+	k.mu.RUnlock()
+	if recent {
+		return nil, false
+	}
+
+	if err := k.Reload(); err != nil {
+		log.Printf("JWT key reload failed: %v", err)
+		return nil, false
+	}
+	return k.Lookup(kid)
+}
+
+// Rotate generates a new key and retires the current one once the new key
+// has been published for publishAfter.
+func (k *KeyRing) Rotate() (*SigningKey, error) {
+	return k.rotateIf(func(*SigningKey) bool { return true })
+}
+
+// rotateIf rotates only if due still holds for the stored active key, and
+// returns nil if it doesn't. due is checked against the store rather than
+// this instance's copy, so only one instance rotates for each tick.
+func (k *KeyRing) rotateIf(due func(active *SigningKey) bool) (*SigningKey, error) {
+	key, err := generateSigningKey(k.algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	rotated := false
+	keys, err := k.store.Update(func(stored []*SigningKey) ([]*SigningKey, error) {
+		var active *SigningKey
+		if len(stored) > 0 && stored[0].RetiredAt.IsZero() {
+			active = stored[0]
+		}
+		// Update may call fn again after a conflicting write
+		rotated = due(active)
+		if !rotated {
+			return stored, nil
+		}
+
+		// The old key signs until the new one may, its grace runs from then
+		now := time.Now()
+		for _, old := range stored {
+			if old.RetiredAt.IsZero() {
+				old.RetiredAt = now.Add(k.publishAfter)
+			}
+		}
+		return append([]*SigningKey{key}, k.prune(stored, now)...), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error saving signing keys: %v", err)
+	}
+
+	k.mu.Lock()
+	k.keys = keys
+	k.loadedAt = time.Now()
+	k.mu.Unlock()
+
+	if !rotated {
+		return nil, nil
+	}
+	return key, nil
+}
+
+// prune drops retired keys whose grace period is over.
+func (k *KeyRing) prune(keys []*SigningKey, now time.Time) []*SigningKey {
+	var kept []*SigningKey
+	for _, key := range keys {
+		if !key.RetiredAt.IsZero() && now.After(key.RetiredAt.Add(k.grace)) {
+			continue
+		}
+		kept = append(kept, key)
+	}
+	return kept
+}
+
+// StartRotation reloads the ring from the store every minute and rotates the
+// active key every interval until ctx is done. Reloading keeps instances that
+// share the store signing with and publishing the same keys.
+func (k *KeyRing) StartRotation(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := k.Reload(); err != nil {
+				log.Printf("JWT key reload failed: %v", err)
+				continue
+			}
+			if interval <= 0 {
+				continue
+			}
+			key, err := k.RotateIfOlder(interval)
+			if err != nil {
+				log.Printf("JWT key rotation failed: %v", err)
+				continue
+			}
+			if key != nil {
+				log.Printf("JWT signing key rotated, new kid: %s", key.ID)
+			}
+		}
+	}
+}
+
+// RotateIfOlder rotates when the stored active key is older than maxAge and
+// returns nil when no rotation was needed.
+func (k *KeyRing) RotateIfOlder(maxAge time.Duration) (*SigningKey, error) {
+	return k.rotateIf(func(active *SigningKey) bool {
+		return active == nil || active.Algorithm != k.algorithm || time.Since(active.CreatedAt) >= maxAge
+	})
+}
+
+// JWK is the public half of a signing key as published in the JWKS document.
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS returns every key that may still verify tokens.
+func (k *KeyRing) JWKS() JWKSet {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	set := JWKSet{Keys: []JWK{}}
+	now := time.Now()
+	for _, key := range k.keys {
+		if !key.RetiredAt.IsZero() && now.After(key.RetiredAt.Add(k.grace)) {
+			continue
+		}
+		set.Keys = append(set.Keys, key.JWK())
+	}
+	return set
+}
+
+// JWK returns the public half of the key.
+func (k *SigningKey) JWK() JWK {
+	jwk := JWK{Kid: k.ID, Use: "sig", Alg: k.Algorithm}
+	switch pub := k.Private.Public().(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+	}
+	return jwk
+}
+
+func generateSigningKey(algorithm string) (*SigningKey, error) {
+	var signer crypto.Signer
+	switch algorithm {
+	case AlgEdDSA:
+		_, priv, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		signer = priv
+	default:
+		priv, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			return nil, err
+		}
+		signer = priv
+	}
+
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+
+	return &SigningKey{
+		ID:        hex.EncodeToString(id),
+		Algorithm: algorithm,
+		Private:   signer,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+type storedKey struct {
+	ID         string    `json:"kid"`
+	Algorithm  string    `json:"alg"`
+	PrivateKey string    `json:"private_key"`
+	CreatedAt  time.Time `json:"created_at"`
+	RetiredAt  time.Time `json:"retired_at"`
+}
+
+// encodeKeys stores the key ring as a single JSON document with PEM encoded private keys.
+func encodeKeys(keys []*SigningKey) ([]byte, error) {
+	stored := make([]storedKey, 0, len(keys))
+	for _, key := range keys {
+		der, err := x509.MarshalPKCS8PrivateKey(key.Private)
+		if err != nil {
+			return nil, err
+		}
+		stored = append(stored, storedKey{
+			ID:         key.ID,
+			Algorithm:  key.Algorithm,
+			PrivateKey: string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+			CreatedAt:  key.CreatedAt,
+			RetiredAt:  key.RetiredAt,
+		})
+	}
+	return json.MarshalIndent(stored, "", "  ")
+}
+
+func decodeKeys(data []byte) ([]*SigningKey, error) {
+	var stored []storedKey
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, err
+	}
+
+	keys := make([]*SigningKey, 0, len(stored))
+	for _, sk := range stored {
+		block, _ := pem.Decode([]byte(sk.PrivateKey))
+		if block == nil {
+			return nil, fmt.Errorf("invalid PEM for key %s", sk.ID)
+		}
+		priv, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		signer, ok := priv.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("key %s is not a signing key", sk.ID)
+		}
+		keys = append(keys, &SigningKey{
+			ID:        sk.ID,
+			Algorithm: sk.Algorithm,
+			Private:   signer,
+			CreatedAt: sk.CreatedAt,
+			RetiredAt: sk.RetiredAt,
+		})
+	}
+	return keys, nil
+}
+
+// fileKeyStore keeps the key ring in keys_dir. Updates are only atomic within
+// one process, so use it for a single instance or local runs.
+type fileKeyStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+func NewFileKeyStore(dir string) KeyStore {
+	return &fileKeyStore{path: filepath.Join(dir, "signing_keys.json")}
+}
+
+func (s *fileKeyStore) Load() ([]*SigningKey, error) {
+	data, err := os.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return decodeKeys(data)
+}
+
+func (s *fileKeyStore) Update(fn func(keys []*SigningKey) ([]*SigningKey, error)) ([]*SigningKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current, err := s.Load()
+	if err != nil {
+		return nil, err
+	}
+	keys, err := fn(current)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := encodeKeys(keys)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0700); err != nil {
+		return nil, err
+	}
+
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+const (
+	signingKeysKey       = "jwt_signing_keys"
+	keyStoreMaxConflicts = 10
+)
+
+// redisKeyStore shares the key ring between every instance using the same
+// redis. Update is an optimistic transaction on the key.
+type redisKeyStore struct {
+	redis *redis.Client
+}
+
+func NewRedisKeyStore(client *redis.Client) KeyStore {
+	return &redisKeyStore{redis: client}
+}
+
+func (s *redisKeyStore) Load() ([]*SigningKey, error) {
+	data, err := s.redis.Get(context.Background(), signingKeysKey).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return decodeKeys(data)
+}
+
+func (s *redisKeyStore) Update(fn func(keys []*SigningKey) ([]*SigningKey, error)) ([]*SigningKey, error) {
+	ctx := context.Background()
+	var keys []*SigningKey
+
+	txf := func(tx *redis.Tx) error {
+		var current []*SigningKey
+		data, err := tx.Get(ctx, signingKeysKey).Bytes()
+		if err != nil && err != redis.Nil {
+			return err
+		}
+		if err == nil {
+			if current, err = decodeKeys(data); err != nil {
+				return err
+			}
+		}
+
+		if keys, err = fn(current); err != nil {
+			return err
+		}
+		encoded, err := encodeKeys(keys)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Set(ctx, signingKeysKey, encoded, 0)
+			return nil
+		})
+		return err
+	}
+
+	for i := 0; i < keyStoreMaxConflicts; i++ {
+		err := s.redis.Watch(ctx, txf, signingKeysKey)
+		if err == redis.TxFailedErr {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return keys, nil
+	}
+	return nil, fmt.Errorf("signing keys changed too often, gave up after %d attempts", keyStoreMaxConflicts)
+}
@@ -0,0 +1,275 @@
+package util
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"rival/config"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	jwksCacheTTL       = time.Hour
+	jwksRefreshBackoff = time.Minute // unknown kids can't force more than one fetch per minute
+)
+
+// OIDCKeySource resolves the public key for a token's kid.
+type OIDCKeySource interface {
+	Key(ctx context.Context, kid string) (crypto.PublicKey, error)
+}
+
+// OIDCProvider verifies ID tokens from any OpenID Connect issuer, e.g. Google or Apple.
+type OIDCProvider struct {
+	name      string
+	issuer    string
+	clientIDs []string
+	keys      OIDCKeySource
+}
+
+func NewOIDCProvider(cfg config.OIDCProviderConfig) *OIDCProvider {
+	return NewOIDCProviderWithKeys(cfg.Name, cfg.Issuer, cfg.ClientIDs, &remoteJWKS{
+		issuer: cfg.Issuer,
+		url:    cfg.JWKSURL,
+		client: &http.Client{Timeout: 10 * time.Second},
+	})
+}
+
+func NewOIDCProviderWithKeys(name, issuer string, clientIDs []string, keys OIDCKeySource) *OIDCProvider {
+	return &OIDCProvider{
+		name:      name,
+		issuer:    issuer,
+		clientIDs: clientIDs,
+		keys:      keys,
+	}
+}
+
+func (p *OIDCProvider) Name() string {
+	return p.name
+}
+
+type oidcClaims struct {
+	Email         string   `json:"email"`
+	EmailVerified flexBool `json:"email_verified"`
+	Name          string   `json:"name"`
+	Picture       string   `json:"picture"`
+	PhoneNumber   string   `json:"phone_number"`
+	jwt.RegisteredClaims
+}
+
+// flexBool accepts both true and "true", Apple sends booleans as strings.
+type flexBool bool
+
+func (b *flexBool) UnmarshalJSON(data []byte) error {
+	switch strings.Trim(string(data), `"`) {
+	case "true":
+		*b = true
+	case "false", "null", "":
+		*b = false
+	default:
+		return fmt.Errorf("invalid boolean: %s", data)
+	}
+	return nil
+}
+
+func (p *OIDCProvider) Verify(ctx context.Context, idToken string) (*ExternalIdentity, error) {
+	claims := &oidcClaims{}
+	_, err := jwt.ParseWithClaims(idToken, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return p.keys.Key(ctx, kid)
+	},
+		jwt.WithValidMethods([]string{"RS256", "ES256", "EdDSA"}),
+		jwt.WithAudience(p.clientIDs...),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(time.Minute),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s ID token: %v", p.name, err)
+	}
+
+	// Google issues tokens with and without the scheme in iss
+	if claims.Issuer != p.issuer && "https://"+claims.Issuer != p.issuer {
+		return nil, fmt.Errorf("invalid %s ID token: unexpected issuer %s", p.name, claims.Issuer)
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("invalid %s ID token: missing subject", p.name)
+	}
+
+	return &ExternalIdentity{
+		Provider:      p.name,
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: bool(claims.EmailVerified),
+		Name:          claims.Name,
+		Picture:       claims.Picture,
+		PhoneNumber:   claims.PhoneNumber,
+	}, nil
+}
+
+// remoteJWKS fetches and caches an issuer's signing keys, discovering the
+// JWKS URL from the issuer's openid-configuration when it isn't configured.
+type remoteJWKS struct {
+	issuer string
+	url    string
+	client *http.Client
+
+	mu        sync.Mutex
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+}
+
+func (r *remoteJWKS) Key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key, ok := r.keys[kid]
+	stale := time.Since(r.fetchedAt) > jwksCacheTTL
+	if ok && !stale {
+		return key, nil
+	}
+
+	if stale || time.Since(r.fetchedAt) > jwksRefreshBackoff {
+		if err := r.refreshLocked(ctx); err != nil {
+			if ok {
+				// Keep verifying with the cached key while the issuer is unreachable
+				return key, nil
+			}
+			return nil, err
+		}
+	}
+
+	key, ok = r.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+	return key, nil
+}
+
+func (r *remoteJWKS) refreshLocked(ctx context.Context) error {
+	if r.url == "" {
+		var discovery struct {
+			JWKSURI string `json:"jwks_uri"`
+		}
+		if err := r.getJSON(ctx, strings.TrimSuffix(r.issuer, "/")+"/.well-known/openid-configuration", &discovery); err != nil {
+			return fmt.Errorf("error discovering JWKS URL: %v", err)
+		}
+		if discovery.JWKSURI == "" {
+			return fmt.Errorf("issuer %s does not publish a jwks_uri", r.issuer)
+		}
+		r.url = discovery.JWKSURI
+	}
+
+	var set JWKSet
+	if err := r.getJSON(ctx, r.url, &set); err != nil {
+		return fmt.Errorf("error fetching JWKS: %v", err)
+	}
+
+	keys, err := ParseJWKSet(set)
+	if err != nil {
+		return err
+	}
+	r.keys = keys
+	r.fetchedAt = time.Now()
+	return nil
+}
+
+func (r *remoteJWKS) getJSON(ctx context.Context, url string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// staticKeys serves a fixed key set, used by the local OIDC stub.
+type staticKeys map[string]crypto.PublicKey
+
+func (s staticKeys) Key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	key, ok := s[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+	return key, nil
+}
+
+// ParseJWKSet converts the signing keys of a JWKS document to public keys by kid.
+// Keys of unsupported types are skipped.
+func ParseJWKSet(set JWKSet) (map[string]crypto.PublicKey, error) {
+	keys := make(map[string]crypto.PublicKey)
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := parseJWK(jwk)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JWK %s: %v", jwk.Kid, err)
+		}
+		if key != nil {
+			keys[jwk.Kid] = key
+		}
+	}
+	return keys, nil
+}
+
+func parseJWK(jwk JWK) (crypto.PublicKey, error) {
+	switch jwk.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		if jwk.Crv != "P-256" {
+			return nil, nil
+		}
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(jwk.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	case "OKP":
+		if jwk.Crv != "Ed25519" {
+			return nil, nil
+		}
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("bad Ed25519 key size")
+		}
+		return ed25519.PublicKey(x), nil
+	}
+	return nil, nil
+}
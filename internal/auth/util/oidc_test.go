@@ -0,0 +1,93 @@
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"rival/config"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestLocalOIDCStubRoundTrip(t *testing.T) {
+	stub, err := NewLocalOIDCStub()
+	if err != nil {
+		t.Fatalf("failed to create stub: %v", err)
+	}
+
+	token, err := stub.IssueIDToken(ExternalIdentity{Email: "stub@example.com", EmailVerified: true, Name: "Stub"}, time.Minute)
+	if err != nil {
+		t.Fatalf("failed to issue token: %v", err)
+	}
+
+	identity, err := stub.Provider().Verify(context.Background(), token)
+	if err != nil {
+		t.Fatalf("stub token rejected: %v", err)
+	}
+	if identity.Provider != ProviderLocal || identity.Subject != "stub@example.com" || !identity.EmailVerified || identity.Name != "Stub" {
+		t.Errorf("unexpected identity %+v", identity)
+	}
+
+	other, _ := NewLocalOIDCStub()
+	if _, err := other.Provider().Verify(context.Background(), token); err == nil {
+		t.Error("expected token signed by another issuer key to be rejected")
+	}
+
+	expired, _ := stub.IssueIDToken(ExternalIdentity{Email: "stub@example.com"}, -time.Hour)
+	if _, err := stub.Provider().Verify(context.Background(), expired); err == nil {
+		t.Error("expected expired token to be rejected")
+	}
+}
+
+func TestOIDCProviderRemoteJWKS(t *testing.T) {
+	key, err := generateSigningKey(AlgRS256)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{"issuer": server.URL, "jwks_uri": server.URL + "/keys"})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(JWKSet{Keys: []JWK{key.JWK()}})
+	})
+
+	sign := func(audience string) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"iss":            server.URL,
+			"sub":            "001234.apple",
+			"aud":            audience,
+			"iat":            time.Now().Unix(),
+			"exp":            time.Now().Add(time.Minute).Unix(),
+			"email":          "apple@example.com",
+			"email_verified": "true",
+		})
+		token.Header["kid"] = key.ID
+		signed, err := token.SignedString(key.Private)
+		if err != nil {
+			t.Fatalf("failed to sign token: %v", err)
+		}
+		return signed
+	}
+
+	provider := NewOIDCProvider(config.OIDCProviderConfig{Name: "apple", Issuer: server.URL, ClientIDs: []string{"com.rival.app"}})
+
+	identity, err := provider.Verify(context.Background(), sign("com.rival.app"))
+	if err != nil {
+		t.Fatalf("token rejected: %v", err)
+	}
+	if identity.Provider != "apple" || identity.Subject != "001234.apple" || !identity.EmailVerified {
+		t.Errorf("unexpected identity %+v", identity)
+	}
+
+	if _, err := provider.Verify(context.Background(), sign("someone-else")); err == nil {
+		t.Error("expected token for another audience to be rejected")
+	}
+}
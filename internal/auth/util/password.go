@@ -0,0 +1,93 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"rival/config"
+)
+
+// commonPasswords is a short list of the passwords seen most in credential stuffing lists.
+var commonPasswords = map[string]bool{
+	"password": true, "password1": true, "password123": true, "12345678": true,
+	"123456789": true, "1234567890": true, "qwerty123": true, "qwertyuiop": true,
+	"iloveyou": true, "11111111": true, "00000000": true, "abc12345": true,
+	"welcome1": true, "letmein1": true, "admin123": true, "rival123": true,
+	"Password1": true, "Passw0rd": true, "Password123": true, "Welcome1": true,
+}
+
+type PasswordPolicy struct {
+	MinLength      int
+	RequireUpper   bool
+	RequireLower   bool
+	RequireDigit   bool
+	RequireSymbol  bool
+	DisallowCommon bool
+}
+
+// NewPasswordPolicy builds the policy from config, never going below 8 characters.
+func NewPasswordPolicy(cfg config.PasswordPolicyConfig) PasswordPolicy {
+	minLength := cfg.MinLength
+	if minLength < 8 {
+		minLength = 8
+	}
+	return PasswordPolicy{
+		MinLength:      minLength,
+		RequireUpper:   cfg.RequireUpper,
+		RequireLower:   cfg.RequireLower,
+		RequireDigit:   cfg.RequireDigit,
+		RequireSymbol:  cfg.RequireSymbol,
+		DisallowCommon: cfg.DisallowCommon,
+	}
+}
+
+// Validate returns a user facing error describing the first rule the password breaks.
+func (p PasswordPolicy) Validate(password, email string) error {
+	if len([]rune(password)) < p.MinLength {
+		return fmt.Errorf("password must be at least %d characters", p.MinLength)
+	}
+	if len(password) > 72 {
+		// bcrypt ignores everything after 72 bytes
+		return fmt.Errorf("password must be at most 72 bytes")
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsSpace(r):
+			hasSymbol = true
+		}
+	}
+
+	if p.RequireUpper && !hasUpper {
+		return fmt.Errorf("password must contain an uppercase letter")
+	}
+	if p.RequireLower && !hasLower {
+		return fmt.Errorf("password must contain a lowercase letter")
+	}
+	if p.RequireDigit && !hasDigit {
+		return fmt.Errorf("password must contain a digit")
+	}
+	if p.RequireSymbol && !hasSymbol {
+		return fmt.Errorf("password must contain a symbol")
+	}
+
+	if p.DisallowCommon {
+		if commonPasswords[password] || commonPasswords[strings.ToLower(password)] {
+			return fmt.Errorf("password is too common")
+		}
+		local := strings.ToLower(strings.SplitN(email, "@", 2)[0])
+		if len(local) >= 4 && strings.Contains(strings.ToLower(password), local) {
+			return fmt.Errorf("password must not contain your email")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package util
+
+import (
+	"testing"
+
+	"rival/config"
+)
+
+func TestPasswordPolicy(t *testing.T) {
+	policy := NewPasswordPolicy(config.PasswordPolicyConfig{
+		MinLength:      10,
+		RequireUpper:   true,
+		RequireLower:   true,
+		RequireDigit:   true,
+		DisallowCommon: true,
+	})
+
+	cases := map[string]bool{
+		"Short1":           false,
+		"alllowercase1":    false,
+		"ALLUPPERCASE1":    false,
+		"NoDigitsHere":     false,
+		"Password123":      false,
+		"Johnsmith2024":    false, // contains the email local part
+		"Correct-Horse-42": true,
+		"Tr0ub4dor&3xtra":  true,
+	}
+	for password, ok := range cases {
+		err := policy.Validate(password, "johnsmith@example.com")
+		if ok && err != nil {
+			t.Errorf("expected %q to pass, got %v", password, err)
+		}
+		if !ok && err == nil {
+			t.Errorf("expected %q to be rejected", password)
+		}
+	}
+}
+
+func TestPasswordPolicyMinimumLength(t *testing.T) {
+	policy := NewPasswordPolicy(config.PasswordPolicyConfig{MinLength: 4})
+	if err := policy.Validate("abcdefg", "a@example.com"); err == nil {
+		t.Errorf("policy should never allow fewer than 8 characters")
+	}
+}
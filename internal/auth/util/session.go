@@ -0,0 +1,47 @@
+package util
+
+import (
+	"context"
+	"time"
+
+	"rival/config"
+	"rival/connection"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// SessionBlocklist marks revoked sessions in redis so the auth interceptor can
+// reject their access tokens without hitting postgres on every request.
+type SessionBlocklist struct {
+	redis *redis.Client
+}
+
+func NewSessionBlocklist() *SessionBlocklist {
+	cfg := config.GetConfig()
+	return &SessionBlocklist{
+		redis: connection.GetRedisClient(&cfg.Redis),
+	}
+}
+
+func revokedSessionKey(sessionID string) string {
+	return "revoked_session:" + sessionID
+}
+
+// Revoke blocks a session for ttl, which should cover the lifetime of its tokens.
+func (b *SessionBlocklist) Revoke(ctx context.Context, sessionID string, ttl time.Duration) error {
+	if sessionID == "" {
+		return nil
+	}
+	return b.redis.Set(ctx, revokedSessionKey(sessionID), 1, ttl).Err()
+}
+
+func (b *SessionBlocklist) IsRevoked(ctx context.Context, sessionID string) (bool, error) {
+	if sessionID == "" {
+		return false, nil
+	}
+	n, err := b.redis.Exists(ctx, revokedSessionKey(sessionID)).Result()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
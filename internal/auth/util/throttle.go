@@ -0,0 +1,257 @@
+package util
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"rival/config"
+	"rival/connection"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Throttle scopes, a login attempt is counted against all three.
+const (
+	ScopeEmail = "email"
+	ScopeIP    = "ip"
+	ScopePair  = "pair"
+)
+
+// ThrottleStatus tells the caller whether a login attempt may go ahead.
+type ThrottleStatus struct {
+	Locked     bool   // a lockout is active for Scope
+	Throttled  bool   // progressive delay has not passed yet
+	Scope      string // which counter tripped
+	RetryAfter time.Duration
+}
+
+// FailureResult describes what a failed attempt triggered.
+type FailureResult struct {
+	EmailFailures int64
+	EmailLocked   bool // the account got locked by this attempt
+	RetryAfter    time.Duration
+}
+
+// LoginThrottler keeps sliding-window failure counters in redis keyed by email,
+// IP and email+IP, and turns them into progressive delays and lockouts.
+type LoginThrottler struct {
+	redis            *redis.Client
+	window           time.Duration
+	maxEmailFailures int64
+	maxIPFailures    int64
+	maxPairFailures  int64
+	delayAfter       int64
+	maxDelay         time.Duration
+	lockout          time.Duration
+}
+
+func NewLoginThrottler(cfg config.LoginThrottleConfig) *LoginThrottler {
+	appCfg := config.GetConfig()
+	return &LoginThrottler{
+		redis:            connection.GetRedisClient(&appCfg.Redis),
+		window:           time.Duration(orDefault(cfg.WindowMinutes, 15)) * time.Minute,
+		maxEmailFailures: int64(orDefault(cfg.MaxEmailFailures, 10)),
+		maxIPFailures:    int64(orDefault(cfg.MaxIPFailures, 50)),
+		maxPairFailures:  int64(orDefault(cfg.MaxPairFailures, 5)),
+		delayAfter:       int64(orDefault(cfg.DelayAfter, 3)),
+		maxDelay:         time.Duration(orDefault(cfg.MaxDelaySeconds, 30)) * time.Second,
+		lockout:          time.Duration(orDefault(cfg.LockoutMinutes, 30)) * time.Minute,
+	}
+}
+
+func orDefault(value, def int) int {
+	if value <= 0 {
+		return def
+	}
+	return value
+}
+
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// throttleIP groups IPv6 clients by /64, the block a single host usually
+// gets, so rotating addresses inside it doesn't reset the IP counters. ip
+// must come from ClientIP, never straight from x-forwarded-for.
+func throttleIP(ip string) string {
+	parsed := net.ParseIP(ip)
+	if parsed == nil || parsed.To4() != nil {
+		return ip
+	}
+	return parsed.Mask(net.CIDRMask(64, 128)).String() + "/64"
+}
+
+func throttleIDs(email, ip string) map[string]string {
+	email = NormalizeEmail(email)
+	ip = throttleIP(ip)
+	return map[string]string{
+		ScopeEmail: email,
+		ScopeIP:    ip,
+		ScopePair:  email + "|" + ip,
+	}
+}
+
+func failuresKey(scope, id string) string { return "login_fail:" + scope + ":" + id }
+func lockKey(scope, id string) string     { return "login_lock:" + scope + ":" + id }
+func delayKey(id string) string           { return "login_delay:" + id }
+func unlockKey(token string) string       { return "login_unlock:" + token }
+
+// Check reports whether an attempt for email from ip is currently blocked.
+func (t *LoginThrottler) Check(ctx context.Context, email, ip string) (ThrottleStatus, error) {
+	ids := throttleIDs(email, ip)
+
+	for _, scope := range []string{ScopeEmail, ScopePair, ScopeIP} {
+		if scope == ScopeIP && ip == "" {
+			continue
+		}
+		ttl, err := t.redis.TTL(ctx, lockKey(scope, ids[scope])).Result()
+		if err != nil {
+			return ThrottleStatus{}, err
+		}
+		if ttl > 0 {
+			return ThrottleStatus{Locked: true, Scope: scope, RetryAfter: ttl}, nil
+		}
+	}
+
+	ttl, err := t.redis.PTTL(ctx, delayKey(ids[ScopePair])).Result()
+	if err != nil {
+		return ThrottleStatus{}, err
+	}
+	if ttl > 0 {
+		return ThrottleStatus{Throttled: true, Scope: ScopePair, RetryAfter: ttl}, nil
+	}
+
+	return ThrottleStatus{}, nil
+}
+
+// RecordFailure counts a failed attempt and applies delays and lockouts.
+func (t *LoginThrottler) RecordFailure(ctx context.Context, email, ip string) (FailureResult, error) {
+	ids := throttleIDs(email, ip)
+	limits := map[string]int64{
+		ScopeEmail: t.maxEmailFailures,
+		ScopeIP:    t.maxIPFailures,
+		ScopePair:  t.maxPairFailures,
+	}
+
+	var result FailureResult
+	now := time.Now()
+	for scope, id := range ids {
+		if scope != ScopeEmail && ip == "" {
+			continue
+		}
+
+		count, err := t.addFailure(ctx, failuresKey(scope, id), now)
+		if err != nil {
+			return result, err
+		}
+
+		if count >= limits[scope] {
+			locked, err := t.redis.SetNX(ctx, lockKey(scope, id), now.Unix(), t.lockout).Result()
+			if err != nil {
+				return result, err
+			}
+			if scope == ScopeEmail {
+				result.EmailLocked = locked
+			}
+		}
+
+		switch scope {
+		case ScopeEmail:
+			result.EmailFailures = count
+		case ScopePair:
+			delay := ProgressiveDelay(count, t.delayAfter, t.maxDelay)
+			if delay > 0 {
+				if err := t.redis.Set(ctx, delayKey(id), 1, delay).Err(); err != nil {
+					return result, err
+				}
+				result.RetryAfter = delay
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// addFailure records one failure in a sliding window sorted set and returns the count inside the window.
+func (t *LoginThrottler) addFailure(ctx context.Context, key string, now time.Time) (int64, error) {
+	member := strconv.FormatInt(now.UnixNano(), 10) + "-" + randomHex(4)
+
+	pipe := t.redis.TxPipeline()
+	pipe.ZAdd(ctx, key, redis.Z{Score: float64(now.UnixNano()), Member: member})
+	pipe.ZRemRangeByScore(ctx, key, "0", strconv.FormatInt(now.Add(-t.window).UnixNano(), 10))
+	count := pipe.ZCard(ctx, key)
+	pipe.Expire(ctx, key, t.window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return count.Val(), nil
+}
+
+// RecordSuccess clears the per-account counters after a good login. IP counters
+// are kept so one valid account can't be used to reset a stuffing run.
+func (t *LoginThrottler) RecordSuccess(ctx context.Context, email, ip string) error {
+	ids := throttleIDs(email, ip)
+	return t.redis.Del(ctx,
+		failuresKey(ScopeEmail, ids[ScopeEmail]),
+		failuresKey(ScopePair, ids[ScopePair]),
+		delayKey(ids[ScopePair]),
+	).Err()
+}
+
+// CreateUnlockToken returns a single use token that lifts the lockout on email.
+func (t *LoginThrottler) CreateUnlockToken(ctx context.Context, email string) (string, error) {
+	token := randomHex(24)
+	if err := t.redis.Set(ctx, unlockKey(token), NormalizeEmail(email), t.lockout).Err(); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// Unlock consumes an unlock token and clears the account lockout.
+func (t *LoginThrottler) Unlock(ctx context.Context, token string) (string, error) {
+	email, err := t.redis.GetDel(ctx, unlockKey(token)).Result()
+	if err == redis.Nil {
+		return "", fmt.Errorf("unlock link is invalid or has expired")
+	}
+	if err != nil {
+		return "", err
+	}
+
+	err = t.redis.Del(ctx,
+		lockKey(ScopeEmail, email),
+		failuresKey(ScopeEmail, email),
+	).Err()
+	return email, err
+}
+
+func (t *LoginThrottler) LockoutDuration() time.Duration {
+	return t.lockout
+}
+
+// ProgressiveDelay doubles the wait for every failure past delayAfter, capped at max.
+func ProgressiveDelay(failures, delayAfter int64, max time.Duration) time.Duration {
+	if failures <= delayAfter {
+		return 0
+	}
+	steps := failures - delayAfter - 1
+	if steps > 30 {
+		return max
+	}
+	delay := time.Second << steps
+	if delay > max {
+		return max
+	}
+	return delay
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,42 @@
+package util
+
+import (
+	"testing"
+	"time"
+)
+
+func TestProgressiveDelay(t *testing.T) {
+	max := 30 * time.Second
+	cases := []struct {
+		failures int64
+		want     time.Duration
+	}{
+		{1, 0},
+		{3, 0},
+		{4, time.Second},
+		{5, 2 * time.Second},
+		{6, 4 * time.Second},
+		{8, 16 * time.Second},
+		{9, max},
+		{100, max},
+	}
+	for _, c := range cases {
+		if got := ProgressiveDelay(c.failures, 3, max); got != c.want {
+			t.Errorf("ProgressiveDelay(%d) = %v, want %v", c.failures, got, c.want)
+		}
+	}
+}
+
+func TestThrottleIDsGroupIPv6(t *testing.T) {
+	a := throttleIDs("A@Example.com ", "2001:db8:1:2:aaaa::1")
+	b := throttleIDs("a@example.com", "2001:db8:1:2:bbbb::9")
+	if a[ScopeIP] != b[ScopeIP] || a[ScopePair] != b[ScopePair] {
+		t.Errorf("addresses in one /64 should share counters: %v vs %v", a, b)
+	}
+	if other := throttleIDs("a@example.com", "2001:db8:1:3::1"); other[ScopeIP] == a[ScopeIP] {
+		t.Errorf("different /64s should not share counters")
+	}
+	if v4 := throttleIDs("a@example.com", "203.0.113.9"); v4[ScopeIP] != "203.0.113.9" {
+		t.Errorf("IPv4 keyed as %s", v4[ScopeIP])
+	}
+}
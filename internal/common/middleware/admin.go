@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"rival/config"
+	"rival/connection"
+	schemapb "rival/gen/proto/proto/schema"
+	schema "rival/gen/sql"
+)
+
+const adminServicePrefix = "/rival.api.v1.AdminService/"
+
+var (
+	userQueries     *schema.Queries
+	userQueriesErr  error
+	userQueriesOnce sync.Once
+)
+
+// userRole reads the caller's role from the database rather than the token,
+// so taking admin away also applies to tokens that are already out.
+var userRole = func(ctx context.Context, userID int) (string, error) {
+	userQueriesOnce.Do(func() {
+		db, err := connection.GetPgConnection(&config.GetConfig().Database)
+		if err != nil {
+			userQueriesErr = err
+			return
+		}
+		userQueries = schema.New(db)
+	})
+	if userQueriesErr != nil {
+		return "", userQueriesErr
+	}
+
+	user, err := userQueries.GetUserByID(ctx, int64(userID))
+	if err != nil {
+		return "", err
+	}
+	return user.Role, nil
+}
+
+// isAdminRole accepts both spellings found in users.role.
+func isAdminRole(role string) bool {
+	return role == "admin" || role == schemapb.UserRole_USER_ROLE_ADMIN.String()
+}
+
+// authorizeAdmin lets only admins call AdminService methods: approving
+// merchants, KYC documents, review moderation and everything else there.
+func authorizeAdmin(ctx context.Context, method string) error {
+	if !strings.HasPrefix(method, adminServicePrefix) {
+		return nil
+	}
+
+	userID, _ := ctx.Value("user_id").(int)
+	if userID == 0 {
+		return status.Error(codes.PermissionDenied, "Admin access required")
+	}
+
+	role, err := userRole(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return status.Error(codes.PermissionDenied, "Admin access required")
+	}
+	if err != nil {
+		return status.Error(codes.Internal, "Authorization unavailable")
+	}
+	if !isAdminRole(role) {
+		return status.Error(codes.PermissionDenied, "Admin access required")
+	}
+	return nil
+}
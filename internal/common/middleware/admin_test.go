@@ -0,0 +1,62 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAdminServiceRequiresAdmin(t *testing.T) {
+	roles := map[int]string{1: "USER_ROLE_CUSTOMER", 2: "merchant", 3: "USER_ROLE_ADMIN", 4: "admin"}
+	original := userRole
+	userRole = func(ctx context.Context, userID int) (string, error) {
+		role, ok := roles[userID]
+		if !ok {
+			return "", pgx.ErrNoRows
+		}
+		return role, nil
+	}
+	t.Cleanup(func() { userRole = original })
+
+	methods := []string{
+		adminServicePrefix + "ApproveMerchant",
+		adminServicePrefix + "SuspendMerchant",
+		adminServicePrefix + "StartMerchantReview",
+		adminServicePrefix + "RejectMerchant",
+		adminServicePrefix + "ReinstateMerchant",
+		adminServicePrefix + "ListMerchantDocuments",
+		adminServicePrefix + "VerifyMerchantDocument",
+		adminServicePrefix + "RejectMerchantDocument",
+		adminServicePrefix + "ListFlaggedReviews",
+		adminServicePrefix + "ModerateReview",
+	}
+	cases := []struct {
+		userID int
+		want   codes.Code
+	}{
+		{1, codes.PermissionDenied},
+		{2, codes.PermissionDenied},
+		{3, codes.OK},
+		{4, codes.OK},
+		{99, codes.PermissionDenied}, // deleted user with a live token
+		{0, codes.PermissionDenied},  // no user in context
+	}
+
+	for _, method := range methods {
+		for _, c := range cases {
+			ctx := context.WithValue(context.Background(), "user_id", c.userID)
+			if got := status.Code(authorizeAdmin(ctx, method)); got != c.want {
+				t.Errorf("%s as user %d: got %v, want %v", method, c.userID, got, c.want)
+			}
+		}
+	}
+
+	// Other services are left to their own checks
+	ctx := context.WithValue(context.Background(), "user_id", 1)
+	if err := authorizeAdmin(ctx, "/rival.api.v1.UserService/GetProfile"); err != nil {
+		t.Errorf("non-admin service denied: %v", err)
+	}
+}
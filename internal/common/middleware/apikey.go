@@ -0,0 +1,115 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	authutil "rival/internal/auth/util"
+	"rival/internal/merchants/repo"
+	"rival/internal/merchants/service"
+	"rival/internal/merchants/util"
+)
+
+// apiKeyScopes lists the RPCs a merchant API key may call and the scope each needs.
+// Anything not listed (key management, user endpoints, ...) requires a JWT.
+// Keys belong to a merchant, not a customer, so nothing here spends a
+// customer's coins: PayToMerchant stays JWT only.
+var apiKeyScopes = map[string]string{
+	"/rival.api.v1.PaymentService/GetSettlements":          util.ScopePaymentsRead,
+	"/rival.api.v1.OrderService/CreateOrder":               util.ScopeOrdersWrite,
+	"/rival.api.v1.OrderService/GetOrder":                  util.ScopeOrdersRead,
+	"/rival.api.v1.OrderService/GetReceipt":                util.ScopeOrdersRead,
+	"/rival.api.v1.MerchantService/GetMerchant":            util.ScopeMerchantRead,
+	"/rival.api.v1.MerchantService/GetDashboardStats":      util.ScopeMerchantRead,
+	"/rival.api.v1.MerchantService/GetOrders":              util.ScopeOrdersRead,
+	"/rival.api.v1.MerchantService/UpdateOrderStatus":      util.ScopeOrdersWrite,
+	"/rival.api.v1.MerchantService/GetOrderReceipt":        util.ScopeOrdersRead,
+	"/rival.api.v1.MerchantService/GetCustomers":           util.ScopeCustomersRead,
+	"/rival.api.v1.MerchantService/GetOffers":              util.ScopeOffersRead,
+	"/rival.api.v1.MerchantService/CreateOffer":            util.ScopeOffersWrite,
+	"/rival.api.v1.MerchantService/UpdateOffer":            util.ScopeOffersWrite,
+	"/rival.api.v1.MerchantService/GetCatalog":             util.ScopeCatalogRead,
+	"/rival.api.v1.MerchantService/CreateCatalogItem":      util.ScopeCatalogWrite,
+	"/rival.api.v1.MerchantService/UpdateCatalogItem":      util.ScopeCatalogWrite,
+	"/rival.api.v1.MerchantService/SetCatalogAvailability": util.ScopeCatalogWrite,
+}
+
+var (
+	apiKeyService     service.APIKeyService
+	apiKeyServiceErr  error
+	apiKeyServiceOnce sync.Once
+)
+
+func getAPIKeyService() (service.APIKeyService, error) {
+	apiKeyServiceOnce.Do(func() {
+		repository, err := repo.NewAPIKeyRepository()
+		if err != nil {
+			apiKeyServiceErr = err
+			return
+		}
+		apiKeyService = service.NewAPIKeyService(repository)
+	})
+	return apiKeyService, apiKeyServiceErr
+}
+
+// extractAPIKey returns the API key from either "x-api-key" or a bearer token.
+func extractAPIKey(md metadata.MD) string {
+	if keys := md.Get("x-api-key"); len(keys) > 0 && util.IsAPIKey(keys[0]) {
+		return keys[0]
+	}
+	if auth := md.Get("authorization"); len(auth) > 0 {
+		token := strings.TrimPrefix(auth[0], "Bearer ")
+		if util.IsAPIKey(token) {
+			return token
+		}
+	}
+	return ""
+}
+
+// authenticateAPIKey resolves an API key to a merchant principal and checks it may call method.
+func authenticateAPIKey(ctx context.Context, key, method string, req interface{}) (context.Context, error) {
+	scope, ok := apiKeyScopes[method]
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "Endpoint not available to API keys")
+	}
+
+	apiKeys, err := getAPIKeyService()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "API key verification unavailable")
+	}
+
+	principal, err := apiKeys.Authenticate(ctx, key, authutil.ClientIP(ctx))
+	if errors.Is(err, service.ErrAPIKeyIPNotAllowed) {
+		return nil, status.Error(codes.PermissionDenied, "API key not allowed from this IP")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "Invalid API key")
+	}
+
+	if !util.HasScope(principal.Scopes, scope) {
+		return nil, status.Errorf(codes.PermissionDenied, "API key missing scope %s", scope)
+	}
+
+	// Keys are merchant scoped, never let them act on another merchant
+	if r, ok := req.(interface{ GetMerchantId() int64 }); ok {
+		id := r.GetMerchantId()
+		if id != 0 && id != int64(principal.MerchantID) {
+			return nil, status.Error(codes.PermissionDenied, "API key belongs to a different merchant")
+		}
+		if id == 0 {
+			setMerchantID(req, int64(principal.MerchantID))
+		}
+	}
+
+	ctx = context.WithValue(ctx, "auth_type", "api_key")
+	ctx = context.WithValue(ctx, "merchant_id", principal.MerchantID)
+	ctx = context.WithValue(ctx, "api_key_id", principal.KeyID)
+	ctx = context.WithValue(ctx, "scopes", principal.Scopes)
+	return ctx, nil
+}
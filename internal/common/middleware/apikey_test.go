@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	paymentpb "rival/gen/proto/proto/api"
+)
+
+func TestAPIKeyCannotPayForCustomers(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-api-key", "rvl_test_secret"))
+	info := &grpc.UnaryServerInfo{FullMethod: "/rival.api.v1.PaymentService/PayToMerchant"}
+	req := &paymentpb.PayToMerchantRequest{UserId: 42, MerchantId: 7, Amount: 10}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		t.Errorf("handler ran for an API key payment")
+		return nil, nil
+	}
+
+	_, err := AuthInterceptor(ctx, req, info, handler)
+	if got := status.Code(err); got != codes.PermissionDenied {
+		t.Errorf("API key PayToMerchant for another customer: got %v, want PermissionDenied", got)
+	}
+}
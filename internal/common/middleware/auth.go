@@ -3,18 +3,17 @@ package middleware
 import (
 	"context"
 	"strings"
-	"time"
+	"sync"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
-	"rival/config"
 	"rival/internal/auth/util"
 )
 
-// AuthInterceptor verifies JWT tokens
+// AuthInterceptor verifies JWT tokens and merchant API keys
 func AuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 	// Skip auth for public endpoints
 	if isPublicEndpoint(info.FullMethod) {
@@ -27,6 +26,36 @@ func AuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServe
 		return nil, status.Error(codes.Unauthenticated, "Missing metadata")
 	}
 
+	// Merchant API keys (POS integrations) resolve to a merchant principal
+	if key := extractAPIKey(md); key != "" {
+		ctx, err := authenticateAPIKey(ctx, key, info.FullMethod, req)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+
+	ctx, err := authenticateJWT(ctx, md)
+	if err != nil {
+		return nil, err
+	}
+
+	// Merchant RPCs act through the caller's staff membership
+	ctx, err = authorizeStaff(ctx, info.FullMethod, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Admin RPCs need the admin role
+	if err := authorizeAdmin(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// authenticateJWT verifies the bearer token and adds the caller to ctx.
+func authenticateJWT(ctx context.Context, md metadata.MD) (context.Context, error) {
 	authHeader := md.Get("authorization")
 	if len(authHeader) == 0 {
 		return nil, status.Error(codes.Unauthenticated, "Missing authorization header")
@@ -38,18 +67,42 @@ func AuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServe
 	}
 
 	// Verify JWT token
-	cfg := config.GetConfig()
-	jwtUtil := util.NewJWTUtil(cfg.JWT.Secret, time.Duration(cfg.JWT.ExpiryHour)*time.Hour, 7*24*time.Hour)
+	jwtUtil, err := util.GetJWTUtil()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Token verification unavailable")
+	}
 	claims, err := jwtUtil.ValidateToken(token)
 	if err != nil {
 		return nil, status.Error(codes.Unauthenticated, "Invalid token")
 	}
 
+	// Reject tokens whose session was revoked (logout, RevokeSession)
+	revoked, err := getSessionBlocklist().IsRevoked(ctx, claims.SessionID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Token verification unavailable")
+	}
+	if revoked {
+		return nil, status.Error(codes.Unauthenticated, "Session has been revoked")
+	}
+
 	// Add user info to context
+	ctx = context.WithValue(ctx, "auth_type", "jwt")
 	ctx = context.WithValue(ctx, "user_id", claims.UserID)
 	ctx = context.WithValue(ctx, "email", claims.Email)
+	ctx = context.WithValue(ctx, "session_id", claims.SessionID)
+	return ctx, nil
+}
 
-	return handler(ctx, req)
+var (
+	sessionBlocklist     *util.SessionBlocklist
+	sessionBlocklistOnce sync.Once
+)
+
+func getSessionBlocklist() *util.SessionBlocklist {
+	sessionBlocklistOnce.Do(func() {
+		sessionBlocklist = util.NewSessionBlocklist()
+	})
+	return sessionBlocklist
 }
 
 // isPublicEndpoint checks if endpoint requires authentication
@@ -61,14 +114,22 @@ func isPublicEndpoint(method string) bool {
 		"/api.AuthService/ResendOTP",
 		"/api.AuthService/ForgotPassword",
 		"/api.AuthService/FirebaseLogin",
+		"/api.AuthService/SocialLogin",
 		"/api.AuthService/ResetPassword",
+		"/api.AuthService/UnlockAccount",
+		"/api.AuthService/LoginWithPhone",
+		"/api.AuthService/VerifyPhoneOTP",
 		"/rival.api.v1.AuthService/Signup",
 		"/rival.api.v1.AuthService/Login",
 		"/rival.api.v1.AuthService/VerifyOTP",
 		"/rival.api.v1.AuthService/ResendOTP",
 		"/rival.api.v1.AuthService/FirebaseLogin",
+		"/rival.api.v1.AuthService/SocialLogin",
 		"/rival.api.v1.AuthService/ForgotPassword",
 		"/rival.api.v1.AuthService/ResetPassword",
+		"/rival.api.v1.AuthService/UnlockAccount",
+		"/rival.api.v1.AuthService/LoginWithPhone",
+		"/rival.api.v1.AuthService/VerifyPhoneOTP",
 	}
 
 	for _, endpoint := range publicEndpoints {
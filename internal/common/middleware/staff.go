@@ -0,0 +1,160 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"rival/internal/merchants/repo"
+	"rival/internal/merchants/service"
+	"rival/internal/merchants/util"
+)
+
+const merchantServicePrefix = "/rival.api.v1.MerchantService/"
+
+// staffPermissions lists the permission a signed-in user's merchant membership
+// needs for each merchant RPC. An empty permission is open to every signed-in
+// user (customers browse merchants and menus). MerchantService methods missing
+// from the map are denied.
+var staffPermissions = map[string]string{
+	merchantServicePrefix + "GetMerchant":                "",
+	merchantServicePrefix + "GetMerchantAddress":         "",
+	merchantServicePrefix + "GetBusinessHours":           "",
+	merchantServicePrefix + "GetCatalog":                 "",
+	merchantServicePrefix + "AcceptStaffInvitation":      "",
+	merchantServicePrefix + "ListMyMemberships":          "",
+	merchantServicePrefix + "UpdateMerchant":             util.PermManageMerchant,
+	merchantServicePrefix + "UpdateMerchantAddress":      util.PermManageMerchant,
+	merchantServicePrefix + "AddMerchantAddress":         util.PermManageMerchant,
+	merchantServicePrefix + "DeleteMerchantAddress":      util.PermManageMerchant,
+	merchantServicePrefix + "SetPrimaryMerchantAddress":  util.PermManageMerchant,
+	merchantServicePrefix + "SetBusinessHours":           util.PermManageMerchant,
+	merchantServicePrefix + "AddClosure":                 util.PermManageMerchant,
+	merchantServicePrefix + "DeleteClosure":              util.PermManageMerchant,
+	merchantServicePrefix + "PauseOrders":                util.PermManageMerchant,
+	merchantServicePrefix + "GetOrders":                  util.PermViewOrders,
+	merchantServicePrefix + "GetCustomers":               util.PermViewOrders,
+	merchantServicePrefix + "UpdateOrderStatus":          util.PermUpdateOrders,
+	merchantServicePrefix + "GetOrderReceipt":            util.PermViewOrders,
+	merchantServicePrefix + "StreamOrders":               util.PermViewOrders,
+	merchantServicePrefix + "GetOffers":                  util.PermViewMerchant,
+	merchantServicePrefix + "ListNotifications":          util.PermViewMerchant,
+	merchantServicePrefix + "MarkNotificationsRead":      util.PermViewMerchant,
+	merchantServicePrefix + "GetUnreadNotificationCount": util.PermViewMerchant,
+	merchantServicePrefix + "StreamNotifications":        util.PermViewMerchant,
+	merchantServicePrefix + "CreateOffer":                util.PermManageOffers,
+	merchantServicePrefix + "UpdateOffer":                util.PermManageOffers,
+	merchantServicePrefix + "ReplyToReview":              util.PermManageReviews,
+	merchantServicePrefix + "CreateCatalogCategory":      util.PermManageCatalog,
+	merchantServicePrefix + "UpdateCatalogCategory":      util.PermManageCatalog,
+	merchantServicePrefix + "DeleteCatalogCategory":      util.PermManageCatalog,
+	merchantServicePrefix + "CreateCatalogItem":          util.PermManageCatalog,
+	merchantServicePrefix + "UpdateCatalogItem":          util.PermManageCatalog,
+	merchantServicePrefix + "DeleteCatalogItem":          util.PermManageCatalog,
+	merchantServicePrefix + "SetCatalogAvailability":     util.PermManageCatalog,
+	merchantServicePrefix + "RequestCatalogImageUpload":  util.PermManageCatalog,
+	merchantServicePrefix + "ConfirmCatalogImageUpload":  util.PermManageCatalog,
+	merchantServicePrefix + "GetDashboardStats":          util.PermViewReports,
+	merchantServicePrefix + "GetPayouts":                 util.PermViewReports,
+	merchantServicePrefix + "CreateAPIKey":               util.PermManageAPIKeys,
+	merchantServicePrefix + "ListAPIKeys":                util.PermManageAPIKeys,
+	merchantServicePrefix + "RevokeAPIKey":               util.PermManageAPIKeys,
+	merchantServicePrefix + "SubmitForReview":            util.PermManageKYC,
+	merchantServicePrefix + "GetOnboardingStatus":        util.PermManageKYC,
+	merchantServicePrefix + "RequestDocumentUpload":      util.PermManageKYC,
+	merchantServicePrefix + "ConfirmDocumentUpload":      util.PermManageKYC,
+	merchantServicePrefix + "ListDocuments":              util.PermManageKYC,
+	merchantServicePrefix + "InviteStaff":                util.PermManageStaff,
+	merchantServicePrefix + "RevokeStaffInvitation":      util.PermManageStaff,
+	merchantServicePrefix + "ListStaff":                  util.PermManageStaff,
+	merchantServicePrefix + "UpdateStaff":                util.PermManageStaff,
+	merchantServicePrefix + "RemoveStaff":                util.PermManageStaff,
+	"/rival.api.v1.PaymentService/GetSettlements":        util.PermViewReports,
+	"/rival.api.v1.PaymentService/InitiateSettlement":    util.PermSettlements,
+}
+
+var (
+	staffService     service.StaffService
+	staffServiceErr  error
+	staffServiceOnce sync.Once
+)
+
+func getStaffService() (service.StaffService, error) {
+	staffServiceOnce.Do(func() {
+		repository, err := repo.NewStaffRepository()
+		if err != nil {
+			staffServiceErr = err
+			return
+		}
+		staffService = service.NewStaffService(repository)
+	})
+	return staffService, staffServiceErr
+}
+
+// authorizeStaff checks a JWT caller's merchant membership for merchant RPCs.
+// A request without merchant_id is filled in from the caller's only membership.
+func authorizeStaff(ctx context.Context, method string, req interface{}) (context.Context, error) {
+	permission, ok := staffPermissions[method]
+	if !ok {
+		if strings.HasPrefix(method, merchantServicePrefix) {
+			return nil, status.Error(codes.PermissionDenied, "Endpoint not available")
+		}
+		return ctx, nil
+	}
+	if permission == "" {
+		return ctx, nil
+	}
+
+	staff, err := getStaffService()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Authorization unavailable")
+	}
+
+	var merchantID int64
+	if r, ok := req.(interface{ GetMerchantId() int64 }); ok {
+		merchantID = r.GetMerchantId()
+	}
+
+	userID, _ := ctx.Value("user_id").(int)
+	principal, err := staff.Authorize(ctx, userID, int(merchantID), permission)
+	if err != nil {
+		return nil, err
+	}
+
+	// Members limited to some outlets only act on those outlets, and not on
+	// settings that apply to the whole merchant
+	if len(principal.OutletIDs) > 0 {
+		if r, ok := req.(interface{ GetAddressId() int64 }); ok {
+			if !util.OutletAllowed(principal.OutletIDs, r.GetAddressId()) {
+				return nil, status.Error(codes.PermissionDenied, "You don't have access to this outlet")
+			}
+		} else if permission == util.PermManageMerchant {
+			return nil, status.Error(codes.PermissionDenied, "Only merchant-wide staff can change this")
+		}
+	}
+
+	if merchantID == 0 {
+		setMerchantID(req, int64(principal.MerchantID))
+	}
+
+	ctx = context.WithValue(ctx, "merchant_id", principal.MerchantID)
+	ctx = context.WithValue(ctx, "staff_role", principal.Role)
+	ctx = context.WithValue(ctx, "outlet_ids", principal.OutletIDs)
+	return ctx, nil
+}
+
+func setMerchantID(req interface{}, merchantID int64) {
+	message, ok := req.(proto.Message)
+	if !ok {
+		return
+	}
+	reflected := message.ProtoReflect()
+	if field := reflected.Descriptor().Fields().ByName("merchant_id"); field != nil && field.Kind() == protoreflect.Int64Kind {
+		reflected.Set(field, protoreflect.ValueOfInt64(merchantID))
+	}
+}
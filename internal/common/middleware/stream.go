@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// StreamAuthInterceptor gives streaming RPCs the same checks as
+// AuthInterceptor. API keys can't open streams.
+func StreamAuthInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if isPublicEndpoint(info.FullMethod) {
+		return handler(srv, ss)
+	}
+
+	md, ok := metadata.FromIncomingContext(ss.Context())
+	if !ok {
+		return status.Error(codes.Unauthenticated, "Missing metadata")
+	}
+	if extractAPIKey(md) != "" {
+		return status.Error(codes.PermissionDenied, "Endpoint not available to API keys")
+	}
+
+	ctx, err := authenticateJWT(ss.Context(), md)
+	if err != nil {
+		return err
+	}
+
+	if err := authorizeAdmin(ctx, info.FullMethod); err != nil {
+		return err
+	}
+
+	return handler(srv, &authStream{ServerStream: ss, ctx: ctx, method: info.FullMethod})
+}
+
+// authStream carries the caller's context into the handler. A stream's
+// request only arrives once the handler reads it, so that is where the staff
+// check runs and where a user_id in the request is bound to the caller.
+type authStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	method string
+}
+
+func (s *authStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *authStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	userID, _ := s.ctx.Value("user_id").(int)
+	if r, ok := m.(interface{ GetUserId() int64 }); ok {
+		id := r.GetUserId()
+		if id != 0 && id != int64(userID) {
+			return status.Error(codes.PermissionDenied, "You can only follow your own updates")
+		}
+		if id == 0 {
+			setUserID(m, int64(userID))
+		}
+	}
+
+	ctx, err := authorizeStaff(s.ctx, s.method, m)
+	if err != nil {
+		return err
+	}
+	s.ctx = ctx
+	return nil
+}
+
+func setUserID(req interface{}, userID int64) {
+	message, ok := req.(proto.Message)
+	if !ok {
+		return
+	}
+	reflected := message.ProtoReflect()
+	if field := reflected.Descriptor().Fields().ByName("user_id"); field != nil && field.Kind() == protoreflect.Int64Kind {
+		reflected.Set(field, protoreflect.ValueOfInt64(userID))
+	}
+}
@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	orderpb "rival/gen/proto/proto/api"
+)
+
+type fakeStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	req proto.Message
+}
+
+func (f *fakeStream) Context() context.Context { return f.ctx }
+
+func (f *fakeStream) RecvMsg(m interface{}) error {
+	proto.Merge(m.(proto.Message), f.req)
+	return nil
+}
+
+func TestAuthStreamBindsUser(t *testing.T) {
+	ctx := context.WithValue(context.Background(), "user_id", 5)
+	method := "/rival.api.v1.OrderService/StreamOrderUpdates"
+
+	// A missing user_id follows the caller
+	stream := &authStream{ServerStream: &fakeStream{ctx: ctx, req: &orderpb.StreamOrderUpdatesRequest{}}, ctx: ctx, method: method}
+	req := &orderpb.StreamOrderUpdatesRequest{}
+	if err := stream.RecvMsg(req); err != nil {
+		t.Fatalf("RecvMsg returned error: %v", err)
+	}
+	if req.UserId != 5 {
+		t.Errorf("user_id = %d, want 5", req.UserId)
+	}
+
+	// Someone else's updates are refused
+	stream = &authStream{ServerStream: &fakeStream{ctx: ctx, req: &orderpb.StreamOrderUpdatesRequest{UserId: 6}}, ctx: ctx, method: method}
+	if got := status.Code(stream.RecvMsg(&orderpb.StreamOrderUpdatesRequest{})); got != codes.PermissionDenied {
+		t.Errorf("other user's stream: got %v, want PermissionDenied", got)
+	}
+}
+
+func TestStreamAuthInterceptorRejects(t *testing.T) {
+	info := &grpc.StreamServerInfo{FullMethod: "/rival.api.v1.UserService/StreamUserNotifications"}
+	handler := func(srv interface{}, ss grpc.ServerStream) error {
+		t.Errorf("handler ran for an unauthenticated stream")
+		return nil
+	}
+
+	cases := []struct {
+		name string
+		ctx  context.Context
+		want codes.Code
+	}{
+		{"no metadata", context.Background(), codes.Unauthenticated},
+		{"api key", metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-api-key", "rvl_test")), codes.PermissionDenied},
+		{"no token", metadata.NewIncomingContext(context.Background(), metadata.Pairs()), codes.Unauthenticated},
+	}
+	for _, c := range cases {
+		err := StreamAuthInterceptor(nil, &fakeStream{ctx: c.ctx}, info, handler)
+		if got := status.Code(err); got != c.want {
+			t.Errorf("%s: got %v, want %v", c.name, got, c.want)
+		}
+	}
+}
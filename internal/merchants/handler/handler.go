@@ -2,6 +2,8 @@ package handler
 
 import (
 	"context"
+	"errors"
+	"strconv"
 	"time"
 
 	merchantpb "rival/gen/proto/proto/api"
@@ -9,12 +11,34 @@ import (
 	"rival/internal/merchants/repo"
 	"rival/internal/merchants/service"
 	"rival/internal/merchants/util"
+	orderrepo "rival/internal/orders/repo"
+	orderservice "rival/internal/orders/service"
+	receiptrepo "rival/internal/receipts/repo"
+	receiptservice "rival/internal/receipts/service"
+	reviewrepo "rival/internal/reviews/repo"
+	reviewservice "rival/internal/reviews/service"
+	"rival/pkg/audit"
+	"rival/pkg/business"
+	"rival/pkg/notify"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type MerchantHandler struct {
 	merchantpb.UnimplementedMerchantServiceServer
 	service service.MerchantService
-	pubsub  util.MerchantPubSubService
+	apiKeys    service.APIKeyService
+	onboarding service.OnboardingService
+	documents  service.DocumentService
+	hours      service.HoursService
+	catalog    service.CatalogService
+	staff      service.StaffService
+	orders     orderservice.OrderService
+	receipts   receiptservice.ReceiptService
+	reviews    reviewservice.ReviewService
+	notifier   *notify.Service
+	pubsub     util.MerchantPubSubService
 }
 
 func NewMerchantHandler() (*MerchantHandler, error) {
@@ -23,12 +47,79 @@ func NewMerchantHandler() (*MerchantHandler, error) {
 		return nil, err
 	}
 
-	merchantService := service.NewMerchantService(repository)
+	apiKeyRepository, err := repo.NewAPIKeyRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	onboardingRepository, err := repo.NewOnboardingRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	documentRepository, err := repo.NewDocumentRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	hoursRepository, err := repo.NewHoursRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	catalogRepository, err := repo.NewCatalogRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	staffRepository, err := repo.NewStaffRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	orderRepository, err := orderrepo.NewOrderRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	receiptRepository, err := receiptrepo.NewReceiptRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	reviewRepository, err := reviewrepo.NewReviewRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	notifier, err := notify.NewServiceFromConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	hoursService := service.NewHoursService(hoursRepository)
+	merchantService := service.NewMerchantService(repository, hoursService, notifier)
+	apiKeyService := service.NewAPIKeyService(apiKeyRepository)
+	documentService := service.NewDocumentService(documentRepository, notifier)
+	onboardingService := service.NewOnboardingService(onboardingRepository, documentService, notifier)
+	catalogService := service.NewCatalogService(catalogRepository)
+	staffService := service.NewStaffService(staffRepository)
+	orderService := orderservice.NewOrderService(orderRepository, hoursService, catalogService, notifier)
 	pubsubService := util.NewMerchantPubSubService()
 
 	return &MerchantHandler{
-		service: merchantService,
-		pubsub:  pubsubService,
+		service:    merchantService,
+		apiKeys:    apiKeyService,
+		onboarding: onboardingService,
+		documents:  documentService,
+		hours:      hoursService,
+		catalog:    catalogService,
+		staff:      staffService,
+		orders:     orderService,
+		receipts:   receiptservice.NewReceiptService(receiptRepository),
+		reviews:    reviewservice.NewReviewService(reviewRepository),
+		notifier:   notifier,
+		pubsub:     pubsubService,
 	}, nil
 }
 
@@ -47,38 +138,156 @@ func (h *MerchantHandler) GetMerchantAddress(ctx context.Context, req *merchantp
 	return h.service.GetMerchantAddress(ctx, int(req.MerchantId))
 }
 func (h *MerchantHandler) UpdateMerchantAddress(ctx context.Context, req *merchantpb.UpdateMerchantAddressRequest) (*merchantpb.UpdateMerchantAddressResponse, error) {
-	// Create address record (simplified)
-	address := &schemapb.MerchantAddress{
-		Id:         req.MerchantId,
-		MerchantId: req.MerchantId,
+	if req.MerchantId == 0 {
+		return nil, errors.New("merchant ID is required")
+	}
+
+	params := service.AddressParams{
 		Street:     req.Street,
 		City:       req.City,
 		State:      req.State,
 		PostalCode: req.PostalCode,
 		Country:    req.Country,
+		Label:      req.Label,
 		Latitude:   req.Latitude,
 		Longitude:  req.Longitude,
 	}
 
-	return &merchantpb.UpdateMerchantAddressResponse{Address: address}, nil
+	return h.service.UpdateMerchantAddress(ctx, int(req.MerchantId), req.AddressId, params)
+}
+
+func (h *MerchantHandler) AddMerchantAddress(ctx context.Context, req *merchantpb.AddMerchantAddressRequest) (*merchantpb.AddMerchantAddressResponse, error) {
+	if req.MerchantId == 0 {
+		return nil, errors.New("merchant ID is required")
+	}
+
+	params := service.AddressParams{
+		Street:     req.Street,
+		City:       req.City,
+		State:      req.State,
+		PostalCode: req.PostalCode,
+		Country:    req.Country,
+		Label:      req.Label,
+		Latitude:   req.Latitude,
+		Longitude:  req.Longitude,
+	}
+
+	return h.service.AddMerchantAddress(ctx, int(req.MerchantId), params, req.IsPrimary)
+}
+
+func (h *MerchantHandler) DeleteMerchantAddress(ctx context.Context, req *merchantpb.DeleteMerchantAddressRequest) (*merchantpb.DeleteMerchantAddressResponse, error) {
+	if req.MerchantId == 0 || req.AddressId == 0 {
+		return nil, errors.New("merchant ID and address ID are required")
+	}
+
+	return h.service.DeleteMerchantAddress(ctx, int(req.MerchantId), req.AddressId)
+}
+
+func (h *MerchantHandler) SetPrimaryMerchantAddress(ctx context.Context, req *merchantpb.SetPrimaryMerchantAddressRequest) (*merchantpb.SetPrimaryMerchantAddressResponse, error) {
+	if req.MerchantId == 0 || req.AddressId == 0 {
+		return nil, errors.New("merchant ID and address ID are required")
+	}
+
+	return h.service.SetPrimaryMerchantAddress(ctx, int(req.MerchantId), req.AddressId)
 }
 
 func (h *MerchantHandler) GetOrders(ctx context.Context, req *merchantpb.GetOrdersRequest) (*merchantpb.GetOrdersResponse, error) {
+	if req.MerchantId == 0 {
+		return nil, errors.New("merchant ID is required")
+	}
+	if req.Limit <= 0 || req.Limit > 100 {
+		req.Limit = 20
+	}
+	if req.Page <= 0 {
+		req.Page = 1
+	}
 
-	return h.service.GetOrders(ctx, req)
+	// Staff limited to some outlets only see those outlets' orders
+	outletIDs, _ := ctx.Value("outlet_ids").([]int64)
+	if req.OutletId != 0 {
+		if !util.OutletAllowed(outletIDs, req.OutletId) {
+			return nil, status.Error(codes.PermissionDenied, "You don't have access to this outlet")
+		}
+		outletIDs = []int64{req.OutletId}
+	}
+
+	filter := orderservice.OrderFilter{
+		MerchantID: int(req.MerchantId),
+		Status:     req.Status,
+		OutletIDs:  outletIDs,
+		MinAmount:  req.MinAmount,
+		Search:     req.Search,
+		Sort:       req.Sort,
+		Page:       req.Page,
+		Limit:      req.Limit,
+	}
+	if req.FromDate != 0 {
+		filter.From = time.Unix(req.FromDate, 0)
+	}
+	if req.ToDate != 0 {
+		filter.To = time.Unix(req.ToDate, 0)
+	}
+
+	return h.orders.ListMerchantOrders(ctx, filter)
 }
 
 func (h *MerchantHandler) UpdateOrderStatus(ctx context.Context, req *merchantpb.UpdateOrderStatusRequest) (*merchantpb.UpdateOrderStatusResponse, error) {
-	// Update order status (simplified)
-	order := &schemapb.Order{
-		Id:        req.OrderId,
-		Status:    req.Status,
-		UpdatedAt: time.Now().Unix(),
+	if req.MerchantId == 0 || req.OrderId == 0 {
+		return nil, errors.New("merchant ID and order ID are required")
+	}
+	if req.Status == "" {
+		return nil, status.Error(codes.InvalidArgument, "status is required")
+	}
+
+	actorID, _ := ctx.Value("user_id").(int)
+	outletIDs, _ := ctx.Value("outlet_ids").([]int64)
+	order, err := h.orders.Transition(ctx, orderservice.StatusChange{
+		OrderID:    int(req.OrderId),
+		To:         req.Status,
+		ActorID:    int64(actorID),
+		ActorType:  audit.ActorMerchant,
+		MerchantID: int(req.MerchantId),
+		OutletIDs:  outletIDs,
+		Reason:     req.Notes,
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return &merchantpb.UpdateOrderStatusResponse{Order: order}, nil
 }
 
+func (h *MerchantHandler) GetOrderReceipt(ctx context.Context, req *merchantpb.GetOrderReceiptRequest) (*merchantpb.GetOrderReceiptResponse, error) {
+	if req.MerchantId == 0 || req.OrderId == 0 {
+		return nil, errors.New("merchant ID and order ID are required")
+	}
+
+	outletIDs, _ := ctx.Value("outlet_ids").([]int64)
+	receipt, err := h.receipts.OrderReceipt(ctx, req.OrderId, receiptservice.Viewer{
+		MerchantID: req.MerchantId,
+		OutletIDs:  outletIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &merchantpb.GetOrderReceiptResponse{Receipt: receipt}, nil
+}
+
+func (h *MerchantHandler) ReplyToReview(ctx context.Context, req *merchantpb.ReplyToReviewRequest) (*merchantpb.ReplyToReviewResponse, error) {
+	if req.MerchantId == 0 || req.ReviewId == 0 {
+		return nil, errors.New("merchant ID and review ID are required")
+	}
+
+	actorID, _ := ctx.Value("user_id").(int)
+	review, err := h.reviews.Reply(ctx, req.MerchantId, req.ReviewId, int64(actorID), req.Reply)
+	if err != nil {
+		return nil, err
+	}
+
+	return &merchantpb.ReplyToReviewResponse{Review: review}, nil
+}
+
 func (h *MerchantHandler) GetCustomers(ctx context.Context, req *merchantpb.GetCustomersRequest) (*merchantpb.GetCustomersResponse, error) {
 
 	return h.service.GetCustomers(ctx, req)
@@ -123,16 +332,389 @@ func (h *MerchantHandler) StreamOrders(req *merchantpb.StreamOrdersRequest, stre
 	return nil
 }
 
+// StreamNotifications replays the merchant's unread notifications and then
+// sends new ones as they happen. The stream interceptor has already checked
+// the caller is staff of req.MerchantId.
 func (h *MerchantHandler) StreamNotifications(req *merchantpb.StreamNotificationsRequest, stream merchantpb.MerchantService_StreamNotificationsServer) error {
-	ch := h.pubsub.SubscribeMerchantNotifications(int(req.MerchantId))
-	defer ch.Close()
+	if req.MerchantId == 0 {
+		return status.Error(codes.InvalidArgument, "merchant ID is required")
+	}
 
-	for data := range ch.Receive() {
-		if notification, ok := data.(*merchantpb.StreamNotificationsResponse); ok {
-			if err := stream.Send(notification); err != nil {
-				return err
-			}
-		}
+	return h.notifier.Stream(stream.Context(), notify.Merchant(req.MerchantId), func(n *schemapb.Notification, replayed bool) error {
+		return stream.Send(&merchantpb.StreamNotificationsResponse{
+			Id:        strconv.FormatInt(n.Id, 10),
+			Title:     n.Title,
+			Message:   n.Body,
+			Type:      n.Type,
+			Timestamp: n.CreatedAt,
+			DeepLink:  n.DeepLink,
+			Replayed:  replayed,
+		})
+	})
+}
+
+func (h *MerchantHandler) ListNotifications(ctx context.Context, req *merchantpb.ListMerchantNotificationsRequest) (*merchantpb.ListMerchantNotificationsResponse, error) {
+	if req.MerchantId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "merchant ID is required")
+	}
+
+	notifications, total, unread, err := h.notifier.List(ctx, notify.Merchant(req.MerchantId), req.Page, req.Limit, req.UnreadOnly)
+	if err != nil {
+		return nil, err
+	}
+	return &merchantpb.ListMerchantNotificationsResponse{
+		Notifications: notifications,
+		TotalCount:    total,
+		UnreadCount:   unread,
+	}, nil
+}
+
+func (h *MerchantHandler) MarkNotificationsRead(ctx context.Context, req *merchantpb.MarkMerchantNotificationsReadRequest) (*merchantpb.MarkMerchantNotificationsReadResponse, error) {
+	if req.MerchantId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "merchant ID is required")
+	}
+
+	updated, err := h.notifier.MarkRead(ctx, notify.Merchant(req.MerchantId), req.NotificationIds, req.All)
+	if err != nil {
+		return nil, err
+	}
+	return &merchantpb.MarkMerchantNotificationsReadResponse{Updated: updated}, nil
+}
+
+func (h *MerchantHandler) GetUnreadNotificationCount(ctx context.Context, req *merchantpb.GetMerchantUnreadNotificationCountRequest) (*merchantpb.GetMerchantUnreadNotificationCountResponse, error) {
+	if req.MerchantId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "merchant ID is required")
+	}
+
+	count, err := h.notifier.UnreadCount(ctx, notify.Merchant(req.MerchantId))
+	if err != nil {
+		return nil, err
+	}
+	return &merchantpb.GetMerchantUnreadNotificationCountResponse{Count: count}, nil
+}
+
+func (h *MerchantHandler) CreateAPIKey(ctx context.Context, req *merchantpb.CreateAPIKeyRequest) (*merchantpb.CreateAPIKeyResponse, error) {
+	if req.MerchantId == 0 {
+		return nil, errors.New("merchant ID is required")
+	}
+
+	if err := requireMerchantStaff(ctx, req.MerchantId); err != nil {
+		return nil, err
+	}
+
+	createdBy, _ := ctx.Value("user_id").(int)
+	return h.apiKeys.CreateAPIKey(ctx, req, createdBy)
+}
+
+func (h *MerchantHandler) ListAPIKeys(ctx context.Context, req *merchantpb.ListAPIKeysRequest) (*merchantpb.ListAPIKeysResponse, error) {
+	if req.MerchantId == 0 {
+		return &merchantpb.ListAPIKeysResponse{ApiKeys: nil}, nil
+	}
+	if err := requireMerchantStaff(ctx, req.MerchantId); err != nil {
+		return nil, err
+	}
+
+	return h.apiKeys.ListAPIKeys(ctx, int(req.MerchantId))
+}
+
+func (h *MerchantHandler) RevokeAPIKey(ctx context.Context, req *merchantpb.RevokeAPIKeyRequest) (*merchantpb.RevokeAPIKeyResponse, error) {
+	if req.MerchantId == 0 || req.ApiKeyId == 0 {
+		return &merchantpb.RevokeAPIKeyResponse{Success: false}, nil
+	}
+	if err := requireMerchantStaff(ctx, req.MerchantId); err != nil {
+		return nil, err
+	}
+
+	return h.apiKeys.RevokeAPIKey(ctx, int(req.MerchantId), req.ApiKeyId)
+}
+
+// requireMerchantStaff makes key management fail closed: the caller must be a
+// signed-in user the auth interceptor authorized for this very merchant, so a
+// gap in the interceptor's permission map can't hand out keys.
+func requireMerchantStaff(ctx context.Context, merchantID int64) error {
+	if authType, _ := ctx.Value("auth_type").(string); authType != "jwt" {
+		return status.Error(codes.PermissionDenied, "API keys are managed by merchant staff")
+	}
+	if authorized, ok := ctx.Value("merchant_id").(int); !ok || int64(authorized) != merchantID {
+		return status.Error(codes.PermissionDenied, "You don't have access to this merchant")
 	}
 	return nil
 }
+
+func (h *MerchantHandler) SubmitForReview(ctx context.Context, req *merchantpb.SubmitForReviewRequest) (*merchantpb.SubmitForReviewResponse, error) {
+	if req.MerchantId == 0 {
+		return nil, errors.New("merchant ID is required")
+	}
+
+	actorID, _ := ctx.Value("user_id").(int)
+	return h.onboarding.SubmitForReview(ctx, int(req.MerchantId), int64(actorID))
+}
+
+func (h *MerchantHandler) GetOnboardingStatus(ctx context.Context, req *merchantpb.GetOnboardingStatusRequest) (*merchantpb.GetOnboardingStatusResponse, error) {
+	if req.MerchantId == 0 {
+		return nil, errors.New("merchant ID is required")
+	}
+
+	return h.onboarding.GetOnboardingStatus(ctx, int(req.MerchantId))
+}
+
+func (h *MerchantHandler) RequestDocumentUpload(ctx context.Context, req *merchantpb.RequestDocumentUploadRequest) (*merchantpb.RequestDocumentUploadResponse, error) {
+	if req.MerchantId == 0 {
+		return nil, errors.New("merchant ID is required")
+	}
+
+	return h.documents.RequestUpload(ctx, req)
+}
+
+func (h *MerchantHandler) ConfirmDocumentUpload(ctx context.Context, req *merchantpb.ConfirmDocumentUploadRequest) (*merchantpb.ConfirmDocumentUploadResponse, error) {
+	if req.MerchantId == 0 {
+		return nil, errors.New("merchant ID is required")
+	}
+
+	return h.documents.ConfirmUpload(ctx, req)
+}
+
+func (h *MerchantHandler) ListDocuments(ctx context.Context, req *merchantpb.ListDocumentsRequest) (*merchantpb.ListDocumentsResponse, error) {
+	if req.MerchantId == 0 {
+		return nil, errors.New("merchant ID is required")
+	}
+
+	return h.documents.ListDocuments(ctx, int(req.MerchantId))
+}
+
+// StartDocumentExpiryReminders blocks, notifying merchants before their licences lapse.
+func (h *MerchantHandler) StartDocumentExpiryReminders(ctx context.Context) {
+	h.documents.StartExpiryReminders(ctx)
+}
+
+func (h *MerchantHandler) GetBusinessHours(ctx context.Context, req *merchantpb.GetBusinessHoursRequest) (*merchantpb.GetBusinessHoursResponse, error) {
+	if req.MerchantId == 0 {
+		return nil, errors.New("merchant ID is required")
+	}
+
+	return h.hours.GetBusinessHours(ctx, int(req.MerchantId))
+}
+
+func (h *MerchantHandler) SetBusinessHours(ctx context.Context, req *merchantpb.SetBusinessHoursRequest) (*merchantpb.GetBusinessHoursResponse, error) {
+	if req.MerchantId == 0 {
+		return nil, errors.New("merchant ID is required")
+	}
+
+	var intervals []business.Interval
+	for _, in := range req.Intervals {
+		opens, err := business.ParseClock(in.OpensAt)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		closes, err := business.ParseClock(in.ClosesAt)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		intervals = append(intervals, business.Interval{
+			Day:    time.Weekday(in.DayOfWeek),
+			Opens:  opens,
+			Closes: closes,
+		})
+	}
+
+	return h.hours.SetBusinessHours(ctx, int(req.MerchantId), req.Timezone, intervals)
+}
+
+func (h *MerchantHandler) AddClosure(ctx context.Context, req *merchantpb.AddClosureRequest) (*merchantpb.AddClosureResponse, error) {
+	if req.MerchantId == 0 {
+		return nil, errors.New("merchant ID is required")
+	}
+
+	params := service.ClosureParams{
+		Date:   req.Date,
+		Reason: req.Reason,
+	}
+	if req.StartsAt != 0 {
+		params.StartsAt = time.Unix(req.StartsAt, 0)
+		params.EndsAt = time.Unix(req.EndsAt, 0)
+	}
+
+	return h.hours.AddClosure(ctx, int(req.MerchantId), params)
+}
+
+func (h *MerchantHandler) DeleteClosure(ctx context.Context, req *merchantpb.DeleteClosureRequest) (*merchantpb.DeleteClosureResponse, error) {
+	if req.MerchantId == 0 || req.ClosureId == 0 {
+		return nil, errors.New("merchant ID and closure ID are required")
+	}
+
+	return h.hours.DeleteClosure(ctx, int(req.MerchantId), req.ClosureId)
+}
+
+func (h *MerchantHandler) PauseOrders(ctx context.Context, req *merchantpb.PauseOrdersRequest) (*merchantpb.PauseOrdersResponse, error) {
+	if req.MerchantId == 0 {
+		return nil, errors.New("merchant ID is required")
+	}
+
+	return h.hours.PauseOrders(ctx, int(req.MerchantId), int(req.Minutes))
+}
+
+func (h *MerchantHandler) GetCatalog(ctx context.Context, req *merchantpb.GetCatalogRequest) (*merchantpb.GetCatalogResponse, error) {
+	if req.MerchantId == 0 {
+		return nil, errors.New("merchant ID is required")
+	}
+
+	return h.catalog.GetCatalog(ctx, int(req.MerchantId))
+}
+
+func (h *MerchantHandler) CreateCatalogCategory(ctx context.Context, req *merchantpb.CreateCatalogCategoryRequest) (*merchantpb.CatalogCategoryResponse, error) {
+	if req.MerchantId == 0 {
+		return nil, errors.New("merchant ID is required")
+	}
+
+	return h.catalog.CreateCategory(ctx, int(req.MerchantId), req.Name, req.SortOrder)
+}
+
+func (h *MerchantHandler) UpdateCatalogCategory(ctx context.Context, req *merchantpb.UpdateCatalogCategoryRequest) (*merchantpb.CatalogCategoryResponse, error) {
+	if req.MerchantId == 0 || req.CategoryId == 0 {
+		return nil, errors.New("merchant ID and category ID are required")
+	}
+
+	return h.catalog.UpdateCategory(ctx, int(req.MerchantId), req.CategoryId, req.Name, req.SortOrder)
+}
+
+func (h *MerchantHandler) DeleteCatalogCategory(ctx context.Context, req *merchantpb.DeleteCatalogCategoryRequest) (*merchantpb.DeleteCatalogCategoryResponse, error) {
+	if req.MerchantId == 0 || req.CategoryId == 0 {
+		return nil, errors.New("merchant ID and category ID are required")
+	}
+
+	return h.catalog.DeleteCategory(ctx, int(req.MerchantId), req.CategoryId)
+}
+
+func (h *MerchantHandler) CreateCatalogItem(ctx context.Context, req *merchantpb.CreateCatalogItemRequest) (*merchantpb.CatalogItemResponse, error) {
+	if req.MerchantId == 0 {
+		return nil, errors.New("merchant ID is required")
+	}
+
+	params := service.ItemParams{
+		CategoryID:  req.CategoryId,
+		Name:        req.Name,
+		Description: req.Description,
+		Price:       req.Price,
+		SortOrder:   req.SortOrder,
+		Options:     optionParams(req.Options),
+	}
+
+	return h.catalog.CreateItem(ctx, int(req.MerchantId), params)
+}
+
+func (h *MerchantHandler) UpdateCatalogItem(ctx context.Context, req *merchantpb.UpdateCatalogItemRequest) (*merchantpb.CatalogItemResponse, error) {
+	if req.MerchantId == 0 || req.ItemId == 0 {
+		return nil, errors.New("merchant ID and item ID are required")
+	}
+
+	params := service.ItemParams{
+		CategoryID:  req.CategoryId,
+		Name:        req.Name,
+		Description: req.Description,
+		Price:       req.Price,
+		SortOrder:   req.SortOrder,
+		Options:     optionParams(req.Options),
+	}
+
+	return h.catalog.UpdateItem(ctx, int(req.MerchantId), req.ItemId, params)
+}
+
+func (h *MerchantHandler) DeleteCatalogItem(ctx context.Context, req *merchantpb.DeleteCatalogItemRequest) (*merchantpb.DeleteCatalogItemResponse, error) {
+	if req.MerchantId == 0 || req.ItemId == 0 {
+		return nil, errors.New("merchant ID and item ID are required")
+	}
+
+	return h.catalog.DeleteItem(ctx, int(req.MerchantId), req.ItemId)
+}
+
+func (h *MerchantHandler) SetCatalogAvailability(ctx context.Context, req *merchantpb.SetCatalogAvailabilityRequest) (*merchantpb.SetCatalogAvailabilityResponse, error) {
+	if req.MerchantId == 0 {
+		return nil, errors.New("merchant ID is required")
+	}
+
+	return h.catalog.SetAvailability(ctx, int(req.MerchantId), req.ItemId, req.OptionId, req.IsAvailable)
+}
+
+func (h *MerchantHandler) RequestCatalogImageUpload(ctx context.Context, req *merchantpb.RequestCatalogImageUploadRequest) (*merchantpb.RequestCatalogImageUploadResponse, error) {
+	if req.MerchantId == 0 || req.ItemId == 0 {
+		return nil, errors.New("merchant ID and item ID are required")
+	}
+
+	return h.catalog.RequestImageUpload(ctx, req)
+}
+
+func (h *MerchantHandler) ConfirmCatalogImageUpload(ctx context.Context, req *merchantpb.ConfirmCatalogImageUploadRequest) (*merchantpb.CatalogItemResponse, error) {
+	if req.MerchantId == 0 || req.ItemId == 0 {
+		return nil, errors.New("merchant ID and item ID are required")
+	}
+
+	return h.catalog.ConfirmImageUpload(ctx, req)
+}
+
+func (h *MerchantHandler) InviteStaff(ctx context.Context, req *merchantpb.InviteStaffRequest) (*merchantpb.InviteStaffResponse, error) {
+	if req.MerchantId == 0 {
+		return nil, errors.New("merchant ID is required")
+	}
+
+	invitedBy, _ := ctx.Value("user_id").(int)
+	return h.staff.Invite(ctx, int(req.MerchantId), invitedBy, req.Email, req.Role, req.OutletIds)
+}
+
+func (h *MerchantHandler) AcceptStaffInvitation(ctx context.Context, req *merchantpb.AcceptStaffInvitationRequest) (*merchantpb.AcceptStaffInvitationResponse, error) {
+	if req.Token == "" {
+		return nil, errors.New("invitation token is required")
+	}
+
+	userID, _ := ctx.Value("user_id").(int)
+	return h.staff.AcceptInvitation(ctx, userID, req.Token)
+}
+
+func (h *MerchantHandler) RevokeStaffInvitation(ctx context.Context, req *merchantpb.RevokeStaffInvitationRequest) (*merchantpb.RevokeStaffInvitationResponse, error) {
+	if req.MerchantId == 0 || req.InvitationId == 0 {
+		return nil, errors.New("merchant ID and invitation ID are required")
+	}
+
+	return h.staff.RevokeInvitation(ctx, int(req.MerchantId), req.InvitationId)
+}
+
+func (h *MerchantHandler) ListStaff(ctx context.Context, req *merchantpb.ListStaffRequest) (*merchantpb.ListStaffResponse, error) {
+	if req.MerchantId == 0 {
+		return nil, errors.New("merchant ID is required")
+	}
+
+	return h.staff.ListStaff(ctx, int(req.MerchantId))
+}
+
+func (h *MerchantHandler) UpdateStaff(ctx context.Context, req *merchantpb.UpdateStaffRequest) (*merchantpb.UpdateStaffResponse, error) {
+	if req.MerchantId == 0 || req.StaffId == 0 {
+		return nil, errors.New("merchant ID and staff ID are required")
+	}
+
+	return h.staff.UpdateStaff(ctx, int(req.MerchantId), req.StaffId, req.Role, req.OutletIds)
+}
+
+func (h *MerchantHandler) RemoveStaff(ctx context.Context, req *merchantpb.RemoveStaffRequest) (*merchantpb.RemoveStaffResponse, error) {
+	if req.MerchantId == 0 || req.StaffId == 0 {
+		return nil, errors.New("merchant ID and staff ID are required")
+	}
+
+	return h.staff.RemoveStaff(ctx, int(req.MerchantId), req.StaffId)
+}
+
+func (h *MerchantHandler) ListMyMemberships(ctx context.Context, req *merchantpb.ListMyMembershipsRequest) (*merchantpb.ListMyMembershipsResponse, error) {
+	userID, _ := ctx.Value("user_id").(int)
+	return h.staff.ListMemberships(ctx, userID)
+}
+
+func optionParams(options []*merchantpb.CatalogOptionInput) []service.OptionParams {
+	var params []service.OptionParams
+	for _, option := range options {
+		params = append(params, service.OptionParams{
+			Kind:        option.Kind,
+			Name:        option.Name,
+			PriceDelta:  option.PriceDelta,
+			IsAvailable: option.IsAvailable,
+		})
+	}
+	return params
+}
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"fmt"
 	"rival/config"
 	"rival/connection"
 	merchantpb "rival/gen/proto/proto/api"
@@ -9,7 +10,12 @@ import (
 	schemapb "rival/gen/proto/proto/schema"
 	schema "rival/gen/sql"
 	authHandler "rival/internal/auth/handler"
+	"rival/internal/merchants/util"
+	"rival/pkg/utils"
 	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 // NewMerchantUser creates a test merchant user for testing
@@ -43,9 +49,9 @@ func NewMerchantUser(ctx context.Context, email string, t *testing.T) (*pb.Signu
 	data := pb.SignupRequest{
 		Name:     "Test Merchant User",
 		Email:    email,
-		Password: "password123",
+		Password: "Rival-Passw0rd",
 		Role:     *schemapb.UserRole_USER_ROLE_MERCHANT.Enum(),
-		Phone:    "12345678",
+		Phone:    "9876543210",
 	}
 
 	_, err = handler.Signup(ctx, &data)
@@ -87,9 +93,9 @@ func NewCustomerUser(ctx context.Context, email string, t *testing.T) (*pb.Signu
 	data := pb.SignupRequest{
 		Name:     "Test Customer User",
 		Email:    email,
-		Password: "password123",
+		Password: "Rival-Passw0rd",
 		Role:     *schemapb.UserRole_USER_ROLE_CUSTOMER.Enum(),
-		Phone:    "87654321",
+		Phone:    "9123456780",
 	}
 
 	_, err = handler.Signup(ctx, &data)
@@ -222,10 +228,11 @@ func TestUpdateMerchantAddress(t *testing.T) {
 	ctx := context.Background()
 
 	// Create a test merchant user
-	_, repo, merchant := NewMerchantUser(ctx, "test-update-address-merchant@example.com", t)
+	_, repo, merchantUser := NewMerchantUser(ctx, "test-update-address-merchant@example.com", t)
+	merchant := CreateMerchantRecord(ctx, merchantUser, repo, t)
 	defer func() {
 		CleanupMerchant(ctx, merchant.Email, repo, t)
-		err := repo.DleteUser(ctx, merchant.ID)
+		err := repo.DleteUser(ctx, merchantUser.ID)
 		if err != nil {
 			t.Logf("Failed to cleanup merchant: %v", err)
 		}
@@ -238,7 +245,7 @@ func TestUpdateMerchantAddress(t *testing.T) {
 
 	// Test updating merchant address
 	req := &merchantpb.UpdateMerchantAddressRequest{
-		MerchantId: int64(merchant.ID),
+		MerchantId: merchant.ID,
 		Street:     "123 Test Street",
 		City:       "Test City",
 		State:      "Test State",
@@ -268,10 +275,114 @@ func TestUpdateMerchantAddress(t *testing.T) {
 	if resp.Address.City != req.City {
 		t.Errorf("Expected city %s, got %s", req.City, resp.Address.City)
 	}
+	if !resp.Address.IsPrimary {
+		t.Errorf("Expected first address to be primary")
+	}
+
+	// Updating again edits the same primary address instead of adding one
+	req.Street = "456 Other Street"
+	resp, err = h.UpdateMerchantAddress(ctx, req)
+	if err != nil {
+		t.Fatalf("UpdateMerchantAddress returned error: %v", err)
+	}
+
+	addresses, err := h.GetMerchantAddress(ctx, &merchantpb.GetMerchantAddressRequest{MerchantId: merchant.ID})
+	if err != nil {
+		t.Fatalf("GetMerchantAddress returned error: %v", err)
+	}
+	if len(addresses.Addresses) != 1 || addresses.Addresses[0].Street != "456 Other Street" {
+		t.Errorf("Expected one persisted address, got %+v", addresses.Addresses)
+	}
 
 	t.Logf("Update merchant address response: %+v", resp)
 }
 
+func TestMerchantBranches(t *testing.T) {
+	ctx := context.Background()
+
+	_, repo, merchantUser := NewMerchantUser(ctx, "test-branches-merchant@example.com", t)
+	merchant := CreateMerchantRecord(ctx, merchantUser, repo, t)
+	defer func() {
+		CleanupMerchant(ctx, merchant.Email, repo, t)
+		err := repo.DleteUser(ctx, merchantUser.ID)
+		if err != nil {
+			t.Logf("Failed to cleanup merchant: %v", err)
+		}
+	}()
+
+	h, err := NewMerchantHandler()
+	if err != nil {
+		t.Fatalf("Failed to create handler: %v", err)
+	}
+
+	first, err := h.AddMerchantAddress(ctx, &merchantpb.AddMerchantAddressRequest{
+		MerchantId: merchant.ID,
+		Label:      "Indiranagar",
+		Street:     "100 Feet Road",
+		City:       "Bengaluru",
+		Latitude:   12.9719,
+		Longitude:  77.6412,
+	})
+	if err != nil {
+		t.Fatalf("AddMerchantAddress returned error: %v", err)
+	}
+	if !first.Address.IsPrimary {
+		t.Errorf("Expected first branch to be primary")
+	}
+
+	second, err := h.AddMerchantAddress(ctx, &merchantpb.AddMerchantAddressRequest{
+		MerchantId: merchant.ID,
+		Label:      "Koramangala",
+		Street:     "80 Feet Road",
+		City:       "Bengaluru",
+		Latitude:   12.9352,
+		Longitude:  77.6245,
+	})
+	if err != nil {
+		t.Fatalf("AddMerchantAddress returned error: %v", err)
+	}
+	if second.Address.IsPrimary {
+		t.Errorf("Expected second branch not to be primary")
+	}
+
+	if _, err := h.AddMerchantAddress(ctx, &merchantpb.AddMerchantAddressRequest{
+		MerchantId: merchant.ID,
+		Street:     "Nowhere",
+		City:       "Bengaluru",
+		Latitude:   120,
+		Longitude:  77.6,
+	}); err == nil {
+		t.Errorf("Expected out of range latitude to be rejected")
+	}
+
+	primary, err := h.SetPrimaryMerchantAddress(ctx, &merchantpb.SetPrimaryMerchantAddressRequest{
+		MerchantId: merchant.ID,
+		AddressId:  second.Address.Id,
+	})
+	if err != nil {
+		t.Fatalf("SetPrimaryMerchantAddress returned error: %v", err)
+	}
+	if !primary.Address.IsPrimary {
+		t.Errorf("Expected branch to become primary")
+	}
+
+	// Deleting the primary promotes the remaining branch
+	if _, err := h.DeleteMerchantAddress(ctx, &merchantpb.DeleteMerchantAddressRequest{
+		MerchantId: merchant.ID,
+		AddressId:  second.Address.Id,
+	}); err != nil {
+		t.Fatalf("DeleteMerchantAddress returned error: %v", err)
+	}
+
+	addresses, err := h.GetMerchantAddress(ctx, &merchantpb.GetMerchantAddressRequest{MerchantId: merchant.ID})
+	if err != nil {
+		t.Fatalf("GetMerchantAddress returned error: %v", err)
+	}
+	if len(addresses.Addresses) != 1 || addresses.Addresses[0].Id != first.Address.Id || !addresses.Addresses[0].IsPrimary {
+		t.Errorf("Expected remaining branch to be primary, got %+v", addresses.Addresses)
+	}
+}
+
 func TestGetOrders(t *testing.T) {
 	ctx := context.Background()
 
@@ -309,43 +420,186 @@ func TestGetOrders(t *testing.T) {
 	t.Logf("Get orders response: %+v", resp)
 }
 
-func TestUpdateOrderStatus(t *testing.T) {
+func TestGetOrders_Filters(t *testing.T) {
 	ctx := context.Background()
 
+	_, repo, merchant := NewMerchantUser(ctx, "test-orders-filter-merchant@example.com", t)
+	merchantRecord := CreateMerchantRecord(ctx, merchant, repo, t)
+	_, _, customer := NewCustomerUser(ctx, "test-orders-filter-customer@example.com", t)
+	defer func() {
+		CleanupMerchant(ctx, merchantRecord.Email, repo, t)
+		for _, id := range []int64{merchant.ID, customer.ID} {
+			if err := repo.DleteUser(ctx, id); err != nil {
+				t.Logf("Failed to cleanup user: %v", err)
+			}
+		}
+	}()
+
+	var outlets []schema.MerchantAddress
+	for _, label := range []string{"Downtown", "Airport"} {
+		outlet, err := repo.CreateMerchantAddress(ctx, schema.CreateMerchantAddressParams{
+			MerchantID: pgtype.Int8{Int64: merchantRecord.ID, Valid: true},
+			City:       pgtype.Text{String: "Pune", Valid: true},
+			Label:      pgtype.Text{String: label, Valid: true},
+			IsPrimary:  len(outlets) == 0,
+		})
+		if err != nil {
+			t.Fatalf("Failed to create outlet: %v", err)
+		}
+		outlets = append(outlets, outlet)
+	}
+
+	suffix := time.Now().Format("150405.000")
+	amounts := []float64{50, 250, 120}
+	for i, amount := range amounts {
+		_, err := repo.CreateOrder(ctx, schema.CreateOrderParams{
+			MerchantID:  pgtype.Int8{Int64: merchantRecord.ID, Valid: true},
+			UserID:      pgtype.Int8{Int64: customer.ID, Valid: true},
+			OrderNumber: fmt.Sprintf("TEST-FILTER-%d-%s", i, suffix),
+			Items:       []byte(`[{"name":"Coffee","quantity":1}]`),
+			Subtotal:    utils.Float64ToNumeric(amount),
+			TotalAmount: utils.Float64ToNumeric(amount),
+			Status:      []string{"pending", "pending", "accepted"}[i],
+			OutletID:    pgtype.Int8{Int64: outlets[i%2].ID, Valid: true},
+		})
+		if err != nil {
+			t.Fatalf("Failed to create order: %v", err)
+		}
+	}
+
 	h, err := NewMerchantHandler()
 	if err != nil {
 		t.Fatalf("Failed to create handler: %v", err)
 	}
 
-	// Test updating order status
-	req := &merchantpb.UpdateOrderStatusRequest{
-		OrderId: 123,         // Use int64 instead of string
-		Status:  "confirmed", // Use string status instead of enum
-		Notes:   "Order confirmed by merchant",
+	cases := []struct {
+		name  string
+		req   *merchantpb.GetOrdersRequest
+		total int32
+		first float64
+	}{
+		{"all", &merchantpb.GetOrdersRequest{}, 3, 120},
+		{"status", &merchantpb.GetOrdersRequest{Status: "pending", Sort: "amount_desc"}, 2, 250},
+		{"outlet", &merchantpb.GetOrdersRequest{OutletId: outlets[0].ID, Sort: "amount_asc"}, 2, 50},
+		{"min amount", &merchantpb.GetOrdersRequest{MinAmount: 100, Sort: "amount_asc"}, 2, 120},
+		{"order number", &merchantpb.GetOrdersRequest{Search: "TEST-FILTER-1-"}, 1, 250},
+		{"customer phone", &merchantpb.GetOrdersRequest{Search: "87654321", Sort: "oldest"}, 3, 50},
+		{"future", &merchantpb.GetOrdersRequest{FromDate: time.Now().Add(time.Hour).Unix()}, 0, 0},
+		{"paged", &merchantpb.GetOrdersRequest{Limit: 1, Page: 2, Sort: "amount_desc"}, 3, 120},
+	}
+	for _, c := range cases {
+		c.req.MerchantId = merchantRecord.ID
+		resp, err := h.GetOrders(ctx, c.req)
+		if err != nil {
+			t.Fatalf("%s: GetOrders returned error: %v", c.name, err)
+		}
+		if resp.TotalCount != c.total {
+			t.Errorf("%s: expected total %d, got %d", c.name, c.total, resp.TotalCount)
+		}
+		if c.total > 0 && (len(resp.Orders) == 0 || resp.Orders[0].TotalAmount != c.first) {
+			t.Errorf("%s: expected first order of %.2f, got %+v", c.name, c.first, resp.Orders)
+		}
 	}
 
-	resp, err := h.UpdateOrderStatus(ctx, req)
+	// Outlet staff only see their outlets
+	staffCtx := context.WithValue(ctx, "outlet_ids", []int64{outlets[1].ID})
+	resp, err := h.GetOrders(staffCtx, &merchantpb.GetOrdersRequest{MerchantId: merchantRecord.ID})
 	if err != nil {
-		t.Fatalf("UpdateOrderStatus returned error: %v", err)
+		t.Fatalf("GetOrders returned error: %v", err)
+	}
+	if resp.TotalCount != 1 || resp.Orders[0].OutletId != outlets[1].ID {
+		t.Errorf("Expected only the Airport order, got %+v", resp.Orders)
+	}
+	if _, err := h.GetOrders(staffCtx, &merchantpb.GetOrdersRequest{MerchantId: merchantRecord.ID, OutletId: outlets[0].ID}); err == nil {
+		t.Errorf("Expected another outlet to be denied")
 	}
 
-	if resp == nil {
-		t.Fatalf("UpdateOrderStatus returned nil response")
+	if _, err := h.GetOrders(ctx, &merchantpb.GetOrdersRequest{MerchantId: merchantRecord.ID, Sort: "random"}); err == nil {
+		t.Errorf("Expected unknown sort to be rejected")
 	}
+}
+
+func TestUpdateOrderStatus(t *testing.T) {
+	ctx := context.Background()
 
-	if resp.Order == nil {
-		t.Fatalf("UpdateOrderStatus returned nil order")
+	_, repo, merchant := NewMerchantUser(ctx, "test-order-status-merchant@example.com", t)
+	merchantRecord := CreateMerchantRecord(ctx, merchant, repo, t)
+	_, _, customer := NewCustomerUser(ctx, "test-order-status-customer@example.com", t)
+	defer func() {
+		CleanupMerchant(ctx, merchantRecord.Email, repo, t)
+		for _, id := range []int64{merchant.ID, customer.ID} {
+			if err := repo.DleteUser(ctx, id); err != nil {
+				t.Logf("Failed to cleanup user: %v", err)
+			}
+		}
+	}()
+
+	order, err := repo.CreateOrder(ctx, schema.CreateOrderParams{
+		MerchantID:  pgtype.Int8{Int64: merchantRecord.ID, Valid: true},
+		UserID:      pgtype.Int8{Int64: customer.ID, Valid: true},
+		OrderNumber: "TEST-STATUS-" + time.Now().Format("150405.000"),
+		Items:       []byte(`[{"name":"Coffee","quantity":1}]`),
+		Subtotal:    utils.Float64ToNumeric(100),
+		TotalAmount: utils.Float64ToNumeric(85),
+		Status:      "pending",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create order: %v", err)
 	}
 
-	// Verify order status update
-	if resp.Order.Status != req.Status {
-		t.Errorf("Expected status %v, got %v", req.Status, resp.Order.Status)
+	h, err := NewMerchantHandler()
+	if err != nil {
+		t.Fatalf("Failed to create handler: %v", err)
+	}
+
+	// Orders can't skip steps, and other merchants can't touch them
+	invalid := []*merchantpb.UpdateOrderStatusRequest{
+		{MerchantId: merchantRecord.ID, OrderId: order.ID, Status: "completed"},
+		{MerchantId: merchantRecord.ID, OrderId: order.ID, Status: "confirmed"},
+		{MerchantId: merchantRecord.ID, OrderId: order.ID, Status: "rejected"},
+		{MerchantId: merchantRecord.ID + 1, OrderId: order.ID, Status: "accepted"},
 	}
-	if resp.Order.Id != req.OrderId {
-		t.Errorf("Expected order ID %d, got %d", req.OrderId, resp.Order.Id)
+	for i, req := range invalid {
+		if _, err := h.UpdateOrderStatus(ctx, req); err == nil {
+			t.Errorf("Expected request %d to be rejected", i)
+		}
 	}
 
-	t.Logf("Update order status response: %+v", resp)
+	for _, next := range []string{"accepted", "preparing", "ready", "completed"} {
+		resp, err := h.UpdateOrderStatus(ctx, &merchantpb.UpdateOrderStatusRequest{
+			MerchantId: merchantRecord.ID,
+			OrderId:    order.ID,
+			Status:     next,
+			Notes:      "Moved to " + next,
+		})
+		if err != nil {
+			t.Fatalf("UpdateOrderStatus(%s) returned error: %v", next, err)
+		}
+		if resp.Order.Status != next || resp.Order.StatusReason != "Moved to "+next {
+			t.Errorf("Expected status %s, got %+v", next, resp.Order)
+		}
+	}
+
+	completed, err := repo.GetOrderByID(ctx, order.ID)
+	if err != nil {
+		t.Fatalf("Failed to reload order: %v", err)
+	}
+	if completed.Status != "completed" || !completed.AcceptedAt.Valid || !completed.ReadyAt.Valid || !completed.CompletedAt.Valid || completed.CancelledAt.Valid {
+		t.Errorf("Expected completed order with lifecycle timestamps, got %+v", completed)
+	}
+
+	// Completed orders are final
+	if _, err := h.UpdateOrderStatus(ctx, &merchantpb.UpdateOrderStatusRequest{MerchantId: merchantRecord.ID, OrderId: order.ID, Status: "cancelled", Notes: "Too late"}); err == nil {
+		t.Errorf("Expected completed order to stay completed")
+	}
+
+	history, err := repo.ListOrderStatusHistory(ctx, order.ID)
+	if err != nil {
+		t.Fatalf("Failed to list history: %v", err)
+	}
+	if len(history) != 4 || history[0].FromStatus.String != "pending" || history[3].ToStatus != "completed" {
+		t.Errorf("Expected 4 history rows from pending to completed, got %+v", history)
+	}
 }
 
 func TestGetCustomers(t *testing.T) {
@@ -564,3 +818,263 @@ func TestUpdateOffer(t *testing.T) {
 	
 	t.Logf("✓ Offer updated: %+v", resp.Offer)
 }
+
+func TestBusinessHours(t *testing.T) {
+	ctx := context.Background()
+
+	_, repo, merchantUser := NewMerchantUser(ctx, "test-hours-merchant@example.com", t)
+	merchant := CreateMerchantRecord(ctx, merchantUser, repo, t)
+	defer func() {
+		CleanupMerchant(ctx, merchant.Email, repo, t)
+		err := repo.DleteUser(ctx, merchantUser.ID)
+		if err != nil {
+			t.Logf("Failed to cleanup merchant: %v", err)
+		}
+	}()
+
+	h, err := NewMerchantHandler()
+	if err != nil {
+		t.Fatalf("Failed to create handler: %v", err)
+	}
+
+	// Without a schedule the merchant is always open
+	resp, err := h.GetMerchant(ctx, &merchantpb.GetMerchantRequest{MerchantId: merchant.ID})
+	if err != nil {
+		t.Fatalf("GetMerchant returned error: %v", err)
+	}
+	if !resp.Merchant.IsOpenNow {
+		t.Errorf("Expected merchant without hours to be open")
+	}
+
+	// Open every day except the current one
+	today := time.Now().UTC().Weekday()
+	var intervals []*schemapb.BusinessHoursInterval
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if day != today {
+			intervals = append(intervals, &schemapb.BusinessHoursInterval{DayOfWeek: int32(day), OpensAt: "00:00", ClosesAt: "23:59"})
+		}
+	}
+	hours, err := h.SetBusinessHours(ctx, &merchantpb.SetBusinessHoursRequest{
+		MerchantId: merchant.ID,
+		Timezone:   "UTC",
+		Intervals:  intervals,
+	})
+	if err != nil {
+		t.Fatalf("SetBusinessHours returned error: %v", err)
+	}
+	if hours.IsOpenNow || len(hours.Intervals) != 6 {
+		t.Errorf("Expected closed merchant with 6 intervals, got %+v", hours)
+	}
+
+	if _, err := h.SetBusinessHours(ctx, &merchantpb.SetBusinessHoursRequest{
+		MerchantId: merchant.ID,
+		Timezone:   "Mars/Olympus",
+	}); err == nil {
+		t.Errorf("Expected unknown timezone to be rejected")
+	}
+
+	// Clearing the schedule and pausing still keeps the merchant closed
+	if _, err := h.SetBusinessHours(ctx, &merchantpb.SetBusinessHoursRequest{MerchantId: merchant.ID}); err != nil {
+		t.Fatalf("SetBusinessHours returned error: %v", err)
+	}
+	paused, err := h.PauseOrders(ctx, &merchantpb.PauseOrdersRequest{MerchantId: merchant.ID, Minutes: 30})
+	if err != nil {
+		t.Fatalf("PauseOrders returned error: %v", err)
+	}
+	if paused.OrdersPausedUntil <= time.Now().Unix() {
+		t.Errorf("Expected pause in the future, got %d", paused.OrdersPausedUntil)
+	}
+
+	resp, err = h.GetMerchant(ctx, &merchantpb.GetMerchantRequest{MerchantId: merchant.ID})
+	if err != nil {
+		t.Fatalf("GetMerchant returned error: %v", err)
+	}
+	if resp.Merchant.IsOpenNow {
+		t.Errorf("Expected paused merchant to be closed")
+	}
+}
+
+func TestCatalog(t *testing.T) {
+	ctx := context.Background()
+
+	_, repo, merchantUser := NewMerchantUser(ctx, "test-catalog-merchant@example.com", t)
+	merchant := CreateMerchantRecord(ctx, merchantUser, repo, t)
+	defer func() {
+		CleanupMerchant(ctx, merchant.Email, repo, t)
+		err := repo.DleteUser(ctx, merchantUser.ID)
+		if err != nil {
+			t.Logf("Failed to cleanup merchant: %v", err)
+		}
+	}()
+
+	h, err := NewMerchantHandler()
+	if err != nil {
+		t.Fatalf("Failed to create handler: %v", err)
+	}
+
+	category, err := h.CreateCatalogCategory(ctx, &merchantpb.CreateCatalogCategoryRequest{MerchantId: merchant.ID, Name: "Coffee"})
+	if err != nil {
+		t.Fatalf("CreateCatalogCategory returned error: %v", err)
+	}
+	if _, err := h.CreateCatalogCategory(ctx, &merchantpb.CreateCatalogCategoryRequest{MerchantId: merchant.ID, Name: "Coffee"}); err == nil {
+		t.Errorf("Expected duplicate category to be rejected")
+	}
+
+	item, err := h.CreateCatalogItem(ctx, &merchantpb.CreateCatalogItemRequest{
+		MerchantId: merchant.ID,
+		CategoryId: category.Category.Id,
+		Name:       "Latte",
+		Price:      120,
+		Options: []*merchantpb.CatalogOptionInput{
+			{Kind: "variant", Name: "Regular", IsAvailable: true},
+			{Kind: "variant", Name: "Large", PriceDelta: 40, IsAvailable: true},
+			{Kind: "addon", Name: "Extra shot", PriceDelta: 30, IsAvailable: true},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateCatalogItem returned error: %v", err)
+	}
+	if len(item.Item.Options) != 3 || !item.Item.IsAvailable {
+		t.Errorf("Expected available item with 3 options, got %+v", item.Item)
+	}
+
+	if _, err := h.CreateCatalogItem(ctx, &merchantpb.CreateCatalogItemRequest{
+		MerchantId: merchant.ID,
+		Name:       "Broken",
+		Price:      10,
+		Options:    []*merchantpb.CatalogOptionInput{{Kind: "size", Name: "Huge"}},
+	}); err == nil {
+		t.Errorf("Expected unknown option kind to be rejected")
+	}
+
+	// Updating replaces the options
+	updated, err := h.UpdateCatalogItem(ctx, &merchantpb.UpdateCatalogItemRequest{
+		MerchantId: merchant.ID,
+		ItemId:     item.Item.Id,
+		CategoryId: category.Category.Id,
+		Name:       "Latte",
+		Price:      130,
+		Options:    []*merchantpb.CatalogOptionInput{{Kind: "addon", Name: "Oat milk", PriceDelta: 25, IsAvailable: true}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateCatalogItem returned error: %v", err)
+	}
+	if updated.Item.Price != 130 || len(updated.Item.Options) != 1 {
+		t.Errorf("Expected updated price and a single option, got %+v", updated.Item)
+	}
+
+	if _, err := h.SetCatalogAvailability(ctx, &merchantpb.SetCatalogAvailabilityRequest{
+		MerchantId:  merchant.ID,
+		ItemId:      item.Item.Id,
+		IsAvailable: false,
+	}); err != nil {
+		t.Fatalf("SetCatalogAvailability returned error: %v", err)
+	}
+
+	catalog, err := h.GetCatalog(ctx, &merchantpb.GetCatalogRequest{MerchantId: merchant.ID})
+	if err != nil {
+		t.Fatalf("GetCatalog returned error: %v", err)
+	}
+	if len(catalog.Categories) != 1 || len(catalog.Items) != 1 || catalog.Items[0].IsAvailable {
+		t.Errorf("Expected one unavailable item in one category, got %+v", catalog)
+	}
+
+	if _, err := h.RequestCatalogImageUpload(ctx, &merchantpb.RequestCatalogImageUploadRequest{
+		MerchantId:  merchant.ID,
+		ItemId:      item.Item.Id,
+		ContentType: "image/gif",
+		SizeBytes:   1024,
+	}); err == nil {
+		t.Errorf("Expected GIF image to be rejected")
+	}
+
+	// Deleting the category keeps its items
+	if _, err := h.DeleteCatalogCategory(ctx, &merchantpb.DeleteCatalogCategoryRequest{MerchantId: merchant.ID, CategoryId: category.Category.Id}); err != nil {
+		t.Fatalf("DeleteCatalogCategory returned error: %v", err)
+	}
+	if _, err := h.DeleteCatalogItem(ctx, &merchantpb.DeleteCatalogItemRequest{MerchantId: merchant.ID, ItemId: item.Item.Id}); err != nil {
+		t.Fatalf("DeleteCatalogItem returned error: %v", err)
+	}
+}
+
+func TestMerchantStaff(t *testing.T) {
+	ctx := context.Background()
+
+	_, repo, owner := NewMerchantUser(ctx, "test-staff-owner@example.com", t)
+	merchant := CreateMerchantRecord(ctx, owner, repo, t)
+	_, _, cashier := NewCustomerUser(ctx, "test-staff-cashier@example.com", t)
+	defer func() {
+		CleanupMerchant(ctx, merchant.Email, repo, t)
+		for _, id := range []int64{owner.ID, cashier.ID} {
+			if err := repo.DleteUser(ctx, id); err != nil {
+				t.Logf("Failed to cleanup user: %v", err)
+			}
+		}
+	}()
+
+	h, err := NewMerchantHandler()
+	if err != nil {
+		t.Fatalf("Failed to create handler: %v", err)
+	}
+
+	// The account sharing the merchant's email becomes its owner
+	principal, err := h.staff.Authorize(ctx, int(owner.ID), int(merchant.ID), util.PermSettlements)
+	if err != nil || principal.Role != util.RoleOwner {
+		t.Fatalf("Expected owner membership, got %+v, %v", principal, err)
+	}
+	if _, err := h.staff.Authorize(ctx, int(cashier.ID), int(merchant.ID), util.PermViewOrders); err == nil {
+		t.Errorf("Expected non-member to be denied")
+	}
+
+	if _, err := h.InviteStaff(ctx, &merchantpb.InviteStaffRequest{MerchantId: merchant.ID, Email: cashier.Email, Role: "waiter"}); err == nil {
+		t.Errorf("Expected unknown role to be rejected")
+	}
+	if _, err := h.InviteStaff(ctx, &merchantpb.InviteStaffRequest{MerchantId: merchant.ID, Email: cashier.Email, Role: util.RoleCashier}); err != nil {
+		t.Fatalf("InviteStaff returned error: %v", err)
+	}
+
+	// The token is only emailed, so plant one we know
+	if _, err := repo.CreateStaffInvitation(ctx, schema.CreateStaffInvitationParams{
+		MerchantID: merchant.ID,
+		Email:      cashier.Email,
+		Role:       util.RoleCashier,
+		OutletIds:  []int64{},
+		TokenHash:  util.HashInvitationToken("test-staff-token"),
+		ExpiresAt:  pgtype.Timestamp{Time: time.Now().UTC().Add(time.Hour), Valid: true},
+	}); err != nil {
+		t.Fatalf("Failed to create invitation: %v", err)
+	}
+	if _, err := h.AcceptStaffInvitation(context.WithValue(ctx, "user_id", int(owner.ID)), &merchantpb.AcceptStaffInvitationRequest{Token: "test-staff-token"}); err == nil {
+		t.Errorf("Expected invitation to be bound to the invited email")
+	}
+	accepted, err := h.AcceptStaffInvitation(context.WithValue(ctx, "user_id", int(cashier.ID)), &merchantpb.AcceptStaffInvitationRequest{Token: "test-staff-token"})
+	if err != nil {
+		t.Fatalf("AcceptStaffInvitation returned error: %v", err)
+	}
+	if _, err := h.AcceptStaffInvitation(context.WithValue(ctx, "user_id", int(cashier.ID)), &merchantpb.AcceptStaffInvitationRequest{Token: "test-staff-token"}); err == nil {
+		t.Errorf("Expected invitation to be single use")
+	}
+
+	// Cashiers update orders but can't settle or manage the catalog
+	if _, err := h.staff.Authorize(ctx, int(cashier.ID), 0, util.PermUpdateOrders); err != nil {
+		t.Errorf("Expected cashier to update orders: %v", err)
+	}
+	if _, err := h.staff.Authorize(ctx, int(cashier.ID), int(merchant.ID), util.PermSettlements); err == nil {
+		t.Errorf("Expected cashier to be denied settlements")
+	}
+
+	staff, err := h.ListStaff(ctx, &merchantpb.ListStaffRequest{MerchantId: merchant.ID})
+	if err != nil {
+		t.Fatalf("ListStaff returned error: %v", err)
+	}
+	if len(staff.Staff) != 2 || len(staff.PendingInvitations) != 1 {
+		t.Errorf("Expected 2 members and 1 pending invitation, got %+v", staff)
+	}
+
+	if _, err := h.UpdateStaff(ctx, &merchantpb.UpdateStaffRequest{MerchantId: merchant.ID, StaffId: principal.StaffID, Role: util.RoleManager}); err == nil {
+		t.Errorf("Expected demoting the last owner to be rejected")
+	}
+	if _, err := h.RemoveStaff(ctx, &merchantpb.RemoveStaffRequest{MerchantId: merchant.ID, StaffId: accepted.Membership.Id}); err != nil {
+		t.Fatalf("RemoveStaff returned error: %v", err)
+	}
+}
@@ -18,6 +18,7 @@ func CreateMerchantRecord(ctx context.Context, user schema.User, repo *schema.Qu
 		Category:           pgtype.Text{String: "restaurant", Valid: true},
 		DiscountPercentage: pgtype.Numeric{Int: big.NewInt(15), Exp: 0, Valid: true},
 		IsActive:           pgtype.Bool{Bool: true, Valid: true},
+		Status:             "approved",
 	})
 	if err != nil {
 		t.Fatalf("Failed to create merchant record: %v", err)
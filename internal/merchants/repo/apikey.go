@@ -0,0 +1,68 @@
+package repo
+
+import (
+	"context"
+
+	"rival/config"
+	"rival/connection"
+	schema "rival/gen/sql"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// APIKeyRepository only needs postgres so the auth interceptor can use it
+// without pulling in the TigerBeetle client.
+type APIKeyRepository interface {
+	CreateAPIKey(ctx context.Context, params schema.CreateMerchantAPIKeyParams) (schema.MerchantApiKey, error)
+	GetAPIKeyByPrefix(ctx context.Context, prefix string) (schema.MerchantApiKey, error)
+	ListAPIKeys(ctx context.Context, merchantID int) ([]schema.MerchantApiKey, error)
+	RevokeAPIKey(ctx context.Context, merchantID int, keyID int64) (bool, error)
+	TouchAPIKey(ctx context.Context, keyID int64, ip string) error
+}
+
+type apiKeyRepository struct {
+	queries *schema.Queries
+}
+
+func NewAPIKeyRepository() (APIKeyRepository, error) {
+	cfg := config.GetConfig()
+
+	db, err := connection.GetPgConnection(&cfg.Database)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiKeyRepository{
+		queries: schema.New(db),
+	}, nil
+}
+
+func (r *apiKeyRepository) CreateAPIKey(ctx context.Context, params schema.CreateMerchantAPIKeyParams) (schema.MerchantApiKey, error) {
+	return r.queries.CreateMerchantAPIKey(ctx, params)
+}
+
+func (r *apiKeyRepository) GetAPIKeyByPrefix(ctx context.Context, prefix string) (schema.MerchantApiKey, error) {
+	return r.queries.GetMerchantAPIKeyByPrefix(ctx, prefix)
+}
+
+func (r *apiKeyRepository) ListAPIKeys(ctx context.Context, merchantID int) ([]schema.MerchantApiKey, error) {
+	return r.queries.ListMerchantAPIKeys(ctx, int64(merchantID))
+}
+
+func (r *apiKeyRepository) RevokeAPIKey(ctx context.Context, merchantID int, keyID int64) (bool, error) {
+	rows, err := r.queries.RevokeMerchantAPIKey(ctx, schema.RevokeMerchantAPIKeyParams{
+		ID:         keyID,
+		MerchantID: int64(merchantID),
+	})
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+func (r *apiKeyRepository) TouchAPIKey(ctx context.Context, keyID int64, ip string) error {
+	return r.queries.TouchMerchantAPIKey(ctx, schema.TouchMerchantAPIKeyParams{
+		ID:         keyID,
+		LastUsedIp: pgtype.Text{String: ip, Valid: ip != ""},
+	})
+}
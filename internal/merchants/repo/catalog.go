@@ -0,0 +1,207 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"rival/config"
+	"rival/connection"
+	schema "rival/gen/sql"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/minio/minio-go/v7"
+)
+
+// CatalogRepository stores the menu in postgres and item images in MinIO
+// under catalog/.
+type CatalogRepository interface {
+	ListCategories(ctx context.Context, merchantID int) ([]schema.CatalogCategory, error)
+	GetCategory(ctx context.Context, merchantID int, categoryID int64) (schema.CatalogCategory, error)
+	CreateCategory(ctx context.Context, params schema.CreateCatalogCategoryParams) (schema.CatalogCategory, error)
+	UpdateCategory(ctx context.Context, params schema.UpdateCatalogCategoryParams) (schema.CatalogCategory, error)
+	DeleteCategory(ctx context.Context, merchantID int, categoryID int64) error
+
+	ListItems(ctx context.Context, merchantID int) ([]schema.CatalogItem, error)
+	GetItem(ctx context.Context, merchantID int, itemID int64) (schema.CatalogItem, error)
+	GetItemsByIDs(ctx context.Context, merchantID int, itemIDs []int64) ([]schema.CatalogItem, error)
+	CountItems(ctx context.Context, merchantID int) (int64, error)
+	CreateItem(ctx context.Context, params schema.CreateCatalogItemParams, options []schema.CreateCatalogOptionParams) (schema.CatalogItem, error)
+	UpdateItem(ctx context.Context, params schema.UpdateCatalogItemParams, options []schema.CreateCatalogOptionParams) (schema.CatalogItem, error)
+	DeleteItem(ctx context.Context, merchantID int, itemID int64) (schema.CatalogItem, error)
+	SetItemAvailability(ctx context.Context, merchantID int, itemID int64, available bool) error
+	SetItemImage(ctx context.Context, params schema.SetCatalogItemImageParams) (schema.CatalogItem, error)
+
+	ListOptions(ctx context.Context, itemIDs []int64) ([]schema.CatalogOption, error)
+	SetOptionAvailability(ctx context.Context, merchantID int, optionID int64, available bool) error
+
+	PresignUpload(ctx context.Context, objectKey, contentType string, maxBytes int64, expiry time.Duration) (string, map[string]string, error)
+	StatObject(ctx context.Context, objectKey string) (minio.ObjectInfo, error)
+	PresignView(ctx context.Context, objectKey string, expiry time.Duration) (string, error)
+	RemoveObject(ctx context.Context, objectKey string) error
+}
+
+type catalogRepository struct {
+	*objectStore
+	db      *pgxpool.Pool
+	queries *schema.Queries
+}
+
+func NewCatalogRepository() (CatalogRepository, error) {
+	cfg := config.GetConfig()
+
+	db, err := connection.GetPgConnection(&cfg.Database)
+	if err != nil {
+		return nil, err
+	}
+
+	store, err := newObjectStore(cfg.S3.BucketName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &catalogRepository{
+		objectStore: store,
+		db:          db,
+		queries:     schema.New(db),
+	}, nil
+}
+
+func (r *catalogRepository) ListCategories(ctx context.Context, merchantID int) ([]schema.CatalogCategory, error) {
+	return r.queries.ListCatalogCategories(ctx, int64(merchantID))
+}
+
+func (r *catalogRepository) GetCategory(ctx context.Context, merchantID int, categoryID int64) (schema.CatalogCategory, error) {
+	return r.queries.GetCatalogCategory(ctx, schema.GetCatalogCategoryParams{
+		ID:         categoryID,
+		MerchantID: int64(merchantID),
+	})
+}
+
+func (r *catalogRepository) CreateCategory(ctx context.Context, params schema.CreateCatalogCategoryParams) (schema.CatalogCategory, error) {
+	return r.queries.CreateCatalogCategory(ctx, params)
+}
+
+func (r *catalogRepository) UpdateCategory(ctx context.Context, params schema.UpdateCatalogCategoryParams) (schema.CatalogCategory, error) {
+	return r.queries.UpdateCatalogCategory(ctx, params)
+}
+
+func (r *catalogRepository) DeleteCategory(ctx context.Context, merchantID int, categoryID int64) error {
+	_, err := r.queries.DeleteCatalogCategory(ctx, schema.DeleteCatalogCategoryParams{
+		ID:         categoryID,
+		MerchantID: int64(merchantID),
+	})
+	return err
+}
+
+func (r *catalogRepository) ListItems(ctx context.Context, merchantID int) ([]schema.CatalogItem, error) {
+	return r.queries.ListCatalogItems(ctx, int64(merchantID))
+}
+
+func (r *catalogRepository) GetItem(ctx context.Context, merchantID int, itemID int64) (schema.CatalogItem, error) {
+	return r.queries.GetCatalogItem(ctx, schema.GetCatalogItemParams{
+		ID:         itemID,
+		MerchantID: int64(merchantID),
+	})
+}
+
+func (r *catalogRepository) GetItemsByIDs(ctx context.Context, merchantID int, itemIDs []int64) ([]schema.CatalogItem, error) {
+	return r.queries.GetCatalogItemsByIDs(ctx, schema.GetCatalogItemsByIDsParams{
+		MerchantID: int64(merchantID),
+		Ids:        itemIDs,
+	})
+}
+
+func (r *catalogRepository) CountItems(ctx context.Context, merchantID int) (int64, error) {
+	return r.queries.CountCatalogItems(ctx, int64(merchantID))
+}
+
+// CreateItem inserts an item together with its variants and add-ons.
+func (r *catalogRepository) CreateItem(ctx context.Context, params schema.CreateCatalogItemParams, options []schema.CreateCatalogOptionParams) (schema.CatalogItem, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return schema.CatalogItem{}, fmt.Errorf("failed to start transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+
+	item, err := qtx.CreateCatalogItem(ctx, params)
+	if err != nil {
+		return item, err
+	}
+	for _, option := range options {
+		option.ItemID = item.ID
+		if _, err := qtx.CreateCatalogOption(ctx, option); err != nil {
+			return item, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return item, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+	return item, nil
+}
+
+// UpdateItem edits an item and replaces all of its options.
+func (r *catalogRepository) UpdateItem(ctx context.Context, params schema.UpdateCatalogItemParams, options []schema.CreateCatalogOptionParams) (schema.CatalogItem, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return schema.CatalogItem{}, fmt.Errorf("failed to start transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+
+	item, err := qtx.UpdateCatalogItem(ctx, params)
+	if err != nil {
+		return item, err
+	}
+	if err := qtx.DeleteCatalogOptions(ctx, item.ID); err != nil {
+		return item, err
+	}
+	for _, option := range options {
+		option.ItemID = item.ID
+		if _, err := qtx.CreateCatalogOption(ctx, option); err != nil {
+			return item, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return item, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+	return item, nil
+}
+
+func (r *catalogRepository) DeleteItem(ctx context.Context, merchantID int, itemID int64) (schema.CatalogItem, error) {
+	return r.queries.DeleteCatalogItem(ctx, schema.DeleteCatalogItemParams{
+		ID:         itemID,
+		MerchantID: int64(merchantID),
+	})
+}
+
+func (r *catalogRepository) SetItemAvailability(ctx context.Context, merchantID int, itemID int64, available bool) error {
+	_, err := r.queries.SetCatalogItemAvailability(ctx, schema.SetCatalogItemAvailabilityParams{
+		ID:          itemID,
+		MerchantID:  int64(merchantID),
+		IsAvailable: available,
+	})
+	return err
+}
+
+func (r *catalogRepository) SetItemImage(ctx context.Context, params schema.SetCatalogItemImageParams) (schema.CatalogItem, error) {
+	return r.queries.SetCatalogItemImage(ctx, params)
+}
+
+func (r *catalogRepository) ListOptions(ctx context.Context, itemIDs []int64) ([]schema.CatalogOption, error) {
+	return r.queries.ListCatalogOptions(ctx, itemIDs)
+}
+
+func (r *catalogRepository) SetOptionAvailability(ctx context.Context, merchantID int, optionID int64, available bool) error {
+	_, err := r.queries.SetCatalogOptionAvailability(ctx, schema.SetCatalogOptionAvailabilityParams{
+		ID:          optionID,
+		MerchantID:  int64(merchantID),
+		IsAvailable: available,
+	})
+	return err
+}
@@ -0,0 +1,78 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"rival/config"
+	"rival/connection"
+	schema "rival/gen/sql"
+
+	"github.com/minio/minio-go/v7"
+)
+
+// DocumentRepository stores KYC document records in postgres and the files in
+// MinIO. KYC files live under kyc/ which, unlike profiles/, is never public.
+type DocumentRepository interface {
+	CreateDocument(ctx context.Context, params schema.CreateMerchantDocumentParams) (schema.MerchantDocument, error)
+	GetDocument(ctx context.Context, documentID int64) (schema.MerchantDocument, error)
+	ListDocuments(ctx context.Context, merchantID int) ([]schema.MerchantDocument, error)
+	ReviewDocument(ctx context.Context, params schema.ReviewMerchantDocumentParams) (schema.MerchantDocument, error)
+	ClaimExpiringDocuments(ctx context.Context, params schema.ClaimExpiringMerchantDocumentsParams) ([]schema.MerchantDocument, error)
+	SetRemindersSent(ctx context.Context, documentID int64, sent int) error
+
+	PresignUpload(ctx context.Context, objectKey, contentType string, maxBytes int64, expiry time.Duration) (string, map[string]string, error)
+	StatObject(ctx context.Context, objectKey string) (minio.ObjectInfo, error)
+	PresignView(ctx context.Context, objectKey string, expiry time.Duration) (string, error)
+}
+
+type documentRepository struct {
+	*objectStore
+	queries *schema.Queries
+}
+
+func NewDocumentRepository() (DocumentRepository, error) {
+	cfg := config.GetConfig()
+
+	db, err := connection.GetPgConnection(&cfg.Database)
+	if err != nil {
+		return nil, err
+	}
+
+	store, err := newObjectStore(cfg.S3.BucketName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &documentRepository{
+		objectStore: store,
+		queries:     schema.New(db),
+	}, nil
+}
+
+func (r *documentRepository) CreateDocument(ctx context.Context, params schema.CreateMerchantDocumentParams) (schema.MerchantDocument, error) {
+	return r.queries.CreateMerchantDocument(ctx, params)
+}
+
+func (r *documentRepository) GetDocument(ctx context.Context, documentID int64) (schema.MerchantDocument, error) {
+	return r.queries.GetMerchantDocument(ctx, documentID)
+}
+
+func (r *documentRepository) ListDocuments(ctx context.Context, merchantID int) ([]schema.MerchantDocument, error) {
+	return r.queries.ListMerchantDocuments(ctx, int64(merchantID))
+}
+
+func (r *documentRepository) ReviewDocument(ctx context.Context, params schema.ReviewMerchantDocumentParams) (schema.MerchantDocument, error) {
+	return r.queries.ReviewMerchantDocument(ctx, params)
+}
+
+func (r *documentRepository) ClaimExpiringDocuments(ctx context.Context, params schema.ClaimExpiringMerchantDocumentsParams) ([]schema.MerchantDocument, error) {
+	return r.queries.ClaimExpiringMerchantDocuments(ctx, params)
+}
+
+func (r *documentRepository) SetRemindersSent(ctx context.Context, documentID int64, sent int) error {
+	return r.queries.SetMerchantDocumentRemindersSent(ctx, schema.SetMerchantDocumentRemindersSentParams{
+		ID:                  documentID,
+		ExpiryRemindersSent: int32(sent),
+	})
+}
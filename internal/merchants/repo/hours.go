@@ -0,0 +1,110 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"rival/config"
+	"rival/connection"
+	schema "rival/gen/sql"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// HoursRepository is postgres only so orders, payments and offers can check
+// whether a merchant is open.
+type HoursRepository interface {
+	GetSchedule(ctx context.Context, merchantID int) (schema.GetMerchantScheduleRow, error)
+	ListHours(ctx context.Context, merchantID int) ([]schema.MerchantHour, error)
+	ReplaceHours(ctx context.Context, merchantID int, timezone string, hours []schema.CreateMerchantHoursParams) error
+	ListClosures(ctx context.Context, merchantID int, after time.Time) ([]schema.MerchantClosure, error)
+	CreateClosure(ctx context.Context, params schema.CreateMerchantClosureParams) (schema.MerchantClosure, error)
+	DeleteClosure(ctx context.Context, merchantID int, closureID int64) error
+	SetOrdersPausedUntil(ctx context.Context, merchantID int, until pgtype.Timestamp) error
+}
+
+type hoursRepository struct {
+	db      *pgxpool.Pool
+	queries *schema.Queries
+}
+
+func NewHoursRepository() (HoursRepository, error) {
+	cfg := config.GetConfig()
+
+	db, err := connection.GetPgConnection(&cfg.Database)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hoursRepository{
+		db:      db,
+		queries: schema.New(db),
+	}, nil
+}
+
+func (r *hoursRepository) GetSchedule(ctx context.Context, merchantID int) (schema.GetMerchantScheduleRow, error) {
+	return r.queries.GetMerchantSchedule(ctx, int64(merchantID))
+}
+
+func (r *hoursRepository) ListHours(ctx context.Context, merchantID int) ([]schema.MerchantHour, error) {
+	return r.queries.ListMerchantHours(ctx, int64(merchantID))
+}
+
+// ReplaceHours swaps the whole weekly schedule, and the timezone when one is
+// given, in one transaction.
+func (r *hoursRepository) ReplaceHours(ctx context.Context, merchantID int, timezone string, hours []schema.CreateMerchantHoursParams) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+
+	if timezone != "" {
+		if err := qtx.SetMerchantTimezone(ctx, schema.SetMerchantTimezoneParams{ID: int64(merchantID), Timezone: timezone}); err != nil {
+			return err
+		}
+	}
+	if err := qtx.DeleteMerchantHours(ctx, int64(merchantID)); err != nil {
+		return err
+	}
+	for _, params := range hours {
+		if _, err := qtx.CreateMerchantHours(ctx, params); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+	return nil
+}
+
+func (r *hoursRepository) ListClosures(ctx context.Context, merchantID int, after time.Time) ([]schema.MerchantClosure, error) {
+	return r.queries.ListMerchantClosures(ctx, schema.ListMerchantClosuresParams{
+		MerchantID: int64(merchantID),
+		After:      pgtype.Timestamp{Time: after.UTC(), Valid: true},
+	})
+}
+
+func (r *hoursRepository) CreateClosure(ctx context.Context, params schema.CreateMerchantClosureParams) (schema.MerchantClosure, error) {
+	return r.queries.CreateMerchantClosure(ctx, params)
+}
+
+func (r *hoursRepository) DeleteClosure(ctx context.Context, merchantID int, closureID int64) error {
+	_, err := r.queries.DeleteMerchantClosure(ctx, schema.DeleteMerchantClosureParams{
+		ID:         closureID,
+		MerchantID: int64(merchantID),
+	})
+	return err
+}
+
+func (r *hoursRepository) SetOrdersPausedUntil(ctx context.Context, merchantID int, until pgtype.Timestamp) error {
+	return r.queries.SetMerchantOrdersPausedUntil(ctx, schema.SetMerchantOrdersPausedUntilParams{
+		ID:                int64(merchantID),
+		OrdersPausedUntil: until,
+	})
+}
@@ -0,0 +1,73 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"rival/config"
+	"rival/connection"
+	schema "rival/gen/sql"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// OnboardingRepository is postgres only so the admin and payments modules can
+// check and change merchant status without a TigerBeetle client.
+type OnboardingRepository interface {
+	GetMerchantByID(ctx context.Context, id int) (schema.Merchant, error)
+	TransitionStatus(ctx context.Context, params schema.TransitionMerchantStatusParams, history schema.CreateMerchantStatusHistoryParams) (schema.Merchant, error)
+	ListStatusHistory(ctx context.Context, merchantID int) ([]schema.MerchantStatusHistory, error)
+}
+
+type onboardingRepository struct {
+	db      *pgxpool.Pool
+	queries *schema.Queries
+}
+
+func NewOnboardingRepository() (OnboardingRepository, error) {
+	cfg := config.GetConfig()
+
+	db, err := connection.GetPgConnection(&cfg.Database)
+	if err != nil {
+		return nil, err
+	}
+
+	return &onboardingRepository{
+		db:      db,
+		queries: schema.New(db),
+	}, nil
+}
+
+func (r *onboardingRepository) GetMerchantByID(ctx context.Context, id int) (schema.Merchant, error) {
+	return r.queries.GetMerchantByID(ctx, int64(id))
+}
+
+// TransitionStatus moves the merchant and records the history row in one
+// transaction. It returns pgx.ErrNoRows when the merchant has left FromStatus.
+func (r *onboardingRepository) TransitionStatus(ctx context.Context, params schema.TransitionMerchantStatusParams, history schema.CreateMerchantStatusHistoryParams) (schema.Merchant, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return schema.Merchant{}, fmt.Errorf("failed to start transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+
+	merchant, err := qtx.TransitionMerchantStatus(ctx, params)
+	if err != nil {
+		return schema.Merchant{}, err
+	}
+
+	if _, err := qtx.CreateMerchantStatusHistory(ctx, history); err != nil {
+		return schema.Merchant{}, fmt.Errorf("failed to record status history: %v", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return schema.Merchant{}, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+	return merchant, nil
+}
+
+func (r *onboardingRepository) ListStatusHistory(ctx context.Context, merchantID int) ([]schema.MerchantStatusHistory, error) {
+	return r.queries.ListMerchantStatusHistory(ctx, int64(merchantID))
+}
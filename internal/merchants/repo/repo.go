@@ -2,12 +2,15 @@ package repo
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"rival/config"
 	"rival/connection"
 	schema "rival/gen/sql"
 	"rival/pkg/tb"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
@@ -23,7 +26,11 @@ type MerchantRepository interface {
 	GetMerchantTransactions(ctx context.Context, merchantID int, limit, offset int32) ([]schema.Transaction, error)
 	GetMerchantCustomers(ctx context.Context, merchantID int, limit, offset int32) ([]schema.User, error)
 	GetMerchantAddresses(ctx context.Context, merchantID int) ([]schema.MerchantAddress, error)
+	GetMerchantAddress(ctx context.Context, merchantID int, addressID int64) (schema.MerchantAddress, error)
 	CreateMerchantAddress(ctx context.Context, params schema.CreateMerchantAddressParams) (schema.MerchantAddress, error)
+	UpdateMerchantAddress(ctx context.Context, params schema.UpdateMerchantAddressParams) (schema.MerchantAddress, error)
+	DeleteMerchantAddress(ctx context.Context, merchantID int, addressID int64) error
+	SetPrimaryMerchantAddress(ctx context.Context, merchantID int, addressID int64) (schema.MerchantAddress, error)
 	CreateOffer(ctx context.Context, params schema.CreateOfferParams) (schema.Offer, error)
 	GetMerchantOffers(ctx context.Context, merchantID int, limit, offset int32) ([]schema.Offer, error)
 	GetOfferByID(ctx context.Context, offerID int) (schema.Offer, error)
@@ -113,8 +120,106 @@ func (r *merchantRepository) GetMerchantAddresses(ctx context.Context, merchantI
 	return r.queries.GetMerchantAddresses(ctx, pgtype.Int8{Int64: int64(merchantID), Valid: true})
 }
 
+func (r *merchantRepository) GetMerchantAddress(ctx context.Context, merchantID int, addressID int64) (schema.MerchantAddress, error) {
+	return r.queries.GetMerchantAddress(ctx, schema.GetMerchantAddressParams{
+		ID:         addressID,
+		MerchantID: pgtype.Int8{Int64: int64(merchantID), Valid: true},
+	})
+}
+
+// CreateMerchantAddress makes the address primary when asked to or when it is
+// the merchant's first, demoting the previous primary in the same transaction.
 func (r *merchantRepository) CreateMerchantAddress(ctx context.Context, params schema.CreateMerchantAddressParams) (schema.MerchantAddress, error) {
-	return r.queries.CreateMerchantAddress(ctx, params)
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return schema.MerchantAddress{}, fmt.Errorf("failed to start transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+
+	if !params.IsPrimary {
+		if _, err := qtx.GetOldestMerchantAddress(ctx, params.MerchantID); errors.Is(err, pgx.ErrNoRows) {
+			params.IsPrimary = true
+		} else if err != nil {
+			return schema.MerchantAddress{}, err
+		}
+	}
+	if params.IsPrimary {
+		if err := qtx.ClearPrimaryMerchantAddress(ctx, params.MerchantID); err != nil {
+			return schema.MerchantAddress{}, err
+		}
+	}
+
+	address, err := qtx.CreateMerchantAddress(ctx, params)
+	if err != nil {
+		return schema.MerchantAddress{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return schema.MerchantAddress{}, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+	return address, nil
+}
+
+func (r *merchantRepository) UpdateMerchantAddress(ctx context.Context, params schema.UpdateMerchantAddressParams) (schema.MerchantAddress, error) {
+	return r.queries.UpdateMerchantAddress(ctx, params)
+}
+
+// DeleteMerchantAddress promotes the oldest remaining address when the primary is deleted.
+func (r *merchantRepository) DeleteMerchantAddress(ctx context.Context, merchantID int, addressID int64) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+	merchant := pgtype.Int8{Int64: int64(merchantID), Valid: true}
+
+	deleted, err := qtx.DeleteMerchantAddress(ctx, schema.DeleteMerchantAddressParams{ID: addressID, MerchantID: merchant})
+	if err != nil {
+		return err
+	}
+
+	if deleted.IsPrimary {
+		next, err := qtx.GetOldestMerchantAddress(ctx, merchant)
+		if err == nil {
+			_, err = qtx.SetPrimaryMerchantAddress(ctx, schema.SetPrimaryMerchantAddressParams{ID: next.ID, MerchantID: merchant})
+		}
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+	return nil
+}
+
+func (r *merchantRepository) SetPrimaryMerchantAddress(ctx context.Context, merchantID int, addressID int64) (schema.MerchantAddress, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return schema.MerchantAddress{}, fmt.Errorf("failed to start transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+	merchant := pgtype.Int8{Int64: int64(merchantID), Valid: true}
+
+	if err := qtx.ClearPrimaryMerchantAddress(ctx, merchant); err != nil {
+		return schema.MerchantAddress{}, err
+	}
+	address, err := qtx.SetPrimaryMerchantAddress(ctx, schema.SetPrimaryMerchantAddressParams{ID: addressID, MerchantID: merchant})
+	if err != nil {
+		return schema.MerchantAddress{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return schema.MerchantAddress{}, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+	return address, nil
 }
 
 func (r *merchantRepository) CreateOffer(ctx context.Context, params schema.CreateOfferParams) (schema.Offer, error) {
@@ -0,0 +1,174 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"rival/config"
+	"rival/connection"
+	schema "rival/gen/sql"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// StaffRepository stores merchant staff memberships and their invitations.
+type StaffRepository interface {
+	GetMember(ctx context.Context, merchantID int, userID int) (schema.MerchantStaff, error)
+	GetMemberByID(ctx context.Context, merchantID int, staffID int64) (schema.MerchantStaff, error)
+	ListMembers(ctx context.Context, merchantID int) ([]schema.ListMerchantStaffRow, error)
+	ListMemberships(ctx context.Context, userID int) ([]schema.ListStaffMembershipsRow, error)
+	UpdateMember(ctx context.Context, params schema.UpdateMerchantStaffParams) (schema.MerchantStaff, error)
+	DeleteMember(ctx context.Context, merchantID int, staffID int64) (schema.MerchantStaff, error)
+	CountOwners(ctx context.Context, merchantID int) (int64, error)
+	ClaimOwnership(ctx context.Context, merchantID int, userID int) (schema.MerchantStaff, error)
+
+	CreateInvitation(ctx context.Context, params schema.CreateStaffInvitationParams) (schema.MerchantStaffInvitation, error)
+	GetInvitationByTokenHash(ctx context.Context, tokenHash string) (schema.MerchantStaffInvitation, error)
+	ListPendingInvitations(ctx context.Context, merchantID int) ([]schema.MerchantStaffInvitation, error)
+	AcceptInvitation(ctx context.Context, invitation schema.MerchantStaffInvitation, userID int) (schema.MerchantStaff, error)
+	RevokeInvitation(ctx context.Context, merchantID int, invitationID int64) (int64, error)
+
+	GetUser(ctx context.Context, userID int) (schema.User, error)
+	GetMerchant(ctx context.Context, merchantID int) (schema.Merchant, error)
+	GetOutletIDs(ctx context.Context, merchantID int) ([]int64, error)
+}
+
+type staffRepository struct {
+	db      *pgxpool.Pool
+	queries *schema.Queries
+}
+
+func NewStaffRepository() (StaffRepository, error) {
+	cfg := config.GetConfig()
+
+	db, err := connection.GetPgConnection(&cfg.Database)
+	if err != nil {
+		return nil, err
+	}
+
+	return &staffRepository{
+		db:      db,
+		queries: schema.New(db),
+	}, nil
+}
+
+func (r *staffRepository) GetMember(ctx context.Context, merchantID int, userID int) (schema.MerchantStaff, error) {
+	return r.queries.GetMerchantStaffMember(ctx, schema.GetMerchantStaffMemberParams{
+		MerchantID: int64(merchantID),
+		UserID:     int64(userID),
+	})
+}
+
+func (r *staffRepository) GetMemberByID(ctx context.Context, merchantID int, staffID int64) (schema.MerchantStaff, error) {
+	return r.queries.GetMerchantStaffByID(ctx, schema.GetMerchantStaffByIDParams{
+		ID:         staffID,
+		MerchantID: int64(merchantID),
+	})
+}
+
+func (r *staffRepository) ListMembers(ctx context.Context, merchantID int) ([]schema.ListMerchantStaffRow, error) {
+	return r.queries.ListMerchantStaff(ctx, int64(merchantID))
+}
+
+func (r *staffRepository) ListMemberships(ctx context.Context, userID int) ([]schema.ListStaffMembershipsRow, error) {
+	return r.queries.ListStaffMemberships(ctx, int64(userID))
+}
+
+func (r *staffRepository) UpdateMember(ctx context.Context, params schema.UpdateMerchantStaffParams) (schema.MerchantStaff, error) {
+	return r.queries.UpdateMerchantStaff(ctx, params)
+}
+
+func (r *staffRepository) DeleteMember(ctx context.Context, merchantID int, staffID int64) (schema.MerchantStaff, error) {
+	return r.queries.DeleteMerchantStaff(ctx, schema.DeleteMerchantStaffParams{
+		ID:         staffID,
+		MerchantID: int64(merchantID),
+	})
+}
+
+func (r *staffRepository) CountOwners(ctx context.Context, merchantID int) (int64, error) {
+	return r.queries.CountMerchantOwners(ctx, int64(merchantID))
+}
+
+func (r *staffRepository) ClaimOwnership(ctx context.Context, merchantID int, userID int) (schema.MerchantStaff, error) {
+	return r.queries.ClaimMerchantOwnership(ctx, schema.ClaimMerchantOwnershipParams{
+		MerchantID: int64(merchantID),
+		UserID:     int64(userID),
+	})
+}
+
+func (r *staffRepository) CreateInvitation(ctx context.Context, params schema.CreateStaffInvitationParams) (schema.MerchantStaffInvitation, error) {
+	return r.queries.CreateStaffInvitation(ctx, params)
+}
+
+func (r *staffRepository) GetInvitationByTokenHash(ctx context.Context, tokenHash string) (schema.MerchantStaffInvitation, error) {
+	return r.queries.GetStaffInvitationByTokenHash(ctx, tokenHash)
+}
+
+func (r *staffRepository) ListPendingInvitations(ctx context.Context, merchantID int) ([]schema.MerchantStaffInvitation, error) {
+	return r.queries.ListPendingStaffInvitations(ctx, int64(merchantID))
+}
+
+// AcceptInvitation marks the invitation used and creates the membership in one
+// transaction, so a token can't be redeemed twice.
+func (r *staffRepository) AcceptInvitation(ctx context.Context, invitation schema.MerchantStaffInvitation, userID int) (schema.MerchantStaff, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return schema.MerchantStaff{}, fmt.Errorf("failed to start transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+
+	accepted, err := qtx.AcceptStaffInvitation(ctx, invitation.ID)
+	if err != nil {
+		return schema.MerchantStaff{}, err
+	}
+	if accepted == 0 {
+		return schema.MerchantStaff{}, fmt.Errorf("invitation is no longer valid")
+	}
+
+	member, err := qtx.CreateMerchantStaff(ctx, schema.CreateMerchantStaffParams{
+		MerchantID: invitation.MerchantID,
+		UserID:     int64(userID),
+		Role:       invitation.Role,
+		OutletIds:  invitation.OutletIds,
+		InvitedBy:  invitation.InvitedBy,
+	})
+	if err != nil {
+		return schema.MerchantStaff{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return schema.MerchantStaff{}, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+	return member, nil
+}
+
+func (r *staffRepository) RevokeInvitation(ctx context.Context, merchantID int, invitationID int64) (int64, error) {
+	return r.queries.RevokeStaffInvitation(ctx, schema.RevokeStaffInvitationParams{
+		ID:         invitationID,
+		MerchantID: int64(merchantID),
+	})
+}
+
+func (r *staffRepository) GetUser(ctx context.Context, userID int) (schema.User, error) {
+	return r.queries.GetUserByID(ctx, int64(userID))
+}
+
+func (r *staffRepository) GetMerchant(ctx context.Context, merchantID int) (schema.Merchant, error) {
+	return r.queries.GetMerchantByID(ctx, int64(merchantID))
+}
+
+func (r *staffRepository) GetOutletIDs(ctx context.Context, merchantID int) ([]int64, error) {
+	addresses, err := r.queries.GetMerchantAddresses(ctx, pgtype.Int8{Int64: int64(merchantID), Valid: true})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, 0, len(addresses))
+	for _, address := range addresses {
+		ids = append(ids, address.ID)
+	}
+	return ids, nil
+}
@@ -0,0 +1,75 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"rival/connection"
+
+	"github.com/minio/minio-go/v7"
+)
+
+// objectStore holds the MinIO operations shared by repositories that take
+// direct uploads from merchants.
+type objectStore struct {
+	minio  *minio.Client
+	bucket string
+}
+
+func newObjectStore(bucket string) (*objectStore, error) {
+	minioClient, err := connection.NewMinioClient()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	exists, _ := minioClient.BucketExists(ctx, bucket)
+	if !exists {
+		minioClient.MakeBucket(ctx, bucket, minio.MakeBucketOptions{})
+	}
+
+	return &objectStore{minio: minioClient, bucket: bucket}, nil
+}
+
+// PresignUpload returns a POST policy URL and the form fields the client must
+// send. Unlike a presigned PUT, the policy lets MinIO enforce type and size.
+func (s *objectStore) PresignUpload(ctx context.Context, objectKey, contentType string, maxBytes int64, expiry time.Duration) (string, map[string]string, error) {
+	policy := minio.NewPostPolicy()
+	if err := policy.SetBucket(s.bucket); err != nil {
+		return "", nil, err
+	}
+	if err := policy.SetKey(objectKey); err != nil {
+		return "", nil, err
+	}
+	if err := policy.SetExpires(time.Now().UTC().Add(expiry)); err != nil {
+		return "", nil, err
+	}
+	if err := policy.SetContentType(contentType); err != nil {
+		return "", nil, err
+	}
+	if err := policy.SetContentLengthRange(1, maxBytes); err != nil {
+		return "", nil, err
+	}
+
+	url, formData, err := s.minio.PresignedPostPolicy(ctx, policy)
+	if err != nil {
+		return "", nil, err
+	}
+	return url.String(), formData, nil
+}
+
+func (s *objectStore) StatObject(ctx context.Context, objectKey string) (minio.ObjectInfo, error) {
+	return s.minio.StatObject(ctx, s.bucket, objectKey, minio.StatObjectOptions{})
+}
+
+func (s *objectStore) PresignView(ctx context.Context, objectKey string, expiry time.Duration) (string, error) {
+	url, err := s.minio.PresignedGetObject(ctx, s.bucket, objectKey, expiry, nil)
+	if err != nil {
+		return "", err
+	}
+	return url.String(), nil
+}
+
+func (s *objectStore) RemoveObject(ctx context.Context, objectKey string) error {
+	return s.minio.RemoveObject(ctx, s.bucket, objectKey, minio.RemoveObjectOptions{})
+}
@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	merchantpb "rival/gen/proto/proto/api"
+	schema "rival/gen/sql"
+	"rival/pkg/geo"
+	"rival/pkg/utils"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AddressParams is a branch address as sent by the merchant. Zero coordinates
+// mean "not provided" and are filled by the geocoder when one is configured.
+type AddressParams struct {
+	Street     string
+	City       string
+	State      string
+	PostalCode string
+	Country    string
+	Label      string
+	Latitude   float64
+	Longitude  float64
+}
+
+func (s *merchantService) AddMerchantAddress(ctx context.Context, merchantID int, params AddressParams, primary bool) (*merchantpb.AddMerchantAddressResponse, error) {
+	if err := validateAddress(params); err != nil {
+		return nil, err
+	}
+	lat, lng, err := s.resolveCoordinates(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := s.repo.CreateMerchantAddress(ctx, schema.CreateMerchantAddressParams{
+		MerchantID: pgtype.Int8{Int64: int64(merchantID), Valid: true},
+		Street:     pgtype.Text{String: params.Street, Valid: true},
+		City:       pgtype.Text{String: params.City, Valid: true},
+		State:      pgtype.Text{String: params.State, Valid: params.State != ""},
+		PostalCode: pgtype.Text{String: params.PostalCode, Valid: params.PostalCode != ""},
+		Country:    pgtype.Text{String: orDefaultCountry(params.Country), Valid: true},
+		Latitude:   lat,
+		Longitude:  lng,
+		IsPrimary:  primary,
+		Label:      pgtype.Text{String: params.Label, Valid: params.Label != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create merchant address: %w", err)
+	}
+
+	return &merchantpb.AddMerchantAddressResponse{
+		Address: convertToProtoMerchantAddress(address),
+	}, nil
+}
+
+// UpdateMerchantAddress edits one branch. Without an address ID it edits the
+// primary address, creating it when the merchant has none yet.
+func (s *merchantService) UpdateMerchantAddress(ctx context.Context, merchantID int, addressID int64, params AddressParams) (*merchantpb.UpdateMerchantAddressResponse, error) {
+	if addressID == 0 {
+		addresses, err := s.repo.GetMerchantAddresses(ctx, merchantID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get merchant addresses: %w", err)
+		}
+		if len(addresses) == 0 || !addresses[0].IsPrimary {
+			created, err := s.AddMerchantAddress(ctx, merchantID, params, true)
+			if err != nil {
+				return nil, err
+			}
+			return &merchantpb.UpdateMerchantAddressResponse{Address: created.Address}, nil
+		}
+		addressID = addresses[0].ID
+	}
+
+	if err := validateAddress(params); err != nil {
+		return nil, err
+	}
+	lat, lng, err := s.resolveCoordinates(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := s.repo.UpdateMerchantAddress(ctx, schema.UpdateMerchantAddressParams{
+		ID:         addressID,
+		MerchantID: pgtype.Int8{Int64: int64(merchantID), Valid: true},
+		Street:     pgtype.Text{String: params.Street, Valid: true},
+		City:       pgtype.Text{String: params.City, Valid: true},
+		State:      pgtype.Text{String: params.State, Valid: params.State != ""},
+		PostalCode: pgtype.Text{String: params.PostalCode, Valid: params.PostalCode != ""},
+		Country:    pgtype.Text{String: orDefaultCountry(params.Country), Valid: true},
+		Latitude:   lat,
+		Longitude:  lng,
+		Label:      pgtype.Text{String: params.Label, Valid: params.Label != ""},
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "address not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update merchant address: %w", err)
+	}
+
+	return &merchantpb.UpdateMerchantAddressResponse{
+		Address: convertToProtoMerchantAddress(address),
+	}, nil
+}
+
+func (s *merchantService) DeleteMerchantAddress(ctx context.Context, merchantID int, addressID int64) (*merchantpb.DeleteMerchantAddressResponse, error) {
+	err := s.repo.DeleteMerchantAddress(ctx, merchantID, addressID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "address not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete merchant address: %w", err)
+	}
+
+	return &merchantpb.DeleteMerchantAddressResponse{Success: true}, nil
+}
+
+func (s *merchantService) SetPrimaryMerchantAddress(ctx context.Context, merchantID int, addressID int64) (*merchantpb.SetPrimaryMerchantAddressResponse, error) {
+	address, err := s.repo.SetPrimaryMerchantAddress(ctx, merchantID, addressID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "address not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to set primary address: %w", err)
+	}
+
+	return &merchantpb.SetPrimaryMerchantAddressResponse{
+		Address: convertToProtoMerchantAddress(address),
+	}, nil
+}
+
+func validateAddress(params AddressParams) error {
+	if strings.TrimSpace(params.Street) == "" || strings.TrimSpace(params.City) == "" {
+		return status.Error(codes.InvalidArgument, "street and city are required")
+	}
+	return nil
+}
+
+// resolveCoordinates validates supplied coordinates or geocodes the address.
+// A failed lookup stores the address without coordinates rather than rejecting it.
+func (s *merchantService) resolveCoordinates(ctx context.Context, params AddressParams) (pgtype.Numeric, pgtype.Numeric, error) {
+	coordinates := geo.Coordinates{Latitude: params.Latitude, Longitude: params.Longitude}
+
+	if coordinates == (geo.Coordinates{}) {
+		if s.geocoder == nil {
+			return pgtype.Numeric{}, pgtype.Numeric{}, nil
+		}
+		query := geo.FormatAddress(params.Street, params.City, params.State, params.PostalCode, orDefaultCountry(params.Country))
+		found, err := s.geocoder.Geocode(ctx, query)
+		if err != nil {
+			if !errors.Is(err, geo.ErrAddressNotFound) {
+				log.Printf("Failed to geocode merchant address: %v", err)
+			}
+			return pgtype.Numeric{}, pgtype.Numeric{}, nil
+		}
+		coordinates = found
+	}
+
+	if err := geo.ValidateCoordinates(coordinates); err != nil {
+		return pgtype.Numeric{}, pgtype.Numeric{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return utils.CoordinateToNumeric(coordinates.Latitude), utils.CoordinateToNumeric(coordinates.Longitude), nil
+}
+
+func orDefaultCountry(country string) string {
+	if country == "" {
+		return "India"
+	}
+	return country
+}
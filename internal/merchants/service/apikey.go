@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	merchantpb "rival/gen/proto/proto/api"
+	schemapb "rival/gen/proto/proto/schema"
+	schema "rival/gen/sql"
+	"rival/internal/merchants/repo"
+	"rival/internal/merchants/util"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var (
+	ErrInvalidAPIKey      = errors.New("invalid API key")
+	ErrAPIKeyIPNotAllowed = errors.New("API key not allowed from this IP")
+)
+
+// APIKeyPrincipal is the merchant identity an API key resolves to.
+type APIKeyPrincipal struct {
+	KeyID      int64
+	MerchantID int
+	Scopes     []string
+}
+
+type APIKeyService interface {
+	CreateAPIKey(ctx context.Context, req *merchantpb.CreateAPIKeyRequest, createdBy int) (*merchantpb.CreateAPIKeyResponse, error)
+	ListAPIKeys(ctx context.Context, merchantID int) (*merchantpb.ListAPIKeysResponse, error)
+	RevokeAPIKey(ctx context.Context, merchantID int, keyID int64) (*merchantpb.RevokeAPIKeyResponse, error)
+	Authenticate(ctx context.Context, key, ip string) (*APIKeyPrincipal, error)
+}
+
+type apiKeyService struct {
+	repo repo.APIKeyRepository
+}
+
+func NewAPIKeyService(repo repo.APIKeyRepository) APIKeyService {
+	return &apiKeyService{repo: repo}
+}
+
+func (s *apiKeyService) CreateAPIKey(ctx context.Context, req *merchantpb.CreateAPIKeyRequest, createdBy int) (*merchantpb.CreateAPIKeyResponse, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+	if len(req.Scopes) == 0 {
+		return nil, fmt.Errorf("at least one scope is required")
+	}
+	for _, scope := range req.Scopes {
+		if !util.IsValidScope(scope) {
+			return nil, fmt.Errorf("unknown scope: %s", scope)
+		}
+	}
+	if err := util.ValidateIPAllowlist(req.AllowedIps); err != nil {
+		return nil, err
+	}
+
+	var expiresAt pgtype.Timestamp
+	if req.ExpiresAt != 0 {
+		if req.ExpiresAt <= time.Now().Unix() {
+			return nil, fmt.Errorf("expires_at must be in the future")
+		}
+		expiresAt = pgtype.Timestamp{Time: time.Unix(req.ExpiresAt, 0), Valid: true}
+	}
+
+	key, prefix, hash, err := util.GenerateAPIKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate API key: %w", err)
+	}
+
+	allowedIPs := req.AllowedIps
+	if allowedIPs == nil {
+		allowedIPs = []string{}
+	}
+
+	apiKey, err := s.repo.CreateAPIKey(ctx, schema.CreateMerchantAPIKeyParams{
+		MerchantID: req.MerchantId,
+		Name:       name,
+		Prefix:     prefix,
+		KeyHash:    hash,
+		Scopes:     req.Scopes,
+		AllowedIps: allowedIPs,
+		CreatedBy:  pgtype.Int8{Int64: int64(createdBy), Valid: createdBy > 0},
+		ExpiresAt:  expiresAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create API key: %w", err)
+	}
+
+	return &merchantpb.CreateAPIKeyResponse{
+		ApiKey: convertToProtoAPIKey(apiKey),
+		Key:    key,
+	}, nil
+}
+
+func (s *apiKeyService) ListAPIKeys(ctx context.Context, merchantID int) (*merchantpb.ListAPIKeysResponse, error) {
+	keys, err := s.repo.ListAPIKeys(ctx, merchantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list API keys: %w", err)
+	}
+
+	var protoKeys []*schemapb.MerchantApiKey
+	for _, key := range keys {
+		protoKeys = append(protoKeys, convertToProtoAPIKey(key))
+	}
+
+	return &merchantpb.ListAPIKeysResponse{
+		ApiKeys: protoKeys,
+	}, nil
+}
+
+func (s *apiKeyService) RevokeAPIKey(ctx context.Context, merchantID int, keyID int64) (*merchantpb.RevokeAPIKeyResponse, error) {
+	revoked, err := s.repo.RevokeAPIKey(ctx, merchantID, keyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to revoke API key: %w", err)
+	}
+
+	return &merchantpb.RevokeAPIKeyResponse{
+		Success: revoked,
+	}, nil
+}
+
+func (s *apiKeyService) Authenticate(ctx context.Context, key, ip string) (*APIKeyPrincipal, error) {
+	prefix, err := util.ParseAPIKey(key)
+	if err != nil {
+		return nil, ErrInvalidAPIKey
+	}
+
+	apiKey, err := s.repo.GetAPIKeyByPrefix(ctx, prefix)
+	if err != nil {
+		return nil, ErrInvalidAPIKey
+	}
+	if !util.VerifyAPIKey(key, apiKey.KeyHash) {
+		return nil, ErrInvalidAPIKey
+	}
+	if apiKey.RevokedAt.Valid {
+		return nil, ErrInvalidAPIKey
+	}
+	if apiKey.ExpiresAt.Valid && time.Now().After(apiKey.ExpiresAt.Time) {
+		return nil, ErrInvalidAPIKey
+	}
+	if !util.IPAllowed(apiKey.AllowedIps, ip) {
+		return nil, ErrAPIKeyIPNotAllowed
+	}
+
+	// Last-used tracking is best effort, the query itself throttles writes
+	_ = s.repo.TouchAPIKey(ctx, apiKey.ID, ip)
+
+	return &APIKeyPrincipal{
+		KeyID:      apiKey.ID,
+		MerchantID: int(apiKey.MerchantID),
+		Scopes:     apiKey.Scopes,
+	}, nil
+}
+
+func convertToProtoAPIKey(key schema.MerchantApiKey) *schemapb.MerchantApiKey {
+	protoKey := &schemapb.MerchantApiKey{
+		Id:         key.ID,
+		MerchantId: key.MerchantID,
+		Name:       key.Name,
+		Prefix:     util.APIKeyPrefix + key.Prefix,
+		Scopes:     key.Scopes,
+		AllowedIps: key.AllowedIps,
+		LastUsedIp: key.LastUsedIp.String,
+		CreatedAt:  key.CreatedAt.Time.Unix(),
+	}
+	if key.LastUsedAt.Valid {
+		protoKey.LastUsedAt = key.LastUsedAt.Time.Unix()
+	}
+	if key.ExpiresAt.Valid {
+		protoKey.ExpiresAt = key.ExpiresAt.Time.Unix()
+	}
+	if key.RevokedAt.Valid {
+		protoKey.RevokedAt = key.RevokedAt.Time.Unix()
+	}
+	return protoKey
+}
@@ -0,0 +1,456 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	merchantpb "rival/gen/proto/proto/api"
+	schemapb "rival/gen/proto/proto/schema"
+	schema "rival/gen/sql"
+	"rival/internal/merchants/repo"
+	"rival/internal/merchants/util"
+	"rival/pkg/utils"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxCatalogImageBytes     = 5 << 20
+	catalogImageUploadExpiry = 15 * time.Minute
+	catalogImageViewExpiry   = 12 * time.Hour
+)
+
+// ItemParams is a catalog item as sent by the merchant. Options replace the
+// item's existing variants and add-ons on update.
+type ItemParams struct {
+	CategoryID  int64
+	Name        string
+	Description string
+	Price       float64
+	SortOrder   int32
+	Options     []OptionParams
+}
+
+type OptionParams struct {
+	Kind        string
+	Name        string
+	PriceDelta  float64
+	IsAvailable bool
+}
+
+type CatalogService interface {
+	GetCatalog(ctx context.Context, merchantID int) (*merchantpb.GetCatalogResponse, error)
+	CreateCategory(ctx context.Context, merchantID int, name string, sortOrder int32) (*merchantpb.CatalogCategoryResponse, error)
+	UpdateCategory(ctx context.Context, merchantID int, categoryID int64, name string, sortOrder int32) (*merchantpb.CatalogCategoryResponse, error)
+	DeleteCategory(ctx context.Context, merchantID int, categoryID int64) (*merchantpb.DeleteCatalogCategoryResponse, error)
+	CreateItem(ctx context.Context, merchantID int, params ItemParams) (*merchantpb.CatalogItemResponse, error)
+	UpdateItem(ctx context.Context, merchantID int, itemID int64, params ItemParams) (*merchantpb.CatalogItemResponse, error)
+	DeleteItem(ctx context.Context, merchantID int, itemID int64) (*merchantpb.DeleteCatalogItemResponse, error)
+	SetAvailability(ctx context.Context, merchantID int, itemID, optionID int64, available bool) (*merchantpb.SetCatalogAvailabilityResponse, error)
+	RequestImageUpload(ctx context.Context, req *merchantpb.RequestCatalogImageUploadRequest) (*merchantpb.RequestCatalogImageUploadResponse, error)
+	ConfirmImageUpload(ctx context.Context, req *merchantpb.ConfirmCatalogImageUploadRequest) (*merchantpb.CatalogItemResponse, error)
+	HasCatalog(ctx context.Context, merchantID int) (bool, error)
+	PriceOrder(ctx context.Context, merchantID int, lines []util.LineRequest) ([]util.PricedLine, float64, error)
+}
+
+type catalogService struct {
+	repo repo.CatalogRepository
+}
+
+func NewCatalogService(repo repo.CatalogRepository) CatalogService {
+	return &catalogService{repo: repo}
+}
+
+func (s *catalogService) GetCatalog(ctx context.Context, merchantID int) (*merchantpb.GetCatalogResponse, error) {
+	categories, err := s.repo.ListCategories(ctx, merchantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get categories: %w", err)
+	}
+	items, err := s.repo.ListItems(ctx, merchantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get items: %w", err)
+	}
+	protoItems, err := s.withOptions(ctx, items)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &merchantpb.GetCatalogResponse{Items: protoItems}
+	for _, category := range categories {
+		resp.Categories = append(resp.Categories, convertToProtoCategory(category))
+	}
+	return resp, nil
+}
+
+func (s *catalogService) CreateCategory(ctx context.Context, merchantID int, name string, sortOrder int32) (*merchantpb.CatalogCategoryResponse, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "category name is required")
+	}
+
+	category, err := s.repo.CreateCategory(ctx, schema.CreateCatalogCategoryParams{
+		MerchantID: int64(merchantID),
+		Name:       name,
+		SortOrder:  sortOrder,
+	})
+	if isUniqueViolation(err) {
+		return nil, status.Errorf(codes.AlreadyExists, "category %q already exists", name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create category: %w", err)
+	}
+
+	return &merchantpb.CatalogCategoryResponse{Category: convertToProtoCategory(category)}, nil
+}
+
+func (s *catalogService) UpdateCategory(ctx context.Context, merchantID int, categoryID int64, name string, sortOrder int32) (*merchantpb.CatalogCategoryResponse, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "category name is required")
+	}
+
+	category, err := s.repo.UpdateCategory(ctx, schema.UpdateCatalogCategoryParams{
+		ID:         categoryID,
+		MerchantID: int64(merchantID),
+		Name:       name,
+		SortOrder:  sortOrder,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "category not found")
+	}
+	if isUniqueViolation(err) {
+		return nil, status.Errorf(codes.AlreadyExists, "category %q already exists", name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update category: %w", err)
+	}
+
+	return &merchantpb.CatalogCategoryResponse{Category: convertToProtoCategory(category)}, nil
+}
+
+func (s *catalogService) DeleteCategory(ctx context.Context, merchantID int, categoryID int64) (*merchantpb.DeleteCatalogCategoryResponse, error) {
+	err := s.repo.DeleteCategory(ctx, merchantID, categoryID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "category not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete category: %w", err)
+	}
+
+	return &merchantpb.DeleteCatalogCategoryResponse{Success: true}, nil
+}
+
+func (s *catalogService) CreateItem(ctx context.Context, merchantID int, params ItemParams) (*merchantpb.CatalogItemResponse, error) {
+	options, err := s.validateItem(ctx, merchantID, params)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := s.repo.CreateItem(ctx, schema.CreateCatalogItemParams{
+		MerchantID:  int64(merchantID),
+		CategoryID:  pgtype.Int8{Int64: params.CategoryID, Valid: params.CategoryID != 0},
+		Name:        strings.TrimSpace(params.Name),
+		Description: pgtype.Text{String: params.Description, Valid: params.Description != ""},
+		Price:       utils.Float64ToNumeric(params.Price),
+		SortOrder:   params.SortOrder,
+	}, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create item: %w", err)
+	}
+
+	return s.itemResponse(ctx, item)
+}
+
+func (s *catalogService) UpdateItem(ctx context.Context, merchantID int, itemID int64, params ItemParams) (*merchantpb.CatalogItemResponse, error) {
+	options, err := s.validateItem(ctx, merchantID, params)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := s.repo.UpdateItem(ctx, schema.UpdateCatalogItemParams{
+		ID:          itemID,
+		MerchantID:  int64(merchantID),
+		CategoryID:  pgtype.Int8{Int64: params.CategoryID, Valid: params.CategoryID != 0},
+		Name:        strings.TrimSpace(params.Name),
+		Description: pgtype.Text{String: params.Description, Valid: params.Description != ""},
+		Price:       utils.Float64ToNumeric(params.Price),
+		SortOrder:   params.SortOrder,
+	}, options)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "item not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update item: %w", err)
+	}
+
+	return s.itemResponse(ctx, item)
+}
+
+func (s *catalogService) DeleteItem(ctx context.Context, merchantID int, itemID int64) (*merchantpb.DeleteCatalogItemResponse, error) {
+	item, err := s.repo.DeleteItem(ctx, merchantID, itemID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "item not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete item: %w", err)
+	}
+
+	if item.ImageKey.Valid {
+		if err := s.repo.RemoveObject(ctx, item.ImageKey.String); err != nil {
+			log.Printf("Failed to remove image of catalog item %d: %v", item.ID, err)
+		}
+	}
+	return &merchantpb.DeleteCatalogItemResponse{Success: true}, nil
+}
+
+// SetAvailability marks an item or a single option as in or out of stock.
+func (s *catalogService) SetAvailability(ctx context.Context, merchantID int, itemID, optionID int64, available bool) (*merchantpb.SetCatalogAvailabilityResponse, error) {
+	var err error
+	switch {
+	case itemID != 0 && optionID != 0:
+		return nil, status.Error(codes.InvalidArgument, "set either item_id or option_id")
+	case itemID != 0:
+		err = s.repo.SetItemAvailability(ctx, merchantID, itemID, available)
+	case optionID != 0:
+		err = s.repo.SetOptionAvailability(ctx, merchantID, optionID, available)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "item_id or option_id is required")
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "item not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update availability: %w", err)
+	}
+
+	return &merchantpb.SetCatalogAvailabilityResponse{Success: true}, nil
+}
+
+func (s *catalogService) RequestImageUpload(ctx context.Context, req *merchantpb.RequestCatalogImageUploadRequest) (*merchantpb.RequestCatalogImageUploadResponse, error) {
+	ext, ok := util.CatalogImageExtension(req.ContentType)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "images must be JPEG, PNG or WebP")
+	}
+	if req.SizeBytes <= 0 || req.SizeBytes > maxCatalogImageBytes {
+		return nil, status.Errorf(codes.InvalidArgument, "images must be smaller than %d MB", maxCatalogImageBytes>>20)
+	}
+	if _, err := s.repo.GetItem(ctx, int(req.MerchantId), req.ItemId); err != nil {
+		return nil, status.Error(codes.NotFound, "item not found")
+	}
+
+	objectKey := util.NewCatalogImageObjectKey(int(req.MerchantId), req.ItemId, ext)
+	uploadURL, formData, err := s.repo.PresignUpload(ctx, objectKey, req.ContentType, maxCatalogImageBytes, catalogImageUploadExpiry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create upload URL: %w", err)
+	}
+
+	return &merchantpb.RequestCatalogImageUploadResponse{
+		UploadUrl: uploadURL,
+		FormData:  formData,
+		ObjectKey: objectKey,
+		ExpiresIn: int64(catalogImageUploadExpiry.Seconds()),
+	}, nil
+}
+
+func (s *catalogService) ConfirmImageUpload(ctx context.Context, req *merchantpb.ConfirmCatalogImageUploadRequest) (*merchantpb.CatalogItemResponse, error) {
+	// The key must come from RequestImageUpload for this item
+	if !strings.HasPrefix(req.ObjectKey, util.CatalogImageKeyPrefix(int(req.MerchantId), req.ItemId)) {
+		return nil, status.Error(codes.InvalidArgument, "object key does not belong to this item")
+	}
+
+	existing, err := s.repo.GetItem(ctx, int(req.MerchantId), req.ItemId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "item not found")
+	}
+
+	object, err := s.repo.StatObject(ctx, req.ObjectKey)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, "image has not been uploaded")
+	}
+	if _, ok := util.CatalogImageExtension(object.ContentType); !ok || object.Size > maxCatalogImageBytes {
+		return nil, status.Error(codes.InvalidArgument, "uploaded file is not an accepted image")
+	}
+
+	item, err := s.repo.SetItemImage(ctx, schema.SetCatalogItemImageParams{
+		ID:         req.ItemId,
+		MerchantID: req.MerchantId,
+		ImageKey:   pgtype.Text{String: req.ObjectKey, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save image: %w", err)
+	}
+
+	if existing.ImageKey.Valid && existing.ImageKey.String != req.ObjectKey {
+		if err := s.repo.RemoveObject(ctx, existing.ImageKey.String); err != nil {
+			log.Printf("Failed to remove old image of catalog item %d: %v", item.ID, err)
+		}
+	}
+	return s.itemResponse(ctx, item)
+}
+
+// HasCatalog reports whether the merchant has a menu. Merchants without one
+// still take free-form orders.
+func (s *catalogService) HasCatalog(ctx context.Context, merchantID int) (bool, error) {
+	count, err := s.repo.CountItems(ctx, merchantID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get catalog: %w", err)
+	}
+	return count > 0, nil
+}
+
+// PriceOrder prices order lines from the current catalog. Unknown, unavailable
+// or mispriced lines are rejected with a status error.
+func (s *catalogService) PriceOrder(ctx context.Context, merchantID int, lines []util.LineRequest) ([]util.PricedLine, float64, error) {
+	var itemIDs []int64
+	for _, line := range lines {
+		itemIDs = append(itemIDs, line.ItemID)
+	}
+
+	items, err := s.repo.GetItemsByIDs(ctx, merchantID, itemIDs)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get catalog items: %w", err)
+	}
+	options, err := s.repo.ListOptions(ctx, itemIDs)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get catalog options: %w", err)
+	}
+
+	priced, subtotal, err := util.PriceLines(items, options, lines)
+	switch {
+	case errors.Is(err, util.ErrItemUnavailable), errors.Is(err, util.ErrPriceChanged):
+		return nil, 0, status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		return nil, 0, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return priced, subtotal, nil
+}
+
+func (s *catalogService) validateItem(ctx context.Context, merchantID int, params ItemParams) ([]schema.CreateCatalogOptionParams, error) {
+	if strings.TrimSpace(params.Name) == "" {
+		return nil, status.Error(codes.InvalidArgument, "item name is required")
+	}
+	if params.Price < 0 {
+		return nil, status.Error(codes.InvalidArgument, "price cannot be negative")
+	}
+	if params.CategoryID != 0 {
+		if _, err := s.repo.GetCategory(ctx, merchantID, params.CategoryID); err != nil {
+			return nil, status.Error(codes.NotFound, "category not found")
+		}
+	}
+
+	var options []schema.CreateCatalogOptionParams
+	for i, option := range params.Options {
+		if !util.IsValidOptionKind(option.Kind) {
+			return nil, status.Errorf(codes.InvalidArgument, "option kind must be %s or %s", util.OptionVariant, util.OptionAddon)
+		}
+		if strings.TrimSpace(option.Name) == "" {
+			return nil, status.Error(codes.InvalidArgument, "option name is required")
+		}
+		if option.Kind == util.OptionAddon && option.PriceDelta < 0 {
+			return nil, status.Error(codes.InvalidArgument, "add-ons cannot reduce the price")
+		}
+		if params.Price+option.PriceDelta < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "%s would price the item below zero", option.Name)
+		}
+		options = append(options, schema.CreateCatalogOptionParams{
+			Kind:        option.Kind,
+			Name:        strings.TrimSpace(option.Name),
+			PriceDelta:  utils.Float64ToNumeric(option.PriceDelta),
+			IsAvailable: option.IsAvailable,
+			SortOrder:   int32(i),
+		})
+	}
+	return options, nil
+}
+
+func (s *catalogService) itemResponse(ctx context.Context, item schema.CatalogItem) (*merchantpb.CatalogItemResponse, error) {
+	protoItems, err := s.withOptions(ctx, []schema.CatalogItem{item})
+	if err != nil {
+		return nil, err
+	}
+	return &merchantpb.CatalogItemResponse{Item: protoItems[0]}, nil
+}
+
+// withOptions converts items and attaches their options and image URLs.
+func (s *catalogService) withOptions(ctx context.Context, items []schema.CatalogItem) ([]*schemapb.CatalogItem, error) {
+	if len(items) == 0 {
+		return nil, nil
+	}
+
+	itemIDs := make([]int64, 0, len(items))
+	for _, item := range items {
+		itemIDs = append(itemIDs, item.ID)
+	}
+	options, err := s.repo.ListOptions(ctx, itemIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get catalog options: %w", err)
+	}
+	optionsByItem := make(map[int64][]*schemapb.CatalogOption)
+	for _, option := range options {
+		optionsByItem[option.ItemID] = append(optionsByItem[option.ItemID], convertToProtoCatalogOption(option))
+	}
+
+	var protoItems []*schemapb.CatalogItem
+	for _, item := range items {
+		protoItem := convertToProtoCatalogItem(item)
+		protoItem.Options = optionsByItem[item.ID]
+		if item.ImageKey.Valid {
+			imageURL, err := s.repo.PresignView(ctx, item.ImageKey.String, catalogImageViewExpiry)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create image URL: %w", err)
+			}
+			protoItem.ImageUrl = imageURL
+		}
+		protoItems = append(protoItems, protoItem)
+	}
+	return protoItems, nil
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
+
+func convertToProtoCategory(category schema.CatalogCategory) *schemapb.CatalogCategory {
+	return &schemapb.CatalogCategory{
+		Id:         category.ID,
+		MerchantId: category.MerchantID,
+		Name:       category.Name,
+		SortOrder:  category.SortOrder,
+	}
+}
+
+func convertToProtoCatalogOption(option schema.CatalogOption) *schemapb.CatalogOption {
+	return &schemapb.CatalogOption{
+		Id:          option.ID,
+		ItemId:      option.ItemID,
+		Kind:        option.Kind,
+		Name:        option.Name,
+		PriceDelta:  utils.NumericToFloat64(option.PriceDelta),
+		IsAvailable: option.IsAvailable,
+		SortOrder:   option.SortOrder,
+	}
+}
+
+func convertToProtoCatalogItem(item schema.CatalogItem) *schemapb.CatalogItem {
+	return &schemapb.CatalogItem{
+		Id:          item.ID,
+		MerchantId:  item.MerchantID,
+		CategoryId:  item.CategoryID.Int64,
+		Name:        item.Name,
+		Description: item.Description.String,
+		Price:       utils.NumericToFloat64(item.Price),
+		IsAvailable: item.IsAvailable,
+		SortOrder:   item.SortOrder,
+		CreatedAt:   item.CreatedAt.Time.Unix(),
+		UpdatedAt:   item.UpdatedAt.Time.Unix(),
+	}
+}
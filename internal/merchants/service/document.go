@@ -0,0 +1,337 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"rival/config"
+	merchantpb "rival/gen/proto/proto/api"
+	schemapb "rival/gen/proto/proto/schema"
+	schema "rival/gen/sql"
+	"rival/internal/merchants/repo"
+	"rival/internal/merchants/util"
+	"rival/pkg/notify"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type DocumentService interface {
+	RequestUpload(ctx context.Context, req *merchantpb.RequestDocumentUploadRequest) (*merchantpb.RequestDocumentUploadResponse, error)
+	ConfirmUpload(ctx context.Context, req *merchantpb.ConfirmDocumentUploadRequest) (*merchantpb.ConfirmDocumentUploadResponse, error)
+	ListDocuments(ctx context.Context, merchantID int) (*merchantpb.ListDocumentsResponse, error)
+	ListForReview(ctx context.Context, merchantID int) ([]*schemapb.MerchantDocument, []string, error)
+	Review(ctx context.Context, documentID, adminID int64, verified bool, reason string) (*schemapb.MerchantDocument, error)
+	MissingDocuments(ctx context.Context, merchantID int) ([]string, error)
+	SendExpiryReminders(ctx context.Context) (int, error)
+	StartExpiryReminders(ctx context.Context)
+}
+
+const (
+	// reminderLease is how long a claimed document is hidden from other instances
+	reminderLease     = 2 * time.Minute
+	reminderBatchSize = 100
+)
+
+type documentService struct {
+	repo          repo.DocumentRepository
+	notifier      *notify.Service
+	maxBytes      int64
+	uploadExpiry  time.Duration
+	viewExpiry    time.Duration
+	reminderDays  []int
+	checkInterval time.Duration
+}
+
+func NewDocumentService(repo repo.DocumentRepository, notifier *notify.Service) DocumentService {
+	cfg := config.GetConfig().KYC
+
+	reminderDays := cfg.ReminderDays
+	if len(reminderDays) == 0 {
+		reminderDays = []int{30, 7}
+	}
+
+	return &documentService{
+		repo:          repo,
+		notifier:      notifier,
+		maxBytes:      int64(orDefault(cfg.MaxUploadMB, 10)) << 20,
+		uploadExpiry:  time.Duration(orDefault(cfg.UploadURLMinutes, 15)) * time.Minute,
+		viewExpiry:    time.Duration(orDefault(cfg.ViewURLMinutes, 10)) * time.Minute,
+		reminderDays:  reminderDays,
+		checkInterval: time.Duration(orDefault(cfg.CheckIntervalHour, 6)) * time.Hour,
+	}
+}
+
+func orDefault(value, def int) int {
+	if value <= 0 {
+		return def
+	}
+	return value
+}
+
+func (s *documentService) RequestUpload(ctx context.Context, req *merchantpb.RequestDocumentUploadRequest) (*merchantpb.RequestDocumentUploadResponse, error) {
+	if !util.IsValidDocumentType(req.DocType) {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown document type: %s", req.DocType)
+	}
+	ext, ok := util.DocumentExtension(req.ContentType)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "documents must be PDF, JPEG or PNG")
+	}
+	if req.SizeBytes <= 0 || req.SizeBytes > s.maxBytes {
+		return nil, status.Errorf(codes.InvalidArgument, "documents must be smaller than %d MB", s.maxBytes>>20)
+	}
+
+	objectKey := util.NewDocumentObjectKey(int(req.MerchantId), req.DocType, ext)
+	uploadURL, formData, err := s.repo.PresignUpload(ctx, objectKey, req.ContentType, s.maxBytes, s.uploadExpiry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create upload URL: %w", err)
+	}
+
+	return &merchantpb.RequestDocumentUploadResponse{
+		UploadUrl: uploadURL,
+		FormData:  formData,
+		ObjectKey: objectKey,
+		ExpiresIn: int64(s.uploadExpiry.Seconds()),
+	}, nil
+}
+
+func (s *documentService) ConfirmUpload(ctx context.Context, req *merchantpb.ConfirmDocumentUploadRequest) (*merchantpb.ConfirmDocumentUploadResponse, error) {
+	if !util.IsValidDocumentType(req.DocType) {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown document type: %s", req.DocType)
+	}
+	// The key must come from RequestUpload for this merchant and type
+	if !strings.HasPrefix(req.ObjectKey, util.DocumentKeyPrefix(int(req.MerchantId), req.DocType)) {
+		return nil, status.Error(codes.InvalidArgument, "object key does not belong to this merchant")
+	}
+	if err := util.ValidateDocumentNumber(req.DocType, req.DocumentNumber); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var expiresAt pgtype.Timestamp
+	if req.ExpiresAt != 0 {
+		expiry := time.Unix(req.ExpiresAt, 0)
+		if !expiry.After(time.Now()) {
+			return nil, status.Error(codes.InvalidArgument, "document has already expired")
+		}
+		expiresAt = pgtype.Timestamp{Time: expiry, Valid: true}
+	} else if req.DocType == util.DocFSSAI {
+		return nil, status.Error(codes.InvalidArgument, "FSSAI licence expiry date is required")
+	}
+
+	object, err := s.repo.StatObject(ctx, req.ObjectKey)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, "document has not been uploaded")
+	}
+	if _, ok := util.DocumentExtension(object.ContentType); !ok || object.Size > s.maxBytes {
+		return nil, status.Error(codes.InvalidArgument, "uploaded file is not an accepted document")
+	}
+
+	document, err := s.repo.CreateDocument(ctx, schema.CreateMerchantDocumentParams{
+		MerchantID:     req.MerchantId,
+		DocType:        req.DocType,
+		DocumentNumber: pgtype.Text{String: strings.ToUpper(strings.TrimSpace(req.DocumentNumber)), Valid: true},
+		ObjectKey:      req.ObjectKey,
+		ContentType:    object.ContentType,
+		SizeBytes:      object.Size,
+		ExpiresAt:      expiresAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save document: %w", err)
+	}
+
+	return &merchantpb.ConfirmDocumentUploadResponse{
+		Document: convertToProtoDocument(document),
+	}, nil
+}
+
+func (s *documentService) ListDocuments(ctx context.Context, merchantID int) (*merchantpb.ListDocumentsResponse, error) {
+	documents, err := s.repo.ListDocuments(ctx, merchantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get documents: %w", err)
+	}
+
+	var protoDocuments []*schemapb.MerchantDocument
+	for _, document := range documents {
+		protoDocuments = append(protoDocuments, convertToProtoDocument(document))
+	}
+
+	return &merchantpb.ListDocumentsResponse{
+		Documents: protoDocuments,
+	}, nil
+}
+
+// ListForReview returns the documents with short lived view URLs plus the
+// required types that still block approval.
+func (s *documentService) ListForReview(ctx context.Context, merchantID int) ([]*schemapb.MerchantDocument, []string, error) {
+	documents, err := s.repo.ListDocuments(ctx, merchantID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get documents: %w", err)
+	}
+
+	var protoDocuments []*schemapb.MerchantDocument
+	for _, document := range documents {
+		protoDocument := convertToProtoDocument(document)
+		viewURL, err := s.repo.PresignView(ctx, document.ObjectKey, s.viewExpiry)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create view URL: %w", err)
+		}
+		protoDocument.ViewUrl = viewURL
+		protoDocuments = append(protoDocuments, protoDocument)
+	}
+
+	return protoDocuments, util.MissingDocuments(documents, time.Now()), nil
+}
+
+func (s *documentService) Review(ctx context.Context, documentID, adminID int64, verified bool, reason string) (*schemapb.MerchantDocument, error) {
+	reason = strings.TrimSpace(reason)
+	newStatus := util.DocStatusVerified
+	if !verified {
+		if reason == "" {
+			return nil, status.Error(codes.InvalidArgument, "a reason is required")
+		}
+		newStatus = util.DocStatusRejected
+	}
+
+	existing, err := s.repo.GetDocument(ctx, documentID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "document not found")
+	}
+	if verified && existing.ExpiresAt.Valid && !existing.ExpiresAt.Time.After(time.Now()) {
+		return nil, status.Error(codes.FailedPrecondition, "document has expired")
+	}
+
+	document, err := s.repo.ReviewDocument(ctx, schema.ReviewMerchantDocumentParams{
+		ID:              documentID,
+		Status:          newStatus,
+		RejectionReason: pgtype.Text{String: reason, Valid: reason != ""},
+		ReviewedBy:      pgtype.Int8{Int64: adminID, Valid: adminID != 0},
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Error(codes.FailedPrecondition, "document has already been reviewed")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to review document: %w", err)
+	}
+
+	if !verified {
+		s.notifier.Notify(ctx, notify.Notification{
+			To:       notify.Merchant(document.MerchantID),
+			Type:     notify.TypeKYC,
+			Title:    strings.ToUpper(document.DocType) + " document rejected",
+			Body:     reason,
+			DeepLink: notify.DocumentsLink,
+		})
+	}
+
+	return convertToProtoDocument(document), nil
+}
+
+func (s *documentService) MissingDocuments(ctx context.Context, merchantID int) ([]string, error) {
+	documents, err := s.repo.ListDocuments(ctx, merchantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get documents: %w", err)
+	}
+	return util.MissingDocuments(documents, time.Now()), nil
+}
+
+// SendExpiryReminders notifies merchants whose verified licences are about to
+// expire, once per threshold in kyc.reminder_days. Documents are claimed with
+// a lease first, so instances running this at the same time split the work
+// instead of each sending the same reminders.
+func (s *documentService) SendExpiryReminders(ctx context.Context) (int, error) {
+	maxDays := 0
+	for _, days := range s.reminderDays {
+		if days > maxDays {
+			maxDays = days
+		}
+	}
+
+	sent := 0
+	for {
+		now := time.Now()
+		documents, err := s.repo.ClaimExpiringDocuments(ctx, schema.ClaimExpiringMerchantDocumentsParams{
+			LeaseUntil:   pgtype.Timestamp{Time: now.Add(reminderLease), Valid: true},
+			Horizon:      pgtype.Timestamp{Time: now.AddDate(0, 0, maxDays), Valid: true},
+			MaxReminders: int32(len(s.reminderDays)),
+			Now:          pgtype.Timestamp{Time: now, Valid: true},
+			BatchSize:    reminderBatchSize,
+		})
+		if err != nil {
+			return sent, err
+		}
+
+		for _, document := range documents {
+			// Not due for its next reminder yet, the lease runs out on its own
+			passed := util.RemindersPassed(document.ExpiresAt.Time, now, s.reminderDays)
+			if passed <= int(document.ExpiryRemindersSent) {
+				continue
+			}
+
+			s.notifier.Notify(ctx, notify.Notification{
+				To:    notify.Merchant(document.MerchantID),
+				Type:  notify.TypeKYC,
+				Title: strings.ToUpper(document.DocType) + " licence expiring",
+				Body: fmt.Sprintf("Your %s licence expires on %s, upload the renewed licence to keep accepting payments",
+					strings.ToUpper(document.DocType), document.ExpiresAt.Time.Format("2006-01-02")),
+				DeepLink: notify.DocumentsLink,
+			})
+
+			if err := s.repo.SetRemindersSent(ctx, document.ID, passed); err != nil {
+				return sent, err
+			}
+			sent++
+		}
+
+		if len(documents) < reminderBatchSize {
+			return sent, nil
+		}
+	}
+}
+
+// StartExpiryReminders runs SendExpiryReminders every kyc.check_interval_hour until ctx is done.
+func (s *documentService) StartExpiryReminders(ctx context.Context) {
+	ticker := time.NewTicker(s.checkInterval)
+	defer ticker.Stop()
+
+	for {
+		if sent, err := s.SendExpiryReminders(ctx); err != nil {
+			log.Printf("Failed to send document expiry reminders: %v", err)
+		} else if sent > 0 {
+			log.Printf("Sent %d document expiry reminders", sent)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func convertToProtoDocument(document schema.MerchantDocument) *schemapb.MerchantDocument {
+	protoDocument := &schemapb.MerchantDocument{
+		Id:              document.ID,
+		MerchantId:      document.MerchantID,
+		DocType:         document.DocType,
+		DocumentNumber:  document.DocumentNumber.String,
+		ContentType:     document.ContentType,
+		SizeBytes:       document.SizeBytes,
+		Status:          document.Status,
+		RejectionReason: document.RejectionReason.String,
+		ReviewedBy:      document.ReviewedBy.Int64,
+		CreatedAt:       document.CreatedAt.Time.Unix(),
+	}
+	if document.ReviewedAt.Valid {
+		protoDocument.ReviewedAt = document.ReviewedAt.Time.Unix()
+	}
+	if document.ExpiresAt.Valid {
+		protoDocument.ExpiresAt = document.ExpiresAt.Time.Unix()
+	}
+	return protoDocument
+}
@@ -0,0 +1,270 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	merchantpb "rival/gen/proto/proto/api"
+	schemapb "rival/gen/proto/proto/schema"
+	schema "rival/gen/sql"
+	"rival/internal/merchants/repo"
+	"rival/pkg/business"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxPauseMinutes = 24 * 60
+
+// ClosureParams is either an explicit [StartsAt, EndsAt) range or a whole
+// Date (YYYY-MM-DD) in the merchant's timezone.
+type ClosureParams struct {
+	StartsAt time.Time
+	EndsAt   time.Time
+	Date     string
+	Reason   string
+}
+
+type HoursService interface {
+	GetBusinessHours(ctx context.Context, merchantID int) (*merchantpb.GetBusinessHoursResponse, error)
+	SetBusinessHours(ctx context.Context, merchantID int, timezone string, intervals []business.Interval) (*merchantpb.GetBusinessHoursResponse, error)
+	AddClosure(ctx context.Context, merchantID int, params ClosureParams) (*merchantpb.AddClosureResponse, error)
+	DeleteClosure(ctx context.Context, merchantID int, closureID int64) (*merchantpb.DeleteClosureResponse, error)
+	PauseOrders(ctx context.Context, merchantID int, minutes int) (*merchantpb.PauseOrdersResponse, error)
+	IsOpen(ctx context.Context, merchantID int, now time.Time) (bool, string, error)
+	RequireOpen(ctx context.Context, merchantID int) error
+}
+
+type hoursService struct {
+	repo repo.HoursRepository
+}
+
+func NewHoursService(repo repo.HoursRepository) HoursService {
+	return &hoursService{repo: repo}
+}
+
+func (s *hoursService) GetBusinessHours(ctx context.Context, merchantID int) (*merchantpb.GetBusinessHoursResponse, error) {
+	now := time.Now()
+	row, hours, closures, err := s.load(ctx, merchantID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := buildSchedule(row, hours, closures)
+	open, reason := schedule.Open(now)
+
+	resp := &merchantpb.GetBusinessHoursResponse{
+		Timezone:     row.Timezone,
+		IsOpenNow:    open,
+		ClosedReason: reason,
+	}
+	for _, h := range hours {
+		resp.Intervals = append(resp.Intervals, &schemapb.BusinessHoursInterval{
+			DayOfWeek: int32(h.DayOfWeek),
+			OpensAt:   business.FormatClock(timeToMinutes(h.OpensAt)),
+			ClosesAt:  business.FormatClock(timeToMinutes(h.ClosesAt)),
+		})
+	}
+	for _, c := range closures {
+		resp.Closures = append(resp.Closures, convertToProtoClosure(c))
+	}
+	if schedule.PausedUntil.After(now) {
+		resp.OrdersPausedUntil = schedule.PausedUntil.Unix()
+	}
+	return resp, nil
+}
+
+func (s *hoursService) SetBusinessHours(ctx context.Context, merchantID int, timezone string, intervals []business.Interval) (*merchantpb.GetBusinessHoursResponse, error) {
+	if timezone != "" {
+		if _, err := time.LoadLocation(timezone); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown timezone: %s", timezone)
+		}
+	}
+
+	var params []schema.CreateMerchantHoursParams
+	for _, in := range intervals {
+		if err := business.ValidateInterval(in); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		params = append(params, schema.CreateMerchantHoursParams{
+			MerchantID: int64(merchantID),
+			DayOfWeek:  int16(in.Day),
+			OpensAt:    minutesToTime(in.Opens),
+			ClosesAt:   minutesToTime(in.Closes),
+		})
+	}
+
+	if err := s.repo.ReplaceHours(ctx, merchantID, timezone, params); err != nil {
+		return nil, fmt.Errorf("failed to save business hours: %w", err)
+	}
+	return s.GetBusinessHours(ctx, merchantID)
+}
+
+func (s *hoursService) AddClosure(ctx context.Context, merchantID int, params ClosureParams) (*merchantpb.AddClosureResponse, error) {
+	start, end := params.StartsAt, params.EndsAt
+
+	if params.Date != "" && start.IsZero() {
+		row, err := s.repo.GetSchedule(ctx, merchantID)
+		if err != nil {
+			return nil, status.Error(codes.NotFound, "merchant not found")
+		}
+		day, err := time.ParseInLocation("2006-01-02", params.Date, loadLocation(row.Timezone))
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "date must be YYYY-MM-DD")
+		}
+		start, end = day, day.AddDate(0, 0, 1)
+	}
+
+	if start.IsZero() || !end.After(start) {
+		return nil, status.Error(codes.InvalidArgument, "closure must end after it starts")
+	}
+	if !end.After(time.Now()) {
+		return nil, status.Error(codes.InvalidArgument, "closure is already over")
+	}
+
+	reason := strings.TrimSpace(params.Reason)
+	closure, err := s.repo.CreateClosure(ctx, schema.CreateMerchantClosureParams{
+		MerchantID: int64(merchantID),
+		StartsAt:   pgtype.Timestamp{Time: start.UTC(), Valid: true},
+		EndsAt:     pgtype.Timestamp{Time: end.UTC(), Valid: true},
+		Reason:     pgtype.Text{String: reason, Valid: reason != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create closure: %w", err)
+	}
+
+	return &merchantpb.AddClosureResponse{
+		Closure: convertToProtoClosure(closure),
+	}, nil
+}
+
+func (s *hoursService) DeleteClosure(ctx context.Context, merchantID int, closureID int64) (*merchantpb.DeleteClosureResponse, error) {
+	err := s.repo.DeleteClosure(ctx, merchantID, closureID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "closure not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete closure: %w", err)
+	}
+
+	return &merchantpb.DeleteClosureResponse{Success: true}, nil
+}
+
+func (s *hoursService) PauseOrders(ctx context.Context, merchantID int, minutes int) (*merchantpb.PauseOrdersResponse, error) {
+	if minutes < 0 || minutes > maxPauseMinutes {
+		return nil, status.Errorf(codes.InvalidArgument, "orders can be paused for up to %d minutes", maxPauseMinutes)
+	}
+
+	var until pgtype.Timestamp
+	if minutes > 0 {
+		until = pgtype.Timestamp{Time: time.Now().UTC().Add(time.Duration(minutes) * time.Minute), Valid: true}
+	}
+
+	if err := s.repo.SetOrdersPausedUntil(ctx, merchantID, until); err != nil {
+		return nil, fmt.Errorf("failed to pause orders: %w", err)
+	}
+
+	resp := &merchantpb.PauseOrdersResponse{}
+	if until.Valid {
+		resp.OrdersPausedUntil = until.Time.Unix()
+	}
+	return resp, nil
+}
+
+func (s *hoursService) IsOpen(ctx context.Context, merchantID int, now time.Time) (bool, string, error) {
+	row, hours, closures, err := s.load(ctx, merchantID, now)
+	if err != nil {
+		return false, "", err
+	}
+	open, reason := buildSchedule(row, hours, closures).Open(now)
+	return open, reason, nil
+}
+
+// RequireOpen returns FailedPrecondition when the merchant is not taking orders.
+func (s *hoursService) RequireOpen(ctx context.Context, merchantID int) error {
+	open, reason, err := s.IsOpen(ctx, merchantID, time.Now())
+	if err != nil {
+		return err
+	}
+	if !open {
+		return status.Errorf(codes.FailedPrecondition, "merchant is not taking orders: %s", reason)
+	}
+	return nil
+}
+
+func (s *hoursService) load(ctx context.Context, merchantID int, now time.Time) (schema.GetMerchantScheduleRow, []schema.MerchantHour, []schema.MerchantClosure, error) {
+	row, err := s.repo.GetSchedule(ctx, merchantID)
+	if err != nil {
+		return row, nil, nil, status.Error(codes.NotFound, "merchant not found")
+	}
+
+	hours, err := s.repo.ListHours(ctx, merchantID)
+	if err != nil {
+		return row, nil, nil, fmt.Errorf("failed to get business hours: %w", err)
+	}
+
+	closures, err := s.repo.ListClosures(ctx, merchantID, now)
+	if err != nil {
+		return row, nil, nil, fmt.Errorf("failed to get closures: %w", err)
+	}
+	return row, hours, closures, nil
+}
+
+func buildSchedule(row schema.GetMerchantScheduleRow, hours []schema.MerchantHour, closures []schema.MerchantClosure) business.Schedule {
+	schedule := business.Schedule{Location: loadLocation(row.Timezone)}
+	if row.OrdersPausedUntil.Valid {
+		schedule.PausedUntil = utcTime(row.OrdersPausedUntil)
+	}
+	for _, h := range hours {
+		schedule.Intervals = append(schedule.Intervals, business.Interval{
+			Day:    time.Weekday(h.DayOfWeek),
+			Opens:  timeToMinutes(h.OpensAt),
+			Closes: timeToMinutes(h.ClosesAt),
+		})
+	}
+	for _, c := range closures {
+		schedule.Closures = append(schedule.Closures, business.Closure{
+			Start:  utcTime(c.StartsAt),
+			End:    utcTime(c.EndsAt),
+			Reason: c.Reason.String,
+		})
+	}
+	return schedule
+}
+
+func loadLocation(name string) *time.Location {
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
+// utcTime reads a TIMESTAMP column written as UTC wall clock time.
+func utcTime(ts pgtype.Timestamp) time.Time {
+	t := ts.Time
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+func timeToMinutes(t pgtype.Time) int {
+	return int(t.Microseconds / int64(time.Minute/time.Microsecond))
+}
+
+func minutesToTime(minutes int) pgtype.Time {
+	return pgtype.Time{Microseconds: int64(minutes) * int64(time.Minute/time.Microsecond), Valid: true}
+}
+
+func convertToProtoClosure(closure schema.MerchantClosure) *schemapb.MerchantClosure {
+	return &schemapb.MerchantClosure{
+		Id:         closure.ID,
+		MerchantId: closure.MerchantID,
+		StartsAt:   utcTime(closure.StartsAt).Unix(),
+		EndsAt:     utcTime(closure.EndsAt).Unix(),
+		Reason:     closure.Reason.String,
+	}
+}
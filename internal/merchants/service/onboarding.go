@@ -0,0 +1,225 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	merchantpb "rival/gen/proto/proto/api"
+	schemapb "rival/gen/proto/proto/schema"
+	schema "rival/gen/sql"
+	"rival/internal/merchants/repo"
+	"rival/internal/merchants/util"
+	"rival/pkg/alerts"
+	"rival/pkg/audit"
+	"rival/pkg/notify"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StatusChange asks for a merchant to move to another lifecycle state.
+type StatusChange struct {
+	MerchantID  int
+	To          string
+	AllowedFrom []string // optional, narrows the states the change may start from
+	ActorID     int64
+	ActorType   string // audit.ActorMerchant, audit.ActorAdmin or audit.ActorSystem
+	Reason      string
+}
+
+type OnboardingService interface {
+	Transition(ctx context.Context, change StatusChange) (schema.Merchant, error)
+	SubmitForReview(ctx context.Context, merchantID int, actorID int64) (*merchantpb.SubmitForReviewResponse, error)
+	GetOnboardingStatus(ctx context.Context, merchantID int) (*merchantpb.GetOnboardingStatusResponse, error)
+	History(ctx context.Context, merchantID int) ([]*schemapb.MerchantStatusChange, error)
+}
+
+type onboardingService struct {
+	repo      repo.OnboardingRepository
+	documents DocumentService
+	notifier  *notify.Service
+}
+
+func NewOnboardingService(repo repo.OnboardingRepository, documents DocumentService, notifier *notify.Service) OnboardingService {
+	return &onboardingService{
+		repo:      repo,
+		documents: documents,
+		notifier:  notifier,
+	}
+}
+
+func (s *onboardingService) Transition(ctx context.Context, change StatusChange) (schema.Merchant, error) {
+	merchant, err := s.repo.GetMerchantByID(ctx, change.MerchantID)
+	if err != nil {
+		return schema.Merchant{}, status.Error(codes.NotFound, "merchant not found")
+	}
+
+	if len(change.AllowedFrom) > 0 && !containsStatus(change.AllowedFrom, merchant.Status) {
+		return schema.Merchant{}, status.Errorf(codes.FailedPrecondition, "merchant is %s", merchant.Status)
+	}
+	if !util.CanTransition(merchant.Status, change.To) {
+		return schema.Merchant{}, status.Errorf(codes.FailedPrecondition, "cannot move merchant from %s to %s", merchant.Status, change.To)
+	}
+
+	reason := strings.TrimSpace(change.Reason)
+	if reason == "" && requiresReason(merchant.Status, change.To) {
+		return schema.Merchant{}, status.Error(codes.InvalidArgument, "a reason is required")
+	}
+
+	if util.IsApproved(change.To) {
+		missing, err := s.documents.MissingDocuments(ctx, change.MerchantID)
+		if err != nil {
+			return schema.Merchant{}, err
+		}
+		if len(missing) > 0 {
+			return schema.Merchant{}, status.Errorf(codes.FailedPrecondition, "missing verified documents: %s", strings.Join(missing, ", "))
+		}
+	}
+
+	updated, err := s.repo.TransitionStatus(ctx,
+		schema.TransitionMerchantStatusParams{
+			ID:         merchant.ID,
+			FromStatus: merchant.Status,
+			ToStatus:   change.To,
+			IsActive:   pgtype.Bool{Bool: util.IsApproved(change.To), Valid: true},
+		},
+		schema.CreateMerchantStatusHistoryParams{
+			MerchantID: merchant.ID,
+			FromStatus: pgtype.Text{String: merchant.Status, Valid: true},
+			ToStatus:   change.To,
+			Reason:     pgtype.Text{String: reason, Valid: reason != ""},
+			ActorID:    pgtype.Int8{Int64: change.ActorID, Valid: change.ActorID != 0},
+			ActorType:  change.ActorType,
+		},
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return schema.Merchant{}, status.Error(codes.Aborted, "merchant status changed concurrently, retry")
+	}
+	if err != nil {
+		return schema.Merchant{}, fmt.Errorf("failed to update merchant status: %w", err)
+	}
+
+	s.notify(ctx, merchant.Status, updated, reason)
+	return updated, nil
+}
+
+// requiresReason is true for decisions the merchant needs an explanation for.
+func requiresReason(from, to string) bool {
+	switch to {
+	case util.StatusRejected, util.StatusSuspended:
+		return true
+	case util.StatusApproved:
+		return from == util.StatusSuspended
+	}
+	return false
+}
+
+func (s *onboardingService) notify(ctx context.Context, from string, merchant schema.Merchant, reason string) {
+	send := func(title, body string) {
+		s.notifier.Notify(ctx, notify.Notification{
+			To:       notify.Merchant(merchant.ID),
+			Type:     notify.TypeOnboarding,
+			Title:    title,
+			Body:     body,
+			DeepLink: notify.OnboardingLink,
+		})
+	}
+
+	switch merchant.Status {
+	case util.StatusSubmitted:
+		alerts.Publish(
+			"Merchant awaiting approval",
+			fmt.Sprintf("%s (%s) submitted their application", merchant.Name, merchant.Email),
+			alerts.SeverityInfo,
+			alerts.TypeMerchantSignup,
+		)
+	case util.StatusApproved:
+		message := "Your merchant account is approved, you can now accept payments and publish offers"
+		if from == util.StatusSuspended {
+			message = "Your merchant account has been reinstated: " + reason
+		}
+		send("Account approved", message)
+	case util.StatusRejected:
+		send("Application rejected", reason)
+	case util.StatusSuspended:
+		send("Account suspended", reason)
+	}
+}
+
+func (s *onboardingService) SubmitForReview(ctx context.Context, merchantID int, actorID int64) (*merchantpb.SubmitForReviewResponse, error) {
+	merchant, err := s.Transition(ctx, StatusChange{
+		MerchantID: merchantID,
+		To:         util.StatusSubmitted,
+		ActorID:    actorID,
+		ActorType:  audit.ActorMerchant,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &merchantpb.SubmitForReviewResponse{
+		Merchant: convertToProtoMerchant(merchant),
+	}, nil
+}
+
+func (s *onboardingService) GetOnboardingStatus(ctx context.Context, merchantID int) (*merchantpb.GetOnboardingStatusResponse, error) {
+	merchant, err := s.repo.GetMerchantByID(ctx, merchantID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "merchant not found")
+	}
+
+	history, err := s.History(ctx, merchantID)
+	if err != nil {
+		return nil, err
+	}
+
+	missing, err := s.documents.MissingDocuments(ctx, merchantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &merchantpb.GetOnboardingStatusResponse{
+		Status:           merchant.Status,
+		History:          history,
+		MissingDocuments: missing,
+	}, nil
+}
+
+func (s *onboardingService) History(ctx context.Context, merchantID int) ([]*schemapb.MerchantStatusChange, error) {
+	rows, err := s.repo.ListStatusHistory(ctx, merchantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get merchant status history: %w", err)
+	}
+
+	var history []*schemapb.MerchantStatusChange
+	for _, row := range rows {
+		history = append(history, convertToProtoStatusChange(row))
+	}
+	return history, nil
+}
+
+func containsStatus(statuses []string, want string) bool {
+	for _, s := range statuses {
+		if s == want {
+			return true
+		}
+	}
+	return false
+}
+
+func convertToProtoStatusChange(row schema.MerchantStatusHistory) *schemapb.MerchantStatusChange {
+	return &schemapb.MerchantStatusChange{
+		Id:         row.ID,
+		MerchantId: row.MerchantID,
+		FromStatus: row.FromStatus.String,
+		ToStatus:   row.ToStatus,
+		Reason:     row.Reason.String,
+		ActorId:    row.ActorID.Int64,
+		ActorType:  row.ActorType,
+		CreatedAt:  row.CreatedAt.Time.Unix(),
+	}
+}
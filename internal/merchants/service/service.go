@@ -3,22 +3,33 @@ package service
 import (
 	"context"
 	"fmt"
+	"log"
 	"time"
 
+	"rival/config"
 	merchantpb "rival/gen/proto/proto/api"
 	schemapb "rival/gen/proto/proto/schema"
 	schema "rival/gen/sql"
 	"rival/internal/merchants/repo"
+	"rival/internal/merchants/util"
+	reviewutil "rival/internal/reviews/util"
+	"rival/pkg/geo"
+	"rival/pkg/notify"
 	"rival/pkg/utils"
 
 	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type MerchantService interface {
 	GetMerchant(ctx context.Context, merchantID int) (*merchantpb.GetMerchantResponse, error)
 	UpdateMerchant(ctx context.Context, req *merchantpb.UpdateMerchantRequest) (*merchantpb.UpdateMerchantResponse, error)
 	GetMerchantAddress(ctx context.Context, merchantID int) (*merchantpb.GetMerchantAddressResponse, error)
-	GetOrders(ctx context.Context, req *merchantpb.GetOrdersRequest) (*merchantpb.GetOrdersResponse, error)
+	AddMerchantAddress(ctx context.Context, merchantID int, params AddressParams, primary bool) (*merchantpb.AddMerchantAddressResponse, error)
+	UpdateMerchantAddress(ctx context.Context, merchantID int, addressID int64, params AddressParams) (*merchantpb.UpdateMerchantAddressResponse, error)
+	DeleteMerchantAddress(ctx context.Context, merchantID int, addressID int64) (*merchantpb.DeleteMerchantAddressResponse, error)
+	SetPrimaryMerchantAddress(ctx context.Context, merchantID int, addressID int64) (*merchantpb.SetPrimaryMerchantAddressResponse, error)
 	GetCustomers(ctx context.Context, req *merchantpb.GetCustomersRequest) (*merchantpb.GetCustomersResponse, error)
 	GetPayouts(ctx context.Context, req *merchantpb.GetPayoutsRequest) (*merchantpb.GetPayoutsResponse, error)
 	CreateOffer(ctx context.Context, req *merchantpb.CreateOfferRequest) (*merchantpb.CreateOfferResponse, error)
@@ -28,11 +39,25 @@ type MerchantService interface {
 }
 
 type merchantService struct {
-	repo repo.MerchantRepository
+	repo          repo.MerchantRepository
+	hours         HoursService
+	geocoder      geo.Geocoder
+	notifier      *notify.Service
+	offerRadiusKm float64
 }
 
-func NewMerchantService(repo repo.MerchantRepository) MerchantService {
-	return &merchantService{repo: repo}
+func NewMerchantService(repo repo.MerchantRepository, hours HoursService, notifier *notify.Service) MerchantService {
+	cfg := config.GetConfig()
+	geocoder, err := geo.NewGeocoderFromConfig(cfg.Geocoder)
+	if err != nil {
+		log.Printf("Geocoding disabled: %v", err)
+	}
+
+	offerRadiusKm := cfg.Push.NearbyOfferRadiusKm
+	if offerRadiusKm <= 0 {
+		offerRadiusKm = 3
+	}
+	return &merchantService{repo: repo, hours: hours, geocoder: geocoder, notifier: notifier, offerRadiusKm: offerRadiusKm}
 }
 
 func (s *merchantService) GetMerchant(ctx context.Context, merchantID int) (*merchantpb.GetMerchantResponse, error) {
@@ -42,7 +67,7 @@ func (s *merchantService) GetMerchant(ctx context.Context, merchantID int) (*mer
 	}
 
 	return &merchantpb.GetMerchantResponse{
-		Merchant: convertToProtoMerchant(merchant),
+		Merchant: s.withOpenNow(ctx, convertToProtoMerchant(merchant)),
 	}, nil
 }
 
@@ -54,7 +79,6 @@ func (s *merchantService) UpdateMerchant(ctx context.Context, req *merchantpb.Up
 		Phone:              pgtype.Text{String: req.Phone, Valid: req.Phone != ""},
 		Category:           pgtype.Text{String: req.Category, Valid: req.Category != ""},
 		DiscountPercentage: utils.Float64ToNumeric(req.DiscountPercentage),
-		IsActive:           pgtype.Bool{Bool: true, Valid: true},
 	}
 
 	err := s.repo.UpdateMerchant(ctx, updateParams)
@@ -69,10 +93,21 @@ func (s *merchantService) UpdateMerchant(ctx context.Context, req *merchantpb.Up
 	}
 
 	return &merchantpb.UpdateMerchantResponse{
-		Merchant: convertToProtoMerchant(merchant),
+		Merchant: s.withOpenNow(ctx, convertToProtoMerchant(merchant)),
 	}, nil
 }
 
+// withOpenNow fills is_open_now, leaving it false if the schedule can't be read.
+func (s *merchantService) withOpenNow(ctx context.Context, merchant *schemapb.Merchant) *schemapb.Merchant {
+	open, _, err := s.hours.IsOpen(ctx, int(merchant.Id), time.Now())
+	if err != nil {
+		log.Printf("Failed to check business hours for merchant %d: %v", merchant.Id, err)
+		return merchant
+	}
+	merchant.IsOpenNow = open
+	return merchant
+}
+
 func (s *merchantService) GetMerchantAddress(ctx context.Context, merchantID int) (*merchantpb.GetMerchantAddressResponse, error) {
 	addresses, err := s.repo.GetMerchantAddresses(ctx, merchantID)
 	if err != nil {
@@ -89,25 +124,6 @@ func (s *merchantService) GetMerchantAddress(ctx context.Context, merchantID int
 	}, nil
 }
 
-func (s *merchantService) GetOrders(ctx context.Context, req *merchantpb.GetOrdersRequest) (*merchantpb.GetOrdersResponse, error) {
-	// Get merchant transactions as "orders"
-	transactions, err := s.repo.GetMerchantTransactions(ctx, int(req.MerchantId), req.Limit, (req.Page-1)*req.Limit)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get merchant transactions: %w", err)
-	}
-
-	// Convert transactions to orders (simplified)
-	var orders []*schemapb.Order
-	for _, tx := range transactions {
-		orders = append(orders, convertTransactionToOrder(tx))
-	}
-
-	return &merchantpb.GetOrdersResponse{
-		Orders:     orders,
-		TotalCount: int32(len(orders)),
-	}, nil
-}
-
 func (s *merchantService) GetCustomers(ctx context.Context, req *merchantpb.GetCustomersRequest) (*merchantpb.GetCustomersResponse, error) {
 	// Get merchant customers
 	customers, err := s.repo.GetMerchantCustomers(ctx, int(req.MerchantId), req.Limit, (req.Page-1)*req.Limit)
@@ -155,6 +171,9 @@ func (s *merchantService) GetPayouts(ctx context.Context, req *merchantpb.GetPay
 }
 
 func (s *merchantService) CreateOffer(ctx context.Context, req *merchantpb.CreateOfferRequest) (*merchantpb.CreateOfferResponse, error) {
+	if err := s.requireApproved(ctx, int(req.MerchantId)); err != nil {
+		return nil, err
+	}
 
 	var validUntil pgtype.Timestamp
 	if req.ValidUntil != 0 {
@@ -176,6 +195,8 @@ func (s *merchantService) CreateOffer(ctx context.Context, req *merchantpb.Creat
 	if err != nil {
 		return nil, fmt.Errorf("failed to create offer: %w", err)
 	}
+	// Finding and notifying nearby users shouldn't hold up the merchant
+	go s.announceOffer(context.Background(), offer)
 
 	return &merchantpb.CreateOfferResponse{
 		Offer: convertToProtoOffer(offer),
@@ -203,6 +224,16 @@ func (s *merchantService) GetOffers(ctx context.Context, req *merchantpb.GetOffe
 }
 
 func (s *merchantService) UpdateOffer(ctx context.Context, req *merchantpb.UpdateOfferRequest) (*merchantpb.UpdateOfferResponse, error) {
+	if req.IsActive {
+		offer, err := s.repo.GetOfferByID(ctx, int(req.OfferId))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get offer: %w", err)
+		}
+		if err := s.requireApproved(ctx, int(offer.MerchantID.Int64)); err != nil {
+			return nil, err
+		}
+	}
+
 	var validUntil pgtype.Timestamp
 	if req.ValidUntil != 0 {
 		validUntil = pgtype.Timestamp{Time: time.Unix(req.ValidUntil, 0), Valid: true}
@@ -269,6 +300,53 @@ func (s *merchantService) GetDashboardStats(ctx context.Context, merchantID int)
 	}, nil
 }
 
+// requireApproved stops merchants that are not approved from publishing offers.
+func (s *merchantService) requireApproved(ctx context.Context, merchantID int) error {
+	merchant, err := s.repo.GetMerchantByID(ctx, merchantID)
+	if err != nil {
+		return fmt.Errorf("failed to get merchant: %w", err)
+	}
+	if !util.IsApproved(merchant.Status) {
+		return status.Error(codes.FailedPrecondition, "merchant must be approved before publishing offers")
+	}
+	return nil
+}
+
+// announceOffer tells users with a device near one of the merchant's
+// branches about a new offer.
+func (s *merchantService) announceOffer(ctx context.Context, offer schema.Offer) {
+	merchant, err := s.repo.GetMerchantByID(ctx, int(offer.MerchantID.Int64))
+	if err != nil {
+		log.Printf("Failed to announce offer %d: %v", offer.ID, err)
+		return
+	}
+	addresses, err := s.repo.GetMerchantAddresses(ctx, int(offer.MerchantID.Int64))
+	if err != nil {
+		log.Printf("Failed to announce offer %d: %v", offer.ID, err)
+		return
+	}
+
+	var branches []geo.Coordinates
+	for _, address := range addresses {
+		if address.Latitude.Valid && address.Longitude.Valid {
+			branches = append(branches, geo.Coordinates{
+				Latitude:  utils.NumericToFloat64(address.Latitude),
+				Longitude: utils.NumericToFloat64(address.Longitude),
+			})
+		}
+	}
+	if len(branches) == 0 {
+		return
+	}
+
+	s.notifier.NotifyNearby(ctx, branches, s.offerRadiusKm, notify.Notification{
+		Type:     notify.TypeOffer,
+		Title:    "New offer nearby",
+		Body:     fmt.Sprintf("%s: %s", merchant.Name, offer.Title),
+		DeepLink: notify.MerchantLink(merchant.ID),
+	})
+}
+
 // Conversion functions
 func convertToProtoMerchant(merchant schema.Merchant) *schemapb.Merchant {
 
@@ -280,23 +358,15 @@ func convertToProtoMerchant(merchant schema.Merchant) *schemapb.Merchant {
 		Category:           merchant.Category.String,
 		DiscountPercentage: utils.NumericToFloat64(merchant.DiscountPercentage),
 		IsActive:           merchant.IsActive.Bool,
+		Status:             merchant.Status,
+		Timezone:           merchant.Timezone,
+		RatingAverage:      reviewutil.AverageRating(merchant.RatingTotal, merchant.RatingCount),
+		RatingCount:        merchant.RatingCount,
 		CreatedAt:          merchant.CreatedAt.Time.Unix(),
 		UpdatedAt:          merchant.UpdatedAt.Time.Unix(),
 	}
 }
 
-func convertTransactionToOrder(tx schema.Transaction) *schemapb.Order {
-
-	return &schemapb.Order{
-		Id:          tx.ID,
-		UserId:      tx.UserID.Int64,
-		MerchantId:  tx.MerchantID.Int64,
-		TotalAmount: utils.NumericToFloat64(tx.OriginalAmount),
-		Status:      tx.Status.String,
-		CreatedAt:   tx.CreatedAt.Time.Unix(),
-	}
-}
-
 func convertToProtoUser(user schema.User) *schemapb.User {
 	return &schemapb.User{
 		Id:        user.ID,
@@ -321,9 +391,10 @@ func convertToProtoMerchantAddress(addr schema.MerchantAddress) *schemapb.Mercha
 		Country:    addr.Country.String,
 		Latitude:   utils.NumericToFloat64(addr.Latitude),
 		Longitude:  utils.NumericToFloat64(addr.Longitude),
-		IsPrimary:  addr.IsPrimary.Bool,
+		IsPrimary:  addr.IsPrimary,
 		CreatedAt:  addr.CreatedAt.Time.Unix(),
 		UpdatedAt:  addr.UpdatedAt.Time.Unix(),
+		Label:      addr.Label.String,
 	}
 }
 
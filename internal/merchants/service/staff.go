@@ -0,0 +1,331 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/mail"
+	"strings"
+	"time"
+
+	merchantpb "rival/gen/proto/proto/api"
+	schemapb "rival/gen/proto/proto/schema"
+	schema "rival/gen/sql"
+	authutil "rival/internal/auth/util"
+	"rival/internal/merchants/repo"
+	"rival/internal/merchants/util"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const staffInvitationTTL = 7 * 24 * time.Hour
+
+// StaffPrincipal is the membership a signed-in user acts through on a merchant.
+type StaffPrincipal struct {
+	StaffID    int64
+	MerchantID int
+	Role       string
+	OutletIDs  []int64
+}
+
+type StaffService interface {
+	Authorize(ctx context.Context, userID, merchantID int, permission string) (*StaffPrincipal, error)
+	Invite(ctx context.Context, merchantID, invitedBy int, email, role string, outletIDs []int64) (*merchantpb.InviteStaffResponse, error)
+	AcceptInvitation(ctx context.Context, userID int, token string) (*merchantpb.AcceptStaffInvitationResponse, error)
+	RevokeInvitation(ctx context.Context, merchantID int, invitationID int64) (*merchantpb.RevokeStaffInvitationResponse, error)
+	ListStaff(ctx context.Context, merchantID int) (*merchantpb.ListStaffResponse, error)
+	UpdateStaff(ctx context.Context, merchantID int, staffID int64, role string, outletIDs []int64) (*merchantpb.UpdateStaffResponse, error)
+	RemoveStaff(ctx context.Context, merchantID int, staffID int64) (*merchantpb.RemoveStaffResponse, error)
+	ListMemberships(ctx context.Context, userID int) (*merchantpb.ListMyMembershipsResponse, error)
+}
+
+type staffService struct {
+	repo  repo.StaffRepository
+	email authutil.Service
+}
+
+func NewStaffService(repo repo.StaffRepository) StaffService {
+	return &staffService{repo: repo, email: authutil.NewEmailService()}
+}
+
+// Authorize resolves the caller's membership and checks its role grants
+// permission. Without a merchant ID the caller's only membership is used.
+func (s *staffService) Authorize(ctx context.Context, userID, merchantID int, permission string) (*StaffPrincipal, error) {
+	if userID == 0 {
+		return nil, status.Error(codes.Unauthenticated, "sign in required")
+	}
+
+	var member schema.MerchantStaff
+	if merchantID == 0 {
+		memberships, err := s.repo.ListMemberships(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get memberships: %w", err)
+		}
+		switch len(memberships) {
+		case 0:
+			return nil, status.Error(codes.PermissionDenied, "you are not a member of any merchant")
+		case 1:
+			member = memberships[0].MerchantStaff
+		default:
+			return nil, status.Error(codes.InvalidArgument, "merchant ID is required")
+		}
+	} else {
+		var err error
+		member, err = s.repo.GetMember(ctx, merchantID, userID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			member, err = s.repo.ClaimOwnership(ctx, merchantID, userID)
+		}
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.PermissionDenied, "you are not a member of this merchant")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get membership: %w", err)
+		}
+	}
+
+	if !util.RoleHasPermission(member.Role, permission) {
+		return nil, status.Errorf(codes.PermissionDenied, "a %s can't do this", member.Role)
+	}
+
+	return &StaffPrincipal{
+		StaffID:    member.ID,
+		MerchantID: int(member.MerchantID),
+		Role:       member.Role,
+		OutletIDs:  member.OutletIds,
+	}, nil
+}
+
+func (s *staffService) Invite(ctx context.Context, merchantID, invitedBy int, email, role string, outletIDs []int64) (*merchantpb.InviteStaffResponse, error) {
+	address, err := mail.ParseAddress(strings.TrimSpace(email))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "a valid email is required")
+	}
+	email = strings.ToLower(address.Address)
+	if err := s.validateMembership(ctx, merchantID, role, outletIDs); err != nil {
+		return nil, err
+	}
+
+	merchant, err := s.repo.GetMerchant(ctx, merchantID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "merchant not found")
+	}
+
+	token, tokenHash, err := util.GenerateInvitationToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate invitation: %w", err)
+	}
+	expiresAt := time.Now().UTC().Add(staffInvitationTTL)
+
+	invitation, err := s.repo.CreateInvitation(ctx, schema.CreateStaffInvitationParams{
+		MerchantID: int64(merchantID),
+		Email:      email,
+		Role:       role,
+		OutletIds:  nonNilIDs(outletIDs),
+		TokenHash:  tokenHash,
+		InvitedBy:  pgtype.Int8{Int64: int64(invitedBy), Valid: invitedBy != 0},
+		ExpiresAt:  pgtype.Timestamp{Time: expiresAt, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create invitation: %w", err)
+	}
+
+	if err := s.email.SendStaffInvitationEmail(email, merchant.Name, role, token, expiresAt); err != nil {
+		log.Printf("Failed to send staff invitation %d: %v", invitation.ID, err)
+	}
+
+	return &merchantpb.InviteStaffResponse{
+		Invitation: convertToProtoInvitation(invitation),
+	}, nil
+}
+
+// AcceptInvitation turns an invitation into a membership. It must be accepted
+// by the account the invitation was sent to.
+func (s *staffService) AcceptInvitation(ctx context.Context, userID int, token string) (*merchantpb.AcceptStaffInvitationResponse, error) {
+	invitation, err := s.repo.GetInvitationByTokenHash(ctx, util.HashInvitationToken(strings.TrimSpace(token)))
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "invitation not found")
+	}
+	if invitation.AcceptedAt.Valid || invitation.RevokedAt.Valid || !utcTime(invitation.ExpiresAt).After(time.Now()) {
+		return nil, status.Error(codes.FailedPrecondition, "invitation is no longer valid")
+	}
+
+	user, err := s.repo.GetUser(ctx, userID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if !strings.EqualFold(user.Email, invitation.Email) {
+		return nil, status.Error(codes.PermissionDenied, "this invitation was sent to a different email")
+	}
+
+	member, err := s.repo.AcceptInvitation(ctx, invitation, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to accept invitation: %v", err)
+	}
+
+	protoMember := convertToProtoStaff(member)
+	protoMember.Name = user.Name
+	protoMember.Email = user.Email
+	return &merchantpb.AcceptStaffInvitationResponse{Membership: protoMember}, nil
+}
+
+func (s *staffService) RevokeInvitation(ctx context.Context, merchantID int, invitationID int64) (*merchantpb.RevokeStaffInvitationResponse, error) {
+	revoked, err := s.repo.RevokeInvitation(ctx, merchantID, invitationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to revoke invitation: %w", err)
+	}
+	if revoked == 0 {
+		return nil, status.Error(codes.NotFound, "invitation not found")
+	}
+
+	return &merchantpb.RevokeStaffInvitationResponse{Success: true}, nil
+}
+
+func (s *staffService) ListStaff(ctx context.Context, merchantID int) (*merchantpb.ListStaffResponse, error) {
+	members, err := s.repo.ListMembers(ctx, merchantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get staff: %w", err)
+	}
+	invitations, err := s.repo.ListPendingInvitations(ctx, merchantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invitations: %w", err)
+	}
+
+	resp := &merchantpb.ListStaffResponse{}
+	for _, row := range members {
+		member := convertToProtoStaff(row.MerchantStaff)
+		member.Name = row.Name
+		member.Email = row.Email
+		resp.Staff = append(resp.Staff, member)
+	}
+	for _, invitation := range invitations {
+		resp.PendingInvitations = append(resp.PendingInvitations, convertToProtoInvitation(invitation))
+	}
+	return resp, nil
+}
+
+func (s *staffService) UpdateStaff(ctx context.Context, merchantID int, staffID int64, role string, outletIDs []int64) (*merchantpb.UpdateStaffResponse, error) {
+	if err := s.validateMembership(ctx, merchantID, role, outletIDs); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repo.GetMemberByID(ctx, merchantID, staffID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "staff member not found")
+	}
+	if existing.Role == util.RoleOwner && role != util.RoleOwner {
+		if err := s.requireAnotherOwner(ctx, merchantID); err != nil {
+			return nil, err
+		}
+	}
+
+	member, err := s.repo.UpdateMember(ctx, schema.UpdateMerchantStaffParams{
+		ID:         staffID,
+		MerchantID: int64(merchantID),
+		Role:       role,
+		OutletIds:  nonNilIDs(outletIDs),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update staff member: %w", err)
+	}
+
+	return &merchantpb.UpdateStaffResponse{Staff: convertToProtoStaff(member)}, nil
+}
+
+func (s *staffService) RemoveStaff(ctx context.Context, merchantID int, staffID int64) (*merchantpb.RemoveStaffResponse, error) {
+	existing, err := s.repo.GetMemberByID(ctx, merchantID, staffID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "staff member not found")
+	}
+	if existing.Role == util.RoleOwner {
+		if err := s.requireAnotherOwner(ctx, merchantID); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := s.repo.DeleteMember(ctx, merchantID, staffID); err != nil {
+		return nil, fmt.Errorf("failed to remove staff member: %w", err)
+	}
+
+	return &merchantpb.RemoveStaffResponse{Success: true}, nil
+}
+
+func (s *staffService) ListMemberships(ctx context.Context, userID int) (*merchantpb.ListMyMembershipsResponse, error) {
+	memberships, err := s.repo.ListMemberships(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get memberships: %w", err)
+	}
+
+	resp := &merchantpb.ListMyMembershipsResponse{}
+	for _, row := range memberships {
+		member := convertToProtoStaff(row.MerchantStaff)
+		member.MerchantName = row.MerchantName
+		resp.Memberships = append(resp.Memberships, member)
+	}
+	return resp, nil
+}
+
+func (s *staffService) validateMembership(ctx context.Context, merchantID int, role string, outletIDs []int64) error {
+	if !util.IsValidRole(role) {
+		return status.Errorf(codes.InvalidArgument, "role must be %s, %s or %s", util.RoleOwner, util.RoleManager, util.RoleCashier)
+	}
+	if len(outletIDs) == 0 {
+		return nil
+	}
+
+	outlets, err := s.repo.GetOutletIDs(ctx, merchantID)
+	if err != nil {
+		return fmt.Errorf("failed to get outlets: %w", err)
+	}
+	for _, id := range outletIDs {
+		if !util.OutletAllowed(outlets, id) {
+			return status.Errorf(codes.InvalidArgument, "outlet %d does not belong to this merchant", id)
+		}
+	}
+	return nil
+}
+
+// requireAnotherOwner keeps a merchant from losing its last owner.
+func (s *staffService) requireAnotherOwner(ctx context.Context, merchantID int) error {
+	owners, err := s.repo.CountOwners(ctx, merchantID)
+	if err != nil {
+		return fmt.Errorf("failed to count owners: %w", err)
+	}
+	if owners <= 1 {
+		return status.Error(codes.FailedPrecondition, "a merchant needs at least one owner")
+	}
+	return nil
+}
+
+func nonNilIDs(ids []int64) []int64 {
+	if ids == nil {
+		return []int64{}
+	}
+	return ids
+}
+
+func convertToProtoStaff(member schema.MerchantStaff) *schemapb.MerchantStaff {
+	return &schemapb.MerchantStaff{
+		Id:         member.ID,
+		MerchantId: member.MerchantID,
+		UserId:     member.UserID,
+		Role:       member.Role,
+		OutletIds:  member.OutletIds,
+		CreatedAt:  member.CreatedAt.Time.Unix(),
+	}
+}
+
+func convertToProtoInvitation(invitation schema.MerchantStaffInvitation) *schemapb.StaffInvitation {
+	return &schemapb.StaffInvitation{
+		Id:         invitation.ID,
+		MerchantId: invitation.MerchantID,
+		Email:      invitation.Email,
+		Role:       invitation.Role,
+		OutletIds:  invitation.OutletIds,
+		ExpiresAt:  utcTime(invitation.ExpiresAt).Unix(),
+		CreatedAt:  invitation.CreatedAt.Time.Unix(),
+	}
+}
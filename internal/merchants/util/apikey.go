@@ -0,0 +1,136 @@
+package util
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// API keys look like rvl_<prefix>_<secret>. The prefix is stored in clear so a
+// key can be identified in logs and looked up, the full key is only stored hashed.
+const APIKeyPrefix = "rvl_"
+
+const (
+	ScopeOrdersRead     = "orders:read"
+	ScopeOrdersWrite    = "orders:write"
+	ScopePaymentsCreate = "payments:create" // grants nothing, kept valid for keys that were issued with it
+	ScopePaymentsRead   = "payments:read"
+	ScopeOffersRead     = "offers:read"
+	ScopeOffersWrite    = "offers:write"
+	ScopeCustomersRead  = "customers:read"
+	ScopeMerchantRead   = "merchant:read"
+	ScopeCatalogRead    = "catalog:read"
+	ScopeCatalogWrite   = "catalog:write"
+)
+
+var validScopes = map[string]bool{
+	ScopeOrdersRead:     true,
+	ScopeOrdersWrite:    true,
+	ScopePaymentsCreate: true,
+	ScopePaymentsRead:   true,
+	ScopeOffersRead:     true,
+	ScopeOffersWrite:    true,
+	ScopeCustomersRead:  true,
+	ScopeMerchantRead:   true,
+	ScopeCatalogRead:    true,
+	ScopeCatalogWrite:   true,
+}
+
+func IsValidScope(scope string) bool {
+	return validScopes[scope]
+}
+
+// IsAPIKey reports whether a bearer credential is an API key rather than a JWT.
+func IsAPIKey(token string) bool {
+	return strings.HasPrefix(token, APIKeyPrefix)
+}
+
+// GenerateAPIKey returns the plaintext key together with its prefix and hash.
+func GenerateAPIKey() (key, prefix, hash string, err error) {
+	prefixBytes := make([]byte, 4)
+	if _, err = rand.Read(prefixBytes); err != nil {
+		return "", "", "", err
+	}
+	secretBytes := make([]byte, 24)
+	if _, err = rand.Read(secretBytes); err != nil {
+		return "", "", "", err
+	}
+
+	prefix = hex.EncodeToString(prefixBytes)
+	key = APIKeyPrefix + prefix + "_" + hex.EncodeToString(secretBytes)
+	return key, prefix, HashAPIKey(key), nil
+}
+
+// ParseAPIKey extracts the lookup prefix from a plaintext key.
+func ParseAPIKey(key string) (string, error) {
+	parts := strings.Split(strings.TrimPrefix(key, APIKeyPrefix), "_")
+	if !IsAPIKey(key) || len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", fmt.Errorf("malformed API key")
+	}
+	return parts[0], nil
+}
+
+func HashAPIKey(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hash[:])
+}
+
+// VerifyAPIKey compares a plaintext key against a stored hash in constant time.
+func VerifyAPIKey(key, hash string) bool {
+	return subtle.ConstantTimeCompare([]byte(HashAPIKey(key)), []byte(hash)) == 1
+}
+
+func HasScope(scopes []string, scope string) bool {
+	for _, s := range scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// IPAllowed checks ip against an allowlist of addresses and CIDR ranges.
+// An empty allowlist allows every address.
+func IPAllowed(allowlist []string, ip string) bool {
+	if len(allowlist) == 0 {
+		return true
+	}
+
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return false
+	}
+
+	for _, entry := range allowlist {
+		if strings.Contains(entry, "/") {
+			if _, network, err := net.ParseCIDR(entry); err == nil && network.Contains(addr) {
+				return true
+			}
+			continue
+		}
+		if allowed := net.ParseIP(entry); allowed != nil && allowed.Equal(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateIPAllowlist rejects entries that are neither an IP nor a CIDR range.
+func ValidateIPAllowlist(allowlist []string) error {
+	for _, entry := range allowlist {
+		if strings.Contains(entry, "/") {
+			if _, _, err := net.ParseCIDR(entry); err != nil {
+				return fmt.Errorf("invalid CIDR in allowlist: %s", entry)
+			}
+			continue
+		}
+		if net.ParseIP(entry) == nil {
+			return fmt.Errorf("invalid IP in allowlist: %s", entry)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package util
+
+import "testing"
+
+func TestGenerateAndVerifyAPIKey(t *testing.T) {
+	key, prefix, hash, err := GenerateAPIKey()
+	if err != nil {
+		t.Fatalf("failed to generate API key: %v", err)
+	}
+	if !IsAPIKey(key) {
+		t.Errorf("generated key %q missing %s prefix", key, APIKeyPrefix)
+	}
+
+	parsed, err := ParseAPIKey(key)
+	if err != nil || parsed != prefix {
+		t.Errorf("expected prefix %s, got %s (%v)", prefix, parsed, err)
+	}
+	if !VerifyAPIKey(key, hash) {
+		t.Errorf("key did not verify against its own hash")
+	}
+	if VerifyAPIKey(key+"x", hash) {
+		t.Errorf("tampered key verified")
+	}
+
+	for _, bad := range []string{"", "rvl_", "rvl_abc", "rvl__secret", "abc_def"} {
+		if _, err := ParseAPIKey(bad); err == nil {
+			t.Errorf("expected %q to be rejected", bad)
+		}
+	}
+}
+
+func TestIPAllowed(t *testing.T) {
+	allowlist := []string{"10.0.0.0/24", "203.0.113.7"}
+
+	cases := map[string]bool{
+		"10.0.0.42":   true,
+		"10.0.1.1":    false,
+		"203.0.113.7": true,
+		"203.0.113.8": false,
+		"not-an-ip":   false,
+	}
+	for ip, want := range cases {
+		if got := IPAllowed(allowlist, ip); got != want {
+			t.Errorf("IPAllowed(%s) = %v, want %v", ip, got, want)
+		}
+	}
+
+	if !IPAllowed(nil, "198.51.100.1") {
+		t.Errorf("empty allowlist should allow any IP")
+	}
+	if err := ValidateIPAllowlist([]string{"10.0.0.0/33"}); err == nil {
+		t.Errorf("expected invalid CIDR to be rejected")
+	}
+}
@@ -0,0 +1,186 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	schema "rival/gen/sql"
+	"rival/pkg/utils"
+
+	"github.com/google/uuid"
+)
+
+// Catalog option kinds. An item with variants must be ordered with exactly
+// one of them, add-ons are optional and stack.
+const (
+	OptionVariant = "variant"
+	OptionAddon   = "addon"
+)
+
+const MaxLineQuantity = 99
+
+var (
+	ErrInvalidLine     = errors.New("invalid line item")
+	ErrItemUnavailable = errors.New("item unavailable")
+	ErrPriceChanged    = errors.New("price changed")
+)
+
+var catalogImageContentTypes = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+}
+
+func IsValidOptionKind(kind string) bool {
+	return kind == OptionVariant || kind == OptionAddon
+}
+
+// CatalogImageExtension returns the extension for an accepted image type.
+func CatalogImageExtension(contentType string) (string, bool) {
+	ext, ok := catalogImageContentTypes[contentType]
+	return ext, ok
+}
+
+// CatalogImageKeyPrefix is where images for one catalog item live.
+func CatalogImageKeyPrefix(merchantID int, itemID int64) string {
+	return fmt.Sprintf("catalog/%d/%d/", merchantID, itemID)
+}
+
+func NewCatalogImageObjectKey(merchantID int, itemID int64, ext string) string {
+	return CatalogImageKeyPrefix(merchantID, itemID) + uuid.NewString() + ext
+}
+
+// LineRequest is one line of an order as sent by the client. UnitPrice is the
+// price the client displayed, 0 when it didn't send one.
+type LineRequest struct {
+	ItemID    int64
+	VariantID int64
+	AddonIDs  []int64
+	Quantity  int
+	UnitPrice float64
+}
+
+// PricedLine is a line item priced from the catalog. It is stored as the
+// order's items snapshot so later menu changes don't rewrite past orders.
+type PricedLine struct {
+	ItemID    int64   `json:"item_id"`
+	VariantID int64   `json:"variant_id,omitempty"`
+	AddonIDs  []int64 `json:"addon_ids,omitempty"`
+	Name      string  `json:"name"`
+	Quantity  int     `json:"quantity"`
+	UnitPrice float64 `json:"unit_price"`
+	Subtotal  float64 `json:"subtotal"`
+}
+
+// PriceLines prices order lines against the merchant's catalog and returns the
+// order subtotal. Amounts are summed in paise to avoid float drift.
+func PriceLines(items []schema.CatalogItem, options []schema.CatalogOption, lines []LineRequest) ([]PricedLine, float64, error) {
+	if len(lines) == 0 {
+		return nil, 0, fmt.Errorf("%w: order has no items", ErrInvalidLine)
+	}
+
+	itemsByID := make(map[int64]schema.CatalogItem, len(items))
+	for _, item := range items {
+		itemsByID[item.ID] = item
+	}
+	optionsByID := make(map[int64]schema.CatalogOption, len(options))
+	hasVariants := make(map[int64]bool)
+	for _, option := range options {
+		optionsByID[option.ID] = option
+		if option.Kind == OptionVariant {
+			hasVariants[option.ItemID] = true
+		}
+	}
+
+	var priced []PricedLine
+	var total int64
+	for _, line := range lines {
+		item, ok := itemsByID[line.ItemID]
+		if !ok {
+			return nil, 0, fmt.Errorf("%w: item %d not found", ErrInvalidLine, line.ItemID)
+		}
+		if !item.IsAvailable {
+			return nil, 0, fmt.Errorf("%w: %s", ErrItemUnavailable, item.Name)
+		}
+		if line.Quantity < 1 || line.Quantity > MaxLineQuantity {
+			return nil, 0, fmt.Errorf("%w: quantity for %s must be between 1 and %d", ErrInvalidLine, item.Name, MaxLineQuantity)
+		}
+
+		unit := toPaise(utils.NumericToFloat64(item.Price))
+		names := []string{item.Name}
+
+		if hasVariants[item.ID] {
+			variant, err := lineOption(optionsByID, item, line.VariantID, OptionVariant)
+			if err != nil {
+				return nil, 0, err
+			}
+			unit += toPaise(utils.NumericToFloat64(variant.PriceDelta))
+			names[0] += " (" + variant.Name + ")"
+		} else if line.VariantID != 0 {
+			return nil, 0, fmt.Errorf("%w: %s has no variants", ErrInvalidLine, item.Name)
+		}
+
+		seen := make(map[int64]bool)
+		for _, addonID := range line.AddonIDs {
+			if seen[addonID] {
+				return nil, 0, fmt.Errorf("%w: add-on %d repeated for %s", ErrInvalidLine, addonID, item.Name)
+			}
+			seen[addonID] = true
+			addon, err := lineOption(optionsByID, item, addonID, OptionAddon)
+			if err != nil {
+				return nil, 0, err
+			}
+			unit += toPaise(utils.NumericToFloat64(addon.PriceDelta))
+			names = append(names, addon.Name)
+		}
+
+		if unit < 0 {
+			return nil, 0, fmt.Errorf("%w: %s is priced below zero", ErrInvalidLine, item.Name)
+		}
+		if line.UnitPrice != 0 && toPaise(line.UnitPrice) != unit {
+			return nil, 0, fmt.Errorf("%w: %s now costs %.2f", ErrPriceChanged, item.Name, fromPaise(unit))
+		}
+
+		subtotal := unit * int64(line.Quantity)
+		total += subtotal
+		priced = append(priced, PricedLine{
+			ItemID:    item.ID,
+			VariantID: line.VariantID,
+			AddonIDs:  line.AddonIDs,
+			Name:      strings.Join(names, " + "),
+			Quantity:  line.Quantity,
+			UnitPrice: fromPaise(unit),
+			Subtotal:  fromPaise(subtotal),
+		})
+	}
+	return priced, fromPaise(total), nil
+}
+
+// SameAmount compares two rupee amounts to the paisa.
+func SameAmount(a, b float64) bool {
+	return toPaise(a) == toPaise(b)
+}
+
+func lineOption(options map[int64]schema.CatalogOption, item schema.CatalogItem, optionID int64, kind string) (schema.CatalogOption, error) {
+	option, ok := options[optionID]
+	if !ok || option.ItemID != item.ID || option.Kind != kind {
+		if kind == OptionVariant && optionID == 0 {
+			return option, fmt.Errorf("%w: choose a variant for %s", ErrInvalidLine, item.Name)
+		}
+		return option, fmt.Errorf("%w: %s %d does not belong to %s", ErrInvalidLine, kind, optionID, item.Name)
+	}
+	if !option.IsAvailable {
+		return option, fmt.Errorf("%w: %s %s", ErrItemUnavailable, item.Name, option.Name)
+	}
+	return option, nil
+}
+
+func toPaise(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
+
+func fromPaise(paise int64) float64 {
+	return float64(paise) / 100
+}
@@ -0,0 +1,62 @@
+package util
+
+import (
+	"errors"
+	"testing"
+
+	schema "rival/gen/sql"
+	"rival/pkg/utils"
+)
+
+func TestPriceLines(t *testing.T) {
+	items := []schema.CatalogItem{
+		{ID: 1, Name: "Latte", Price: utils.Float64ToNumeric(120), IsAvailable: true},
+		{ID: 2, Name: "Croissant", Price: utils.Float64ToNumeric(95.50), IsAvailable: true},
+		{ID: 3, Name: "Bagel", Price: utils.Float64ToNumeric(80), IsAvailable: false},
+	}
+	options := []schema.CatalogOption{
+		{ID: 10, ItemID: 1, Kind: OptionVariant, Name: "Small", PriceDelta: utils.Float64ToNumeric(0), IsAvailable: true},
+		{ID: 11, ItemID: 1, Kind: OptionVariant, Name: "Large", PriceDelta: utils.Float64ToNumeric(40), IsAvailable: true},
+		{ID: 12, ItemID: 1, Kind: OptionAddon, Name: "Extra shot", PriceDelta: utils.Float64ToNumeric(30.10), IsAvailable: true},
+		{ID: 13, ItemID: 1, Kind: OptionAddon, Name: "Oat milk", PriceDelta: utils.Float64ToNumeric(25), IsAvailable: false},
+	}
+
+	lines, subtotal, err := PriceLines(items, options, []LineRequest{
+		{ItemID: 1, VariantID: 11, AddonIDs: []int64{12}, Quantity: 2, UnitPrice: 190.10},
+		{ItemID: 2, Quantity: 3},
+	})
+	if err != nil {
+		t.Fatalf("PriceLines failed: %v", err)
+	}
+	if subtotal != 666.70 {
+		t.Errorf("subtotal = %.2f, want 666.70", subtotal)
+	}
+	if lines[0].Name != "Latte (Large) + Extra shot" || lines[0].Subtotal != 380.20 {
+		t.Errorf("unexpected first line: %+v", lines[0])
+	}
+
+	cases := []struct {
+		name string
+		line LineRequest
+		want error
+	}{
+		{"unknown item", LineRequest{ItemID: 99, Quantity: 1}, ErrInvalidLine},
+		{"unavailable item", LineRequest{ItemID: 3, Quantity: 1}, ErrItemUnavailable},
+		{"missing variant", LineRequest{ItemID: 1, Quantity: 1}, ErrInvalidLine},
+		{"addon as variant", LineRequest{ItemID: 1, VariantID: 12, Quantity: 1}, ErrInvalidLine},
+		{"unavailable addon", LineRequest{ItemID: 1, VariantID: 10, AddonIDs: []int64{13}, Quantity: 1}, ErrItemUnavailable},
+		{"repeated addon", LineRequest{ItemID: 1, VariantID: 10, AddonIDs: []int64{12, 12}, Quantity: 1}, ErrInvalidLine},
+		{"variant on plain item", LineRequest{ItemID: 2, VariantID: 10, Quantity: 1}, ErrInvalidLine},
+		{"zero quantity", LineRequest{ItemID: 2}, ErrInvalidLine},
+		{"stale price", LineRequest{ItemID: 2, Quantity: 1, UnitPrice: 90}, ErrPriceChanged},
+	}
+	for _, tc := range cases {
+		if _, _, err := PriceLines(items, options, []LineRequest{tc.line}); !errors.Is(err, tc.want) {
+			t.Errorf("%s: got %v, want %v", tc.name, err, tc.want)
+		}
+	}
+
+	if _, _, err := PriceLines(items, options, nil); !errors.Is(err, ErrInvalidLine) {
+		t.Errorf("expected empty order to be rejected, got %v", err)
+	}
+}
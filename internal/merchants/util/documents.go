@@ -0,0 +1,106 @@
+package util
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	schema "rival/gen/sql"
+
+	"github.com/google/uuid"
+)
+
+// KYC document types
+const (
+	DocPAN   = "pan"
+	DocGST   = "gst"
+	DocFSSAI = "fssai"
+)
+
+// KYC document statuses
+const (
+	DocStatusUploaded = "uploaded"
+	DocStatusVerified = "verified"
+	DocStatusRejected = "rejected"
+)
+
+// RequiredDocuments must all be verified and unexpired before a merchant is approved.
+var RequiredDocuments = []string{DocPAN, DocGST, DocFSSAI}
+
+// documentContentTypes maps the accepted upload types to a file extension.
+var documentContentTypes = map[string]string{
+	"application/pdf": ".pdf",
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+}
+
+var documentNumberPatterns = map[string]*regexp.Regexp{
+	DocPAN:   regexp.MustCompile(`^[A-Z]{5}[0-9]{4}[A-Z]$`),
+	DocGST:   regexp.MustCompile(`^[0-9]{2}[A-Z]{5}[0-9]{4}[A-Z][1-9A-Z]Z[0-9A-Z]$`),
+	DocFSSAI: regexp.MustCompile(`^[0-9]{14}$`),
+}
+
+func IsValidDocumentType(docType string) bool {
+	_, ok := documentNumberPatterns[docType]
+	return ok
+}
+
+// DocumentExtension returns the extension for an accepted content type.
+func DocumentExtension(contentType string) (string, bool) {
+	ext, ok := documentContentTypes[contentType]
+	return ext, ok
+}
+
+// ValidateDocumentNumber checks the PAN, GSTIN or FSSAI licence number format.
+func ValidateDocumentNumber(docType, number string) error {
+	pattern, ok := documentNumberPatterns[docType]
+	if !ok {
+		return fmt.Errorf("unknown document type: %s", docType)
+	}
+	if !pattern.MatchString(strings.ToUpper(strings.TrimSpace(number))) {
+		return fmt.Errorf("invalid %s number", strings.ToUpper(docType))
+	}
+	return nil
+}
+
+// DocumentKeyPrefix is where uploads for one merchant and document type live.
+func DocumentKeyPrefix(merchantID int, docType string) string {
+	return fmt.Sprintf("kyc/%d/%s/", merchantID, docType)
+}
+
+func NewDocumentObjectKey(merchantID int, docType, ext string) string {
+	return DocumentKeyPrefix(merchantID, docType) + uuid.NewString() + ext
+}
+
+// MissingDocuments returns the required document types whose latest upload is
+// not verified or has expired. docs must be ordered newest first.
+func MissingDocuments(docs []schema.MerchantDocument, now time.Time) []string {
+	latest := make(map[string]schema.MerchantDocument)
+	for _, doc := range docs {
+		if _, seen := latest[doc.DocType]; !seen {
+			latest[doc.DocType] = doc
+		}
+	}
+
+	var missing []string
+	for _, docType := range RequiredDocuments {
+		doc, ok := latest[docType]
+		if !ok || doc.Status != DocStatusVerified || (doc.ExpiresAt.Valid && !doc.ExpiresAt.Time.After(now)) {
+			missing = append(missing, docType)
+		}
+	}
+	return missing
+}
+
+// RemindersPassed counts the reminder thresholds (days before expiry) that
+// expiresAt has already crossed at now.
+func RemindersPassed(expiresAt, now time.Time, reminderDays []int) int {
+	passed := 0
+	for _, days := range reminderDays {
+		if !now.Before(expiresAt.Add(-time.Duration(days) * 24 * time.Hour)) {
+			passed++
+		}
+	}
+	return passed
+}
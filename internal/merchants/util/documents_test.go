@@ -0,0 +1,65 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	schema "rival/gen/sql"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestValidateDocumentNumber(t *testing.T) {
+	valid := map[string]string{
+		DocPAN:   "ABCDE1234F",
+		DocGST:   "27ABCDE1234F1Z5",
+		DocFSSAI: "12345678901234",
+	}
+	for docType, number := range valid {
+		if err := ValidateDocumentNumber(docType, number); err != nil {
+			t.Errorf("expected %s %s to be valid: %v", docType, number, err)
+		}
+	}
+
+	invalid := map[string]string{
+		DocPAN:   "ABCD1234F",
+		DocGST:   "27ABCDE1234F1X5",
+		DocFSSAI: "1234567890123",
+	}
+	for docType, number := range invalid {
+		if err := ValidateDocumentNumber(docType, number); err == nil {
+			t.Errorf("expected %s %s to be rejected", docType, number)
+		}
+	}
+}
+
+func TestMissingDocuments(t *testing.T) {
+	now := time.Now()
+	docs := []schema.MerchantDocument{
+		{DocType: DocFSSAI, Status: DocStatusVerified, ExpiresAt: pgtype.Timestamp{Time: now.Add(-time.Hour), Valid: true}},
+		{DocType: DocGST, Status: DocStatusUploaded},
+		{DocType: DocGST, Status: DocStatusVerified},
+		{DocType: DocPAN, Status: DocStatusVerified},
+	}
+
+	missing := MissingDocuments(docs, now)
+	if len(missing) != 2 || missing[0] != DocGST || missing[1] != DocFSSAI {
+		t.Errorf("expected gst (pending re-upload) and fssai (expired) to be missing, got %v", missing)
+	}
+}
+
+func TestRemindersPassed(t *testing.T) {
+	now := time.Now()
+	days := []int{30, 7}
+
+	cases := map[time.Duration]int{
+		60 * 24 * time.Hour: 0,
+		20 * 24 * time.Hour: 1,
+		3 * 24 * time.Hour:  2,
+	}
+	for untilExpiry, want := range cases {
+		if got := RemindersPassed(now.Add(untilExpiry), now, days); got != want {
+			t.Errorf("expiry in %v: got %d reminders, want %d", untilExpiry, got, want)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package util
+
+// Merchant lifecycle states
+const (
+	StatusDraft       = "draft"
+	StatusSubmitted   = "submitted"
+	StatusUnderReview = "under_review"
+	StatusApproved    = "approved"
+	StatusRejected    = "rejected"
+	StatusSuspended   = "suspended"
+)
+
+// merchantTransitions lists where a merchant may move from each state. A
+// rejected merchant can fix its details and resubmit, and reinstating a
+// suspended merchant moves it back to approved.
+var merchantTransitions = map[string][]string{
+	StatusDraft:       {StatusSubmitted},
+	StatusSubmitted:   {StatusUnderReview, StatusApproved, StatusRejected},
+	StatusUnderReview: {StatusApproved, StatusRejected},
+	StatusRejected:    {StatusSubmitted},
+	StatusApproved:    {StatusSuspended},
+	StatusSuspended:   {StatusApproved},
+}
+
+func CanTransition(from, to string) bool {
+	for _, next := range merchantTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// IsApproved reports whether a merchant may take payments and publish offers.
+func IsApproved(status string) bool {
+	return status == StatusApproved
+}
+
+// IsPending reports whether a merchant is waiting on an admin decision.
+func IsPending(status string) bool {
+	return status == StatusSubmitted || status == StatusUnderReview
+}
@@ -0,0 +1,28 @@
+package util
+
+import "testing"
+
+func TestCanTransition(t *testing.T) {
+	cases := []struct {
+		from, to string
+		want     bool
+	}{
+		{StatusDraft, StatusSubmitted, true},
+		{StatusDraft, StatusApproved, false},
+		{StatusSubmitted, StatusUnderReview, true},
+		{StatusUnderReview, StatusApproved, true},
+		{StatusUnderReview, StatusRejected, true},
+		{StatusRejected, StatusSubmitted, true},
+		{StatusRejected, StatusApproved, false},
+		{StatusApproved, StatusSuspended, true},
+		{StatusSuspended, StatusApproved, true},
+		{StatusSuspended, StatusDraft, false},
+		{StatusApproved, StatusApproved, false},
+	}
+
+	for _, c := range cases {
+		if got := CanTransition(c.from, c.to); got != c.want {
+			t.Errorf("CanTransition(%s, %s) = %v, want %v", c.from, c.to, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package util
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// Staff roles
+const (
+	RoleOwner   = "owner"
+	RoleManager = "manager"
+	RoleCashier = "cashier"
+)
+
+// Staff permissions. Each merchant RPC requires one of these, see
+// staffPermissions in the auth middleware.
+const (
+	PermViewMerchant   = "merchant:view"
+	PermManageMerchant = "merchant:manage"
+	PermViewOrders     = "orders:view"
+	PermUpdateOrders   = "orders:update"
+	PermManageCatalog  = "catalog:manage"
+	PermManageOffers   = "offers:manage"
+	PermManageReviews  = "reviews:manage" // replying to customer reviews
+	PermViewReports    = "reports:view"
+	PermManageStaff    = "staff:manage"
+	PermManageAPIKeys  = "api_keys:manage"
+	PermManageKYC      = "kyc:manage"
+	PermSettlements    = "settlements:manage" // payouts and the bank account they go to
+)
+
+var cashierPermissions = []string{
+	PermViewMerchant,
+	PermViewOrders,
+	PermUpdateOrders,
+}
+
+var managerPermissions = append([]string{
+	PermManageMerchant,
+	PermManageCatalog,
+	PermManageOffers,
+	PermManageReviews,
+	PermViewReports,
+}, cashierPermissions...)
+
+var rolePermissions = map[string][]string{
+	RoleCashier: cashierPermissions,
+	RoleManager: managerPermissions,
+	RoleOwner: append([]string{
+		PermManageStaff,
+		PermManageAPIKeys,
+		PermManageKYC,
+		PermSettlements,
+	}, managerPermissions...),
+}
+
+func IsValidRole(role string) bool {
+	_, ok := rolePermissions[role]
+	return ok
+}
+
+func RoleHasPermission(role, permission string) bool {
+	for _, p := range rolePermissions[role] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// OutletAllowed reports whether a member limited to outletIDs may act on an
+// address. Members without outlets work everywhere; limited members can't act
+// on an unspecified (0) address.
+func OutletAllowed(outletIDs []int64, addressID int64) bool {
+	if len(outletIDs) == 0 {
+		return true
+	}
+	for _, id := range outletIDs {
+		if id == addressID {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateInvitationToken returns an invitation token and the hash to store.
+func GenerateInvitationToken() (token, hash string, err error) {
+	tokenBytes := make([]byte, 24)
+	if _, err = rand.Read(tokenBytes); err != nil {
+		return "", "", err
+	}
+	token = hex.EncodeToString(tokenBytes)
+	return token, HashInvitationToken(token), nil
+}
+
+func HashInvitationToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
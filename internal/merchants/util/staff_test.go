@@ -0,0 +1,48 @@
+package util
+
+import "testing"
+
+func TestRolePermissions(t *testing.T) {
+	cases := []struct {
+		role       string
+		permission string
+		allowed    bool
+	}{
+		{RoleCashier, PermUpdateOrders, true},
+		{RoleCashier, PermManageCatalog, false},
+		{RoleCashier, PermSettlements, false},
+		{RoleManager, PermManageCatalog, true},
+		{RoleManager, PermSettlements, false},
+		{RoleManager, PermManageStaff, false},
+		{RoleOwner, PermSettlements, true},
+		{RoleOwner, PermUpdateOrders, true},
+		{"waiter", PermViewOrders, false},
+	}
+	for _, tc := range cases {
+		if got := RoleHasPermission(tc.role, tc.permission); got != tc.allowed {
+			t.Errorf("RoleHasPermission(%s, %s) = %v, want %v", tc.role, tc.permission, got, tc.allowed)
+		}
+	}
+}
+
+func TestOutletAllowed(t *testing.T) {
+	if !OutletAllowed(nil, 0) || !OutletAllowed(nil, 7) {
+		t.Error("members without outlets should work everywhere")
+	}
+	if !OutletAllowed([]int64{3, 7}, 7) {
+		t.Error("expected assigned outlet to be allowed")
+	}
+	if OutletAllowed([]int64{3}, 7) || OutletAllowed([]int64{3}, 0) {
+		t.Error("expected other and unspecified outlets to be denied")
+	}
+}
+
+func TestInvitationToken(t *testing.T) {
+	token, hash, err := GenerateInvitationToken()
+	if err != nil {
+		t.Fatalf("GenerateInvitationToken failed: %v", err)
+	}
+	if HashInvitationToken(token) != hash || token == hash {
+		t.Error("expected the stored hash to match the token")
+	}
+}
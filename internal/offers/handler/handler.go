@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"context"
+
+	offerpb "rival/gen/proto/proto/api"
+	merchantrepo "rival/internal/merchants/repo"
+	merchantservice "rival/internal/merchants/service"
+	"rival/internal/offers/repo"
+	"rival/internal/offers/service"
+	"rival/pkg/geo"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type OfferHandler struct {
+	offerpb.UnimplementedOfferServiceServer
+	service service.OfferService
+}
+
+func NewOfferHandler() (*OfferHandler, error) {
+	repository, err := repo.NewOfferRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	hoursRepository, err := merchantrepo.NewHoursRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	offerService := service.NewOfferService(repository, merchantservice.NewHoursService(hoursRepository))
+
+	return &OfferHandler{
+		service: offerService,
+	}, nil
+}
+
+func (h *OfferHandler) GetNearbyOffers(ctx context.Context, req *offerpb.GetNearbyOffersRequest) (*offerpb.GetNearbyOffersResponse, error) {
+	center := geo.Coordinates{Latitude: req.Latitude, Longitude: req.Longitude}
+	if err := geo.ValidateCoordinates(center); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if !service.IsValidSort(req.Sort) {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown sort %q", req.Sort)
+	}
+
+	return h.service.GetNearbyOffers(ctx, center, req.RadiusKm, req.Sort)
+}
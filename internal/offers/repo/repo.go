@@ -0,0 +1,34 @@
+package repo
+
+import (
+	"context"
+
+	"rival/config"
+	"rival/connection"
+	schema "rival/gen/sql"
+)
+
+type OfferRepository interface {
+	ListOffersInArea(ctx context.Context, params schema.ListOffersInAreaParams) ([]schema.ListOffersInAreaRow, error)
+}
+
+type offerRepository struct {
+	queries *schema.Queries
+}
+
+func NewOfferRepository() (OfferRepository, error) {
+	cfg := config.GetConfig()
+
+	db, err := connection.GetPgConnection(&cfg.Database)
+	if err != nil {
+		return nil, err
+	}
+
+	return &offerRepository{
+		queries: schema.New(db),
+	}, nil
+}
+
+func (r *offerRepository) ListOffersInArea(ctx context.Context, params schema.ListOffersInAreaParams) ([]schema.ListOffersInAreaRow, error) {
+	return r.queries.ListOffersInArea(ctx, params)
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	offerpb "rival/gen/proto/proto/api"
+	schemapb "rival/gen/proto/proto/schema"
+	schema "rival/gen/sql"
+	merchantservice "rival/internal/merchants/service"
+	"rival/internal/offers/repo"
+	reviewutil "rival/internal/reviews/util"
+	"rival/pkg/geo"
+	"rival/pkg/utils"
+)
+
+const (
+	defaultRadiusKm = 5.0
+	maxRadiusKm     = 50.0
+)
+
+// Nearby offer orderings
+const (
+	SortDistance = "distance"
+	SortRating   = "rating" // best rated merchant first, then nearest
+)
+
+func IsValidSort(sortBy string) bool {
+	return sortBy == "" || sortBy == SortDistance || sortBy == SortRating
+}
+
+type OfferService interface {
+	GetNearbyOffers(ctx context.Context, center geo.Coordinates, radiusKm float64, sortBy string) (*offerpb.GetNearbyOffersResponse, error)
+}
+
+type offerService struct {
+	repo  repo.OfferRepository
+	hours merchantservice.HoursService
+}
+
+func NewOfferService(repo repo.OfferRepository, hours merchantservice.HoursService) OfferService {
+	return &offerService{repo: repo, hours: hours}
+}
+
+// GetNearbyOffers returns live offers from merchants that are open right now,
+// nearest branch first unless sorted by rating.
+func (s *offerService) GetNearbyOffers(ctx context.Context, center geo.Coordinates, radiusKm float64, sortBy string) (*offerpb.GetNearbyOffersResponse, error) {
+	if radiusKm <= 0 {
+		radiusKm = defaultRadiusKm
+	}
+	if radiusKm > maxRadiusKm {
+		radiusKm = maxRadiusKm
+	}
+
+	min, max := geo.BoundingBox(center, radiusKm)
+	rows, err := s.repo.ListOffersInArea(ctx, schema.ListOffersInAreaParams{
+		MinLat: min.Latitude,
+		MaxLat: max.Latitude,
+		MinLng: min.Longitude,
+		MaxLng: max.Longitude,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get offers: %w", err)
+	}
+
+	now := time.Now()
+	openNow := make(map[int64]bool)
+	nearest := make(map[int64]*schemapb.Offer)
+
+	for _, row := range rows {
+		distance := geo.DistanceKm(center, geo.Coordinates{Latitude: row.Latitude, Longitude: row.Longitude})
+		if distance > radiusKm {
+			continue
+		}
+
+		merchantID := row.Offer.MerchantID.Int64
+		open, checked := openNow[merchantID]
+		if !checked {
+			open, _, err = s.hours.IsOpen(ctx, int(merchantID), now)
+			if err != nil {
+				return nil, err
+			}
+			openNow[merchantID] = open
+		}
+		if !open {
+			continue
+		}
+
+		// A merchant with several branches in range lists the offer once
+		if existing, ok := nearest[row.Offer.ID]; ok && existing.DistanceKm <= distance {
+			continue
+		}
+		offer := convertToProtoOffer(row.Offer)
+		offer.DistanceKm = distance
+		offer.MerchantRatingAverage = reviewutil.AverageRating(row.RatingTotal, row.RatingCount)
+		offer.MerchantRatingCount = row.RatingCount
+		nearest[row.Offer.ID] = offer
+	}
+
+	offers := make([]*schemapb.Offer, 0, len(nearest))
+	for _, offer := range nearest {
+		offers = append(offers, offer)
+	}
+	sort.Slice(offers, func(i, j int) bool {
+		if sortBy == SortRating {
+			if offers[i].MerchantRatingAverage != offers[j].MerchantRatingAverage {
+				return offers[i].MerchantRatingAverage > offers[j].MerchantRatingAverage
+			}
+			if offers[i].MerchantRatingCount != offers[j].MerchantRatingCount {
+				return offers[i].MerchantRatingCount > offers[j].MerchantRatingCount
+			}
+		}
+		if offers[i].DistanceKm != offers[j].DistanceKm {
+			return offers[i].DistanceKm < offers[j].DistanceKm
+		}
+		return offers[i].Id < offers[j].Id
+	})
+
+	return &offerpb.GetNearbyOffersResponse{
+		Offers: offers,
+	}, nil
+}
+
+func convertToProtoOffer(offer schema.Offer) *schemapb.Offer {
+	protoOffer := &schemapb.Offer{
+		Id:                 offer.ID,
+		MerchantId:         offer.MerchantID.Int64,
+		Title:              offer.Title,
+		Description:        offer.Description.String,
+		DiscountPercentage: utils.NumericToFloat64(offer.DiscountPercentage),
+		MinAmount:          utils.NumericToFloat64(offer.MinAmount),
+		MaxDiscount:        utils.NumericToFloat64(offer.MaxDiscount),
+		IsActive:           offer.IsActive.Bool,
+		ValidFrom:          offer.ValidFrom.Time.Unix(),
+		CreatedAt:          offer.CreatedAt.Time.Unix(),
+		UpdatedAt:          offer.UpdatedAt.Time.Unix(),
+	}
+	if offer.ValidUntil.Valid {
+		protoOffer.ValidUntil = offer.ValidUntil.Time.Unix()
+	}
+	return protoOffer
+}
@@ -5,15 +5,25 @@ import (
 	"fmt"
 
 	orderpb "rival/gen/proto/proto/api"
+	merchantrepo "rival/internal/merchants/repo"
+	merchantservice "rival/internal/merchants/service"
 	"rival/internal/orders/repo"
 	"rival/internal/orders/service"
 	"rival/internal/orders/util"
+	receiptrepo "rival/internal/receipts/repo"
+	receiptservice "rival/internal/receipts/service"
+	"rival/pkg/notify"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type OrderHandler struct {
 	orderpb.UnimplementedOrderServiceServer
-	service service.OrderService
-	pubsub  util.OrderPubSubService
+	service  service.OrderService
+	timers   service.TimerService
+	receipts receiptservice.ReceiptService
+	pubsub   util.OrderPubSubService
 }
 
 func NewOrderHandler() (*OrderHandler, error) {
@@ -22,17 +32,40 @@ func NewOrderHandler() (*OrderHandler, error) {
 		return nil, err
 	}
 
-	orderService := service.NewOrderService(repository)
+	hoursRepository, err := merchantrepo.NewHoursRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	catalogRepository, err := merchantrepo.NewCatalogRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	notifier, err := notify.NewServiceFromConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	orderService := service.NewOrderService(repository, merchantservice.NewHoursService(hoursRepository), merchantservice.NewCatalogService(catalogRepository), notifier)
+	receiptRepository, err := receiptrepo.NewReceiptRepository()
+	if err != nil {
+		return nil, err
+	}
+
 	pubsubService := util.NewOrderPubSubService()
 
 	return &OrderHandler{
-		service: orderService,
-		pubsub:  pubsubService,
+		service:  orderService,
+		timers:   service.NewTimerService(repository, orderService, notifier),
+		receipts: receiptservice.NewReceiptService(receiptRepository),
+		pubsub:   pubsubService,
 	}, nil
 }
 
 func (h *OrderHandler) CreateOrder(ctx context.Context, req *orderpb.CreateOrderRequest) (*orderpb.CreateOrderResponse, error) {
-	if req.Subtotal <= 0 {
+	// Catalog orders are priced by the server
+	if len(req.LineItems) == 0 && req.Subtotal <= 0 {
 		return nil, fmt.Errorf("subtotal must be greater than 0")
 	}
 
@@ -61,6 +94,41 @@ func (h *OrderHandler) CancelOrder(ctx context.Context, req *orderpb.CancelOrder
 	return h.service.CancelOrder(ctx, req)
 }
 
+// ConfirmOrder lets a customer accept an order a merchant created for them.
+func (h *OrderHandler) ConfirmOrder(ctx context.Context, req *orderpb.ConfirmOrderRequest) (*orderpb.ConfirmOrderResponse, error) {
+	if req.OrderId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "order ID is required")
+	}
+
+	return h.service.ConfirmOrder(ctx, req)
+}
+
+// GetReceipt returns the receipt of a completed order to its customer, or to
+// the merchant when called with an API key.
+func (h *OrderHandler) GetReceipt(ctx context.Context, req *orderpb.GetReceiptRequest) (*orderpb.GetReceiptResponse, error) {
+	if req.OrderId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "order ID is required")
+	}
+
+	var viewer receiptservice.Viewer
+	if authType, _ := ctx.Value("auth_type").(string); authType == "api_key" {
+		merchantID, _ := ctx.Value("merchant_id").(int)
+		viewer.MerchantID = int64(merchantID)
+	} else {
+		userID, _ := ctx.Value("user_id").(int)
+		viewer.UserID = int64(userID)
+	}
+	if viewer.UserID == 0 && viewer.MerchantID == 0 {
+		return nil, status.Error(codes.Unauthenticated, "sign in to view receipts")
+	}
+
+	receipt, err := h.receipts.OrderReceipt(ctx, req.OrderId, viewer)
+	if err != nil {
+		return nil, err
+	}
+	return &orderpb.GetReceiptResponse{Receipt: receipt}, nil
+}
+
 func (h *OrderHandler) StreamOrderUpdates(req *orderpb.StreamOrderUpdatesRequest, stream orderpb.OrderService_StreamOrderUpdatesServer) error {
 	ch := h.pubsub.SubscribeOrderUpdates(int(req.UserId))
 	defer ch.Close()
@@ -74,3 +142,8 @@ func (h *OrderHandler) StreamOrderUpdates(req *orderpb.StreamOrderUpdatesRequest
 	}
 	return nil
 }
+
+// StartOrderTimers blocks, auto-rejecting and escalating orders that miss their SLA.
+func (h *OrderHandler) StartOrderTimers(ctx context.Context) {
+	h.timers.StartTimers(ctx)
+}
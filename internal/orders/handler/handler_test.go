@@ -10,7 +10,15 @@ import (
 	schemapb "rival/gen/proto/proto/schema"
 	schema "rival/gen/sql"
 	authHandler "rival/internal/auth/handler"
+	"rival/internal/orders/service"
+	"rival/internal/orders/util"
+	"rival/pkg/audit"
+	"rival/pkg/tb"
+	"rival/pkg/utils"
 	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 // NewOrderUser creates a test user for order testing
@@ -23,9 +31,9 @@ func NewOrderUser(ctx context.Context, email string, role schemapb.UserRole, t *
 	data := pb.SignupRequest{
 		Name:     "Test Order User",
 		Email:    email,
-		Password: "password123",
+		Password: "Rival-Passw0rd",
 		Role:     role,
-		Phone:    "12345678",
+		Phone:    "9876543210",
 	}
 
 	_, err = handler.Signup(ctx, &data)
@@ -75,6 +83,7 @@ func TestCreateOrder_ValidRequest(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Failed to create handler: %v", err)
 	}
+	ctx = context.WithValue(ctx, "user_id", int(customer.ID))
 
 	// Test creating order
 	req := &orderpb.CreateOrderRequest{
@@ -102,6 +111,55 @@ func TestCreateOrder_ValidRequest(t *testing.T) {
 	t.Logf("Create order response: %+v", resp)
 }
 
+func TestCreateOrder_OrderNumbers(t *testing.T) {
+	ctx := context.Background()
+
+	_, repo, customer := NewOrderUser(ctx, "test-order-numbers@example.com", schemapb.UserRole_USER_ROLE_CUSTOMER, t)
+	defer func() {
+		err := repo.DleteUser(ctx, customer.ID)
+		if err != nil {
+			t.Logf("Failed to cleanup customer: %v", err)
+		}
+	}()
+
+	_, repo2, merchant := NewOrderUser(ctx, "test-order-numbers-merchant@example.com", schemapb.UserRole_USER_ROLE_MERCHANT, t)
+	merchantRecord := CreateMerchantRecord(ctx, merchant, repo2, t)
+	defer func() {
+		CleanupMerchant(ctx, merchantRecord.Email, repo2, t)
+		err := repo2.DleteUser(ctx, merchant.ID)
+		if err != nil {
+			t.Logf("Failed to cleanup merchant: %v", err)
+		}
+	}()
+
+	h, err := NewOrderHandler()
+	if err != nil {
+		t.Fatalf("Failed to create handler: %v", err)
+	}
+	ctx = context.WithValue(ctx, "user_id", int(customer.ID))
+
+	// Orders placed in the same second used to collide on the order number
+	var numbers []string
+	for i := 0; i < 3; i++ {
+		resp, err := h.CreateOrder(ctx, &orderpb.CreateOrderRequest{
+			UserId:     customer.ID,
+			MerchantId: merchantRecord.ID,
+			Items:      `[{"name":"Coffee","quantity":1}]`,
+			Subtotal:   100,
+		})
+		if err != nil {
+			t.Fatalf("Failed to create order %d: %v", i, err)
+		}
+		numbers = append(numbers, resp.Order.OrderNumber)
+	}
+
+	for i, number := range numbers {
+		if number != util.FormatOrderNumber(int64(i+1)) {
+			t.Errorf("Expected order %d to be numbered %s, got %s", i, util.FormatOrderNumber(int64(i+1)), number)
+		}
+	}
+}
+
 func TestCreateOrder_ZeroSubtotal(t *testing.T) {
 	ctx := context.Background()
 	h, err := NewOrderHandler()
@@ -174,6 +232,7 @@ func TestGetOrder(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Failed to create handler: %v", err)
 	}
+	ctx = context.WithValue(ctx, "user_id", int(customer.ID))
 
 	// Test getting order
 	req := &orderpb.GetOrderRequest{
@@ -208,6 +267,7 @@ func TestGetUserOrders_WithDefaults(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Failed to create handler: %v", err)
 	}
+	ctx = context.WithValue(ctx, "user_id", int(customer.ID))
 
 	// Test with invalid pagination - should apply defaults
 	req := &orderpb.GetUserOrdersRequest{
@@ -252,6 +312,7 @@ func TestGetUserOrders_WithValidPagination(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Failed to create handler: %v", err)
 	}
+	ctx = context.WithValue(ctx, "user_id", int(customer.ID))
 
 	// Test with valid pagination
 	req := &orderpb.GetUserOrdersRequest{
@@ -292,27 +353,301 @@ func TestCancelOrder(t *testing.T) {
 		}
 	}()
 
+	_, repo2, merchant := NewOrderUser(ctx, "test-cancel-order-merchant@example.com", schemapb.UserRole_USER_ROLE_MERCHANT, t)
+	merchantRecord := CreateMerchantRecord(ctx, merchant, repo2, t)
+	defer func() {
+		CleanupMerchant(ctx, merchantRecord.Email, repo2, t)
+		err := repo2.DleteUser(ctx, merchant.ID)
+		if err != nil {
+			t.Logf("Failed to cleanup merchant: %v", err)
+		}
+	}()
+
 	h, err := NewOrderHandler()
 	if err != nil {
 		t.Fatalf("Failed to create handler: %v", err)
 	}
+	ctx = context.WithValue(ctx, "user_id", int(customer.ID))
+
+	createResp, err := h.CreateOrder(ctx, &orderpb.CreateOrderRequest{
+		UserId:     customer.ID,
+		MerchantId: merchantRecord.ID,
+		Items:      `[{"name":"Coffee","quantity":1}]`,
+		Subtotal:   100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create order: %v", err)
+	}
 
-	// Test canceling order
 	req := &orderpb.CancelOrderRequest{
-		OrderId: 456, // Use int64 instead of string
+		OrderId: createResp.Order.Id,
 		Reason:  "Customer requested cancellation",
 	}
 
-	resp, err := h.CancelOrder(ctx, req)
+	// Only the customer who placed the order can cancel it
+	if _, err := h.CancelOrder(context.WithValue(ctx, "user_id", int(merchant.ID)), req); err == nil {
+		t.Errorf("Expected another user's cancellation to be rejected")
+	}
+
+	customerCtx := context.WithValue(ctx, "user_id", int(customer.ID))
+	resp, err := h.CancelOrder(customerCtx, req)
 	if err != nil {
 		t.Fatalf("CancelOrder returned error: %v", err)
 	}
+	if resp == nil || !resp.Success {
+		t.Fatalf("CancelOrder returned %+v", resp)
+	}
 
-	if resp == nil {
-		t.Fatalf("CancelOrder returned nil response")
+	getResp, err := h.GetOrder(ctx, &orderpb.GetOrderRequest{OrderId: createResp.Order.Id})
+	if err != nil {
+		t.Fatalf("Failed to get order: %v", err)
+	}
+	if getResp.Order.Status != "cancelled" || getResp.Order.CancelledAt == 0 || getResp.Order.StatusReason != req.Reason {
+		t.Errorf("Expected cancelled order with reason, got %+v", getResp.Order)
+	}
+
+	// Cancelled orders are final
+	if _, err := h.CancelOrder(customerCtx, req); err == nil {
+		t.Errorf("Expected second cancellation to be rejected")
+	}
+}
+
+func TestCreateOrder_ChargesCoins(t *testing.T) {
+	ctx := context.Background()
+
+	_, repo, customer := NewOrderUser(ctx, "test-order-coins@example.com", schemapb.UserRole_USER_ROLE_CUSTOMER, t)
+	defer func() {
+		err := repo.DleteUser(ctx, customer.ID)
+		if err != nil {
+			t.Logf("Failed to cleanup customer: %v", err)
+		}
+	}()
+
+	_, repo2, merchant := NewOrderUser(ctx, "test-order-coins-merchant@example.com", schemapb.UserRole_USER_ROLE_MERCHANT, t)
+	merchantRecord := CreateMerchantRecord(ctx, merchant, repo2, t)
+	defer func() {
+		CleanupMerchant(ctx, merchantRecord.Email, repo2, t)
+		err := repo2.DleteUser(ctx, merchant.ID)
+		if err != nil {
+			t.Logf("Failed to cleanup merchant: %v", err)
+		}
+	}()
+
+	ledger, err := tb.NewService()
+	if err != nil {
+		t.Fatalf("Failed to create tigerbeetle service: %v", err)
+	}
+	if err := ledger.AddCoins(int(customer.ID), 50); err != nil {
+		t.Fatalf("Failed to add coins: %v", err)
+	}
+	before, err := ledger.GetBalance(int(customer.ID))
+	if err != nil {
+		t.Fatalf("Failed to get balance: %v", err)
+	}
+
+	h, err := NewOrderHandler()
+	if err != nil {
+		t.Fatalf("Failed to create handler: %v", err)
+	}
+	ctx = context.WithValue(ctx, "user_id", int(customer.ID))
+
+	req := &orderpb.CreateOrderRequest{
+		UserId:     customer.ID,
+		MerchantId: merchantRecord.ID,
+		Items:      `[{"name":"Coffee","quantity":1}]`,
+		Subtotal:   100,
+	}
+
+	// More coins than the customer holds
+	req.CoinsUsed = before + 1
+	if _, err := h.CreateOrder(ctx, req); err == nil {
+		t.Errorf("Expected order exceeding the balance to be rejected")
+	}
+
+	req.CoinsUsed = 40
+	createResp, err := h.CreateOrder(ctx, req)
+	if err != nil {
+		t.Fatalf("Failed to create order: %v", err)
+	}
+
+	payment, err := repo.GetOrderPayment(ctx, pgtype.Int8{Int64: createResp.Order.Id, Valid: true})
+	if err != nil {
+		t.Fatalf("Expected order payment to be recorded: %v", err)
+	}
+	if utils.NumericToFloat64(payment.CoinsSpent) != 40 {
+		t.Errorf("Expected 40 coins charged, got %+v", payment)
+	}
+	if balance, _ := ledger.GetBalance(int(customer.ID)); balance != before-40 {
+		t.Errorf("Expected balance %.2f after order, got %.2f", before-40, balance)
+	}
+
+	// Cancelling hands the coins back
+	_, err = h.CancelOrder(context.WithValue(ctx, "user_id", int(customer.ID)), &orderpb.CancelOrderRequest{
+		OrderId: createResp.Order.Id,
+		Reason:  "Changed my mind",
+	})
+	if err != nil {
+		t.Fatalf("CancelOrder returned error: %v", err)
+	}
+	if balance, _ := ledger.GetBalance(int(customer.ID)); balance != before {
+		t.Errorf("Expected balance %.2f after cancel, got %.2f", before, balance)
+	}
+}
+
+func TestGetReceipt(t *testing.T) {
+	ctx := context.Background()
+
+	_, repo, customer := NewOrderUser(ctx, "test-receipt-customer@example.com", schemapb.UserRole_USER_ROLE_CUSTOMER, t)
+	defer func() {
+		err := repo.DleteUser(ctx, customer.ID)
+		if err != nil {
+			t.Logf("Failed to cleanup customer: %v", err)
+		}
+	}()
+
+	_, repo2, merchant := NewOrderUser(ctx, "test-receipt-merchant@example.com", schemapb.UserRole_USER_ROLE_MERCHANT, t)
+	merchantRecord := CreateMerchantRecord(ctx, merchant, repo2, t)
+	defer func() {
+		CleanupMerchant(ctx, merchantRecord.Email, repo2, t)
+		err := repo2.DleteUser(ctx, merchant.ID)
+		if err != nil {
+			t.Logf("Failed to cleanup merchant: %v", err)
+		}
+	}()
+
+	h, err := NewOrderHandler()
+	if err != nil {
+		t.Fatalf("Failed to create handler: %v", err)
+	}
+	ctx = context.WithValue(ctx, "user_id", int(customer.ID))
+
+	createResp, err := h.CreateOrder(ctx, &orderpb.CreateOrderRequest{
+		UserId:     customer.ID,
+		MerchantId: merchantRecord.ID,
+		Items:      `[{"name":"Coffee","quantity":2,"price":50}]`,
+		Subtotal:   100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create order: %v", err)
+	}
+
+	customerCtx := context.WithValue(ctx, "user_id", int(customer.ID))
+	req := &orderpb.GetReceiptRequest{OrderId: createResp.Order.Id}
+
+	// Receipts wait for the order to be completed
+	if _, err := h.GetReceipt(customerCtx, req); err == nil {
+		t.Errorf("Expected receipt of a pending order to be refused")
+	}
+
+	for _, to := range []string{util.StatusAccepted, util.StatusPreparing, util.StatusReady, util.StatusCompleted} {
+		_, err := h.service.Transition(ctx, service.StatusChange{
+			OrderID:    int(createResp.Order.Id),
+			To:         to,
+			ActorID:    merchant.ID,
+			ActorType:  audit.ActorMerchant,
+			MerchantID: int(merchantRecord.ID),
+		})
+		if err != nil {
+			t.Fatalf("Failed to move order to %s: %v", to, err)
+		}
 	}
 
-	t.Logf("Cancel order response: %+v", resp)
+	first, err := h.GetReceipt(customerCtx, req)
+	if err != nil {
+		t.Fatalf("GetReceipt returned error: %v", err)
+	}
+	if first.Receipt.InvoiceNumber == "" || first.Receipt.PdfUrl == "" || first.Receipt.HtmlUrl == "" {
+		t.Errorf("Expected a numbered receipt with download links, got %+v", first.Receipt)
+	}
+
+	// The receipt is issued once
+	second, err := h.GetReceipt(customerCtx, req)
+	if err != nil {
+		t.Fatalf("GetReceipt returned error: %v", err)
+	}
+	if second.Receipt.Id != first.Receipt.Id || second.Receipt.InvoiceNumber != first.Receipt.InvoiceNumber {
+		t.Errorf("Expected the same receipt, got %+v and %+v", first.Receipt, second.Receipt)
+	}
+
+	if _, err := h.GetReceipt(context.WithValue(ctx, "user_id", int(merchant.ID)), req); err == nil {
+		t.Errorf("Expected another user's receipt to be hidden")
+	}
+}
+
+func TestOrderTimers_AutoReject(t *testing.T) {
+	ctx := context.Background()
+
+	_, repo, customer := NewOrderUser(ctx, "test-timer-customer@example.com", schemapb.UserRole_USER_ROLE_CUSTOMER, t)
+	defer func() {
+		err := repo.DleteUser(ctx, customer.ID)
+		if err != nil {
+			t.Logf("Failed to cleanup customer: %v", err)
+		}
+	}()
+
+	_, repo2, merchant := NewOrderUser(ctx, "test-timer-merchant@example.com", schemapb.UserRole_USER_ROLE_MERCHANT, t)
+	merchantRecord := CreateMerchantRecord(ctx, merchant, repo2, t)
+	defer func() {
+		CleanupMerchant(ctx, merchantRecord.Email, repo2, t)
+		err := repo2.DleteUser(ctx, merchant.ID)
+		if err != nil {
+			t.Logf("Failed to cleanup merchant: %v", err)
+		}
+	}()
+
+	h, err := NewOrderHandler()
+	if err != nil {
+		t.Fatalf("Failed to create handler: %v", err)
+	}
+	ctx = context.WithValue(ctx, "user_id", int(customer.ID))
+
+	createResp, err := h.CreateOrder(ctx, &orderpb.CreateOrderRequest{
+		UserId:     customer.ID,
+		MerchantId: merchantRecord.ID,
+		Items:      `[{"name":"Coffee","quantity":1}]`,
+		Subtotal:   100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create order: %v", err)
+	}
+
+	// Pretend the accept deadline has passed
+	if _, err := repo.ScheduleOrderTimer(ctx, schema.ScheduleOrderTimerParams{
+		OrderID: createResp.Order.Id,
+		Kind:    util.TimerAcceptDeadline,
+		DueAt:   pgtype.Timestamp{Time: time.Now().UTC().Add(-time.Minute), Valid: true},
+	}); err != nil {
+		t.Fatalf("Failed to reschedule timer: %v", err)
+	}
+
+	updates := util.NewOrderPubSubService().SubscribeOrderUpdates(int(customer.ID))
+	defer updates.Close()
+
+	if _, err := h.timers.RunDueTimers(ctx); err != nil {
+		t.Fatalf("RunDueTimers returned error: %v", err)
+	}
+
+	getResp, err := h.GetOrder(ctx, &orderpb.GetOrderRequest{OrderId: createResp.Order.Id})
+	if err != nil {
+		t.Fatalf("Failed to get order: %v", err)
+	}
+	if getResp.Order.Status != util.StatusRejected || getResp.Order.RejectedAt == 0 {
+		t.Errorf("Expected order to be auto-rejected, got %+v", getResp.Order)
+	}
+
+	select {
+	case data := <-updates.Receive():
+		if update, ok := data.(*orderpb.StreamOrderUpdatesResponse); !ok || update.EventType != util.StatusRejected {
+			t.Errorf("Expected rejected event, got %+v", data)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("Expected customer to be notified")
+	}
+
+	// The timer fired once, running again is a no-op
+	if _, err := h.timers.RunDueTimers(ctx); err != nil {
+		t.Fatalf("RunDueTimers returned error: %v", err)
+	}
 }
 
 // Test pagination edge cases
@@ -332,6 +667,7 @@ func TestGetUserOrders_PaginationEdgeCases(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Failed to create handler: %v", err)
 	}
+	ctx = context.WithValue(ctx, "user_id", int(customer.ID))
 
 	testCases := []struct {
 		name          string
@@ -405,6 +741,7 @@ func TestCreateOrder_ItemValidation(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Failed to create handler: %v", err)
 	}
+	ctx = context.WithValue(ctx, "user_id", int(customer.ID))
 
 	testCases := []struct {
 		name        string
@@ -484,6 +821,7 @@ func TestOrderStates(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Failed to create handler: %v", err)
 	}
+	ctx = context.WithValue(ctx, "user_id", int(customer.ID))
 
 	// Test getting orders with different states
 	req := &orderpb.GetUserOrdersRequest{
@@ -536,6 +874,7 @@ func TestOrderFlow_Complete_EndToEnd(t *testing.T) {
 	defer repo2.DleteUser(ctx, merchant.ID)
 	
 	h, _ := NewOrderHandler()
+	ctx = context.WithValue(ctx, "user_id", int(customer.ID))
 	
 	t.Logf("========================================")
 	t.Logf("ORDER END-TO-END TEST")
@@ -603,7 +942,7 @@ func TestOrderFlow_Complete_EndToEnd(t *testing.T) {
 		Reason:  "Customer requested cancellation for E2E test",
 	}
 	
-	_, err = h.CancelOrder(ctx, cancelReq)
+	_, err = h.CancelOrder(context.WithValue(ctx, "user_id", int(customer.ID)), cancelReq)
 	if err != nil {
 		t.Logf("⚠ Cancel order failed: %v", err)
 	} else {
@@ -624,3 +963,212 @@ func TestOrderFlow_Complete_EndToEnd(t *testing.T) {
 	t.Logf("END-TO-END TEST COMPLETED")
 	t.Logf("========================================")
 }
+
+func TestCreateOrder_CatalogLineItems(t *testing.T) {
+	ctx := context.Background()
+
+	_, repo, customer := NewOrderUser(ctx, "test-catalog-customer@example.com", schemapb.UserRole_USER_ROLE_CUSTOMER, t)
+	defer func() {
+		err := repo.DleteUser(ctx, customer.ID)
+		if err != nil {
+			t.Logf("Failed to cleanup customer: %v", err)
+		}
+	}()
+
+	_, repo2, merchant := NewOrderUser(ctx, "test-catalog-order-merchant@example.com", schemapb.UserRole_USER_ROLE_MERCHANT, t)
+	merchantRecord := CreateMerchantRecord(ctx, merchant, repo2, t)
+	defer func() {
+		CleanupMerchant(ctx, merchantRecord.Email, repo2, t)
+		err := repo2.DleteUser(ctx, merchant.ID)
+		if err != nil {
+			t.Logf("Failed to cleanup merchant: %v", err)
+		}
+	}()
+
+	item, err := repo2.CreateCatalogItem(ctx, schema.CreateCatalogItemParams{
+		MerchantID: merchantRecord.ID,
+		Name:       "Croissant",
+		Price:      utils.Float64ToNumeric(95.50),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create catalog item: %v", err)
+	}
+	soldOut, err := repo2.CreateCatalogItem(ctx, schema.CreateCatalogItemParams{
+		MerchantID: merchantRecord.ID,
+		Name:       "Bagel",
+		Price:      utils.Float64ToNumeric(80),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create catalog item: %v", err)
+	}
+	if _, err := repo2.SetCatalogItemAvailability(ctx, schema.SetCatalogItemAvailabilityParams{ID: soldOut.ID, MerchantID: merchantRecord.ID}); err != nil {
+		t.Fatalf("Failed to mark item unavailable: %v", err)
+	}
+
+	h, err := NewOrderHandler()
+	if err != nil {
+		t.Fatalf("Failed to create handler: %v", err)
+	}
+	ctx = context.WithValue(ctx, "user_id", int(customer.ID))
+
+	resp, err := h.CreateOrder(ctx, &orderpb.CreateOrderRequest{
+		UserId:     int64(customer.ID),
+		MerchantId: merchantRecord.ID,
+		LineItems:  []*schemapb.OrderLineItem{{ItemId: item.ID, Quantity: 2}},
+	})
+	if err != nil {
+		t.Fatalf("CreateOrder returned error: %v", err)
+	}
+	if resp.Order.Subtotal != 191 || len(resp.Order.LineItems) != 1 || resp.Order.LineItems[0].Name != "Croissant" {
+		t.Errorf("Expected server priced order of 191.00, got %+v", resp.Order)
+	}
+
+	rejected := []*orderpb.CreateOrderRequest{
+		// Free-form items are not accepted once the merchant has a catalog
+		{UserId: int64(customer.ID), MerchantId: merchantRecord.ID, Items: `[{"name":"Croissant","quantity":1}]`, Subtotal: 1},
+		{UserId: int64(customer.ID), MerchantId: merchantRecord.ID, LineItems: []*schemapb.OrderLineItem{{ItemId: soldOut.ID, Quantity: 1}}},
+		{UserId: int64(customer.ID), MerchantId: merchantRecord.ID, LineItems: []*schemapb.OrderLineItem{{ItemId: item.ID, Quantity: 1, UnitPrice: 50}}},
+		{UserId: int64(customer.ID), MerchantId: merchantRecord.ID, LineItems: []*schemapb.OrderLineItem{{ItemId: item.ID, Quantity: 1}}, Subtotal: 10},
+	}
+	for i, req := range rejected {
+		if _, err := h.CreateOrder(ctx, req); err == nil {
+			t.Errorf("Expected request %d to be rejected", i)
+		}
+	}
+}
+
+func TestOrderOwnership(t *testing.T) {
+	ctx := context.Background()
+
+	_, repo, customer := NewOrderUser(ctx, "test-owner-customer@example.com", schemapb.UserRole_USER_ROLE_CUSTOMER, t)
+	defer repo.DleteUser(ctx, customer.ID)
+
+	_, repo1, other := NewOrderUser(ctx, "test-owner-other@example.com", schemapb.UserRole_USER_ROLE_CUSTOMER, t)
+	defer repo1.DleteUser(ctx, other.ID)
+
+	_, repo2, merchant := NewOrderUser(ctx, "test-owner-merchant@example.com", schemapb.UserRole_USER_ROLE_MERCHANT, t)
+	merchantRecord := CreateMerchantRecord(ctx, merchant, repo2, t)
+	defer func() {
+		CleanupMerchant(ctx, merchantRecord.Email, repo2, t)
+		repo2.DleteUser(ctx, merchant.ID)
+	}()
+
+	h, err := NewOrderHandler()
+	if err != nil {
+		t.Fatalf("Failed to create handler: %v", err)
+	}
+	customerCtx := context.WithValue(ctx, "user_id", int(customer.ID))
+	otherCtx := context.WithValue(ctx, "user_id", int(other.ID))
+
+	req := &orderpb.CreateOrderRequest{
+		UserId:     int64(other.ID),
+		MerchantId: merchantRecord.ID,
+		Items:      `[{"name":"Coffee","quantity":1}]`,
+		Subtotal:   10,
+	}
+	if _, err := h.CreateOrder(customerCtx, req); err == nil {
+		t.Errorf("Expected an order for another customer to be rejected")
+	}
+	if _, err := h.CreateOrder(ctx, req); err == nil {
+		t.Errorf("Expected an order without a signed in customer to be rejected")
+	}
+
+	// Leaving the customer out orders for the caller
+	req.UserId = 0
+	createResp, err := h.CreateOrder(customerCtx, req)
+	if err != nil {
+		t.Fatalf("CreateOrder returned error: %v", err)
+	}
+	if createResp.Order.UserId != int64(customer.ID) {
+		t.Errorf("Expected order for customer %d, got %d", customer.ID, createResp.Order.UserId)
+	}
+
+	getReq := &orderpb.GetOrderRequest{OrderId: createResp.Order.Id}
+	if _, err := h.GetOrder(customerCtx, getReq); err != nil {
+		t.Errorf("GetOrder returned error for the customer: %v", err)
+	}
+	if _, err := h.GetOrder(otherCtx, getReq); err == nil {
+		t.Errorf("Expected another customer to be refused the order")
+	}
+	merchantCtx := context.WithValue(context.WithValue(ctx, "auth_type", "api_key"), "merchant_id", int(merchantRecord.ID))
+	if _, err := h.GetOrder(merchantCtx, getReq); err != nil {
+		t.Errorf("GetOrder returned error for the merchant: %v", err)
+	}
+
+	if _, err := h.GetUserOrders(otherCtx, &orderpb.GetUserOrdersRequest{UserId: int64(customer.ID)}); err == nil {
+		t.Errorf("Expected another customer to be refused the order list")
+	}
+}
+
+func TestCreateOrder_APIKeyAwaitsConfirmation(t *testing.T) {
+	ctx := context.Background()
+
+	_, repo, customer := NewOrderUser(ctx, "test-confirm-customer@example.com", schemapb.UserRole_USER_ROLE_CUSTOMER, t)
+	defer repo.DleteUser(ctx, customer.ID)
+
+	_, repo1, other := NewOrderUser(ctx, "test-confirm-other@example.com", schemapb.UserRole_USER_ROLE_CUSTOMER, t)
+	defer repo1.DleteUser(ctx, other.ID)
+
+	_, repo2, merchant := NewOrderUser(ctx, "test-confirm-merchant@example.com", schemapb.UserRole_USER_ROLE_MERCHANT, t)
+	merchantRecord := CreateMerchantRecord(ctx, merchant, repo2, t)
+	defer func() {
+		CleanupMerchant(ctx, merchantRecord.Email, repo2, t)
+		repo2.DleteUser(ctx, merchant.ID)
+	}()
+
+	ledger, err := tb.NewService()
+	if err != nil {
+		t.Fatalf("Failed to create tigerbeetle service: %v", err)
+	}
+	if err := ledger.AddCoins(int(customer.ID), 50); err != nil {
+		t.Fatalf("Failed to add coins: %v", err)
+	}
+	before, err := ledger.GetBalance(int(customer.ID))
+	if err != nil {
+		t.Fatalf("Failed to get balance: %v", err)
+	}
+
+	h, err := NewOrderHandler()
+	if err != nil {
+		t.Fatalf("Failed to create handler: %v", err)
+	}
+
+	merchantCtx := context.WithValue(context.WithValue(ctx, "auth_type", "api_key"), "merchant_id", int(merchantRecord.ID))
+	createResp, err := h.CreateOrder(merchantCtx, &orderpb.CreateOrderRequest{
+		UserId:     int64(customer.ID),
+		MerchantId: merchantRecord.ID,
+		Items:      `[{"name":"Coffee","quantity":1}]`,
+		Subtotal:   100,
+		CoinsUsed:  20,
+	})
+	if err != nil {
+		t.Fatalf("CreateOrder returned error: %v", err)
+	}
+	if createResp.Order.Status != util.StatusAwaitingCustomer {
+		t.Errorf("Expected status %s, got %s", util.StatusAwaitingCustomer, createResp.Order.Status)
+	}
+	if balance, _ := ledger.GetBalance(int(customer.ID)); balance != before {
+		t.Errorf("Expected no coins charged before confirmation, balance %.2f want %.2f", balance, before)
+	}
+
+	confirmReq := &orderpb.ConfirmOrderRequest{OrderId: createResp.Order.Id}
+	if _, err := h.ConfirmOrder(context.WithValue(ctx, "user_id", int(other.ID)), confirmReq); err == nil {
+		t.Errorf("Expected another customer to be refused the confirmation")
+	}
+
+	customerCtx := context.WithValue(ctx, "user_id", int(customer.ID))
+	confirmResp, err := h.ConfirmOrder(customerCtx, confirmReq)
+	if err != nil {
+		t.Fatalf("ConfirmOrder returned error: %v", err)
+	}
+	if confirmResp.Order.Status != util.StatusPending {
+		t.Errorf("Expected status %s after confirmation, got %s", util.StatusPending, confirmResp.Order.Status)
+	}
+	if balance, _ := ledger.GetBalance(int(customer.ID)); balance != before-20 {
+		t.Errorf("Expected balance %.2f after confirmation, got %.2f", before-20, balance)
+	}
+
+	if _, err := h.ConfirmOrder(customerCtx, confirmReq); err == nil {
+		t.Errorf("Expected a second confirmation to be rejected")
+	}
+}
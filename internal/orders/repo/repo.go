@@ -2,31 +2,62 @@ package repo
 
 import (
 	"context"
+	"fmt"
+	"log"
 
 	"rival/config"
 	"rival/connection"
 	schema "rival/gen/sql"
+	"rival/internal/orders/util"
+	"rival/pkg/tb"
+	"rival/pkg/transaction"
+	"rival/pkg/utils"
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// NewOrder is everything written when an order is placed.
+type NewOrder struct {
+	Order       schema.CreateOrderParams        // OrderNumber and NumberPeriod are filled in here
+	DailyNumber bool                            // restart the merchant's order numbers every day
+	Payment     *schema.CreateTransactionParams // coins charged for the order, nil when none
+	Timers      []schema.ScheduleOrderTimerParams
+}
+
+// StatusTransition is everything written when an order changes status.
+type StatusTransition struct {
+	Status  schema.TransitionOrderStatusParams
+	History schema.CreateOrderStatusHistoryParams
+	Timers  []schema.ScheduleOrderTimerParams
+	Charge  *schema.CreateTransactionParams // coins to charge for the order, if any
+	Refund  bool                            // reverse the coins charged for the order
+}
+
 type OrderRepository interface {
-	CreateOrder(ctx context.Context, params schema.CreateOrderParams) (schema.Order, error)
+	CreateOrder(ctx context.Context, order NewOrder) (schema.Order, error)
 	GetOrderByID(ctx context.Context, id int) (schema.Order, error)
-	GetOrderByNumber(ctx context.Context, orderNumber string) (schema.Order, error)
-	UpdateOrderStatus(ctx context.Context, params schema.UpdateOrderStatusParams) error
+	GetOrderByNumber(ctx context.Context, merchantID int, orderNumber string) (schema.Order, error)
+	TransitionStatus(ctx context.Context, transition StatusTransition) (schema.Order, error)
 	GetUserOrders(ctx context.Context, userID int, limit, offset int32) ([]schema.Order, error)
 	GetUserOrdersByStatus(ctx context.Context, userID int, status string, limit, offset int32) ([]schema.Order, error)
 	GetMerchantOrders(ctx context.Context, merchantID int, limit, offset int32) ([]schema.Order, error)
 	GetMerchantOrdersByStatus(ctx context.Context, merchantID int, status string, limit, offset int32) ([]schema.Order, error)
 	CountUserOrders(ctx context.Context, userID int) (int64, error)
 	CountMerchantOrders(ctx context.Context, merchantID int) (int64, error)
+	ListMerchantOrders(ctx context.Context, params schema.ListMerchantOrdersParams) ([]schema.Order, error)
+	CountFilteredMerchantOrders(ctx context.Context, params schema.CountFilteredMerchantOrdersParams) (int64, error)
+	GetOutlet(ctx context.Context, merchantID int, outletID int64) (schema.MerchantAddress, error)
+	ClaimDueTimers(ctx context.Context, params schema.ClaimDueOrderTimersParams) ([]schema.OrderTimer, error)
+	CompleteTimer(ctx context.Context, params schema.CompleteOrderTimerParams) error
+	FailTimer(ctx context.Context, params schema.FailOrderTimerParams) error
+	GetBalance(ctx context.Context, userID int) (float64, error)
 }
 
 type orderRepository struct {
 	db      *pgxpool.Pool
 	queries *schema.Queries
+	tb      *tb.TbService
 }
 
 func NewOrderRepository() (OrderRepository, error) {
@@ -37,26 +68,123 @@ func NewOrderRepository() (OrderRepository, error) {
 		return nil, err
 	}
 
+	tbService, err := tb.NewService()
+	if err != nil {
+		return nil, err
+	}
+
 	return &orderRepository{
 		db:      db,
 		queries: schema.New(db),
+		tb:      tbService,
 	}, nil
 }
 
-func (r *orderRepository) CreateOrder(ctx context.Context, params schema.CreateOrderParams) (schema.Order, error) {
-	return r.queries.CreateOrder(ctx, params)
+// CreateOrder inserts the order, charges its coins and schedules its timers
+// in one transaction, so an order is never stored without its payment.
+func (r *orderRepository) CreateOrder(ctx context.Context, newOrder NewOrder) (schema.Order, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return schema.Order{}, fmt.Errorf("failed to start transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+
+	number, err := qtx.NextOrderNumber(ctx, schema.NextOrderNumberParams{
+		Daily:      newOrder.DailyNumber,
+		MerchantID: newOrder.Order.MerchantID.Int64,
+	})
+	if err != nil {
+		return schema.Order{}, fmt.Errorf("failed to allocate order number: %v", err)
+	}
+	newOrder.Order.OrderNumber = util.FormatOrderNumber(number.LastValue)
+	newOrder.Order.NumberPeriod = number.Period
+
+	order, err := qtx.CreateOrder(ctx, newOrder.Order)
+	if err != nil {
+		return schema.Order{}, err
+	}
+
+	if err := scheduleTimers(ctx, qtx, order.ID, newOrder.Timers); err != nil {
+		return schema.Order{}, err
+	}
+
+	if newOrder.Payment != nil {
+		payment := *newOrder.Payment
+		payment.OrderID = pgtype.Int8{Int64: order.ID, Valid: true}
+		if _, err := transaction.ChargeOrder(ctx, qtx, r.tb, payment); err != nil {
+			return schema.Order{}, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		// The coins already left the wallet, hand them back
+		if newOrder.Payment != nil {
+			coins := utils.NumericToFloat64(newOrder.Payment.CoinsSpent)
+			if refundErr := r.tb.RefundOrder(int(newOrder.Payment.UserID.Int64), int(newOrder.Payment.MerchantID.Int64), coins, order.ID); refundErr != nil {
+				log.Printf("Failed to refund order %d after a failed commit: %v", order.ID, refundErr)
+			}
+		}
+		return schema.Order{}, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+	return order, nil
 }
 
 func (r *orderRepository) GetOrderByID(ctx context.Context, id int) (schema.Order, error) {
 	return r.queries.GetOrderByID(ctx, int64(id))
 }
 
-func (r *orderRepository) GetOrderByNumber(ctx context.Context, orderNumber string) (schema.Order, error) {
-	return r.queries.GetOrderByNumber(ctx, orderNumber)
+func (r *orderRepository) GetOrderByNumber(ctx context.Context, merchantID int, orderNumber string) (schema.Order, error) {
+	return r.queries.GetOrderByNumber(ctx, schema.GetOrderByNumberParams{
+		MerchantID:  pgtype.Int8{Int64: int64(merchantID), Valid: true},
+		OrderNumber: orderNumber,
+	})
 }
 
-func (r *orderRepository) UpdateOrderStatus(ctx context.Context, params schema.UpdateOrderStatusParams) error {
-	return r.queries.UpdateOrderStatus(ctx, params)
+// TransitionStatus moves the order, records the history row, schedules the
+// timers of the new state and charges or refunds the coins when asked, in one
+// transaction. It returns pgx.ErrNoRows when the order has left FromStatus.
+// Ledger transfers are posted before the commit under IDs derived from the
+// order, so retrying after a failed commit doesn't move the coins twice.
+func (r *orderRepository) TransitionStatus(ctx context.Context, transition StatusTransition) (schema.Order, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return schema.Order{}, fmt.Errorf("failed to start transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+
+	order, err := qtx.TransitionOrderStatus(ctx, transition.Status)
+	if err != nil {
+		return schema.Order{}, err
+	}
+
+	if _, err := qtx.CreateOrderStatusHistory(ctx, transition.History); err != nil {
+		return schema.Order{}, fmt.Errorf("failed to record status history: %v", err)
+	}
+
+	if err := scheduleTimers(ctx, qtx, order.ID, transition.Timers); err != nil {
+		return schema.Order{}, err
+	}
+
+	if transition.Charge != nil {
+		if _, err := transaction.ChargeOrder(ctx, qtx, r.tb, *transition.Charge); err != nil {
+			return schema.Order{}, err
+		}
+	}
+
+	if transition.Refund {
+		if _, err := transaction.RefundOrder(ctx, qtx, r.tb, order.ID); err != nil {
+			return schema.Order{}, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return schema.Order{}, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+	return order, nil
 }
 
 func (r *orderRepository) GetUserOrders(ctx context.Context, userID int, limit, offset int32) ([]schema.Order, error) {
@@ -70,7 +198,7 @@ func (r *orderRepository) GetUserOrders(ctx context.Context, userID int, limit,
 func (r *orderRepository) GetUserOrdersByStatus(ctx context.Context, userID int, status string, limit, offset int32) ([]schema.Order, error) {
 	return r.queries.GetUserOrdersByStatus(ctx, schema.GetUserOrdersByStatusParams{
 		UserID: pgtype.Int8{Int64: int64(userID), Valid: true},
-		Status: pgtype.Text{String: status, Valid: true},
+		Status: status,
 		Limit:  limit,
 		Offset: offset,
 	})
@@ -88,7 +216,7 @@ func (r *orderRepository) GetMerchantOrdersByStatus(ctx context.Context, merchan
 
 	return r.queries.GetMerchantOrdersByStatus(ctx, schema.GetMerchantOrdersByStatusParams{
 		MerchantID: pgtype.Int8{Int64: int64(merchantID), Valid: true},
-		Status:     pgtype.Text{String: status, Valid: true},
+		Status:     status,
 		Limit:      limit,
 		Offset:     offset,
 	})
@@ -101,3 +229,54 @@ func (r *orderRepository) CountUserOrders(ctx context.Context, userID int) (int6
 func (r *orderRepository) CountMerchantOrders(ctx context.Context, merchantID int) (int64, error) {
 	return r.queries.CountMerchantOrders(ctx, pgtype.Int8{Int64: int64(merchantID), Valid: true})
 }
+
+func (r *orderRepository) ListMerchantOrders(ctx context.Context, params schema.ListMerchantOrdersParams) ([]schema.Order, error) {
+	rows, err := r.queries.ListMerchantOrders(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	orders := make([]schema.Order, 0, len(rows))
+	for _, row := range rows {
+		orders = append(orders, row.Order)
+	}
+	return orders, nil
+}
+
+func (r *orderRepository) CountFilteredMerchantOrders(ctx context.Context, params schema.CountFilteredMerchantOrdersParams) (int64, error) {
+	return r.queries.CountFilteredMerchantOrders(ctx, params)
+}
+
+// GetOutlet returns the merchant's address an order is placed at.
+func (r *orderRepository) GetOutlet(ctx context.Context, merchantID int, outletID int64) (schema.MerchantAddress, error) {
+	return r.queries.GetMerchantAddress(ctx, schema.GetMerchantAddressParams{
+		ID:         outletID,
+		MerchantID: pgtype.Int8{Int64: int64(merchantID), Valid: true},
+	})
+}
+
+func scheduleTimers(ctx context.Context, qtx *schema.Queries, orderID int64, timers []schema.ScheduleOrderTimerParams) error {
+	for _, timer := range timers {
+		timer.OrderID = orderID
+		if _, err := qtx.ScheduleOrderTimer(ctx, timer); err != nil {
+			return fmt.Errorf("failed to schedule %s timer: %v", timer.Kind, err)
+		}
+	}
+	return nil
+}
+
+func (r *orderRepository) ClaimDueTimers(ctx context.Context, params schema.ClaimDueOrderTimersParams) ([]schema.OrderTimer, error) {
+	return r.queries.ClaimDueOrderTimers(ctx, params)
+}
+
+func (r *orderRepository) CompleteTimer(ctx context.Context, params schema.CompleteOrderTimerParams) error {
+	return r.queries.CompleteOrderTimer(ctx, params)
+}
+
+func (r *orderRepository) FailTimer(ctx context.Context, params schema.FailOrderTimerParams) error {
+	return r.queries.FailOrderTimer(ctx, params)
+}
+
+func (r *orderRepository) GetBalance(ctx context.Context, userID int) (float64, error) {
+	return r.tb.GetBalance(userID)
+}
@@ -2,17 +2,28 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
-	"strconv"
+	"strings"
 	"time"
 
+	"rival/config"
 	orderpb "rival/gen/proto/proto/api"
 	schemapb "rival/gen/proto/proto/schema"
 	schema "rival/gen/sql"
+	merchantservice "rival/internal/merchants/service"
+	merchantutil "rival/internal/merchants/util"
 	"rival/internal/orders/repo"
+	"rival/internal/orders/util"
+	"rival/pkg/audit"
+	"rival/pkg/notify"
 	"rival/pkg/utils"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type OrderService interface {
@@ -20,54 +31,204 @@ type OrderService interface {
 	GetOrder(ctx context.Context, req *orderpb.GetOrderRequest) (*orderpb.GetOrderResponse, error)
 	GetUserOrders(ctx context.Context, req *orderpb.GetUserOrdersRequest) (*orderpb.GetUserOrdersResponse, error)
 	CancelOrder(ctx context.Context, req *orderpb.CancelOrderRequest) (*orderpb.CancelOrderResponse, error)
+	ConfirmOrder(ctx context.Context, req *orderpb.ConfirmOrderRequest) (*orderpb.ConfirmOrderResponse, error)
+	Transition(ctx context.Context, change StatusChange) (*schemapb.Order, error)
+	ListMerchantOrders(ctx context.Context, filter OrderFilter) (*orderpb.GetOrdersResponse, error)
+}
+
+// StatusChange moves an order along its lifecycle on behalf of an actor.
+type StatusChange struct {
+	OrderID    int
+	To         string
+	ActorID    int64
+	ActorType  string  // audit.ActorUser, audit.ActorMerchant or audit.ActorSystem
+	MerchantID int     // acting merchant, set for audit.ActorMerchant
+	OutletIDs  []int64 // outlets the acting staff member is limited to, empty for all
+	Reason     string
+}
+
+// OrderFilter narrows a merchant's order list. Zero values skip a filter.
+type OrderFilter struct {
+	MerchantID int
+	Status     string
+	From       time.Time
+	To         time.Time
+	OutletIDs  []int64
+	MinAmount  float64
+	Search     string
+	Sort       string // newest, oldest, amount_desc or amount_asc
+	Page       int32
+	Limit      int32
 }
 
 type orderService struct {
-	repo repo.OrderRepository
+	repo           repo.OrderRepository
+	hours          merchantservice.HoursService
+	catalog        merchantservice.CatalogService
+	pubsub         util.OrderPubSubService
+	merchantPubsub merchantutil.MerchantPubSubService
+	notifier       *notify.Service
+	timers         timerConfig
+	numberReset    string
 }
 
-func NewOrderService(repo repo.OrderRepository) OrderService {
-	return &orderService{repo: repo}
+func NewOrderService(repo repo.OrderRepository, hours merchantservice.HoursService, catalog merchantservice.CatalogService, notifier *notify.Service) OrderService {
+	return &orderService{
+		repo:           repo,
+		hours:          hours,
+		catalog:        catalog,
+		notifier:       notifier,
+		pubsub:         util.NewOrderPubSubService(),
+		merchantPubsub: merchantutil.NewMerchantPubSubService(),
+		timers:         loadTimerConfig(),
+		numberReset:    config.GetConfig().Orders.NumberReset,
+	}
 }
 
+// CreateOrder places an order for the signed in customer. Orders a merchant
+// creates with an API key wait for the customer to confirm them, and their
+// coins are only charged then.
 func (s *orderService) CreateOrder(ctx context.Context, req *orderpb.CreateOrderRequest) (*orderpb.CreateOrderResponse, error) {
-	// Generate unique order number
-	orderNumber := generateOrderNumber()
+	customerID, byMerchant, err := orderCustomer(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = customerID
+
+	if err := s.hours.RequireOpen(ctx, int(req.MerchantId)); err != nil {
+		return nil, err
+	}
+
+	if req.OutletId != 0 {
+		if _, err := s.repo.GetOutlet(ctx, int(req.MerchantId), req.OutletId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "outlet does not belong to this merchant")
+		}
+	}
+
+	subtotal, items, err := s.priceItems(ctx, req)
+	if err != nil {
+		return nil, err
+	}
 
 	// Calculate discount and total
-	discountAmount := req.Subtotal * 0.15 // Default 15% discount
-	totalAmount := req.Subtotal - discountAmount
+	discountAmount := subtotal * 0.15 // Default 15% discount
+	totalAmount := subtotal - discountAmount
+
+	if req.CoinsUsed < 0 || req.CoinsUsed > totalAmount+0.005 {
+		return nil, status.Errorf(codes.InvalidArgument, "coins used must be between 0 and the order total %.2f", totalAmount)
+	}
+	if req.CoinsUsed > 0 && !byMerchant {
+		balance, err := s.repo.GetBalance(ctx, int(req.UserId))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get balance: %w", err)
+		}
+		if balance < req.CoinsUsed {
+			return nil, status.Errorf(codes.FailedPrecondition, "insufficient balance: have %.2f, need %.2f", balance, req.CoinsUsed)
+		}
+	}
 
 	createParams := schema.CreateOrderParams{
 		MerchantID:     pgtype.Int8{Int64: int64(req.MerchantId), Valid: true},
 		UserID:         pgtype.Int8{Int64: int64(req.UserId), Valid: true},
-		OfferID:        pgtype.Int8{Int64: int64(req.OfferId), Valid: true},
-		OrderNumber:    orderNumber,
-		Items:          []byte(req.Items),
-		Subtotal:       utils.Float64ToNumeric(req.Subtotal),
+		OfferID:        pgtype.Int8{Int64: int64(req.OfferId), Valid: req.OfferId != 0},
+		Items:          items,
+		Subtotal:       utils.Float64ToNumeric(subtotal),
 		DiscountAmount: utils.Float64ToNumeric(discountAmount),
 		TotalAmount:    utils.Float64ToNumeric(totalAmount),
 		CoinsUsed:      utils.Float64ToNumeric(req.CoinsUsed),
-		Status:         pgtype.Text{String: "pending", Valid: true},
+		Status:         util.StatusPending,
 		Notes:          pgtype.Text{String: req.Notes, Valid: req.Notes != ""},
+		OutletID:       pgtype.Int8{Int64: req.OutletId, Valid: req.OutletId != 0},
 	}
 
-	order, err := s.repo.CreateOrder(ctx, createParams)
+	newOrder := repo.NewOrder{
+		Order:       createParams,
+		DailyNumber: s.numberReset == util.NumberResetDaily,
+		// Merchants that never accept the order don't leave it pending forever,
+		// nor do customers that never confirm it
+		Timers: []schema.ScheduleOrderTimerParams{s.timers.schedule(util.TimerAcceptDeadline, s.timers.accept)},
+	}
+	if byMerchant {
+		newOrder.Order.Status = util.StatusAwaitingCustomer
+	} else if req.CoinsUsed > 0 {
+		newOrder.Payment = &schema.CreateTransactionParams{
+			UserID:         createParams.UserID,
+			MerchantID:     createParams.MerchantID,
+			CoinsSpent:     createParams.CoinsUsed,
+			OriginalAmount: createParams.Subtotal,
+			DiscountAmount: createParams.DiscountAmount,
+			FinalAmount:    createParams.TotalAmount,
+		}
+	}
+
+	order, err := s.repo.CreateOrder(ctx, newOrder)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create order: %w", err)
 	}
 
+	protoOrder := convertToProtoOrder(order)
+	s.publish(protoOrder, "created")
+	if byMerchant {
+		s.notifier.Notify(ctx, notify.Notification{
+			To:       notify.User(protoOrder.UserId),
+			Type:     notify.TypeOrder,
+			Title:    "Confirm your order",
+			Body:     fmt.Sprintf("Order %s for %.2f is waiting for you to confirm it", protoOrder.OrderNumber, protoOrder.TotalAmount),
+			DeepLink: notify.OrderLink(protoOrder.Id),
+		})
+	} else {
+		s.notifyNewOrder(ctx, protoOrder)
+	}
+
 	return &orderpb.CreateOrderResponse{
-		Order: convertToProtoOrder(order),
+		Order: protoOrder,
 	}, nil
 }
 
-func (s *orderService) GetOrder(ctx context.Context, req *orderpb.GetOrderRequest) (*orderpb.GetOrderResponse, error) {
+// orderCustomer returns who an order is placed for. Customers can only order
+// for themselves; merchant API keys name the customer, and byMerchant is set.
+func orderCustomer(ctx context.Context, req *orderpb.CreateOrderRequest) (customerID int64, byMerchant bool, err error) {
+	if authType, _ := ctx.Value("auth_type").(string); authType == "api_key" {
+		if req.UserId == 0 {
+			return 0, false, status.Error(codes.InvalidArgument, "user ID is required")
+		}
+		return req.UserId, true, nil
+	}
 
+	userID, ok := ctx.Value("user_id").(int)
+	if !ok || userID == 0 {
+		return 0, false, status.Error(codes.Unauthenticated, "sign in to place an order")
+	}
+	if req.UserId != 0 && req.UserId != int64(userID) {
+		return 0, false, status.Error(codes.PermissionDenied, "orders can only be placed for yourself")
+	}
+	return int64(userID), false, nil
+}
+
+// canViewOrder reports whether the caller is the order's customer, or its
+// merchant calling with an API key.
+func canViewOrder(ctx context.Context, order schema.Order) bool {
+	if authType, _ := ctx.Value("auth_type").(string); authType == "api_key" {
+		merchantID, _ := ctx.Value("merchant_id").(int)
+		return merchantID != 0 && order.MerchantID.Int64 == int64(merchantID)
+	}
+	userID, _ := ctx.Value("user_id").(int)
+	return userID != 0 && order.UserID.Int64 == int64(userID)
+}
+
+// GetOrder returns an order to its customer or merchant. Other orders look
+// the same as missing ones.
+func (s *orderService) GetOrder(ctx context.Context, req *orderpb.GetOrderRequest) (*orderpb.GetOrderResponse, error) {
 	order, err := s.repo.GetOrderByID(ctx, int(req.OrderId))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "order not found")
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to get order: %w", err)
 	}
+	if !canViewOrder(ctx, order) {
+		return nil, status.Error(codes.NotFound, "order not found")
+	}
 
 	return &orderpb.GetOrderResponse{
 		Order: convertToProtoOrder(order),
@@ -75,7 +236,14 @@ func (s *orderService) GetOrder(ctx context.Context, req *orderpb.GetOrderReques
 }
 
 func (s *orderService) GetUserOrders(ctx context.Context, req *orderpb.GetUserOrdersRequest) (*orderpb.GetUserOrdersResponse, error) {
-	userID := int(req.UserId)
+	userID, ok := ctx.Value("user_id").(int)
+	if !ok || userID == 0 {
+		return nil, status.Error(codes.Unauthenticated, "sign in to view your orders")
+	}
+	if req.UserId != 0 && req.UserId != int64(userID) {
+		return nil, status.Error(codes.PermissionDenied, "you can only view your own orders")
+	}
+
 	var orders []schema.Order
 	if req.Status != "" {
 		orders, _ = s.repo.GetUserOrdersByStatus(ctx, userID, req.Status, req.Limit, (req.Page-1)*req.Limit)
@@ -95,14 +263,20 @@ func (s *orderService) GetUserOrders(ctx context.Context, req *orderpb.GetUserOr
 }
 
 func (s *orderService) CancelOrder(ctx context.Context, req *orderpb.CancelOrderRequest) (*orderpb.CancelOrderResponse, error) {
+	userID, ok := ctx.Value("user_id").(int)
+	if !ok || userID == 0 {
+		return nil, status.Error(codes.Unauthenticated, "sign in to cancel an order")
+	}
 
-	err := s.repo.UpdateOrderStatus(ctx, schema.UpdateOrderStatusParams{
-		ID:     int64(req.OrderId),
-		Status: pgtype.Text{String: "cancelled", Valid: true},
+	_, err := s.Transition(ctx, StatusChange{
+		OrderID:   int(req.OrderId),
+		To:        util.StatusCancelled,
+		ActorID:   int64(userID),
+		ActorType: audit.ActorUser,
+		Reason:    req.Reason,
 	})
-
 	if err != nil {
-		return nil, fmt.Errorf("failed to cancel order: %w", err)
+		return nil, err
 	}
 
 	return &orderpb.CancelOrderResponse{
@@ -110,18 +284,300 @@ func (s *orderService) CancelOrder(ctx context.Context, req *orderpb.CancelOrder
 	}, nil
 }
 
-// Helper functions
-func generateOrderNumber() string {
-	timestamp := time.Now().Unix()
-	return "ORD" + strconv.FormatInt(timestamp, 10)
+// ConfirmOrder lets a customer accept an order a merchant created for them.
+// Its coins are charged now and the merchant's accept deadline starts.
+func (s *orderService) ConfirmOrder(ctx context.Context, req *orderpb.ConfirmOrderRequest) (*orderpb.ConfirmOrderResponse, error) {
+	userID, ok := ctx.Value("user_id").(int)
+	if !ok || userID == 0 {
+		return nil, status.Error(codes.Unauthenticated, "sign in to confirm an order")
+	}
+
+	order, err := s.repo.GetOrderByID(ctx, int(req.OrderId))
+	if err != nil || order.UserID.Int64 != int64(userID) {
+		return nil, status.Error(codes.NotFound, "order not found")
+	}
+
+	if coins := utils.NumericToFloat64(order.CoinsUsed); coins > 0 {
+		balance, err := s.repo.GetBalance(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get balance: %w", err)
+		}
+		if balance < coins {
+			return nil, status.Errorf(codes.FailedPrecondition, "insufficient balance: have %.2f, need %.2f", balance, coins)
+		}
+	}
+
+	protoOrder, err := s.Transition(ctx, StatusChange{
+		OrderID:   int(req.OrderId),
+		To:        util.StatusPending,
+		ActorID:   int64(userID),
+		ActorType: audit.ActorUser,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &orderpb.ConfirmOrderResponse{
+		Order: protoOrder,
+	}, nil
+}
+
+// Transition checks the actor may make the change, moves the order with its
+// timestamp and history row, and publishes it to the merchant and customer streams.
+func (s *orderService) Transition(ctx context.Context, change StatusChange) (*schemapb.Order, error) {
+	order, err := s.repo.GetOrderByID(ctx, change.OrderID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "order not found")
+	}
+
+	// Orders of other merchants or customers look the same as missing ones
+	switch change.ActorType {
+	case audit.ActorMerchant:
+		if order.MerchantID.Int64 != int64(change.MerchantID) {
+			return nil, status.Error(codes.NotFound, "order not found")
+		}
+		if len(change.OutletIDs) > 0 && !merchantutil.OutletAllowed(change.OutletIDs, order.OutletID.Int64) {
+			return nil, status.Error(codes.NotFound, "order not found")
+		}
+	case audit.ActorUser:
+		if order.UserID.Int64 != change.ActorID {
+			return nil, status.Error(codes.NotFound, "order not found")
+		}
+	}
+
+	if !util.IsValidStatus(change.To) {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown order status %q", change.To)
+	}
+	if !util.CanTransition(change.ActorType, order.Status, change.To) {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot move order from %s to %s", order.Status, change.To)
+	}
+
+	reason := strings.TrimSpace(change.Reason)
+	if reason == "" && change.ActorType == audit.ActorMerchant && (change.To == util.StatusRejected || change.To == util.StatusCancelled) {
+		return nil, status.Error(codes.InvalidArgument, "a reason is required")
+	}
+
+	var timers []schema.ScheduleOrderTimerParams
+	var charge *schema.CreateTransactionParams
+	switch change.To {
+	case util.StatusPending:
+		// Only confirmed orders get here, the merchant's clock starts now
+		timers = append(timers, s.timers.schedule(util.TimerAcceptDeadline, s.timers.accept))
+		charge = orderPayment(order)
+	case util.StatusPreparing:
+		timers = append(timers, s.timers.schedule(util.TimerPreparingEscalation, s.timers.preparing))
+	}
+
+	updated, err := s.repo.TransitionStatus(ctx, repo.StatusTransition{
+		Status: schema.TransitionOrderStatusParams{
+			ID:         order.ID,
+			FromStatus: order.Status,
+			ToStatus:   change.To,
+			Reason:     pgtype.Text{String: reason, Valid: reason != ""},
+			ChangedAt:  pgtype.Timestamp{Time: time.Now().UTC(), Valid: true},
+		},
+		History: schema.CreateOrderStatusHistoryParams{
+			OrderID:    order.ID,
+			FromStatus: pgtype.Text{String: order.Status, Valid: true},
+			ToStatus:   change.To,
+			Reason:     pgtype.Text{String: reason, Valid: reason != ""},
+			ActorID:    pgtype.Int8{Int64: change.ActorID, Valid: change.ActorID != 0},
+			ActorType:  change.ActorType,
+		},
+		Timers: timers,
+		Charge: charge,
+		Refund: util.RefundsPayment(change.To),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Error(codes.Aborted, "order status changed concurrently, retry")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update order status: %w", err)
+	}
+
+	protoOrder := convertToProtoOrder(updated)
+	s.publish(protoOrder, change.To)
+	s.notifyStatus(ctx, protoOrder, change)
+	return protoOrder, nil
+}
+
+// orderPayment is the charge for an order's coins, nil when it uses none.
+func orderPayment(order schema.Order) *schema.CreateTransactionParams {
+	if utils.NumericToFloat64(order.CoinsUsed) <= 0 {
+		return nil
+	}
+	return &schema.CreateTransactionParams{
+		UserID:         order.UserID,
+		MerchantID:     order.MerchantID,
+		OrderID:        pgtype.Int8{Int64: order.ID, Valid: true},
+		CoinsSpent:     order.CoinsUsed,
+		OriginalAmount: order.Subtotal,
+		DiscountAmount: order.DiscountAmount,
+		FinalAmount:    order.TotalAmount,
+	}
+}
+
+// notifyNewOrder tells the merchant an order is waiting to be accepted.
+func (s *orderService) notifyNewOrder(ctx context.Context, order *schemapb.Order) {
+	s.notifier.Notify(ctx, notify.Notification{
+		To:       notify.Merchant(order.MerchantId),
+		Type:     notify.TypeOrder,
+		Title:    "New order",
+		Body:     fmt.Sprintf("Order %s for %.2f is waiting to be accepted", order.OrderNumber, order.TotalAmount),
+		DeepLink: notify.OrderLink(order.Id),
+	})
 }
 
+// notifyStatus tells the other side of the order about a status change:
+// the merchant when the customer confirms or cancels, the customer otherwise.
+func (s *orderService) notifyStatus(ctx context.Context, order *schemapb.Order, change StatusChange) {
+	if change.ActorType == audit.ActorUser && change.To == util.StatusPending {
+		s.notifyNewOrder(ctx, order)
+		return
+	}
+	if change.ActorType == audit.ActorUser {
+		s.notifier.Notify(ctx, notify.Notification{
+			To:       notify.Merchant(order.MerchantId),
+			Type:     notify.TypeOrder,
+			Title:    "Order cancelled",
+			Body:     fmt.Sprintf("The customer cancelled order %s", order.OrderNumber),
+			DeepLink: notify.OrderLink(order.Id),
+		})
+		return
+	}
+
+	title := util.CustomerNotice(change.To)
+	if title == "" || order.UserId == 0 {
+		return
+	}
+	body := "Order " + order.OrderNumber
+	if order.StatusReason != "" {
+		body += ": " + order.StatusReason
+	}
+	s.notifier.Notify(ctx, notify.Notification{
+		To:       notify.User(order.UserId),
+		Type:     notify.TypeOrder,
+		Title:    title,
+		Body:     body,
+		DeepLink: notify.OrderLink(order.Id),
+	})
+}
+
+func (s *orderService) ListMerchantOrders(ctx context.Context, filter OrderFilter) (*orderpb.GetOrdersResponse, error) {
+	if filter.Status != "" && !util.IsValidStatus(filter.Status) {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown order status %q", filter.Status)
+	}
+	switch filter.Sort {
+	case "":
+		filter.Sort = "newest"
+	case "newest", "oldest", "amount_desc", "amount_asc":
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown sort %q", filter.Sort)
+	}
+
+	search := strings.TrimSpace(filter.Search)
+	// An order number read out at the counter ("oo-k7") still finds the order
+	if number, ok := util.NormalizeOrderNumber(search); ok {
+		search = number
+	}
+	params := schema.CountFilteredMerchantOrdersParams{
+		MerchantID:  pgtype.Int8{Int64: int64(filter.MerchantID), Valid: true},
+		Status:      pgtype.Text{String: filter.Status, Valid: filter.Status != ""},
+		CreatedFrom: pgtype.Timestamp{Time: filter.From.UTC(), Valid: !filter.From.IsZero()},
+		CreatedTo:   pgtype.Timestamp{Time: filter.To.UTC(), Valid: !filter.To.IsZero()},
+		OutletIds:   filter.OutletIDs,
+		Search:      pgtype.Text{String: search, Valid: search != ""},
+	}
+	if filter.MinAmount > 0 {
+		params.MinAmount = utils.Float64ToNumeric(filter.MinAmount)
+	}
+
+	total, err := s.repo.CountFilteredMerchantOrders(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count orders: %w", err)
+	}
+
+	orders, err := s.repo.ListMerchantOrders(ctx, schema.ListMerchantOrdersParams{
+		MerchantID:  params.MerchantID,
+		Status:      params.Status,
+		CreatedFrom: params.CreatedFrom,
+		CreatedTo:   params.CreatedTo,
+		OutletIds:   params.OutletIds,
+		MinAmount:   params.MinAmount,
+		Search:      params.Search,
+		Sort:        filter.Sort,
+		RowLimit:    filter.Limit,
+		RowOffset:   (filter.Page - 1) * filter.Limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list orders: %w", err)
+	}
+
+	var protoOrders []*schemapb.Order
+	for _, order := range orders {
+		protoOrders = append(protoOrders, convertToProtoOrder(order))
+	}
+
+	return &orderpb.GetOrdersResponse{
+		Orders:     protoOrders,
+		TotalCount: int32(total),
+	}, nil
+}
+
+// publish sends an order event to the merchant's and the customer's streams.
+func (s *orderService) publish(order *schemapb.Order, eventType string) {
+	s.merchantPubsub.PublishOrderUpdate(int(order.MerchantId), order, eventType)
+	s.pubsub.PublishOrderUpdate(int(order.UserId), order, eventType)
+}
+
+// priceItems returns the order subtotal and items snapshot. Catalog line items
+// are priced on the server; the free-form items JSON and client subtotal are
+// only accepted from merchants that have no catalog.
+func (s *orderService) priceItems(ctx context.Context, req *orderpb.CreateOrderRequest) (float64, []byte, error) {
+	if len(req.LineItems) == 0 {
+		hasCatalog, err := s.catalog.HasCatalog(ctx, int(req.MerchantId))
+		if err != nil {
+			return 0, nil, err
+		}
+		if hasCatalog {
+			return 0, nil, status.Error(codes.FailedPrecondition, "this merchant takes orders from its catalog, send line items")
+		}
+		return req.Subtotal, []byte(req.Items), nil
+	}
+
+	var lines []merchantutil.LineRequest
+	for _, item := range req.LineItems {
+		lines = append(lines, merchantutil.LineRequest{
+			ItemID:    item.ItemId,
+			VariantID: item.VariantId,
+			AddonIDs:  item.AddonIds,
+			Quantity:  int(item.Quantity),
+			UnitPrice: item.UnitPrice,
+		})
+	}
+
+	priced, subtotal, err := s.catalog.PriceOrder(ctx, int(req.MerchantId), lines)
+	if err != nil {
+		return 0, nil, err
+	}
+	if req.Subtotal != 0 && !merchantutil.SameAmount(req.Subtotal, subtotal) {
+		return 0, nil, status.Errorf(codes.FailedPrecondition, "subtotal %.2f does not match the catalog total %.2f", req.Subtotal, subtotal)
+	}
+
+	items, err := json.Marshal(priced)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to encode items: %w", err)
+	}
+	return subtotal, items, nil
+}
+
+// Helper functions
 func convertToProtoOrder(order schema.Order) *schemapb.Order {
 	userID, _ := order.UserID.Value()
 	merchantID, _ := order.MerchantID.Value()
 	orderID := order.ID
 
-	return &schemapb.Order{
+	protoOrder := &schemapb.Order{
 		Id:             orderID,
 		UserId:         userID.(int64),
 		MerchantId:     merchantID.(int64),
@@ -132,9 +588,58 @@ func convertToProtoOrder(order schema.Order) *schemapb.Order {
 		DiscountAmount: utils.NumericToFloat64(order.DiscountAmount),
 		TotalAmount:    utils.NumericToFloat64(order.TotalAmount),
 		CoinsUsed:      utils.NumericToFloat64(order.CoinsUsed),
-		Status:         order.Status.String,
+		Status:         order.Status,
 		Notes:          order.Notes.String,
 		CreatedAt:      order.CreatedAt.Time.Unix(),
 		UpdatedAt:      order.UpdatedAt.Time.Unix(),
+		LineItems:      convertToProtoLineItems(order.Items),
+		StatusReason:   order.StatusReason.String,
+		OutletId:       order.OutletID.Int64,
+	}
+
+	if order.AcceptedAt.Valid {
+		protoOrder.AcceptedAt = order.AcceptedAt.Time.Unix()
+	}
+	if order.PreparingAt.Valid {
+		protoOrder.PreparingAt = order.PreparingAt.Time.Unix()
+	}
+	if order.ReadyAt.Valid {
+		protoOrder.ReadyAt = order.ReadyAt.Time.Unix()
+	}
+	if order.CompletedAt.Valid {
+		protoOrder.CompletedAt = order.CompletedAt.Time.Unix()
+	}
+	if order.CancelledAt.Valid {
+		protoOrder.CancelledAt = order.CancelledAt.Time.Unix()
+	}
+	if order.RejectedAt.Valid {
+		protoOrder.RejectedAt = order.RejectedAt.Time.Unix()
+	}
+	return protoOrder
+}
+
+// convertToProtoLineItems decodes the items snapshot of catalog orders.
+// Free-form items from merchants without a catalog yield no line items.
+func convertToProtoLineItems(items []byte) []*schemapb.OrderLineItem {
+	var lines []merchantutil.PricedLine
+	if err := json.Unmarshal(items, &lines); err != nil {
+		return nil
+	}
+
+	var protoLines []*schemapb.OrderLineItem
+	for _, line := range lines {
+		if line.ItemID == 0 {
+			return nil
+		}
+		protoLines = append(protoLines, &schemapb.OrderLineItem{
+			ItemId:    line.ItemID,
+			VariantId: line.VariantID,
+			AddonIds:  line.AddonIDs,
+			Quantity:  int32(line.Quantity),
+			UnitPrice: line.UnitPrice,
+			Name:      line.Name,
+			Subtotal:  line.Subtotal,
+		})
 	}
+	return protoLines
 }
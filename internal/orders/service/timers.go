@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"rival/config"
+	schema "rival/gen/sql"
+	"rival/internal/orders/repo"
+	"rival/internal/orders/util"
+	"rival/pkg/alerts"
+	"rival/pkg/audit"
+	"rival/pkg/notify"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// timerLease is how long a claimed timer is hidden from other instances
+	timerLease       = 2 * time.Minute
+	timerBatchSize   = 50
+	timerMaxAttempts = 5
+)
+
+type timerConfig struct {
+	accept    time.Duration
+	preparing time.Duration
+	interval  time.Duration
+}
+
+func loadTimerConfig() timerConfig {
+	cfg := config.GetConfig().Orders
+	return timerConfig{
+		accept:    time.Duration(orDefault(cfg.AcceptTimeoutMinutes, 10)) * time.Minute,
+		preparing: time.Duration(orDefault(cfg.PreparingTimeoutMinutes, 30)) * time.Minute,
+		interval:  time.Duration(orDefault(cfg.TimerIntervalSeconds, 30)) * time.Second,
+	}
+}
+
+func (c timerConfig) schedule(kind string, after time.Duration) schema.ScheduleOrderTimerParams {
+	return schema.ScheduleOrderTimerParams{
+		Kind:  kind,
+		DueAt: pgtype.Timestamp{Time: time.Now().UTC().Add(after), Valid: true},
+	}
+}
+
+func orDefault(value, def int) int {
+	if value <= 0 {
+		return def
+	}
+	return value
+}
+
+// TimerService fires the order SLA timers kept in order_timers.
+type TimerService interface {
+	RunDueTimers(ctx context.Context) (int, error)
+	StartTimers(ctx context.Context)
+}
+
+type timerService struct {
+	repo     repo.OrderRepository
+	orders   OrderService
+	pubsub   util.OrderPubSubService
+	notifier *notify.Service
+	config   timerConfig
+}
+
+func NewTimerService(repo repo.OrderRepository, orders OrderService, notifier *notify.Service) TimerService {
+	return &timerService{
+		repo:     repo,
+		orders:   orders,
+		pubsub:   util.NewOrderPubSubService(),
+		notifier: notifier,
+		config:   loadTimerConfig(),
+	}
+}
+
+// RunDueTimers claims the timers that are due and fires them. A timer that
+// fails keeps its claim and is retried once the lease runs out, timerLease
+// later, up to timerMaxAttempts times.
+func (s *timerService) RunDueTimers(ctx context.Context) (int, error) {
+	now := time.Now().UTC()
+	timers, err := s.repo.ClaimDueTimers(ctx, schema.ClaimDueOrderTimersParams{
+		LeaseUntil:  pgtype.Timestamp{Time: now.Add(timerLease), Valid: true},
+		Now:         pgtype.Timestamp{Time: now, Valid: true},
+		MaxAttempts: timerMaxAttempts,
+		BatchSize:   timerBatchSize,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim order timers: %w", err)
+	}
+
+	fired := 0
+	for _, timer := range timers {
+		if err := s.fire(ctx, timer); err != nil {
+			log.Printf("Order timer %d (%s) for order %d failed: %v", timer.ID, timer.Kind, timer.OrderID, err)
+			if err := s.repo.FailTimer(ctx, schema.FailOrderTimerParams{
+				ID:        timer.ID,
+				LastError: pgtype.Text{String: err.Error(), Valid: true},
+			}); err != nil {
+				return fired, err
+			}
+			continue
+		}
+
+		if err := s.repo.CompleteTimer(ctx, schema.CompleteOrderTimerParams{
+			ID:      timer.ID,
+			FiredAt: pgtype.Timestamp{Time: time.Now().UTC(), Valid: true},
+		}); err != nil {
+			return fired, err
+		}
+		fired++
+	}
+	return fired, nil
+}
+
+// fire acts on one timer. Orders that already moved on are left alone.
+func (s *timerService) fire(ctx context.Context, timer schema.OrderTimer) error {
+	switch timer.Kind {
+	case util.TimerAcceptDeadline:
+		current, err := s.repo.GetOrderByID(ctx, int(timer.OrderID))
+		if err != nil {
+			return err
+		}
+
+		// The deadline also expires orders the customer never confirmed
+		minutes := int(s.config.accept / time.Minute)
+		reason := fmt.Sprintf("Not accepted within %d minutes", minutes)
+		body := fmt.Sprintf("Order %s was rejected because it wasn't accepted within %d minutes", current.OrderNumber, minutes)
+		if current.Status == util.StatusAwaitingCustomer {
+			reason = fmt.Sprintf("Not confirmed by the customer within %d minutes", minutes)
+			body = fmt.Sprintf("Order %s was rejected because the customer didn't confirm it within %d minutes", current.OrderNumber, minutes)
+		}
+
+		order, err := s.orders.Transition(ctx, StatusChange{
+			OrderID:   int(timer.OrderID),
+			To:        util.StatusRejected,
+			ActorType: audit.ActorSystem,
+			Reason:    reason,
+		})
+		if code := status.Code(err); code == codes.FailedPrecondition || code == codes.Aborted || code == codes.NotFound {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		s.notifier.Notify(ctx, notify.Notification{
+			To:       notify.Merchant(order.MerchantId),
+			Type:     notify.TypeOrderSLA,
+			Title:    "Order auto-rejected",
+			Body:     body,
+			DeepLink: notify.OrderLink(order.Id),
+		})
+		return nil
+
+	case util.TimerPreparingEscalation:
+		order, err := s.repo.GetOrderByID(ctx, int(timer.OrderID))
+		if err != nil {
+			return err
+		}
+		if order.Status != util.StatusPreparing {
+			return nil
+		}
+
+		minutes := int(s.config.preparing / time.Minute)
+		message := fmt.Sprintf("Order %s has been preparing for over %d minutes", order.OrderNumber, minutes)
+		s.notifier.Notify(ctx, notify.Notification{
+			To:       notify.Merchant(order.MerchantID.Int64),
+			Type:     notify.TypeOrderSLA,
+			Title:    "Order running late",
+			Body:     message,
+			DeepLink: notify.OrderLink(order.ID),
+		})
+		s.pubsub.PublishOrderUpdate(int(order.UserID.Int64), convertToProtoOrder(order), "delayed")
+		if order.UserID.Valid {
+			s.notifier.Notify(ctx, notify.Notification{
+				To:       notify.User(order.UserID.Int64),
+				Type:     notify.TypeOrder,
+				Title:    "Your order is running late",
+				Body:     fmt.Sprintf("Order %s is taking longer than expected", order.OrderNumber),
+				DeepLink: notify.OrderLink(order.ID),
+			})
+		}
+		alerts.Publish("Order running late", message, alerts.SeverityWarning, alerts.TypeOrderDelayed)
+		return nil
+	}
+	return fmt.Errorf("unknown timer kind %q", timer.Kind)
+}
+
+// StartTimers runs RunDueTimers every orders.timer_interval_seconds until ctx is done.
+// Every instance can run it, claims keep a timer from firing twice.
+func (s *timerService) StartTimers(ctx context.Context) {
+	ticker := time.NewTicker(s.config.interval)
+	defer ticker.Stop()
+
+	for {
+		if fired, err := s.RunDueTimers(ctx); err != nil {
+			log.Printf("Failed to run order timers: %v", err)
+		} else if fired > 0 {
+			log.Printf("Fired %d order timers", fired)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package util
+
+import "rival/pkg/audit"
+
+// Order lifecycle states
+const (
+	StatusAwaitingCustomer = "awaiting_customer" // created with a merchant API key, not yet confirmed
+	StatusPending          = "pending"
+	StatusAccepted         = "accepted"
+	StatusPreparing        = "preparing"
+	StatusReady            = "ready"
+	StatusCompleted        = "completed"
+	StatusCancelled        = "cancelled"
+	StatusRejected         = "rejected"
+)
+
+// Order timer kinds
+const (
+	TimerAcceptDeadline      = "accept_deadline"      // auto-rejects an order still pending
+	TimerPreparingEscalation = "preparing_escalation" // flags an order stuck in preparing
+)
+
+// orderTransitions lists where each actor may move an order from each state.
+// Customers confirm orders a merchant created for them and can only back out
+// before the kitchen starts, merchants run the rest of the lifecycle and may
+// still cancel an order they can't fulfil. The system only rejects orders
+// nobody confirmed or accepted in time.
+var orderTransitions = map[string]map[string][]string{
+	audit.ActorUser: {
+		StatusAwaitingCustomer: {StatusPending, StatusCancelled},
+		StatusPending:          {StatusCancelled},
+		StatusAccepted:         {StatusCancelled},
+	},
+	audit.ActorMerchant: {
+		StatusAwaitingCustomer: {StatusCancelled},
+		StatusPending:          {StatusAccepted, StatusRejected},
+		StatusAccepted:         {StatusPreparing, StatusCancelled},
+		StatusPreparing:        {StatusReady, StatusCancelled},
+		StatusReady:            {StatusCompleted},
+	},
+	audit.ActorSystem: {
+		StatusAwaitingCustomer: {StatusRejected},
+		StatusPending:          {StatusRejected},
+	},
+}
+
+// CanTransition reports whether actor (audit.ActorUser, audit.ActorMerchant or
+// audit.ActorSystem) may move an order from one state to another.
+func CanTransition(actor, from, to string) bool {
+	for _, next := range orderTransitions[actor][from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidStatus reports whether status is part of the order lifecycle.
+func IsValidStatus(status string) bool {
+	switch status {
+	case StatusAwaitingCustomer, StatusPending, StatusAccepted, StatusPreparing, StatusReady, StatusCompleted, StatusCancelled, StatusRejected:
+		return true
+	}
+	return false
+}
+
+// IsFinal reports whether an order has left the lifecycle for good.
+func IsFinal(status string) bool {
+	return status == StatusCompleted || status == StatusCancelled || status == StatusRejected
+}
+
+// RefundsPayment reports whether moving to status hands the order's coins back.
+func RefundsPayment(status string) bool {
+	return status == StatusCancelled || status == StatusRejected
+}
+
+// CustomerNotice is the title of the notification a customer gets when their
+// order moves to status, or "" for states they aren't told about.
+func CustomerNotice(status string) string {
+	switch status {
+	case StatusAccepted:
+		return "Order accepted"
+	case StatusPreparing:
+		return "Your order is being prepared"
+	case StatusReady:
+		return "Your order is ready"
+	case StatusCompleted:
+		return "Order completed"
+	case StatusCancelled:
+		return "Order cancelled"
+	case StatusRejected:
+		return "Order rejected"
+	}
+	return ""
+}
@@ -0,0 +1,56 @@
+package util
+
+import (
+	"testing"
+
+	"rival/pkg/audit"
+)
+
+func TestCanTransition(t *testing.T) {
+	cases := []struct {
+		actor, from, to string
+		want            bool
+	}{
+		{audit.ActorMerchant, StatusPending, StatusAccepted, true},
+		{audit.ActorMerchant, StatusPending, StatusRejected, true},
+		{audit.ActorMerchant, StatusAccepted, StatusPreparing, true},
+		{audit.ActorMerchant, StatusPreparing, StatusReady, true},
+		{audit.ActorMerchant, StatusReady, StatusCompleted, true},
+		{audit.ActorMerchant, StatusPreparing, StatusCancelled, true},
+		{audit.ActorMerchant, StatusPending, StatusCompleted, false},
+		{audit.ActorMerchant, StatusAccepted, StatusRejected, false},
+		{audit.ActorMerchant, StatusCompleted, StatusCancelled, false},
+		{audit.ActorMerchant, StatusCancelled, StatusAccepted, false},
+		{audit.ActorMerchant, StatusAwaitingCustomer, StatusPending, false},
+		{audit.ActorMerchant, StatusAwaitingCustomer, StatusAccepted, false},
+		{audit.ActorMerchant, StatusAwaitingCustomer, StatusCancelled, true},
+		{audit.ActorUser, StatusAwaitingCustomer, StatusPending, true},
+		{audit.ActorUser, StatusAwaitingCustomer, StatusCancelled, true},
+		{audit.ActorUser, StatusPending, StatusCancelled, true},
+		{audit.ActorUser, StatusAccepted, StatusCancelled, true},
+		{audit.ActorUser, StatusPreparing, StatusCancelled, false},
+		{audit.ActorUser, StatusCompleted, StatusCancelled, false},
+		{audit.ActorUser, StatusPending, StatusAccepted, false},
+		{audit.ActorSystem, StatusPending, StatusRejected, true},
+		{audit.ActorSystem, StatusPending, StatusCancelled, false},
+		{audit.ActorSystem, StatusAwaitingCustomer, StatusRejected, true},
+		{audit.ActorSystem, StatusAccepted, StatusRejected, false},
+	}
+
+	for _, c := range cases {
+		if got := CanTransition(c.actor, c.from, c.to); got != c.want {
+			t.Errorf("CanTransition(%s, %s, %s) = %v, want %v", c.actor, c.from, c.to, got, c.want)
+		}
+	}
+}
+
+func TestCustomerNotice(t *testing.T) {
+	for _, status := range []string{StatusAccepted, StatusPreparing, StatusReady, StatusCompleted, StatusCancelled, StatusRejected} {
+		if CustomerNotice(status) == "" {
+			t.Errorf("CustomerNotice(%s) is empty", status)
+		}
+	}
+	if got := CustomerNotice(StatusPending); got != "" {
+		t.Errorf("CustomerNotice(pending) = %q, want empty", got)
+	}
+}
@@ -0,0 +1,67 @@
+package util
+
+import "strings"
+
+// Order numbers are a merchant's counter in Crockford base32 followed by a
+// check symbol, e.g. 00K7. The alphabet has no I, L, O or U, so a code read
+// aloud at the counter can't be mistaken for another.
+const (
+	crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+	orderNumberWidth  = 3 // counter symbols before the check symbol
+)
+
+// Order number reset periods, orders.number_reset in config.yml
+const (
+	NumberResetDaily = "daily"
+	NumberResetNever = "never"
+)
+
+// FormatOrderNumber encodes a counter value as an order number.
+func FormatOrderNumber(seq int64) string {
+	var digits []byte
+	for n := seq; n > 0; n /= 32 {
+		digits = append([]byte{crockfordAlphabet[n%32]}, digits...)
+	}
+	for len(digits) < orderNumberWidth {
+		digits = append([]byte{'0'}, digits...)
+	}
+	return string(digits) + string(crockfordAlphabet[checkSymbol(digits)])
+}
+
+// NormalizeOrderNumber cleans up a code typed or read back by a person:
+// case, separators and the look-alikes O, I and L are forgiven. It returns
+// false when the code isn't valid base32 or its check symbol doesn't match.
+func NormalizeOrderNumber(code string) (string, bool) {
+	code = strings.ToUpper(code)
+	code = strings.NewReplacer("-", "", " ", "", "O", "0", "I", "1", "L", "1").Replace(code)
+	if len(code) < 2 {
+		return "", false
+	}
+	for i := 0; i < len(code); i++ {
+		if strings.IndexByte(crockfordAlphabet, code[i]) < 0 {
+			return "", false
+		}
+	}
+
+	body, check := code[:len(code)-1], code[len(code)-1]
+	if crockfordAlphabet[checkSymbol([]byte(body))] != check {
+		return "", false
+	}
+	return code, true
+}
+
+// checkSymbol is the Luhn mod 32 check for digits, which catches any single
+// wrong symbol and most swapped neighbours.
+func checkSymbol(digits []byte) int {
+	factor, sum := 2, 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		addend := factor * strings.IndexByte(crockfordAlphabet, digits[i])
+		sum += addend/32 + addend%32
+		if factor == 2 {
+			factor = 1
+		} else {
+			factor = 2
+		}
+	}
+	return (32 - sum%32) % 32
+}
@@ -0,0 +1,60 @@
+package util
+
+import "testing"
+
+func TestFormatOrderNumber(t *testing.T) {
+	seen := map[string]bool{}
+	for seq := int64(1); seq <= 5000; seq++ {
+		code := FormatOrderNumber(seq)
+		if seen[code] {
+			t.Fatalf("FormatOrderNumber(%d) = %s, already issued", seq, code)
+		}
+		seen[code] = true
+
+		if normalized, ok := NormalizeOrderNumber(code); !ok || normalized != code {
+			t.Errorf("NormalizeOrderNumber(%s) = %s, %v", code, normalized, ok)
+		}
+	}
+
+	if code := FormatOrderNumber(1); len(code) != orderNumberWidth+1 || code[:orderNumberWidth] != "001" {
+		t.Errorf("FormatOrderNumber(1) = %s, want 001 plus a check symbol", code)
+	}
+}
+
+func TestNormalizeOrderNumber(t *testing.T) {
+	code := FormatOrderNumber(12345)
+
+	cases := []struct {
+		input string
+		want  bool
+	}{
+		{code, true},
+		{"  " + code[:2] + "-" + code[2:] + " ", true},
+		{"", false},
+		{"U", false},
+		{code[:len(code)-1], false},
+	}
+	for _, c := range cases {
+		if _, ok := NormalizeOrderNumber(c.input); ok != c.want {
+			t.Errorf("NormalizeOrderNumber(%q) valid = %v, want %v", c.input, ok, c.want)
+		}
+	}
+
+	// Look-alikes read back by a person map onto the real symbols
+	if got, ok := NormalizeOrderNumber("o" + FormatOrderNumber(1)[1:]); !ok || got != FormatOrderNumber(1) {
+		t.Errorf("Expected O to be read as 0, got %s, %v", got, ok)
+	}
+
+	// Any single wrong symbol is caught by the check symbol
+	for i := 0; i < len(code); i++ {
+		for _, r := range crockfordAlphabet {
+			if byte(r) == code[i] {
+				continue
+			}
+			typo := code[:i] + string(r) + code[i+1:]
+			if _, ok := NormalizeOrderNumber(typo); ok {
+				t.Errorf("Expected typo %s of %s to be rejected", typo, code)
+			}
+		}
+	}
+}
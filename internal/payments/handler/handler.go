@@ -5,15 +5,24 @@ import (
 	"fmt"
 
 	paymentpb "rival/gen/proto/proto/api"
+	merchantrepo "rival/internal/merchants/repo"
+	merchantservice "rival/internal/merchants/service"
 	"rival/internal/payments/repo"
 	"rival/internal/payments/service"
 	"rival/internal/payments/util"
+	receiptrepo "rival/internal/receipts/repo"
+	receiptservice "rival/internal/receipts/service"
+	"rival/pkg/notify"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type PaymentHandler struct {
 	paymentpb.UnimplementedPaymentServiceServer
-	service service.PaymentService
-	pubsub  util.PaymentPubSubService
+	service  service.PaymentService
+	receipts receiptservice.ReceiptService
+	pubsub   util.PaymentPubSubService
 }
 
 func NewPaymentHandler() (*PaymentHandler, error) {
@@ -22,12 +31,28 @@ func NewPaymentHandler() (*PaymentHandler, error) {
 		return nil, err
 	}
 
-	service := service.NewPaymentService(repo)
+	hoursRepository, err := merchantrepo.NewHoursRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	receiptRepository, err := receiptrepo.NewReceiptRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	notifier, err := notify.NewServiceFromConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	service := service.NewPaymentService(repo, merchantservice.NewHoursService(hoursRepository), notifier)
 	pubsubService := util.NewPaymentPubSubService()
 
 	return &PaymentHandler{
-		service: service,
-		pubsub:  pubsubService,
+		service:  service,
+		receipts: receiptservice.NewReceiptService(receiptRepository),
+		pubsub:   pubsubService,
 	}, nil
 }
 
@@ -129,6 +154,24 @@ func (h *PaymentHandler) GetFinancialHistory(ctx context.Context, req *paymentpb
 	return h.service.GetFinancialHistory(ctx, req)
 }
 
+// GetTransactionReceipt returns the receipt of one of the caller's payments.
+func (h *PaymentHandler) GetTransactionReceipt(ctx context.Context, req *paymentpb.GetTransactionReceiptRequest) (*paymentpb.GetTransactionReceiptResponse, error) {
+	if req.TransactionId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "transaction ID is required")
+	}
+
+	userID, _ := ctx.Value("user_id").(int)
+	if userID == 0 {
+		return nil, status.Error(codes.Unauthenticated, "sign in to view receipts")
+	}
+
+	receipt, err := h.receipts.TransactionReceipt(ctx, req.TransactionId, receiptservice.Viewer{UserID: int64(userID)})
+	if err != nil {
+		return nil, err
+	}
+	return &paymentpb.GetTransactionReceiptResponse{Receipt: receipt}, nil
+}
+
 // Merchant Settlements
 func (h *PaymentHandler) InitiateSettlement(ctx context.Context, req *paymentpb.InitiateSettlementRequest) (*paymentpb.InitiateSettlementResponse, error) {
 
@@ -14,6 +14,8 @@ import (
 	"testing"
 
 	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Helper Functions
@@ -40,9 +42,9 @@ func NewUser(ctx context.Context, email string, t *testing.T) (*authpb.SignupReq
 	data := authpb.SignupRequest{
 		Name:     "Test Payment User",
 		Email:    email,
-		Password: "password123",
+		Password: "Rival-Passw0rd",
 		Role:     *schemapb.UserRole_USER_ROLE_CUSTOMER.Enum(),
-		Phone:    "12345678",
+		Phone:    "9876543210",
 	}
 
 	_, err = handler.Signup(ctx, &data)
@@ -68,6 +70,7 @@ func CreateMerchantRecord(ctx context.Context, user schema.User, repo *schema.Qu
 		Category:           pgtype.Text{String: "restaurant", Valid: true},
 		DiscountPercentage: pgtype.Numeric{Int: big.NewInt(15), Exp: 0, Valid: true},
 		IsActive:           pgtype.Bool{Bool: true, Valid: true},
+		Status:             "approved",
 	})
 	if err != nil {
 		t.Fatalf("Failed to create merchant record: %v", err)
@@ -494,13 +497,14 @@ func TestCreditDebitHistory(t *testing.T) {
 		Category:           pgtype.Text{String: "restaurant", Valid: true},
 		DiscountPercentage: pgtype.Numeric{Int: big.NewInt(10), Exp: 0, Valid: true},
 		IsActive:           pgtype.Bool{Bool: true, Valid: true},
+		Status:             "approved",
 	})
 	if err != nil {
 		t.Fatalf("Merchant creation failed: %v", err)
 	}
 	defer repo.DeleteMerchant(ctx, merchant.ID)
 
-	_, err = h.PayToMerchant(ctx, &paymentpb.PayToMerchantRequest{
+	_, err = h.PayToMerchant(context.WithValue(ctx, "user_id", int(user.ID)), &paymentpb.PayToMerchantRequest{
 		UserId:     int64(user.ID),
 		MerchantId: int64(merchant.ID),
 		Amount:     100,
@@ -570,13 +574,14 @@ func TestGetFinancialHistoryComplete(t *testing.T) {
 		Category:           pgtype.Text{String: "restaurant", Valid: true},
 		DiscountPercentage: pgtype.Numeric{Int: big.NewInt(10), Exp: 0, Valid: true},
 		IsActive:           pgtype.Bool{Bool: true, Valid: true},
+		Status:             "approved",
 	})
 	if err != nil {
 		t.Fatalf("Merchant creation failed: %v", err)
 	}
 	defer repo.DeleteMerchant(ctx, merchant.ID)
 
-	_, err = h.PayToMerchant(ctx, &paymentpb.PayToMerchantRequest{
+	_, err = h.PayToMerchant(context.WithValue(ctx, "user_id", int(user.ID)), &paymentpb.PayToMerchantRequest{
 		UserId:     int64(user.ID),
 		MerchantId: int64(merchant.ID),
 		Amount:     200,
@@ -752,7 +757,7 @@ func TestHistoryMaintenance_Complete(t *testing.T) {
 		Amount:     10.0,
 		OrderId:    "test-order-history",
 	}
-	paymentResp, err := h.PayToMerchant(ctx, paymentReq)
+	paymentResp, err := h.PayToMerchant(context.WithValue(ctx, "user_id", int(user.ID)), paymentReq)
 	if err != nil {
 		t.Fatalf("Failed to pay merchant: %v", err)
 	}
@@ -836,7 +841,7 @@ func TestPayToMerchant_ValidRequest(t *testing.T) {
 		OrderId:    "test-order-123",
 	}
 
-	resp, err := h.PayToMerchant(ctx, req)
+	resp, err := h.PayToMerchant(context.WithValue(ctx, "user_id", int(payer.ID)), req)
 	if err != nil {
 		t.Logf("PayToMerchant returned error: %v (may be insufficient balance)", err)
 		return
@@ -846,6 +851,42 @@ func TestPayToMerchant_ValidRequest(t *testing.T) {
 	}
 }
 
+func TestPayToMerchant_OnlyOwnWallet(t *testing.T) {
+	ctx := context.Background()
+	_, repo1, payer := NewUser(ctx, "test-payer-own@example.com", t)
+	defer repo1.DleteUser(ctx, payer.ID)
+
+	_, repo2, merchantUser := NewUser(ctx, "test-payment-merchant-own@example.com", t)
+	defer func() {
+		CleanupMerchant(ctx, merchantUser.Email, repo2, t)
+		repo2.DleteUser(ctx, merchantUser.ID)
+	}()
+
+	merchant := CreateMerchantRecord(ctx, merchantUser, repo2, t)
+	h, _ := NewPaymentHandler()
+
+	req := &paymentpb.PayToMerchantRequest{
+		UserId:     int64(payer.ID),
+		MerchantId: int64(merchant.ID),
+		Amount:     2.0,
+	}
+
+	// Another signed in user can't spend the payer's coins
+	if _, err := h.PayToMerchant(context.WithValue(ctx, "user_id", int(merchantUser.ID)), req); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PermissionDenied paying from another wallet, got %v", err)
+	}
+
+	// Nor can the merchant's API key
+	keyCtx := context.WithValue(context.WithValue(ctx, "auth_type", "api_key"), "merchant_id", int(merchant.ID))
+	if _, err := h.PayToMerchant(keyCtx, req); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PermissionDenied for an API key payment, got %v", err)
+	}
+
+	if _, err := h.PayToMerchant(ctx, req); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Expected Unauthenticated without a signed in user, got %v", err)
+	}
+}
+
 func TestPaymentToMerchant_WithBalanceCheck(t *testing.T) {
 	ctx := context.Background()
 
@@ -889,7 +930,7 @@ func TestPaymentToMerchant_WithBalanceCheck(t *testing.T) {
 		OrderId:    "test-order-balance-check",
 	}
 
-	paymentResp, err := h.PayToMerchant(ctx, paymentReq)
+	paymentResp, err := h.PayToMerchant(context.WithValue(ctx, "user_id", int(payer.ID)), paymentReq)
 	if err != nil {
 		t.Logf("Payment failed: %v", err)
 		return
@@ -2,11 +2,13 @@ package repo
 
 import (
 	"context"
+	"fmt"
 
 	"rival/config"
 	"rival/connection"
 	schema "rival/gen/sql"
 	"rival/pkg/tb"
+	"rival/pkg/transaction"
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -25,6 +27,11 @@ type PaymentRepository interface {
 	UpdateTransactionStatus(ctx context.Context, params schema.UpdateTransactionStatusParams) error
 	GetUserTransactions(ctx context.Context, userID int, limit, offset int32) ([]schema.Transaction, error)
 
+	// Orders
+	GetOrderByID(ctx context.Context, orderID int64) (schema.Order, error)
+	GetOrderPayment(ctx context.Context, orderID int64) (schema.Transaction, error)
+	ChargeOrder(ctx context.Context, params schema.CreateTransactionParams) (schema.Transaction, error)
+
 	// Settlements
 	CreateSettlement(ctx context.Context, params schema.CreateSettlementParams) (schema.Settlement, error)
 	GetSettlementByID(ctx context.Context, id int) (schema.Settlement, error)
@@ -132,6 +139,34 @@ func (r *paymentRepository) GetMerchantSettlements(ctx context.Context, merchant
 	})
 }
 
+// Orders
+func (r *paymentRepository) GetOrderByID(ctx context.Context, orderID int64) (schema.Order, error) {
+	return r.queries.GetOrderByID(ctx, orderID)
+}
+
+func (r *paymentRepository) GetOrderPayment(ctx context.Context, orderID int64) (schema.Transaction, error) {
+	return r.queries.GetOrderPayment(ctx, pgtype.Int8{Int64: orderID, Valid: true})
+}
+
+// ChargeOrder debits an existing order; params.OrderID must be set.
+func (r *paymentRepository) ChargeOrder(ctx context.Context, params schema.CreateTransactionParams) (schema.Transaction, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return schema.Transaction{}, fmt.Errorf("failed to start transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	payment, err := transaction.ChargeOrder(ctx, r.queries.WithTx(tx), r.tb, params)
+	if err != nil {
+		return schema.Transaction{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return schema.Transaction{}, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+	return payment, nil
+}
+
 // Merchants
 func (r *paymentRepository) GetMerchantByID(ctx context.Context, merchantID int) (schema.Merchant, error) {
 	return r.queries.GetMerchantByID(ctx, int64(merchantID))
@@ -187,6 +222,22 @@ func (r *paymentRepository) GetAccountTransfers(ctx context.Context, accountID i
 				txType = "credit"
 				desc = "Payment received"
 			}
+		case 4: // Order payment
+			if isDebit {
+				txType = "debit"
+				desc = "Order payment"
+			} else {
+				txType = "credit"
+				desc = "Order payment received"
+			}
+		case 5: // Order refund
+			if isDebit {
+				txType = "debit"
+				desc = "Order refund issued"
+			} else {
+				txType = "credit"
+				desc = "Order refund"
+			}
 		case 3: // Transfer
 			var otherUserID uint64
 			if isDebit {
@@ -2,18 +2,27 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	paymentpb "rival/gen/proto/proto/api"
 	schemapb "rival/gen/proto/proto/schema"
 	schema "rival/gen/sql"
+	merchantservice "rival/internal/merchants/service"
+	merchantutil "rival/internal/merchants/util"
+	orderutil "rival/internal/orders/util"
 	"rival/internal/payments/repo"
 	userrepo "rival/internal/users/repo"
+	"rival/pkg/notify"
 	"rival/pkg/utils"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type PaymentService interface {
@@ -37,12 +46,16 @@ type PaymentService interface {
 }
 
 type paymentService struct {
-	repo repo.PaymentRepository
+	repo     repo.PaymentRepository
+	hours    merchantservice.HoursService
+	notifier *notify.Service
 }
 
-func NewPaymentService(repo repo.PaymentRepository) PaymentService {
+func NewPaymentService(repo repo.PaymentRepository, hours merchantservice.HoursService, notifier *notify.Service) PaymentService {
 	return &paymentService{
-		repo: repo,
+		repo:     repo,
+		hours:    hours,
+		notifier: notifier,
 	}
 }
 
@@ -116,6 +129,8 @@ func (s *paymentService) VerifyPayment(ctx context.Context, req *paymentpb.Verif
 		return nil, fmt.Errorf("failed to update purchase status: %w", err)
 	}
 
+	s.notifyCredit(ctx, int64(userID), "Coins added", fmt.Sprintf("%.2f coins were added to your wallet", coinsToAdd), "")
+
 	// Get new balance
 	newBalance, err := s.repo.GetBalance(ctx, userID)
 	if err != nil {
@@ -201,7 +216,16 @@ func (s *paymentService) RefundPayment(ctx context.Context, req *paymentpb.Refun
 }
 
 // Payment Transfers
+
+// PayToMerchant spends the signed in customer's coins at a merchant, or on
+// one of their unpaid orders when order_id is set.
 func (s *paymentService) PayToMerchant(ctx context.Context, req *paymentpb.PayToMerchantRequest) (*paymentpb.PayToMerchantResponse, error) {
+	payerID, err := paymentPayer(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = payerID
+
 	userID := int(req.UserId)
 	merchantID := int(req.MerchantId)
 
@@ -210,6 +234,16 @@ func (s *paymentService) PayToMerchant(ctx context.Context, req *paymentpb.PayTo
 	if err != nil {
 		return nil, fmt.Errorf("failed to get merchant: %w", err)
 	}
+	if !merchantutil.IsApproved(merchant.Status) {
+		return nil, status.Error(codes.FailedPrecondition, "merchant is not accepting payments")
+	}
+	if err := s.hours.RequireOpen(ctx, merchantID); err != nil {
+		return nil, err
+	}
+
+	if req.OrderId != "" {
+		return s.payForOrder(ctx, req, merchant)
+	}
 
 	discountPercentage := utils.NumericToFloat64(merchant.DiscountPercentage)
 	discountAmount := req.Amount * (discountPercentage / 100)
@@ -237,6 +271,7 @@ func (s *paymentService) PayToMerchant(ctx context.Context, req *paymentpb.PayTo
 	if err != nil {
 		return nil, fmt.Errorf("failed to create transaction: %w", err)
 	}
+	s.notifyPayment(ctx, req.UserId, merchant, finalAmount, notify.TransactionLink(transaction.ID))
 
 	// Get remaining balance
 	remainingBalance, err := s.repo.GetBalance(ctx, userID)
@@ -254,6 +289,122 @@ func (s *paymentService) PayToMerchant(ctx context.Context, req *paymentpb.PayTo
 	}, nil
 }
 
+// payForOrder charges an unpaid order its total. The discount was already
+// applied when the order was priced, so it isn't taken again here.
+// paymentPayer returns whose wallet a payment comes from. Only the customer
+// can spend their coins: a different user_id is refused, and merchant API keys
+// can't pay at all.
+func paymentPayer(ctx context.Context, req *paymentpb.PayToMerchantRequest) (int64, error) {
+	if authType, _ := ctx.Value("auth_type").(string); authType == "api_key" {
+		return 0, status.Error(codes.PermissionDenied, "payments must be made by the customer")
+	}
+
+	userID, ok := ctx.Value("user_id").(int)
+	if !ok || userID == 0 {
+		return 0, status.Error(codes.Unauthenticated, "sign in to pay")
+	}
+	if req.UserId != 0 && req.UserId != int64(userID) {
+		return 0, status.Error(codes.PermissionDenied, "you can only pay from your own wallet")
+	}
+	return int64(userID), nil
+}
+
+func (s *paymentService) payForOrder(ctx context.Context, req *paymentpb.PayToMerchantRequest, merchant schema.Merchant) (*paymentpb.PayToMerchantResponse, error) {
+	orderID, err := strconv.ParseInt(req.OrderId, 10, 64)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid order ID")
+	}
+
+	order, err := s.repo.GetOrderByID(ctx, orderID)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && (order.UserID.Int64 != req.UserId || order.MerchantID.Int64 != req.MerchantId)) {
+		return nil, status.Error(codes.NotFound, "order not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+	if orderutil.IsFinal(order.Status) {
+		return nil, status.Errorf(codes.FailedPrecondition, "order is %s", order.Status)
+	}
+
+	_, err = s.repo.GetOrderPayment(ctx, orderID)
+	if err == nil {
+		return nil, status.Error(codes.AlreadyExists, "order is already paid")
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("failed to get order payment: %w", err)
+	}
+
+	total := utils.NumericToFloat64(order.TotalAmount)
+	balance, err := s.repo.GetBalance(ctx, int(req.UserId))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balance: %w", err)
+	}
+	if balance < total {
+		return nil, status.Errorf(codes.FailedPrecondition, "insufficient balance: have %.2f, need %.2f", balance, total)
+	}
+
+	payment, err := s.repo.ChargeOrder(ctx, schema.CreateTransactionParams{
+		UserID:         order.UserID,
+		MerchantID:     order.MerchantID,
+		OrderID:        pgtype.Int8{Int64: order.ID, Valid: true},
+		CoinsSpent:     order.TotalAmount,
+		OriginalAmount: order.Subtotal,
+		DiscountAmount: order.DiscountAmount,
+		FinalAmount:    order.TotalAmount,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to process payment: %w", err)
+	}
+	s.notifyPayment(ctx, req.UserId, merchant, total, notify.OrderLink(order.ID))
+
+	remainingBalance, err := s.repo.GetBalance(ctx, int(req.UserId))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balance: %w", err)
+	}
+
+	return &paymentpb.PayToMerchantResponse{
+		Success:          true,
+		TransactionId:    fmt.Sprintf("%d", payment.ID),
+		DiscountAmount:   utils.NumericToFloat64(order.DiscountAmount),
+		FinalAmount:      total,
+		RemainingBalance: remainingBalance,
+		Transaction:      convertToProtoTransaction(payment),
+	}, nil
+}
+
+// notifyPayment tells the customer their payment went through and the
+// merchant that it arrived.
+func (s *paymentService) notifyPayment(ctx context.Context, userID int64, merchant schema.Merchant, amount float64, link string) {
+	s.notifier.Notify(ctx, notify.Notification{
+		To:       notify.User(userID),
+		Type:     notify.TypePayment,
+		Title:    "Payment successful",
+		Body:     fmt.Sprintf("You paid %.2f coins to %s", amount, merchant.Name),
+		DeepLink: link,
+	})
+	s.notifier.Notify(ctx, notify.Notification{
+		To:       notify.Merchant(merchant.ID),
+		Type:     notify.TypePayment,
+		Title:    "Payment received",
+		Body:     fmt.Sprintf("A customer paid %.2f coins", amount),
+		DeepLink: link,
+	})
+}
+
+// notifyCredit tells a user coins were added to their wallet.
+func (s *paymentService) notifyCredit(ctx context.Context, userID int64, title, body, link string) {
+	if userID == 0 {
+		return
+	}
+	s.notifier.Notify(ctx, notify.Notification{
+		To:       notify.User(userID),
+		Type:     notify.TypeWallet,
+		Title:    title,
+		Body:     body,
+		DeepLink: link,
+	})
+}
+
 func (s *paymentService) TransferToUser(ctx context.Context, req *paymentpb.TransferToUserRequest) (*paymentpb.TransferToUserResponse, error) {
 	fromUserID := int(req.FromUserId)
 	toUserID := int(req.ToUserId)
@@ -279,7 +430,7 @@ func (s *paymentService) TransferToUser(ctx context.Context, req *paymentpb.Tran
 	}
 
 	// Create transaction record for receiver (credit)
-	_, err = s.repo.CreateTransaction(ctx, schema.CreateTransactionParams{
+	receiverTx, err := s.repo.CreateTransaction(ctx, schema.CreateTransactionParams{
 		UserID:          pgtype.Int8{Int64: req.ToUserId, Valid: true},
 		CoinsSpent:      utils.Float64ToNumeric(-req.Amount), // Negative for credit
 		OriginalAmount:  utils.Float64ToNumeric(req.Amount),
@@ -291,6 +442,7 @@ func (s *paymentService) TransferToUser(ctx context.Context, req *paymentpb.Tran
 	if err != nil {
 		return nil, fmt.Errorf("failed to create receiver transaction: %w", err)
 	}
+	s.notifyCredit(ctx, req.ToUserId, "Coins received", fmt.Sprintf("You received %.2f coins", req.Amount), notify.TransactionLink(receiverTx.ID))
 
 	// Get remaining balance
 	remainingBalance, err := s.repo.GetBalance(ctx, fromUserID)
@@ -376,6 +528,7 @@ func (s *paymentService) ProcessRefund(ctx context.Context, req *paymentpb.Proce
 	if err != nil {
 		return nil, fmt.Errorf("failed to create refund transaction: %w", err)
 	}
+	s.notifyCredit(ctx, int64(userID), "Refund credited", fmt.Sprintf("%.2f coins were refunded to your wallet", req.Amount), notify.TransactionLink(refundTransaction.ID))
 
 	return &paymentpb.ProcessRefundResponse{
 		Success:             true,
@@ -0,0 +1,177 @@
+package repo
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"rival/config"
+	"rival/connection"
+	schema "rival/gen/sql"
+	"rival/internal/receipts/util"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/minio/minio-go/v7"
+)
+
+// NewReceipt is a receipt to number, render and store. The invoice number
+// and object keys of Params are filled in by CreateReceipt.
+type NewReceipt struct {
+	Params  schema.CreateReceiptParams
+	Receipt util.Receipt
+}
+
+// ReceiptRepository keeps receipt records in postgres and the rendered files
+// in MinIO under receipts/, which is private like kyc/.
+type ReceiptRepository interface {
+	CreateReceipt(ctx context.Context, receipt NewReceipt) (schema.Receipt, error)
+	GetReceiptByOrder(ctx context.Context, orderID int64) (schema.Receipt, error)
+	GetReceiptByTransaction(ctx context.Context, transactionID int64) (schema.Receipt, error)
+	PresignView(ctx context.Context, objectKey string, expiry time.Duration) (string, error)
+
+	GetOrderByID(ctx context.Context, orderID int64) (schema.Order, error)
+	GetTransactionByID(ctx context.Context, transactionID int64) (schema.Transaction, error)
+	GetMerchantByID(ctx context.Context, merchantID int64) (schema.Merchant, error)
+	GetMerchantAddresses(ctx context.Context, merchantID int64) ([]schema.MerchantAddress, error)
+	GetMerchantGSTIN(ctx context.Context, merchantID int64) (pgtype.Text, error)
+	GetUserByID(ctx context.Context, userID int64) (schema.User, error)
+}
+
+type receiptRepository struct {
+	db      *pgxpool.Pool
+	queries *schema.Queries
+	minio   *minio.Client
+	bucket  string
+}
+
+func NewReceiptRepository() (ReceiptRepository, error) {
+	cfg := config.GetConfig()
+
+	db, err := connection.GetPgConnection(&cfg.Database)
+	if err != nil {
+		return nil, err
+	}
+
+	minioClient, err := connection.NewMinioClient()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	exists, _ := minioClient.BucketExists(ctx, cfg.S3.BucketName)
+	if !exists {
+		minioClient.MakeBucket(ctx, cfg.S3.BucketName, minio.MakeBucketOptions{})
+	}
+
+	return &receiptRepository{
+		db:      db,
+		queries: schema.New(db),
+		minio:   minioClient,
+		bucket:  cfg.S3.BucketName,
+	}, nil
+}
+
+// CreateReceipt takes the merchant's next invoice number, renders the HTML
+// and PDF and uploads them before the record commits, so a stored receipt
+// always has its files and a failed upload doesn't burn a number.
+func (r *receiptRepository) CreateReceipt(ctx context.Context, receipt NewReceipt) (schema.Receipt, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return schema.Receipt{}, fmt.Errorf("failed to start transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+
+	params := receipt.Params
+	seq, err := qtx.NextInvoiceNumber(ctx, schema.NextInvoiceNumberParams{
+		MerchantID:    params.MerchantID,
+		FinancialYear: params.FinancialYear,
+	})
+	if err != nil {
+		return schema.Receipt{}, fmt.Errorf("failed to allocate invoice number: %v", err)
+	}
+	params.InvoiceNumber = util.FormatInvoiceNumber(params.FinancialYear, seq)
+	receipt.Receipt.InvoiceNumber = params.InvoiceNumber
+
+	html, err := util.RenderHTML(receipt.Receipt)
+	if err != nil {
+		return schema.Receipt{}, fmt.Errorf("failed to render receipt: %v", err)
+	}
+
+	base := fmt.Sprintf("receipts/%d/%s/%06d", params.MerchantID, params.FinancialYear, seq)
+	params.HtmlKey = base + ".html"
+	params.PdfKey = base + ".pdf"
+
+	if err := r.putObject(ctx, params.HtmlKey, "text/html; charset=utf-8", html); err != nil {
+		return schema.Receipt{}, err
+	}
+	if err := r.putObject(ctx, params.PdfKey, "application/pdf", util.RenderPDF(receipt.Receipt)); err != nil {
+		return schema.Receipt{}, err
+	}
+
+	created, err := qtx.CreateReceipt(ctx, params)
+	if err != nil {
+		return schema.Receipt{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return schema.Receipt{}, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+	return created, nil
+}
+
+func (r *receiptRepository) putObject(ctx context.Context, objectKey, contentType string, data []byte) error {
+	name := objectKey[strings.LastIndex(objectKey, "/")+1:]
+	_, err := r.minio.PutObject(ctx, r.bucket, objectKey, bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{
+		ContentType:        contentType,
+		ContentDisposition: fmt.Sprintf("inline; filename=%q", name),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upload %s: %v", objectKey, err)
+	}
+	return nil
+}
+
+func (r *receiptRepository) GetReceiptByOrder(ctx context.Context, orderID int64) (schema.Receipt, error) {
+	return r.queries.GetReceiptByOrder(ctx, pgtype.Int8{Int64: orderID, Valid: true})
+}
+
+func (r *receiptRepository) GetReceiptByTransaction(ctx context.Context, transactionID int64) (schema.Receipt, error) {
+	return r.queries.GetReceiptByTransaction(ctx, pgtype.Int8{Int64: transactionID, Valid: true})
+}
+
+func (r *receiptRepository) PresignView(ctx context.Context, objectKey string, expiry time.Duration) (string, error) {
+	url, err := r.minio.PresignedGetObject(ctx, r.bucket, objectKey, expiry, nil)
+	if err != nil {
+		return "", err
+	}
+	return url.String(), nil
+}
+
+func (r *receiptRepository) GetOrderByID(ctx context.Context, orderID int64) (schema.Order, error) {
+	return r.queries.GetOrderByID(ctx, orderID)
+}
+
+func (r *receiptRepository) GetTransactionByID(ctx context.Context, transactionID int64) (schema.Transaction, error) {
+	return r.queries.GetTransactionByID(ctx, transactionID)
+}
+
+func (r *receiptRepository) GetMerchantByID(ctx context.Context, merchantID int64) (schema.Merchant, error) {
+	return r.queries.GetMerchantByID(ctx, merchantID)
+}
+
+func (r *receiptRepository) GetMerchantAddresses(ctx context.Context, merchantID int64) ([]schema.MerchantAddress, error) {
+	return r.queries.GetMerchantAddresses(ctx, pgtype.Int8{Int64: merchantID, Valid: true})
+}
+
+func (r *receiptRepository) GetMerchantGSTIN(ctx context.Context, merchantID int64) (pgtype.Text, error) {
+	return r.queries.GetMerchantGSTIN(ctx, merchantID)
+}
+
+func (r *receiptRepository) GetUserByID(ctx context.Context, userID int64) (schema.User, error) {
+	return r.queries.GetUserByID(ctx, userID)
+}
@@ -0,0 +1,276 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"rival/config"
+	schemapb "rival/gen/proto/proto/schema"
+	schema "rival/gen/sql"
+	orderutil "rival/internal/orders/util"
+	"rival/internal/receipts/repo"
+	"rival/internal/receipts/util"
+	"rival/pkg/utils"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Viewer is who asks for a receipt. Receipts of other customers or merchants
+// are reported as not found.
+type Viewer struct {
+	UserID     int64   // the customer, 0 when a merchant asks
+	MerchantID int64   // the merchant, 0 when a customer asks
+	OutletIDs  []int64 // outlets the merchant's staff member is limited to, empty for all
+}
+
+func (v Viewer) owns(userID, merchantID int64) bool {
+	if v.UserID == 0 && v.MerchantID == 0 {
+		return false
+	}
+	return (v.UserID == 0 || v.UserID == userID) && (v.MerchantID == 0 || v.MerchantID == merchantID)
+}
+
+func (v Viewer) ownsOrder(order schema.Order) bool {
+	if !v.owns(order.UserID.Int64, order.MerchantID.Int64) {
+		return false
+	}
+	return len(v.OutletIDs) == 0 || (order.OutletID.Valid && slices.Contains(v.OutletIDs, order.OutletID.Int64))
+}
+
+// ReceiptService issues receipts on first request and hands out short lived
+// links to the stored files afterwards.
+type ReceiptService interface {
+	OrderReceipt(ctx context.Context, orderID int64, viewer Viewer) (*schemapb.Receipt, error)
+	TransactionReceipt(ctx context.Context, transactionID int64, viewer Viewer) (*schemapb.Receipt, error)
+}
+
+type receiptService struct {
+	repo    repo.ReceiptRepository
+	gstRate float64
+	viewTTL time.Duration
+}
+
+func NewReceiptService(repo repo.ReceiptRepository) ReceiptService {
+	cfg := config.GetConfig().Receipts
+	viewMinutes := cfg.ViewURLMinutes
+	if viewMinutes <= 0 {
+		viewMinutes = 15
+	}
+	return &receiptService{
+		repo:    repo,
+		gstRate: cfg.GSTRatePercent,
+		viewTTL: time.Duration(viewMinutes) * time.Minute,
+	}
+}
+
+func (s *receiptService) OrderReceipt(ctx context.Context, orderID int64, viewer Viewer) (*schemapb.Receipt, error) {
+	order, err := s.repo.GetOrderByID(ctx, orderID)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && !viewer.ownsOrder(order)) {
+		return nil, status.Error(codes.NotFound, "order not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	existing, err := s.repo.GetReceiptByOrder(ctx, orderID)
+	if err == nil {
+		return s.present(ctx, existing)
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("failed to get receipt: %w", err)
+	}
+
+	if order.Status != orderutil.StatusCompleted {
+		return nil, status.Error(codes.FailedPrecondition, "receipts are issued once the order is completed")
+	}
+
+	subtotal := utils.NumericToFloat64(order.Subtotal)
+	total := utils.NumericToFloat64(order.TotalAmount)
+	receipt := util.Receipt{
+		Reference: "Order " + order.OrderNumber,
+		Lines:     util.ParseItems(order.Items, subtotal),
+		Subtotal:  subtotal,
+		Discount:  utils.NumericToFloat64(order.DiscountAmount),
+		Total:     total,
+		CoinsUsed: utils.NumericToFloat64(order.CoinsUsed),
+	}
+
+	params := schema.CreateReceiptParams{
+		MerchantID: order.MerchantID.Int64,
+		UserID:     order.UserID,
+		OrderID:    pgtype.Int8{Int64: order.ID, Valid: true},
+	}
+
+	created, err := s.issue(ctx, params, receipt, order.OutletID)
+	if err != nil {
+		// Lost a race with another request for the same receipt
+		if existing, getErr := s.repo.GetReceiptByOrder(ctx, orderID); getErr == nil {
+			return s.present(ctx, existing)
+		}
+		return nil, err
+	}
+	return s.present(ctx, created)
+}
+
+func (s *receiptService) TransactionReceipt(ctx context.Context, transactionID int64, viewer Viewer) (*schemapb.Receipt, error) {
+	transaction, err := s.repo.GetTransactionByID(ctx, transactionID)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && !viewer.owns(transaction.UserID.Int64, transaction.MerchantID.Int64)) {
+		return nil, status.Error(codes.NotFound, "transaction not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	// An order is invoiced once, paying for it doesn't issue a second invoice
+	if transaction.OrderID.Valid {
+		return s.OrderReceipt(ctx, transaction.OrderID.Int64, viewer)
+	}
+	if transaction.TransactionType.String != "payment" || transaction.Status.String != "completed" {
+		return nil, status.Error(codes.FailedPrecondition, "receipts are only issued for completed payments to merchants")
+	}
+
+	existing, err := s.repo.GetReceiptByTransaction(ctx, transactionID)
+	if err == nil {
+		return s.present(ctx, existing)
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("failed to get receipt: %w", err)
+	}
+
+	original := utils.NumericToFloat64(transaction.OriginalAmount)
+	final := utils.NumericToFloat64(transaction.FinalAmount)
+	receipt := util.Receipt{
+		Reference: "Transaction " + strconv.FormatInt(transaction.ID, 10),
+		Lines:     []util.Line{{Name: "Payment", Quantity: 1, UnitPrice: original, Amount: original}},
+		Subtotal:  original,
+		Discount:  utils.NumericToFloat64(transaction.DiscountAmount),
+		Total:     final,
+		CoinsUsed: utils.NumericToFloat64(transaction.CoinsSpent),
+	}
+
+	params := schema.CreateReceiptParams{
+		MerchantID:    transaction.MerchantID.Int64,
+		UserID:        transaction.UserID,
+		TransactionID: pgtype.Int8{Int64: transaction.ID, Valid: true},
+	}
+
+	created, err := s.issue(ctx, params, receipt, pgtype.Int8{})
+	if err != nil {
+		if existing, getErr := s.repo.GetReceiptByTransaction(ctx, transactionID); getErr == nil {
+			return s.present(ctx, existing)
+		}
+		return nil, err
+	}
+	return s.present(ctx, created)
+}
+
+// issue fills in the merchant and customer blocks, dates the receipt in the
+// merchant's timezone and stores it.
+func (s *receiptService) issue(ctx context.Context, params schema.CreateReceiptParams, receipt util.Receipt, outletID pgtype.Int8) (schema.Receipt, error) {
+	merchant, err := s.repo.GetMerchantByID(ctx, params.MerchantID)
+	if err != nil {
+		return schema.Receipt{}, fmt.Errorf("failed to get merchant: %w", err)
+	}
+
+	gstin, err := s.repo.GetMerchantGSTIN(ctx, merchant.ID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return schema.Receipt{}, fmt.Errorf("failed to get GSTIN: %w", err)
+	}
+
+	addresses, err := s.repo.GetMerchantAddresses(ctx, merchant.ID)
+	if err != nil {
+		return schema.Receipt{}, fmt.Errorf("failed to get merchant address: %w", err)
+	}
+
+	receipt.Merchant = util.Party{
+		Name:    merchant.Name,
+		Address: formatAddress(pickAddress(addresses, outletID)),
+		Phone:   merchant.Phone.String,
+		GSTIN:   gstin.String,
+	}
+
+	receipt.Title = util.TitleReceipt
+	if gstin.String != "" {
+		receipt.Title = util.TitleTaxInvoice
+		receipt.Tax = util.IncludedGST(receipt.Total, s.gstRate)
+		params.Gstin = gstin
+	}
+
+	if params.UserID.Valid {
+		customer, err := s.repo.GetUserByID(ctx, params.UserID.Int64)
+		if err != nil {
+			return schema.Receipt{}, fmt.Errorf("failed to get customer: %w", err)
+		}
+		receipt.Customer = util.Party{Name: customer.Name, Phone: customer.Phone.String, Email: customer.Email}
+	}
+
+	location, err := time.LoadLocation(merchant.Timezone)
+	if err != nil {
+		location = time.UTC
+	}
+	receipt.IssuedAt = time.Now().In(location)
+	params.FinancialYear = util.FinancialYear(receipt.IssuedAt)
+
+	return s.repo.CreateReceipt(ctx, repo.NewReceipt{Params: params, Receipt: receipt})
+}
+
+func (s *receiptService) present(ctx context.Context, receipt schema.Receipt) (*schemapb.Receipt, error) {
+	htmlURL, err := s.repo.PresignView(ctx, receipt.HtmlKey, s.viewTTL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to presign receipt: %w", err)
+	}
+	pdfURL, err := s.repo.PresignView(ctx, receipt.PdfKey, s.viewTTL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to presign receipt: %w", err)
+	}
+
+	return &schemapb.Receipt{
+		Id:            receipt.ID,
+		MerchantId:    receipt.MerchantID,
+		UserId:        receipt.UserID.Int64,
+		OrderId:       receipt.OrderID.Int64,
+		TransactionId: receipt.TransactionID.Int64,
+		InvoiceNumber: receipt.InvoiceNumber,
+		FinancialYear: receipt.FinancialYear,
+		Gstin:         receipt.Gstin.String,
+		HtmlUrl:       htmlURL,
+		PdfUrl:        pdfURL,
+		UrlsExpireAt:  time.Now().Add(s.viewTTL).Unix(),
+		CreatedAt:     receipt.CreatedAt.Time.Unix(),
+	}, nil
+}
+
+// pickAddress prefers the outlet the order was placed at, then the primary
+// address, which GetMerchantAddresses lists first.
+func pickAddress(addresses []schema.MerchantAddress, outletID pgtype.Int8) *schema.MerchantAddress {
+	for i := range addresses {
+		if outletID.Valid && addresses[i].ID == outletID.Int64 {
+			return &addresses[i]
+		}
+	}
+	if len(addresses) > 0 {
+		return &addresses[0]
+	}
+	return nil
+}
+
+func formatAddress(address *schema.MerchantAddress) string {
+	if address == nil {
+		return ""
+	}
+	var parts []string
+	for _, part := range []pgtype.Text{address.Street, address.City, address.State, address.PostalCode} {
+		if part.String != "" {
+			parts = append(parts, part.String)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
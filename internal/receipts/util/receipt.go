@@ -0,0 +1,132 @@
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+)
+
+// Receipt is everything printed on an order or payment receipt. Amounts are
+// in rupees; prices are GST inclusive.
+type Receipt struct {
+	Title         string // Tax Invoice when the merchant has a GSTIN, Receipt otherwise
+	InvoiceNumber string
+	IssuedAt      time.Time // in the merchant's timezone
+	Reference     string    // order number or transaction ID
+	Merchant      Party
+	Customer      Party
+	Lines         []Line
+	Subtotal      float64
+	Discount      float64
+	Total         float64
+	CoinsUsed     float64
+	Tax           Tax
+}
+
+// Party is the seller or buyer block of a receipt.
+type Party struct {
+	Name    string
+	Address string
+	Phone   string
+	Email   string
+	GSTIN   string
+}
+
+type Line struct {
+	Name      string
+	Quantity  int
+	UnitPrice float64
+	Amount    float64
+}
+
+// Tax is the GST included in a receipt total, split evenly between the
+// central and state share since orders are supplied within the state.
+type Tax struct {
+	RatePercent float64
+	Taxable     float64
+	CGST        float64
+	SGST        float64
+}
+
+// Receipt titles
+const (
+	TitleTaxInvoice = "Tax Invoice"
+	TitleReceipt    = "Receipt"
+)
+
+// FinancialYear is the Indian financial year (April to March) t falls in,
+// written like 2026-27.
+func FinancialYear(t time.Time) string {
+	start := t.Year()
+	if t.Month() < time.April {
+		start--
+	}
+	return fmt.Sprintf("%d-%02d", start, (start+1)%100)
+}
+
+// FormatInvoiceNumber writes the seq'th invoice of a financial year, e.g.
+// 2026-27/000042. GST caps invoice numbers at 16 characters.
+func FormatInvoiceNumber(financialYear string, seq int64) string {
+	return fmt.Sprintf("%s/%06d", financialYear, seq)
+}
+
+// IncludedGST works out the GST contained in a tax-inclusive total. The
+// split is done in paise so CGST + SGST + taxable value add up exactly.
+func IncludedGST(total, ratePercent float64) Tax {
+	totalPaise := int64(math.Round(total * 100))
+	taxablePaise := int64(math.Round(float64(totalPaise) * 100 / (100 + ratePercent)))
+	taxPaise := totalPaise - taxablePaise
+	cgstPaise := taxPaise / 2
+
+	return Tax{
+		RatePercent: ratePercent,
+		Taxable:     float64(taxablePaise) / 100,
+		CGST:        float64(cgstPaise) / 100,
+		SGST:        float64(taxPaise-cgstPaise) / 100,
+	}
+}
+
+// orderItem reads both the free-form items clients send and the priced
+// catalog lines stored for catalog orders.
+type orderItem struct {
+	Name      string  `json:"name"`
+	Quantity  int     `json:"quantity"`
+	Price     float64 `json:"price"`
+	UnitPrice float64 `json:"unit_price"`
+	Subtotal  float64 `json:"subtotal"`
+}
+
+// ParseItems turns an order's items snapshot into receipt lines. Items that
+// can't be read become a single line for the whole subtotal.
+func ParseItems(items []byte, subtotal float64) []Line {
+	var parsed []orderItem
+	if err := json.Unmarshal(items, &parsed); err != nil || len(parsed) == 0 {
+		return []Line{{Name: "Order items", Quantity: 1, UnitPrice: subtotal, Amount: subtotal}}
+	}
+
+	lines := make([]Line, 0, len(parsed))
+	for _, item := range parsed {
+		quantity := item.Quantity
+		if quantity <= 0 {
+			quantity = 1
+		}
+		unit := item.UnitPrice
+		if unit == 0 {
+			unit = item.Price
+		}
+		amount := item.Subtotal
+		if amount == 0 {
+			amount = unit * float64(quantity)
+		}
+		if unit == 0 {
+			unit = amount / float64(quantity)
+		}
+		name := item.Name
+		if name == "" {
+			name = "Item"
+		}
+		lines = append(lines, Line{Name: name, Quantity: quantity, UnitPrice: unit, Amount: amount})
+	}
+	return lines
+}
@@ -0,0 +1,100 @@
+package util
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFinancialYear(t *testing.T) {
+	cases := map[string]string{
+		"2026-03-31": "2025-26",
+		"2026-04-01": "2026-27",
+		"2026-12-31": "2026-27",
+		"2099-06-15": "2099-00",
+	}
+	for date, want := range cases {
+		day, _ := time.Parse("2006-01-02", date)
+		if got := FinancialYear(day); got != want {
+			t.Errorf("FinancialYear(%s) = %s, want %s", date, got, want)
+		}
+	}
+
+	if got := FormatInvoiceNumber("2026-27", 42); got != "2026-27/000042" || len(got) > 16 {
+		t.Errorf("FormatInvoiceNumber = %s", got)
+	}
+}
+
+func TestIncludedGST(t *testing.T) {
+	for _, total := range []float64{105, 99.99, 0.01, 1234.57} {
+		tax := IncludedGST(total, 5)
+		sum := tax.Taxable + tax.CGST + tax.SGST
+		if diff := sum - total; diff > 0.001 || diff < -0.001 {
+			t.Errorf("IncludedGST(%.2f) parts add up to %.2f", total, sum)
+		}
+	}
+
+	tax := IncludedGST(105, 5)
+	if tax.Taxable != 100 || tax.CGST != 2.5 || tax.SGST != 2.5 {
+		t.Errorf("IncludedGST(105, 5) = %+v", tax)
+	}
+}
+
+func TestParseItems(t *testing.T) {
+	freeForm := ParseItems([]byte(`[{"name":"Tea","quantity":2,"price":10}]`), 20)
+	if len(freeForm) != 1 || freeForm[0].UnitPrice != 10 || freeForm[0].Amount != 20 {
+		t.Errorf("Unexpected free-form lines %+v", freeForm)
+	}
+
+	catalog := ParseItems([]byte(`[{"item_id":1,"name":"Dosa","quantity":3,"unit_price":40,"subtotal":120}]`), 120)
+	if len(catalog) != 1 || catalog[0].UnitPrice != 40 || catalog[0].Amount != 120 {
+		t.Errorf("Unexpected catalog lines %+v", catalog)
+	}
+
+	unreadable := ParseItems([]byte(`not json`), 55)
+	if len(unreadable) != 1 || unreadable[0].Amount != 55 {
+		t.Errorf("Expected a single line for unreadable items, got %+v", unreadable)
+	}
+}
+
+func TestRender(t *testing.T) {
+	receipt := Receipt{
+		Title:         TitleTaxInvoice,
+		InvoiceNumber: "2026-27/000001",
+		IssuedAt:      time.Date(2026, 10, 18, 12, 30, 0, 0, time.UTC),
+		Reference:     "Order 00K7",
+		Merchant:      Party{Name: "Chai & Co", GSTIN: "29ABCDE1234F1Z5"},
+		Customer:      Party{Name: "<script>alert(1)</script>"},
+		Lines:         []Line{{Name: "Tea", Quantity: 2, UnitPrice: 52.5, Amount: 105}},
+		Subtotal:      105,
+		Total:         105,
+		Tax:           IncludedGST(105, 5),
+	}
+
+	html, err := RenderHTML(receipt)
+	if err != nil {
+		t.Fatalf("RenderHTML returned error: %v", err)
+	}
+	page := string(html)
+	for _, want := range []string{"2026-27/000001", "GSTIN 29ABCDE1234F1Z5", "Chai &amp; Co", "CGST @ 2.5%", "₹105.00"} {
+		if !strings.Contains(page, want) {
+			t.Errorf("Expected HTML to contain %q", want)
+		}
+	}
+	if strings.Contains(page, "<script>") {
+		t.Errorf("Expected customer name to be escaped")
+	}
+
+	pdf := RenderPDF(receipt)
+	if !bytes.HasPrefix(pdf, []byte("%PDF-")) || !bytes.Contains(pdf, []byte("2026-27/000001")) {
+		t.Errorf("Expected a PDF with the invoice number")
+	}
+
+	// Without a GSTIN there is no tax breakdown
+	receipt.Title, receipt.Merchant.GSTIN = TitleReceipt, ""
+	html, _ = RenderHTML(receipt)
+	if strings.Contains(string(html), "CGST") {
+		t.Errorf("Expected no tax breakdown without a GSTIN")
+	}
+}
@@ -0,0 +1,189 @@
+package util
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+
+	"rival/pkg/pdf"
+)
+
+var receiptTemplate = template.Must(template.New("receipt").Funcs(template.FuncMap{
+	"money": func(amount float64) string { return fmt.Sprintf("₹%.2f", amount) },
+	"half":  func(rate float64) float64 { return rate / 2 },
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}} {{.InvoiceNumber}}</title>
+<style>
+body { font-family: Helvetica, Arial, sans-serif; color: #222; max-width: 720px; margin: 24px auto; }
+table { width: 100%; border-collapse: collapse; }
+th, td { padding: 6px 4px; border-bottom: 1px solid #ddd; text-align: left; }
+.num { text-align: right; }
+.parties { display: flex; justify-content: space-between; margin: 16px 0; }
+.totals td { border: none; }
+.muted { color: #666; font-size: 0.9em; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<p>Invoice No. <strong>{{.InvoiceNumber}}</strong><br>
+Date {{.IssuedAt.Format "02 Jan 2006 15:04"}}<br>
+Reference {{.Reference}}</p>
+<div class="parties">
+<div>
+<strong>{{.Merchant.Name}}</strong><br>
+{{with .Merchant.Address}}{{.}}<br>{{end}}
+{{with .Merchant.Phone}}{{.}}<br>{{end}}
+{{with .Merchant.GSTIN}}GSTIN {{.}}{{end}}
+</div>
+<div>
+Billed to<br>
+<strong>{{.Customer.Name}}</strong><br>
+{{with .Customer.Phone}}{{.}}<br>{{end}}
+{{with .Customer.Email}}{{.}}{{end}}
+</div>
+</div>
+<table>
+<tr><th>Item</th><th class="num">Qty</th><th class="num">Rate</th><th class="num">Amount</th></tr>
+{{range .Lines}}<tr><td>{{.Name}}</td><td class="num">{{.Quantity}}</td><td class="num">{{money .UnitPrice}}</td><td class="num">{{money .Amount}}</td></tr>
+{{end}}</table>
+<table class="totals">
+<tr><td>Subtotal</td><td class="num">{{money .Subtotal}}</td></tr>
+{{if .Discount}}<tr><td>Discount</td><td class="num">-{{money .Discount}}</td></tr>{{end}}
+<tr><td><strong>Total</strong></td><td class="num"><strong>{{money .Total}}</strong></td></tr>
+{{if .CoinsUsed}}<tr><td>Paid with coins</td><td class="num">{{money .CoinsUsed}}</td></tr>{{end}}
+</table>
+{{if .Merchant.GSTIN}}<table class="totals muted">
+<tr><td>Taxable value</td><td class="num">{{money .Tax.Taxable}}</td></tr>
+<tr><td>CGST @ {{half .Tax.RatePercent}}%</td><td class="num">{{money .Tax.CGST}}</td></tr>
+<tr><td>SGST @ {{half .Tax.RatePercent}}%</td><td class="num">{{money .Tax.SGST}}</td></tr>
+</table>
+<p class="muted">Prices are inclusive of GST.</p>{{end}}
+</body>
+</html>
+`))
+
+// RenderHTML renders the receipt as a standalone HTML page.
+func RenderHTML(receipt Receipt) ([]byte, error) {
+	var out bytes.Buffer
+	if err := receiptTemplate.Execute(&out, receipt); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// PDF layout, in points
+const (
+	margin     = 48.0
+	lineHeight = 14.0
+	bodySize   = 10.0
+)
+
+// RenderPDF renders the receipt as an A4 PDF. The standard fonts have no
+// rupee sign, so amounts are written with Rs.
+func RenderPDF(receipt Receipt) []byte {
+	doc := pdf.New(receipt.Title + " " + receipt.InvoiceNumber)
+	right := pdf.PageWidth - margin
+	y := margin + 18
+
+	write := func(font pdf.Font, s string) {
+		doc.Text(margin, y, font, bodySize, s)
+		y += lineHeight
+	}
+	amountRow := func(label string, amount float64) {
+		doc.Text(margin, y, pdf.Helvetica, bodySize, label)
+		doc.TextRight(right, y, bodySize, rupees(amount))
+		y += lineHeight
+	}
+
+	doc.Text(margin, y, pdf.HelveticaBold, 18, receipt.Title)
+	y += 28
+	write(pdf.Helvetica, "Invoice No. "+receipt.InvoiceNumber)
+	write(pdf.Helvetica, "Date "+receipt.IssuedAt.Format("02 Jan 2006 15:04"))
+	write(pdf.Helvetica, "Reference "+receipt.Reference)
+	y += lineHeight
+
+	write(pdf.HelveticaBold, receipt.Merchant.Name)
+	for _, s := range []string{receipt.Merchant.Address, receipt.Merchant.Phone} {
+		if s != "" {
+			write(pdf.Helvetica, s)
+		}
+	}
+	if receipt.Merchant.GSTIN != "" {
+		write(pdf.Helvetica, "GSTIN "+receipt.Merchant.GSTIN)
+	}
+	y += lineHeight
+
+	write(pdf.Helvetica, "Billed to")
+	write(pdf.HelveticaBold, receipt.Customer.Name)
+	for _, s := range []string{receipt.Customer.Phone, receipt.Customer.Email} {
+		if s != "" {
+			write(pdf.Helvetica, s)
+		}
+	}
+	y += lineHeight
+
+	// Item table: name on the left, quantity, rate and amount right aligned
+	qtyCol, rateCol := right-200, right-100
+	doc.Text(margin, y, pdf.HelveticaBold, bodySize, "Item")
+	doc.Text(qtyCol-pdf.TextWidth(bodySize, "Qty"), y, pdf.HelveticaBold, bodySize, "Qty")
+	doc.Text(rateCol-pdf.TextWidth(bodySize, "Rate"), y, pdf.HelveticaBold, bodySize, "Rate")
+	doc.Text(right-pdf.TextWidth(bodySize, "Amount"), y, pdf.HelveticaBold, bodySize, "Amount")
+	y += 6
+	doc.Line(margin, y, right, y)
+	y += lineHeight
+
+	for _, line := range receipt.Lines {
+		if y > pdf.PageHeight-margin-8*lineHeight {
+			doc.AddPage()
+			y = margin + lineHeight
+		}
+		doc.Text(margin, y, pdf.Helvetica, bodySize, truncate(line.Name, 48))
+		doc.TextRight(qtyCol, y, bodySize, fmt.Sprintf("%d", line.Quantity))
+		doc.TextRight(rateCol, y, bodySize, rupees(line.UnitPrice))
+		doc.TextRight(right, y, bodySize, rupees(line.Amount))
+		y += lineHeight
+	}
+	y -= 8
+	doc.Line(margin, y, right, y)
+	y += lineHeight + 4
+
+	amountRow("Subtotal", receipt.Subtotal)
+	if receipt.Discount != 0 {
+		amountRow("Discount", -receipt.Discount)
+	}
+	doc.Text(margin, y, pdf.HelveticaBold, bodySize, "Total")
+	doc.TextRight(right, y, bodySize, rupees(receipt.Total))
+	y += lineHeight
+	if receipt.CoinsUsed != 0 {
+		amountRow("Paid with coins", receipt.CoinsUsed)
+	}
+
+	if receipt.Merchant.GSTIN != "" {
+		y += lineHeight
+		half := receipt.Tax.RatePercent / 2
+		amountRow("Taxable value", receipt.Tax.Taxable)
+		amountRow(fmt.Sprintf("CGST @ %g%%", half), receipt.Tax.CGST)
+		amountRow(fmt.Sprintf("SGST @ %g%%", half), receipt.Tax.SGST)
+		write(pdf.Helvetica, "Prices are inclusive of GST.")
+	}
+
+	return doc.Bytes()
+}
+
+func rupees(amount float64) string {
+	if amount < 0 {
+		return fmt.Sprintf("-Rs. %.2f", -amount)
+	}
+	return fmt.Sprintf("Rs. %.2f", amount)
+}
+
+func truncate(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return string(runes[:max-3]) + "..."
+}
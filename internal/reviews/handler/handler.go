@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	reviewpb "rival/gen/proto/proto/api"
+	"rival/internal/reviews/repo"
+	"rival/internal/reviews/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ReviewHandler struct {
+	reviewpb.UnimplementedReviewServiceServer
+	service service.ReviewService
+}
+
+func NewReviewHandler() (*ReviewHandler, error) {
+	repository, err := repo.NewReviewRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReviewHandler{
+		service: service.NewReviewService(repository),
+	}, nil
+}
+
+func (h *ReviewHandler) CreateReview(ctx context.Context, req *reviewpb.CreateReviewRequest) (*reviewpb.CreateReviewResponse, error) {
+	userID, err := customerFromContext(ctx, "sign in to review an order")
+	if err != nil {
+		return nil, err
+	}
+	if req.OrderId == 0 {
+		return nil, errors.New("order ID is required")
+	}
+
+	review, err := h.service.Create(ctx, userID, req)
+	if err != nil {
+		return nil, err
+	}
+	return &reviewpb.CreateReviewResponse{Review: review}, nil
+}
+
+func (h *ReviewHandler) RequestReviewPhotoUpload(ctx context.Context, req *reviewpb.RequestReviewPhotoUploadRequest) (*reviewpb.RequestReviewPhotoUploadResponse, error) {
+	userID, err := customerFromContext(ctx, "sign in to review an order")
+	if err != nil {
+		return nil, err
+	}
+	if req.OrderId == 0 {
+		return nil, errors.New("order ID is required")
+	}
+
+	return h.service.RequestPhotoUpload(ctx, userID, req)
+}
+
+func (h *ReviewHandler) ListMerchantReviews(ctx context.Context, req *reviewpb.ListMerchantReviewsRequest) (*reviewpb.ListMerchantReviewsResponse, error) {
+	if req.MerchantId == 0 {
+		return nil, errors.New("merchant ID is required")
+	}
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.Limit <= 0 || req.Limit > 50 {
+		req.Limit = 20
+	}
+
+	return h.service.ListMerchantReviews(ctx, req)
+}
+
+func (h *ReviewHandler) FlagReview(ctx context.Context, req *reviewpb.FlagReviewRequest) (*reviewpb.FlagReviewResponse, error) {
+	userID, err := customerFromContext(ctx, "sign in to flag a review")
+	if err != nil {
+		return nil, err
+	}
+	if req.ReviewId == 0 {
+		return nil, errors.New("review ID is required")
+	}
+
+	if err := h.service.Flag(ctx, userID, req.ReviewId, req.Reason); err != nil {
+		return nil, err
+	}
+	return &reviewpb.FlagReviewResponse{Success: true}, nil
+}
+
+// customerFromContext returns the signed-in user. Merchant API keys can't
+// write or flag reviews.
+func customerFromContext(ctx context.Context, message string) (int64, error) {
+	if authType, _ := ctx.Value("auth_type").(string); authType == "api_key" {
+		return 0, status.Error(codes.PermissionDenied, "reviews are written by customers")
+	}
+	userID, _ := ctx.Value("user_id").(int)
+	if userID == 0 {
+		return 0, status.Error(codes.Unauthenticated, message)
+	}
+	return int64(userID), nil
+}
@@ -0,0 +1,178 @@
+package handler
+
+import (
+	"context"
+	"math/big"
+	"rival/config"
+	"rival/connection"
+	pb "rival/gen/proto/proto/api"
+	schemapb "rival/gen/proto/proto/schema"
+	schema "rival/gen/sql"
+	authHandler "rival/internal/auth/handler"
+	orderutil "rival/internal/orders/util"
+	"rival/internal/reviews/util"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// NewReviewUser creates a test user for review testing
+func NewReviewUser(ctx context.Context, email string, role schemapb.UserRole, t *testing.T) (*schema.Queries, schema.User) {
+	handler, err := authHandler.NewAuthHandler()
+	if err != nil {
+		t.Fatalf("Failed to create auth handler: %v", err)
+	}
+
+	_, err = handler.Signup(ctx, &pb.SignupRequest{
+		Name:     "Test Review User",
+		Email:    email,
+		Password: "Rival-Passw0rd",
+		Role:     role,
+		Phone:    "9876543210",
+	})
+	if err != nil {
+		t.Fatalf("Failed to signup user: %v", err)
+	}
+
+	cfg := config.GetConfig()
+	db, err := connection.GetPgConnection(&cfg.Database)
+	if err != nil {
+		t.Fatalf("Failed to get db connection: %v", err)
+	}
+
+	repo := schema.New(db)
+	user, err := repo.GetUserByEmail(ctx, email)
+	if err != nil {
+		t.Fatalf("Failed to get user by email: %v", err)
+	}
+	return repo, user
+}
+
+func createOrder(ctx context.Context, repo *schema.Queries, merchantID, userID int64, number, status string, t *testing.T) schema.Order {
+	amount := pgtype.Numeric{Int: big.NewInt(100), Exp: 0, Valid: true}
+	order, err := repo.CreateOrder(ctx, schema.CreateOrderParams{
+		MerchantID:     pgtype.Int8{Int64: merchantID, Valid: true},
+		UserID:         pgtype.Int8{Int64: userID, Valid: true},
+		OrderNumber:    number,
+		Items:          []byte(`[{"name":"Coffee","quantity":1,"price":100}]`),
+		Subtotal:       amount,
+		DiscountAmount: pgtype.Numeric{Int: big.NewInt(0), Exp: 0, Valid: true},
+		TotalAmount:    amount,
+		CoinsUsed:      pgtype.Numeric{Int: big.NewInt(0), Exp: 0, Valid: true},
+		Status:         status,
+		NumberPeriod:   "test",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create order: %v", err)
+	}
+	return order
+}
+
+func TestReviews(t *testing.T) {
+	ctx := context.Background()
+
+	repo, customer := NewReviewUser(ctx, "test-review-customer@example.com", schemapb.UserRole_USER_ROLE_CUSTOMER, t)
+	defer repo.DleteUser(ctx, customer.ID)
+	_, other := NewReviewUser(ctx, "test-review-other@example.com", schemapb.UserRole_USER_ROLE_CUSTOMER, t)
+	defer repo.DleteUser(ctx, other.ID)
+	_, owner := NewReviewUser(ctx, "test-review-merchant@example.com", schemapb.UserRole_USER_ROLE_MERCHANT, t)
+	defer repo.DleteUser(ctx, owner.ID)
+
+	merchant, err := repo.CreateMerchant(ctx, schema.CreateMerchantParams{
+		Name:               "Test Review Merchant",
+		Email:              owner.Email,
+		DiscountPercentage: pgtype.Numeric{Int: big.NewInt(10), Exp: 0, Valid: true},
+		IsActive:           pgtype.Bool{Bool: true, Valid: true},
+		Status:             "approved",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create merchant: %v", err)
+	}
+	defer repo.DeleteMerchant(ctx, merchant.ID)
+
+	completed := createOrder(ctx, repo, merchant.ID, customer.ID, "TRV1", orderutil.StatusCompleted, t)
+	pending := createOrder(ctx, repo, merchant.ID, customer.ID, "TRV2", orderutil.StatusPending, t)
+
+	h, err := NewReviewHandler()
+	if err != nil {
+		t.Fatalf("Failed to create handler: %v", err)
+	}
+
+	customerCtx := context.WithValue(ctx, "user_id", int(customer.ID))
+	otherCtx := context.WithValue(ctx, "user_id", int(other.ID))
+
+	if _, err := h.CreateReview(customerCtx, &pb.CreateReviewRequest{OrderId: pending.ID, Rating: 5}); err == nil {
+		t.Errorf("Expected a review of a pending order to be refused")
+	}
+	if _, err := h.CreateReview(otherCtx, &pb.CreateReviewRequest{OrderId: completed.ID, Rating: 5}); err == nil {
+		t.Errorf("Expected a review of another customer's order to be refused")
+	}
+	if _, err := h.CreateReview(customerCtx, &pb.CreateReviewRequest{OrderId: completed.ID, Rating: 6}); err == nil {
+		t.Errorf("Expected a rating of 6 to be refused")
+	}
+
+	created, err := h.CreateReview(customerCtx, &pb.CreateReviewRequest{OrderId: completed.ID, Rating: 4, Body: "Good coffee"})
+	if err != nil {
+		t.Fatalf("CreateReview returned error: %v", err)
+	}
+	if created.Review.Rating != 4 || created.Review.Status != util.StatusPublished {
+		t.Errorf("Unexpected review %+v", created.Review)
+	}
+
+	if _, err := h.CreateReview(customerCtx, &pb.CreateReviewRequest{OrderId: completed.ID, Rating: 1}); err == nil {
+		t.Errorf("Expected a second review of the same order to be refused")
+	}
+
+	list, err := h.ListMerchantReviews(ctx, &pb.ListMerchantReviewsRequest{MerchantId: merchant.ID})
+	if err != nil {
+		t.Fatalf("ListMerchantReviews returned error: %v", err)
+	}
+	if list.TotalCount != 1 || list.RatingCount != 1 || list.RatingAverage != 4 {
+		t.Errorf("Expected one review rated 4, got %+v", list)
+	}
+
+	// Flagging twice counts once
+	for i := 0; i < 2; i++ {
+		if _, err := h.FlagReview(otherCtx, &pb.FlagReviewRequest{ReviewId: created.Review.Id, Reason: "spam"}); err != nil {
+			t.Fatalf("FlagReview returned error: %v", err)
+		}
+	}
+	review, err := repo.GetReview(ctx, created.Review.Id)
+	if err != nil {
+		t.Fatalf("Failed to get review: %v", err)
+	}
+	if review.FlagCount != 1 {
+		t.Errorf("Expected 1 flag, got %d", review.FlagCount)
+	}
+
+	// Hiding takes the rating out of the merchant's average
+	if _, err := h.service.Moderate(ctx, review.ID, owner.ID, util.ActionHide, "spam"); err != nil {
+		t.Fatalf("Moderate returned error: %v", err)
+	}
+	updated, err := repo.GetMerchantByID(ctx, merchant.ID)
+	if err != nil {
+		t.Fatalf("Failed to get merchant: %v", err)
+	}
+	if updated.RatingCount != 0 || updated.RatingTotal != 0 {
+		t.Errorf("Expected the hidden rating to be removed, got %d ratings totalling %d", updated.RatingCount, updated.RatingTotal)
+	}
+	if _, err := h.service.Moderate(ctx, review.ID, owner.ID, util.ActionHide, "spam"); err == nil {
+		t.Errorf("Expected hiding a hidden review to fail")
+	}
+
+	if _, err := h.service.Moderate(ctx, review.ID, owner.ID, util.ActionRestore, ""); err != nil {
+		t.Fatalf("Moderate returned error: %v", err)
+	}
+	replied, err := h.service.Reply(ctx, merchant.ID, review.ID, owner.ID, "Thanks!")
+	if err != nil {
+		t.Fatalf("Reply returned error: %v", err)
+	}
+	if replied.Reply != "Thanks!" || replied.RepliedAt == 0 {
+		t.Errorf("Unexpected reply %+v", replied)
+	}
+
+	updated, _ = repo.GetMerchantByID(ctx, merchant.ID)
+	if updated.RatingCount != 1 || updated.RatingTotal != 4 {
+		t.Errorf("Expected the restored rating to count, got %d ratings totalling %d", updated.RatingCount, updated.RatingTotal)
+	}
+}
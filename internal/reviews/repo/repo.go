@@ -0,0 +1,266 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"rival/config"
+	"rival/connection"
+	schema "rival/gen/sql"
+	"rival/internal/reviews/util"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/minio/minio-go/v7"
+)
+
+// NewReview is a review to post along with the photos already uploaded for it.
+type NewReview struct {
+	Params    schema.CreateReviewParams
+	PhotoKeys []string
+}
+
+// ReviewRepository keeps reviews in postgres and their photos in MinIO under
+// reviews/. Every change to a published rating updates the merchant's totals
+// in the same transaction.
+type ReviewRepository interface {
+	CreateReview(ctx context.Context, review NewReview) (schema.Review, error)
+	GetReview(ctx context.Context, reviewID int64) (schema.Review, error)
+	GetReviewByOrder(ctx context.Context, orderID int64) (schema.Review, error)
+	ListMerchantReviews(ctx context.Context, params schema.ListMerchantReviewsParams) ([]schema.ListMerchantReviewsRow, error)
+	CountMerchantReviews(ctx context.Context, merchantID int64) (int64, error)
+	ListFlaggedReviews(ctx context.Context, params schema.ListFlaggedReviewsParams) ([]schema.Review, error)
+	CountFlaggedReviews(ctx context.Context) (int64, error)
+	ListReviewPhotos(ctx context.Context, reviewIDs []int64) ([]schema.ReviewPhoto, error)
+	ReplyToReview(ctx context.Context, params schema.ReplyToReviewParams) (schema.Review, error)
+	FlagReview(ctx context.Context, params schema.FlagReviewParams) (bool, error)
+	ModerateReview(ctx context.Context, params schema.ModerateReviewParams) (schema.Review, error)
+
+	GetOrderByID(ctx context.Context, orderID int64) (schema.Order, error)
+	GetMerchantByID(ctx context.Context, merchantID int64) (schema.Merchant, error)
+	GetUserByID(ctx context.Context, userID int64) (schema.User, error)
+
+	PresignUpload(ctx context.Context, objectKey, contentType string, maxBytes int64, expiry time.Duration) (string, map[string]string, error)
+	StatObject(ctx context.Context, objectKey string) (minio.ObjectInfo, error)
+	PresignView(ctx context.Context, objectKey string, expiry time.Duration) (string, error)
+}
+
+type reviewRepository struct {
+	db      *pgxpool.Pool
+	queries *schema.Queries
+	minio   *minio.Client
+	bucket  string
+}
+
+func NewReviewRepository() (ReviewRepository, error) {
+	cfg := config.GetConfig()
+
+	db, err := connection.GetPgConnection(&cfg.Database)
+	if err != nil {
+		return nil, err
+	}
+
+	minioClient, err := connection.NewMinioClient()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	exists, _ := minioClient.BucketExists(ctx, cfg.S3.BucketName)
+	if !exists {
+		minioClient.MakeBucket(ctx, cfg.S3.BucketName, minio.MakeBucketOptions{})
+	}
+
+	return &reviewRepository{
+		db:      db,
+		queries: schema.New(db),
+		minio:   minioClient,
+		bucket:  cfg.S3.BucketName,
+	}, nil
+}
+
+// CreateReview posts the review with its photos and counts the rating towards
+// the merchant's average.
+func (r *reviewRepository) CreateReview(ctx context.Context, review NewReview) (schema.Review, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return schema.Review{}, fmt.Errorf("failed to start transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+
+	created, err := qtx.CreateReview(ctx, review.Params)
+	if err != nil {
+		return schema.Review{}, err
+	}
+
+	for _, key := range review.PhotoKeys {
+		if _, err := qtx.CreateReviewPhoto(ctx, schema.CreateReviewPhotoParams{ReviewID: created.ID, ObjectKey: key}); err != nil {
+			return schema.Review{}, fmt.Errorf("failed to save photo: %v", err)
+		}
+	}
+
+	if err := r.adjustRating(ctx, qtx, created.MerchantID, "", created.Status, created.Rating); err != nil {
+		return schema.Review{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return schema.Review{}, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+	return created, nil
+}
+
+// FlagReview records the user's flag, reporting false when they had already
+// flagged the review.
+func (r *reviewRepository) FlagReview(ctx context.Context, params schema.FlagReviewParams) (bool, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to start transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+
+	inserted, err := qtx.FlagReview(ctx, params)
+	if err != nil {
+		return false, err
+	}
+	if inserted == 0 {
+		return false, nil
+	}
+	if err := qtx.IncrementReviewFlags(ctx, params.ReviewID); err != nil {
+		return false, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+	return true, nil
+}
+
+// ModerateReview moves a review from params.FromStatus to params.ToStatus and
+// updates the merchant's totals when it enters or leaves the published set.
+// pgx.ErrNoRows means the review is missing or no longer in FromStatus.
+func (r *reviewRepository) ModerateReview(ctx context.Context, params schema.ModerateReviewParams) (schema.Review, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return schema.Review{}, fmt.Errorf("failed to start transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+
+	review, err := qtx.ModerateReview(ctx, params)
+	if err != nil {
+		return schema.Review{}, err
+	}
+
+	if err := r.adjustRating(ctx, qtx, review.MerchantID, params.FromStatus, params.ToStatus, review.Rating); err != nil {
+		return schema.Review{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return schema.Review{}, fmt.Errorf("failed to commit transaction: %v", err)
+	}
+	return review, nil
+}
+
+func (r *reviewRepository) adjustRating(ctx context.Context, qtx *schema.Queries, merchantID int64, from, to string, rating int16) error {
+	countDelta, totalDelta := util.RatingDelta(from, to, rating)
+	if countDelta == 0 {
+		return nil
+	}
+	err := qtx.AdjustMerchantRating(ctx, schema.AdjustMerchantRatingParams{
+		CountDelta: countDelta,
+		TotalDelta: totalDelta,
+		ID:         merchantID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update merchant rating: %v", err)
+	}
+	return nil
+}
+
+func (r *reviewRepository) GetReview(ctx context.Context, reviewID int64) (schema.Review, error) {
+	return r.queries.GetReview(ctx, reviewID)
+}
+
+func (r *reviewRepository) GetReviewByOrder(ctx context.Context, orderID int64) (schema.Review, error) {
+	return r.queries.GetReviewByOrder(ctx, orderID)
+}
+
+func (r *reviewRepository) ListMerchantReviews(ctx context.Context, params schema.ListMerchantReviewsParams) ([]schema.ListMerchantReviewsRow, error) {
+	return r.queries.ListMerchantReviews(ctx, params)
+}
+
+func (r *reviewRepository) CountMerchantReviews(ctx context.Context, merchantID int64) (int64, error) {
+	return r.queries.CountMerchantReviews(ctx, merchantID)
+}
+
+func (r *reviewRepository) ListFlaggedReviews(ctx context.Context, params schema.ListFlaggedReviewsParams) ([]schema.Review, error) {
+	return r.queries.ListFlaggedReviews(ctx, params)
+}
+
+func (r *reviewRepository) CountFlaggedReviews(ctx context.Context) (int64, error) {
+	return r.queries.CountFlaggedReviews(ctx)
+}
+
+func (r *reviewRepository) ListReviewPhotos(ctx context.Context, reviewIDs []int64) ([]schema.ReviewPhoto, error) {
+	return r.queries.ListReviewPhotos(ctx, reviewIDs)
+}
+
+func (r *reviewRepository) ReplyToReview(ctx context.Context, params schema.ReplyToReviewParams) (schema.Review, error) {
+	return r.queries.ReplyToReview(ctx, params)
+}
+
+func (r *reviewRepository) GetOrderByID(ctx context.Context, orderID int64) (schema.Order, error) {
+	return r.queries.GetOrderByID(ctx, orderID)
+}
+
+func (r *reviewRepository) GetMerchantByID(ctx context.Context, merchantID int64) (schema.Merchant, error) {
+	return r.queries.GetMerchantByID(ctx, merchantID)
+}
+
+func (r *reviewRepository) GetUserByID(ctx context.Context, userID int64) (schema.User, error) {
+	return r.queries.GetUserByID(ctx, userID)
+}
+
+// PresignUpload returns a POST policy URL and the form fields the client must
+// send, so MinIO enforces the photo's type and size.
+func (r *reviewRepository) PresignUpload(ctx context.Context, objectKey, contentType string, maxBytes int64, expiry time.Duration) (string, map[string]string, error) {
+	policy := minio.NewPostPolicy()
+	if err := policy.SetBucket(r.bucket); err != nil {
+		return "", nil, err
+	}
+	if err := policy.SetKey(objectKey); err != nil {
+		return "", nil, err
+	}
+	if err := policy.SetExpires(time.Now().UTC().Add(expiry)); err != nil {
+		return "", nil, err
+	}
+	if err := policy.SetContentType(contentType); err != nil {
+		return "", nil, err
+	}
+	if err := policy.SetContentLengthRange(1, maxBytes); err != nil {
+		return "", nil, err
+	}
+
+	url, formData, err := r.minio.PresignedPostPolicy(ctx, policy)
+	if err != nil {
+		return "", nil, err
+	}
+	return url.String(), formData, nil
+}
+
+func (r *reviewRepository) StatObject(ctx context.Context, objectKey string) (minio.ObjectInfo, error) {
+	return r.minio.StatObject(ctx, r.bucket, objectKey, minio.StatObjectOptions{})
+}
+
+func (r *reviewRepository) PresignView(ctx context.Context, objectKey string, expiry time.Duration) (string, error) {
+	url, err := r.minio.PresignedGetObject(ctx, r.bucket, objectKey, expiry, nil)
+	if err != nil {
+		return "", err
+	}
+	return url.String(), nil
+}
@@ -0,0 +1,404 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	reviewpb "rival/gen/proto/proto/api"
+	schemapb "rival/gen/proto/proto/schema"
+	schema "rival/gen/sql"
+	orderutil "rival/internal/orders/util"
+	"rival/internal/reviews/repo"
+	"rival/internal/reviews/util"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxPhotoBytes     = 5 << 20
+	photoUploadExpiry = 15 * time.Minute
+	photoViewExpiry   = 12 * time.Hour
+	maxReplyLength    = 1000
+)
+
+// ReviewService lets customers review their completed orders, merchants
+// answer and admins moderate what customers flag.
+type ReviewService interface {
+	Create(ctx context.Context, userID int64, req *reviewpb.CreateReviewRequest) (*schemapb.Review, error)
+	RequestPhotoUpload(ctx context.Context, userID int64, req *reviewpb.RequestReviewPhotoUploadRequest) (*reviewpb.RequestReviewPhotoUploadResponse, error)
+	ListMerchantReviews(ctx context.Context, req *reviewpb.ListMerchantReviewsRequest) (*reviewpb.ListMerchantReviewsResponse, error)
+	Flag(ctx context.Context, userID, reviewID int64, reason string) error
+	Reply(ctx context.Context, merchantID, reviewID, actorID int64, reply string) (*schemapb.Review, error)
+	ListFlagged(ctx context.Context, page, limit int32) ([]*schemapb.Review, int32, error)
+	Moderate(ctx context.Context, reviewID, adminID int64, action, reason string) (*schemapb.Review, error)
+}
+
+type reviewService struct {
+	repo repo.ReviewRepository
+}
+
+func NewReviewService(repo repo.ReviewRepository) ReviewService {
+	return &reviewService{repo: repo}
+}
+
+// reviewableOrder returns the user's order if it can be reviewed. Orders of
+// other customers are reported as not found.
+func (s *reviewService) reviewableOrder(ctx context.Context, userID, orderID int64) (schema.Order, error) {
+	order, err := s.repo.GetOrderByID(ctx, orderID)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && order.UserID.Int64 != userID) {
+		return schema.Order{}, status.Error(codes.NotFound, "order not found")
+	}
+	if err != nil {
+		return schema.Order{}, fmt.Errorf("failed to get order: %w", err)
+	}
+	if order.Status != orderutil.StatusCompleted {
+		return schema.Order{}, status.Error(codes.FailedPrecondition, "only completed orders can be reviewed")
+	}
+
+	_, err = s.repo.GetReviewByOrder(ctx, orderID)
+	if err == nil {
+		return schema.Order{}, status.Error(codes.AlreadyExists, "this order has already been reviewed")
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return schema.Order{}, fmt.Errorf("failed to get review: %w", err)
+	}
+	return order, nil
+}
+
+func (s *reviewService) Create(ctx context.Context, userID int64, req *reviewpb.CreateReviewRequest) (*schemapb.Review, error) {
+	if !util.IsValidRating(req.Rating) {
+		return nil, status.Errorf(codes.InvalidArgument, "rating must be between %d and %d", util.MinRating, util.MaxRating)
+	}
+	body := strings.TrimSpace(req.Body)
+	if utf8.RuneCountInString(body) > util.MaxBodyLength {
+		return nil, status.Errorf(codes.InvalidArgument, "reviews are limited to %d characters", util.MaxBodyLength)
+	}
+
+	order, err := s.reviewableOrder(ctx, userID, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	photoKeys, err := s.checkPhotos(ctx, userID, order.ID, req.PhotoKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	review, err := s.repo.CreateReview(ctx, repo.NewReview{
+		Params: schema.CreateReviewParams{
+			MerchantID: order.MerchantID.Int64,
+			UserID:     pgtype.Int8{Int64: userID, Valid: true},
+			OrderID:    order.ID,
+			Rating:     int16(req.Rating),
+			Body:       pgtype.Text{String: body, Valid: body != ""},
+		},
+		PhotoKeys: photoKeys,
+	})
+	if isUniqueViolation(err) {
+		return nil, status.Error(codes.AlreadyExists, "this order has already been reviewed")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create review: %w", err)
+	}
+
+	var userName string
+	if user, err := s.repo.GetUserByID(ctx, userID); err == nil {
+		userName = user.Name
+	}
+	reviews, err := s.present(ctx, []schema.Review{review}, []string{userName})
+	if err != nil {
+		return nil, err
+	}
+	return reviews[0], nil
+}
+
+// checkPhotos makes sure each photo was uploaded through
+// RequestPhotoUpload for this order and is an accepted image.
+func (s *reviewService) checkPhotos(ctx context.Context, userID, orderID int64, keys []string) ([]string, error) {
+	var photoKeys []string
+	seen := make(map[string]bool)
+	for _, key := range keys {
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		photoKeys = append(photoKeys, key)
+	}
+	if len(photoKeys) > util.MaxPhotos {
+		return nil, status.Errorf(codes.InvalidArgument, "a review can have at most %d photos", util.MaxPhotos)
+	}
+
+	prefix := util.PhotoKeyPrefix(userID, orderID)
+	for _, key := range photoKeys {
+		if !strings.HasPrefix(key, prefix) {
+			return nil, status.Error(codes.InvalidArgument, "photo does not belong to this order")
+		}
+		object, err := s.repo.StatObject(ctx, key)
+		if err != nil {
+			return nil, status.Error(codes.FailedPrecondition, "photo has not been uploaded")
+		}
+		if _, ok := util.PhotoExtension(object.ContentType); !ok || object.Size > maxPhotoBytes {
+			return nil, status.Error(codes.InvalidArgument, "uploaded file is not an accepted image")
+		}
+	}
+	return photoKeys, nil
+}
+
+func (s *reviewService) RequestPhotoUpload(ctx context.Context, userID int64, req *reviewpb.RequestReviewPhotoUploadRequest) (*reviewpb.RequestReviewPhotoUploadResponse, error) {
+	ext, ok := util.PhotoExtension(req.ContentType)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "photos must be JPEG, PNG or WebP")
+	}
+	if req.SizeBytes <= 0 || req.SizeBytes > maxPhotoBytes {
+		return nil, status.Errorf(codes.InvalidArgument, "photos must be smaller than %d MB", maxPhotoBytes>>20)
+	}
+	if _, err := s.reviewableOrder(ctx, userID, req.OrderId); err != nil {
+		return nil, err
+	}
+
+	objectKey := util.NewPhotoObjectKey(userID, req.OrderId, ext)
+	uploadURL, formData, err := s.repo.PresignUpload(ctx, objectKey, req.ContentType, maxPhotoBytes, photoUploadExpiry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create upload URL: %w", err)
+	}
+
+	return &reviewpb.RequestReviewPhotoUploadResponse{
+		UploadUrl: uploadURL,
+		FormData:  formData,
+		ObjectKey: objectKey,
+		ExpiresIn: int64(photoUploadExpiry.Seconds()),
+	}, nil
+}
+
+// ListMerchantReviews lists a merchant's published reviews, newest first,
+// with the merchant's rating.
+func (s *reviewService) ListMerchantReviews(ctx context.Context, req *reviewpb.ListMerchantReviewsRequest) (*reviewpb.ListMerchantReviewsResponse, error) {
+	merchant, err := s.repo.GetMerchantByID(ctx, req.MerchantId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "merchant not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get merchant: %w", err)
+	}
+
+	rows, err := s.repo.ListMerchantReviews(ctx, schema.ListMerchantReviewsParams{
+		MerchantID: merchant.ID,
+		Limit:      req.Limit,
+		Offset:     (req.Page - 1) * req.Limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reviews: %w", err)
+	}
+	total, err := s.repo.CountMerchantReviews(ctx, merchant.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count reviews: %w", err)
+	}
+
+	reviews := make([]schema.Review, len(rows))
+	userNames := make([]string, len(rows))
+	for i, row := range rows {
+		reviews[i] = row.Review
+		userNames[i] = row.UserName.String
+	}
+	protoReviews, err := s.present(ctx, reviews, userNames)
+	if err != nil {
+		return nil, err
+	}
+
+	return &reviewpb.ListMerchantReviewsResponse{
+		Reviews:       protoReviews,
+		TotalCount:    int32(total),
+		RatingAverage: util.AverageRating(merchant.RatingTotal, merchant.RatingCount),
+		RatingCount:   merchant.RatingCount,
+	}, nil
+}
+
+// Flag reports a published review to the admins. Flagging the same review
+// twice counts once.
+func (s *reviewService) Flag(ctx context.Context, userID, reviewID int64, reason string) error {
+	review, err := s.repo.GetReview(ctx, reviewID)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && review.Status != util.StatusPublished) {
+		return status.Error(codes.NotFound, "review not found")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get review: %w", err)
+	}
+
+	reason = strings.TrimSpace(reason)
+	_, err = s.repo.FlagReview(ctx, schema.FlagReviewParams{
+		ReviewID: review.ID,
+		UserID:   userID,
+		Reason:   pgtype.Text{String: reason, Valid: reason != ""},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to flag review: %w", err)
+	}
+	return nil
+}
+
+// Reply sets the merchant's public answer to a review, replacing any earlier
+// one. Reviews of other merchants are reported as not found.
+func (s *reviewService) Reply(ctx context.Context, merchantID, reviewID, actorID int64, reply string) (*schemapb.Review, error) {
+	reply = strings.TrimSpace(reply)
+	if reply == "" {
+		return nil, status.Error(codes.InvalidArgument, "reply is required")
+	}
+	if utf8.RuneCountInString(reply) > maxReplyLength {
+		return nil, status.Errorf(codes.InvalidArgument, "replies are limited to %d characters", maxReplyLength)
+	}
+
+	review, err := s.repo.ReplyToReview(ctx, schema.ReplyToReviewParams{
+		ID:         reviewID,
+		MerchantID: merchantID,
+		Reply:      pgtype.Text{String: reply, Valid: true},
+		RepliedBy:  pgtype.Int8{Int64: actorID, Valid: actorID != 0},
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "review not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to reply to review: %w", err)
+	}
+
+	reviews, err := s.present(ctx, []schema.Review{review}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return reviews[0], nil
+}
+
+// ListFlagged lists reviews with open flags, most flagged first.
+func (s *reviewService) ListFlagged(ctx context.Context, page, limit int32) ([]*schemapb.Review, int32, error) {
+	reviews, err := s.repo.ListFlaggedReviews(ctx, schema.ListFlaggedReviewsParams{
+		Limit:  limit,
+		Offset: (page - 1) * limit,
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get flagged reviews: %w", err)
+	}
+	total, err := s.repo.CountFlaggedReviews(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count flagged reviews: %w", err)
+	}
+
+	protoReviews, err := s.present(ctx, reviews, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	return protoReviews, int32(total), nil
+}
+
+// Moderate hides or restores a review, or dismisses its flags. Hiding and
+// restoring take the rating out of or back into the merchant's average.
+func (s *reviewService) Moderate(ctx context.Context, reviewID, adminID int64, action, reason string) (*schemapb.Review, error) {
+	var from, to string
+	switch action {
+	case util.ActionHide:
+		from, to = util.StatusPublished, util.StatusHidden
+	case util.ActionRestore:
+		from, to = util.StatusHidden, util.StatusPublished
+	case util.ActionDismiss:
+		from, to = util.StatusPublished, util.StatusPublished
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown moderation action %q", action)
+	}
+
+	reason = strings.TrimSpace(reason)
+	if action == util.ActionHide && reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "a reason is required")
+	}
+
+	review, err := s.repo.ModerateReview(ctx, schema.ModerateReviewParams{
+		ToStatus:    to,
+		ModeratedBy: pgtype.Int8{Int64: adminID, Valid: adminID != 0},
+		Reason:      pgtype.Text{String: reason, Valid: reason != ""},
+		ID:          reviewID,
+		FromStatus:  from,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		existing, getErr := s.repo.GetReview(ctx, reviewID)
+		if getErr != nil {
+			return nil, status.Error(codes.NotFound, "review not found")
+		}
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot %s a %s review", action, existing.Status)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to moderate review: %w", err)
+	}
+
+	reviews, err := s.present(ctx, []schema.Review{review}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return reviews[0], nil
+}
+
+// present converts reviews and signs their photo links. userNames is
+// parallel to reviews and may be nil.
+func (s *reviewService) present(ctx context.Context, reviews []schema.Review, userNames []string) ([]*schemapb.Review, error) {
+	reviewIDs := make([]int64, len(reviews))
+	for i, review := range reviews {
+		reviewIDs[i] = review.ID
+	}
+
+	photoURLs := make(map[int64][]string)
+	if len(reviewIDs) > 0 {
+		photos, err := s.repo.ListReviewPhotos(ctx, reviewIDs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get review photos: %w", err)
+		}
+		for _, photo := range photos {
+			url, err := s.repo.PresignView(ctx, photo.ObjectKey, photoViewExpiry)
+			if err != nil {
+				log.Printf("Failed to sign photo %s of review %d: %v", photo.ObjectKey, photo.ReviewID, err)
+				continue
+			}
+			photoURLs[photo.ReviewID] = append(photoURLs[photo.ReviewID], url)
+		}
+	}
+
+	protoReviews := make([]*schemapb.Review, 0, len(reviews))
+	for i, review := range reviews {
+		protoReview := convertToProtoReview(review)
+		protoReview.PhotoUrls = photoURLs[review.ID]
+		if i < len(userNames) {
+			protoReview.UserName = userNames[i]
+		}
+		protoReviews = append(protoReviews, protoReview)
+	}
+	return protoReviews, nil
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
+
+func convertToProtoReview(review schema.Review) *schemapb.Review {
+	protoReview := &schemapb.Review{
+		Id:               review.ID,
+		MerchantId:       review.MerchantID,
+		UserId:           review.UserID.Int64,
+		OrderId:          review.OrderID,
+		Rating:           int32(review.Rating),
+		Body:             review.Body.String,
+		Status:           review.Status,
+		FlagCount:        review.FlagCount,
+		ModerationReason: review.ModerationReason.String,
+		Reply:            review.Reply.String,
+		CreatedAt:        review.CreatedAt.Time.Unix(),
+	}
+	if review.RepliedAt.Valid {
+		protoReview.RepliedAt = review.RepliedAt.Time.Unix()
+	}
+	return protoReview
+}
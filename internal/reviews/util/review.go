@@ -0,0 +1,75 @@
+package util
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/google/uuid"
+)
+
+// Review statuses. Only published reviews are listed and counted in the
+// merchant's rating.
+const (
+	StatusPublished = "published"
+	StatusHidden    = "hidden"
+)
+
+// Moderation actions an admin can take on a review
+const (
+	ActionHide    = "hide"
+	ActionRestore = "restore"
+	ActionDismiss = "dismiss" // keep the review up and clear its flags
+)
+
+const (
+	MinRating     = 1
+	MaxRating     = 5
+	MaxBodyLength = 2000
+	MaxPhotos     = 5
+)
+
+var photoContentTypes = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+}
+
+func IsValidRating(rating int32) bool {
+	return rating >= MinRating && rating <= MaxRating
+}
+
+// PhotoExtension returns the extension for an accepted photo type.
+func PhotoExtension(contentType string) (string, bool) {
+	ext, ok := photoContentTypes[contentType]
+	return ext, ok
+}
+
+// PhotoKeyPrefix is where photos for the review of one order live.
+func PhotoKeyPrefix(userID, orderID int64) string {
+	return fmt.Sprintf("reviews/%d/%d/", userID, orderID)
+}
+
+func NewPhotoObjectKey(userID, orderID int64, ext string) string {
+	return PhotoKeyPrefix(userID, orderID) + uuid.NewString() + ext
+}
+
+// AverageRating is the mean of a merchant's published ratings to two
+// decimals, 0 when there are none.
+func AverageRating(total int64, count int32) float64 {
+	if count <= 0 {
+		return 0
+	}
+	return math.Round(float64(total)/float64(count)*100) / 100
+}
+
+// RatingDelta is how a status change moves the merchant's rating totals:
+// publishing adds the rating, hiding removes it.
+func RatingDelta(from, to string, rating int16) (int32, int64) {
+	switch {
+	case from != StatusPublished && to == StatusPublished:
+		return 1, int64(rating)
+	case from == StatusPublished && to != StatusPublished:
+		return -1, -int64(rating)
+	}
+	return 0, 0
+}
@@ -0,0 +1,61 @@
+package util
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAverageRating(t *testing.T) {
+	cases := []struct {
+		total int64
+		count int32
+		want  float64
+	}{
+		{0, 0, 0},
+		{5, 1, 5},
+		{14, 3, 4.67},
+		{7, 2, 3.5},
+		{-3, 0, 0},
+	}
+	for _, c := range cases {
+		if got := AverageRating(c.total, c.count); got != c.want {
+			t.Errorf("AverageRating(%d, %d) = %v, want %v", c.total, c.count, got, c.want)
+		}
+	}
+}
+
+func TestRatingDelta(t *testing.T) {
+	cases := []struct {
+		from, to  string
+		wantCount int32
+		wantTotal int64
+	}{
+		{StatusPublished, StatusHidden, -1, -4},
+		{StatusHidden, StatusPublished, 1, 4},
+		{StatusPublished, StatusPublished, 0, 0},
+		{"", StatusPublished, 1, 4},
+	}
+	for _, c := range cases {
+		count, total := RatingDelta(c.from, c.to, 4)
+		if count != c.wantCount || total != c.wantTotal {
+			t.Errorf("RatingDelta(%q, %q) = %d, %d, want %d, %d", c.from, c.to, count, total, c.wantCount, c.wantTotal)
+		}
+	}
+}
+
+func TestPhotoObjectKey(t *testing.T) {
+	if _, ok := PhotoExtension("image/gif"); ok {
+		t.Error("GIFs should not be accepted")
+	}
+	ext, ok := PhotoExtension("image/png")
+	if !ok {
+		t.Fatal("PNGs should be accepted")
+	}
+	key := NewPhotoObjectKey(7, 42, ext)
+	if !strings.HasPrefix(key, PhotoKeyPrefix(7, 42)) || !strings.HasSuffix(key, ".png") {
+		t.Errorf("unexpected object key %q", key)
+	}
+	if strings.HasPrefix(key, PhotoKeyPrefix(7, 4)) {
+		t.Errorf("prefix of order 4 matches key %q of order 42", key)
+	}
+}
@@ -2,15 +2,25 @@ package handler
 
 import (
 	"context"
+	"errors"
+	"strconv"
 
 	userspb "rival/gen/proto/proto/api"
+	schemapb "rival/gen/proto/proto/schema"
 	"rival/internal/users/repo"
 	"rival/internal/users/service"
+	"rival/pkg/business"
+	"rival/pkg/geo"
+	"rival/pkg/notify"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type UserHandler struct {
 	userspb.UnimplementedUserServiceServer
-	service service.UserService
+	service  service.UserService
+	notifier *notify.Service
 }
 
 func NewUserHandler() (*UserHandler, error) {
@@ -19,10 +29,16 @@ func NewUserHandler() (*UserHandler, error) {
 		return nil, err
 	}
 
-	userService := service.NewUserService(repository)
+	notifier, err := notify.NewServiceFromConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	userService := service.NewUserService(repository, notifier)
 
 	return &UserHandler{
-		service: userService,
+		service:  userService,
+		notifier: notifier,
 	}, nil
 }
 
@@ -105,3 +121,266 @@ func (h *UserHandler) ApplyReferralCode(ctx context.Context, req *userspb.ApplyR
 	}
 	return h.service.ApplyReferralCode(ctx, int(req.UserId), req.ReferralCode)
 }
+
+func (h *UserHandler) AddFavorite(ctx context.Context, req *userspb.AddFavoriteRequest) (*userspb.AddFavoriteResponse, error) {
+	userID, ok := ctx.Value("user_id").(int)
+	if !ok || userID == 0 {
+		return nil, status.Error(codes.Unauthenticated, "sign in to save favorites")
+	}
+	if err := h.service.AddFavorite(ctx, userID, req.MerchantId, req.OfferId); err != nil {
+		return nil, err
+	}
+	return &userspb.AddFavoriteResponse{Success: true}, nil
+}
+
+func (h *UserHandler) RemoveFavorite(ctx context.Context, req *userspb.RemoveFavoriteRequest) (*userspb.RemoveFavoriteResponse, error) {
+	userID, ok := ctx.Value("user_id").(int)
+	if !ok || userID == 0 {
+		return nil, status.Error(codes.Unauthenticated, "sign in to save favorites")
+	}
+	if err := h.service.RemoveFavorite(ctx, userID, req.MerchantId, req.OfferId); err != nil {
+		return nil, err
+	}
+	return &userspb.RemoveFavoriteResponse{Success: true}, nil
+}
+
+func (h *UserHandler) ListFavorites(ctx context.Context, req *userspb.ListFavoritesRequest) (*userspb.ListFavoritesResponse, error) {
+	userID, ok := ctx.Value("user_id").(int)
+	if !ok || userID == 0 {
+		return nil, status.Error(codes.Unauthenticated, "sign in to see favorites")
+	}
+	return h.service.ListFavorites(ctx, userID)
+}
+
+func (h *UserHandler) GetRecommendations(ctx context.Context, req *userspb.GetRecommendationsRequest) (*userspb.GetRecommendationsResponse, error) {
+	userID, ok := ctx.Value("user_id").(int)
+	if !ok || userID == 0 {
+		return nil, status.Error(codes.Unauthenticated, "sign in to see recommendations")
+	}
+
+	center := geo.Coordinates{Latitude: req.Latitude, Longitude: req.Longitude}
+	if err := geo.ValidateCoordinates(center); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 || limit > 50 {
+		limit = 20
+	}
+
+	return h.service.GetRecommendations(ctx, userID, center, req.RadiusKm, limit)
+}
+
+// StreamUserNotifications replays the user's unread notifications and then
+// sends new ones as they happen.
+func (h *UserHandler) StreamUserNotifications(req *userspb.StreamUserNotificationsRequest, stream userspb.UserService_StreamUserNotificationsServer) error {
+	userID, ok := stream.Context().Value("user_id").(int)
+	if !ok || userID == 0 {
+		return status.Error(codes.Unauthenticated, "sign in to see notifications")
+	}
+
+	return h.notifier.Stream(stream.Context(), notify.User(int64(userID)), func(n *schemapb.Notification, replayed bool) error {
+		return stream.Send(&userspb.StreamUserNotificationsResponse{
+			Id:        strconv.FormatInt(n.Id, 10),
+			Title:     n.Title,
+			Message:   n.Body,
+			Type:      n.Type,
+			Timestamp: n.CreatedAt,
+			DeepLink:  n.DeepLink,
+			Replayed:  replayed,
+		})
+	})
+}
+
+func (h *UserHandler) ListNotifications(ctx context.Context, req *userspb.ListNotificationsRequest) (*userspb.ListNotificationsResponse, error) {
+	userID, ok := ctx.Value("user_id").(int)
+	if !ok || userID == 0 {
+		return nil, status.Error(codes.Unauthenticated, "sign in to see notifications")
+	}
+
+	notifications, total, unread, err := h.notifier.List(ctx, notify.User(int64(userID)), req.Page, req.Limit, req.UnreadOnly)
+	if err != nil {
+		return nil, err
+	}
+	return &userspb.ListNotificationsResponse{
+		Notifications: notifications,
+		TotalCount:    total,
+		UnreadCount:   unread,
+	}, nil
+}
+
+func (h *UserHandler) MarkNotificationsRead(ctx context.Context, req *userspb.MarkNotificationsReadRequest) (*userspb.MarkNotificationsReadResponse, error) {
+	userID, ok := ctx.Value("user_id").(int)
+	if !ok || userID == 0 {
+		return nil, status.Error(codes.Unauthenticated, "sign in to see notifications")
+	}
+
+	updated, err := h.notifier.MarkRead(ctx, notify.User(int64(userID)), req.NotificationIds, req.All)
+	if err != nil {
+		return nil, err
+	}
+	return &userspb.MarkNotificationsReadResponse{Updated: updated}, nil
+}
+
+func (h *UserHandler) GetUnreadNotificationCount(ctx context.Context, req *userspb.GetUnreadNotificationCountRequest) (*userspb.GetUnreadNotificationCountResponse, error) {
+	userID, ok := ctx.Value("user_id").(int)
+	if !ok || userID == 0 {
+		return nil, status.Error(codes.Unauthenticated, "sign in to see notifications")
+	}
+
+	count, err := h.notifier.UnreadCount(ctx, notify.User(int64(userID)))
+	if err != nil {
+		return nil, err
+	}
+	return &userspb.GetUnreadNotificationCountResponse{Count: count}, nil
+}
+
+func (h *UserHandler) RegisterDevice(ctx context.Context, req *userspb.RegisterDeviceRequest) (*userspb.RegisterDeviceResponse, error) {
+	userID, ok := ctx.Value("user_id").(int)
+	if !ok || userID == 0 {
+		return nil, status.Error(codes.Unauthenticated, "sign in to register a device")
+	}
+
+	var location *geo.Coordinates
+	if req.Latitude != 0 || req.Longitude != 0 {
+		location = &geo.Coordinates{Latitude: req.Latitude, Longitude: req.Longitude}
+		if err := geo.ValidateCoordinates(*location); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	err := h.notifier.RegisterDevice(ctx, int64(userID), req.Token, req.Platform, location)
+	if errors.Is(err, notify.ErrNoToken) || errors.Is(err, notify.ErrUnknownPlatform) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &userspb.RegisterDeviceResponse{Success: true}, nil
+}
+
+func (h *UserHandler) UnregisterDevice(ctx context.Context, req *userspb.UnregisterDeviceRequest) (*userspb.UnregisterDeviceResponse, error) {
+	userID, ok := ctx.Value("user_id").(int)
+	if !ok || userID == 0 {
+		return nil, status.Error(codes.Unauthenticated, "sign in to unregister a device")
+	}
+
+	removed, err := h.notifier.UnregisterDevice(ctx, int64(userID), req.Token)
+	if err != nil {
+		return nil, err
+	}
+	return &userspb.UnregisterDeviceResponse{Success: removed}, nil
+}
+
+func (h *UserHandler) GetNotificationPreferences(ctx context.Context, req *userspb.GetNotificationPreferencesRequest) (*userspb.GetNotificationPreferencesResponse, error) {
+	userID, ok := ctx.Value("user_id").(int)
+	if !ok || userID == 0 {
+		return nil, status.Error(codes.Unauthenticated, "sign in to see notification preferences")
+	}
+
+	preferences, err := h.notifier.Preferences(ctx, int64(userID))
+	if err != nil {
+		return nil, err
+	}
+	settings, err := h.notifier.Settings(ctx, int64(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &userspb.GetNotificationPreferencesResponse{
+		Preferences: convertToProtoPreferences(preferences),
+		Timezone:    settings.Timezone,
+		Locale:      settings.Locale,
+	}
+	if settings.Quiet != nil {
+		resp.QuietHoursStart = business.FormatClock(settings.Quiet.Start)
+		resp.QuietHoursEnd = business.FormatClock(settings.Quiet.End)
+	}
+	return resp, nil
+}
+
+func (h *UserHandler) UpdateNotificationPreferences(ctx context.Context, req *userspb.UpdateNotificationPreferencesRequest) (*userspb.GetNotificationPreferencesResponse, error) {
+	userID, ok := ctx.Value("user_id").(int)
+	if !ok || userID == 0 {
+		return nil, status.Error(codes.Unauthenticated, "sign in to change notification preferences")
+	}
+
+	preferences := make([]notify.Preference, len(req.Preferences))
+	for i, preference := range req.Preferences {
+		preferences[i] = notify.Preference{
+			Category: preference.Category,
+			Push:     preference.Push,
+			Email:    preference.Email,
+			InApp:    preference.InApp,
+		}
+	}
+	err := h.notifier.SetPreferences(ctx, int64(userID), preferences)
+	if errors.Is(err, notify.ErrUnknownCategory) || errors.Is(err, notify.ErrSecurityRequired) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return h.GetNotificationPreferences(ctx, &userspb.GetNotificationPreferencesRequest{})
+}
+
+func (h *UserHandler) SetQuietHours(ctx context.Context, req *userspb.SetQuietHoursRequest) (*userspb.GetNotificationPreferencesResponse, error) {
+	userID, ok := ctx.Value("user_id").(int)
+	if !ok || userID == 0 {
+		return nil, status.Error(codes.Unauthenticated, "sign in to change quiet hours")
+	}
+
+	settings := notify.Settings{Timezone: req.Timezone}
+	if req.Start != "" || req.End != "" {
+		start, err := business.ParseClock(req.Start)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		end, err := business.ParseClock(req.End)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		settings.Quiet = &notify.QuietHours{Start: start, End: end}
+	}
+
+	_, err := h.notifier.SetSettings(ctx, int64(userID), settings)
+	if errors.Is(err, notify.ErrUnknownTimezone) || errors.Is(err, notify.ErrInvalidQuietHours) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return h.GetNotificationPreferences(ctx, &userspb.GetNotificationPreferencesRequest{})
+}
+
+func (h *UserHandler) SetNotificationLanguage(ctx context.Context, req *userspb.SetNotificationLanguageRequest) (*userspb.GetNotificationPreferencesResponse, error) {
+	userID, ok := ctx.Value("user_id").(int)
+	if !ok || userID == 0 {
+		return nil, status.Error(codes.Unauthenticated, "sign in to change your language")
+	}
+
+	err := h.notifier.SetLocale(ctx, int64(userID), req.Locale)
+	if errors.Is(err, notify.ErrUnknownLocale) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return h.GetNotificationPreferences(ctx, &userspb.GetNotificationPreferencesRequest{})
+}
+
+func convertToProtoPreferences(preferences []notify.Preference) []*schemapb.NotificationPreference {
+	protoPreferences := make([]*schemapb.NotificationPreference, len(preferences))
+	for i, preference := range preferences {
+		protoPreferences[i] = &schemapb.NotificationPreference{
+			Category: preference.Category,
+			Push:     preference.Push,
+			Email:    preference.Email,
+			InApp:    preference.InApp,
+		}
+	}
+	return protoPreferences
+}
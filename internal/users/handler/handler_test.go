@@ -9,7 +9,13 @@ import (
 	schemapb "rival/gen/proto/proto/schema"
 	schema "rival/gen/sql"
 	"rival/internal/auth/handler"
+	"rival/pkg/notify"
+	"rival/pkg/utils"
 	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func NewUser(ctx context.Context, email string, t *testing.T) (*authpb.SignupRequest, *schema.Queries, schema.User) {
@@ -20,9 +26,9 @@ func NewUser(ctx context.Context, email string, t *testing.T) (*authpb.SignupReq
 	data := authpb.SignupRequest{
 		Name:     "Test User",
 		Email:    email,
-		Password: "password123",
+		Password: "Rival-Passw0rd",
 		Role:     *schemapb.UserRole_USER_ROLE_ADMIN.Enum(),
-		Phone:    "12345678",
+		Phone:    "9876543210",
 	}
 	handler.Signup(ctx, &data)
 	cfg := config.GetConfig()
@@ -91,7 +97,7 @@ func TestUpdateUser(t *testing.T) {
 	updateReq := &userspb.UpdateUserRequest{
 		UserId:     auser.ID,
 		Name:       "Updated Name",
-		Phone:      "987654321",
+		Phone:      "98765 43211",
 		ProfilePic: "updated-pic.jpg",
 	}
 
@@ -108,6 +114,20 @@ func TestUpdateUser(t *testing.T) {
 		t.Fatalf("UpdateUser did not update name: got %v want %v", updateResp.User.Name, "Updated Name")
 	}
 
+	if updateResp.User.Phone != "+919876543211" {
+		t.Fatalf("UpdateUser did not normalize phone: got %v want %v", updateResp.User.Phone, "+919876543211")
+	}
+
+	// Test with an invalid phone
+	_, err = handler.UpdateUser(ctx, &userspb.UpdateUserRequest{
+		UserId: auser.ID,
+		Name:   "Updated Name",
+		Phone:  "12345678",
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("UpdateUser accepted an invalid phone: %v", err)
+	}
+
 	// Test with zero user ID
 	zeroReq := &userspb.UpdateUserRequest{
 		UserId: 0,
@@ -468,3 +488,257 @@ func TestGetUploadURL_ZeroUserId(t *testing.T) {
 		t.Fatalf("Expected empty URL for userId=0, got: %s", resp.UploadUrl)
 	}
 }
+
+func TestFavoritesAndRecommendations(t *testing.T) {
+	ctx := context.Background()
+	data, repo, user := NewUser(ctx, "test-favorites@example.com", t)
+	defer func() {
+		repo.DeleteByEmail(context.Background(), data.Email)
+	}()
+
+	merchant, err := repo.CreateMerchant(ctx, schema.CreateMerchantParams{
+		Name:               "Test Favorite Merchant",
+		Email:              "test-favorites-merchant@example.com",
+		Category:           pgtype.Text{String: "cafe", Valid: true},
+		DiscountPercentage: utils.Float64ToNumeric(10),
+		IsActive:           pgtype.Bool{Bool: true, Valid: true},
+		Status:             "approved",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create merchant: %v", err)
+	}
+	defer repo.DeleteMerchant(ctx, merchant.ID)
+
+	_, err = repo.CreateMerchantAddress(ctx, schema.CreateMerchantAddressParams{
+		MerchantID: pgtype.Int8{Int64: merchant.ID, Valid: true},
+		Latitude:   utils.Float64ToNumeric(12.9716),
+		Longitude:  utils.Float64ToNumeric(77.5946),
+		IsPrimary:  true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create merchant address: %v", err)
+	}
+
+	handler, err := NewUserHandler()
+	if err != nil {
+		t.Fatalf("Failed to create handler: %v", err)
+	}
+
+	if _, err := handler.AddFavorite(ctx, &userspb.AddFavoriteRequest{MerchantId: merchant.ID}); err == nil {
+		t.Errorf("Expected AddFavorite without a signed-in user to fail")
+	}
+
+	userCtx := context.WithValue(ctx, "user_id", int(user.ID))
+	if _, err := handler.AddFavorite(userCtx, &userspb.AddFavoriteRequest{}); err == nil {
+		t.Errorf("Expected AddFavorite without an ID to fail")
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := handler.AddFavorite(userCtx, &userspb.AddFavoriteRequest{MerchantId: merchant.ID}); err != nil {
+			t.Fatalf("AddFavorite returned error: %v", err)
+		}
+	}
+
+	favorites, err := handler.ListFavorites(userCtx, &userspb.ListFavoritesRequest{})
+	if err != nil {
+		t.Fatalf("ListFavorites returned error: %v", err)
+	}
+	if len(favorites.Merchants) != 1 || favorites.Merchants[0].Id != merchant.ID {
+		t.Errorf("Expected the merchant in favorites, got %+v", favorites.Merchants)
+	}
+
+	feed, err := handler.GetRecommendations(userCtx, &userspb.GetRecommendationsRequest{Latitude: 12.97, Longitude: 77.59})
+	if err != nil {
+		t.Fatalf("GetRecommendations returned error: %v", err)
+	}
+	var found bool
+	for _, recommendation := range feed.Recommendations {
+		if recommendation.MerchantId != merchant.ID {
+			continue
+		}
+		found = true
+		if len(recommendation.Reasons) == 0 || recommendation.Reasons[0].Signal != "favorite" {
+			t.Errorf("Expected the favorite to explain the recommendation, got %+v", recommendation.Reasons)
+		}
+	}
+	if !found {
+		t.Errorf("Expected the nearby favorite to be recommended")
+	}
+
+	if _, err := handler.RemoveFavorite(userCtx, &userspb.RemoveFavoriteRequest{MerchantId: merchant.ID}); err != nil {
+		t.Fatalf("RemoveFavorite returned error: %v", err)
+	}
+	if _, err := handler.RemoveFavorite(userCtx, &userspb.RemoveFavoriteRequest{MerchantId: merchant.ID}); err == nil {
+		t.Errorf("Expected removing a missing favorite to fail")
+	}
+}
+
+func TestNotifications(t *testing.T) {
+	ctx := context.Background()
+	data, repo, user := NewUser(ctx, "test-notifications@example.com", t)
+	defer func() {
+		repo.DeleteByEmail(context.Background(), data.Email)
+	}()
+
+	h, err := NewUserHandler()
+	if err != nil {
+		t.Fatalf("Failed to create handler: %v", err)
+	}
+	userCtx := context.WithValue(ctx, "user_id", int(user.ID))
+
+	if _, err := h.ListNotifications(ctx, &userspb.ListNotificationsRequest{}); err == nil {
+		t.Fatalf("ListNotifications should require a signed-in user")
+	}
+
+	for _, title := range []string{"First", "Second"} {
+		h.notifier.Notify(ctx, notify.Notification{
+			To:       notify.User(user.ID),
+			Type:     notify.TypeSystem,
+			Title:    title,
+			Body:     "Hello",
+			DeepLink: notify.ReferralsLink,
+		})
+	}
+
+	listResp, err := h.ListNotifications(userCtx, &userspb.ListNotificationsRequest{})
+	if err != nil {
+		t.Fatalf("Failed to list notifications: %v", err)
+	}
+	if len(listResp.Notifications) != 2 || listResp.UnreadCount != 2 {
+		t.Fatalf("ListNotifications = %d notifications, %d unread, want 2 and 2", len(listResp.Notifications), listResp.UnreadCount)
+	}
+	newest := listResp.Notifications[0]
+	if newest.Title != "Second" || newest.Read || newest.DeepLink != notify.ReferralsLink {
+		t.Fatalf("newest notification = %+v", newest)
+	}
+
+	markResp, err := h.MarkNotificationsRead(userCtx, &userspb.MarkNotificationsReadRequest{NotificationIds: []int64{newest.Id}})
+	if err != nil {
+		t.Fatalf("Failed to mark notification read: %v", err)
+	}
+	if markResp.Updated != 1 {
+		t.Fatalf("MarkNotificationsRead updated %d, want 1", markResp.Updated)
+	}
+
+	countResp, err := h.GetUnreadNotificationCount(userCtx, &userspb.GetUnreadNotificationCountRequest{})
+	if err != nil {
+		t.Fatalf("Failed to count unread notifications: %v", err)
+	}
+	if countResp.Count != 1 {
+		t.Fatalf("GetUnreadNotificationCount = %d, want 1", countResp.Count)
+	}
+
+	unreadResp, err := h.ListNotifications(userCtx, &userspb.ListNotificationsRequest{UnreadOnly: true})
+	if err != nil {
+		t.Fatalf("Failed to list unread notifications: %v", err)
+	}
+	if len(unreadResp.Notifications) != 1 || unreadResp.Notifications[0].Title != "First" {
+		t.Fatalf("unread notifications = %+v, want only First", unreadResp.Notifications)
+	}
+
+	if _, err := h.MarkNotificationsRead(userCtx, &userspb.MarkNotificationsReadRequest{All: true}); err != nil {
+		t.Fatalf("Failed to mark all notifications read: %v", err)
+	}
+	countResp, err = h.GetUnreadNotificationCount(userCtx, &userspb.GetUnreadNotificationCountRequest{})
+	if err != nil {
+		t.Fatalf("Failed to count unread notifications: %v", err)
+	}
+	if countResp.Count != 0 {
+		t.Fatalf("GetUnreadNotificationCount after marking all = %d, want 0", countResp.Count)
+	}
+}
+
+func TestDevicesAndNotificationPreferences(t *testing.T) {
+	ctx := context.Background()
+	data, repo, user := NewUser(ctx, "test-devices@example.com", t)
+	defer func() {
+		repo.DeleteByEmail(context.Background(), data.Email)
+	}()
+
+	h, err := NewUserHandler()
+	if err != nil {
+		t.Fatalf("Failed to create handler: %v", err)
+	}
+	userCtx := context.WithValue(ctx, "user_id", int(user.ID))
+
+	if _, err := h.RegisterDevice(userCtx, &userspb.RegisterDeviceRequest{Token: "tok-1", Platform: "symbian"}); err == nil {
+		t.Fatalf("RegisterDevice should reject unknown platforms")
+	}
+	if _, err := h.RegisterDevice(userCtx, &userspb.RegisterDeviceRequest{Token: "tok-1", Platform: "android", Latitude: 12.97, Longitude: 77.59}); err != nil {
+		t.Fatalf("Failed to register device: %v", err)
+	}
+	// Registering again only refreshes the token
+	if _, err := h.RegisterDevice(userCtx, &userspb.RegisterDeviceRequest{Token: "tok-1", Platform: "android"}); err != nil {
+		t.Fatalf("Failed to refresh device: %v", err)
+	}
+
+	prefResp, err := h.GetNotificationPreferences(userCtx, &userspb.GetNotificationPreferencesRequest{})
+	if err != nil {
+		t.Fatalf("Failed to get preferences: %v", err)
+	}
+	for _, preference := range prefResp.Preferences {
+		if !preference.Push {
+			t.Fatalf("push for %s should default to on", preference.Category)
+		}
+	}
+
+	updated, err := h.UpdateNotificationPreferences(userCtx, &userspb.UpdateNotificationPreferencesRequest{
+		Preferences: []*schemapb.NotificationPreference{{Category: notify.CategoryOffers, Push: false}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to update preferences: %v", err)
+	}
+	for _, preference := range updated.Preferences {
+		if want := preference.Category != notify.CategoryOffers; preference.Push != want {
+			t.Fatalf("push for %s = %v, want %v", preference.Category, preference.Push, want)
+		}
+	}
+
+	if _, err := h.UpdateNotificationPreferences(userCtx, &userspb.UpdateNotificationPreferencesRequest{
+		Preferences: []*schemapb.NotificationPreference{{Category: "gossip"}},
+	}); err == nil {
+		t.Fatalf("UpdateNotificationPreferences should reject unknown categories")
+	}
+
+	if _, err := h.UpdateNotificationPreferences(userCtx, &userspb.UpdateNotificationPreferencesRequest{
+		Preferences: []*schemapb.NotificationPreference{{Category: notify.CategorySecurity, Push: false, Email: true, InApp: true}},
+	}); err == nil {
+		t.Fatalf("UpdateNotificationPreferences should not turn off security notifications")
+	}
+
+	quietResp, err := h.SetQuietHours(userCtx, &userspb.SetQuietHoursRequest{Timezone: "Asia/Kolkata", Start: "22:00", End: "07:00"})
+	if err != nil {
+		t.Fatalf("Failed to set quiet hours: %v", err)
+	}
+	if quietResp.QuietHoursStart != "22:00" || quietResp.QuietHoursEnd != "07:00" {
+		t.Fatalf("quiet hours = %s-%s, want 22:00-07:00", quietResp.QuietHoursStart, quietResp.QuietHoursEnd)
+	}
+	if _, err := h.SetQuietHours(userCtx, &userspb.SetQuietHoursRequest{Timezone: "Mars/Olympus", Start: "22:00", End: "07:00"}); err == nil {
+		t.Fatalf("SetQuietHours should reject unknown timezones")
+	}
+	cleared, err := h.SetQuietHours(userCtx, &userspb.SetQuietHoursRequest{})
+	if err != nil {
+		t.Fatalf("Failed to clear quiet hours: %v", err)
+	}
+	if cleared.QuietHoursStart != "" {
+		t.Fatalf("quiet hours should be off, got start %s", cleared.QuietHoursStart)
+	}
+
+	langResp, err := h.SetNotificationLanguage(userCtx, &userspb.SetNotificationLanguageRequest{Locale: "hi"})
+	if err != nil {
+		t.Fatalf("Failed to set language: %v", err)
+	}
+	if langResp.Locale != "hi" || langResp.QuietHoursStart != "" {
+		t.Fatalf("language = %s, quiet hours %s; want hi and quiet hours untouched", langResp.Locale, langResp.QuietHoursStart)
+	}
+	if _, err := h.SetNotificationLanguage(userCtx, &userspb.SetNotificationLanguageRequest{Locale: "xx"}); err == nil {
+		t.Fatalf("SetNotificationLanguage should reject unsupported languages")
+	}
+
+	unregResp, err := h.UnregisterDevice(userCtx, &userspb.UnregisterDeviceRequest{Token: "tok-1"})
+	if err != nil {
+		t.Fatalf("Failed to unregister device: %v", err)
+	}
+	if !unregResp.Success {
+		t.Fatalf("UnregisterDevice should remove the registered token")
+	}
+}
@@ -28,6 +28,21 @@ type UserRepository interface {
 	UpdateReferralRewardStatus(ctx context.Context, params schema.UpdateReferralRewardStatusParams) error
 	GenerateUploadURL(ctx context.Context, userID, fileName, contentType string) (uploadURL, fileURL string, err error)
 	GenerateViewURL(ctx context.Context, userID, fileName string) (string, error)
+
+	GetMerchantByID(ctx context.Context, merchantID int64) (schema.Merchant, error)
+	GetOfferByID(ctx context.Context, offerID int64) (schema.Offer, error)
+	AddFavoriteMerchant(ctx context.Context, userID int, merchantID int64) error
+	RemoveFavoriteMerchant(ctx context.Context, userID int, merchantID int64) (bool, error)
+	ListFavoriteMerchants(ctx context.Context, userID int) ([]schema.Merchant, error)
+	AddFavoriteOffer(ctx context.Context, userID int, offerID int64) error
+	RemoveFavoriteOffer(ctx context.Context, userID int, offerID int64) (bool, error)
+	ListFavoriteOffers(ctx context.Context, userID int) ([]schema.Offer, error)
+
+	// Recommendation signals
+	ListMerchantsInArea(ctx context.Context, params schema.ListMerchantsInAreaParams) ([]schema.ListMerchantsInAreaRow, error)
+	ListFavoriteMerchantIDs(ctx context.Context, userID int) ([]int64, error)
+	ListUserMerchantVisits(ctx context.Context, userID int) ([]schema.ListUserMerchantVisitsRow, error)
+	ListUserRatings(ctx context.Context, userID int) ([]schema.ListUserRatingsRow, error)
 }
 
 type userRepository struct {
@@ -165,3 +180,53 @@ func (r *userRepository) GenerateViewURL(ctx context.Context, userID, fileName s
 	fileURL := "http://" + cfg.S3.Endpoint + "/" + cfg.S3.BucketName + "/" + objectName
 	return fileURL, nil
 }
+
+func (r *userRepository) GetMerchantByID(ctx context.Context, merchantID int64) (schema.Merchant, error) {
+	return r.queries.GetMerchantByID(ctx, merchantID)
+}
+
+func (r *userRepository) GetOfferByID(ctx context.Context, offerID int64) (schema.Offer, error) {
+	return r.queries.GetOfferByID(ctx, offerID)
+}
+
+func (r *userRepository) AddFavoriteMerchant(ctx context.Context, userID int, merchantID int64) error {
+	return r.queries.AddFavoriteMerchant(ctx, schema.AddFavoriteMerchantParams{UserID: int64(userID), MerchantID: merchantID})
+}
+
+func (r *userRepository) RemoveFavoriteMerchant(ctx context.Context, userID int, merchantID int64) (bool, error) {
+	removed, err := r.queries.RemoveFavoriteMerchant(ctx, schema.RemoveFavoriteMerchantParams{UserID: int64(userID), MerchantID: merchantID})
+	return removed > 0, err
+}
+
+func (r *userRepository) ListFavoriteMerchants(ctx context.Context, userID int) ([]schema.Merchant, error) {
+	return r.queries.ListFavoriteMerchants(ctx, int64(userID))
+}
+
+func (r *userRepository) AddFavoriteOffer(ctx context.Context, userID int, offerID int64) error {
+	return r.queries.AddFavoriteOffer(ctx, schema.AddFavoriteOfferParams{UserID: int64(userID), OfferID: offerID})
+}
+
+func (r *userRepository) RemoveFavoriteOffer(ctx context.Context, userID int, offerID int64) (bool, error) {
+	removed, err := r.queries.RemoveFavoriteOffer(ctx, schema.RemoveFavoriteOfferParams{UserID: int64(userID), OfferID: offerID})
+	return removed > 0, err
+}
+
+func (r *userRepository) ListFavoriteOffers(ctx context.Context, userID int) ([]schema.Offer, error) {
+	return r.queries.ListFavoriteOffers(ctx, int64(userID))
+}
+
+func (r *userRepository) ListMerchantsInArea(ctx context.Context, params schema.ListMerchantsInAreaParams) ([]schema.ListMerchantsInAreaRow, error) {
+	return r.queries.ListMerchantsInArea(ctx, params)
+}
+
+func (r *userRepository) ListFavoriteMerchantIDs(ctx context.Context, userID int) ([]int64, error) {
+	return r.queries.ListFavoriteMerchantIDs(ctx, int64(userID))
+}
+
+func (r *userRepository) ListUserMerchantVisits(ctx context.Context, userID int) ([]schema.ListUserMerchantVisitsRow, error) {
+	return r.queries.ListUserMerchantVisits(ctx, pgtype.Int8{Int64: int64(userID), Valid: true})
+}
+
+func (r *userRepository) ListUserRatings(ctx context.Context, userID int) ([]schema.ListUserRatingsRow, error) {
+	return r.queries.ListUserRatings(ctx, pgtype.Int8{Int64: int64(userID), Valid: true})
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	userspb "rival/gen/proto/proto/api"
+	schemapb "rival/gen/proto/proto/schema"
+	schema "rival/gen/sql"
+	reviewutil "rival/internal/reviews/util"
+	"rival/pkg/utils"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AddFavorite saves a merchant or an offer for the user. Saving it again is
+// not an error.
+func (s *userService) AddFavorite(ctx context.Context, userID int, merchantID, offerID int64) error {
+	if (merchantID == 0) == (offerID == 0) {
+		return status.Error(codes.InvalidArgument, "set either merchant_id or offer_id")
+	}
+
+	if merchantID != 0 {
+		_, err := s.repo.GetMerchantByID(ctx, merchantID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return status.Error(codes.NotFound, "merchant not found")
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get merchant: %w", err)
+		}
+		if err := s.repo.AddFavoriteMerchant(ctx, userID, merchantID); err != nil {
+			return fmt.Errorf("failed to save favorite: %w", err)
+		}
+		return nil
+	}
+
+	_, err := s.repo.GetOfferByID(ctx, offerID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return status.Error(codes.NotFound, "offer not found")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get offer: %w", err)
+	}
+	if err := s.repo.AddFavoriteOffer(ctx, userID, offerID); err != nil {
+		return fmt.Errorf("failed to save favorite: %w", err)
+	}
+	return nil
+}
+
+func (s *userService) RemoveFavorite(ctx context.Context, userID int, merchantID, offerID int64) error {
+	if (merchantID == 0) == (offerID == 0) {
+		return status.Error(codes.InvalidArgument, "set either merchant_id or offer_id")
+	}
+
+	var removed bool
+	var err error
+	if merchantID != 0 {
+		removed, err = s.repo.RemoveFavoriteMerchant(ctx, userID, merchantID)
+	} else {
+		removed, err = s.repo.RemoveFavoriteOffer(ctx, userID, offerID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to remove favorite: %w", err)
+	}
+	if !removed {
+		return status.Error(codes.NotFound, "favorite not found")
+	}
+	return nil
+}
+
+// ListFavorites returns the user's saved merchants and offers, most recently
+// saved first.
+func (s *userService) ListFavorites(ctx context.Context, userID int) (*userspb.ListFavoritesResponse, error) {
+	merchants, err := s.repo.ListFavoriteMerchants(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get favorite merchants: %w", err)
+	}
+	offers, err := s.repo.ListFavoriteOffers(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get favorite offers: %w", err)
+	}
+
+	response := &userspb.ListFavoritesResponse{}
+	for _, merchant := range merchants {
+		response.Merchants = append(response.Merchants, convertToProtoMerchant(merchant))
+	}
+	for _, offer := range offers {
+		response.Offers = append(response.Offers, convertToProtoOffer(offer))
+	}
+	return response, nil
+}
+
+func convertToProtoMerchant(merchant schema.Merchant) *schemapb.Merchant {
+	return &schemapb.Merchant{
+		Id:                 merchant.ID,
+		Name:               merchant.Name,
+		Phone:              merchant.Phone.String,
+		Category:           merchant.Category.String,
+		DiscountPercentage: utils.NumericToFloat64(merchant.DiscountPercentage),
+		IsActive:           merchant.IsActive.Bool,
+		Status:             merchant.Status,
+		Timezone:           merchant.Timezone,
+		RatingAverage:      reviewutil.AverageRating(merchant.RatingTotal, merchant.RatingCount),
+		RatingCount:        merchant.RatingCount,
+		CreatedAt:          merchant.CreatedAt.Time.Unix(),
+		UpdatedAt:          merchant.UpdatedAt.Time.Unix(),
+	}
+}
+
+func convertToProtoOffer(offer schema.Offer) *schemapb.Offer {
+	protoOffer := &schemapb.Offer{
+		Id:                 offer.ID,
+		MerchantId:         offer.MerchantID.Int64,
+		Title:              offer.Title,
+		Description:        offer.Description.String,
+		DiscountPercentage: utils.NumericToFloat64(offer.DiscountPercentage),
+		MinAmount:          utils.NumericToFloat64(offer.MinAmount),
+		MaxDiscount:        utils.NumericToFloat64(offer.MaxDiscount),
+		IsActive:           offer.IsActive.Bool,
+		ValidFrom:          offer.ValidFrom.Time.Unix(),
+		CreatedAt:          offer.CreatedAt.Time.Unix(),
+		UpdatedAt:          offer.UpdatedAt.Time.Unix(),
+	}
+	if offer.ValidUntil.Valid {
+		protoOffer.ValidUntil = offer.ValidUntil.Time.Unix()
+	}
+	return protoOffer
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	userspb "rival/gen/proto/proto/api"
+	schema "rival/gen/sql"
+	reviewutil "rival/internal/reviews/util"
+	"rival/internal/users/util"
+	"rival/pkg/geo"
+)
+
+const (
+	defaultRecommendationRadiusKm = 5.0
+	maxRecommendationRadiusKm     = 50.0
+)
+
+// GetRecommendations ranks the approved merchants around center for the user,
+// see util.Score for the signals.
+func (s *userService) GetRecommendations(ctx context.Context, userID int, center geo.Coordinates, radiusKm float64, limit int) (*userspb.GetRecommendationsResponse, error) {
+	if radiusKm <= 0 {
+		radiusKm = defaultRecommendationRadiusKm
+	}
+	if radiusKm > maxRecommendationRadiusKm {
+		radiusKm = maxRecommendationRadiusKm
+	}
+
+	min, max := geo.BoundingBox(center, radiusKm)
+	rows, err := s.repo.ListMerchantsInArea(ctx, schema.ListMerchantsInAreaParams{
+		MinLat: min.Latitude,
+		MaxLat: max.Latitude,
+		MinLng: min.Longitude,
+		MaxLng: max.Longitude,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get merchants: %w", err)
+	}
+
+	// A merchant with several branches in range is ranked by its nearest one
+	nearest := make(map[int64]util.Candidate)
+	var order []int64
+	for _, row := range rows {
+		distance := geo.DistanceKm(center, geo.Coordinates{Latitude: row.Latitude, Longitude: row.Longitude})
+		if distance > radiusKm {
+			continue
+		}
+		existing, seen := nearest[row.ID]
+		if seen && existing.DistanceKm <= distance {
+			continue
+		}
+		if !seen {
+			order = append(order, row.ID)
+		}
+		nearest[row.ID] = util.Candidate{
+			MerchantID:    row.ID,
+			Name:          row.Name,
+			Category:      row.Category,
+			DistanceKm:    distance,
+			RatingAverage: reviewutil.AverageRating(row.RatingTotal, row.RatingCount),
+			RatingCount:   row.RatingCount,
+		}
+	}
+
+	candidates := make([]util.Candidate, 0, len(order))
+	for _, id := range order {
+		candidates = append(candidates, nearest[id])
+	}
+
+	profile, err := s.profile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	ranked := util.Rank(candidates, profile, radiusKm, time.Now())
+	if limit > 0 && len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+
+	response := &userspb.GetRecommendationsResponse{}
+	for _, recommendation := range ranked {
+		response.Recommendations = append(response.Recommendations, convertToProtoRecommendation(recommendation))
+	}
+	return response, nil
+}
+
+// profile gathers the user's order history, favorites and ratings.
+func (s *userService) profile(ctx context.Context, userID int) (util.Profile, error) {
+	profile := util.Profile{
+		Visits:      make(map[int64]util.Visit),
+		Categories:  make(map[string]int),
+		Favorites:   make(map[int64]bool),
+		UserRatings: make(map[int64]float64),
+	}
+
+	visits, err := s.repo.ListUserMerchantVisits(ctx, userID)
+	if err != nil {
+		return util.Profile{}, fmt.Errorf("failed to get order history: %w", err)
+	}
+	for _, visit := range visits {
+		profile.Visits[visit.MerchantID] = util.Visit{Count: int(visit.VisitCount), LastAt: visit.LastVisitAt.Time}
+		if visit.Category != "" {
+			profile.Categories[visit.Category] += int(visit.VisitCount)
+		}
+	}
+
+	favorites, err := s.repo.ListFavoriteMerchantIDs(ctx, userID)
+	if err != nil {
+		return util.Profile{}, fmt.Errorf("failed to get favorites: %w", err)
+	}
+	for _, merchantID := range favorites {
+		profile.Favorites[merchantID] = true
+	}
+
+	ratings, err := s.repo.ListUserRatings(ctx, userID)
+	if err != nil {
+		return util.Profile{}, fmt.Errorf("failed to get ratings: %w", err)
+	}
+	for _, rating := range ratings {
+		profile.UserRatings[rating.MerchantID] = rating.Rating
+	}
+
+	return profile, nil
+}
+
+func convertToProtoRecommendation(recommendation util.Recommendation) *userspb.Recommendation {
+	protoRecommendation := &userspb.Recommendation{
+		MerchantId:    recommendation.MerchantID,
+		Name:          recommendation.Name,
+		Category:      recommendation.Category,
+		DistanceKm:    recommendation.DistanceKm,
+		RatingAverage: recommendation.RatingAverage,
+		RatingCount:   recommendation.RatingCount,
+		Score:         recommendation.Score,
+	}
+	for _, reason := range recommendation.Reasons {
+		protoRecommendation.Reasons = append(protoRecommendation.Reasons, &userspb.RecommendationReason{
+			Signal: reason.Signal,
+			Points: reason.Points,
+			Text:   reason.Text,
+		})
+	}
+	return protoRecommendation
+}
@@ -8,9 +8,14 @@ import (
 	schemapb "rival/gen/proto/proto/schema"
 	schema "rival/gen/sql"
 	"rival/internal/users/repo"
+	"rival/pkg/geo"
+	"rival/pkg/notify"
+	"rival/pkg/sms"
 	"rival/pkg/utils"
 
 	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type UpdateUserParams struct {
@@ -38,14 +43,19 @@ type UserService interface {
 	GetUserStats(ctx context.Context, userID int) (*userspb.GetUserResponse, error)
 	GetReferralCode(ctx context.Context, userID int) (*userspb.GetReferralCodeResponse, error)
 	ApplyReferralCode(ctx context.Context, userID int, referralCode string) (*userspb.ApplyReferralCodeResponse, error)
+	AddFavorite(ctx context.Context, userID int, merchantID, offerID int64) error
+	RemoveFavorite(ctx context.Context, userID int, merchantID, offerID int64) error
+	ListFavorites(ctx context.Context, userID int) (*userspb.ListFavoritesResponse, error)
+	GetRecommendations(ctx context.Context, userID int, center geo.Coordinates, radiusKm float64, limit int) (*userspb.GetRecommendationsResponse, error)
 }
 
 type userService struct {
-	repo repo.UserRepository
+	repo     repo.UserRepository
+	notifier *notify.Service
 }
 
-func NewUserService(repo repo.UserRepository) UserService {
-	return &userService{repo: repo}
+func NewUserService(repo repo.UserRepository, notifier *notify.Service) UserService {
+	return &userService{repo: repo, notifier: notifier}
 }
 
 func (s *userService) GetUser(ctx context.Context, userID int) (*userspb.GetUserResponse, error) {
@@ -60,10 +70,19 @@ func (s *userService) GetUser(ctx context.Context, userID int) (*userspb.GetUser
 }
 
 func (s *userService) UpdateUser(ctx context.Context, params UpdateUserParams) (*userspb.UpdateUserResponse, error) {
+	// Phones are stored in E.164 so phone sign in can find them
+	phone := params.Phone
+	if phone != "" {
+		var err error
+		if phone, err = sms.NormalizePhone(phone); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "enter a valid mobile number")
+		}
+	}
+
 	updateParams := schema.UpdateUserProfileParams{
 		ID:         int64(params.UserID),
 		Name:       params.Name,
-		Phone:      pgtype.Text{String: params.Phone, Valid: params.Phone != ""},
+		Phone:      pgtype.Text{String: phone, Valid: phone != ""},
 		ProfilePic: pgtype.Text{String: params.ProfilePic, Valid: params.ProfilePic != ""},
 	}
 
@@ -221,6 +240,14 @@ func (s *userService) ApplyReferralCode(ctx context.Context, userID int, referra
 		return nil, err
 	}
 
+	s.notifier.Notify(ctx, notify.Notification{
+		To:       notify.User(referrer.ID),
+		Type:     notify.TypeReferral,
+		Title:    "Your referral joined",
+		Body:     fmt.Sprintf("%s used your referral code, your reward is on its way", currentUser.Name),
+		DeepLink: notify.ReferralsLink,
+	})
+
 	return &userspb.ApplyReferralCodeResponse{
 		Success:      true,
 		Message:      "Referral code applied successfully! You and your referrer will receive rewards.",
@@ -282,19 +309,20 @@ func convertToProtoUser(user schema.User) *schemapb.User {
 	}
 
 	return &schemapb.User{
-		Id:           user.ID,
-		Email:        user.Email,
-		PasswordHash: user.PasswordHash.String,
-		Phone:        user.Phone.String,
-		Name:         user.Name,
-		ProfilePic:   repo.GenerateViewURL(fmt.Sprintf("%d", user.ID), "profile.jpg"),
-		FirebaseUid:  user.FirebaseUid.String,
-		CoinBalance:  coinBalance,
-		Role:         role,
-		ReferralCode: user.ReferralCode.String,
-		ReferredBy:   referredBy,
-		CreatedAt:    user.CreatedAt.Time.Unix(),
-		UpdatedAt:    user.UpdatedAt.Time.Unix(),
+		Id:            user.ID,
+		Email:         user.Email,
+		PasswordHash:  user.PasswordHash.String,
+		Phone:         user.Phone.String,
+		Name:          user.Name,
+		ProfilePic:    repo.GenerateViewURL(fmt.Sprintf("%d", user.ID), "profile.jpg"),
+		FirebaseUid:   user.FirebaseUid.String,
+		CoinBalance:   coinBalance,
+		Role:          role,
+		ReferralCode:  user.ReferralCode.String,
+		ReferredBy:    referredBy,
+		CreatedAt:     user.CreatedAt.Time.Unix(),
+		UpdatedAt:     user.UpdatedAt.Time.Unix(),
+		PhoneVerified: user.PhoneVerifiedAt.Valid,
 	}
 }
 
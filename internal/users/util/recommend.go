@@ -0,0 +1,171 @@
+package util
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// Recommendation signals. Each one adds points to a merchant's score and
+// explains itself, so the feed can say why a merchant is there.
+const (
+	SignalDistance   = "distance"
+	SignalHistory    = "history"
+	SignalCategory   = "category"
+	SignalFavorite   = "favorite"
+	SignalRating     = "rating"
+	SignalUserRating = "user_rating"
+)
+
+// Signal weights: the most points each signal can add
+const (
+	weightDistance   = 1.0
+	weightHistory    = 2.0
+	weightCategory   = 1.5
+	weightFavorite   = 2.5
+	weightRating     = 1.0
+	weightUserRating = 1.5 // negative for merchants the user rated badly
+)
+
+const (
+	historyVisitCap     = 10                  // visits beyond this don't add more
+	historyHalfLife     = 30 * 24 * time.Hour // a visit counts half as much a month later
+	ratingPriorMean     = 3.5                 // pulls merchants with few ratings towards the middle
+	ratingPriorWeight   = 5
+	minRatingsToMention = 3
+)
+
+// Candidate is a nearby merchant to rank.
+type Candidate struct {
+	MerchantID    int64
+	Name          string
+	Category      string
+	DistanceKm    float64
+	RatingAverage float64
+	RatingCount   int32
+}
+
+// Visit is how often and how recently the user bought from a merchant.
+type Visit struct {
+	Count  int
+	LastAt time.Time
+}
+
+// Profile is what the user's history says about their taste.
+type Profile struct {
+	Visits      map[int64]Visit
+	Categories  map[string]int // visits per merchant category
+	Favorites   map[int64]bool
+	UserRatings map[int64]float64 // stars the user gave each merchant
+}
+
+// Reason is one signal's share of a score.
+type Reason struct {
+	Signal string
+	Points float64
+	Text   string
+}
+
+type Recommendation struct {
+	Candidate
+	Score   float64
+	Reasons []Reason // largest share first
+}
+
+// Rank scores every candidate and returns them best first; ties go to the
+// nearer merchant. radiusKm is the search radius distance is measured against.
+func Rank(candidates []Candidate, profile Profile, radiusKm float64, now time.Time) []Recommendation {
+	recommendations := make([]Recommendation, 0, len(candidates))
+	for _, candidate := range candidates {
+		recommendations = append(recommendations, Score(candidate, profile, radiusKm, now))
+	}
+	sort.SliceStable(recommendations, func(i, j int) bool {
+		if recommendations[i].Score != recommendations[j].Score {
+			return recommendations[i].Score > recommendations[j].Score
+		}
+		if recommendations[i].DistanceKm != recommendations[j].DistanceKm {
+			return recommendations[i].DistanceKm < recommendations[j].DistanceKm
+		}
+		return recommendations[i].MerchantID < recommendations[j].MerchantID
+	})
+	return recommendations
+}
+
+// Score adds up the signals for one merchant.
+func Score(candidate Candidate, profile Profile, radiusKm float64, now time.Time) Recommendation {
+	var reasons []Reason
+	add := func(signal string, points float64, text string) {
+		if points != 0 {
+			reasons = append(reasons, Reason{Signal: signal, Points: round(points), Text: text})
+		}
+	}
+
+	if radiusKm > 0 {
+		closeness := math.Max(0, 1-candidate.DistanceKm/radiusKm)
+		add(SignalDistance, weightDistance*closeness, fmt.Sprintf("%.1f km away", candidate.DistanceKm))
+	}
+
+	if visit, ok := profile.Visits[candidate.MerchantID]; ok && visit.Count > 0 {
+		frequency := float64(min(visit.Count, historyVisitCap)) / historyVisitCap
+		age := math.Max(0, now.Sub(visit.LastAt).Hours())
+		recency := math.Pow(0.5, age/historyHalfLife.Hours())
+		add(SignalHistory, weightHistory*frequency*recency, visitText(visit.Count))
+	}
+
+	if share := categoryShare(profile.Categories, candidate.Category); share > 0 {
+		add(SignalCategory, weightCategory*share, fmt.Sprintf("You often visit %s places", candidate.Category))
+	}
+
+	if profile.Favorites[candidate.MerchantID] {
+		add(SignalFavorite, weightFavorite, "In your favorites")
+	}
+
+	smoothed := (candidate.RatingAverage*float64(candidate.RatingCount) + ratingPriorMean*ratingPriorWeight) /
+		float64(candidate.RatingCount+ratingPriorWeight)
+	ratingText := "Not rated yet"
+	if candidate.RatingCount >= minRatingsToMention {
+		ratingText = fmt.Sprintf("Rated %.1f by %d customers", candidate.RatingAverage, candidate.RatingCount)
+	}
+	add(SignalRating, weightRating*(smoothed-1)/4, ratingText)
+
+	if stars, ok := profile.UserRatings[candidate.MerchantID]; ok {
+		add(SignalUserRating, weightUserRating*(stars-3)/2, fmt.Sprintf("You rated it %.0f stars", stars))
+	}
+
+	var score float64
+	for _, reason := range reasons {
+		score += reason.Points
+	}
+	sort.SliceStable(reasons, func(i, j int) bool {
+		return math.Abs(reasons[i].Points) > math.Abs(reasons[j].Points)
+	})
+
+	return Recommendation{Candidate: candidate, Score: round(score), Reasons: reasons}
+}
+
+// categoryShare is the part of the user's visits that went to category.
+func categoryShare(categories map[string]int, category string) float64 {
+	if category == "" {
+		return 0
+	}
+	var total int
+	for _, count := range categories {
+		total += count
+	}
+	if total == 0 {
+		return 0
+	}
+	return float64(categories[category]) / float64(total)
+}
+
+func visitText(count int) string {
+	if count == 1 {
+		return "You've been here once"
+	}
+	return fmt.Sprintf("You've been here %d times", count)
+}
+
+func round(value float64) float64 {
+	return math.Round(value*1000) / 1000
+}
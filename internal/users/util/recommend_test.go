@@ -0,0 +1,105 @@
+package util
+
+import (
+	"testing"
+	"time"
+)
+
+func TestScore_ColdStart(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	near := Score(Candidate{MerchantID: 1, DistanceKm: 1}, Profile{}, 5, now)
+	far := Score(Candidate{MerchantID: 2, DistanceKm: 4}, Profile{}, 5, now)
+	if near.Score <= far.Score {
+		t.Errorf("Expected the nearer merchant to score higher, got %v and %v", near.Score, far.Score)
+	}
+
+	// A single 5 star rating is pulled towards the prior, many are not
+	one := Score(Candidate{MerchantID: 3, RatingAverage: 5, RatingCount: 1}, Profile{}, 0, now)
+	many := Score(Candidate{MerchantID: 4, RatingAverage: 5, RatingCount: 50}, Profile{}, 0, now)
+	if one.Score >= many.Score {
+		t.Errorf("Expected many ratings to outweigh one, got %v and %v", one.Score, many.Score)
+	}
+	if many.Reasons[0].Text != "Rated 5.0 by 50 customers" {
+		t.Errorf("Unexpected reason %+v", many.Reasons[0])
+	}
+}
+
+func TestScore_Explains(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	profile := Profile{
+		Visits:      map[int64]Visit{1: {Count: 3, LastAt: now}},
+		Categories:  map[string]int{"cafe": 3, "grocery": 1},
+		Favorites:   map[int64]bool{1: true},
+		UserRatings: map[int64]float64{1: 5},
+	}
+	got := Score(Candidate{MerchantID: 1, Category: "cafe", DistanceKm: 2.5}, profile, 5, now)
+
+	want := map[string]float64{
+		SignalDistance:   0.5,
+		SignalHistory:    0.6,
+		SignalCategory:   1.125,
+		SignalFavorite:   2.5,
+		SignalRating:     0.625,
+		SignalUserRating: 1.5,
+	}
+	var total float64
+	for _, reason := range got.Reasons {
+		if reason.Points != want[reason.Signal] {
+			t.Errorf("%s = %v points, want %v", reason.Signal, reason.Points, want[reason.Signal])
+		}
+		total += reason.Points
+	}
+	if len(got.Reasons) != len(want) {
+		t.Errorf("Expected %d reasons, got %+v", len(want), got.Reasons)
+	}
+	if got.Score != round(total) {
+		t.Errorf("Score %v does not add up to %v", got.Score, total)
+	}
+	if got.Reasons[0].Signal != SignalFavorite {
+		t.Errorf("Expected the favorite to explain most, got %+v", got.Reasons[0])
+	}
+}
+
+func TestScore_HistoryDecays(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	recent := Profile{Visits: map[int64]Visit{1: {Count: 10, LastAt: now}}}
+	old := Profile{Visits: map[int64]Visit{1: {Count: 10, LastAt: now.Add(-30 * 24 * time.Hour)}}}
+
+	a := Score(Candidate{MerchantID: 1}, recent, 0, now)
+	b := Score(Candidate{MerchantID: 1}, old, 0, now)
+	if diff := a.Score - b.Score; diff < 0.999 || diff > 1.001 {
+		t.Errorf("Expected a month old history to count half (1 point less), got %v and %v", a.Score, b.Score)
+	}
+}
+
+func TestScore_BadExperience(t *testing.T) {
+	now := time.Now()
+	profile := Profile{UserRatings: map[int64]float64{1: 1}}
+	disliked := Score(Candidate{MerchantID: 1, DistanceKm: 1}, profile, 5, now)
+	other := Score(Candidate{MerchantID: 2, DistanceKm: 1}, profile, 5, now)
+	if disliked.Score >= other.Score {
+		t.Errorf("Expected a merchant rated 1 star to rank lower, got %v and %v", disliked.Score, other.Score)
+	}
+}
+
+func TestRank(t *testing.T) {
+	now := time.Now()
+	profile := Profile{Favorites: map[int64]bool{3: true}}
+	ranked := Rank([]Candidate{
+		{MerchantID: 1, DistanceKm: 1},
+		{MerchantID: 2, DistanceKm: 0.5},
+		{MerchantID: 3, DistanceKm: 4},
+		{MerchantID: 4, DistanceKm: 1},
+	}, profile, 5, now)
+
+	var ids []int64
+	for _, r := range ranked {
+		ids = append(ids, r.MerchantID)
+	}
+	want := []int64{3, 2, 1, 4}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Fatalf("Rank order = %v, want %v", ids, want)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package alerts
+
+import (
+	"strconv"
+	"time"
+
+	adminpb "rival/gen/proto/proto/api"
+	"rival/pkg/pubsub"
+)
+
+const topic = "system_alerts"
+
+// Severities
+const (
+	SeverityInfo     = "info"
+	SeverityWarning  = "warning"
+	SeverityError    = "error"
+	SeverityCritical = "critical"
+)
+
+// Alert types
+const (
+	TypeMerchantSignup = "merchant_signup"
+	TypeHighVolume     = "high_volume"
+	TypeSystemError    = "system_error"
+	TypeOrderDelayed   = "order_delayed"
+)
+
+// Publish sends an alert to every admin subscribed to StreamSystemAlerts.
+func Publish(title, message, severity, alertType string) {
+	now := time.Now()
+	pubsub.Get().Publish(topic, &adminpb.StreamSystemAlertsResponse{
+		Id:        "alert_" + strconv.FormatInt(now.UnixNano(), 10),
+		Title:     title,
+		Message:   message,
+		Severity:  severity,
+		Type:      alertType,
+		Timestamp: now.Unix(),
+	})
+}
+
+func Subscribe() *pubsub.Channel {
+	return pubsub.Get().Subscribe(topic)
+}
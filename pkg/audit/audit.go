@@ -0,0 +1,75 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"net/netip"
+
+	schema "rival/gen/sql"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Actor types
+const (
+	ActorUser     = "user"
+	ActorMerchant = "merchant"
+	ActorAdmin    = "admin"
+	ActorSystem   = "system"
+	ActorAnon     = "anonymous"
+)
+
+// Entry is one row of the audit log.
+type Entry struct {
+	ActorID    int64
+	ActorType  string
+	Action     string
+	TargetType string
+	TargetID   int64
+	Metadata   map[string]interface{}
+	IPAddress  string
+	UserAgent  string
+}
+
+type Service struct {
+	queries *schema.Queries
+}
+
+func NewService(db *pgxpool.Pool) *Service {
+	return &Service{
+		queries: schema.New(db),
+	}
+}
+
+func (s *Service) Log(ctx context.Context, entry Entry) error {
+	var metadata []byte
+	if len(entry.Metadata) > 0 {
+		data, err := json.Marshal(entry.Metadata)
+		if err != nil {
+			return err
+		}
+		metadata = data
+	}
+
+	var ip *netip.Addr
+	if addr, err := netip.ParseAddr(entry.IPAddress); err == nil {
+		ip = &addr
+	}
+
+	actorType := entry.ActorType
+	if actorType == "" {
+		actorType = ActorAnon
+	}
+
+	return s.queries.CreateAuditLog(ctx, schema.CreateAuditLogParams{
+		ActorID:    pgtype.Int8{Int64: entry.ActorID, Valid: entry.ActorID != 0},
+		ActorType:  actorType,
+		Action:     entry.Action,
+		TargetType: pgtype.Text{String: entry.TargetType, Valid: entry.TargetType != ""},
+		TargetID:   pgtype.Int8{Int64: entry.TargetID, Valid: entry.TargetID != 0},
+		Metadata:   metadata,
+		IpAddress:  ip,
+		UserAgent:  pgtype.Text{String: entry.UserAgent, Valid: entry.UserAgent != ""},
+	})
+}
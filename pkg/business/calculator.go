@@ -2,7 +2,6 @@ package business
 
 import (
 	"fmt"
-	"time"
 )
 
 type DiscountCalculator struct {
@@ -110,21 +109,3 @@ func (dc *DiscountCalculator) CalculateReferralBonus() ReferralBonus {
 		RefereeBonus:  10.0, // $10 for new user
 	}
 }
-
-func (dc *DiscountCalculator) ValidateBusinessHours(merchantType string) error {
-	now := time.Now()
-	hour := now.Hour()
-	
-	switch merchantType {
-	case "restaurant":
-		// Restaurant hours: 6 AM to 11 PM
-		if hour < 6 || hour > 23 {
-			return fmt.Errorf("restaurant payments only allowed between 6 AM and 11 PM")
-		}
-	case "grocery":
-		// Grocery available 24/7
-		return nil
-	}
-	
-	return nil
-}
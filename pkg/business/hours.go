@@ -0,0 +1,113 @@
+package business
+
+import (
+	"fmt"
+	"time"
+	_ "time/tzdata" // merchants pick IANA zones, don't depend on the host's zoneinfo
+)
+
+// EndOfDay is 24:00, the latest closing time.
+const EndOfDay = 24 * 60
+
+// Interval is one opening window on a weekday, in minutes since local midnight.
+// Closes before Opens means the window runs past midnight into the next day.
+// Closes at EndOfDay means it runs until midnight.
+type Interval struct {
+	Day    time.Weekday
+	Opens  int
+	Closes int
+}
+
+// Closure is a holiday or temporary closure that overrides the weekly hours.
+type Closure struct {
+	Start  time.Time
+	End    time.Time
+	Reason string
+}
+
+// Schedule decides whether a merchant is open. A merchant without intervals
+// has never set hours and is treated as always open.
+type Schedule struct {
+	Location    *time.Location
+	Intervals   []Interval
+	Closures    []Closure
+	PausedUntil time.Time
+}
+
+// Open reports whether the merchant takes orders at now, and why not when closed.
+func (s Schedule) Open(now time.Time) (bool, string) {
+	if now.Before(s.PausedUntil) {
+		return false, "orders are paused until " + s.PausedUntil.In(s.location()).Format("15:04")
+	}
+
+	for _, c := range s.Closures {
+		if !now.Before(c.Start) && now.Before(c.End) {
+			if c.Reason != "" {
+				return false, "closed: " + c.Reason
+			}
+			return false, "closed until " + c.End.In(s.location()).Format("Jan 2 15:04")
+		}
+	}
+
+	if len(s.Intervals) == 0 {
+		return true, ""
+	}
+
+	local := now.In(s.location())
+	minute := local.Hour()*60 + local.Minute()
+	today := local.Weekday()
+	yesterday := (today + 6) % 7
+
+	for _, in := range s.Intervals {
+		overnight := in.Closes < in.Opens
+		if in.Day == today && minute >= in.Opens && (overnight || minute < in.Closes) {
+			return true, ""
+		}
+		if in.Day == yesterday && overnight && minute < in.Closes {
+			return true, ""
+		}
+	}
+	return false, "outside business hours"
+}
+
+func (s Schedule) location() *time.Location {
+	if s.Location == nil {
+		return time.UTC
+	}
+	return s.Location
+}
+
+// ValidateInterval checks an interval is on the clock and not empty. A day
+// open around the clock is 00:00 to 24:00.
+func ValidateInterval(in Interval) error {
+	if in.Day < time.Sunday || in.Day > time.Saturday {
+		return fmt.Errorf("invalid day of week: %d", in.Day)
+	}
+	if in.Opens < 0 || in.Opens >= EndOfDay {
+		return fmt.Errorf("opening time must be between 00:00 and 23:59")
+	}
+	if in.Closes < 0 || in.Closes > EndOfDay {
+		return fmt.Errorf("closing time must be between 00:00 and 24:00")
+	}
+	if in.Opens == in.Closes {
+		return fmt.Errorf("opening and closing time must differ")
+	}
+	return nil
+}
+
+// ParseClock parses "HH:MM" into minutes since midnight. "24:00" is
+// EndOfDay, callers that only take times of day reject it.
+func ParseClock(value string) (int, error) {
+	if value == "24:00" {
+		return EndOfDay, nil
+	}
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q, expected HH:MM", value)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+func FormatClock(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
@@ -0,0 +1,109 @@
+package business
+
+import (
+	"testing"
+	"time"
+)
+
+func TestScheduleOpen(t *testing.T) {
+	kolkata, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Fatalf("failed to load timezone: %v", err)
+	}
+
+	// Lunch and dinner on Mondays, all day Wednesday, a late night shift on Fridays
+	schedule := Schedule{
+		Location: kolkata,
+		Intervals: []Interval{
+			{Day: time.Monday, Opens: 12 * 60, Closes: 15 * 60},
+			{Day: time.Monday, Opens: 19 * 60, Closes: 23 * 60},
+			{Day: time.Wednesday, Opens: 0, Closes: EndOfDay},
+			{Day: time.Friday, Opens: 20 * 60, Closes: 2 * 60},
+		},
+	}
+
+	at := func(day, hour, minute int) time.Time {
+		// 2026-10-19 is a Monday
+		return time.Date(2026, 10, 19+day, hour, minute, 0, 0, kolkata)
+	}
+
+	cases := []struct {
+		name string
+		now  time.Time
+		open bool
+	}{
+		{"lunch", at(0, 13, 0), true},
+		{"between shifts", at(0, 16, 0), false},
+		{"closing minute", at(0, 15, 0), false},
+		{"dinner", at(0, 22, 59), true},
+		{"tuesday", at(1, 13, 0), false},
+		{"wednesday midnight", at(2, 0, 0), true},
+		{"wednesday last minute", at(2, 23, 59), true},
+		{"thursday after all day", at(3, 0, 30), false},
+		{"friday night", at(4, 23, 30), true},
+		{"past midnight into saturday", at(5, 1, 30), true},
+		{"saturday after close", at(5, 2, 0), false},
+		{"lunch seen from utc", at(0, 13, 0).UTC(), true},
+	}
+	for _, tc := range cases {
+		if open, reason := schedule.Open(tc.now); open != tc.open {
+			t.Errorf("%s: open = %v (%s), want %v", tc.name, open, reason, tc.open)
+		}
+	}
+}
+
+func TestScheduleOverrides(t *testing.T) {
+	now := time.Date(2026, 12, 25, 10, 0, 0, 0, time.UTC)
+
+	if open, _ := (Schedule{}).Open(now); !open {
+		t.Error("merchant without hours should be open")
+	}
+
+	holiday := Schedule{Closures: []Closure{{
+		Start:  time.Date(2026, 12, 25, 0, 0, 0, 0, time.UTC),
+		End:    time.Date(2026, 12, 26, 0, 0, 0, 0, time.UTC),
+		Reason: "Christmas",
+	}}}
+	if open, reason := holiday.Open(now); open || reason != "closed: Christmas" {
+		t.Errorf("expected holiday closure, got open=%v reason=%q", open, reason)
+	}
+	if open, _ := holiday.Open(now.Add(24 * time.Hour)); !open {
+		t.Error("expected merchant to reopen after the holiday")
+	}
+
+	paused := Schedule{PausedUntil: now.Add(30 * time.Minute)}
+	if open, _ := paused.Open(now); open {
+		t.Error("expected paused merchant to be closed")
+	}
+	if open, _ := paused.Open(now.Add(time.Hour)); !open {
+		t.Error("expected pause to lapse")
+	}
+}
+
+func TestValidateInterval(t *testing.T) {
+	if err := ValidateInterval(Interval{Day: time.Friday, Opens: 20 * 60, Closes: 2 * 60}); err != nil {
+		t.Errorf("overnight interval rejected: %v", err)
+	}
+	if err := ValidateInterval(Interval{Day: time.Monday, Opens: 600, Closes: 600}); err == nil {
+		t.Error("expected empty interval to be rejected")
+	}
+	if err := ValidateInterval(Interval{Day: 7, Opens: 0, Closes: 60}); err == nil {
+		t.Error("expected invalid weekday to be rejected")
+	}
+	if err := ValidateInterval(Interval{Day: time.Monday, Opens: 0, Closes: EndOfDay}); err != nil {
+		t.Errorf("24 hour day rejected: %v", err)
+	}
+	if err := ValidateInterval(Interval{Day: time.Monday, Opens: EndOfDay, Closes: 60}); err == nil {
+		t.Error("expected opening at 24:00 to be rejected")
+	}
+
+	if minutes, err := ParseClock("09:30"); err != nil || minutes != 570 || FormatClock(minutes) != "09:30" {
+		t.Errorf("ParseClock(09:30) = %d, %v", minutes, err)
+	}
+	if minutes, err := ParseClock("24:00"); err != nil || minutes != EndOfDay || FormatClock(minutes) != "24:00" {
+		t.Errorf("ParseClock(24:00) = %d, %v", minutes, err)
+	}
+	if _, err := ParseClock("25:00"); err == nil {
+		t.Error("expected invalid clock to be rejected")
+	}
+}
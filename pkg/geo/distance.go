@@ -0,0 +1,33 @@
+package geo
+
+import "math"
+
+const earthRadiusKm = 6371.0
+
+// DistanceKm is the great circle distance between two points.
+func DistanceKm(a, b Coordinates) float64 {
+	lat1, lat2 := radians(a.Latitude), radians(b.Latitude)
+	dLat := lat2 - lat1
+	dLng := radians(b.Longitude - a.Longitude)
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// BoundingBox returns the min and max corners of a box containing every point
+// within radiusKm of center, for a cheap index friendly pre-filter.
+func BoundingBox(center Coordinates, radiusKm float64) (Coordinates, Coordinates) {
+	dLat := radiusKm / earthRadiusKm * 180 / math.Pi
+	dLng := 180.0
+	if cos := math.Cos(radians(center.Latitude)); cos > 0.01 {
+		dLng = math.Min(180, dLat/cos)
+	}
+
+	min := Coordinates{Latitude: math.Max(-90, center.Latitude-dLat), Longitude: math.Max(-180, center.Longitude-dLng)}
+	max := Coordinates{Latitude: math.Min(90, center.Latitude+dLat), Longitude: math.Min(180, center.Longitude+dLng)}
+	return min, max
+}
+
+func radians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
@@ -0,0 +1,35 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDistanceKm(t *testing.T) {
+	delhi := Coordinates{Latitude: 28.6139, Longitude: 77.2090}
+	mumbai := Coordinates{Latitude: 19.0760, Longitude: 72.8777}
+
+	if d := DistanceKm(delhi, mumbai); math.Abs(d-1153) > 5 {
+		t.Errorf("Delhi to Mumbai = %.1f km, want about 1153", d)
+	}
+	if d := DistanceKm(delhi, delhi); d != 0 {
+		t.Errorf("distance to self = %f", d)
+	}
+}
+
+func TestBoundingBox(t *testing.T) {
+	center := Coordinates{Latitude: 12.9716, Longitude: 77.5946}
+	min, max := BoundingBox(center, 5)
+
+	// Points just under 5 km due north and east must fall inside the box
+	north := Coordinates{Latitude: center.Latitude + 4.99/111.2, Longitude: center.Longitude}
+	east := Coordinates{Latitude: center.Latitude, Longitude: center.Longitude + 4.99/(111.2*math.Cos(radians(center.Latitude)))}
+	for _, p := range []Coordinates{north, east} {
+		if DistanceKm(center, p) > 5 {
+			t.Fatalf("test point %+v is not within 5 km", p)
+		}
+		if p.Latitude > max.Latitude || p.Latitude < min.Latitude || p.Longitude > max.Longitude || p.Longitude < min.Longitude {
+			t.Errorf("%+v outside box %+v - %+v", p, min, max)
+		}
+	}
+}
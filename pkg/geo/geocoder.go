@@ -0,0 +1,164 @@
+package geo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"rival/config"
+)
+
+var ErrAddressNotFound = errors.New("address not found")
+
+type Coordinates struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// Geocoder turns a free form address into coordinates.
+type Geocoder interface {
+	Geocode(ctx context.Context, address string) (Coordinates, error)
+}
+
+// NewGeocoderFromConfig returns nil when geocoding is disabled.
+func NewGeocoderFromConfig(cfg config.GeocoderConfig) (Geocoder, error) {
+	switch cfg.Provider {
+	case "":
+		return nil, nil
+	case "nominatim":
+		return NewNominatimGeocoder(cfg.URL, cfg.UserAgent), nil
+	case "fixture":
+		return LoadFixtureGeocoder(cfg.FixturePath)
+	default:
+		return nil, fmt.Errorf("unknown geocoder provider: %s", cfg.Provider)
+	}
+}
+
+// ValidateCoordinates checks latitude and longitude are on the globe.
+func ValidateCoordinates(c Coordinates) error {
+	if c.Latitude < -90 || c.Latitude > 90 {
+		return fmt.Errorf("latitude must be between -90 and 90")
+	}
+	if c.Longitude < -180 || c.Longitude > 180 {
+		return fmt.Errorf("longitude must be between -180 and 180")
+	}
+	return nil
+}
+
+// FormatAddress joins the non-empty parts with commas, the form geocoders expect.
+func FormatAddress(parts ...string) string {
+	var kept []string
+	for _, part := range parts {
+		if part = strings.TrimSpace(part); part != "" {
+			kept = append(kept, part)
+		}
+	}
+	return strings.Join(kept, ", ")
+}
+
+// NominatimGeocoder queries an OpenStreetMap Nominatim server.
+type NominatimGeocoder struct {
+	baseURL   string
+	userAgent string
+	client    *http.Client
+}
+
+func NewNominatimGeocoder(baseURL, userAgent string) *NominatimGeocoder {
+	if baseURL == "" {
+		baseURL = "https://nominatim.openstreetmap.org"
+	}
+	return &NominatimGeocoder{
+		baseURL:   strings.TrimRight(baseURL, "/"),
+		userAgent: userAgent,
+		client:    &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+func (g *NominatimGeocoder) Geocode(ctx context.Context, address string) (Coordinates, error) {
+	query := url.Values{"q": {address}, "format": {"json"}, "limit": {"1"}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, g.baseURL+"/search?"+query.Encode(), nil)
+	if err != nil {
+		return Coordinates{}, err
+	}
+	// Nominatim's usage policy requires an identifying user agent
+	if g.userAgent != "" {
+		req.Header.Set("User-Agent", g.userAgent)
+	}
+
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return Coordinates{}, fmt.Errorf("geocoder request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return Coordinates{}, fmt.Errorf("geocoder returned status %d", resp.StatusCode)
+	}
+
+	var results []struct {
+		Lat string `json:"lat"`
+		Lon string `json:"lon"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&results); err != nil {
+		return Coordinates{}, fmt.Errorf("invalid geocoder response: %w", err)
+	}
+	if len(results) == 0 {
+		return Coordinates{}, ErrAddressNotFound
+	}
+
+	lat, err := strconv.ParseFloat(results[0].Lat, 64)
+	if err != nil {
+		return Coordinates{}, fmt.Errorf("invalid latitude in geocoder response: %w", err)
+	}
+	lng, err := strconv.ParseFloat(results[0].Lon, 64)
+	if err != nil {
+		return Coordinates{}, fmt.Errorf("invalid longitude in geocoder response: %w", err)
+	}
+	return Coordinates{Latitude: lat, Longitude: lng}, nil
+}
+
+// FixtureGeocoder answers from a fixed table, for tests and offline development.
+type FixtureGeocoder struct {
+	entries map[string]Coordinates
+}
+
+func NewFixtureGeocoder(entries map[string]Coordinates) *FixtureGeocoder {
+	normalized := make(map[string]Coordinates, len(entries))
+	for address, c := range entries {
+		normalized[normalizeAddress(address)] = c
+	}
+	return &FixtureGeocoder{entries: normalized}
+}
+
+// LoadFixtureGeocoder reads a JSON object of address -> coordinates.
+func LoadFixtureGeocoder(path string) (*FixtureGeocoder, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read geocoder fixtures: %w", err)
+	}
+
+	var entries map[string]Coordinates
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("invalid geocoder fixtures: %w", err)
+	}
+	return NewFixtureGeocoder(entries), nil
+}
+
+func (g *FixtureGeocoder) Geocode(ctx context.Context, address string) (Coordinates, error) {
+	c, ok := g.entries[normalizeAddress(address)]
+	if !ok {
+		return Coordinates{}, ErrAddressNotFound
+	}
+	return c, nil
+}
+
+func normalizeAddress(address string) string {
+	return strings.Join(strings.Fields(strings.ToLower(address)), " ")
+}
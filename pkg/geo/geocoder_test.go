@@ -0,0 +1,73 @@
+package geo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestValidateCoordinates(t *testing.T) {
+	cases := []struct {
+		c     Coordinates
+		valid bool
+	}{
+		{Coordinates{12.9716, 77.5946}, true},
+		{Coordinates{-90, 180}, true},
+		{Coordinates{90.1, 0}, false},
+		{Coordinates{0, -180.5}, false},
+	}
+	for _, tc := range cases {
+		if err := ValidateCoordinates(tc.c); (err == nil) != tc.valid {
+			t.Errorf("ValidateCoordinates(%+v) = %v, want valid=%v", tc.c, err, tc.valid)
+		}
+	}
+}
+
+func TestFixtureGeocoder(t *testing.T) {
+	g := NewFixtureGeocoder(map[string]Coordinates{
+		"12 MG Road, Bengaluru, India": {Latitude: 12.9756, Longitude: 77.6066},
+	})
+
+	address := FormatAddress(" 12 mg road ", "bengaluru", "", "India")
+	c, err := g.Geocode(context.Background(), address)
+	if err != nil {
+		t.Fatalf("expected fixture match for %q: %v", address, err)
+	}
+	if c.Latitude != 12.9756 || c.Longitude != 77.6066 {
+		t.Errorf("unexpected coordinates %+v", c)
+	}
+
+	if _, err := g.Geocode(context.Background(), "Nowhere"); !errors.Is(err, ErrAddressNotFound) {
+		t.Errorf("expected ErrAddressNotFound, got %v", err)
+	}
+}
+
+func TestNominatimGeocoder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("User-Agent") != "rival-test" {
+			t.Errorf("missing user agent")
+		}
+		if r.URL.Query().Get("q") == "Nowhere" {
+			w.Write([]byte(`[]`))
+			return
+		}
+		w.Write([]byte(`[{"lat":"28.6139","lon":"77.2090"}]`))
+	}))
+	defer server.Close()
+
+	g := NewNominatimGeocoder(server.URL, "rival-test")
+
+	c, err := g.Geocode(context.Background(), "Connaught Place, New Delhi")
+	if err != nil {
+		t.Fatalf("geocode failed: %v", err)
+	}
+	if c.Latitude != 28.6139 || c.Longitude != 77.2090 {
+		t.Errorf("unexpected coordinates %+v", c)
+	}
+
+	if _, err := g.Geocode(context.Background(), "Nowhere"); !errors.Is(err, ErrAddressNotFound) {
+		t.Errorf("expected ErrAddressNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,202 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/smtp"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"rival/config"
+)
+
+const (
+	defaultFrom = "noreply@rival.com"
+	senderName  = "RIVAL"
+)
+
+var ErrInvalidAddress = errors.New("invalid email address")
+
+// Message is one rendered email with an HTML body and its plain text
+// alternative.
+type Message struct {
+	From    string
+	To      string
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// Bytes encodes the message as multipart/alternative MIME, plain text first
+// so clients that can show HTML pick the last part.
+func (m Message) Bytes(now time.Time) ([]byte, error) {
+	for _, address := range []string{m.From, m.To} {
+		if address == "" || strings.ContainsAny(address, "\r\n") {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidAddress, address)
+		}
+	}
+
+	var buf bytes.Buffer
+	body := multipart.NewWriter(&buf)
+
+	fmt.Fprintf(&buf, "From: %s\r\n", mime.QEncoding.Encode("utf-8", senderName)+" <"+m.From+">")
+	fmt.Fprintf(&buf, "To: %s\r\n", m.To)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", m.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	fmt.Fprintf(&buf, "Message-ID: <%s@%s>\r\n", messageID(), domainOf(m.From))
+	fmt.Fprintf(&buf, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&buf, "Content-Type: multipart/alternative; boundary=%q\r\n\r\n", body.Boundary())
+
+	parts := []struct{ contentType, content string }{
+		{"text/plain; charset=UTF-8", m.Text},
+		{"text/html; charset=UTF-8", m.HTML},
+	}
+	for _, part := range parts {
+		w, err := body.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		qp := quotedprintable.NewWriter(w)
+		if _, err := qp.Write([]byte(part.content)); err != nil {
+			return nil, err
+		}
+		if err := qp.Close(); err != nil {
+			return nil, err
+		}
+	}
+	if err := body.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func messageID() string {
+	b := make([]byte, 12)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func domainOf(address string) string {
+	if at := strings.LastIndex(address, "@"); at >= 0 {
+		return address[at+1:]
+	}
+	return "rival.com"
+}
+
+// Transport hands a message to whatever delivers it.
+type Transport interface {
+	Name() string
+	Send(ctx context.Context, msg Message) error
+}
+
+// NewTransportFromConfig picks the transport set by mail.transport.
+func NewTransportFromConfig(cfg config.MailHogConfig) (Transport, error) {
+	switch cfg.Transport {
+	case "", "smtp":
+		return NewSMTPTransport(cfg.SMTPServer, cfg.SMTPPort), nil
+	case "file":
+		dir := cfg.SinkDir
+		if dir == "" {
+			dir = filepath.Join("tmp", "mail")
+		}
+		return NewFileTransport(dir), nil
+	default:
+		return nil, fmt.Errorf("unknown mail transport: %s", cfg.Transport)
+	}
+}
+
+// FromAddress is the envelope sender from mail.from.
+func FromAddress(cfg config.MailHogConfig) string {
+	if cfg.From != "" {
+		return cfg.From
+	}
+	return defaultFrom
+}
+
+// Backoff is how long to wait before retrying an email that failed attempts
+// times: 30 seconds doubling up to an hour.
+func Backoff(attempts int) time.Duration {
+	delay := 30 * time.Second
+	for i := 1; i < attempts && delay < time.Hour; i++ {
+		delay *= 2
+	}
+	if delay > time.Hour {
+		delay = time.Hour
+	}
+	return delay
+}
+
+// SMTPTransport sends through an SMTP relay. MailHog and the production relay
+// both accept mail without authentication from our network.
+type SMTPTransport struct {
+	addr string
+}
+
+func NewSMTPTransport(host string, port int) *SMTPTransport {
+	return &SMTPTransport{addr: fmt.Sprintf("%s:%d", host, port)}
+}
+
+func (t *SMTPTransport) Name() string {
+	return "smtp"
+}
+
+func (t *SMTPTransport) Send(ctx context.Context, msg Message) error {
+	data, err := msg.Bytes(time.Now())
+	if err != nil {
+		return err
+	}
+	return smtp.SendMail(t.addr, nil, msg.From, []string{msg.To}, data)
+}
+
+// FileTransport writes every message to an .eml file instead of sending it,
+// for local runs. Any mail client can open them.
+type FileTransport struct {
+	dir string
+}
+
+func NewFileTransport(dir string) *FileTransport {
+	return &FileTransport{dir: dir}
+}
+
+func (t *FileTransport) Name() string {
+	return "file"
+}
+
+func (t *FileTransport) Send(ctx context.Context, msg Message) error {
+	now := time.Now()
+	data, err := msg.Bytes(now)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(t.dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create mail sink: %w", err)
+	}
+
+	name := fmt.Sprintf("%d-%s.eml", now.UnixNano(), fileSafe(msg.To))
+	return os.WriteFile(filepath.Join(t.dir, name), data, 0o644)
+}
+
+func fileSafe(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '.', r == '-', r == '_':
+			return r
+		case r == '@':
+			return '_'
+		}
+		return -1
+	}, s)
+}
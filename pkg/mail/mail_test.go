@@ -0,0 +1,108 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMessageBytes(t *testing.T) {
+	msg := Message{
+		From:    "noreply@rival.com",
+		To:      "asha@example.com",
+		Subject: "आपका OTP कोड - RIVAL",
+		Text:    "Your code is 482913",
+		HTML:    "<p>Your code is <strong>482913</strong></p>",
+	}
+	data, err := msg.Bytes(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parsed, err := mail.ReadMessage(strings.NewReader(string(data)))
+	if err != nil {
+		t.Fatalf("not a valid message: %v", err)
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	if err != nil || subject != msg.Subject {
+		t.Errorf("subject = %q, %v; want %q", subject, err, msg.Subject)
+	}
+	if parsed.Header.Get("To") != msg.To || parsed.Header.Get("Message-Id") == "" {
+		t.Errorf("missing headers: %v", parsed.Header)
+	}
+
+	mediaType, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/alternative" {
+		t.Fatalf("content type = %q, %v", mediaType, err)
+	}
+	reader := multipart.NewReader(parsed.Body, params["boundary"])
+	want := []struct{ contentType, body string }{
+		{"text/plain; charset=UTF-8", msg.Text},
+		{"text/html; charset=UTF-8", msg.HTML},
+	}
+	for _, w := range want {
+		part, err := reader.NextPart()
+		if err != nil {
+			t.Fatalf("missing %s part: %v", w.contentType, err)
+		}
+		body, _ := io.ReadAll(part) // the reader decodes quoted-printable
+		if part.Header.Get("Content-Type") != w.contentType || string(body) != w.body {
+			t.Errorf("part %s = %q", part.Header.Get("Content-Type"), body)
+		}
+	}
+	if _, err := reader.NextPart(); err != io.EOF {
+		t.Errorf("expected exactly two parts")
+	}
+}
+
+func TestMessageBytesRejectsHeaderInjection(t *testing.T) {
+	msg := Message{From: "noreply@rival.com", To: "asha@example.com\r\nBcc: all@example.com"}
+	if _, err := msg.Bytes(time.Now()); !errors.Is(err, ErrInvalidAddress) {
+		t.Errorf("Bytes = %v, want ErrInvalidAddress", err)
+	}
+}
+
+func TestFileTransport(t *testing.T) {
+	dir := t.TempDir()
+	transport := NewFileTransport(filepath.Join(dir, "mail"))
+
+	msg, err := Render(TemplateWelcome, "en", Data{"Name": "Asha"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg.From, msg.To = "noreply@rival.com", "asha@example.com"
+	if err := transport.Send(context.Background(), msg); err != nil {
+		t.Fatal(err)
+	}
+
+	files, _ := filepath.Glob(filepath.Join(dir, "mail", "*.eml"))
+	if len(files) != 1 || !strings.HasSuffix(files[0], "-asha_example.com.eml") {
+		t.Fatalf("files = %v", files)
+	}
+	data, _ := os.ReadFile(files[0])
+	if _, err := mail.ReadMessage(strings.NewReader(string(data))); err != nil {
+		t.Errorf("sink wrote an invalid message: %v", err)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	cases := map[int]time.Duration{
+		1:  30 * time.Second,
+		2:  time.Minute,
+		4:  4 * time.Minute,
+		20: time.Hour,
+	}
+	for attempts, want := range cases {
+		if got := Backoff(attempts); got != want {
+			t.Errorf("Backoff(%d) = %v, want %v", attempts, got, want)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"rival/config"
+	"rival/connection"
+	schema "rival/gen/sql"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	// emailLease is how long a claimed email is hidden from other instances
+	emailLease     = 2 * time.Minute
+	emailBatchSize = 50
+)
+
+// Outbox queues rendered emails in email_outbox for the Worker, so callers
+// never wait on SMTP.
+type Outbox struct {
+	queries *schema.Queries
+}
+
+func NewOutbox(db *pgxpool.Pool) *Outbox {
+	return &Outbox{queries: schema.New(db)}
+}
+
+// Enqueue renders the template and queues it for to. Rendering errors are
+// returned right away; delivery errors are retried by the Worker.
+func (o *Outbox) Enqueue(ctx context.Context, to, name, locale string, data Data) error {
+	if !IsSupportedLocale(locale) {
+		locale = DefaultLocale
+	}
+	msg, err := Render(name, locale, data)
+	if err != nil {
+		return err
+	}
+
+	_, err = o.queries.EnqueueEmail(ctx, schema.EnqueueEmailParams{
+		Recipient: to,
+		Template:  name,
+		Locale:    locale,
+		Subject:   msg.Subject,
+		TextBody:  msg.Text,
+		HtmlBody:  msg.HTML,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to queue email: %w", err)
+	}
+	return nil
+}
+
+// Worker sends queued emails through a Transport, retrying failures with
+// backoff until mail.max_attempts.
+type Worker struct {
+	queries     *schema.Queries
+	transport   Transport
+	from        string
+	maxAttempts int
+	interval    time.Duration
+}
+
+func NewWorker(db *pgxpool.Pool, transport Transport, cfg config.MailHogConfig) *Worker {
+	maxAttempts := cfg.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = 6
+	}
+	interval := time.Duration(cfg.IntervalSeconds) * time.Second
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+
+	return &Worker{
+		queries:     schema.New(db),
+		transport:   transport,
+		from:        FromAddress(cfg),
+		maxAttempts: maxAttempts,
+		interval:    interval,
+	}
+}
+
+func NewWorkerFromConfig() (*Worker, error) {
+	cfg := config.GetConfig()
+	transport, err := NewTransportFromConfig(cfg.MailHog)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := connection.GetPgConnection(&cfg.Database)
+	if err != nil {
+		return nil, err
+	}
+	return NewWorker(db, transport, cfg.MailHog), nil
+}
+
+// DeliverDue claims the emails that are due and sends them.
+func (w *Worker) DeliverDue(ctx context.Context) (int, error) {
+	now := time.Now().UTC()
+	emails, err := w.queries.ClaimDueEmails(ctx, schema.ClaimDueEmailsParams{
+		LeaseUntil: pgtype.Timestamp{Time: now.Add(emailLease), Valid: true},
+		Now:        pgtype.Timestamp{Time: now, Valid: true},
+		BatchSize:  emailBatchSize,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim emails: %w", err)
+	}
+
+	sent := 0
+	for _, email := range emails {
+		ok, err := w.deliver(ctx, email)
+		if err != nil {
+			return sent, err
+		}
+		if ok {
+			sent++
+		}
+	}
+	return sent, nil
+}
+
+// deliver sends one email and records the outcome. Only failures to record
+// it are returned.
+func (w *Worker) deliver(ctx context.Context, email schema.EmailOutbox) (bool, error) {
+	err := w.transport.Send(ctx, Message{
+		From:    w.from,
+		To:      email.Recipient,
+		Subject: email.Subject,
+		Text:    email.TextBody,
+		HTML:    email.HtmlBody,
+	})
+
+	switch {
+	case err == nil:
+		return true, w.queries.MarkEmailSent(ctx, email.ID)
+
+	case int(email.Attempts) >= w.maxAttempts:
+		log.Printf("Giving up on %s email %d after %d attempts: %v", email.Template, email.ID, email.Attempts, err)
+		return false, w.queries.FailEmail(ctx, schema.FailEmailParams{
+			ID:        email.ID,
+			LastError: pgtype.Text{String: err.Error(), Valid: true},
+		})
+
+	default:
+		return false, w.queries.RetryEmail(ctx, schema.RetryEmailParams{
+			ID:            email.ID,
+			NextAttemptAt: pgtype.Timestamp{Time: time.Now().UTC().Add(Backoff(int(email.Attempts))), Valid: true},
+			LastError:     pgtype.Text{String: err.Error(), Valid: true},
+		})
+	}
+}
+
+// Start runs DeliverDue every mail.interval_seconds until ctx is done. Every
+// instance can run it, claims keep an email from being sent twice.
+func (w *Worker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		if sent, err := w.DeliverDue(ctx); err != nil {
+			log.Printf("Failed to deliver emails: %v", err)
+		} else if sent > 0 {
+			log.Printf("Delivered %d emails through %s", sent, w.transport.Name())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package mail
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+	"time"
+)
+
+// Each template has <locale>/<name>.html with a "content" block, placed in
+// layout.html, and <locale>/<name>.txt, the plain text body with a "subject"
+// block. <locale>/footer.tmpl holds the footer of both.
+//
+//go:embed templates
+var templateFS embed.FS
+
+// Templates
+const (
+	TemplateOTP             = "otp"              // OTP, ExpiresInMinutes
+	TemplateWelcome         = "welcome"          // Name
+	TemplatePasswordReset   = "password_reset"   // OTP, ExpiresInMinutes
+	TemplateNewDeviceLogin  = "new_device_login" // Name, DeviceName, Platform, IPAddress, At
+	TemplateAccountLocked   = "account_locked"   // LockoutMinutes, UnlockToken
+	TemplateStaffInvitation = "staff_invitation" // MerchantName, Role, Token, ExpiresAt
+)
+
+var Templates = []string{
+	TemplateOTP,
+	TemplateWelcome,
+	TemplatePasswordReset,
+	TemplateNewDeviceLogin,
+	TemplateAccountLocked,
+	TemplateStaffInvitation,
+}
+
+const DefaultLocale = "en"
+
+// Locales every template is translated to
+var Locales = []string{"en", "hi"}
+
+func IsSupportedLocale(locale string) bool {
+	for _, l := range Locales {
+		if l == locale {
+			return true
+		}
+	}
+	return false
+}
+
+// Data fills a template, the keys each one needs are listed next to its name.
+type Data map[string]any
+
+var funcs = map[string]any{
+	"date":     func(t time.Time) string { return t.UTC().Format("02 Jan 2006") },
+	"datetime": func(t time.Time) string { return t.UTC().Format("02 Jan 2006 15:04 MST") },
+}
+
+type compiled struct {
+	html *htmltemplate.Template
+	text *texttemplate.Template
+}
+
+// Parsed once at startup so a broken template fails the build's tests, not a
+// signup.
+var compiledTemplates = mustCompile()
+
+func mustCompile() map[string]compiled {
+	templates := make(map[string]compiled)
+	for _, locale := range Locales {
+		footer := "templates/" + locale + "/footer.tmpl"
+		for _, name := range Templates {
+			base := "templates/" + locale + "/" + name
+			templates[locale+"/"+name] = compiled{
+				html: htmltemplate.Must(htmltemplate.New("layout.html").Funcs(funcs).Option("missingkey=error").
+					ParseFS(templateFS, "templates/layout.html", footer, base+".html")),
+				text: texttemplate.Must(texttemplate.New(name+".txt").Funcs(funcs).Option("missingkey=error").
+					ParseFS(templateFS, base+".txt", footer)),
+			}
+		}
+	}
+	return templates
+}
+
+// Render renders the named template in locale, falling back to English for
+// locales without translations. From and To are left to the caller.
+func Render(name, locale string, data Data) (Message, error) {
+	if !IsSupportedLocale(locale) {
+		locale = DefaultLocale
+	}
+	t, ok := compiledTemplates[locale+"/"+name]
+	if !ok {
+		return Message{}, fmt.Errorf("unknown email template: %s", name)
+	}
+
+	var subject, text, footer, html bytes.Buffer
+	if err := t.text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return Message{}, fmt.Errorf("failed to render %s subject: %w", name, err)
+	}
+	if err := t.text.Execute(&text, data); err != nil {
+		return Message{}, fmt.Errorf("failed to render %s text: %w", name, err)
+	}
+	if err := t.text.ExecuteTemplate(&footer, "footer", data); err != nil {
+		return Message{}, fmt.Errorf("failed to render %s footer: %w", name, err)
+	}
+
+	// The layout also needs the locale and subject
+	view := make(Data, len(data)+2)
+	for key, value := range data {
+		view[key] = value
+	}
+	view["Locale"] = locale
+	view["Subject"] = subject.String()
+	if err := t.html.Execute(&html, view); err != nil {
+		return Message{}, fmt.Errorf("failed to render %s html: %w", name, err)
+	}
+
+	return Message{
+		Subject: strings.TrimSpace(subject.String()),
+		Text:    strings.TrimSpace(text.String()) + "\n\n--\n" + strings.TrimSpace(footer.String()) + "\n",
+		HTML:    html.String(),
+	}, nil
+}
@@ -0,0 +1,119 @@
+package mail
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// previews fills every template with sample data. Set MAIL_PREVIEW_DIR to
+// write each template in each locale there as an .eml file to open in a mail
+// client.
+var previews = map[string]Data{
+	TemplateOTP:           {"OTP": "482913", "ExpiresInMinutes": 10},
+	TemplateWelcome:       {"Name": "Asha <Admin>"},
+	TemplatePasswordReset: {"OTP": "705112", "ExpiresInMinutes": 10},
+	TemplateNewDeviceLogin: {
+		"Name":       "Asha",
+		"DeviceName": "Pixel 8",
+		"Platform":   "android",
+		"IPAddress":  "203.0.113.7",
+		"At":         time.Date(2026, 3, 1, 18, 30, 0, 0, time.UTC),
+	},
+	TemplateAccountLocked: {"LockoutMinutes": 15, "UnlockToken": "UNLOCK-1234"},
+	TemplateStaffInvitation: {
+		"MerchantName": "Chai & Co",
+		"Role":         "manager",
+		"Token":        "INV-5678",
+		"ExpiresAt":    time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC),
+	},
+}
+
+func TestTemplatePreviews(t *testing.T) {
+	var sink *FileTransport
+	if dir := os.Getenv("MAIL_PREVIEW_DIR"); dir != "" {
+		sink = NewFileTransport(dir)
+	}
+
+	for _, name := range Templates {
+		data, ok := previews[name]
+		if !ok {
+			t.Fatalf("no preview data for template %s", name)
+		}
+
+		for _, locale := range Locales {
+			msg, err := Render(name, locale, data)
+			if err != nil {
+				t.Fatalf("%s/%s: %v", locale, name, err)
+			}
+			if msg.Subject == "" || strings.Contains(msg.Subject, "\n") {
+				t.Errorf("%s/%s: bad subject %q", locale, name, msg.Subject)
+			}
+			if !strings.Contains(msg.HTML, `<html lang="`+locale+`">`) {
+				t.Errorf("%s/%s: html is missing the layout", locale, name)
+			}
+			for _, part := range []string{msg.HTML, msg.Text} {
+				if strings.Contains(part, "<no value>") || strings.Contains(part, "{{") {
+					t.Errorf("%s/%s: unrendered placeholders in %q", locale, name, part)
+				}
+			}
+			if strings.Contains(msg.Text, "</") {
+				t.Errorf("%s/%s: text alternative contains markup", locale, name)
+			}
+
+			if sink != nil {
+				msg.From, msg.To = defaultFrom, locale+"-"+name+"@preview.rival.com"
+				if err := sink.Send(context.Background(), msg); err != nil {
+					t.Fatalf("failed to write preview: %v", err)
+				}
+			}
+		}
+	}
+}
+
+func TestRenderValues(t *testing.T) {
+	msg, err := Render(TemplateOTP, "en", previews[TemplateOTP])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(msg.HTML, "482913") || !strings.Contains(msg.Text, "482913") {
+		t.Errorf("OTP missing from the email")
+	}
+
+	// Names are escaped in HTML but kept as typed in the text part
+	msg, err = Render(TemplateWelcome, "en", previews[TemplateWelcome])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(msg.HTML, "<Admin>") || !strings.Contains(msg.HTML, "&lt;Admin&gt;") {
+		t.Errorf("name not escaped in html")
+	}
+	if !strings.Contains(msg.Text, "Asha <Admin>") {
+		t.Errorf("name missing from text")
+	}
+
+	hi, err := Render(TemplateOTP, "hi", previews[TemplateOTP])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hi.Subject == msg.Subject || !strings.Contains(hi.Text, "सत्यापन") {
+		t.Errorf("hi locale not used: %q", hi.Subject)
+	}
+
+	fallback, err := Render(TemplateOTP, "fr", previews[TemplateOTP])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fallback.Subject != "Your OTP Code - RIVAL" {
+		t.Errorf("unknown locale should fall back to English, got %q", fallback.Subject)
+	}
+
+	if _, err := Render(TemplateOTP, "en", Data{"OTP": "1"}); err == nil {
+		t.Errorf("missing data should fail rendering")
+	}
+	if _, err := Render("newsletter", "en", Data{}); err == nil {
+		t.Errorf("unknown template should fail rendering")
+	}
+}
@@ -0,0 +1,351 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"rival/config"
+	"rival/connection"
+	schemapb "rival/gen/proto/proto/schema"
+	schema "rival/gen/sql"
+	"rival/pkg/pubsub"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Notification types
+const (
+	TypeOrder      = "order"
+	TypePayment    = "payment"
+	TypeWallet     = "wallet"
+	TypeOffer      = "offer"
+	TypeReferral   = "referral"
+	TypeOnboarding = "onboarding"
+	TypeKYC        = "kyc"
+	TypeOrderSLA   = "order_sla"
+	TypeSecurity   = "security"
+	TypeSystem     = "system"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+	// maxReplay bounds how many unread notifications a stream replays on connect.
+	maxReplay = 100
+)
+
+var (
+	ErrNoRecipient     = errors.New("notification needs exactly one of user or merchant")
+	ErrNoToken         = errors.New("device token is required")
+	ErrUnknownPlatform = errors.New("unknown device platform")
+	ErrUnknownCategory = errors.New("unknown notification category")
+)
+
+// Recipient is the inbox a notification goes to: a user or a merchant.
+type Recipient struct {
+	UserID     int64
+	MerchantID int64
+}
+
+func User(userID int64) Recipient {
+	return Recipient{UserID: userID}
+}
+
+func Merchant(merchantID int64) Recipient {
+	return Recipient{MerchantID: merchantID}
+}
+
+func (r Recipient) valid() bool {
+	return (r.UserID > 0) != (r.MerchantID > 0)
+}
+
+// Topic is the pubsub topic live notifications for r are published on.
+func (r Recipient) Topic() string {
+	if r.UserID > 0 {
+		return "user_notifications:" + strconv.FormatInt(r.UserID, 10)
+	}
+	return "merchant_notifications:" + strconv.FormatInt(r.MerchantID, 10)
+}
+
+func (r Recipient) userID() pgtype.Int8 {
+	return pgtype.Int8{Int64: r.UserID, Valid: r.UserID > 0}
+}
+
+func (r Recipient) merchantID() pgtype.Int8 {
+	return pgtype.Int8{Int64: r.MerchantID, Valid: r.MerchantID > 0}
+}
+
+// Notification is one message for a user's or merchant's inbox.
+type Notification struct {
+	To       Recipient
+	Type     string
+	Title    string
+	Body     string
+	DeepLink string
+}
+
+// Deep links opened by the apps
+func OrderLink(orderID int64) string {
+	return fmt.Sprintf("rival://orders/%d", orderID)
+}
+
+func TransactionLink(transactionID int64) string {
+	return fmt.Sprintf("rival://transactions/%d", transactionID)
+}
+
+func MerchantLink(merchantID int64) string {
+	return fmt.Sprintf("rival://merchants/%d", merchantID)
+}
+
+const (
+	ReferralsLink  = "rival://referrals"
+	DocumentsLink  = "rival://merchant/documents"
+	OnboardingLink = "rival://merchant/onboarding"
+)
+
+// Service stores notifications in the notifications table and delivers them
+// live over pubsub. Streams replay what is still unread on connect, so
+// nothing sent while the app was closed is lost. User notifications are also
+// queued for the PushWorker when push is enabled. Each channel is subject to
+// the user's preferences and quiet hours, see Check.
+type Service struct {
+	queries *schema.Queries
+	ps      *pubsub.PubSub
+	push    bool
+}
+
+func NewService(db *pgxpool.Pool) *Service {
+	return &Service{
+		queries: schema.New(db),
+		ps:      pubsub.Get(),
+		push:    config.GetConfig().Push.Provider != "",
+	}
+}
+
+// NewServiceFromConfig uses the shared postgres pool.
+func NewServiceFromConfig() (*Service, error) {
+	cfg := config.GetConfig()
+	db, err := connection.GetPgConnection(&cfg.Database)
+	if err != nil {
+		return nil, err
+	}
+	return NewService(db), nil
+}
+
+// Notify stores n and pushes it to any open stream of the recipient. Like
+// the other side effects of a state change it never fails the caller, so
+// errors are only logged.
+func (s *Service) Notify(ctx context.Context, n Notification) {
+	if err := s.send(ctx, n); err != nil {
+		log.Printf("failed to notify %s: %v", n.To.Topic(), err)
+	}
+}
+
+func (s *Service) send(ctx context.Context, n Notification) error {
+	if !n.To.valid() {
+		return ErrNoRecipient
+	}
+	if n.Type == "" {
+		n.Type = TypeSystem
+	}
+
+	// Merchant inboxes have no preferences and never get pushes
+	inbox := Delivery{Send: true}
+	pushed := Delivery{Send: s.push && n.To.UserID > 0}
+	if n.To.UserID > 0 {
+		p, err := s.loadPolicy(ctx, n.To.UserID)
+		if err != nil {
+			return err
+		}
+		category, now := CategoryOf(n.Type), time.Now()
+		inbox = p.decide(ChannelInApp, category, now)
+		if pushed.Send {
+			pushed = p.decide(ChannelPush, category, now)
+		}
+		if !inbox.Send && !pushed.Send {
+			return nil
+		}
+	}
+
+	// A push still needs the row, it is kept out of the inbox by storing it read
+	row, err := s.queries.CreateNotification(ctx, schema.CreateNotificationParams{
+		UserID:     n.To.userID(),
+		MerchantID: n.To.merchantID(),
+		Type:       n.Type,
+		Title:      n.Title,
+		Body:       n.Body,
+		DeepLink:   pgtype.Text{String: n.DeepLink, Valid: n.DeepLink != ""},
+		ReadAt:     pgtype.Timestamp{Time: time.Now().UTC(), Valid: !inbox.Send},
+	})
+	if err != nil {
+		return err
+	}
+
+	if inbox.Send {
+		s.ps.Publish(n.To.Topic(), ToProto(row))
+	}
+
+	if pushed.Send {
+		if err := s.enqueuePush(ctx, n.To.UserID, row.ID, pushed.At); err != nil {
+			return fmt.Errorf("failed to queue push: %w", err)
+		}
+	}
+	return nil
+}
+
+// List returns a page of notifications newest first together with the
+// total matching and the number still unread.
+func (s *Service) List(ctx context.Context, to Recipient, page, limit int32, unreadOnly bool) ([]*schemapb.Notification, int32, int32, error) {
+	if !to.valid() {
+		return nil, 0, 0, ErrNoRecipient
+	}
+	if page < 1 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	rows, err := s.queries.ListNotifications(ctx, schema.ListNotificationsParams{
+		UserID:      to.userID(),
+		MerchantID:  to.merchantID(),
+		UnreadOnly:  unreadOnly,
+		LimitCount:  limit,
+		OffsetCount: (page - 1) * limit,
+	})
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("failed to list notifications: %w", err)
+	}
+
+	total, err := s.queries.CountNotifications(ctx, schema.CountNotificationsParams{
+		UserID:     to.userID(),
+		MerchantID: to.merchantID(),
+		UnreadOnly: unreadOnly,
+	})
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("failed to count notifications: %w", err)
+	}
+
+	unread, err := s.UnreadCount(ctx, to)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	notifications := make([]*schemapb.Notification, len(rows))
+	for i, row := range rows {
+		notifications[i] = ToProto(row)
+	}
+	return notifications, int32(total), unread, nil
+}
+
+// MarkRead marks the given notifications, or all of them, as read and
+// returns how many were unread. Ids of other recipients are ignored.
+func (s *Service) MarkRead(ctx context.Context, to Recipient, ids []int64, all bool) (int32, error) {
+	if !to.valid() {
+		return 0, ErrNoRecipient
+	}
+	if !all && len(ids) == 0 {
+		return 0, nil
+	}
+	if all {
+		ids = nil
+	}
+
+	updated, err := s.queries.MarkNotificationsRead(ctx, schema.MarkNotificationsReadParams{
+		UserID:     to.userID(),
+		MerchantID: to.merchantID(),
+		Ids:        ids,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to mark notifications read: %w", err)
+	}
+	return int32(updated), nil
+}
+
+func (s *Service) UnreadCount(ctx context.Context, to Recipient) (int32, error) {
+	if !to.valid() {
+		return 0, ErrNoRecipient
+	}
+
+	count, err := s.queries.CountNotifications(ctx, schema.CountNotificationsParams{
+		UserID:     to.userID(),
+		MerchantID: to.merchantID(),
+		UnreadOnly: true,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count unread notifications: %w", err)
+	}
+	return int32(count), nil
+}
+
+// Stream replays the recipient's unread notifications oldest first and then
+// forwards new ones until ctx is done or send fails. It subscribes before
+// replaying so nothing published in between is missed; a notification
+// delivered by both is sent once.
+func (s *Service) Stream(ctx context.Context, to Recipient, send func(n *schemapb.Notification, replayed bool) error) error {
+	if !to.valid() {
+		return ErrNoRecipient
+	}
+
+	ch := s.ps.Subscribe(to.Topic())
+	defer ch.Close()
+
+	unread, err := s.queries.ListUnreadNotifications(ctx, schema.ListUnreadNotificationsParams{
+		UserID:     to.userID(),
+		MerchantID: to.merchantID(),
+		LimitCount: maxReplay,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list unread notifications: %w", err)
+	}
+
+	replayed := make(map[int64]bool, len(unread))
+	for _, row := range unread {
+		if err := send(ToProto(row), true); err != nil {
+			return err
+		}
+		replayed[row.ID] = true
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case data, ok := <-ch.Receive():
+			if !ok {
+				return nil
+			}
+			n, isNotification := data.(*schemapb.Notification)
+			if !isNotification || replayed[n.Id] {
+				continue
+			}
+			if err := send(n, false); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func ToProto(n schema.Notification) *schemapb.Notification {
+	notification := &schemapb.Notification{
+		Id:        n.ID,
+		Type:      n.Type,
+		Title:     n.Title,
+		Body:      n.Body,
+		DeepLink:  n.DeepLink.String,
+		Read:      n.ReadAt.Valid,
+		CreatedAt: n.CreatedAt.Time.Unix(),
+	}
+	if n.ReadAt.Valid {
+		notification.ReadAt = n.ReadAt.Time.Unix()
+	}
+	return notification
+}
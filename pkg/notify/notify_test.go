@@ -0,0 +1,165 @@
+package notify
+
+import (
+	"testing"
+	"time"
+
+	schema "rival/gen/sql"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestRecipient(t *testing.T) {
+	cases := []struct {
+		to    Recipient
+		valid bool
+		topic string
+	}{
+		{User(7), true, "user_notifications:7"},
+		{Merchant(3), true, "merchant_notifications:3"},
+		{Recipient{}, false, ""},
+		{Recipient{UserID: 7, MerchantID: 3}, false, ""},
+	}
+
+	for _, c := range cases {
+		if got := c.to.valid(); got != c.valid {
+			t.Errorf("%+v valid = %v, want %v", c.to, got, c.valid)
+		}
+		if c.valid && c.to.Topic() != c.topic {
+			t.Errorf("%+v topic = %q, want %q", c.to, c.to.Topic(), c.topic)
+		}
+	}
+}
+
+func TestToProto(t *testing.T) {
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	row := schema.Notification{
+		ID:        42,
+		UserID:    pgtype.Int8{Int64: 7, Valid: true},
+		Type:      TypeOrder,
+		Title:     "Your order is ready",
+		Body:      "Order ABC12",
+		DeepLink:  pgtype.Text{String: OrderLink(9), Valid: true},
+		CreatedAt: pgtype.Timestamp{Time: created, Valid: true},
+	}
+
+	n := ToProto(row)
+	if n.Id != 42 || n.DeepLink != "rival://orders/9" || n.Read || n.ReadAt != 0 || n.CreatedAt != created.Unix() {
+		t.Fatalf("ToProto(unread) = %+v", n)
+	}
+
+	row.ReadAt = pgtype.Timestamp{Time: created.Add(time.Hour), Valid: true}
+	n = ToProto(row)
+	if !n.Read || n.ReadAt != created.Add(time.Hour).Unix() {
+		t.Fatalf("ToProto(read) = %+v", n)
+	}
+}
+
+func TestCategoryOf(t *testing.T) {
+	cases := map[string]string{
+		TypeOrder:      CategoryOrders,
+		TypeOrderSLA:   CategoryOrders,
+		TypePayment:    CategoryWallet,
+		TypeWallet:     CategoryWallet,
+		TypeOffer:      CategoryOffers,
+		TypeReferral:   CategoryReferrals,
+		TypeSecurity:   CategorySecurity,
+		TypeOnboarding: CategoryAccount,
+		TypeKYC:        CategoryAccount,
+		TypeSystem:     CategoryAccount,
+	}
+
+	for notificationType, want := range cases {
+		if got := CategoryOf(notificationType); got != want {
+			t.Errorf("CategoryOf(%s) = %s, want %s", notificationType, got, want)
+		}
+	}
+}
+
+func TestMergePreferences(t *testing.T) {
+	preferences := mergePreferences([]schema.NotificationPreference{
+		{Category: CategoryOffers, Push: false, Email: false, InApp: true},
+		{Category: CategoryOrders, Push: true, Email: true, InApp: true},
+		{Category: CategorySecurity, Push: false, Email: false, InApp: false},
+	})
+
+	if len(preferences) != len(Categories) {
+		t.Fatalf("mergePreferences returned %d categories, want %d", len(preferences), len(Categories))
+	}
+	for _, preference := range preferences {
+		want := preference.Category != CategoryOffers
+		if preference.Push != want || preference.Email != want {
+			t.Errorf("%s push/email = %v/%v, want %v", preference.Category, preference.Push, preference.Email, want)
+		}
+		if !preference.InApp {
+			t.Errorf("%s in-app should be on", preference.Category)
+		}
+	}
+}
+
+func TestQuietUntil(t *testing.T) {
+	overnight := Settings{Timezone: "Asia/Kolkata", Quiet: &QuietHours{Start: 22 * 60, End: 7 * 60}}
+	daytime := Settings{Timezone: "Asia/Kolkata", Quiet: &QuietHours{Start: 13 * 60, End: 14 * 60}}
+	kolkata, _ := time.LoadLocation("Asia/Kolkata")
+
+	cases := []struct {
+		name     string
+		settings Settings
+		now      time.Time
+		quiet    bool
+		until    time.Time
+	}{
+		{"off", Settings{Timezone: "Asia/Kolkata"}, time.Date(2026, 3, 1, 23, 0, 0, 0, kolkata), false, time.Time{}},
+		{"before midnight", overnight, time.Date(2026, 3, 1, 23, 30, 0, 0, kolkata), true, time.Date(2026, 3, 2, 7, 0, 0, 0, kolkata)},
+		{"after midnight", overnight, time.Date(2026, 3, 2, 1, 0, 0, 0, kolkata), true, time.Date(2026, 3, 2, 7, 0, 0, 0, kolkata)},
+		{"end is exclusive", overnight, time.Date(2026, 3, 2, 7, 0, 0, 0, kolkata), false, time.Time{}},
+		{"evening", overnight, time.Date(2026, 3, 2, 21, 59, 0, 0, kolkata), false, time.Time{}},
+		{"same day window", daytime, time.Date(2026, 3, 2, 13, 15, 0, 0, kolkata), true, time.Date(2026, 3, 2, 14, 0, 0, 0, kolkata)},
+		{"user's timezone", overnight, time.Date(2026, 3, 1, 17, 0, 0, 0, time.UTC), true, time.Date(2026, 3, 2, 7, 0, 0, 0, kolkata)},
+	}
+
+	for _, c := range cases {
+		until, quiet := c.settings.quietUntil(c.now)
+		if quiet != c.quiet || !until.Equal(c.until) {
+			t.Errorf("%s: quietUntil = %v, %v; want %v, %v", c.name, until, quiet, c.until, c.quiet)
+		}
+	}
+}
+
+func TestDecide(t *testing.T) {
+	kolkata, _ := time.LoadLocation("Asia/Kolkata")
+	night := time.Date(2026, 3, 1, 23, 30, 0, 0, kolkata)
+	morning := time.Date(2026, 3, 2, 7, 0, 0, 0, kolkata)
+
+	p := policy{
+		preferences: []Preference{
+			{Category: CategoryOrders, Push: true, Email: true, InApp: true},
+			{Category: CategoryOffers, Push: false, Email: false, InApp: true},
+			{Category: CategorySecurity, Push: false, Email: false, InApp: false},
+		},
+		settings: Settings{Timezone: "Asia/Kolkata", Quiet: &QuietHours{Start: 22 * 60, End: 7 * 60}},
+	}
+
+	cases := []struct {
+		name     string
+		channel  string
+		category string
+		want     Delivery
+	}{
+		{"push held until quiet hours end", ChannelPush, CategoryOrders, Delivery{Send: true, At: morning}},
+		{"inbox ignores quiet hours", ChannelInApp, CategoryOrders, Delivery{Send: true}},
+		{"email ignores quiet hours", ChannelEmail, CategoryOrders, Delivery{Send: true}},
+		{"push turned off", ChannelPush, CategoryOffers, Delivery{}},
+		{"email turned off", ChannelEmail, CategoryOffers, Delivery{}},
+		{"security push always now", ChannelPush, CategorySecurity, Delivery{Send: true}},
+		{"security email always", ChannelEmail, CategorySecurity, Delivery{Send: true}},
+		{"account not configurable", ChannelInApp, CategoryAccount, Delivery{Send: true}},
+	}
+
+	for _, c := range cases {
+		got := p.decide(c.channel, c.category, night)
+		if got.Send != c.want.Send || !got.At.Equal(c.want.At) {
+			t.Errorf("%s: decide = %+v, want %+v", c.name, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,373 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+	_ "time/tzdata" // users pick IANA zones, don't depend on the host's zoneinfo
+
+	schema "rival/gen/sql"
+	"rival/pkg/mail"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Categories users can turn channels on and off for
+const (
+	CategoryOrders    = "orders"
+	CategoryWallet    = "wallet"
+	CategoryOffers    = "offers"
+	CategoryReferrals = "referrals"
+	CategorySecurity  = "security"
+	CategoryAccount   = "account" // merchant onboarding, KYC and system notices
+)
+
+// Channels a notification can be delivered on
+const (
+	ChannelInApp = "in_app"
+	ChannelPush  = "push"
+	ChannelEmail = "email"
+)
+
+const defaultTimezone = "Asia/Kolkata"
+
+var (
+	ErrSecurityRequired  = errors.New("security notifications can't be turned off")
+	ErrUnknownTimezone   = errors.New("unknown timezone")
+	ErrInvalidQuietHours = errors.New("quiet hours must start and end at different times of day")
+	ErrUnknownLocale     = errors.New("unsupported language")
+)
+
+// Categories lists the categories shown to users, in display order.
+var Categories = []string{CategoryOrders, CategoryWallet, CategoryOffers, CategoryReferrals, CategorySecurity}
+
+// CategoryOf maps a notification type to the category its preferences live under.
+func CategoryOf(notificationType string) string {
+	switch notificationType {
+	case TypeOrder, TypeOrderSLA:
+		return CategoryOrders
+	case TypePayment, TypeWallet:
+		return CategoryWallet
+	case TypeOffer:
+		return CategoryOffers
+	case TypeReferral:
+		return CategoryReferrals
+	case TypeSecurity:
+		return CategorySecurity
+	}
+	return CategoryAccount
+}
+
+func IsValidCategory(category string) bool {
+	for _, c := range Categories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
+// AlwaysDelivered reports whether messages of the category ignore channel
+// preferences and quiet hours. OTPs, new-device logins and lockouts must
+// reach the user no matter what.
+func AlwaysDelivered(category string) bool {
+	return category == CategorySecurity
+}
+
+// Preference is which channels a user wants for one category.
+type Preference struct {
+	Category string
+	Push     bool
+	Email    bool
+	InApp    bool
+}
+
+func (p Preference) allows(channel string) bool {
+	switch channel {
+	case ChannelPush:
+		return p.Push
+	case ChannelEmail:
+		return p.Email
+	case ChannelInApp:
+		return p.InApp
+	}
+	return false
+}
+
+// QuietHours is a daily window, in minutes since the user's local midnight,
+// during which pushes are held back. End before Start runs past midnight.
+type QuietHours struct {
+	Start int
+	End   int
+}
+
+// Settings apply to every category.
+type Settings struct {
+	Timezone string
+	Quiet    *QuietHours
+	Locale   string // language of emails, one of mail.Locales
+}
+
+// quietUntil reports whether now falls in the quiet hours and, if so, when
+// they end.
+func (s Settings) quietUntil(now time.Time) (time.Time, bool) {
+	if s.Quiet == nil {
+		return time.Time{}, false
+	}
+	location, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		location = time.UTC
+	}
+
+	local := now.In(location)
+	minute := local.Hour()*60 + local.Minute()
+	start, end := s.Quiet.Start, s.Quiet.End
+
+	var quiet bool
+	if start < end {
+		quiet = minute >= start && minute < end
+	} else {
+		quiet = minute >= start || minute < end
+	}
+	if !quiet {
+		return time.Time{}, false
+	}
+
+	until := time.Date(local.Year(), local.Month(), local.Day(), end/60, end%60, 0, 0, location)
+	if !until.After(local) {
+		until = time.Date(local.Year(), local.Month(), local.Day()+1, end/60, end%60, 0, 0, location)
+	}
+	return until.UTC(), true
+}
+
+// Delivery is whether a message goes out on a channel and when.
+type Delivery struct {
+	Send bool
+	At   time.Time // zero means right away
+}
+
+// policy is everything stored about how one user wants to be notified.
+type policy struct {
+	preferences []Preference
+	settings    Settings
+}
+
+// decide is the one place channel preferences and quiet hours are applied.
+// Quiet hours only hold back pushes, the inbox and email don't interrupt.
+func (p policy) decide(channel, category string, now time.Time) Delivery {
+	if AlwaysDelivered(category) {
+		return Delivery{Send: true}
+	}
+	for _, preference := range p.preferences {
+		if preference.Category == category && !preference.allows(channel) {
+			return Delivery{}
+		}
+	}
+	if channel == ChannelPush {
+		if until, quiet := p.settings.quietUntil(now); quiet {
+			return Delivery{Send: true, At: until}
+		}
+	}
+	return Delivery{Send: true}
+}
+
+func (s *Service) loadPolicy(ctx context.Context, userID int64) (policy, error) {
+	preferences, err := s.Preferences(ctx, userID)
+	if err != nil {
+		return policy{}, err
+	}
+	settings, err := s.Settings(ctx, userID)
+	if err != nil {
+		return policy{}, err
+	}
+	return policy{preferences: preferences, settings: settings}, nil
+}
+
+// Check decides whether a message of the category may be sent to the user on
+// channel right now. Every sender goes through it.
+func (s *Service) Check(ctx context.Context, userID int64, channel, category string) (Delivery, error) {
+	if AlwaysDelivered(category) {
+		return Delivery{Send: true}, nil
+	}
+	p, err := s.loadPolicy(ctx, userID)
+	if err != nil {
+		return Delivery{}, err
+	}
+	return p.decide(channel, category, time.Now()), nil
+}
+
+// AllowEmail reports whether the owner of address wants emails of the
+// category. Addresses without an account, such as staff invitations, and
+// categories users can't configure always get them. Lookup failures are
+// logged and the email is sent.
+func (s *Service) AllowEmail(ctx context.Context, address, category string) bool {
+	if AlwaysDelivered(category) || !IsValidCategory(category) {
+		return true
+	}
+
+	user, err := s.queries.GetUserByEmail(ctx, address)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return true
+	}
+	if err != nil {
+		log.Printf("failed to look up email preferences: %v", err)
+		return true
+	}
+
+	delivery, err := s.Check(ctx, user.ID, ChannelEmail, category)
+	if err != nil {
+		log.Printf("failed to check email preferences for user %d: %v", user.ID, err)
+		return true
+	}
+	return delivery.Send
+}
+
+// Preferences returns the user's setting for every category, filling in the
+// default (enabled) for categories they never changed.
+func (s *Service) Preferences(ctx context.Context, userID int64) ([]Preference, error) {
+	rows, err := s.queries.ListNotificationPreferences(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notification preferences: %w", err)
+	}
+	return mergePreferences(rows), nil
+}
+
+func mergePreferences(rows []schema.NotificationPreference) []Preference {
+	stored := make(map[string]schema.NotificationPreference, len(rows))
+	for _, row := range rows {
+		stored[row.Category] = row
+	}
+
+	preferences := make([]Preference, len(Categories))
+	for i, category := range Categories {
+		row, ok := stored[category]
+		if !ok || AlwaysDelivered(category) {
+			preferences[i] = Preference{Category: category, Push: true, Email: true, InApp: true}
+			continue
+		}
+		preferences[i] = Preference{Category: category, Push: row.Push, Email: row.Email, InApp: row.InApp}
+	}
+	return preferences
+}
+
+// SetPreferences replaces the channels of the given categories and leaves the
+// others alone.
+func (s *Service) SetPreferences(ctx context.Context, userID int64, preferences []Preference) error {
+	for _, preference := range preferences {
+		if !IsValidCategory(preference.Category) {
+			return fmt.Errorf("%w: %q", ErrUnknownCategory, preference.Category)
+		}
+		if AlwaysDelivered(preference.Category) && !(preference.Push && preference.Email && preference.InApp) {
+			return ErrSecurityRequired
+		}
+	}
+
+	for _, preference := range preferences {
+		err := s.queries.UpsertNotificationPreference(ctx, schema.UpsertNotificationPreferenceParams{
+			UserID:   userID,
+			Category: preference.Category,
+			Push:     preference.Push,
+			Email:    preference.Email,
+			InApp:    preference.InApp,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to save notification preference: %w", err)
+		}
+	}
+	return nil
+}
+
+// Settings returns the user's timezone and quiet hours.
+func (s *Service) Settings(ctx context.Context, userID int64) (Settings, error) {
+	row, err := s.queries.GetNotificationSettings(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return Settings{Timezone: defaultTimezone, Locale: mail.DefaultLocale}, nil
+	}
+	if err != nil {
+		return Settings{}, fmt.Errorf("failed to get notification settings: %w", err)
+	}
+	return settingsFromRow(row), nil
+}
+
+func settingsFromRow(row schema.NotificationSetting) Settings {
+	settings := Settings{Timezone: row.Timezone, Locale: row.Locale}
+	if row.QuietStart.Valid && row.QuietEnd.Valid {
+		settings.Quiet = &QuietHours{Start: timeToMinutes(row.QuietStart), End: timeToMinutes(row.QuietEnd)}
+	}
+	return settings
+}
+
+// SetSettings stores the user's timezone and quiet hours, the locale is set
+// with SetLocale. An empty timezone
+// keeps the default and nil quiet hours turns them off.
+func (s *Service) SetSettings(ctx context.Context, userID int64, settings Settings) (Settings, error) {
+	if settings.Timezone == "" {
+		settings.Timezone = defaultTimezone
+	}
+	if _, err := time.LoadLocation(settings.Timezone); err != nil {
+		return Settings{}, fmt.Errorf("%w: %q", ErrUnknownTimezone, settings.Timezone)
+	}
+
+	params := schema.UpsertNotificationSettingsParams{
+		UserID:   userID,
+		Timezone: settings.Timezone,
+	}
+	if quiet := settings.Quiet; quiet != nil {
+		if !validMinute(quiet.Start) || !validMinute(quiet.End) || quiet.Start == quiet.End {
+			return Settings{}, ErrInvalidQuietHours
+		}
+		params.QuietStart = minutesToTime(quiet.Start)
+		params.QuietEnd = minutesToTime(quiet.End)
+	}
+
+	row, err := s.queries.UpsertNotificationSettings(ctx, params)
+	if err != nil {
+		return Settings{}, fmt.Errorf("failed to save notification settings: %w", err)
+	}
+	return settingsFromRow(row), nil
+}
+
+// SetLocale stores the language the user's emails are written in.
+func (s *Service) SetLocale(ctx context.Context, userID int64, locale string) error {
+	if !mail.IsSupportedLocale(locale) {
+		return fmt.Errorf("%w: %q", ErrUnknownLocale, locale)
+	}
+	err := s.queries.SetNotificationLocale(ctx, schema.SetNotificationLocaleParams{
+		UserID: userID,
+		Locale: locale,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save language: %w", err)
+	}
+	return nil
+}
+
+// EmailLocale is the language to write to address in, English for addresses
+// without an account.
+func (s *Service) EmailLocale(ctx context.Context, address string) string {
+	user, err := s.queries.GetUserByEmail(ctx, address)
+	if err != nil {
+		return mail.DefaultLocale
+	}
+	settings, err := s.Settings(ctx, user.ID)
+	if err != nil {
+		log.Printf("failed to look up language for user %d: %v", user.ID, err)
+		return mail.DefaultLocale
+	}
+	return settings.Locale
+}
+
+func validMinute(minute int) bool {
+	return minute >= 0 && minute < 24*60
+}
+
+func timeToMinutes(t pgtype.Time) int {
+	return int(t.Microseconds / int64(time.Minute/time.Microsecond))
+}
+
+func minutesToTime(minutes int) pgtype.Time {
+	return pgtype.Time{Microseconds: int64(minutes) * int64(time.Minute/time.Microsecond), Valid: true}
+}
@@ -0,0 +1,234 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"rival/config"
+	"rival/connection"
+	schema "rival/gen/sql"
+	"rival/pkg/geo"
+	"rival/pkg/push"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	// pushLease is how long a claimed push is hidden from other instances
+	pushLease     = 2 * time.Minute
+	pushBatchSize = 100
+)
+
+// RegisterDevice stores a push token for the user. A known token is moved to
+// the user and its last location updated when one is given.
+func (s *Service) RegisterDevice(ctx context.Context, userID int64, token, platform string, location *geo.Coordinates) error {
+	if token == "" {
+		return ErrNoToken
+	}
+	if !push.IsValidPlatform(platform) {
+		return fmt.Errorf("%w: %q", ErrUnknownPlatform, platform)
+	}
+
+	params := schema.UpsertDeviceTokenParams{
+		UserID:   userID,
+		Token:    token,
+		Platform: platform,
+	}
+	if location != nil {
+		params.Latitude = pgtype.Float8{Float64: location.Latitude, Valid: true}
+		params.Longitude = pgtype.Float8{Float64: location.Longitude, Valid: true}
+	}
+
+	if _, err := s.queries.UpsertDeviceToken(ctx, params); err != nil {
+		return fmt.Errorf("failed to register device: %w", err)
+	}
+	return nil
+}
+
+// UnregisterDevice forgets the user's token, e.g. on sign out.
+func (s *Service) UnregisterDevice(ctx context.Context, userID int64, token string) (bool, error) {
+	removed, err := s.queries.DeleteDeviceToken(ctx, schema.DeleteDeviceTokenParams{
+		UserID: userID,
+		Token:  token,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to unregister device: %w", err)
+	}
+	return removed > 0, nil
+}
+
+// NotifyNearby sends n to every user with a device last seen within radiusKm
+// of any of the points and returns how many users that was.
+func (s *Service) NotifyNearby(ctx context.Context, points []geo.Coordinates, radiusKm float64, n Notification) int {
+	users := make(map[int64]bool)
+	for _, point := range points {
+		min, max := geo.BoundingBox(point, radiusKm)
+		rows, err := s.queries.ListDeviceUsersInArea(ctx, schema.ListDeviceUsersInAreaParams{
+			MinLat: min.Latitude,
+			MaxLat: max.Latitude,
+			MinLng: min.Longitude,
+			MaxLng: max.Longitude,
+		})
+		if err != nil {
+			log.Printf("failed to find devices near %v: %v", point, err)
+			continue
+		}
+		for _, row := range rows {
+			if geo.DistanceKm(point, geo.Coordinates{Latitude: row.Latitude, Longitude: row.Longitude}) <= radiusKm {
+				users[row.UserID] = true
+			}
+		}
+	}
+
+	for userID := range users {
+		n.To = User(userID)
+		s.Notify(ctx, n)
+	}
+	return len(users)
+}
+
+// enqueuePush queues a push to each of the user's devices, held until sendAt
+// when that is set.
+func (s *Service) enqueuePush(ctx context.Context, userID, notificationID int64, sendAt time.Time) error {
+	if sendAt.IsZero() {
+		sendAt = time.Now().UTC()
+	}
+	_, err := s.queries.EnqueuePushDeliveries(ctx, schema.EnqueuePushDeliveriesParams{
+		NotificationID: notificationID,
+		SendAt:         pgtype.Timestamp{Time: sendAt, Valid: true},
+		UserID:         userID,
+	})
+	return err
+}
+
+// PushWorker sends the queued pushes through a PushProvider. Failed pushes
+// are retried with backoff; tokens the provider rejects are dropped.
+type PushWorker struct {
+	queries     *schema.Queries
+	provider    push.PushProvider
+	maxAttempts int
+	interval    time.Duration
+}
+
+func NewPushWorker(db *pgxpool.Pool, provider push.PushProvider, cfg config.PushConfig) *PushWorker {
+	maxAttempts := cfg.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = 5
+	}
+	interval := time.Duration(cfg.IntervalSeconds) * time.Second
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+
+	return &PushWorker{
+		queries:     schema.New(db),
+		provider:    provider,
+		maxAttempts: maxAttempts,
+		interval:    interval,
+	}
+}
+
+// NewPushWorkerFromConfig returns nil when push is disabled.
+func NewPushWorkerFromConfig() (*PushWorker, error) {
+	cfg := config.GetConfig()
+	provider, err := push.NewPushProviderFromConfig(cfg.Push)
+	if err != nil || provider == nil {
+		return nil, err
+	}
+
+	db, err := connection.GetPgConnection(&cfg.Database)
+	if err != nil {
+		return nil, err
+	}
+	return NewPushWorker(db, provider, cfg.Push), nil
+}
+
+// DeliverDue claims the pushes that are due and sends them.
+func (w *PushWorker) DeliverDue(ctx context.Context) (int, error) {
+	now := time.Now().UTC()
+	deliveries, err := w.queries.ClaimDuePushDeliveries(ctx, schema.ClaimDuePushDeliveriesParams{
+		LeaseUntil: pgtype.Timestamp{Time: now.Add(pushLease), Valid: true},
+		Now:        pgtype.Timestamp{Time: now, Valid: true},
+		BatchSize:  pushBatchSize,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim pushes: %w", err)
+	}
+
+	sent := 0
+	for _, delivery := range deliveries {
+		ok, err := w.deliver(ctx, delivery)
+		if err != nil {
+			return sent, err
+		}
+		if ok {
+			sent++
+		}
+	}
+	return sent, nil
+}
+
+// deliver sends one push and records the outcome. Only failures to record
+// it are returned.
+func (w *PushWorker) deliver(ctx context.Context, delivery schema.ClaimDuePushDeliveriesRow) (bool, error) {
+	err := w.provider.Send(ctx, push.Message{
+		Token:    delivery.Token,
+		Platform: delivery.Platform,
+		Title:    delivery.Title,
+		Body:     delivery.Body,
+		Data: map[string]string{
+			"notification_id": strconv.FormatInt(delivery.NotificationID, 10),
+			"type":            delivery.Type,
+			"deep_link":       delivery.DeepLink.String,
+		},
+	})
+
+	switch {
+	case err == nil:
+		return true, w.queries.MarkPushSent(ctx, delivery.ID)
+
+	case errors.Is(err, push.ErrInvalidToken):
+		// Removing the token also removes its queued pushes
+		return false, w.queries.DeleteDeviceTokenByID(ctx, delivery.DeviceTokenID)
+
+	case int(delivery.Attempts) >= w.maxAttempts:
+		log.Printf("Giving up on push %d after %d attempts: %v", delivery.ID, delivery.Attempts, err)
+		return false, w.queries.FailPushDelivery(ctx, schema.FailPushDeliveryParams{
+			ID:        delivery.ID,
+			LastError: pgtype.Text{String: err.Error(), Valid: true},
+		})
+
+	default:
+		return false, w.queries.RetryPushDelivery(ctx, schema.RetryPushDeliveryParams{
+			ID:            delivery.ID,
+			NextAttemptAt: pgtype.Timestamp{Time: time.Now().UTC().Add(push.Backoff(int(delivery.Attempts))), Valid: true},
+			LastError:     pgtype.Text{String: err.Error(), Valid: true},
+		})
+	}
+}
+
+// Start runs DeliverDue every push.interval_seconds until ctx is done. Every
+// instance can run it, claims keep a push from being sent twice.
+func (w *PushWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		if sent, err := w.DeliverDue(ctx); err != nil {
+			log.Printf("Failed to deliver pushes: %v", err)
+		} else if sent > 0 {
+			log.Printf("Delivered %d push notifications", sent)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
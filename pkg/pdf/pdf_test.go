@@ -0,0 +1,58 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+func TestBytes(t *testing.T) {
+	doc := New("Receipt")
+	doc.Text(48, 60, HelveticaBold, 18, "Tax Invoice (copy)")
+	doc.TextRight(500, 80, 10, "Rs. 95.00")
+	doc.Line(48, 90, 500, 90)
+	doc.AddPage()
+	doc.Text(48, 60, Helvetica, 10, "Page two")
+	out := doc.Bytes()
+
+	if !bytes.HasPrefix(out, []byte("%PDF-1.4\n")) || !bytes.HasSuffix(out, []byte("%%EOF\n")) {
+		t.Fatalf("Missing PDF header or trailer")
+	}
+	if !bytes.Contains(out, []byte("/Count 2")) {
+		t.Errorf("Expected two pages")
+	}
+	if !bytes.Contains(out, []byte(`(Tax Invoice \(copy\))`)) {
+		t.Errorf("Expected parentheses to be escaped")
+	}
+
+	// Every xref entry must point at the start of its object
+	startxref := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(out)
+	if startxref == nil {
+		t.Fatalf("Missing startxref")
+	}
+	xref, _ := strconv.Atoi(string(startxref[1]))
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(out[xref:], -1)
+	for i, entry := range entries {
+		offset, _ := strconv.Atoi(string(entry[1]))
+		want := fmt.Sprintf("%d 0 obj\n", i+1)
+		if !bytes.HasPrefix(out[offset:], []byte(want)) {
+			t.Errorf("xref entry %d points at %q", i+1, out[offset:offset+len(want)])
+		}
+	}
+}
+
+func TestEscape(t *testing.T) {
+	cases := map[string]string{
+		`a\b`:     `a\\b`,
+		"café":    `caf\351`,
+		"₹ 10":    "? 10",
+		"two\nln": "two ln",
+	}
+	for in, want := range cases {
+		if got := escape(in); got != want {
+			t.Errorf("escape(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
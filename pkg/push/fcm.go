@@ -0,0 +1,78 @@
+package push
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"rival/config"
+
+	firebase "firebase.google.com/go/v4"
+	"firebase.google.com/go/v4/messaging"
+	"google.golang.org/api/option"
+)
+
+// fcmProvider sends through Firebase Cloud Messaging, which also relays to
+// APNs for iOS devices. The client needs the Firebase credentials file so it
+// is only created on first use.
+type fcmProvider struct {
+	mu     sync.Mutex
+	client *messaging.Client
+}
+
+func NewFCMProvider() PushProvider {
+	return &fcmProvider{}
+}
+
+func (p *fcmProvider) Name() string {
+	return "fcm"
+}
+
+func (p *fcmProvider) messaging(ctx context.Context) (*messaging.Client, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.client == nil {
+		cfg := config.GetConfig()
+		app, err := firebase.NewApp(ctx, nil, option.WithCredentialsFile(cfg.Firebase.CredentialsPath))
+		if err != nil {
+			return nil, fmt.Errorf("error initializing firebase app: %v", err)
+		}
+		client, err := app.Messaging(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error getting firebase messaging client: %v", err)
+		}
+		p.client = client
+	}
+	return p.client, nil
+}
+
+func (p *fcmProvider) Send(ctx context.Context, msg Message) error {
+	client, err := p.messaging(ctx)
+	if err != nil {
+		return err
+	}
+
+	message := &messaging.Message{
+		Token: msg.Token,
+		Notification: &messaging.Notification{
+			Title: msg.Title,
+			Body:  msg.Body,
+		},
+		Data: msg.Data,
+	}
+	switch msg.Platform {
+	case PlatformAndroid:
+		message.Android = &messaging.AndroidConfig{Priority: "high"}
+	case PlatformIOS:
+		message.APNS = &messaging.APNSConfig{
+			Payload: &messaging.APNSPayload{Aps: &messaging.Aps{Sound: "default"}},
+		}
+	}
+
+	_, err = client.Send(ctx, message)
+	if messaging.IsRegistrationTokenNotRegistered(err) || messaging.IsInvalidArgument(err) {
+		return fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	return err
+}
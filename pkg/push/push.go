@@ -0,0 +1,110 @@
+package push
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"rival/config"
+)
+
+// ErrInvalidToken means the device token will never work again, e.g. the app
+// was uninstalled. The token should be dropped instead of retried.
+var ErrInvalidToken = errors.New("invalid device token")
+
+// Device platforms
+const (
+	PlatformAndroid = "android"
+	PlatformIOS     = "ios"
+	PlatformWeb     = "web"
+)
+
+func IsValidPlatform(platform string) bool {
+	switch platform {
+	case PlatformAndroid, PlatformIOS, PlatformWeb:
+		return true
+	}
+	return false
+}
+
+// Message is one push to one device.
+type Message struct {
+	Token    string
+	Platform string
+	Title    string
+	Body     string
+	Data     map[string]string // delivered to the app, e.g. deep_link and notification_id
+}
+
+// PushProvider delivers messages to a push service such as FCM or APNs.
+type PushProvider interface {
+	Name() string
+	Send(ctx context.Context, msg Message) error
+}
+
+// NewPushProviderFromConfig returns nil when push is disabled.
+func NewPushProviderFromConfig(cfg config.PushConfig) (PushProvider, error) {
+	switch cfg.Provider {
+	case "":
+		return nil, nil
+	case "fcm":
+		return NewFCMProvider(), nil
+	case "recording":
+		return NewRecordingProvider(), nil
+	default:
+		return nil, fmt.Errorf("unknown push provider: %s", cfg.Provider)
+	}
+}
+
+// Backoff is how long to wait before retrying a push that failed attempts
+// times: 30 seconds doubling up to an hour.
+func Backoff(attempts int) time.Duration {
+	delay := 30 * time.Second
+	for i := 1; i < attempts && delay < time.Hour; i++ {
+		delay *= 2
+	}
+	if delay > time.Hour {
+		delay = time.Hour
+	}
+	return delay
+}
+
+// RecordingProvider keeps every message instead of sending it, for local
+// runs and tests. Tokens listed in Invalid are rejected with ErrInvalidToken.
+type RecordingProvider struct {
+	mu      sync.Mutex
+	sent    []Message
+	Invalid map[string]bool
+	Err     error // returned for every other token when set
+}
+
+func NewRecordingProvider() *RecordingProvider {
+	return &RecordingProvider{Invalid: make(map[string]bool)}
+}
+
+func (p *RecordingProvider) Name() string {
+	return "recording"
+}
+
+func (p *RecordingProvider) Send(ctx context.Context, msg Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.Invalid[msg.Token] {
+		return ErrInvalidToken
+	}
+	if p.Err != nil {
+		return p.Err
+	}
+	p.sent = append(p.sent, msg)
+	return nil
+}
+
+// Sent returns the messages delivered so far.
+func (p *RecordingProvider) Sent() []Message {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]Message(nil), p.sent...)
+}
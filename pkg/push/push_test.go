@@ -0,0 +1,63 @@
+package push
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestBackoff(t *testing.T) {
+	cases := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{0, 30 * time.Second},
+		{1, 30 * time.Second},
+		{2, time.Minute},
+		{3, 2 * time.Minute},
+		{7, 32 * time.Minute},
+		{8, time.Hour},
+		{20, time.Hour},
+	}
+
+	for _, c := range cases {
+		if got := Backoff(c.attempts); got != c.want {
+			t.Errorf("Backoff(%d) = %v, want %v", c.attempts, got, c.want)
+		}
+	}
+}
+
+func TestRecordingProvider(t *testing.T) {
+	provider := NewRecordingProvider()
+	provider.Invalid["gone"] = true
+	ctx := context.Background()
+
+	if err := provider.Send(ctx, Message{Token: "ok", Title: "Hi"}); err != nil {
+		t.Fatalf("Send(ok) = %v", err)
+	}
+	if err := provider.Send(ctx, Message{Token: "gone"}); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("Send(gone) = %v, want ErrInvalidToken", err)
+	}
+
+	provider.Err = errors.New("unavailable")
+	if err := provider.Send(ctx, Message{Token: "ok"}); err == nil {
+		t.Fatalf("Send should fail while Err is set")
+	}
+
+	sent := provider.Sent()
+	if len(sent) != 1 || sent[0].Title != "Hi" {
+		t.Fatalf("Sent() = %+v, want the first message only", sent)
+	}
+}
+
+func TestIsValidPlatform(t *testing.T) {
+	for _, platform := range []string{PlatformAndroid, PlatformIOS, PlatformWeb} {
+		if !IsValidPlatform(platform) {
+			t.Errorf("IsValidPlatform(%s) = false", platform)
+		}
+	}
+	if IsValidPlatform("symbian") {
+		t.Errorf("IsValidPlatform(symbian) = true")
+	}
+}
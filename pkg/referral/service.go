@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	schema "rival/gen/sql"
+	"rival/pkg/notify"
 	"rival/pkg/tb"
 	"rival/pkg/utils"
 
@@ -27,6 +28,7 @@ type Service struct {
 	db      *pgxpool.Pool
 	queries *schema.Queries
 	tb      *tb.TbService
+	notify  *notify.Service
 	config  Config
 }
 
@@ -35,6 +37,7 @@ func NewService(db *pgxpool.Pool, tb *tb.TbService) *Service {
 		db:      db,
 		queries: schema.New(db),
 		tb:      tb,
+		notify:  notify.NewService(db),
 		config: Config{
 			ReferrerBonus: 5.0,   // $5 for referrer
 			RefereeBonus:  10.0,  // $10 for new user
@@ -118,6 +121,14 @@ func (s *Service) ProcessReferral(ctx context.Context, referrerCode string, newU
 		return fmt.Errorf("failed to commit transaction: %v", err)
 	}
 
+	s.notify.Notify(ctx, notify.Notification{
+		To:       notify.User(referrer.ID),
+		Type:     notify.TypeReferral,
+		Title:    "You earned a referral bonus",
+		Body:     fmt.Sprintf("A friend signed up with your code, %.0f coins were added to your balance", s.config.ReferrerBonus),
+		DeepLink: notify.ReferralsLink,
+	})
+
 	return nil
 }
 
@@ -0,0 +1,143 @@
+package sms
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"rival/config"
+)
+
+// MSG91Provider sends through MSG91's flow API. Indian operators only deliver
+// DLT registered templates, so the message is the configured template filled
+// with Params rather than Body.
+type MSG91Provider struct {
+	baseURL    string
+	authKey    string
+	templateID string
+	client     *http.Client
+}
+
+func NewMSG91Provider(cfg config.MSG91Config) *MSG91Provider {
+	baseURL := cfg.URL
+	if baseURL == "" {
+		baseURL = "https://control.msg91.com"
+	}
+	return &MSG91Provider{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		authKey:    cfg.AuthKey,
+		templateID: cfg.TemplateID,
+		client:     &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (p *MSG91Provider) Name() string {
+	return "msg91"
+}
+
+func (p *MSG91Provider) Send(ctx context.Context, msg Message) error {
+	// MSG91 wants the number without the leading +
+	recipient := map[string]string{"mobiles": strings.TrimPrefix(msg.To, "+")}
+	for key, value := range msg.Params {
+		recipient[key] = value
+	}
+	payload, err := json.Marshal(map[string]any{
+		"template_id": p.templateID,
+		"recipients":  []map[string]string{recipient},
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.baseURL+"/api/v5/flow/", bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("authkey", p.authKey)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("msg91 request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Errors come back as 200 with type "error" as well as HTTP errors
+	var result struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	}
+	_ = json.NewDecoder(io.LimitReader(resp.Body, 64<<10)).Decode(&result)
+	if resp.StatusCode != http.StatusOK || result.Type == "error" {
+		return fmt.Errorf("msg91 returned status %d: %s", resp.StatusCode, result.Message)
+	}
+	return nil
+}
+
+// TwilioProvider sends through Twilio's Messages API.
+type TwilioProvider struct {
+	baseURL    string
+	accountSID string
+	authToken  string
+	from       string
+	client     *http.Client
+}
+
+func NewTwilioProvider(cfg config.TwilioConfig) *TwilioProvider {
+	baseURL := cfg.URL
+	if baseURL == "" {
+		baseURL = "https://api.twilio.com"
+	}
+	return &TwilioProvider{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		accountSID: cfg.AccountSID,
+		authToken:  cfg.AuthToken,
+		from:       cfg.From,
+		client:     &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (p *TwilioProvider) Name() string {
+	return "twilio"
+}
+
+func (p *TwilioProvider) Send(ctx context.Context, msg Message) error {
+	form := url.Values{"To": {msg.To}, "Body": {msg.Body}}
+	// Messaging service SIDs start with MG, anything else is a phone number
+	if strings.HasPrefix(p.from, "MG") {
+		form.Set("MessagingServiceSid", p.from)
+	} else {
+		form.Set("From", p.from)
+	}
+
+	endpoint := fmt.Sprintf("%s/2010-04-01/Accounts/%s/Messages.json", p.baseURL, url.PathEscape(p.accountSID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(p.accountSID, p.authToken)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("twilio request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		var result struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		}
+		_ = json.NewDecoder(io.LimitReader(resp.Body, 64<<10)).Decode(&result)
+		return fmt.Errorf("twilio returned status %d: %s (code %d)", resp.StatusCode, result.Message, result.Code)
+	}
+	return nil
+}
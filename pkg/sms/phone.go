@@ -0,0 +1,75 @@
+package sms
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrInvalidPhone = errors.New("invalid phone number")
+
+const indiaCode = "91"
+
+// NormalizePhone turns a phone number as typed into E.164 (+919876543210).
+// Numbers without a country code are taken to be Indian, written with or
+// without the trunk 0. Indian numbers must be 10 digit mobiles starting with
+// 6-9, anything else must be a plausible international number.
+func NormalizePhone(raw string) (string, error) {
+	cleaned := strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '(', ')', '.', '\t':
+			return -1
+		}
+		return r
+	}, strings.TrimSpace(raw))
+
+	var digits string
+	switch {
+	case strings.HasPrefix(cleaned, "+"):
+		digits = cleaned[1:]
+	case strings.HasPrefix(cleaned, "00"):
+		digits = cleaned[2:]
+	default:
+		if !allDigits(cleaned) {
+			return "", ErrInvalidPhone
+		}
+		switch {
+		case len(cleaned) == 10:
+			digits = indiaCode + cleaned
+		case len(cleaned) == 11 && cleaned[0] == '0':
+			digits = indiaCode + cleaned[1:]
+		case len(cleaned) == 12 && strings.HasPrefix(cleaned, indiaCode):
+			digits = cleaned
+		default:
+			return "", ErrInvalidPhone
+		}
+	}
+
+	if !allDigits(digits) || len(digits) < 8 || len(digits) > 15 || digits[0] == '0' {
+		return "", ErrInvalidPhone
+	}
+	if strings.HasPrefix(digits, indiaCode) {
+		national := digits[len(indiaCode):]
+		if len(national) != 10 || national[0] < '6' {
+			return "", ErrInvalidPhone
+		}
+	}
+	return "+" + digits, nil
+}
+
+// IsE164 reports whether phone is already normalized.
+func IsE164(phone string) bool {
+	normalized, err := NormalizePhone(phone)
+	return err == nil && normalized == phone
+}
+
+func allDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
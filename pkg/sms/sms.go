@@ -0,0 +1,87 @@
+package sms
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+
+	"rival/config"
+)
+
+// Message is one SMS to one E.164 number. Template based providers such as
+// MSG91 send Params instead of Body.
+type Message struct {
+	To     string
+	Body   string
+	Params map[string]string // e.g. otp
+}
+
+// SMSProvider delivers text messages through a gateway such as MSG91 or Twilio.
+type SMSProvider interface {
+	Name() string
+	Send(ctx context.Context, msg Message) error
+}
+
+// NewSMSProviderFromConfig returns nil when SMS is disabled.
+func NewSMSProviderFromConfig(cfg config.SMSConfig) (SMSProvider, error) {
+	switch cfg.Provider {
+	case "":
+		return nil, nil
+	case "msg91":
+		return NewMSG91Provider(cfg.MSG91), nil
+	case "twilio":
+		return NewTwilioProvider(cfg.Twilio), nil
+	case "fake":
+		return NewFakeProvider(), nil
+	default:
+		return nil, fmt.Errorf("unknown sms provider: %s", cfg.Provider)
+	}
+}
+
+// FakeProvider keeps every message instead of sending it and logs it so codes
+// can be read off the console, for local runs and tests.
+type FakeProvider struct {
+	mu   sync.Mutex
+	sent []Message
+	Err  error // returned for every message when set
+}
+
+func NewFakeProvider() *FakeProvider {
+	return &FakeProvider{}
+}
+
+func (p *FakeProvider) Name() string {
+	return "fake"
+}
+
+func (p *FakeProvider) Send(ctx context.Context, msg Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.Err != nil {
+		return p.Err
+	}
+	log.Printf("SMS to %s: %s", msg.To, msg.Body)
+	p.sent = append(p.sent, msg)
+	return nil
+}
+
+// Sent returns the messages delivered so far.
+func (p *FakeProvider) Sent() []Message {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]Message(nil), p.sent...)
+}
+
+// Last returns the latest message to the number.
+func (p *FakeProvider) Last(to string) (Message, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for i := len(p.sent) - 1; i >= 0; i-- {
+		if p.sent[i].To == to {
+			return p.sent[i], true
+		}
+	}
+	return Message{}, false
+}
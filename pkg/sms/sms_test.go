@@ -0,0 +1,136 @@
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"rival/config"
+)
+
+func TestNormalizePhone(t *testing.T) {
+	cases := []struct {
+		raw  string
+		want string
+	}{
+		{"9876543210", "+919876543210"},
+		{"098765 43210", "+919876543210"},
+		{"+91 98765-43210", "+919876543210"},
+		{"919876543210", "+919876543210"},
+		{"0091 98765 43210", "+919876543210"},
+		{"+1 (415) 555-2671", "+14155552671"},
+		{"+44 20 7946 0958", "+442079460958"},
+		{"12345678", ""},          // too short for India, no country code
+		{"5876543210", ""},        // Indian numbers starting below 6 aren't mobiles
+		{"+91 98765 4321", ""},    // 9 digits
+		{"+0 123 456 789", ""},    // country codes don't start with 0
+		{"+1234567890123456", ""}, // longer than E.164 allows
+		{"98765x43210", ""},
+		{"", ""},
+	}
+
+	for _, c := range cases {
+		got, err := NormalizePhone(c.raw)
+		if c.want == "" {
+			if !errors.Is(err, ErrInvalidPhone) {
+				t.Errorf("NormalizePhone(%q) = %q, %v; want ErrInvalidPhone", c.raw, got, err)
+			}
+			continue
+		}
+		if err != nil || got != c.want {
+			t.Errorf("NormalizePhone(%q) = %q, %v; want %q", c.raw, got, err, c.want)
+		}
+	}
+
+	if !IsE164("+919876543210") || IsE164("9876543210") {
+		t.Errorf("IsE164 should only accept normalized numbers")
+	}
+}
+
+func TestMSG91Provider(t *testing.T) {
+	var got struct {
+		TemplateID string              `json:"template_id"`
+		Recipients []map[string]string `json:"recipients"`
+	}
+	var authKey string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authKey = r.Header.Get("authkey")
+		if r.URL.Path != "/api/v5/flow/" {
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		if got.Recipients[0]["mobiles"] == "919999999999" {
+			_, _ = w.Write([]byte(`{"type":"error","message":"blocked number"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"type":"success","message":"3763646c3058"}`))
+	}))
+	defer server.Close()
+
+	provider := NewMSG91Provider(config.MSG91Config{AuthKey: "key", TemplateID: "tpl", URL: server.URL})
+	err := provider.Send(context.Background(), Message{To: "+919876543210", Params: map[string]string{"otp": "482913"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if authKey != "key" || got.TemplateID != "tpl" {
+		t.Errorf("authkey = %q, template = %q", authKey, got.TemplateID)
+	}
+	if r := got.Recipients[0]; r["mobiles"] != "919876543210" || r["otp"] != "482913" {
+		t.Errorf("recipient = %v", r)
+	}
+
+	if err := provider.Send(context.Background(), Message{To: "+919999999999"}); err == nil {
+		t.Errorf("error responses should fail the send")
+	}
+}
+
+func TestTwilioProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, _ := r.BasicAuth()
+		if r.URL.Path != "/2010-04-01/Accounts/AC123/Messages.json" || user != "AC123" || pass != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"code":20003,"message":"Authenticate"}`))
+			return
+		}
+		if r.FormValue("To") != "+14155552671" || r.FormValue("From") != "+15005550006" || r.FormValue("Body") == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"code":21211,"message":"Invalid 'To' Phone Number"}`))
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"sid":"SM123","status":"queued"}`))
+	}))
+	defer server.Close()
+
+	cfg := config.TwilioConfig{AccountSID: "AC123", AuthToken: "secret", From: "+15005550006", URL: server.URL}
+	if err := NewTwilioProvider(cfg).Send(context.Background(), Message{To: "+14155552671", Body: "Your code is 482913"}); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg.AuthToken = "wrong"
+	if err := NewTwilioProvider(cfg).Send(context.Background(), Message{To: "+14155552671", Body: "hi"}); err == nil {
+		t.Errorf("rejected credentials should fail the send")
+	}
+}
+
+func TestFakeProvider(t *testing.T) {
+	provider := NewFakeProvider()
+	_ = provider.Send(context.Background(), Message{To: "+919876543210", Body: "first"})
+	_ = provider.Send(context.Background(), Message{To: "+919876543210", Body: "second"})
+
+	if last, ok := provider.Last("+919876543210"); !ok || last.Body != "second" {
+		t.Errorf("Last = %+v, %v", last, ok)
+	}
+	if _, ok := provider.Last("+14155552671"); ok {
+		t.Errorf("Last should not find other numbers")
+	}
+
+	provider.Err = errors.New("gateway down")
+	if err := provider.Send(context.Background(), Message{To: "+919876543210"}); err == nil || len(provider.Sent()) != 2 {
+		t.Errorf("failed sends should not be recorded")
+	}
+}
@@ -21,6 +21,8 @@ type Service interface {
 	GetUser(userID int) (*[]types.Account, error)
 	ProcessPayment(userID, merchantID int, amount float64) error
 	Transfer(fromID, toID int, amount float64) error
+	ChargeOrder(userID, merchantID int, amount float64, orderID int64) error
+	RefundOrder(userID, merchantID int, amount float64, orderID int64) error
 	GetAccountTransfers(accountID int) ([]types.Transfer, error)
 	Close()
 }
@@ -133,6 +135,53 @@ func (s *TbService) Transfer(fromID, toID int, amount float64) error {
 	return err
 }
 
+// Transfer codes of order payments and refunds
+const (
+	codeOrderPayment = 4
+	codeOrderRefund  = 5
+)
+
+// ChargeOrder moves coins from the user to the merchant for an order. The order
+// ID is kept in the transfer's UserData64.
+func (s *TbService) ChargeOrder(userID, merchantID int, amount float64, orderID int64) error {
+	return s.createTransfer(types.Transfer{
+		ID:              orderTransferID(codeOrderPayment, orderID),
+		DebitAccountID:  types.ToUint128(uint64(userID)),
+		CreditAccountID: types.ToUint128(uint64(merchantID)),
+		Amount:          types.ToUint128(uint64(amount * 100)),
+		UserData64:      uint64(orderID),
+		Ledger:          1,
+		Code:            codeOrderPayment,
+	})
+}
+
+// RefundOrder returns the coins charged for an order to the user. Each order
+// has one refund transfer ID, so a retry after a failed commit can't pay twice.
+func (s *TbService) RefundOrder(userID, merchantID int, amount float64, orderID int64) error {
+	return s.createTransfer(types.Transfer{
+		ID:              orderTransferID(codeOrderRefund, orderID),
+		DebitAccountID:  types.ToUint128(uint64(merchantID)),
+		CreditAccountID: types.ToUint128(uint64(userID)),
+		Amount:          types.ToUint128(uint64(amount * 100)),
+		UserData64:      uint64(orderID),
+		Ledger:          1,
+		Code:            codeOrderRefund,
+	})
+}
+
+// createTransfer submits one transfer and fails when the ledger rejects it.
+// Resubmitting an identical transfer succeeds without moving coins again.
+func (s *TbService) createTransfer(transfer types.Transfer) error {
+	results, err := s.client.CreateTransfers([]types.Transfer{transfer})
+	if err != nil {
+		return err
+	}
+	if len(results) > 0 && results[0].Result != types.TransferExists {
+		return fmt.Errorf("transfer rejected: %v", results[0].Result)
+	}
+	return nil
+}
+
 func (s *TbService) GetAccountTransfers(accountID int) ([]types.Transfer, error) {
 	id := types.ToUint128(uint64(accountID))
 	filter := types.AccountFilter{
@@ -149,6 +198,20 @@ func (s *TbService) GetAccountTransfers(accountID int) ([]types.Transfer, error)
 	return transfers, nil
 }
 
+// orderTransferID derives the transfer ID of an order payment or refund. The
+// high half has its top bit set, which the timestamps of generateTransferID
+// never do, so the two can't collide.
+func orderTransferID(code uint16, orderID int64) types.Uint128 {
+	high := uint64(1)<<63 | uint64(code)
+	low := uint64(orderID)
+	return types.Uint128([16]uint8{
+		uint8(high >> 56), uint8(high >> 48), uint8(high >> 40), uint8(high >> 32),
+		uint8(high >> 24), uint8(high >> 16), uint8(high >> 8), uint8(high),
+		uint8(low >> 56), uint8(low >> 48), uint8(low >> 40), uint8(low >> 32),
+		uint8(low >> 24), uint8(low >> 16), uint8(low >> 8), uint8(low),
+	})
+}
+
 func generateTransferID() types.Uint128 {
 	now := time.Now().UnixNano()
 	randBytes := make([]byte, 8)
@@ -0,0 +1,75 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	schema "rival/gen/sql"
+	"rival/pkg/tb"
+	"rival/pkg/utils"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Transaction types linked to an order
+const (
+	TypeOrderPayment = "order_payment"
+	TypeOrderRefund  = "order_refund"
+)
+
+// ChargeOrder records an order payment and debits the coins in TigerBeetle.
+// qtx must be bound to the caller's transaction: the record is written first
+// (its unique index stops a second charge), so a failed transfer rolls it back.
+func ChargeOrder(ctx context.Context, qtx *schema.Queries, ledger tb.Service, params schema.CreateTransactionParams) (schema.Transaction, error) {
+	params.TransactionType = pgtype.Text{String: TypeOrderPayment, Valid: true}
+	params.Status = pgtype.Text{String: "completed", Valid: true}
+
+	payment, err := qtx.CreateTransaction(ctx, params)
+	if err != nil {
+		return schema.Transaction{}, fmt.Errorf("failed to record order payment: %v", err)
+	}
+
+	coins := utils.NumericToFloat64(payment.CoinsSpent)
+	if err := ledger.ChargeOrder(int(payment.UserID.Int64), int(payment.MerchantID.Int64), coins, payment.OrderID.Int64); err != nil {
+		return schema.Transaction{}, fmt.Errorf("payment failed: %v", err)
+	}
+	return payment, nil
+}
+
+// RefundOrder reverses the coins charged for an order, if any. Like
+// ChargeOrder it must run inside the caller's transaction. It returns false
+// when the order was never charged.
+func RefundOrder(ctx context.Context, qtx *schema.Queries, ledger tb.Service, orderID int64) (bool, error) {
+	payment, err := qtx.GetOrderPayment(ctx, pgtype.Int8{Int64: orderID, Valid: true})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get order payment: %v", err)
+	}
+
+	coins := utils.NumericToFloat64(payment.CoinsSpent)
+
+	// Negative like other credits, so spending totals net out
+	_, err = qtx.CreateTransaction(ctx, schema.CreateTransactionParams{
+		UserID:          payment.UserID,
+		MerchantID:      payment.MerchantID,
+		OrderID:         payment.OrderID,
+		CoinsSpent:      utils.Float64ToNumeric(-coins),
+		OriginalAmount:  payment.OriginalAmount,
+		DiscountAmount:  payment.DiscountAmount,
+		FinalAmount:     payment.FinalAmount,
+		TransactionType: pgtype.Text{String: TypeOrderRefund, Valid: true},
+		Status:          pgtype.Text{String: "completed", Valid: true},
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to record order refund: %v", err)
+	}
+
+	if err := ledger.RefundOrder(int(payment.UserID.Int64), int(payment.MerchantID.Int64), coins, orderID); err != nil {
+		return false, fmt.Errorf("refund failed: %v", err)
+	}
+	return true, nil
+}
@@ -60,3 +60,13 @@ func padLeft(str string, length int, pad rune) string {
 	}
 	return str
 }
+
+// CoordinateToNumeric converts a latitude or longitude to pgtype.Numeric,
+// keeping the 8 decimal places the address columns store
+func CoordinateToNumeric(f float64) pgtype.Numeric {
+	var num pgtype.Numeric
+	if err := num.Scan(strconv.FormatFloat(f, 'f', 8, 64)); err != nil {
+		return pgtype.Numeric{Valid: false}
+	}
+	return num
+}
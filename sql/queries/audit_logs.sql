@@ -0,0 +1,11 @@
+-- name: CreateAuditLog :exec
+INSERT INTO audit_logs (
+    actor_id, actor_type, action, target_type, target_id, metadata, ip_address, user_agent
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+);
+
+-- name: ListAuditLogs :many
+SELECT * FROM audit_logs
+ORDER BY created_at DESC
+LIMIT $1 OFFSET $2;
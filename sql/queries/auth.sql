@@ -30,6 +30,26 @@ RETURNING
 -- name: GetUserByEmail :one
 SELECT * FROM users WHERE email = $1;
 
+-- name: GetUserByVerifiedPhone :one
+SELECT * FROM users WHERE phone = $1 AND phone_verified_at IS NOT NULL;
+
+-- name: MarkEmailVerified :exec
+UPDATE users
+SET
+    email_verified_at = COALESCE(email_verified_at, NOW()),
+    updated_at = NOW()
+WHERE
+    id = $1;
+
+-- name: MarkPhoneVerified :exec
+UPDATE users
+SET
+    phone = $2,
+    phone_verified_at = NOW(),
+    updated_at = NOW()
+WHERE
+    id = $1;
+
 -- name: GetUserByID :one
 SELECT * FROM users WHERE id = $1;
 
@@ -41,6 +61,8 @@ UPDATE users
 SET
     name = $2,
     phone = $3,
+    -- A new number has to be verified again
+    phone_verified_at = CASE WHEN phone IS DISTINCT FROM $3 THEN NULL ELSE phone_verified_at END,
     profile_pic = $4,
     updated_at = NOW()
 WHERE
@@ -60,9 +82,15 @@ INSERT INTO
         user_id,
         token_hash,
         refresh_token_hash,
-        expires_at
+        expires_at,
+        session_id,
+        device_id,
+        device_name,
+        platform,
+        user_agent,
+        ip_address
     )
-VALUES ($1, $2, $3, $4);
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
 
 -- name: GetJWTSession :one
 SELECT *
@@ -76,3 +104,46 @@ UPDATE jwt_sessions SET is_revoked = true WHERE token_hash = $1;
 
 -- name: RevokeAllUserSessions :exec
 UPDATE jwt_sessions SET is_revoked = true WHERE user_id = $1;
+
+-- name: GetJWTSessionBySessionID :one
+SELECT *
+FROM jwt_sessions
+WHERE
+    session_id = $1
+    AND is_revoked = false;
+
+-- name: ListUserSessions :many
+SELECT *
+FROM jwt_sessions
+WHERE
+    user_id = $1
+    AND is_revoked = false
+    AND expires_at > NOW()
+ORDER BY last_seen_at DESC;
+
+-- name: RevokeUserSession :one
+UPDATE jwt_sessions
+SET
+    is_revoked = true
+WHERE
+    id = $1
+    AND user_id = $2
+    AND is_revoked = false
+RETURNING
+    *;
+
+-- name: TouchJWTSession :exec
+UPDATE jwt_sessions
+SET
+    last_seen_at = NOW(),
+    expires_at = $2
+WHERE
+    session_id = $1;
+
+-- name: GetUserDeviceStats :one
+SELECT
+    COUNT(*) AS total_sessions,
+    COUNT(*) FILTER (WHERE device_id = $2) AS device_sessions
+FROM jwt_sessions
+WHERE
+    user_id = $1;
@@ -0,0 +1,94 @@
+-- name: CreateCatalogCategory :one
+INSERT INTO catalog_categories (
+    merchant_id, name, sort_order
+) VALUES (
+    $1, $2, $3
+) RETURNING *;
+
+-- name: UpdateCatalogCategory :one
+UPDATE catalog_categories
+SET name = $3, sort_order = $4, updated_at = NOW()
+WHERE id = $1 AND merchant_id = $2
+RETURNING *;
+
+-- name: DeleteCatalogCategory :one
+DELETE FROM catalog_categories
+WHERE id = $1 AND merchant_id = $2
+RETURNING *;
+
+-- name: GetCatalogCategory :one
+SELECT * FROM catalog_categories
+WHERE id = $1 AND merchant_id = $2;
+
+-- name: ListCatalogCategories :many
+SELECT * FROM catalog_categories
+WHERE merchant_id = $1
+ORDER BY sort_order, name;
+
+-- name: CreateCatalogItem :one
+INSERT INTO catalog_items (
+    merchant_id, category_id, name, description, price, sort_order
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: UpdateCatalogItem :one
+UPDATE catalog_items
+SET category_id = $3, name = $4, description = $5, price = $6, sort_order = $7, updated_at = NOW()
+WHERE id = $1 AND merchant_id = $2
+RETURNING *;
+
+-- name: DeleteCatalogItem :one
+DELETE FROM catalog_items
+WHERE id = $1 AND merchant_id = $2
+RETURNING *;
+
+-- name: GetCatalogItem :one
+SELECT * FROM catalog_items
+WHERE id = $1 AND merchant_id = $2;
+
+-- name: ListCatalogItems :many
+SELECT * FROM catalog_items
+WHERE merchant_id = $1
+ORDER BY sort_order, name;
+
+-- name: GetCatalogItemsByIDs :many
+SELECT * FROM catalog_items
+WHERE merchant_id = $1 AND id = ANY(sqlc.arg(ids)::bigint[]);
+
+-- name: CountCatalogItems :one
+SELECT COUNT(*) FROM catalog_items WHERE merchant_id = $1;
+
+-- name: SetCatalogItemAvailability :one
+UPDATE catalog_items
+SET is_available = $3, updated_at = NOW()
+WHERE id = $1 AND merchant_id = $2
+RETURNING *;
+
+-- name: SetCatalogItemImage :one
+UPDATE catalog_items
+SET image_key = $3, updated_at = NOW()
+WHERE id = $1 AND merchant_id = $2
+RETURNING *;
+
+-- name: CreateCatalogOption :one
+INSERT INTO catalog_options (
+    item_id, kind, name, price_delta, is_available, sort_order
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: DeleteCatalogOptions :exec
+DELETE FROM catalog_options WHERE item_id = $1;
+
+-- name: ListCatalogOptions :many
+SELECT * FROM catalog_options
+WHERE item_id = ANY(sqlc.arg(item_ids)::bigint[])
+ORDER BY item_id, sort_order, id;
+
+-- name: SetCatalogOptionAvailability :one
+UPDATE catalog_options
+SET is_available = $3
+WHERE catalog_options.id = $1
+  AND item_id IN (SELECT catalog_items.id FROM catalog_items WHERE catalog_items.merchant_id = $2)
+RETURNING *;
@@ -0,0 +1,75 @@
+-- name: AddFavoriteMerchant :exec
+INSERT INTO favorite_merchants (user_id, merchant_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING;
+
+-- name: RemoveFavoriteMerchant :execrows
+DELETE FROM favorite_merchants WHERE user_id = $1 AND merchant_id = $2;
+
+-- name: ListFavoriteMerchants :many
+SELECT merchants.* FROM favorite_merchants
+JOIN merchants ON merchants.id = favorite_merchants.merchant_id
+WHERE favorite_merchants.user_id = $1
+ORDER BY favorite_merchants.created_at DESC;
+
+-- name: AddFavoriteOffer :exec
+INSERT INTO favorite_offers (user_id, offer_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING;
+
+-- name: RemoveFavoriteOffer :execrows
+DELETE FROM favorite_offers WHERE user_id = $1 AND offer_id = $2;
+
+-- name: ListFavoriteOffers :many
+SELECT offers.* FROM favorite_offers
+JOIN offers ON offers.id = favorite_offers.offer_id
+WHERE favorite_offers.user_id = $1
+ORDER BY favorite_offers.created_at DESC;
+
+-- name: ListFavoriteMerchantIDs :many
+-- Merchants the user saved directly or through one of their offers
+SELECT merchant_id FROM favorite_merchants WHERE favorite_merchants.user_id = $1
+UNION
+SELECT offers.merchant_id::bigint FROM favorite_offers
+JOIN offers ON offers.id = favorite_offers.offer_id
+WHERE favorite_offers.user_id = $1 AND offers.merchant_id IS NOT NULL;
+
+-- name: ListUserMerchantVisits :many
+-- How often and how recently the user bought from each merchant: completed
+-- orders plus completed payments that weren't for an order
+SELECT visits.merchant_id::bigint AS merchant_id,
+       COALESCE(merchants.category, '')::text AS category,
+       COUNT(*)::int AS visit_count,
+       MAX(visits.visited_at)::timestamp AS last_visit_at
+FROM (
+    SELECT orders.merchant_id, orders.created_at AS visited_at FROM orders
+    WHERE orders.user_id = $1 AND orders.status = 'completed'
+    UNION ALL
+    SELECT transactions.merchant_id, transactions.created_at AS visited_at FROM transactions
+    WHERE transactions.user_id = $1 AND transactions.transaction_type = 'payment'
+      AND transactions.status = 'completed' AND transactions.order_id IS NULL
+) AS visits
+JOIN merchants ON merchants.id = visits.merchant_id
+GROUP BY visits.merchant_id, merchants.category;
+
+-- name: ListUserRatings :many
+-- The stars the user gave each merchant, averaged over their reviews
+SELECT merchant_id, AVG(rating)::float8 AS rating
+FROM reviews
+WHERE user_id = $1
+GROUP BY merchant_id;
+
+-- name: ListMerchantsInArea :many
+-- Approved merchants with a branch inside the bounding box, one row per branch
+SELECT merchants.id,
+       merchants.name,
+       COALESCE(merchants.category, '')::text AS category,
+       merchants.rating_count,
+       merchants.rating_total,
+       merchant_addresses.latitude::float8 AS latitude,
+       merchant_addresses.longitude::float8 AS longitude
+FROM merchants
+JOIN merchant_addresses ON merchant_addresses.merchant_id = merchants.id
+WHERE merchants.status = 'approved'
+  AND merchant_addresses.latitude BETWEEN sqlc.arg(min_lat)::float8 AND sqlc.arg(max_lat)::float8
+  AND merchant_addresses.longitude BETWEEN sqlc.arg(min_lng)::float8 AND sqlc.arg(max_lng)::float8;
@@ -0,0 +1,32 @@
+-- name: CreateUserIdentity :one
+INSERT INTO user_identities (
+    user_id, provider, subject, email, email_verified, last_login_at
+) VALUES (
+    $1, $2, $3, $4, $5, NOW()
+) RETURNING *;
+
+-- name: GetIdentityByProviderSubject :one
+SELECT * FROM user_identities
+WHERE provider = $1 AND subject = $2;
+
+-- name: ListUserIdentities :many
+SELECT * FROM user_identities
+WHERE user_id = $1
+ORDER BY created_at;
+
+-- name: DeleteUserIdentity :execrows
+DELETE FROM user_identities
+WHERE user_id = $1 AND provider = $2;
+
+-- name: TouchUserIdentity :exec
+UPDATE user_identities SET
+    last_login_at = NOW(),
+    email = $2,
+    email_verified = $3
+WHERE id = $1;
+
+-- name: SetUserFirebaseUID :exec
+UPDATE users SET
+    firebase_uid = $2,
+    updated_at = NOW()
+WHERE id = $1;
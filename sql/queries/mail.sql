@@ -0,0 +1,45 @@
+-- name: EnqueueEmail :one
+INSERT INTO email_outbox (recipient, template, locale, subject, text_body, html_body)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id;
+
+-- name: ClaimDueEmails :many
+-- Same claim and lease scheme as push deliveries
+UPDATE email_outbox SET
+    claimed_until = sqlc.arg(lease_until),
+    attempts = attempts + 1
+WHERE email_outbox.id IN (
+    SELECT due.id FROM email_outbox due
+    WHERE due.status = 'pending'
+        AND due.next_attempt_at <= sqlc.arg(now)
+        AND (due.claimed_until IS NULL OR due.claimed_until < sqlc.arg(now))
+    ORDER BY due.next_attempt_at
+    LIMIT sqlc.arg(batch_size)
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: MarkEmailSent :exec
+UPDATE email_outbox SET
+    status = 'sent',
+    sent_at = NOW(),
+    claimed_until = NULL,
+    text_body = '',
+    html_body = ''
+WHERE id = $1;
+
+-- name: RetryEmail :exec
+UPDATE email_outbox SET
+    next_attempt_at = sqlc.arg(next_attempt_at),
+    last_error = sqlc.arg(last_error),
+    claimed_until = NULL
+WHERE id = sqlc.arg(id);
+
+-- name: FailEmail :exec
+UPDATE email_outbox SET
+    status = 'failed',
+    last_error = sqlc.arg(last_error),
+    claimed_until = NULL,
+    text_body = '',
+    html_body = ''
+WHERE id = sqlc.arg(id);
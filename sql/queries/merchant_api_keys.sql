@@ -0,0 +1,25 @@
+-- name: CreateMerchantAPIKey :one
+INSERT INTO merchant_api_keys (
+    merchant_id, name, prefix, key_hash, scopes, allowed_ips, created_by, expires_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+) RETURNING *;
+
+-- name: GetMerchantAPIKeyByPrefix :one
+SELECT * FROM merchant_api_keys WHERE prefix = $1;
+
+-- name: ListMerchantAPIKeys :many
+SELECT * FROM merchant_api_keys
+WHERE merchant_id = $1
+ORDER BY created_at DESC;
+
+-- name: RevokeMerchantAPIKey :execrows
+UPDATE merchant_api_keys SET revoked_at = NOW()
+WHERE id = $1 AND merchant_id = $2 AND revoked_at IS NULL;
+
+-- name: TouchMerchantAPIKey :exec
+UPDATE merchant_api_keys SET
+    last_used_at = NOW(),
+    last_used_ip = $2
+WHERE id = $1
+  AND (last_used_at IS NULL OR last_used_at < NOW() - INTERVAL '1 minute');
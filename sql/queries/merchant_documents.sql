@@ -0,0 +1,49 @@
+-- name: CreateMerchantDocument :one
+INSERT INTO merchant_documents (
+    merchant_id, doc_type, document_number, object_key, content_type, size_bytes, expires_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+) RETURNING *;
+
+-- name: GetMerchantDocument :one
+SELECT * FROM merchant_documents WHERE id = $1;
+
+-- name: ListMerchantDocuments :many
+SELECT * FROM merchant_documents
+WHERE merchant_id = $1
+ORDER BY created_at DESC, id DESC;
+
+-- name: ReviewMerchantDocument :one
+UPDATE merchant_documents SET
+    status = $2,
+    rejection_reason = $3,
+    reviewed_by = $4,
+    reviewed_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1 AND status = 'uploaded'
+RETURNING *;
+
+-- name: ClaimExpiringMerchantDocuments :many
+-- Same claim and lease scheme as order timers, so instances running the
+-- reminder loop at once don't remind the same merchant twice
+UPDATE merchant_documents SET
+    reminder_claimed_until = sqlc.arg(lease_until)
+WHERE merchant_documents.id IN (
+    SELECT due.id FROM merchant_documents due
+    WHERE due.status = 'verified'
+        AND due.expires_at IS NOT NULL
+        AND due.expires_at <= sqlc.arg(horizon)
+        AND due.expiry_reminders_sent < sqlc.arg(max_reminders)::int
+        AND (due.reminder_claimed_until IS NULL OR due.reminder_claimed_until < sqlc.arg(now))
+    ORDER BY due.expires_at
+    LIMIT sqlc.arg(batch_size)
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: SetMerchantDocumentRemindersSent :exec
+UPDATE merchant_documents SET
+    expiry_reminders_sent = $2,
+    reminder_claimed_until = NULL,
+    updated_at = NOW()
+WHERE id = $1;
@@ -0,0 +1,40 @@
+-- name: GetMerchantSchedule :one
+SELECT id, timezone, orders_paused_until FROM merchants WHERE id = $1;
+
+-- name: SetMerchantTimezone :exec
+UPDATE merchants SET timezone = $2, updated_at = NOW() WHERE id = $1;
+
+-- name: ListMerchantHours :many
+SELECT * FROM merchant_hours
+WHERE merchant_id = $1
+ORDER BY day_of_week, opens_at;
+
+-- name: DeleteMerchantHours :exec
+DELETE FROM merchant_hours WHERE merchant_id = $1;
+
+-- name: CreateMerchantHours :one
+INSERT INTO merchant_hours (
+    merchant_id, day_of_week, opens_at, closes_at
+) VALUES (
+    $1, $2, $3, $4
+) RETURNING *;
+
+-- name: ListMerchantClosures :many
+SELECT * FROM merchant_closures
+WHERE merchant_id = $1 AND ends_at > sqlc.arg(after)
+ORDER BY starts_at;
+
+-- name: CreateMerchantClosure :one
+INSERT INTO merchant_closures (
+    merchant_id, starts_at, ends_at, reason
+) VALUES (
+    $1, $2, $3, $4
+) RETURNING *;
+
+-- name: DeleteMerchantClosure :one
+DELETE FROM merchant_closures
+WHERE id = $1 AND merchant_id = $2
+RETURNING *;
+
+-- name: SetMerchantOrdersPausedUntil :exec
+UPDATE merchants SET orders_paused_until = $2, updated_at = NOW() WHERE id = $1;
@@ -0,0 +1,20 @@
+-- name: TransitionMerchantStatus :one
+-- Only moves the merchant if it is still in from_status, so concurrent reviews can't both win
+UPDATE merchants SET
+    status = sqlc.arg(to_status),
+    is_active = sqlc.arg(is_active),
+    updated_at = NOW()
+WHERE id = sqlc.arg(id) AND status = sqlc.arg(from_status)
+RETURNING *;
+
+-- name: CreateMerchantStatusHistory :one
+INSERT INTO merchant_status_history (
+    merchant_id, from_status, to_status, reason, actor_id, actor_type
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: ListMerchantStatusHistory :many
+SELECT * FROM merchant_status_history
+WHERE merchant_id = $1
+ORDER BY created_at DESC, id DESC;
@@ -0,0 +1,84 @@
+-- name: GetMerchantStaffMember :one
+SELECT * FROM merchant_staff
+WHERE merchant_id = $1 AND user_id = $2;
+
+-- name: ListMerchantStaff :many
+SELECT sqlc.embed(merchant_staff), users.name, users.email
+FROM merchant_staff
+JOIN users ON users.id = merchant_staff.user_id
+WHERE merchant_staff.merchant_id = $1
+ORDER BY merchant_staff.created_at;
+
+-- name: ListStaffMemberships :many
+SELECT sqlc.embed(merchant_staff), merchants.name AS merchant_name
+FROM merchant_staff
+JOIN merchants ON merchants.id = merchant_staff.merchant_id
+WHERE merchant_staff.user_id = $1
+ORDER BY merchant_staff.created_at;
+
+-- name: CreateMerchantStaff :one
+INSERT INTO merchant_staff (
+    merchant_id, user_id, role, outlet_ids, invited_by
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+ON CONFLICT (merchant_id, user_id) DO UPDATE
+SET role = EXCLUDED.role, outlet_ids = EXCLUDED.outlet_ids, updated_at = NOW()
+RETURNING *;
+
+-- name: UpdateMerchantStaff :one
+UPDATE merchant_staff
+SET role = $3, outlet_ids = $4, updated_at = NOW()
+WHERE id = $1 AND merchant_id = $2
+RETURNING *;
+
+-- name: DeleteMerchantStaff :one
+DELETE FROM merchant_staff
+WHERE id = $1 AND merchant_id = $2
+RETURNING *;
+
+-- name: GetMerchantStaffByID :one
+SELECT * FROM merchant_staff
+WHERE id = $1 AND merchant_id = $2;
+
+-- name: CountMerchantOwners :one
+SELECT COUNT(*) FROM merchant_staff
+WHERE merchant_id = $1 AND role = 'owner';
+
+-- name: ClaimMerchantOwnership :one
+-- Merchants created with a login email are owned by the user with that email
+-- until they have an owner membership.
+INSERT INTO merchant_staff (merchant_id, user_id, role)
+SELECT merchants.id, users.id, 'owner'
+FROM merchants
+JOIN users ON LOWER(users.email) = LOWER(merchants.email)
+WHERE merchants.id = sqlc.arg(merchant_id) AND users.id = sqlc.arg(user_id)
+  AND NOT EXISTS (
+    SELECT 1 FROM merchant_staff owners
+    WHERE owners.merchant_id = merchants.id AND owners.role = 'owner'
+  )
+RETURNING *;
+
+-- name: CreateStaffInvitation :one
+INSERT INTO merchant_staff_invitations (
+    merchant_id, email, role, outlet_ids, token_hash, invited_by, expires_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+) RETURNING *;
+
+-- name: GetStaffInvitationByTokenHash :one
+SELECT * FROM merchant_staff_invitations
+WHERE token_hash = $1;
+
+-- name: ListPendingStaffInvitations :many
+SELECT * FROM merchant_staff_invitations
+WHERE merchant_id = $1 AND accepted_at IS NULL AND revoked_at IS NULL AND expires_at > NOW()
+ORDER BY created_at DESC;
+
+-- name: AcceptStaffInvitation :execrows
+UPDATE merchant_staff_invitations SET accepted_at = NOW()
+WHERE id = $1 AND accepted_at IS NULL AND revoked_at IS NULL;
+
+-- name: RevokeStaffInvitation :execrows
+UPDATE merchant_staff_invitations SET revoked_at = NOW()
+WHERE id = $1 AND merchant_id = $2 AND accepted_at IS NULL AND revoked_at IS NULL;
@@ -1,8 +1,8 @@
 -- name: CreateMerchant :one
 INSERT INTO merchants (
-    name, email, phone, category, discount_percentage, is_active
+    name, email, phone, category, discount_percentage, is_active, status
 ) VALUES (
-    $1, $2, $3, $4, $5, $6
+    $1, $2, $3, $4, $5, $6, $7
 ) RETURNING *;
 
 -- name: GetMerchantByID :one
@@ -17,7 +17,6 @@ UPDATE merchants SET
     phone = $3,
     category = $4,
     discount_percentage = $5,
-    is_active = $6,
     updated_at = NOW()
 WHERE id = $1;
 
@@ -51,18 +50,58 @@ SELECT * FROM merchants
 ORDER BY created_at DESC 
 LIMIT $1 OFFSET $2;
 
+-- name: GetMerchantsByStatuses :many
+SELECT * FROM merchants
+WHERE status = ANY(sqlc.arg(statuses)::text[])
+ORDER BY created_at DESC
+LIMIT sqlc.arg(row_limit) OFFSET sqlc.arg(row_offset);
+
+-- name: CountPendingMerchants :one
+SELECT COUNT(*) FROM merchants WHERE status IN ('submitted', 'under_review');
+
 -- name: GetMerchantAddresses :many
 SELECT * FROM merchant_addresses 
 WHERE merchant_id = $1 
-ORDER BY created_at DESC;
+ORDER BY is_primary DESC, created_at DESC;
+
+-- name: GetMerchantAddress :one
+SELECT * FROM merchant_addresses
+WHERE id = $1 AND merchant_id = $2;
 
 -- name: CreateMerchantAddress :one
 INSERT INTO merchant_addresses (
-    merchant_id, street, city, state, postal_code, country, latitude, longitude, is_primary
+    merchant_id, street, city, state, postal_code, country, latitude, longitude, is_primary, label
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
 ) RETURNING *;
 
+-- name: UpdateMerchantAddress :one
+UPDATE merchant_addresses
+SET street = $3, city = $4, state = $5, postal_code = $6, country = $7,
+    latitude = $8, longitude = $9, label = $10, updated_at = NOW()
+WHERE id = $1 AND merchant_id = $2
+RETURNING *;
+
+-- name: DeleteMerchantAddress :one
+DELETE FROM merchant_addresses
+WHERE id = $1 AND merchant_id = $2
+RETURNING *;
+
+-- name: ClearPrimaryMerchantAddress :exec
+UPDATE merchant_addresses SET is_primary = false, updated_at = NOW()
+WHERE merchant_id = $1 AND is_primary;
+
+-- name: SetPrimaryMerchantAddress :one
+UPDATE merchant_addresses SET is_primary = true, updated_at = NOW()
+WHERE id = $1 AND merchant_id = $2
+RETURNING *;
+
+-- name: GetOldestMerchantAddress :one
+SELECT * FROM merchant_addresses
+WHERE merchant_id = $1
+ORDER BY created_at, id
+LIMIT 1;
+
 -- name: CreateOffer :one
 INSERT INTO offers (
     merchant_id, title, description, discount_percentage, min_amount, max_discount, valid_from, valid_until
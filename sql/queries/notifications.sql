@@ -0,0 +1,37 @@
+-- Notifications belong to either a user or a merchant; the other id is null.
+
+-- name: CreateNotification :one
+-- read_at is set for notifications the user keeps out of their inbox
+INSERT INTO notifications (user_id, merchant_id, type, title, body, deep_link, read_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: ListNotifications :many
+SELECT * FROM notifications
+WHERE user_id IS NOT DISTINCT FROM sqlc.narg(user_id)
+    AND merchant_id IS NOT DISTINCT FROM sqlc.narg(merchant_id)
+    AND (NOT sqlc.arg(unread_only)::boolean OR read_at IS NULL)
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg(limit_count) OFFSET sqlc.arg(offset_count);
+
+-- name: CountNotifications :one
+SELECT COUNT(*) FROM notifications
+WHERE user_id IS NOT DISTINCT FROM sqlc.narg(user_id)
+    AND merchant_id IS NOT DISTINCT FROM sqlc.narg(merchant_id)
+    AND (NOT sqlc.arg(unread_only)::boolean OR read_at IS NULL);
+
+-- name: ListUnreadNotifications :many
+-- Oldest first, for replaying on a stream
+SELECT * FROM notifications
+WHERE user_id IS NOT DISTINCT FROM sqlc.narg(user_id)
+    AND merchant_id IS NOT DISTINCT FROM sqlc.narg(merchant_id)
+    AND read_at IS NULL
+ORDER BY created_at, id
+LIMIT sqlc.arg(limit_count);
+
+-- name: MarkNotificationsRead :execrows
+UPDATE notifications SET read_at = NOW()
+WHERE user_id IS NOT DISTINCT FROM sqlc.narg(user_id)
+    AND merchant_id IS NOT DISTINCT FROM sqlc.narg(merchant_id)
+    AND read_at IS NULL
+    AND (sqlc.narg(ids)::bigint[] IS NULL OR id = ANY(sqlc.narg(ids)::bigint[]));
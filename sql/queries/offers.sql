@@ -0,0 +1,17 @@
+-- name: ListOffersInArea :many
+-- Live offers of approved merchants with a branch inside the bounding box,
+-- one row per offer and branch
+SELECT sqlc.embed(offers),
+       merchant_addresses.latitude::float8 AS latitude,
+       merchant_addresses.longitude::float8 AS longitude,
+       merchants.rating_count,
+       merchants.rating_total
+FROM offers
+JOIN merchants ON merchants.id = offers.merchant_id
+JOIN merchant_addresses ON merchant_addresses.merchant_id = offers.merchant_id
+WHERE offers.is_active = true
+  AND merchants.status = 'approved'
+  AND (offers.valid_from IS NULL OR offers.valid_from <= NOW())
+  AND (offers.valid_until IS NULL OR offers.valid_until > NOW())
+  AND merchant_addresses.latitude BETWEEN sqlc.arg(min_lat)::float8 AND sqlc.arg(max_lat)::float8
+  AND merchant_addresses.longitude BETWEEN sqlc.arg(min_lng)::float8 AND sqlc.arg(max_lng)::float8;
@@ -1,21 +1,59 @@
 -- name: CreateOrder :one
 INSERT INTO orders (
-    merchant_id, user_id, offer_id, order_number, items, subtotal, discount_amount, total_amount, coins_used, status, notes
+    merchant_id, user_id, offer_id, order_number, items, subtotal, discount_amount, total_amount, coins_used, status, notes, outlet_id, number_period
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
 ) RETURNING *;
 
+-- name: NextOrderNumber :one
+-- Bumps the merchant's counter for the current period. Daily periods follow the
+-- merchant's timezone; the row stays locked until the order commits, so numbers
+-- are handed out without gaps.
+INSERT INTO order_number_counters (merchant_id, period, last_value)
+SELECT m.id,
+    CASE WHEN sqlc.arg(daily)::boolean THEN to_char(NOW() AT TIME ZONE m.timezone, 'YYYY-MM-DD') ELSE '' END,
+    1
+FROM merchants m
+WHERE m.id = sqlc.arg(merchant_id)
+ON CONFLICT (merchant_id, period) DO UPDATE SET last_value = order_number_counters.last_value + 1
+RETURNING period, last_value;
+
 -- name: GetOrderByID :one
 SELECT * FROM orders WHERE id = $1;
 
 -- name: GetOrderByNumber :one
-SELECT * FROM orders WHERE order_number = $1;
+-- Numbers repeat across periods, the latest order wins
+SELECT * FROM orders
+WHERE merchant_id = $1 AND order_number = $2
+ORDER BY created_at DESC
+LIMIT 1;
 
--- name: UpdateOrderStatus :exec
+-- name: TransitionOrderStatus :one
+-- Only moves the order if it is still in from_status, so a merchant and customer can't both win
 UPDATE orders SET
-    status = $2,
+    status = sqlc.arg(to_status),
+    status_reason = sqlc.narg(reason),
+    accepted_at = CASE WHEN sqlc.arg(to_status) = 'accepted' THEN sqlc.arg(changed_at)::timestamp ELSE accepted_at END,
+    preparing_at = CASE WHEN sqlc.arg(to_status) = 'preparing' THEN sqlc.arg(changed_at)::timestamp ELSE preparing_at END,
+    ready_at = CASE WHEN sqlc.arg(to_status) = 'ready' THEN sqlc.arg(changed_at)::timestamp ELSE ready_at END,
+    completed_at = CASE WHEN sqlc.arg(to_status) = 'completed' THEN sqlc.arg(changed_at)::timestamp ELSE completed_at END,
+    cancelled_at = CASE WHEN sqlc.arg(to_status) = 'cancelled' THEN sqlc.arg(changed_at)::timestamp ELSE cancelled_at END,
+    rejected_at = CASE WHEN sqlc.arg(to_status) = 'rejected' THEN sqlc.arg(changed_at)::timestamp ELSE rejected_at END,
     updated_at = NOW()
-WHERE id = $1;
+WHERE id = sqlc.arg(id) AND status = sqlc.arg(from_status)
+RETURNING *;
+
+-- name: CreateOrderStatusHistory :one
+INSERT INTO order_status_history (
+    order_id, from_status, to_status, reason, actor_id, actor_type
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: ListOrderStatusHistory :many
+SELECT * FROM order_status_history
+WHERE order_id = $1
+ORDER BY created_at, id;
 
 -- name: GetUserOrders :many
 SELECT * FROM orders 
@@ -46,3 +84,84 @@ SELECT COUNT(*) FROM orders WHERE user_id = $1;
 
 -- name: CountMerchantOrders :one
 SELECT COUNT(*) FROM orders WHERE merchant_id = $1;
+
+-- name: ListMerchantOrders :many
+-- Filters are optional (NULL skips them); sort is newest, oldest, amount_desc or amount_asc
+SELECT sqlc.embed(orders) FROM orders
+LEFT JOIN users ON users.id = orders.user_id
+WHERE orders.merchant_id = sqlc.arg(merchant_id)
+    AND (sqlc.narg(status)::text IS NULL OR orders.status = sqlc.narg(status))
+    AND (sqlc.narg(created_from)::timestamp IS NULL OR orders.created_at >= sqlc.narg(created_from))
+    AND (sqlc.narg(created_to)::timestamp IS NULL OR orders.created_at < sqlc.narg(created_to))
+    AND (sqlc.narg(outlet_ids)::bigint[] IS NULL OR orders.outlet_id = ANY(sqlc.narg(outlet_ids)::bigint[]))
+    AND (sqlc.narg(min_amount)::numeric IS NULL OR orders.total_amount >= sqlc.narg(min_amount))
+    AND (sqlc.narg(search)::text IS NULL
+        OR orders.order_number ILIKE '%' || sqlc.narg(search) || '%'
+        OR users.name ILIKE '%' || sqlc.narg(search) || '%'
+        OR users.phone ILIKE '%' || sqlc.narg(search) || '%')
+ORDER BY
+    CASE WHEN sqlc.arg(sort)::text = 'oldest' THEN orders.created_at END ASC,
+    CASE WHEN sqlc.arg(sort)::text = 'amount_desc' THEN orders.total_amount END DESC,
+    CASE WHEN sqlc.arg(sort)::text = 'amount_asc' THEN orders.total_amount END ASC,
+    orders.created_at DESC,
+    orders.id DESC
+LIMIT sqlc.arg(row_limit) OFFSET sqlc.arg(row_offset);
+
+-- name: CountFilteredMerchantOrders :one
+SELECT COUNT(*) FROM orders
+LEFT JOIN users ON users.id = orders.user_id
+WHERE orders.merchant_id = sqlc.arg(merchant_id)
+    AND (sqlc.narg(status)::text IS NULL OR orders.status = sqlc.narg(status))
+    AND (sqlc.narg(created_from)::timestamp IS NULL OR orders.created_at >= sqlc.narg(created_from))
+    AND (sqlc.narg(created_to)::timestamp IS NULL OR orders.created_at < sqlc.narg(created_to))
+    AND (sqlc.narg(outlet_ids)::bigint[] IS NULL OR orders.outlet_id = ANY(sqlc.narg(outlet_ids)::bigint[]))
+    AND (sqlc.narg(min_amount)::numeric IS NULL OR orders.total_amount >= sqlc.narg(min_amount))
+    AND (sqlc.narg(search)::text IS NULL
+        OR orders.order_number ILIKE '%' || sqlc.narg(search) || '%'
+        OR users.name ILIKE '%' || sqlc.narg(search) || '%'
+        OR users.phone ILIKE '%' || sqlc.narg(search) || '%');
+
+-- name: ScheduleOrderTimer :one
+INSERT INTO order_timers (
+    order_id, kind, due_at
+) VALUES (
+    $1, $2, $3
+)
+ON CONFLICT (order_id, kind) DO UPDATE SET
+    due_at = EXCLUDED.due_at,
+    claimed_until = NULL,
+    attempts = 0,
+    fired_at = NULL,
+    last_error = NULL
+RETURNING *;
+
+-- name: ClaimDueOrderTimers :many
+-- SKIP LOCKED plus the lease keeps instances from picking up the same timer;
+-- a claim whose worker died is picked up again once the lease runs out
+UPDATE order_timers SET
+    claimed_until = sqlc.arg(lease_until),
+    attempts = attempts + 1
+WHERE id IN (
+    SELECT due.id FROM order_timers due
+    WHERE due.fired_at IS NULL
+        AND due.due_at <= sqlc.arg(now)
+        AND (due.claimed_until IS NULL OR due.claimed_until < sqlc.arg(now))
+        AND due.attempts < sqlc.arg(max_attempts)
+    ORDER BY due.due_at
+    LIMIT sqlc.arg(batch_size)
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: CompleteOrderTimer :exec
+UPDATE order_timers SET
+    fired_at = sqlc.arg(fired_at),
+    claimed_until = NULL,
+    last_error = sqlc.narg(last_error)
+WHERE id = sqlc.arg(id);
+
+-- name: FailOrderTimer :exec
+-- The claim's lease is kept, so the timer is retried once it runs out
+UPDATE order_timers SET
+    last_error = $2
+WHERE id = $1;
@@ -0,0 +1,117 @@
+-- name: UpsertDeviceToken :one
+-- A token moves to whoever registers it last, e.g. after signing in as someone else
+INSERT INTO device_tokens (user_id, token, platform, latitude, longitude)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (token) DO UPDATE SET
+    user_id = EXCLUDED.user_id,
+    platform = EXCLUDED.platform,
+    latitude = COALESCE(EXCLUDED.latitude, device_tokens.latitude),
+    longitude = COALESCE(EXCLUDED.longitude, device_tokens.longitude),
+    last_seen_at = NOW()
+RETURNING *;
+
+-- name: DeleteDeviceToken :execrows
+DELETE FROM device_tokens WHERE user_id = $1 AND token = $2;
+
+-- name: DeleteDeviceTokenByID :exec
+DELETE FROM device_tokens WHERE id = $1;
+
+-- name: ListDeviceUsersInArea :many
+SELECT DISTINCT ON (user_id) user_id,
+       latitude::float8 AS latitude,
+       longitude::float8 AS longitude
+FROM device_tokens
+WHERE latitude BETWEEN sqlc.arg(min_lat)::float8 AND sqlc.arg(max_lat)::float8
+  AND longitude BETWEEN sqlc.arg(min_lng)::float8 AND sqlc.arg(max_lng)::float8
+ORDER BY user_id, last_seen_at DESC;
+
+-- name: EnqueuePushDeliveries :execrows
+INSERT INTO push_deliveries (notification_id, device_token_id, next_attempt_at)
+SELECT sqlc.arg(notification_id), device_tokens.id, sqlc.arg(send_at)
+FROM device_tokens
+WHERE device_tokens.user_id = sqlc.arg(user_id)
+ON CONFLICT DO NOTHING;
+
+-- name: ClaimDuePushDeliveries :many
+-- Same claim and lease scheme as order timers
+WITH claimed AS (
+    UPDATE push_deliveries SET
+        claimed_until = sqlc.arg(lease_until),
+        attempts = attempts + 1
+    WHERE push_deliveries.id IN (
+        SELECT due.id FROM push_deliveries due
+        WHERE due.status = 'pending'
+            AND due.next_attempt_at <= sqlc.arg(now)
+            AND (due.claimed_until IS NULL OR due.claimed_until < sqlc.arg(now))
+        ORDER BY due.next_attempt_at
+        LIMIT sqlc.arg(batch_size)
+        FOR UPDATE SKIP LOCKED
+    )
+    RETURNING push_deliveries.id, push_deliveries.notification_id, push_deliveries.device_token_id, push_deliveries.attempts
+)
+SELECT claimed.id,
+       claimed.attempts,
+       claimed.device_token_id,
+       device_tokens.token,
+       device_tokens.platform,
+       notifications.id AS notification_id,
+       notifications.type,
+       notifications.title,
+       notifications.body,
+       notifications.deep_link
+FROM claimed
+JOIN device_tokens ON device_tokens.id = claimed.device_token_id
+JOIN notifications ON notifications.id = claimed.notification_id;
+
+-- name: MarkPushSent :exec
+UPDATE push_deliveries SET
+    status = 'sent',
+    sent_at = NOW(),
+    claimed_until = NULL
+WHERE id = $1;
+
+-- name: RetryPushDelivery :exec
+UPDATE push_deliveries SET
+    next_attempt_at = sqlc.arg(next_attempt_at),
+    last_error = sqlc.arg(last_error),
+    claimed_until = NULL
+WHERE id = sqlc.arg(id);
+
+-- name: FailPushDelivery :exec
+UPDATE push_deliveries SET
+    status = 'failed',
+    last_error = sqlc.arg(last_error),
+    claimed_until = NULL
+WHERE id = sqlc.arg(id);
+
+-- name: ListNotificationPreferences :many
+SELECT * FROM notification_preferences WHERE user_id = $1;
+
+-- name: UpsertNotificationPreference :exec
+INSERT INTO notification_preferences (user_id, category, push, email, in_app)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (user_id, category) DO UPDATE SET
+    push = EXCLUDED.push,
+    email = EXCLUDED.email,
+    in_app = EXCLUDED.in_app,
+    updated_at = NOW();
+
+-- name: GetNotificationSettings :one
+SELECT * FROM notification_settings WHERE user_id = $1;
+
+-- name: UpsertNotificationSettings :one
+INSERT INTO notification_settings (user_id, timezone, quiet_start, quiet_end)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (user_id) DO UPDATE SET
+    timezone = EXCLUDED.timezone,
+    quiet_start = EXCLUDED.quiet_start,
+    quiet_end = EXCLUDED.quiet_end,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: SetNotificationLocale :exec
+INSERT INTO notification_settings (user_id, locale)
+VALUES ($1, $2)
+ON CONFLICT (user_id) DO UPDATE SET
+    locale = EXCLUDED.locale,
+    updated_at = NOW();
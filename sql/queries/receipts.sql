@@ -0,0 +1,30 @@
+-- name: NextInvoiceNumber :one
+-- Invoice numbers run per merchant per financial year. The counter row stays
+-- locked until the receipt commits, so numbers are handed out without gaps.
+INSERT INTO invoice_counters (merchant_id, financial_year, last_value)
+VALUES ($1, $2, 1)
+ON CONFLICT (merchant_id, financial_year) DO UPDATE SET last_value = invoice_counters.last_value + 1
+RETURNING last_value;
+
+-- name: CreateReceipt :one
+INSERT INTO receipts (
+    merchant_id, user_id, order_id, transaction_id, invoice_number, financial_year, gstin, html_key, pdf_key
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+) RETURNING *;
+
+-- name: GetReceiptByOrder :one
+SELECT * FROM receipts WHERE order_id = $1;
+
+-- name: GetReceiptByTransaction :one
+SELECT * FROM receipts WHERE transaction_id = $1;
+
+-- name: GetMerchantGSTIN :one
+-- The GSTIN printed on tax invoices comes from the merchant's verified GST document
+SELECT document_number FROM merchant_documents
+WHERE merchant_id = $1
+  AND doc_type = 'gst'
+  AND status = 'verified'
+  AND document_number IS NOT NULL
+ORDER BY reviewed_at DESC
+LIMIT 1;
@@ -0,0 +1,80 @@
+-- name: CreateReview :one
+INSERT INTO reviews (
+    merchant_id, user_id, order_id, rating, body
+) VALUES (
+    $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: GetReview :one
+SELECT * FROM reviews WHERE id = $1;
+
+-- name: GetReviewByOrder :one
+SELECT * FROM reviews WHERE order_id = $1;
+
+-- name: ListMerchantReviews :many
+SELECT sqlc.embed(reviews), users.name AS user_name
+FROM reviews
+LEFT JOIN users ON users.id = reviews.user_id
+WHERE reviews.merchant_id = $1 AND reviews.status = 'published'
+ORDER BY reviews.created_at DESC, reviews.id DESC
+LIMIT $2 OFFSET $3;
+
+-- name: CountMerchantReviews :one
+SELECT COUNT(*) FROM reviews WHERE merchant_id = $1 AND status = 'published';
+
+-- name: ListFlaggedReviews :many
+SELECT * FROM reviews
+WHERE flag_count > 0
+ORDER BY flag_count DESC, created_at
+LIMIT $1 OFFSET $2;
+
+-- name: CountFlaggedReviews :one
+SELECT COUNT(*) FROM reviews WHERE flag_count > 0;
+
+-- name: ReplyToReview :one
+UPDATE reviews SET
+    reply = $3,
+    replied_by = $4,
+    replied_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1 AND merchant_id = $2
+RETURNING *;
+
+-- name: FlagReview :execrows
+-- Does nothing when the user already flagged the review
+INSERT INTO review_flags (review_id, user_id, reason)
+VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING;
+
+-- name: IncrementReviewFlags :exec
+UPDATE reviews SET flag_count = flag_count + 1 WHERE id = $1;
+
+-- name: ModerateReview :one
+-- Only moves the review if it is still in from_status, so the rating totals
+-- are adjusted once. Moderating settles the open flags.
+UPDATE reviews SET
+    status = sqlc.arg(to_status),
+    flag_count = 0,
+    moderated_by = sqlc.arg(moderated_by),
+    moderated_at = NOW(),
+    moderation_reason = sqlc.narg(reason),
+    updated_at = NOW()
+WHERE id = sqlc.arg(id) AND status = sqlc.arg(from_status)
+RETURNING *;
+
+-- name: CreateReviewPhoto :one
+INSERT INTO review_photos (review_id, object_key)
+VALUES ($1, $2)
+RETURNING *;
+
+-- name: ListReviewPhotos :many
+SELECT * FROM review_photos
+WHERE review_id = ANY(sqlc.arg(review_ids)::bigint[])
+ORDER BY review_id, id;
+
+-- name: AdjustMerchantRating :exec
+-- Adds (or with negative values removes) published ratings from the merchant's totals
+UPDATE merchants SET
+    rating_count = rating_count + sqlc.arg(count_delta),
+    rating_total = rating_total + sqlc.arg(total_delta)
+WHERE id = sqlc.arg(id);
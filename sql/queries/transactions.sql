@@ -1,11 +1,15 @@
 -- name: CreateTransaction :one
 INSERT INTO transactions (
     user_id, merchant_id, coins_spent, original_amount, discount_amount, final_amount,
-    transaction_type, status
+    transaction_type, status, order_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
 ) RETURNING *;
 
+-- name: GetOrderPayment :one
+SELECT * FROM transactions
+WHERE order_id = $1 AND transaction_type = 'order_payment';
+
 -- name: CreateCoinPurchase :one
 INSERT INTO coin_purchases (
     user_id, amount, coins_received, payment_method, status
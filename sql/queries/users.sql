@@ -6,6 +6,8 @@ UPDATE users
 SET
     name = $2,
     phone = $3,
+    -- A new number has to be verified again
+    phone_verified_at = CASE WHEN phone IS DISTINCT FROM $3 THEN NULL ELSE phone_verified_at END,
     profile_pic = $4,
     updated_at = NOW()
 WHERE
@@ -0,0 +1,22 @@
+-- +goose Up
+-- API keys for server-to-server merchant integrations (POS)
+CREATE TABLE merchant_api_keys (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    merchant_id BIGINT NOT NULL REFERENCES merchants (id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    prefix VARCHAR(16) UNIQUE NOT NULL,
+    key_hash VARCHAR(64) NOT NULL,
+    scopes TEXT[] NOT NULL DEFAULT '{}',
+    allowed_ips TEXT[] NOT NULL DEFAULT '{}',
+    created_by BIGINT REFERENCES users (id) ON DELETE SET NULL,
+    last_used_at TIMESTAMP,
+    last_used_ip VARCHAR(45),
+    expires_at TIMESTAMP,
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX idx_merchant_api_keys_merchant_id ON merchant_api_keys (merchant_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS merchant_api_keys;
@@ -0,0 +1,38 @@
+-- +goose Up
+-- Device metadata so users can see and revoke their sessions
+ALTER TABLE jwt_sessions ADD COLUMN session_id VARCHAR(64);
+
+ALTER TABLE jwt_sessions ADD COLUMN device_id VARCHAR(255);
+
+ALTER TABLE jwt_sessions ADD COLUMN device_name VARCHAR(255);
+
+ALTER TABLE jwt_sessions ADD COLUMN platform VARCHAR(50);
+
+ALTER TABLE jwt_sessions ADD COLUMN user_agent TEXT;
+
+ALTER TABLE jwt_sessions ADD COLUMN ip_address VARCHAR(45);
+
+ALTER TABLE jwt_sessions ADD COLUMN last_seen_at TIMESTAMP DEFAULT NOW();
+
+CREATE UNIQUE INDEX idx_jwt_sessions_session_id ON jwt_sessions (session_id);
+
+CREATE INDEX idx_jwt_sessions_user_id ON jwt_sessions (user_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_jwt_sessions_user_id;
+
+DROP INDEX IF EXISTS idx_jwt_sessions_session_id;
+
+ALTER TABLE jwt_sessions DROP COLUMN IF EXISTS last_seen_at;
+
+ALTER TABLE jwt_sessions DROP COLUMN IF EXISTS ip_address;
+
+ALTER TABLE jwt_sessions DROP COLUMN IF EXISTS user_agent;
+
+ALTER TABLE jwt_sessions DROP COLUMN IF EXISTS platform;
+
+ALTER TABLE jwt_sessions DROP COLUMN IF EXISTS device_name;
+
+ALTER TABLE jwt_sessions DROP COLUMN IF EXISTS device_id;
+
+ALTER TABLE jwt_sessions DROP COLUMN IF EXISTS session_id;
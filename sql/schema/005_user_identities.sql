@@ -0,0 +1,25 @@
+-- +goose Up
+-- External identities (Firebase, OIDC providers) linked to a user
+CREATE TABLE user_identities (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    user_id BIGINT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    provider VARCHAR(50) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    email VARCHAR(255),
+    email_verified BOOLEAN DEFAULT false,
+    last_login_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE (provider, subject),
+    UNIQUE (user_id, provider)
+);
+
+CREATE INDEX idx_user_identities_user_id ON user_identities (user_id);
+
+-- Existing Firebase users become linked identities
+INSERT INTO user_identities (user_id, provider, subject, email, email_verified)
+SELECT id, 'firebase', firebase_uid, email, true
+FROM users
+WHERE firebase_uid IS NOT NULL;
+
+-- +goose Down
+DROP TABLE IF EXISTS user_identities;
@@ -0,0 +1,34 @@
+-- +goose Up
+-- Merchant lifecycle: draft -> submitted -> under_review -> approved/rejected -> suspended
+ALTER TABLE merchants ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'draft' CHECK (
+    status IN ('draft', 'submitted', 'under_review', 'approved', 'rejected', 'suspended')
+);
+
+-- Existing merchants were live before approvals existed
+UPDATE merchants SET status = CASE WHEN is_active THEN 'approved' ELSE 'suspended' END;
+
+ALTER TABLE merchants ALTER COLUMN is_active SET DEFAULT false;
+
+CREATE INDEX idx_merchants_status ON merchants (status);
+
+CREATE TABLE merchant_status_history (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    merchant_id BIGINT NOT NULL REFERENCES merchants (id) ON DELETE CASCADE,
+    from_status VARCHAR(20),
+    to_status VARCHAR(20) NOT NULL,
+    reason TEXT,
+    actor_id BIGINT,
+    actor_type VARCHAR(20) NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX idx_merchant_status_history_merchant ON merchant_status_history (merchant_id, created_at DESC);
+
+-- +goose Down
+DROP TABLE IF EXISTS merchant_status_history;
+
+DROP INDEX IF EXISTS idx_merchants_status;
+
+ALTER TABLE merchants ALTER COLUMN is_active SET DEFAULT true;
+
+ALTER TABLE merchants DROP COLUMN IF EXISTS status;
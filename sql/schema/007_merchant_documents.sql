@@ -0,0 +1,26 @@
+-- +goose Up
+-- KYC documents (PAN, GST, FSSAI) stored privately in MinIO under kyc/
+CREATE TABLE merchant_documents (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    merchant_id BIGINT NOT NULL REFERENCES merchants (id) ON DELETE CASCADE,
+    doc_type VARCHAR(20) NOT NULL CHECK (doc_type IN ('pan', 'gst', 'fssai')),
+    document_number VARCHAR(50),
+    object_key VARCHAR(512) NOT NULL UNIQUE,
+    content_type VARCHAR(100) NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'uploaded' CHECK (status IN ('uploaded', 'verified', 'rejected')),
+    rejection_reason TEXT,
+    reviewed_by BIGINT,
+    reviewed_at TIMESTAMP,
+    expires_at TIMESTAMP,
+    expiry_reminders_sent INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX idx_merchant_documents_merchant ON merchant_documents (merchant_id, doc_type, created_at DESC);
+
+CREATE INDEX idx_merchant_documents_expiry ON merchant_documents (expires_at) WHERE status = 'verified';
+
+-- +goose Down
+DROP TABLE IF EXISTS merchant_documents;
@@ -0,0 +1,40 @@
+-- +goose Up
+-- Merchants can run several outlets, each with its own address
+ALTER TABLE merchant_addresses ADD COLUMN label VARCHAR(100);
+
+-- Keep only the newest primary address per merchant before enforcing one
+UPDATE merchant_addresses a SET is_primary = false
+WHERE is_primary AND EXISTS (
+    SELECT 1 FROM merchant_addresses b
+    WHERE b.merchant_id = a.merchant_id AND b.is_primary AND b.created_at > a.created_at
+);
+
+-- Rows from before the column had a default, SET NOT NULL fails on them
+UPDATE merchant_addresses SET is_primary = false WHERE is_primary IS NULL;
+
+ALTER TABLE merchant_addresses ALTER COLUMN is_primary SET DEFAULT false;
+ALTER TABLE merchant_addresses ALTER COLUMN is_primary SET NOT NULL;
+
+CREATE UNIQUE INDEX idx_merchant_addresses_primary ON merchant_addresses (merchant_id) WHERE is_primary;
+
+ALTER TABLE merchant_addresses ADD CONSTRAINT merchant_addresses_coordinates_check CHECK (
+    latitude BETWEEN -90 AND 90 AND longitude BETWEEN -180 AND 180
+);
+
+ALTER TABLE merchant_addresses DROP CONSTRAINT merchant_addresses_merchant_id_fkey;
+ALTER TABLE merchant_addresses ADD CONSTRAINT merchant_addresses_merchant_id_fkey
+    FOREIGN KEY (merchant_id) REFERENCES merchants (id) ON DELETE CASCADE;
+
+-- +goose Down
+ALTER TABLE merchant_addresses DROP CONSTRAINT merchant_addresses_merchant_id_fkey;
+ALTER TABLE merchant_addresses ADD CONSTRAINT merchant_addresses_merchant_id_fkey
+    FOREIGN KEY (merchant_id) REFERENCES merchants (id);
+
+ALTER TABLE merchant_addresses DROP CONSTRAINT IF EXISTS merchant_addresses_coordinates_check;
+
+DROP INDEX IF EXISTS idx_merchant_addresses_primary;
+
+ALTER TABLE merchant_addresses ALTER COLUMN is_primary DROP NOT NULL;
+ALTER TABLE merchant_addresses ALTER COLUMN is_primary SET DEFAULT true;
+
+ALTER TABLE merchant_addresses DROP COLUMN IF EXISTS label;
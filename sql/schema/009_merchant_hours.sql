@@ -0,0 +1,38 @@
+-- +goose Up
+-- Schedules are evaluated in the merchant's own timezone
+ALTER TABLE merchants ADD COLUMN timezone VARCHAR(64) NOT NULL DEFAULT 'Asia/Kolkata';
+ALTER TABLE merchants ADD COLUMN orders_paused_until TIMESTAMP;
+
+-- Weekly opening intervals, several per day. closes_at before opens_at runs past midnight.
+CREATE TABLE merchant_hours (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    merchant_id BIGINT NOT NULL REFERENCES merchants (id) ON DELETE CASCADE,
+    day_of_week SMALLINT NOT NULL CHECK (day_of_week BETWEEN 0 AND 6), -- 0 = Sunday
+    opens_at TIME NOT NULL,
+    closes_at TIME NOT NULL,
+    CHECK (opens_at <> closes_at)
+);
+
+CREATE INDEX idx_merchant_hours_merchant ON merchant_hours (merchant_id, day_of_week);
+
+-- Holidays and temporary closures override the weekly schedule (UTC)
+CREATE TABLE merchant_closures (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    merchant_id BIGINT NOT NULL REFERENCES merchants (id) ON DELETE CASCADE,
+    starts_at TIMESTAMP NOT NULL,
+    ends_at TIMESTAMP NOT NULL,
+    reason TEXT,
+    created_at TIMESTAMP DEFAULT NOW(),
+    CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX idx_merchant_closures_merchant ON merchant_closures (merchant_id, ends_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS merchant_closures;
+
+DROP TABLE IF EXISTS merchant_hours;
+
+ALTER TABLE merchants DROP COLUMN IF EXISTS orders_paused_until;
+
+ALTER TABLE merchants DROP COLUMN IF EXISTS timezone;
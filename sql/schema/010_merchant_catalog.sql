@@ -0,0 +1,46 @@
+-- +goose Up
+CREATE TABLE catalog_categories (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    merchant_id BIGINT NOT NULL REFERENCES merchants (id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    sort_order INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE (merchant_id, name)
+);
+
+CREATE TABLE catalog_items (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    merchant_id BIGINT NOT NULL REFERENCES merchants (id) ON DELETE CASCADE,
+    category_id BIGINT REFERENCES catalog_categories (id) ON DELETE SET NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    price DECIMAL(10, 2) NOT NULL CHECK (price >= 0),
+    is_available BOOLEAN NOT NULL DEFAULT true,
+    image_key VARCHAR(255),
+    sort_order INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX idx_catalog_items_merchant ON catalog_items (merchant_id, sort_order);
+
+-- Variants replace each other (pick one, e.g. size), add-ons stack. Both adjust the item price.
+CREATE TABLE catalog_options (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    item_id BIGINT NOT NULL REFERENCES catalog_items (id) ON DELETE CASCADE,
+    kind VARCHAR(10) NOT NULL CHECK (kind IN ('variant', 'addon')),
+    name VARCHAR(100) NOT NULL,
+    price_delta DECIMAL(10, 2) NOT NULL DEFAULT 0.00,
+    is_available BOOLEAN NOT NULL DEFAULT true,
+    sort_order INT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_catalog_options_item ON catalog_options (item_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS catalog_options;
+
+DROP TABLE IF EXISTS catalog_items;
+
+DROP TABLE IF EXISTS catalog_categories;
@@ -0,0 +1,43 @@
+-- +goose Up
+-- Staff memberships link user accounts to a merchant with a role. An empty
+-- outlet_ids list means the member works at every outlet (merchant address).
+CREATE TABLE merchant_staff (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    merchant_id BIGINT NOT NULL REFERENCES merchants (id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    role VARCHAR(20) NOT NULL CHECK (role IN ('owner', 'manager', 'cashier')),
+    outlet_ids BIGINT[] NOT NULL DEFAULT '{}',
+    invited_by BIGINT REFERENCES users (id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE (merchant_id, user_id)
+);
+
+CREATE INDEX idx_merchant_staff_user_id ON merchant_staff (user_id);
+
+CREATE TABLE merchant_staff_invitations (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    merchant_id BIGINT NOT NULL REFERENCES merchants (id) ON DELETE CASCADE,
+    email VARCHAR(255) NOT NULL,
+    role VARCHAR(20) NOT NULL CHECK (role IN ('owner', 'manager', 'cashier')),
+    outlet_ids BIGINT[] NOT NULL DEFAULT '{}',
+    token_hash VARCHAR(64) UNIQUE NOT NULL,
+    invited_by BIGINT REFERENCES users (id) ON DELETE SET NULL,
+    expires_at TIMESTAMP NOT NULL,
+    accepted_at TIMESTAMP,
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX idx_merchant_staff_invitations_merchant_id ON merchant_staff_invitations (merchant_id);
+
+-- The account that shares the merchant's login email owns the merchant
+INSERT INTO merchant_staff (merchant_id, user_id, role)
+SELECT m.id, u.id, 'owner'
+FROM merchants m
+JOIN users u ON LOWER(u.email) = LOWER(m.email);
+
+-- +goose Down
+DROP TABLE IF EXISTS merchant_staff_invitations;
+
+DROP TABLE IF EXISTS merchant_staff;
@@ -0,0 +1,48 @@
+-- +goose Up
+-- Order lifecycle: pending -> accepted -> preparing -> ready -> completed, with cancelled/rejected branches
+UPDATE orders SET status = 'accepted' WHERE status = 'confirmed';
+UPDATE orders SET status = 'pending' WHERE status IS NULL;
+
+ALTER TABLE orders ALTER COLUMN status SET NOT NULL;
+ALTER TABLE orders ADD CONSTRAINT orders_status_check CHECK (
+    status IN ('pending', 'accepted', 'preparing', 'ready', 'completed', 'cancelled', 'rejected')
+);
+
+ALTER TABLE orders ADD COLUMN status_reason TEXT;
+ALTER TABLE orders ADD COLUMN accepted_at TIMESTAMP;
+ALTER TABLE orders ADD COLUMN preparing_at TIMESTAMP;
+ALTER TABLE orders ADD COLUMN ready_at TIMESTAMP;
+ALTER TABLE orders ADD COLUMN completed_at TIMESTAMP;
+ALTER TABLE orders ADD COLUMN cancelled_at TIMESTAMP;
+ALTER TABLE orders ADD COLUMN rejected_at TIMESTAMP;
+
+CREATE INDEX idx_orders_merchant_status ON orders (merchant_id, status);
+
+CREATE TABLE order_status_history (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    order_id BIGINT NOT NULL REFERENCES orders (id) ON DELETE CASCADE,
+    from_status VARCHAR(20),
+    to_status VARCHAR(20) NOT NULL,
+    reason TEXT,
+    actor_id BIGINT,
+    actor_type VARCHAR(20) NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX idx_order_status_history_order ON order_status_history (order_id, created_at DESC);
+
+-- +goose Down
+DROP TABLE IF EXISTS order_status_history;
+
+DROP INDEX IF EXISTS idx_orders_merchant_status;
+
+ALTER TABLE orders DROP COLUMN IF EXISTS rejected_at;
+ALTER TABLE orders DROP COLUMN IF EXISTS cancelled_at;
+ALTER TABLE orders DROP COLUMN IF EXISTS completed_at;
+ALTER TABLE orders DROP COLUMN IF EXISTS ready_at;
+ALTER TABLE orders DROP COLUMN IF EXISTS preparing_at;
+ALTER TABLE orders DROP COLUMN IF EXISTS accepted_at;
+ALTER TABLE orders DROP COLUMN IF EXISTS status_reason;
+
+ALTER TABLE orders DROP CONSTRAINT IF EXISTS orders_status_check;
+ALTER TABLE orders ALTER COLUMN status DROP NOT NULL;
@@ -0,0 +1,12 @@
+-- +goose Up
+-- Branch (merchant address) an order was placed at, used to scope outlet staff
+ALTER TABLE orders ADD COLUMN outlet_id BIGINT REFERENCES merchant_addresses (id) ON DELETE SET NULL;
+
+CREATE INDEX idx_orders_merchant_created ON orders (merchant_id, created_at DESC);
+CREATE INDEX idx_orders_outlet ON orders (outlet_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_orders_outlet;
+DROP INDEX IF EXISTS idx_orders_merchant_created;
+
+ALTER TABLE orders DROP COLUMN IF EXISTS outlet_id;
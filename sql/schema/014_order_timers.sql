@@ -0,0 +1,20 @@
+-- +goose Up
+-- Durable order deadlines. Workers claim due rows with a lease, so a timer survives
+-- restarts and runs on one instance at a time.
+CREATE TABLE order_timers (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    order_id BIGINT NOT NULL REFERENCES orders (id) ON DELETE CASCADE,
+    kind VARCHAR(30) NOT NULL CHECK (kind IN ('accept_deadline', 'preparing_escalation')),
+    due_at TIMESTAMP NOT NULL,
+    claimed_until TIMESTAMP,
+    attempts INT NOT NULL DEFAULT 0,
+    fired_at TIMESTAMP,
+    last_error TEXT,
+    created_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE (order_id, kind)
+);
+
+CREATE INDEX idx_order_timers_due ON order_timers (due_at) WHERE fired_at IS NULL;
+
+-- +goose Down
+DROP TABLE IF EXISTS order_timers;
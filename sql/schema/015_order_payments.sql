@@ -0,0 +1,13 @@
+-- +goose Up
+-- Coins charged for an order are linked to it so cancelling can reverse them
+ALTER TABLE transactions ADD COLUMN order_id BIGINT REFERENCES orders (id) ON DELETE SET NULL;
+ALTER TABLE transactions ADD COLUMN updated_at TIMESTAMP DEFAULT NOW();
+
+-- An order is charged and refunded at most once
+CREATE UNIQUE INDEX idx_transactions_order_type ON transactions (order_id, transaction_type) WHERE order_id IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_transactions_order_type;
+
+ALTER TABLE transactions DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE transactions DROP COLUMN IF EXISTS order_id;
@@ -0,0 +1,19 @@
+-- +goose Up
+-- Order numbers are short per-merchant codes from a counter, optionally reset every
+-- day, so they are only unique within the merchant and numbering period.
+CREATE TABLE order_number_counters (
+    merchant_id BIGINT NOT NULL REFERENCES merchants (id) ON DELETE CASCADE,
+    period VARCHAR(10) NOT NULL,
+    last_value BIGINT NOT NULL,
+    PRIMARY KEY (merchant_id, period)
+);
+
+ALTER TABLE orders ADD COLUMN number_period VARCHAR(10) NOT NULL DEFAULT '';
+ALTER TABLE orders DROP CONSTRAINT IF EXISTS orders_order_number_key;
+CREATE UNIQUE INDEX idx_orders_merchant_number ON orders (merchant_id, number_period, order_number);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_orders_merchant_number;
+ALTER TABLE orders ADD CONSTRAINT orders_order_number_key UNIQUE (order_number);
+ALTER TABLE orders DROP COLUMN IF EXISTS number_period;
+DROP TABLE IF EXISTS order_number_counters;
@@ -0,0 +1,29 @@
+-- +goose Up
+-- Receipts and GST tax invoices rendered for orders and merchant payments. The
+-- HTML and PDF files live privately in MinIO under receipts/.
+CREATE TABLE invoice_counters (
+    merchant_id BIGINT NOT NULL REFERENCES merchants (id) ON DELETE CASCADE,
+    financial_year VARCHAR(7) NOT NULL,
+    last_value BIGINT NOT NULL,
+    PRIMARY KEY (merchant_id, financial_year)
+);
+
+CREATE TABLE receipts (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    merchant_id BIGINT NOT NULL REFERENCES merchants (id) ON DELETE CASCADE,
+    user_id BIGINT REFERENCES users (id) ON DELETE SET NULL,
+    order_id BIGINT UNIQUE REFERENCES orders (id) ON DELETE CASCADE,
+    transaction_id BIGINT UNIQUE REFERENCES transactions (id) ON DELETE CASCADE,
+    invoice_number VARCHAR(16) NOT NULL,
+    financial_year VARCHAR(7) NOT NULL,
+    gstin VARCHAR(15),
+    html_key VARCHAR(512) NOT NULL,
+    pdf_key VARCHAR(512) NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    CHECK (num_nonnulls(order_id, transaction_id) = 1),
+    UNIQUE (merchant_id, financial_year, invoice_number)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS receipts;
+DROP TABLE IF EXISTS invoice_counters;
@@ -0,0 +1,54 @@
+-- +goose Up
+-- Customer reviews of completed orders, one per order. Merchants keep running
+-- totals of their published ratings so the average needs no aggregate query.
+CREATE TABLE reviews (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    merchant_id BIGINT NOT NULL REFERENCES merchants (id) ON DELETE CASCADE,
+    user_id BIGINT REFERENCES users (id) ON DELETE SET NULL,
+    order_id BIGINT NOT NULL UNIQUE REFERENCES orders (id) ON DELETE CASCADE,
+    rating SMALLINT NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    body TEXT,
+    status VARCHAR(20) NOT NULL DEFAULT 'published' CHECK (status IN ('published', 'hidden')),
+    flag_count INT NOT NULL DEFAULT 0,
+    moderated_by BIGINT,
+    moderated_at TIMESTAMP,
+    moderation_reason TEXT,
+    reply TEXT,
+    replied_by BIGINT,
+    replied_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX idx_reviews_merchant ON reviews (merchant_id, created_at DESC) WHERE status = 'published';
+
+CREATE INDEX idx_reviews_flagged ON reviews (flag_count DESC, created_at) WHERE flag_count > 0;
+
+-- Photos are uploaded to MinIO under reviews/ before the review is posted
+CREATE TABLE review_photos (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    review_id BIGINT NOT NULL REFERENCES reviews (id) ON DELETE CASCADE,
+    object_key VARCHAR(512) NOT NULL UNIQUE,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX idx_review_photos_review ON review_photos (review_id);
+
+-- One flag per user and review, so flag_count counts people
+CREATE TABLE review_flags (
+    review_id BIGINT NOT NULL REFERENCES reviews (id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    reason TEXT,
+    created_at TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (review_id, user_id)
+);
+
+ALTER TABLE merchants ADD COLUMN rating_count INT NOT NULL DEFAULT 0;
+ALTER TABLE merchants ADD COLUMN rating_total BIGINT NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE merchants DROP COLUMN IF EXISTS rating_total;
+ALTER TABLE merchants DROP COLUMN IF EXISTS rating_count;
+DROP TABLE IF EXISTS review_flags;
+DROP TABLE IF EXISTS review_photos;
+DROP TABLE IF EXISTS reviews;
@@ -0,0 +1,19 @@
+-- +goose Up
+-- Merchants and offers a user saved, also a signal for recommendations
+CREATE TABLE favorite_merchants (
+    user_id BIGINT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    merchant_id BIGINT NOT NULL REFERENCES merchants (id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (user_id, merchant_id)
+);
+
+CREATE TABLE favorite_offers (
+    user_id BIGINT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    offer_id BIGINT NOT NULL REFERENCES offers (id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (user_id, offer_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS favorite_offers;
+DROP TABLE IF EXISTS favorite_merchants;
@@ -0,0 +1,22 @@
+-- +goose Up
+-- Inbox of notifications for users and merchants. Streams replay the unread
+-- ones on connect so nothing sent while the app was closed is lost.
+CREATE TABLE notifications (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    user_id BIGINT REFERENCES users (id) ON DELETE CASCADE,
+    merchant_id BIGINT REFERENCES merchants (id) ON DELETE CASCADE,
+    type VARCHAR(32) NOT NULL,
+    title VARCHAR(200) NOT NULL,
+    body TEXT NOT NULL DEFAULT '',
+    deep_link VARCHAR(512),
+    read_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CHECK ((user_id IS NULL) <> (merchant_id IS NULL))
+);
+
+CREATE INDEX idx_notifications_user ON notifications (user_id, created_at DESC) WHERE user_id IS NOT NULL;
+CREATE INDEX idx_notifications_merchant ON notifications (merchant_id, created_at DESC) WHERE merchant_id IS NOT NULL;
+CREATE INDEX idx_notifications_unread ON notifications (user_id, merchant_id) WHERE read_at IS NULL;
+
+-- +goose Down
+DROP TABLE IF EXISTS notifications;
@@ -0,0 +1,47 @@
+-- +goose Up
+-- Devices registered for push notifications. The last known location lets
+-- new offers reach users nearby.
+CREATE TABLE device_tokens (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    user_id BIGINT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    token TEXT NOT NULL UNIQUE,
+    platform VARCHAR(10) NOT NULL CHECK (platform IN ('android', 'ios', 'web')),
+    latitude DOUBLE PRECISION,
+    longitude DOUBLE PRECISION,
+    created_at TIMESTAMP DEFAULT NOW(),
+    last_seen_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX idx_device_tokens_user ON device_tokens (user_id);
+CREATE INDEX idx_device_tokens_location ON device_tokens (latitude, longitude) WHERE latitude IS NOT NULL;
+
+-- One push per notification and device, sent by the delivery worker
+CREATE TABLE push_deliveries (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    notification_id BIGINT NOT NULL REFERENCES notifications (id) ON DELETE CASCADE,
+    device_token_id BIGINT NOT NULL REFERENCES device_tokens (id) ON DELETE CASCADE,
+    status VARCHAR(10) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'sent', 'failed')),
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    claimed_until TIMESTAMP,
+    last_error TEXT,
+    sent_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE (notification_id, device_token_id)
+);
+
+CREATE INDEX idx_push_deliveries_due ON push_deliveries (next_attempt_at) WHERE status = 'pending';
+
+-- Per category opt-outs; a missing row means the default (enabled)
+CREATE TABLE notification_preferences (
+    user_id BIGINT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    category VARCHAR(20) NOT NULL,
+    push BOOLEAN NOT NULL DEFAULT TRUE,
+    updated_at TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (user_id, category)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS notification_preferences;
+DROP TABLE IF EXISTS push_deliveries;
+DROP TABLE IF EXISTS device_tokens;
@@ -0,0 +1,22 @@
+-- +goose Up
+-- Preferences per channel; push already exists from 021
+ALTER TABLE notification_preferences ADD COLUMN email BOOLEAN NOT NULL DEFAULT TRUE;
+ALTER TABLE notification_preferences ADD COLUMN in_app BOOLEAN NOT NULL DEFAULT TRUE;
+
+-- Quiet hours are evaluated in the user's own timezone. quiet_end before
+-- quiet_start runs past midnight; no row or NULL times means none.
+CREATE TABLE notification_settings (
+    user_id BIGINT PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    timezone VARCHAR(64) NOT NULL DEFAULT 'Asia/Kolkata',
+    quiet_start TIME,
+    quiet_end TIME,
+    updated_at TIMESTAMP DEFAULT NOW(),
+    CHECK ((quiet_start IS NULL) = (quiet_end IS NULL)),
+    CHECK (quiet_start <> quiet_end)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS notification_settings;
+
+ALTER TABLE notification_preferences DROP COLUMN IF EXISTS in_app;
+ALTER TABLE notification_preferences DROP COLUMN IF EXISTS email;
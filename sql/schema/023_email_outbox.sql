@@ -0,0 +1,29 @@
+-- +goose Up
+-- Language transactional emails are written in
+ALTER TABLE notification_settings ADD COLUMN locale VARCHAR(8) NOT NULL DEFAULT 'en';
+
+-- Rendered emails waiting to be sent, retried with backoff like pushes.
+-- Bodies are cleared once sent so codes don't linger.
+CREATE TABLE email_outbox (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    recipient VARCHAR(255) NOT NULL,
+    template VARCHAR(64) NOT NULL,
+    locale VARCHAR(8) NOT NULL,
+    subject TEXT NOT NULL,
+    text_body TEXT NOT NULL,
+    html_body TEXT NOT NULL,
+    status VARCHAR(10) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'sent', 'failed')),
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    claimed_until TIMESTAMP,
+    last_error TEXT,
+    sent_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX idx_email_outbox_due ON email_outbox (next_attempt_at) WHERE status = 'pending';
+
+-- +goose Down
+DROP TABLE IF EXISTS email_outbox;
+
+ALTER TABLE notification_settings DROP COLUMN IF EXISTS locale;
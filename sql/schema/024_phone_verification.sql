@@ -0,0 +1,10 @@
+-- +goose Up
+-- Verified phones are stored in E.164 and can sign in with an SMS code
+ALTER TABLE users ADD COLUMN phone_verified_at TIMESTAMP;
+
+CREATE UNIQUE INDEX idx_users_verified_phone ON users (phone) WHERE phone_verified_at IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_users_verified_phone;
+
+ALTER TABLE users DROP COLUMN IF EXISTS phone_verified_at;
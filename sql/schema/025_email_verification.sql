@@ -0,0 +1,16 @@
+-- +goose Up
+-- Set once the user proved they own the email, by an OTP or a provider that
+-- verified it. Social logins only link to accounts with a verified email.
+ALTER TABLE users ADD COLUMN email_verified_at TIMESTAMP;
+
+-- Accounts created through a provider that verified the same email
+UPDATE users u
+SET email_verified_at = i.created_at
+FROM user_identities i
+WHERE i.user_id = u.id
+    AND i.email_verified
+    AND LOWER(i.email) = LOWER(u.email)
+    AND u.email_verified_at IS NULL;
+
+-- +goose Down
+ALTER TABLE users DROP COLUMN IF EXISTS email_verified_at;
@@ -0,0 +1,7 @@
+-- +goose Up
+-- Instances claim documents before sending expiry reminders, the same lease
+-- scheme as order timers, so each reminder goes out once.
+ALTER TABLE merchant_documents ADD COLUMN reminder_claimed_until TIMESTAMP;
+
+-- +goose Down
+ALTER TABLE merchant_documents DROP COLUMN IF EXISTS reminder_claimed_until;
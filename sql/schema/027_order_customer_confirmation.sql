@@ -0,0 +1,14 @@
+-- +goose Up
+-- Orders a merchant creates with an API key wait for the customer to confirm them
+ALTER TABLE orders DROP CONSTRAINT IF EXISTS orders_status_check;
+ALTER TABLE orders ADD CONSTRAINT orders_status_check CHECK (
+    status IN ('awaiting_customer', 'pending', 'accepted', 'preparing', 'ready', 'completed', 'cancelled', 'rejected')
+);
+
+-- +goose Down
+UPDATE orders SET status = 'cancelled' WHERE status = 'awaiting_customer';
+
+ALTER TABLE orders DROP CONSTRAINT IF EXISTS orders_status_check;
+ALTER TABLE orders ADD CONSTRAINT orders_status_check CHECK (
+    status IN ('pending', 'accepted', 'preparing', 'ready', 'completed', 'cancelled', 'rejected')
+);